@@ -22,15 +22,21 @@ import (
 
 	cachev1beta1 "github.com/crossplane-contrib/provider-azure/apis/cache/v1beta1"
 	computev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	computev1beta1 "github.com/crossplane-contrib/provider-azure/apis/compute/v1beta1"
 	databasev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
 	databasev1beta1 "github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
 	dnsv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/dns/v1alpha1"
+	insightsv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/insights/v1alpha1"
 	keyvaultv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/keyvault/v1alpha1"
+	messagingv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/messaging/v1alpha1"
 	networkv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	sqlv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/sql/v1alpha1"
+	sqlv1beta1 "github.com/crossplane-contrib/provider-azure/apis/sql/v1beta1"
 	storagev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
 	azurev1alpha1 "github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
 	azurev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 	azurev1beta1 "github.com/crossplane-contrib/provider-azure/apis/v1beta1"
+	webv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/web/v1alpha1"
 )
 
 func init() {
@@ -41,12 +47,18 @@ func init() {
 		azurev1beta1.SchemeBuilder.AddToScheme,
 		cachev1beta1.SchemeBuilder.AddToScheme,
 		computev1alpha3.SchemeBuilder.AddToScheme,
+		computev1beta1.SchemeBuilder.AddToScheme,
 		databasev1alpha3.SchemeBuilder.AddToScheme,
 		databasev1beta1.SchemeBuilder.AddToScheme,
+		insightsv1alpha1.SchemeBuilder.AddToScheme,
 		keyvaultv1alpha1.SchemeBuilder.AddToScheme,
+		messagingv1alpha1.SchemeBuilder.AddToScheme,
 		networkv1alpha3.SchemeBuilder.AddToScheme,
+		sqlv1alpha1.SchemeBuilder.AddToScheme,
+		sqlv1beta1.SchemeBuilder.AddToScheme,
 		storagev1alpha3.SchemeBuilder.AddToScheme,
 		dnsv1alpha1.SchemeBuilder.AddToScheme,
+		webv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 )
 
 const (
@@ -60,6 +62,12 @@ type RedisParameters struct {
 	// because if ResourceGroupNameRef is given we'll programmatically fill it out
 	// before making any calls to Azure API.
 
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
 	// ResourceGroupName in which to create this resource.
 	// +immutable
 	ResourceGroupName string `json:"resourceGroupName,omitempty"`
@@ -131,6 +139,11 @@ type RedisParameters struct {
 	// Tags - Resource tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+
+	// CredentialRotation configures the periodic regeneration of the access
+	// keys of this Redis.
+	// +optional
+	CredentialRotation *apisv1alpha3.CredentialRotationPolicy `json:"credentialRotation,omitempty"`
 }
 
 // A RedisSpec defines the desired state of a Redis.
@@ -173,6 +186,10 @@ type RedisObservation struct {
 type RedisStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          RedisObservation `json:"atProvider,omitempty"`
+
+	// CredentialRotation represents the state of the access key rotation of
+	// this Redis.
+	CredentialRotation apisv1alpha3.CredentialRotationStatus `json:"credentialRotation,omitempty"`
 }
 
 // +kubebuilder:object:root=true
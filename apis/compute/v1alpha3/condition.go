@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TypeUpgrading AKS clusters are being upgraded to a new Kubernetes version.
+const TypeUpgrading xpv1.ConditionType = "Upgrading"
+
+// Reasons an AKS cluster is or is not being upgraded.
+const (
+	ReasonUpgradingControlPlane xpv1.ConditionReason = "UpgradingControlPlane"
+	ReasonUpgradingNodes        xpv1.ConditionReason = "UpgradingNodes"
+	ReasonUpgraded              xpv1.ConditionReason = "Upgraded"
+)
+
+// UpgradingControlPlane returns a condition that indicates the control plane
+// of the cluster is being upgraded to the supplied Kubernetes version.
+func UpgradingControlPlane(version string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpgrading,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpgradingControlPlane,
+		Message:            "upgrading control plane to Kubernetes " + version,
+	}
+}
+
+// UpgradingNodes returns a condition that indicates the node pools of the
+// cluster are being upgraded to the supplied Kubernetes version.
+func UpgradingNodes(version string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpgrading,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpgradingNodes,
+		Message:            "upgrading node pools to Kubernetes " + version,
+	}
+}
+
+// Upgraded returns a condition that indicates the cluster finished upgrading
+// to its desired Kubernetes version.
+func Upgraded() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpgrading,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpgraded,
+	}
+}
@@ -23,11 +23,37 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	insightsv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/insights/v1alpha1"
 	networkv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
 	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 )
 
+// DiskID extracts status.atProvider.id from the supplied managed resource,
+// which must be a Disk.
+func DiskID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		d, ok := mg.(*Disk)
+		if !ok {
+			return ""
+		}
+		return d.Status.AtProvider.ID
+	}
+}
+
+// SnapshotID extracts status.atProvider.id from the supplied managed
+// resource, which must be a Snapshot.
+func SnapshotID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		s, ok := mg.(*Snapshot)
+		if !ok {
+			return ""
+		}
+		return s.Status.AtProvider.ID
+	}
+}
+
 // ResolveReferences of this AKSCluster.
 func (mg *AKSCluster) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -60,5 +86,161 @@ func (mg *AKSCluster) ResolveReferences(ctx context.Context, c client.Reader) er
 	mg.Spec.VnetSubnetID = rsp.ResolvedValue
 	mg.Spec.VnetSubnetIDRef = rsp.ResolvedReference
 
+	// Resolve spec.logAnalyticsWorkspaceID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.LogAnalyticsWorkspaceID,
+		Reference:    mg.Spec.LogAnalyticsWorkspaceIDRef,
+		Selector:     mg.Spec.LogAnalyticsWorkspaceIDSelector,
+		To:           reference.To{Managed: &insightsv1alpha1.LogAnalyticsWorkspace{}, List: &insightsv1alpha1.LogAnalyticsWorkspaceList{}},
+		Extract:      insightsv1alpha1.LogAnalyticsWorkspaceID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.logAnalyticsWorkspaceID")
+	}
+	mg.Spec.LogAnalyticsWorkspaceID = rsp.ResolvedValue
+	mg.Spec.LogAnalyticsWorkspaceIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this AKSNodePool.
+func (mg *AKSNodePool) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.clusterName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ClusterName,
+		Reference:    mg.Spec.ForProvider.ClusterNameRef,
+		Selector:     mg.Spec.ForProvider.ClusterNameSelector,
+		To:           reference.To{Managed: &AKSCluster{}, List: &AKSClusterList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.clusterName")
+	}
+	mg.Spec.ForProvider.ClusterName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ClusterNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this VirtualMachine.
+func (mg *VirtualMachine) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.dataDisks[*].diskID
+	for i := range mg.Spec.ForProvider.DataDisks {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: mg.Spec.ForProvider.DataDisks[i].DiskID,
+			Reference:    mg.Spec.ForProvider.DataDisks[i].DiskIDRef,
+			Selector:     mg.Spec.ForProvider.DataDisks[i].DiskIDSelector,
+			To:           reference.To{Managed: &Disk{}, List: &DiskList{}},
+			Extract:      DiskID(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.dataDisks[%d].diskID", i)
+		}
+		mg.Spec.ForProvider.DataDisks[i].DiskID = rsp.ResolvedValue
+		mg.Spec.ForProvider.DataDisks[i].DiskIDRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this Disk.
+func (mg *Disk) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.sourceSnapshotID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceSnapshotID),
+		Reference:    mg.Spec.ForProvider.SourceSnapshotIDRef,
+		Selector:     mg.Spec.ForProvider.SourceSnapshotIDSelector,
+		To:           reference.To{Managed: &Snapshot{}, List: &SnapshotList{}},
+		Extract:      SnapshotID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceSnapshotID")
+	}
+	mg.Spec.ForProvider.SourceSnapshotID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceSnapshotIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Snapshot.
+func (mg *Snapshot) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.sourceDiskID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceDiskID),
+		Reference:    mg.Spec.ForProvider.SourceDiskIDRef,
+		Selector:     mg.Spec.ForProvider.SourceDiskIDSelector,
+		To:           reference.To{Managed: &Disk{}, List: &DiskList{}},
+		Extract:      DiskID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceDiskID")
+	}
+	mg.Spec.ForProvider.SourceDiskID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceDiskIDRef = rsp.ResolvedReference
+
 	return nil
 }
@@ -48,3 +48,51 @@ var (
 func init() {
 	SchemeBuilder.Register(&AKSCluster{}, &AKSClusterList{})
 }
+
+// AKSNodePool type metadata.
+var (
+	AKSNodePoolKind             = reflect.TypeOf(AKSNodePool{}).Name()
+	AKSNodePoolGroupKind        = schema.GroupKind{Group: Group, Kind: AKSNodePoolKind}.String()
+	AKSNodePoolKindAPIVersion   = AKSNodePoolKind + "." + SchemeGroupVersion.String()
+	AKSNodePoolGroupVersionKind = SchemeGroupVersion.WithKind(AKSNodePoolKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AKSNodePool{}, &AKSNodePoolList{})
+}
+
+// VirtualMachine type metadata.
+var (
+	VirtualMachineKind             = reflect.TypeOf(VirtualMachine{}).Name()
+	VirtualMachineGroupKind        = schema.GroupKind{Group: Group, Kind: VirtualMachineKind}.String()
+	VirtualMachineKindAPIVersion   = VirtualMachineKind + "." + SchemeGroupVersion.String()
+	VirtualMachineGroupVersionKind = SchemeGroupVersion.WithKind(VirtualMachineKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&VirtualMachine{}, &VirtualMachineList{})
+}
+
+// Disk type metadata.
+var (
+	DiskKind             = reflect.TypeOf(Disk{}).Name()
+	DiskGroupKind        = schema.GroupKind{Group: Group, Kind: DiskKind}.String()
+	DiskKindAPIVersion   = DiskKind + "." + SchemeGroupVersion.String()
+	DiskGroupVersionKind = SchemeGroupVersion.WithKind(DiskKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Disk{}, &DiskList{})
+}
+
+// Snapshot type metadata.
+var (
+	SnapshotKind             = reflect.TypeOf(Snapshot{}).Name()
+	SnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: SnapshotKind}.String()
+	SnapshotKindAPIVersion   = SnapshotKind + "." + SchemeGroupVersion.String()
+	SnapshotGroupVersionKind = SchemeGroupVersion.WithKind(SnapshotKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Snapshot{}, &SnapshotList{})
+}
@@ -17,9 +17,12 @@ limitations under the License.
 package v1alpha3
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 )
 
 const (
@@ -30,6 +33,12 @@ const (
 // AKSClusterParameters define the desired state of an Azure Kubernetes Engine
 // cluster.
 type AKSClusterParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
 	// ResourceGroupName is the name of the resource group that the cluster will
 	// be created in
 	ResourceGroupName string `json:"resourceGroupName,omitempty"`
@@ -45,7 +54,11 @@ type AKSClusterParameters struct {
 	// Location is the Azure location that the cluster will be created in
 	Location string `json:"location"`
 
-	// Version is the Kubernetes version that will be deployed to the cluster
+	// Version is the Kubernetes version that will be deployed to the cluster.
+	// Changing it upgrades the cluster in place: the control plane first,
+	// then its node pools. The Upgrading condition reports the progress. A
+	// version without a patch version, e.g. 1.22, is replaced by the patch
+	// version that Azure deploys.
 	Version string `json:"version"`
 
 	// VnetSubnetID is the subnet to which the cluster will be deployed.
@@ -61,16 +74,147 @@ type AKSClusterParameters struct {
 
 	// NodeCount is the number of nodes that the cluster will initially be
 	// created with.  This can be scaled over time and defaults to 1.
+	// Changing it scales the cluster in place.
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	NodeCount *int `json:"nodeCount,omitempty"`
 
+	// EnableAutoScaling enables the cluster autoscaler, which scales the
+	// default node pool between MinCount and MaxCount nodes. NodeCount is
+	// only used as the initial node count when it is enabled.
+	// +optional
+	EnableAutoScaling bool `json:"enableAutoScaling,omitempty"`
+
+	// MinCount is the minimum number of nodes of the default node pool. It
+	// is required when EnableAutoScaling is true.
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinCount *int `json:"minCount,omitempty"`
+
+	// MaxCount is the maximum number of nodes of the default node pool. It
+	// is required when EnableAutoScaling is true.
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxCount *int `json:"maxCount,omitempty"`
+
 	// NodeVMSize is the name of the worker node VM size, e.g., Standard_B2s,
 	// Standard_F2s_v2, etc.
 	// +optional
 	NodeVMSize string `json:"nodeVMSize"`
 
+	// Zones - A list of availability zones to spread the worker nodes
+	// across. The node VM size must be available in each zone of the
+	// cluster's location.
+	// +immutable
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// AgentPoolType is the type of the default node pool. Availability
+	// zones, additional node pools and the cluster autoscaler all require
+	// VirtualMachineScaleSets, which is the default.
+	// +kubebuilder:validation:Enum=VirtualMachineScaleSets;AvailabilitySet
+	// +immutable
+	// +optional
+	AgentPoolType string `json:"agentPoolType,omitempty"`
+
+	// NodeOSDiskSizeGB is the size of the OS disk of each node of the
+	// default node pool, in GB. Azure chooses a size based on the VM size if
+	// it is omitted.
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=2048
+	// +immutable
+	// +optional
+	NodeOSDiskSizeGB *int `json:"nodeOSDiskSizeGB,omitempty"`
+
+	// NodeMaxPods is the maximum number of pods that can run on each node of
+	// the default node pool. Azure chooses a number based on the network
+	// plugin if it is omitted.
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=250
+	// +immutable
+	// +optional
+	NodeMaxPods *int `json:"nodeMaxPods,omitempty"`
+
+	// NodeTaints are added to the nodes of the default node pool, of the
+	// form key=value:NoSchedule.
+	// +optional
+	NodeTaints []string `json:"nodeTaints,omitempty"`
+
+	// NodeLabels are added to the nodes of the default node pool.
+	// +optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+
+	// PrivateCluster provisions the cluster with an API server that is only
+	// reachable from within its virtual network, through a private FQDN. A
+	// VnetSubnetID is required when it is true.
+	// +immutable
+	// +optional
+	PrivateCluster bool `json:"privateCluster,omitempty"`
+
+	// APIServerAuthorizedIPRanges restricts access to the API server of a
+	// public cluster to the supplied IP ranges, in CIDR notation, e.g.
+	// 203.0.113.0/24. The API server is reachable from anywhere if it is
+	// omitted. It cannot be combined with PrivateCluster.
+	// +optional
+	APIServerAuthorizedIPRanges []string `json:"apiServerAuthorizedIPRanges,omitempty"`
+
+	// NetworkPlugin is the network plugin of the cluster. Defaults to azure
+	// when a VnetSubnetID is supplied, and to kubenet otherwise.
+	// +kubebuilder:validation:Enum=azure;kubenet
+	// +immutable
+	// +optional
+	NetworkPlugin string `json:"networkPlugin,omitempty"`
+
+	// NetworkPolicy is the network policy implementation of the cluster. The
+	// azure policy requires the azure network plugin.
+	// +kubebuilder:validation:Enum=azure;calico
+	// +immutable
+	// +optional
+	NetworkPolicy string `json:"networkPolicy,omitempty"`
+
+	// PodCIDR is the CIDR range from which pod IPs are assigned when the
+	// kubenet network plugin is used.
+	// +immutable
+	// +optional
+	PodCIDR string `json:"podCidr,omitempty"`
+
+	// ServiceCIDR is the CIDR range from which service cluster IPs are
+	// assigned. It must not overlap with any subnet IP ranges.
+	// +immutable
+	// +optional
+	ServiceCIDR string `json:"serviceCidr,omitempty"`
+
+	// DNSServiceIP is the IP address assigned to the Kubernetes DNS service.
+	// It must be within ServiceCIDR.
+	// +immutable
+	// +optional
+	DNSServiceIP string `json:"dnsServiceIP,omitempty"`
+
+	// DockerBridgeCIDR is the CIDR range assigned to the Docker bridge
+	// network. It must not overlap with any subnet IP ranges or ServiceCIDR.
+	// +immutable
+	// +optional
+	DockerBridgeCIDR string `json:"dockerBridgeCidr,omitempty"`
+
+	// OutboundType is how the nodes of the cluster egress to the internet.
+	// With loadBalancer they egress through the public IPs of the load
+	// balancer of the cluster. With userDefinedRouting they egress through
+	// the route table of the VnetSubnetID, e.g. to an Azure Firewall, which
+	// must be set up before the cluster is created. Defaults to
+	// loadBalancer.
+	// +kubebuilder:validation:Enum=loadBalancer;userDefinedRouting
+	// +immutable
+	// +optional
+	OutboundType string `json:"outboundType,omitempty"`
+
+	// LoadBalancerProfile configures the outbound connections of the load
+	// balancer of the cluster. It requires the loadBalancer OutboundType.
+	// +optional
+	LoadBalancerProfile *AKSClusterLoadBalancerProfile `json:"loadBalancerProfile,omitempty"`
+
 	// DNSNamePrefix is the DNS name prefix to use with the hosted Kubernetes
 	// API server FQDN. You will use this to connect to the Kubernetes API when
 	// managing containers after creating the cluster.
@@ -81,6 +225,335 @@ type AKSClusterParameters struct {
 	// cluster.
 	// +optional
 	DisableRBAC bool `json:"disableRBAC,omitempty"`
+
+	// NodeResourceGroup is the name of the resource group that will contain
+	// the agent pool nodes. Azure generates a name if it is omitted.
+	// +immutable
+	// +optional
+	NodeResourceGroup string `json:"nodeResourceGroup,omitempty"`
+
+	// SKUTier is the tier of the managed cluster SKU. The Paid tier provides
+	// an uptime SLA for the Kubernetes API server. Defaults to Free.
+	// +kubebuilder:validation:Enum=Free;Paid
+	// +optional
+	SKUTier string `json:"skuTier,omitempty"`
+
+	// Identity configures the cluster to use a managed identity rather than
+	// an Azure AD application and service principal created for it. When a
+	// VnetSubnetID is supplied the identity must be granted the Network
+	// Contributor role on the subnet.
+	// +immutable
+	// +optional
+	Identity *AKSClusterIdentity `json:"identity,omitempty"`
+
+	// ServicePrincipalDeletionPolicy determines what happens to the Azure AD
+	// application and service principal that the controller creates for the
+	// cluster when the cluster is deleted. They are deleted along with the
+	// cluster by default. Orphan retains them, for example so that they may
+	// be reused or audited; their IDs are recorded in the status of the
+	// cluster for later cleanup. It has no effect on clusters that use a
+	// managed identity.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	ServicePrincipalDeletionPolicy xpv1.DeletionPolicy `json:"servicePrincipalDeletionPolicy,omitempty"`
+	// AADProfile integrates the cluster with Azure Active Directory, which
+	// then authenticates the users of the Kubernetes API. Only a kubeconfig
+	// that authenticates as an Azure AD user is written to the connection
+	// secret by default, see KubeconfigCredentials.
+	// +immutable
+	// +optional
+	AADProfile *AKSClusterAADProfile `json:"aadProfile,omitempty"`
+
+	// LinuxProfile configures the administrator account of the Linux nodes
+	// of the cluster. Azure creates one with a generated SSH key if it is
+	// omitted.
+	// +immutable
+	// +optional
+	LinuxProfile *AKSClusterLinuxProfile `json:"linuxProfile,omitempty"`
+
+	// WindowsProfile configures the administrator account of the Windows
+	// nodes of the cluster. Azure creates one with a generated password if
+	// it is omitted.
+	// +immutable
+	// +optional
+	WindowsProfile *AKSClusterWindowsProfile `json:"windowsProfile,omitempty"`
+
+	// KubeconfigCredentials determines which kubeconfigs are written to the
+	// connection secret. Admin writes the kubeconfig of the cluster admin
+	// under the kubeconfig key, User writes a kubeconfig of the cluster user,
+	// who authenticates as an Azure AD user if AADProfile is set, under the
+	// userKubeconfig key, and AdminAndUser writes both. Defaults to User for
+	// clusters with an AADProfile, and to Admin otherwise.
+	// +kubebuilder:validation:Enum=Admin;User;AdminAndUser
+	// +optional
+	KubeconfigCredentials string `json:"kubeconfigCredentials,omitempty"`
+
+	// NodePools are additional pools of worker nodes, e.g. pools of a
+	// different VM size or OS type. They are added to the pool of NodeCount
+	// NodeVMSize nodes that every cluster has.
+	// +optional
+	NodePools []AKSClusterNodePool `json:"nodePools,omitempty"`
+
+	// AddonProfiles enables or disables the addons of the cluster. Addons
+	// that are omitted are left as they are.
+	// +optional
+	AddonProfiles *AKSClusterAddonProfiles `json:"addonProfiles,omitempty"`
+
+	// LogAnalyticsWorkspaceID is the resource ID of a Log Analytics
+	// workspace. When it is set the monitoring (omsagent) addon is enabled and
+	// sends the container logs and metrics of the cluster to the workspace,
+	// unless AddonProfiles disables it.
+	// +optional
+	LogAnalyticsWorkspaceID string `json:"logAnalyticsWorkspaceID,omitempty"`
+
+	// LogAnalyticsWorkspaceIDRef - A reference to a LogAnalyticsWorkspace to
+	// retrieve its resource ID
+	// +optional
+	LogAnalyticsWorkspaceIDRef *xpv1.Reference `json:"logAnalyticsWorkspaceIDRef,omitempty"`
+
+	// LogAnalyticsWorkspaceIDSelector - Select a reference to a
+	// LogAnalyticsWorkspace to retrieve its resource ID
+	// +optional
+	LogAnalyticsWorkspaceIDSelector *xpv1.Selector `json:"logAnalyticsWorkspaceIDSelector,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// ConnectionSecretFormat customizes the keys written to the connection
+	// secret of the cluster, e.g. to write only its kubeconfig and token.
+	// Connection strings do not apply to clusters and are ignored.
+	// +optional
+	ConnectionSecretFormat *apisv1alpha3.ConnectionSecretFormat `json:"connectionSecretFormat,omitempty"`
+}
+
+// An AKSClusterNodePool is an additional pool of worker nodes declared inline
+// in an AKS cluster.
+type AKSClusterNodePool struct {
+	// Name of the node pool. It must be unique within the cluster, and may
+	// not be 'agentpool', which is the name of the default node pool.
+	// +kubebuilder:validation:Pattern=`^[a-z][a-z0-9]{0,11}$`
+	Name string `json:"name"`
+
+	// VMSize is the name of the VM size of the nodes, e.g., Standard_B2s.
+	// +immutable
+	VMSize string `json:"vmSize"`
+
+	// Count is the number of nodes in the pool. Defaults to 1.
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Count *int `json:"count,omitempty"`
+
+	// OSType is the operating system of the nodes. Defaults to Linux.
+	// +kubebuilder:validation:Enum=Linux;Windows
+	// +immutable
+	// +optional
+	OSType string `json:"osType,omitempty"`
+
+	// OSDiskSizeGB is the size of the OS disk of each node of the pool, in
+	// GB. Azure chooses a size based on the VM size if it is omitted.
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=2048
+	// +immutable
+	// +optional
+	OSDiskSizeGB *int `json:"osDiskSizeGB,omitempty"`
+
+	// MaxPods is the maximum number of pods that can run on each node of
+	// the pool. Azure chooses a number based on the network plugin if it is
+	// omitted.
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=250
+	// +immutable
+	// +optional
+	MaxPods *int `json:"maxPods,omitempty"`
+
+	// ScaleSetPriority of the nodes of the pool. Spot nodes run on spare
+	// capacity at a discount, and may be evicted whenever Azure needs that
+	// capacity back. AKS taints spot nodes with
+	// kubernetes.azure.com/scalesetpriority=spot:NoSchedule. Defaults to
+	// Regular.
+	// +kubebuilder:validation:Enum=Regular;Spot
+	// +immutable
+	// +optional
+	ScaleSetPriority string `json:"scaleSetPriority,omitempty"`
+
+	// ScaleSetEvictionPolicy determines whether evicted spot nodes are
+	// deleted or deallocated. Deallocated nodes still count against the
+	// compute quota. Defaults to Delete. Requires a Spot ScaleSetPriority.
+	// +kubebuilder:validation:Enum=Delete;Deallocate
+	// +immutable
+	// +optional
+	ScaleSetEvictionPolicy string `json:"scaleSetEvictionPolicy,omitempty"`
+
+	// SpotMaxPrice is the maximum price per hour, in US dollars, paid for
+	// each spot node, e.g. 0.05. Nodes are evicted when the spot price
+	// exceeds it. Defaults to -1, which pays up to the on-demand price.
+	// Requires a Spot ScaleSetPriority.
+	// +immutable
+	// +optional
+	SpotMaxPrice *resource.Quantity `json:"spotMaxPrice,omitempty"`
+
+	// Zones - A list of availability zones to spread the nodes of the pool
+	// across. The VM size must be available in each zone of the cluster's
+	// location.
+	// +immutable
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// Taints added to the nodes of the pool, of the form
+	// key=value:NoSchedule.
+	// +optional
+	Taints []string `json:"taints,omitempty"`
+
+	// Labels added to the nodes of the pool.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// AKSClusterLoadBalancerProfile configures the outbound connections of the
+// load balancer of an AKS cluster.
+type AKSClusterLoadBalancerProfile struct {
+	// ManagedOutboundIPCount is the number of public IPs Azure creates for
+	// the outbound connections of the cluster. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	ManagedOutboundIPCount *int `json:"managedOutboundIPCount,omitempty"`
+
+	// IdleTimeoutInMinutes is how long idle outbound connections are kept
+	// open. Defaults to 30.
+	// +kubebuilder:validation:Minimum=4
+	// +kubebuilder:validation:Maximum=120
+	// +optional
+	IdleTimeoutInMinutes *int `json:"idleTimeoutInMinutes,omitempty"`
+}
+
+// AKSClusterLinuxProfile configures the administrator account of the
+// Linux nodes of an AKS cluster.
+type AKSClusterLinuxProfile struct {
+	// AdminUsername is the name of the administrator account of the nodes.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z][-A-Za-z0-9_]*$`
+	AdminUsername string `json:"adminUsername"`
+
+	// SSHPublicKeys are authorized to log in to the administrator account.
+	// +optional
+	SSHPublicKeys []string `json:"sshPublicKeys,omitempty"`
+
+	// SSHPublicKeySecretRef references an SSH public key that is authorized
+	// to log in to the administrator account, in addition to SSHPublicKeys.
+	// +optional
+	SSHPublicKeySecretRef *xpv1.SecretKeySelector `json:"sshPublicKeySecretRef,omitempty"`
+}
+
+// AKSClusterWindowsProfile configures the administrator account of the
+// Windows nodes of an AKS cluster.
+type AKSClusterWindowsProfile struct {
+	// AdminUsername is the name of the administrator account of the nodes.
+	AdminUsername string `json:"adminUsername"`
+
+	// AdminPasswordSecretRef references the password of the administrator
+	// account.
+	AdminPasswordSecretRef xpv1.SecretKeySelector `json:"adminPasswordSecretRef"`
+
+	// LicenseType is the license type of the nodes. Windows_Server enables
+	// Azure Hybrid User Benefits.
+	// +kubebuilder:validation:Enum=None;Windows_Server
+	// +optional
+	LicenseType string `json:"licenseType,omitempty"`
+}
+
+// AKSClusterAADProfile configures the Azure Active Directory integration of
+// an AKS cluster.
+type AKSClusterAADProfile struct {
+	// Managed enables the AKS-managed Azure AD integration, which requires
+	// no Azure AD applications. ServerAppID, ServerAppSecretSecretRef and
+	// ClientAppID are required otherwise.
+	// +optional
+	Managed bool `json:"managed,omitempty"`
+
+	// AdminGroupObjectIDs are the object IDs of the Azure AD groups whose
+	// members are admins of the cluster. Only used when Managed is true.
+	// +optional
+	AdminGroupObjectIDs []string `json:"adminGroupObjectIDs,omitempty"`
+
+	// ServerAppID is the ID of the Azure AD application that authenticates
+	// users to the Kubernetes API server.
+	// +optional
+	ServerAppID string `json:"serverAppID,omitempty"`
+
+	// ServerAppSecretSecretRef references the secret key that holds the
+	// secret of the Azure AD server application.
+	// +optional
+	ServerAppSecretSecretRef *xpv1.SecretKeySelector `json:"serverAppSecretSecretRef,omitempty"`
+
+	// ClientAppID is the ID of the Azure AD application that users
+	// authenticate with, e.g. through kubectl.
+	// +optional
+	ClientAppID string `json:"clientAppID,omitempty"`
+
+	// TenantID is the ID of the Azure AD tenant that users authenticate
+	// against. Defaults to the tenant of the cluster's subscription.
+	// +optional
+	TenantID string `json:"tenantID,omitempty"`
+}
+
+// Kubeconfigs an AKS cluster may write to its connection secret.
+const (
+	KubeconfigCredentialsAdmin        = "Admin"
+	KubeconfigCredentialsUser         = "User"
+	KubeconfigCredentialsAdminAndUser = "AdminAndUser"
+)
+
+// Managed identity types supported by an AKS cluster.
+const (
+	AKSClusterIdentityTypeSystemAssigned = "SystemAssigned"
+	AKSClusterIdentityTypeUserAssigned   = "UserAssigned"
+)
+
+// AKSClusterAddonProfiles configures the addons of an AKS cluster.
+type AKSClusterAddonProfiles struct {
+	// HTTPApplicationRouting configures the HTTP application routing addon,
+	// which exposes applications through an ingress controller and a DNS
+	// zone created for the cluster. It is not meant for production use.
+	// +optional
+	HTTPApplicationRouting *AKSClusterAddonProfile `json:"httpApplicationRouting,omitempty"`
+
+	// Monitoring configures the monitoring (omsagent) addon. Enabling it
+	// requires LogAnalyticsWorkspaceID, which enables it by default.
+	// +optional
+	Monitoring *AKSClusterAddonProfile `json:"monitoring,omitempty"`
+
+	// AzurePolicy configures the Azure Policy addon, which enforces Azure
+	// Policy assignments within the cluster.
+	// +optional
+	AzurePolicy *AKSClusterAddonProfile `json:"azurePolicy,omitempty"`
+
+	// KubeDashboard configures the Kubernetes dashboard addon. It is not
+	// available to clusters that run Kubernetes 1.19 or later.
+	// +optional
+	KubeDashboard *AKSClusterAddonProfile `json:"kubeDashboard,omitempty"`
+}
+
+// AKSClusterAddonProfile configures an addon of an AKS cluster.
+type AKSClusterAddonProfile struct {
+	// Enabled is whether the addon is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// AKSClusterIdentity is the managed identity used by an AKS cluster.
+type AKSClusterIdentity struct {
+	// Type of the managed identity.
+	// +kubebuilder:validation:Enum=SystemAssigned;UserAssigned
+	Type string `json:"type"`
+
+	// UserAssignedIdentityID is the resource ID of the user-assigned managed
+	// identity, of the form /subscriptions/{subscriptionId}/resourceGroups/
+	// {resourceGroupName}/providers/Microsoft.ManagedIdentity/
+	// userAssignedIdentities/{identityName}. It is required when Type is
+	// UserAssigned.
+	// +optional
+	UserAssignedIdentityID string `json:"userAssignedIdentityID,omitempty"`
 }
 
 // An AKSClusterSpec defines the desired state of a AKSCluster.
@@ -102,6 +575,26 @@ type AKSClusterStatus struct {
 
 	// Endpoint is the endpoint where the cluster can be reached
 	Endpoint string `json:"endpoint,omitempty"`
+	// LastOperation represents the state of the last operation started by
+	// the controller, e.g. a scale or an upgrade of the cluster.
+	LastOperation apisv1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+
+	// ServicePrincipalSecretExpiry is when the secret of the cluster's
+	// service principal expires. The controller rotates the secret shortly
+	// before then.
+	ServicePrincipalSecretExpiry *metav1.Time `json:"servicePrincipalSecretExpiry,omitempty"`
+
+	// ApplicationObjectID is the object ID of the Azure AD application that
+	// the controller created for the cluster.
+	ApplicationObjectID string `json:"applicationObjectID,omitempty"`
+
+	// ApplicationID is the application (client) ID of the Azure AD
+	// application that the controller created for the cluster.
+	ApplicationID string `json:"applicationID,omitempty"`
+
+	// ServicePrincipalObjectID is the object ID of the service principal
+	// that the controller created for the cluster.
+	ServicePrincipalObjectID string `json:"servicePrincipalObjectID,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -115,6 +608,7 @@ type AKSClusterStatus struct {
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 type AKSCluster struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -131,3 +625,605 @@ type AKSClusterList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []AKSCluster `json:"items"`
 }
+
+// AKSNodePoolParameters define the desired state of a pool of worker nodes of
+// an Azure Kubernetes Engine cluster.
+type AKSNodePoolParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName is the name of the resource group of the cluster.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup to retrieve its
+	// name
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to a ResourceGroup to
+	// retrieve its name
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// ClusterName is the name of the cluster the node pool belongs to.
+	// +immutable
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef - A reference to an AKSCluster to retrieve its name
+	ClusterNameRef *xpv1.Reference `json:"clusterNameRef,omitempty"`
+
+	// ClusterNameSelector - Select a reference to an AKSCluster to retrieve
+	// its name
+	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// VMSize is the name of the VM size of the nodes, e.g., Standard_B2s.
+	// +immutable
+	VMSize string `json:"vmSize"`
+
+	// Count is the number of nodes in the pool. Defaults to 1.
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Count *int `json:"count,omitempty"`
+
+	// OSType is the operating system of the nodes. Defaults to Linux.
+	// +kubebuilder:validation:Enum=Linux;Windows
+	// +immutable
+	// +optional
+	OSType string `json:"osType,omitempty"`
+
+	// Version is the Kubernetes version of the nodes. It must not be newer
+	// than the version of the cluster, which is used if it is omitted.
+	// Changing it upgrades the node pool in place.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// VnetSubnetID is the subnet to which the nodes will be deployed. It
+	// must be in the virtual network of the cluster.
+	// +immutable
+	// +optional
+	VnetSubnetID string `json:"vnetSubnetID,omitempty"`
+
+	// Zones - A list of availability zones to spread the nodes across.
+	// +immutable
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// Taints added to the nodes of the pool, of the form
+	// key=value:NoSchedule.
+	// +optional
+	Taints []string `json:"taints,omitempty"`
+
+	// Labels added to the nodes of the pool.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An AKSNodePoolSpec defines the desired state of an AKSNodePool.
+type AKSNodePoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AKSNodePoolParameters `json:"forProvider"`
+}
+
+// AKSNodePoolObservation represents the observed state of an AKSNodePool.
+type AKSNodePoolObservation struct {
+	// ID is the resource ID of the node pool.
+	ID string `json:"id,omitempty"`
+
+	// ProvisioningState is the current state of the node pool.
+	ProvisioningState string `json:"provisioningState,omitempty"`
+
+	// Version is the Kubernetes version of the nodes.
+	Version string `json:"version,omitempty"`
+
+	// LastOperation represents the state of the last operation started by
+	// the controller, e.g. a scale or an upgrade of the node pool.
+	LastOperation apisv1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+}
+
+// An AKSNodePoolStatus represents the observed state of an AKSNodePool.
+type AKSNodePoolStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AKSNodePoolObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AKSNodePool is a managed resource that represents a pool of worker nodes
+// of an Azure Kubernetes Engine cluster. Its external name is the name of the
+// node pool, which must start with a lowercase letter and consist of at most
+// 12 lowercase letters and digits.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.provisioningState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+// +kubebuilder:subresource:status
+type AKSNodePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AKSNodePoolSpec   `json:"spec"`
+	Status AKSNodePoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AKSNodePoolList contains a list of AKSNodePool.
+type AKSNodePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AKSNodePool `json:"items"`
+}
+
+// VirtualMachineImageReference specifies the image a virtual machine is
+// created from. Either an ID or a publisher, offer, SKU and version must be
+// specified.
+type VirtualMachineImageReference struct {
+	// ID is the resource ID of a custom or shared gallery image.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Publisher of the marketplace image, e.g. Canonical.
+	// +optional
+	Publisher *string `json:"publisher,omitempty"`
+
+	// Offer of the marketplace image, e.g. 0001-com-ubuntu-server-focal.
+	// +optional
+	Offer *string `json:"offer,omitempty"`
+
+	// SKU of the marketplace image, e.g. 20_04-lts.
+	// +optional
+	SKU *string `json:"sku,omitempty"`
+
+	// Version of the marketplace image, e.g. latest.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// VirtualMachineOSDisk specifies the managed operating system disk of a
+// virtual machine.
+type VirtualMachineOSDisk struct {
+	// Caching of the disk. Defaults to ReadWrite.
+	// +kubebuilder:validation:Enum=None;ReadOnly;ReadWrite
+	// +optional
+	Caching *string `json:"caching,omitempty"`
+
+	// StorageAccountType of the disk, e.g. Premium_LRS.
+	// +kubebuilder:validation:Enum=Standard_LRS;Premium_LRS;StandardSSD_LRS;UltraSSD_LRS;Premium_ZRS;StandardSSD_ZRS
+	// +optional
+	StorageAccountType *string `json:"storageAccountType,omitempty"`
+
+	// DiskSizeGB is the size of the disk in gigabytes. Defaults to the size
+	// of the image.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	DiskSizeGB *int32 `json:"diskSizeGB,omitempty"`
+}
+
+// VirtualMachineDataDisk attaches a managed disk to a virtual machine.
+type VirtualMachineDataDisk struct {
+	// LUN is the logical unit number of the disk, which must be unique
+	// within the virtual machine.
+	// +kubebuilder:validation:Minimum=0
+	LUN int32 `json:"lun"`
+
+	// DiskID is the resource ID of the managed disk.
+	// +optional
+	DiskID string `json:"diskID,omitempty"`
+
+	// DiskIDRef - A reference to a Disk to retrieve its ID
+	// +optional
+	DiskIDRef *xpv1.Reference `json:"diskIDRef,omitempty"`
+
+	// DiskIDSelector - Select a reference to a Disk to retrieve its ID
+	// +optional
+	DiskIDSelector *xpv1.Selector `json:"diskIDSelector,omitempty"`
+
+	// Caching of the disk. Defaults to None.
+	// +kubebuilder:validation:Enum=None;ReadOnly;ReadWrite
+	// +optional
+	Caching *string `json:"caching,omitempty"`
+}
+
+// VirtualMachineNetworkInterface references a network interface that is
+// attached to a virtual machine.
+type VirtualMachineNetworkInterface struct {
+	// ID is the resource ID of the network interface.
+	ID string `json:"id"`
+
+	// Primary specifies whether this is the primary network interface. One
+	// must be primary if a virtual machine has more than one.
+	// +optional
+	Primary *bool `json:"primary,omitempty"`
+}
+
+// VirtualMachineOSProfile specifies the operating system settings of a
+// virtual machine.
+type VirtualMachineOSProfile struct {
+	// ComputerName is the host name of the virtual machine. Defaults to the
+	// external name of the virtual machine.
+	// +optional
+	ComputerName *string `json:"computerName,omitempty"`
+
+	// AdminUsername is the name of the administrator account.
+	AdminUsername string `json:"adminUsername"`
+
+	// AdminPasswordSecretRef references the password of the administrator
+	// account. A password is generated if it is omitted, unless password
+	// authentication is disabled.
+	// +optional
+	AdminPasswordSecretRef *xpv1.SecretKeySelector `json:"adminPasswordSecretRef,omitempty"`
+
+	// CustomData is passed to the virtual machine when it is created, e.g. a
+	// cloud-init configuration. It is base64 encoded by the provider.
+	// +optional
+	CustomData *string `json:"customData,omitempty"`
+
+	// SSHPublicKeys are authorized to log in to the administrator account of
+	// a Linux virtual machine.
+	// +optional
+	SSHPublicKeys []string `json:"sshPublicKeys,omitempty"`
+
+	// DisablePasswordAuthentication disables password authentication to a
+	// Linux virtual machine, which then requires SSH public keys.
+	// +optional
+	DisablePasswordAuthentication *bool `json:"disablePasswordAuthentication,omitempty"`
+}
+
+// VirtualMachineParameters define the desired state of an Azure virtual
+// machine.
+type VirtualMachineParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName is the name of the resource group of the virtual
+	// machine.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup to retrieve its
+	// name
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to a ResourceGroup to
+	// retrieve its name
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location is the Azure location of the virtual machine.
+	// +immutable
+	Location string `json:"location"`
+
+	// VMSize is the name of the VM size of the virtual machine, e.g.,
+	// Standard_B2s. Changing it resizes the virtual machine, which restarts
+	// it.
+	VMSize string `json:"vmSize"`
+
+	// Zones - The availability zone of the virtual machine.
+	// +immutable
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// ImageReference is the image the virtual machine is created from.
+	// +immutable
+	ImageReference VirtualMachineImageReference `json:"imageReference"`
+
+	// OSDisk is the managed operating system disk of the virtual machine.
+	// +immutable
+	// +optional
+	OSDisk *VirtualMachineOSDisk `json:"osDisk,omitempty"`
+
+	// DataDisks are managed disks attached to the virtual machine. Disks
+	// are attached and detached when they are added to or removed from the
+	// list. They are not deleted along with the virtual machine.
+	// +optional
+	DataDisks []VirtualMachineDataDisk `json:"dataDisks,omitempty"`
+
+	// NetworkInterfaces attached to the virtual machine.
+	// +kubebuilder:validation:MinItems=1
+	// +immutable
+	NetworkInterfaces []VirtualMachineNetworkInterface `json:"networkInterfaces"`
+
+	// OSProfile specifies the operating system settings of the virtual
+	// machine.
+	// +immutable
+	OSProfile VirtualMachineOSProfile `json:"osProfile"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A VirtualMachineSpec defines the desired state of a VirtualMachine.
+type VirtualMachineSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VirtualMachineParameters `json:"forProvider"`
+}
+
+// VirtualMachineObservation represents the observed state of a
+// VirtualMachine.
+type VirtualMachineObservation struct {
+	// ID is the resource ID of the virtual machine.
+	ID string `json:"id,omitempty"`
+
+	// VMID is the unique ID of the virtual machine that Azure assigned.
+	VMID string `json:"vmID,omitempty"`
+
+	// ProvisioningState is the current state of the virtual machine.
+	ProvisioningState string `json:"provisioningState,omitempty"`
+
+	// LastOperation represents the state of the last operation started by
+	// the controller.
+	LastOperation apisv1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+}
+
+// A VirtualMachineStatus represents the observed state of a VirtualMachine.
+type VirtualMachineStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VirtualMachineObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VirtualMachine is a managed resource that represents an Azure virtual
+// machine. Its external name is the name of the virtual machine. Its
+// connection secret holds the name and password of its administrator
+// account.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SIZE",type="string",JSONPath=".spec.forProvider.vmSize"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.provisioningState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+// +kubebuilder:subresource:status
+type VirtualMachine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineSpec   `json:"spec"`
+	Status VirtualMachineStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineList contains a list of VirtualMachine.
+type VirtualMachineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachine `json:"items"`
+}
+
+// DiskParameters define the desired state of an Azure managed disk.
+type DiskParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName is the name of the resource group of the disk.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup to retrieve its
+	// name
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to a ResourceGroup to
+	// retrieve its name
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location is the Azure location of the disk.
+	// +immutable
+	Location string `json:"location"`
+
+	// Zones - The availability zone of the disk.
+	// +immutable
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// SKU of the disk. Defaults to Standard_LRS.
+	// +kubebuilder:validation:Enum=Standard_LRS;Premium_LRS;StandardSSD_LRS;UltraSSD_LRS;Premium_ZRS;StandardSSD_ZRS
+	// +optional
+	SKU *string `json:"sku,omitempty"`
+
+	// DiskSizeGB is the size of the disk in gigabytes. It is required unless
+	// the disk is created from a snapshot, and can only be increased.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	DiskSizeGB *int32 `json:"diskSizeGB,omitempty"`
+
+	// SourceSnapshotID is the resource ID of a snapshot the disk is created
+	// from. An empty disk is created if it is omitted.
+	// +immutable
+	// +optional
+	SourceSnapshotID *string `json:"sourceSnapshotID,omitempty"`
+
+	// SourceSnapshotIDRef - A reference to a Snapshot to retrieve its ID
+	// +optional
+	SourceSnapshotIDRef *xpv1.Reference `json:"sourceSnapshotIDRef,omitempty"`
+
+	// SourceSnapshotIDSelector - Select a reference to a Snapshot to
+	// retrieve its ID
+	// +optional
+	SourceSnapshotIDSelector *xpv1.Selector `json:"sourceSnapshotIDSelector,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A DiskSpec defines the desired state of a Disk.
+type DiskSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DiskParameters `json:"forProvider"`
+}
+
+// DiskObservation represents the observed state of a Disk.
+type DiskObservation struct {
+	// ID is the resource ID of the disk.
+	ID string `json:"id,omitempty"`
+
+	// UniqueID is the unique ID of the disk that Azure assigned.
+	UniqueID string `json:"uniqueID,omitempty"`
+
+	// DiskState is the attachment state of the disk, e.g. Attached.
+	DiskState string `json:"diskState,omitempty"`
+
+	// ProvisioningState is the current state of the disk.
+	ProvisioningState string `json:"provisioningState,omitempty"`
+}
+
+// A DiskStatus represents the observed state of a Disk.
+type DiskStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DiskObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Disk is a managed resource that represents an Azure managed disk. Its
+// external name is the name of the disk.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SIZE",type="integer",JSONPath=".spec.forProvider.diskSizeGB"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.diskState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+// +kubebuilder:subresource:status
+type Disk struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DiskSpec   `json:"spec"`
+	Status DiskStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DiskList contains a list of Disk.
+type DiskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Disk `json:"items"`
+}
+
+// SnapshotParameters define the desired state of an Azure snapshot of a
+// managed disk.
+type SnapshotParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName is the name of the resource group of the snapshot.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup to retrieve its
+	// name
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to a ResourceGroup to
+	// retrieve its name
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location is the Azure location of the snapshot.
+	// +immutable
+	Location string `json:"location"`
+
+	// SKU of the snapshot. Defaults to Standard_LRS.
+	// +kubebuilder:validation:Enum=Standard_LRS;Premium_LRS;Standard_ZRS
+	// +immutable
+	// +optional
+	SKU *string `json:"sku,omitempty"`
+
+	// SourceDiskID is the resource ID of the managed disk the snapshot is
+	// taken of.
+	// +immutable
+	// +optional
+	SourceDiskID *string `json:"sourceDiskID,omitempty"`
+
+	// SourceDiskIDRef - A reference to a Disk to retrieve its ID
+	// +optional
+	SourceDiskIDRef *xpv1.Reference `json:"sourceDiskIDRef,omitempty"`
+
+	// SourceDiskIDSelector - Select a reference to a Disk to retrieve its ID
+	// +optional
+	SourceDiskIDSelector *xpv1.Selector `json:"sourceDiskIDSelector,omitempty"`
+
+	// Incremental snapshots only store the changes since the previous
+	// snapshot of the same disk.
+	// +immutable
+	// +optional
+	Incremental *bool `json:"incremental,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A SnapshotSpec defines the desired state of a Snapshot.
+type SnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SnapshotParameters `json:"forProvider"`
+}
+
+// SnapshotObservation represents the observed state of a Snapshot.
+type SnapshotObservation struct {
+	// ID is the resource ID of the snapshot.
+	ID string `json:"id,omitempty"`
+
+	// UniqueID is the unique ID of the snapshot that Azure assigned.
+	UniqueID string `json:"uniqueID,omitempty"`
+
+	// DiskSizeGB is the size of the snapshotted disk in gigabytes.
+	DiskSizeGB *int32 `json:"diskSizeGB,omitempty"`
+
+	// ProvisioningState is the current state of the snapshot.
+	ProvisioningState string `json:"provisioningState,omitempty"`
+}
+
+// A SnapshotStatus represents the observed state of a Snapshot.
+type SnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Snapshot is a managed resource that represents an Azure snapshot of a
+// managed disk. Its external name is the name of the snapshot.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.provisioningState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+// +kubebuilder:subresource:status
+type Snapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotSpec   `json:"spec"`
+	Status SnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnapshotList contains a list of Snapshot.
+type SnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Snapshot `json:"items"`
+}
@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha3
 
 import (
+	apisv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -53,6 +54,121 @@ func (in *AKSCluster) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSClusterAADProfile) DeepCopyInto(out *AKSClusterAADProfile) {
+	*out = *in
+	if in.AdminGroupObjectIDs != nil {
+		in, out := &in.AdminGroupObjectIDs, &out.AdminGroupObjectIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServerAppSecretSecretRef != nil {
+		in, out := &in.ServerAppSecretSecretRef, &out.ServerAppSecretSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterAADProfile.
+func (in *AKSClusterAADProfile) DeepCopy() *AKSClusterAADProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSClusterAADProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSClusterAddonProfile) DeepCopyInto(out *AKSClusterAddonProfile) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterAddonProfile.
+func (in *AKSClusterAddonProfile) DeepCopy() *AKSClusterAddonProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSClusterAddonProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSClusterAddonProfiles) DeepCopyInto(out *AKSClusterAddonProfiles) {
+	*out = *in
+	if in.HTTPApplicationRouting != nil {
+		in, out := &in.HTTPApplicationRouting, &out.HTTPApplicationRouting
+		*out = new(AKSClusterAddonProfile)
+		**out = **in
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(AKSClusterAddonProfile)
+		**out = **in
+	}
+	if in.AzurePolicy != nil {
+		in, out := &in.AzurePolicy, &out.AzurePolicy
+		*out = new(AKSClusterAddonProfile)
+		**out = **in
+	}
+	if in.KubeDashboard != nil {
+		in, out := &in.KubeDashboard, &out.KubeDashboard
+		*out = new(AKSClusterAddonProfile)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterAddonProfiles.
+func (in *AKSClusterAddonProfiles) DeepCopy() *AKSClusterAddonProfiles {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSClusterAddonProfiles)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSClusterIdentity) DeepCopyInto(out *AKSClusterIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterIdentity.
+func (in *AKSClusterIdentity) DeepCopy() *AKSClusterIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSClusterIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSClusterLinuxProfile) DeepCopyInto(out *AKSClusterLinuxProfile) {
+	*out = *in
+	if in.SSHPublicKeys != nil {
+		in, out := &in.SSHPublicKeys, &out.SSHPublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SSHPublicKeySecretRef != nil {
+		in, out := &in.SSHPublicKeySecretRef, &out.SSHPublicKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterLinuxProfile.
+func (in *AKSClusterLinuxProfile) DeepCopy() *AKSClusterLinuxProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSClusterLinuxProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AKSClusterList) DeepCopyInto(out *AKSClusterList) {
 	*out = *in
@@ -85,9 +201,91 @@ func (in *AKSClusterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSClusterLoadBalancerProfile) DeepCopyInto(out *AKSClusterLoadBalancerProfile) {
+	*out = *in
+	if in.ManagedOutboundIPCount != nil {
+		in, out := &in.ManagedOutboundIPCount, &out.ManagedOutboundIPCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.IdleTimeoutInMinutes != nil {
+		in, out := &in.IdleTimeoutInMinutes, &out.IdleTimeoutInMinutes
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterLoadBalancerProfile.
+func (in *AKSClusterLoadBalancerProfile) DeepCopy() *AKSClusterLoadBalancerProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSClusterLoadBalancerProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSClusterNodePool) DeepCopyInto(out *AKSClusterNodePool) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int)
+		**out = **in
+	}
+	if in.OSDiskSizeGB != nil {
+		in, out := &in.OSDiskSizeGB, &out.OSDiskSizeGB
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxPods != nil {
+		in, out := &in.MaxPods, &out.MaxPods
+		*out = new(int)
+		**out = **in
+	}
+	if in.SpotMaxPrice != nil {
+		in, out := &in.SpotMaxPrice, &out.SpotMaxPrice
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterNodePool.
+func (in *AKSClusterNodePool) DeepCopy() *AKSClusterNodePool {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSClusterNodePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AKSClusterParameters) DeepCopyInto(out *AKSClusterParameters) {
 	*out = *in
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
 	if in.ResourceGroupNameRef != nil {
 		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
 		*out = new(v1.Reference)
@@ -113,6 +311,107 @@ func (in *AKSClusterParameters) DeepCopyInto(out *AKSClusterParameters) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.MinCount != nil {
+		in, out := &in.MinCount, &out.MinCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxCount != nil {
+		in, out := &in.MaxCount, &out.MaxCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeOSDiskSizeGB != nil {
+		in, out := &in.NodeOSDiskSizeGB, &out.NodeOSDiskSizeGB
+		*out = new(int)
+		**out = **in
+	}
+	if in.NodeMaxPods != nil {
+		in, out := &in.NodeMaxPods, &out.NodeMaxPods
+		*out = new(int)
+		**out = **in
+	}
+	if in.NodeTaints != nil {
+		in, out := &in.NodeTaints, &out.NodeTaints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.APIServerAuthorizedIPRanges != nil {
+		in, out := &in.APIServerAuthorizedIPRanges, &out.APIServerAuthorizedIPRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoadBalancerProfile != nil {
+		in, out := &in.LoadBalancerProfile, &out.LoadBalancerProfile
+		*out = new(AKSClusterLoadBalancerProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = new(AKSClusterIdentity)
+		**out = **in
+	}
+	if in.AADProfile != nil {
+		in, out := &in.AADProfile, &out.AADProfile
+		*out = new(AKSClusterAADProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LinuxProfile != nil {
+		in, out := &in.LinuxProfile, &out.LinuxProfile
+		*out = new(AKSClusterLinuxProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WindowsProfile != nil {
+		in, out := &in.WindowsProfile, &out.WindowsProfile
+		*out = new(AKSClusterWindowsProfile)
+		**out = **in
+	}
+	if in.NodePools != nil {
+		in, out := &in.NodePools, &out.NodePools
+		*out = make([]AKSClusterNodePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AddonProfiles != nil {
+		in, out := &in.AddonProfiles, &out.AddonProfiles
+		*out = new(AKSClusterAddonProfiles)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogAnalyticsWorkspaceIDRef != nil {
+		in, out := &in.LogAnalyticsWorkspaceIDRef, &out.LogAnalyticsWorkspaceIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.LogAnalyticsWorkspaceIDSelector != nil {
+		in, out := &in.LogAnalyticsWorkspaceIDSelector, &out.LogAnalyticsWorkspaceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ConnectionSecretFormat != nil {
+		in, out := &in.ConnectionSecretFormat, &out.ConnectionSecretFormat
+		*out = new(apisv1alpha3.ConnectionSecretFormat)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterParameters.
@@ -146,6 +445,11 @@ func (in *AKSClusterSpec) DeepCopy() *AKSClusterSpec {
 func (in *AKSClusterStatus) DeepCopyInto(out *AKSClusterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.LastOperation = in.LastOperation
+	if in.ServicePrincipalSecretExpiry != nil {
+		in, out := &in.ServicePrincipalSecretExpiry, &out.ServicePrincipalSecretExpiry
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterStatus.
@@ -157,3 +461,879 @@ func (in *AKSClusterStatus) DeepCopy() *AKSClusterStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSClusterWindowsProfile) DeepCopyInto(out *AKSClusterWindowsProfile) {
+	*out = *in
+	out.AdminPasswordSecretRef = in.AdminPasswordSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterWindowsProfile.
+func (in *AKSClusterWindowsProfile) DeepCopy() *AKSClusterWindowsProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSClusterWindowsProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSNodePool) DeepCopyInto(out *AKSNodePool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSNodePool.
+func (in *AKSNodePool) DeepCopy() *AKSNodePool {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSNodePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AKSNodePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSNodePoolList) DeepCopyInto(out *AKSNodePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AKSNodePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSNodePoolList.
+func (in *AKSNodePoolList) DeepCopy() *AKSNodePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSNodePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AKSNodePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSNodePoolObservation) DeepCopyInto(out *AKSNodePoolObservation) {
+	*out = *in
+	out.LastOperation = in.LastOperation
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSNodePoolObservation.
+func (in *AKSNodePoolObservation) DeepCopy() *AKSNodePoolObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSNodePoolObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSNodePoolParameters) DeepCopyInto(out *AKSNodePoolParameters) {
+	*out = *in
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterNameRef != nil {
+		in, out := &in.ClusterNameRef, &out.ClusterNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ClusterNameSelector != nil {
+		in, out := &in.ClusterNameSelector, &out.ClusterNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int)
+		**out = **in
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSNodePoolParameters.
+func (in *AKSNodePoolParameters) DeepCopy() *AKSNodePoolParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSNodePoolParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSNodePoolSpec) DeepCopyInto(out *AKSNodePoolSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSNodePoolSpec.
+func (in *AKSNodePoolSpec) DeepCopy() *AKSNodePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSNodePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSNodePoolStatus) DeepCopyInto(out *AKSNodePoolStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSNodePoolStatus.
+func (in *AKSNodePoolStatus) DeepCopy() *AKSNodePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSNodePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Disk) DeepCopyInto(out *Disk) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Disk.
+func (in *Disk) DeepCopy() *Disk {
+	if in == nil {
+		return nil
+	}
+	out := new(Disk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Disk) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskList) DeepCopyInto(out *DiskList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Disk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskList.
+func (in *DiskList) DeepCopy() *DiskList {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DiskList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskObservation) DeepCopyInto(out *DiskObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskObservation.
+func (in *DiskObservation) DeepCopy() *DiskObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskParameters) DeepCopyInto(out *DiskParameters) {
+	*out = *in
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SKU != nil {
+		in, out := &in.SKU, &out.SKU
+		*out = new(string)
+		**out = **in
+	}
+	if in.DiskSizeGB != nil {
+		in, out := &in.DiskSizeGB, &out.DiskSizeGB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SourceSnapshotID != nil {
+		in, out := &in.SourceSnapshotID, &out.SourceSnapshotID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceSnapshotIDRef != nil {
+		in, out := &in.SourceSnapshotIDRef, &out.SourceSnapshotIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SourceSnapshotIDSelector != nil {
+		in, out := &in.SourceSnapshotIDSelector, &out.SourceSnapshotIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskParameters.
+func (in *DiskParameters) DeepCopy() *DiskParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskSpec) DeepCopyInto(out *DiskSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSpec.
+func (in *DiskSpec) DeepCopy() *DiskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskStatus) DeepCopyInto(out *DiskStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskStatus.
+func (in *DiskStatus) DeepCopy() *DiskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Snapshot) DeepCopyInto(out *Snapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Snapshot.
+func (in *Snapshot) DeepCopy() *Snapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(Snapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Snapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotList) DeepCopyInto(out *SnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Snapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotList.
+func (in *SnapshotList) DeepCopy() *SnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotObservation) DeepCopyInto(out *SnapshotObservation) {
+	*out = *in
+	if in.DiskSizeGB != nil {
+		in, out := &in.DiskSizeGB, &out.DiskSizeGB
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotObservation.
+func (in *SnapshotObservation) DeepCopy() *SnapshotObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotParameters) DeepCopyInto(out *SnapshotParameters) {
+	*out = *in
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SKU != nil {
+		in, out := &in.SKU, &out.SKU
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceDiskID != nil {
+		in, out := &in.SourceDiskID, &out.SourceDiskID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceDiskIDRef != nil {
+		in, out := &in.SourceDiskIDRef, &out.SourceDiskIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SourceDiskIDSelector != nil {
+		in, out := &in.SourceDiskIDSelector, &out.SourceDiskIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Incremental != nil {
+		in, out := &in.Incremental, &out.Incremental
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotParameters.
+func (in *SnapshotParameters) DeepCopy() *SnapshotParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotSpec) DeepCopyInto(out *SnapshotSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSpec.
+func (in *SnapshotSpec) DeepCopy() *SnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotStatus) DeepCopyInto(out *SnapshotStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotStatus.
+func (in *SnapshotStatus) DeepCopy() *SnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachine.
+func (in *VirtualMachine) DeepCopy() *VirtualMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDataDisk) DeepCopyInto(out *VirtualMachineDataDisk) {
+	*out = *in
+	if in.DiskIDRef != nil {
+		in, out := &in.DiskIDRef, &out.DiskIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DiskIDSelector != nil {
+		in, out := &in.DiskIDSelector, &out.DiskIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Caching != nil {
+		in, out := &in.Caching, &out.Caching
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDataDisk.
+func (in *VirtualMachineDataDisk) DeepCopy() *VirtualMachineDataDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDataDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageReference) DeepCopyInto(out *VirtualMachineImageReference) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Publisher != nil {
+		in, out := &in.Publisher, &out.Publisher
+		*out = new(string)
+		**out = **in
+	}
+	if in.Offer != nil {
+		in, out := &in.Offer, &out.Offer
+		*out = new(string)
+		**out = **in
+	}
+	if in.SKU != nil {
+		in, out := &in.SKU, &out.SKU
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageReference.
+func (in *VirtualMachineImageReference) DeepCopy() *VirtualMachineImageReference {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineList) DeepCopyInto(out *VirtualMachineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineList.
+func (in *VirtualMachineList) DeepCopy() *VirtualMachineList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkInterface) DeepCopyInto(out *VirtualMachineNetworkInterface) {
+	*out = *in
+	if in.Primary != nil {
+		in, out := &in.Primary, &out.Primary
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkInterface.
+func (in *VirtualMachineNetworkInterface) DeepCopy() *VirtualMachineNetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineOSDisk) DeepCopyInto(out *VirtualMachineOSDisk) {
+	*out = *in
+	if in.Caching != nil {
+		in, out := &in.Caching, &out.Caching
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAccountType != nil {
+		in, out := &in.StorageAccountType, &out.StorageAccountType
+		*out = new(string)
+		**out = **in
+	}
+	if in.DiskSizeGB != nil {
+		in, out := &in.DiskSizeGB, &out.DiskSizeGB
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineOSDisk.
+func (in *VirtualMachineOSDisk) DeepCopy() *VirtualMachineOSDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineOSDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineOSProfile) DeepCopyInto(out *VirtualMachineOSProfile) {
+	*out = *in
+	if in.ComputerName != nil {
+		in, out := &in.ComputerName, &out.ComputerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdminPasswordSecretRef != nil {
+		in, out := &in.AdminPasswordSecretRef, &out.AdminPasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.CustomData != nil {
+		in, out := &in.CustomData, &out.CustomData
+		*out = new(string)
+		**out = **in
+	}
+	if in.SSHPublicKeys != nil {
+		in, out := &in.SSHPublicKeys, &out.SSHPublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DisablePasswordAuthentication != nil {
+		in, out := &in.DisablePasswordAuthentication, &out.DisablePasswordAuthentication
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineOSProfile.
+func (in *VirtualMachineOSProfile) DeepCopy() *VirtualMachineOSProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineOSProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineObservation) DeepCopyInto(out *VirtualMachineObservation) {
+	*out = *in
+	out.LastOperation = in.LastOperation
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineObservation.
+func (in *VirtualMachineObservation) DeepCopy() *VirtualMachineObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineParameters) DeepCopyInto(out *VirtualMachineParameters) {
+	*out = *in
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.ImageReference.DeepCopyInto(&out.ImageReference)
+	if in.OSDisk != nil {
+		in, out := &in.OSDisk, &out.OSDisk
+		*out = new(VirtualMachineOSDisk)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataDisks != nil {
+		in, out := &in.DataDisks, &out.DataDisks
+		*out = make([]VirtualMachineDataDisk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]VirtualMachineNetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.OSProfile.DeepCopyInto(&out.OSProfile)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineParameters.
+func (in *VirtualMachineParameters) DeepCopy() *VirtualMachineParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSpec.
+func (in *VirtualMachineSpec) DeepCopy() *VirtualMachineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStatus.
+func (in *VirtualMachineStatus) DeepCopy() *VirtualMachineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+)
+
+// ConvertTo converts this AKSCluster to the v1alpha3 hub version.
+func (in *AKSCluster) ConvertTo(hub conversion.Hub) error {
+	out := hub.(*v1alpha3.AKSCluster)
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec.ResourceSpec = in.Spec.ResourceSpec
+
+	p, pool, np := in.Spec.ForProvider, in.Spec.ForProvider.DefaultAgentPoolProfile, in.Spec.ForProvider.NetworkProfile
+	out.Spec.AKSClusterParameters = v1alpha3.AKSClusterParameters{
+		SubscriptionID:                  p.SubscriptionID,
+		ResourceGroupName:               p.ResourceGroupName,
+		ResourceGroupNameRef:            p.ResourceGroupNameRef,
+		ResourceGroupNameSelector:       p.ResourceGroupNameSelector,
+		Location:                        p.Location,
+		Version:                         p.Version,
+		VnetSubnetID:                    np.VnetSubnetID,
+		VnetSubnetIDRef:                 np.VnetSubnetIDRef,
+		VnetSubnetIDSelector:            np.VnetSubnetIDSelector,
+		NodeCount:                       pool.Count,
+		EnableAutoScaling:               pool.EnableAutoScaling,
+		MinCount:                        pool.MinCount,
+		MaxCount:                        pool.MaxCount,
+		NodeVMSize:                      pool.VMSize,
+		Zones:                           pool.Zones,
+		AgentPoolType:                   pool.Type,
+		NodeOSDiskSizeGB:                pool.OSDiskSizeGB,
+		NodeMaxPods:                     pool.MaxPods,
+		NodeTaints:                      pool.Taints,
+		NodeLabels:                      pool.Labels,
+		PrivateCluster:                  np.PrivateCluster,
+		APIServerAuthorizedIPRanges:     np.APIServerAuthorizedIPRanges,
+		NetworkPlugin:                   np.NetworkPlugin,
+		NetworkPolicy:                   np.NetworkPolicy,
+		PodCIDR:                         np.PodCIDR,
+		ServiceCIDR:                     np.ServiceCIDR,
+		DNSServiceIP:                    np.DNSServiceIP,
+		DockerBridgeCIDR:                np.DockerBridgeCIDR,
+		OutboundType:                    np.OutboundType,
+		DNSNamePrefix:                   p.DNSNamePrefix,
+		DisableRBAC:                     p.DisableRBAC,
+		NodeResourceGroup:               p.NodeResourceGroup,
+		SKUTier:                         p.SKUTier,
+		LogAnalyticsWorkspaceID:         p.LogAnalyticsWorkspaceID,
+		LogAnalyticsWorkspaceIDRef:      p.LogAnalyticsWorkspaceIDRef,
+		LogAnalyticsWorkspaceIDSelector: p.LogAnalyticsWorkspaceIDSelector,
+		KubeconfigCredentials:           p.KubeconfigCredentials,
+		Tags:                            p.Tags,
+		ConnectionSecretFormat:          p.ConnectionSecretFormat,
+		ServicePrincipalDeletionPolicy:  p.ServicePrincipalDeletionPolicy,
+	}
+	if p.Identity != nil {
+		out.Spec.Identity = &v1alpha3.AKSClusterIdentity{
+			Type:                   p.Identity.Type,
+			UserAssignedIdentityID: p.Identity.UserAssignedIdentityID,
+		}
+	}
+	if p.AADProfile != nil {
+		out.Spec.AADProfile = &v1alpha3.AKSClusterAADProfile{
+			Managed:                  p.AADProfile.Managed,
+			AdminGroupObjectIDs:      p.AADProfile.AdminGroupObjectIDs,
+			ServerAppID:              p.AADProfile.ServerAppID,
+			ServerAppSecretSecretRef: p.AADProfile.ServerAppSecretSecretRef,
+			ClientAppID:              p.AADProfile.ClientAppID,
+			TenantID:                 p.AADProfile.TenantID,
+		}
+	}
+	if lb := np.LoadBalancerProfile; lb != nil {
+		out.Spec.LoadBalancerProfile = &v1alpha3.AKSClusterLoadBalancerProfile{
+			ManagedOutboundIPCount: lb.ManagedOutboundIPCount,
+			IdleTimeoutInMinutes:   lb.IdleTimeoutInMinutes,
+		}
+	}
+	if p.LinuxProfile != nil {
+		out.Spec.LinuxProfile = &v1alpha3.AKSClusterLinuxProfile{
+			AdminUsername:         p.LinuxProfile.AdminUsername,
+			SSHPublicKeys:         p.LinuxProfile.SSHPublicKeys,
+			SSHPublicKeySecretRef: p.LinuxProfile.SSHPublicKeySecretRef,
+		}
+	}
+	if p.WindowsProfile != nil {
+		out.Spec.WindowsProfile = &v1alpha3.AKSClusterWindowsProfile{
+			AdminUsername:          p.WindowsProfile.AdminUsername,
+			AdminPasswordSecretRef: p.WindowsProfile.AdminPasswordSecretRef,
+			LicenseType:            p.WindowsProfile.LicenseType,
+		}
+	}
+	if p.AddonProfiles != nil {
+		out.Spec.AddonProfiles = &v1alpha3.AKSClusterAddonProfiles{
+			HTTPApplicationRouting: addonProfileTo(p.AddonProfiles.HTTPApplicationRouting),
+			Monitoring:             addonProfileTo(p.AddonProfiles.Monitoring),
+			AzurePolicy:            addonProfileTo(p.AddonProfiles.AzurePolicy),
+			KubeDashboard:          addonProfileTo(p.AddonProfiles.KubeDashboard),
+		}
+	}
+	if p.AgentPoolProfiles != nil {
+		out.Spec.NodePools = make([]v1alpha3.AKSClusterNodePool, len(p.AgentPoolProfiles))
+		for i, ap := range p.AgentPoolProfiles {
+			out.Spec.NodePools[i] = v1alpha3.AKSClusterNodePool{
+				Name:         ap.Name,
+				VMSize:       ap.VMSize,
+				Count:        ap.Count,
+				OSType:       ap.OSType,
+				OSDiskSizeGB: ap.OSDiskSizeGB,
+				MaxPods:      ap.MaxPods,
+				Zones:        ap.Zones,
+				Taints:       ap.Taints,
+				Labels:       ap.Labels,
+
+				ScaleSetPriority:       ap.ScaleSetPriority,
+				ScaleSetEvictionPolicy: ap.ScaleSetEvictionPolicy,
+				SpotMaxPrice:           ap.SpotMaxPrice,
+			}
+		}
+	}
+
+	o := in.Status.AtProvider
+	out.Status = v1alpha3.AKSClusterStatus{
+		ResourceStatus:               in.Status.ResourceStatus,
+		State:                        o.State,
+		ProviderID:                   o.ProviderID,
+		Endpoint:                     o.Endpoint,
+		LastOperation:                o.LastOperation,
+		ServicePrincipalSecretExpiry: o.ServicePrincipalSecretExpiry,
+		ApplicationObjectID:          o.ApplicationObjectID,
+		ApplicationID:                o.ApplicationID,
+		ServicePrincipalObjectID:     o.ServicePrincipalObjectID,
+	}
+	return nil
+}
+
+// ConvertFrom converts the v1alpha3 hub version to this AKSCluster.
+func (in *AKSCluster) ConvertFrom(hub conversion.Hub) error {
+	h := hub.(*v1alpha3.AKSCluster)
+	in.ObjectMeta = h.ObjectMeta
+	in.Spec.ResourceSpec = h.Spec.ResourceSpec
+
+	p := h.Spec.AKSClusterParameters
+	in.Spec.ForProvider = AKSClusterParameters{
+		SubscriptionID:            p.SubscriptionID,
+		ResourceGroupName:         p.ResourceGroupName,
+		ResourceGroupNameRef:      p.ResourceGroupNameRef,
+		ResourceGroupNameSelector: p.ResourceGroupNameSelector,
+		Location:                  p.Location,
+		Version:                   p.Version,
+		DNSNamePrefix:             p.DNSNamePrefix,
+		DisableRBAC:               p.DisableRBAC,
+		NodeResourceGroup:         p.NodeResourceGroup,
+		SKUTier:                   p.SKUTier,
+		DefaultAgentPoolProfile: DefaultAgentPoolProfile{
+			VMSize:            p.NodeVMSize,
+			Count:             p.NodeCount,
+			EnableAutoScaling: p.EnableAutoScaling,
+			MinCount:          p.MinCount,
+			MaxCount:          p.MaxCount,
+			Zones:             p.Zones,
+			Type:              p.AgentPoolType,
+			OSDiskSizeGB:      p.NodeOSDiskSizeGB,
+			MaxPods:           p.NodeMaxPods,
+			Taints:            p.NodeTaints,
+			Labels:            p.NodeLabels,
+		},
+		NetworkProfile: NetworkProfile{
+			VnetSubnetID:                p.VnetSubnetID,
+			VnetSubnetIDRef:             p.VnetSubnetIDRef,
+			VnetSubnetIDSelector:        p.VnetSubnetIDSelector,
+			PrivateCluster:              p.PrivateCluster,
+			APIServerAuthorizedIPRanges: p.APIServerAuthorizedIPRanges,
+			NetworkPlugin:               p.NetworkPlugin,
+			NetworkPolicy:               p.NetworkPolicy,
+			PodCIDR:                     p.PodCIDR,
+			ServiceCIDR:                 p.ServiceCIDR,
+			DNSServiceIP:                p.DNSServiceIP,
+			DockerBridgeCIDR:            p.DockerBridgeCIDR,
+			OutboundType:                p.OutboundType,
+		},
+		LogAnalyticsWorkspaceID:         p.LogAnalyticsWorkspaceID,
+		LogAnalyticsWorkspaceIDRef:      p.LogAnalyticsWorkspaceIDRef,
+		LogAnalyticsWorkspaceIDSelector: p.LogAnalyticsWorkspaceIDSelector,
+		KubeconfigCredentials:           p.KubeconfigCredentials,
+		Tags:                            p.Tags,
+		ConnectionSecretFormat:          p.ConnectionSecretFormat,
+		ServicePrincipalDeletionPolicy:  p.ServicePrincipalDeletionPolicy,
+	}
+	if p.Identity != nil {
+		in.Spec.ForProvider.Identity = &Identity{
+			Type:                   p.Identity.Type,
+			UserAssignedIdentityID: p.Identity.UserAssignedIdentityID,
+		}
+	}
+	if p.AADProfile != nil {
+		in.Spec.ForProvider.AADProfile = &AADProfile{
+			Managed:                  p.AADProfile.Managed,
+			AdminGroupObjectIDs:      p.AADProfile.AdminGroupObjectIDs,
+			ServerAppID:              p.AADProfile.ServerAppID,
+			ServerAppSecretSecretRef: p.AADProfile.ServerAppSecretSecretRef,
+			ClientAppID:              p.AADProfile.ClientAppID,
+			TenantID:                 p.AADProfile.TenantID,
+		}
+	}
+	if lb := p.LoadBalancerProfile; lb != nil {
+		in.Spec.ForProvider.NetworkProfile.LoadBalancerProfile = &LoadBalancerProfile{
+			ManagedOutboundIPCount: lb.ManagedOutboundIPCount,
+			IdleTimeoutInMinutes:   lb.IdleTimeoutInMinutes,
+		}
+	}
+	if p.LinuxProfile != nil {
+		in.Spec.ForProvider.LinuxProfile = &LinuxProfile{
+			AdminUsername:         p.LinuxProfile.AdminUsername,
+			SSHPublicKeys:         p.LinuxProfile.SSHPublicKeys,
+			SSHPublicKeySecretRef: p.LinuxProfile.SSHPublicKeySecretRef,
+		}
+	}
+	if p.WindowsProfile != nil {
+		in.Spec.ForProvider.WindowsProfile = &WindowsProfile{
+			AdminUsername:          p.WindowsProfile.AdminUsername,
+			AdminPasswordSecretRef: p.WindowsProfile.AdminPasswordSecretRef,
+			LicenseType:            p.WindowsProfile.LicenseType,
+		}
+	}
+	if p.AddonProfiles != nil {
+		in.Spec.ForProvider.AddonProfiles = &AddonProfiles{
+			HTTPApplicationRouting: addonProfileFrom(p.AddonProfiles.HTTPApplicationRouting),
+			Monitoring:             addonProfileFrom(p.AddonProfiles.Monitoring),
+			AzurePolicy:            addonProfileFrom(p.AddonProfiles.AzurePolicy),
+			KubeDashboard:          addonProfileFrom(p.AddonProfiles.KubeDashboard),
+		}
+	}
+	if p.NodePools != nil {
+		in.Spec.ForProvider.AgentPoolProfiles = make([]AgentPoolProfile, len(p.NodePools))
+		for i, np := range p.NodePools {
+			in.Spec.ForProvider.AgentPoolProfiles[i] = AgentPoolProfile{
+				Name:         np.Name,
+				VMSize:       np.VMSize,
+				Count:        np.Count,
+				OSType:       np.OSType,
+				OSDiskSizeGB: np.OSDiskSizeGB,
+				MaxPods:      np.MaxPods,
+				Zones:        np.Zones,
+				Taints:       np.Taints,
+				Labels:       np.Labels,
+
+				ScaleSetPriority:       np.ScaleSetPriority,
+				ScaleSetEvictionPolicy: np.ScaleSetEvictionPolicy,
+				SpotMaxPrice:           np.SpotMaxPrice,
+			}
+		}
+	}
+
+	in.Status = AKSClusterStatus{
+		ResourceStatus: h.Status.ResourceStatus,
+		AtProvider: AKSClusterObservation{
+			State:                        h.Status.State,
+			ProviderID:                   h.Status.ProviderID,
+			Endpoint:                     h.Status.Endpoint,
+			LastOperation:                h.Status.LastOperation,
+			ServicePrincipalSecretExpiry: h.Status.ServicePrincipalSecretExpiry,
+			ApplicationObjectID:          h.Status.ApplicationObjectID,
+			ApplicationID:                h.Status.ApplicationID,
+			ServicePrincipalObjectID:     h.Status.ServicePrincipalObjectID,
+		},
+	}
+	return nil
+}
+
+func addonProfileTo(p *AddonProfile) *v1alpha3.AKSClusterAddonProfile {
+	if p == nil {
+		return nil
+	}
+	return &v1alpha3.AKSClusterAddonProfile{Enabled: p.Enabled}
+}
+
+func addonProfileFrom(p *v1alpha3.AKSClusterAddonProfile) *AddonProfile {
+	if p == nil {
+		return nil
+	}
+	return &AddonProfile{Enabled: p.Enabled}
+}
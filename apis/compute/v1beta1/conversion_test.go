@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	apisv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+var _ conversion.Convertible = &AKSCluster{}
+var _ conversion.Hub = &v1alpha3.AKSCluster{}
+
+func hubAKSCluster() *v1alpha3.AKSCluster {
+	count, min, max, disk, pods, idle := 3, 1, 5, 128, 50, 10
+	price := resource.MustParse("0.05")
+	return &v1alpha3.AKSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cool-cluster"},
+		Spec: v1alpha3.AKSClusterSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: "cool-secret", Namespace: "default"},
+			},
+			AKSClusterParameters: v1alpha3.AKSClusterParameters{
+				ResourceGroupName:           "cool-rg",
+				Location:                    "westus2",
+				Version:                     "1.22.6",
+				VnetSubnetID:                "cool-subnet",
+				VnetSubnetIDRef:             &xpv1.Reference{Name: "cool-subnet"},
+				NodeCount:                   &count,
+				EnableAutoScaling:           true,
+				MinCount:                    &min,
+				MaxCount:                    &max,
+				NodeVMSize:                  "Standard_B2s",
+				Zones:                       []string{"1", "2"},
+				AgentPoolType:               "VirtualMachineScaleSets",
+				NodeOSDiskSizeGB:            &disk,
+				NodeMaxPods:                 &pods,
+				NodeTaints:                  []string{"dedicated=system:NoSchedule"},
+				NodeLabels:                  map[string]string{"pool": "system"},
+				PrivateCluster:              true,
+				APIServerAuthorizedIPRanges: []string{"203.0.113.0/24"},
+				NetworkPlugin:               "azure",
+				NetworkPolicy:               "calico",
+				ServiceCIDR:                 "10.0.0.0/16",
+				DNSServiceIP:                "10.0.0.10",
+				DockerBridgeCIDR:            "172.17.0.1/16",
+				OutboundType:                "loadBalancer",
+				LoadBalancerProfile:         &v1alpha3.AKSClusterLoadBalancerProfile{ManagedOutboundIPCount: &count, IdleTimeoutInMinutes: &idle},
+				DNSNamePrefix:               "cool",
+				NodeResourceGroup:           "cool-nodes",
+				SKUTier:                     "Paid",
+				Identity:                    &v1alpha3.AKSClusterIdentity{Type: v1alpha3.AKSClusterIdentityTypeSystemAssigned},
+				AADProfile:                  &v1alpha3.AKSClusterAADProfile{Managed: true, AdminGroupObjectIDs: []string{"admins"}},
+				KubeconfigCredentials:       v1alpha3.KubeconfigCredentialsAdminAndUser,
+				LinuxProfile: &v1alpha3.AKSClusterLinuxProfile{
+					AdminUsername:         "azureuser",
+					SSHPublicKeys:         []string{"ssh-rsa AAAA"},
+					SSHPublicKeySecretRef: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "ssh", Namespace: "default"}, Key: "key"},
+				},
+				WindowsProfile: &v1alpha3.AKSClusterWindowsProfile{
+					AdminUsername:          "azureuser",
+					AdminPasswordSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "win", Namespace: "default"}, Key: "password"},
+					LicenseType:            "Windows_Server",
+				},
+				AddonProfiles: &v1alpha3.AKSClusterAddonProfiles{AzurePolicy: &v1alpha3.AKSClusterAddonProfile{Enabled: true}},
+				NodePools: []v1alpha3.AKSClusterNodePool{
+					{Name: "win", VMSize: "Standard_D2s_v3", OSType: "Windows", OSDiskSizeGB: &disk, MaxPods: &pods, Labels: map[string]string{"os": "windows"}},
+					{Name: "spot", VMSize: "Standard_D2s_v3", ScaleSetPriority: "Spot", ScaleSetEvictionPolicy: "Deallocate", SpotMaxPrice: &price},
+				},
+				Tags:                           map[string]string{"cool": "tag"},
+				ConnectionSecretFormat:         &apisv1alpha3.ConnectionSecretFormat{Keys: []string{"kubeconfig"}},
+				ServicePrincipalDeletionPolicy: xpv1.DeletionOrphan,
+			},
+		},
+		Status: v1alpha3.AKSClusterStatus{
+			State:                    "Succeeded",
+			ProviderID:               "cool-id",
+			Endpoint:                 "cool.hcp.westus2.azmk8s.io",
+			LastOperation:            apisv1alpha3.AsyncOperation{Method: "PUT", Status: "Succeeded"},
+			ApplicationObjectID:      "cool-app-object",
+			ApplicationID:            "cool-app",
+			ServicePrincipalObjectID: "cool-sp-object",
+		},
+	}
+}
+
+func TestAKSClusterConversion(t *testing.T) {
+	cases := map[string]struct {
+		hub *v1alpha3.AKSCluster
+	}{
+		"Empty": {
+			hub: &v1alpha3.AKSCluster{},
+		},
+		"Full": {
+			hub: hubAKSCluster(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			spoke := &AKSCluster{}
+			if err := spoke.ConvertFrom(tc.hub); err != nil {
+				t.Fatalf("ConvertFrom(...): %s", err)
+			}
+			got := &v1alpha3.AKSCluster{}
+			if err := spoke.ConvertTo(got); err != nil {
+				t.Fatalf("ConvertTo(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.hub, got); diff != "" {
+				t.Errorf("round trip: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAKSClusterConvertFrom(t *testing.T) {
+	spoke := &AKSCluster{}
+	if err := spoke.ConvertFrom(hubAKSCluster()); err != nil {
+		t.Fatalf("ConvertFrom(...): %s", err)
+	}
+
+	// Spot check that the flat v1alpha3 fields end up where they belong.
+	count, min, max, disk, pods, idle := 3, 1, 5, 128, 50, 10
+	price := resource.MustParse("0.05")
+	wantPool := DefaultAgentPoolProfile{
+		VMSize:            "Standard_B2s",
+		Count:             &count,
+		EnableAutoScaling: true,
+		MinCount:          &min,
+		MaxCount:          &max,
+		Zones:             []string{"1", "2"},
+		Type:              "VirtualMachineScaleSets",
+		OSDiskSizeGB:      &disk,
+		MaxPods:           &pods,
+		Taints:            []string{"dedicated=system:NoSchedule"},
+		Labels:            map[string]string{"pool": "system"},
+	}
+	if diff := cmp.Diff(wantPool, spoke.Spec.ForProvider.DefaultAgentPoolProfile); diff != "" {
+		t.Errorf("DefaultAgentPoolProfile: -want, +got:\n%s", diff)
+	}
+	wantNet := NetworkProfile{
+		VnetSubnetID:                "cool-subnet",
+		VnetSubnetIDRef:             &xpv1.Reference{Name: "cool-subnet"},
+		PrivateCluster:              true,
+		APIServerAuthorizedIPRanges: []string{"203.0.113.0/24"},
+		NetworkPlugin:               "azure",
+		NetworkPolicy:               "calico",
+		ServiceCIDR:                 "10.0.0.0/16",
+		DNSServiceIP:                "10.0.0.10",
+		DockerBridgeCIDR:            "172.17.0.1/16",
+		OutboundType:                "loadBalancer",
+		LoadBalancerProfile:         &LoadBalancerProfile{ManagedOutboundIPCount: &count, IdleTimeoutInMinutes: &idle},
+	}
+	if diff := cmp.Diff(wantNet, spoke.Spec.ForProvider.NetworkProfile); diff != "" {
+		t.Errorf("NetworkProfile: -want, +got:\n%s", diff)
+	}
+	wantPools := []AgentPoolProfile{
+		{Name: "win", VMSize: "Standard_D2s_v3", OSType: "Windows", OSDiskSizeGB: &disk, MaxPods: &pods, Labels: map[string]string{"os": "windows"}},
+		{Name: "spot", VMSize: "Standard_D2s_v3", ScaleSetPriority: "Spot", ScaleSetEvictionPolicy: "Deallocate", SpotMaxPrice: &price},
+	}
+	if diff := cmp.Diff(wantPools, spoke.Spec.ForProvider.AgentPoolProfiles); diff != "" {
+		t.Errorf("AgentPoolProfiles: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("cool.hcp.westus2.azmk8s.io", spoke.Status.AtProvider.Endpoint); diff != "" {
+		t.Errorf("AtProvider.Endpoint: -want, +got:\n%s", diff)
+	}
+}
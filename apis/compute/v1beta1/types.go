@@ -0,0 +1,637 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+// AKSClusterParameters define the desired state of an Azure Kubernetes Engine
+// cluster.
+type AKSClusterParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName is the name of the resource group that the cluster will
+	// be created in
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup to retrieve its
+	// name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to a ResourceGroup to
+	// retrieve its name
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location is the Azure location that the cluster will be created in
+	// +immutable
+	Location string `json:"location"`
+
+	// Version is the Kubernetes version that will be deployed to the cluster.
+	// Changing it upgrades the cluster in place: the control plane first,
+	// then its agent pools. The Upgrading condition reports the progress. A
+	// version without a patch version, e.g. 1.22, is replaced by the patch
+	// version that Azure deploys.
+	Version string `json:"version"`
+
+	// DNSNamePrefix is the DNS name prefix to use with the hosted Kubernetes
+	// API server FQDN.
+	// +optional
+	DNSNamePrefix string `json:"dnsNamePrefix,omitempty"`
+
+	// DisableRBAC determines whether RBAC will be disabled or enabled in the
+	// cluster.
+	// +optional
+	DisableRBAC bool `json:"disableRBAC,omitempty"`
+
+	// NodeResourceGroup is the name of the resource group that will contain
+	// the agent pool nodes. Azure generates a name if it is omitted.
+	// +immutable
+	// +optional
+	NodeResourceGroup string `json:"nodeResourceGroup,omitempty"`
+
+	// SKUTier is the tier of the managed cluster SKU. The Paid tier provides
+	// an uptime SLA for the Kubernetes API server. Defaults to Free.
+	// +kubebuilder:validation:Enum=Free;Paid
+	// +optional
+	SKUTier string `json:"skuTier,omitempty"`
+
+	// DefaultAgentPoolProfile is the agent pool that every cluster has.
+	// +optional
+	DefaultAgentPoolProfile DefaultAgentPoolProfile `json:"defaultAgentPoolProfile,omitempty"`
+
+	// AgentPoolProfiles are additional pools of worker nodes, e.g. pools of
+	// a different VM size or OS type.
+	// +optional
+	AgentPoolProfiles []AgentPoolProfile `json:"agentPoolProfiles,omitempty"`
+
+	// NetworkProfile configures the network of the cluster.
+	// +optional
+	NetworkProfile NetworkProfile `json:"networkProfile,omitempty"`
+
+	// Identity configures the cluster to use a managed identity rather than
+	// an Azure AD application and service principal created for it. When a
+	// subnet is supplied the identity must be granted the Network
+	// Contributor role on it.
+	// +immutable
+	// +optional
+	Identity *Identity `json:"identity,omitempty"`
+
+	// ServicePrincipalDeletionPolicy determines what happens to the Azure AD
+	// application and service principal that the controller creates for the
+	// cluster when the cluster is deleted. They are deleted along with the
+	// cluster by default. Orphan retains them, for example so that they may
+	// be reused or audited; their IDs are recorded in the status of the
+	// cluster for later cleanup. It has no effect on clusters that use a
+	// managed identity.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	ServicePrincipalDeletionPolicy xpv1.DeletionPolicy `json:"servicePrincipalDeletionPolicy,omitempty"`
+
+	// AADProfile integrates the cluster with Azure Active Directory, which
+	// then authenticates the users of the Kubernetes API. Only a kubeconfig
+	// that authenticates as an Azure AD user is written to the connection
+	// secret by default, see KubeconfigCredentials.
+	// +immutable
+	// +optional
+	AADProfile *AADProfile `json:"aadProfile,omitempty"`
+
+	// LinuxProfile configures the administrator account of the Linux nodes
+	// of the cluster. Azure creates one with a generated SSH key if it is
+	// omitted.
+	// +immutable
+	// +optional
+	LinuxProfile *LinuxProfile `json:"linuxProfile,omitempty"`
+
+	// WindowsProfile configures the administrator account of the Windows
+	// nodes of the cluster. Azure creates one with a generated password if
+	// it is omitted.
+	// +immutable
+	// +optional
+	WindowsProfile *WindowsProfile `json:"windowsProfile,omitempty"`
+
+	// KubeconfigCredentials determines which kubeconfigs are written to the
+	// connection secret. Admin writes the kubeconfig of the cluster admin
+	// under the kubeconfig key, User writes a kubeconfig of the cluster user,
+	// who authenticates as an Azure AD user if AADProfile is set, under the
+	// userKubeconfig key, and AdminAndUser writes both. Defaults to User for
+	// clusters with an AADProfile, and to Admin otherwise.
+	// +kubebuilder:validation:Enum=Admin;User;AdminAndUser
+	// +optional
+	KubeconfigCredentials string `json:"kubeconfigCredentials,omitempty"`
+
+	// AddonProfiles enables or disables the addons of the cluster. Addons
+	// that are omitted are left as they are.
+	// +optional
+	AddonProfiles *AddonProfiles `json:"addonProfiles,omitempty"`
+
+	// LogAnalyticsWorkspaceID is the resource ID of a Log Analytics
+	// workspace. When it is set the monitoring (omsagent) addon is enabled and
+	// sends the container logs and metrics of the cluster to the workspace,
+	// unless AddonProfiles disables it.
+	// +optional
+	LogAnalyticsWorkspaceID string `json:"logAnalyticsWorkspaceID,omitempty"`
+
+	// LogAnalyticsWorkspaceIDRef - A reference to a LogAnalyticsWorkspace to
+	// retrieve its resource ID
+	// +optional
+	LogAnalyticsWorkspaceIDRef *xpv1.Reference `json:"logAnalyticsWorkspaceIDRef,omitempty"`
+
+	// LogAnalyticsWorkspaceIDSelector - Select a reference to a
+	// LogAnalyticsWorkspace to retrieve its resource ID
+	// +optional
+	LogAnalyticsWorkspaceIDSelector *xpv1.Selector `json:"logAnalyticsWorkspaceIDSelector,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// ConnectionSecretFormat customizes the keys written to the connection
+	// secret of the cluster, e.g. to write only its kubeconfig and token.
+	// Connection strings do not apply to clusters and are ignored.
+	// +optional
+	ConnectionSecretFormat *apisv1alpha3.ConnectionSecretFormat `json:"connectionSecretFormat,omitempty"`
+}
+
+// A DefaultAgentPoolProfile configures the agent pool that every AKS cluster
+// has.
+type DefaultAgentPoolProfile struct {
+	// VMSize is the name of the VM size of the nodes, e.g., Standard_B2s,
+	// Standard_F2s_v2, etc.
+	// +optional
+	VMSize string `json:"vmSize,omitempty"`
+
+	// Count is the number of nodes that the pool will initially be created
+	// with. Defaults to 1. Changing it scales the pool in place.
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Count *int `json:"count,omitempty"`
+
+	// EnableAutoScaling enables the cluster autoscaler, which scales the
+	// pool between MinCount and MaxCount nodes. Count is only used as the
+	// initial node count when it is enabled.
+	// +optional
+	EnableAutoScaling bool `json:"enableAutoScaling,omitempty"`
+
+	// MinCount is the minimum number of nodes of the pool. It is required
+	// when EnableAutoScaling is true.
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinCount *int `json:"minCount,omitempty"`
+
+	// MaxCount is the maximum number of nodes of the pool. It is required
+	// when EnableAutoScaling is true.
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxCount *int `json:"maxCount,omitempty"`
+
+	// Zones - A list of availability zones to spread the nodes across. The
+	// VM size must be available in each zone of the cluster's location.
+	// +immutable
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// Type of the pool. Availability zones, agent pool profiles and the
+	// cluster autoscaler all require VirtualMachineScaleSets, which is the
+	// default.
+	// +kubebuilder:validation:Enum=VirtualMachineScaleSets;AvailabilitySet
+	// +immutable
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// OSDiskSizeGB is the size of the OS disk of each node of the pool, in
+	// GB. Azure chooses a size based on the VM size if it is omitted.
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=2048
+	// +immutable
+	// +optional
+	OSDiskSizeGB *int `json:"osDiskSizeGB,omitempty"`
+
+	// MaxPods is the maximum number of pods that can run on each node of
+	// the pool. Azure chooses a number based on the network plugin if it is
+	// omitted.
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=250
+	// +immutable
+	// +optional
+	MaxPods *int `json:"maxPods,omitempty"`
+
+	// Taints added to the nodes of the pool, of the form
+	// key=value:NoSchedule.
+	// +optional
+	Taints []string `json:"taints,omitempty"`
+
+	// Labels added to the nodes of the pool.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// An AgentPoolProfile is an additional pool of worker nodes declared inline
+// in an AKS cluster.
+type AgentPoolProfile struct {
+	// Name of the pool. It must be unique within the cluster, and may not be
+	// 'agentpool', which is the name of the default agent pool.
+	// +kubebuilder:validation:Pattern=`^[a-z][a-z0-9]{0,11}$`
+	Name string `json:"name"`
+
+	// VMSize is the name of the VM size of the nodes, e.g., Standard_B2s.
+	// +immutable
+	VMSize string `json:"vmSize"`
+
+	// Count is the number of nodes in the pool. Defaults to 1.
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Count *int `json:"count,omitempty"`
+
+	// OSType is the operating system of the nodes. Defaults to Linux.
+	// +kubebuilder:validation:Enum=Linux;Windows
+	// +immutable
+	// +optional
+	OSType string `json:"osType,omitempty"`
+
+	// OSDiskSizeGB is the size of the OS disk of each node of the pool, in
+	// GB. Azure chooses a size based on the VM size if it is omitted.
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=2048
+	// +immutable
+	// +optional
+	OSDiskSizeGB *int `json:"osDiskSizeGB,omitempty"`
+
+	// MaxPods is the maximum number of pods that can run on each node of
+	// the pool. Azure chooses a number based on the network plugin if it is
+	// omitted.
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=250
+	// +immutable
+	// +optional
+	MaxPods *int `json:"maxPods,omitempty"`
+
+	// ScaleSetPriority of the nodes of the pool. Spot nodes run on spare
+	// capacity at a discount, and may be evicted whenever Azure needs that
+	// capacity back. AKS taints spot nodes with
+	// kubernetes.azure.com/scalesetpriority=spot:NoSchedule. Defaults to
+	// Regular.
+	// +kubebuilder:validation:Enum=Regular;Spot
+	// +immutable
+	// +optional
+	ScaleSetPriority string `json:"scaleSetPriority,omitempty"`
+
+	// ScaleSetEvictionPolicy determines whether evicted spot nodes are
+	// deleted or deallocated. Deallocated nodes still count against the
+	// compute quota. Defaults to Delete. Requires a Spot ScaleSetPriority.
+	// +kubebuilder:validation:Enum=Delete;Deallocate
+	// +immutable
+	// +optional
+	ScaleSetEvictionPolicy string `json:"scaleSetEvictionPolicy,omitempty"`
+
+	// SpotMaxPrice is the maximum price per hour, in US dollars, paid for
+	// each spot node, e.g. 0.05. Nodes are evicted when the spot price
+	// exceeds it. Defaults to -1, which pays up to the on-demand price.
+	// Requires a Spot ScaleSetPriority.
+	// +immutable
+	// +optional
+	SpotMaxPrice *resource.Quantity `json:"spotMaxPrice,omitempty"`
+
+	// Zones - A list of availability zones to spread the nodes of the pool
+	// across. The VM size must be available in each zone of the cluster's
+	// location.
+	// +immutable
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// Taints added to the nodes of the pool, of the form
+	// key=value:NoSchedule.
+	// +optional
+	Taints []string `json:"taints,omitempty"`
+
+	// Labels added to the nodes of the pool.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// A NetworkProfile configures the network of an AKS cluster.
+type NetworkProfile struct {
+	// VnetSubnetID is the subnet to which the cluster will be deployed.
+	// +immutable
+	// +optional
+	VnetSubnetID string `json:"vnetSubnetID,omitempty"`
+
+	// VnetSubnetIDRef - A reference to a Subnet to retrieve its ID
+	// +immutable
+	// +optional
+	VnetSubnetIDRef *xpv1.Reference `json:"vnetSubnetIDRef,omitempty"`
+
+	// VnetSubnetIDSelector - Select a reference to a Subnet to retrieve
+	// its ID
+	// +immutable
+	// +optional
+	VnetSubnetIDSelector *xpv1.Selector `json:"vnetSubnetIDSelector,omitempty"`
+
+	// PrivateCluster provisions the cluster with an API server that is only
+	// reachable from within its virtual network, through a private FQDN. A
+	// VnetSubnetID is required when it is true.
+	// +immutable
+	// +optional
+	PrivateCluster bool `json:"privateCluster,omitempty"`
+
+	// APIServerAuthorizedIPRanges restricts access to the API server of a
+	// public cluster to the supplied IP ranges, in CIDR notation, e.g.
+	// 203.0.113.0/24. The API server is reachable from anywhere if it is
+	// omitted. It cannot be combined with PrivateCluster.
+	// +optional
+	APIServerAuthorizedIPRanges []string `json:"apiServerAuthorizedIPRanges,omitempty"`
+
+	// NetworkPlugin is the network plugin of the cluster. Defaults to azure
+	// when a VnetSubnetID is supplied, and to kubenet otherwise.
+	// +kubebuilder:validation:Enum=azure;kubenet
+	// +immutable
+	// +optional
+	NetworkPlugin string `json:"networkPlugin,omitempty"`
+
+	// NetworkPolicy is the network policy implementation of the cluster. The
+	// azure policy requires the azure network plugin.
+	// +kubebuilder:validation:Enum=azure;calico
+	// +immutable
+	// +optional
+	NetworkPolicy string `json:"networkPolicy,omitempty"`
+
+	// PodCIDR is the CIDR range from which pod IPs are assigned when the
+	// kubenet network plugin is used.
+	// +immutable
+	// +optional
+	PodCIDR string `json:"podCIDR,omitempty"`
+
+	// ServiceCIDR is the CIDR range from which service cluster IPs are
+	// assigned. It must not overlap with any subnet IP ranges.
+	// +immutable
+	// +optional
+	ServiceCIDR string `json:"serviceCIDR,omitempty"`
+
+	// DNSServiceIP is the IP address assigned to the Kubernetes DNS service.
+	// It must be within ServiceCIDR.
+	// +immutable
+	// +optional
+	DNSServiceIP string `json:"dnsServiceIP,omitempty"`
+
+	// DockerBridgeCIDR is the CIDR range assigned to the Docker bridge
+	// network. It must not overlap with any subnet IP ranges or ServiceCIDR.
+	// +immutable
+	// +optional
+	DockerBridgeCIDR string `json:"dockerBridgeCIDR,omitempty"`
+
+	// OutboundType is how the nodes of the cluster egress to the internet.
+	// With loadBalancer they egress through the public IPs of the load
+	// balancer of the cluster. With userDefinedRouting they egress through
+	// the route table of the VnetSubnetID, e.g. to an Azure Firewall, which
+	// must be set up before the cluster is created. Defaults to
+	// loadBalancer.
+	// +kubebuilder:validation:Enum=loadBalancer;userDefinedRouting
+	// +immutable
+	// +optional
+	OutboundType string `json:"outboundType,omitempty"`
+
+	// LoadBalancerProfile configures the outbound connections of the load
+	// balancer of the cluster. It requires the loadBalancer OutboundType.
+	// +optional
+	LoadBalancerProfile *LoadBalancerProfile `json:"loadBalancerProfile,omitempty"`
+}
+
+// A LoadBalancerProfile configures the outbound connections of the
+// load balancer of an AKS cluster.
+type LoadBalancerProfile struct {
+	// ManagedOutboundIPCount is the number of public IPs Azure creates for
+	// the outbound connections of the cluster. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	ManagedOutboundIPCount *int `json:"managedOutboundIPCount,omitempty"`
+
+	// IdleTimeoutInMinutes is how long idle outbound connections are kept
+	// open. Defaults to 30.
+	// +kubebuilder:validation:Minimum=4
+	// +kubebuilder:validation:Maximum=120
+	// +optional
+	IdleTimeoutInMinutes *int `json:"idleTimeoutInMinutes,omitempty"`
+}
+
+// A LinuxProfile configures the administrator account of the Linux nodes of
+// an AKS cluster.
+type LinuxProfile struct {
+	// AdminUsername is the name of the administrator account of the nodes.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z][-A-Za-z0-9_]*$`
+	AdminUsername string `json:"adminUsername"`
+
+	// SSHPublicKeys are authorized to log in to the administrator account.
+	// +optional
+	SSHPublicKeys []string `json:"sshPublicKeys,omitempty"`
+
+	// SSHPublicKeySecretRef references an SSH public key that is authorized
+	// to log in to the administrator account, in addition to SSHPublicKeys.
+	// +optional
+	SSHPublicKeySecretRef *xpv1.SecretKeySelector `json:"sshPublicKeySecretRef,omitempty"`
+}
+
+// A WindowsProfile configures the administrator account of the Windows nodes
+// of an AKS cluster.
+type WindowsProfile struct {
+	// AdminUsername is the name of the administrator account of the nodes.
+	AdminUsername string `json:"adminUsername"`
+
+	// AdminPasswordSecretRef references the password of the administrator
+	// account.
+	AdminPasswordSecretRef xpv1.SecretKeySelector `json:"adminPasswordSecretRef"`
+
+	// LicenseType is the license type of the nodes. Windows_Server enables
+	// Azure Hybrid User Benefits.
+	// +kubebuilder:validation:Enum=None;Windows_Server
+	// +optional
+	LicenseType string `json:"licenseType,omitempty"`
+}
+
+// An AADProfile configures the Azure Active Directory integration of an AKS
+// cluster.
+type AADProfile struct {
+	// Managed enables the AKS-managed Azure AD integration, which requires
+	// no Azure AD applications. ServerAppID, ServerAppSecretSecretRef and
+	// ClientAppID are required otherwise.
+	// +optional
+	Managed bool `json:"managed,omitempty"`
+
+	// AdminGroupObjectIDs are the object IDs of the Azure AD groups whose
+	// members are admins of the cluster. Only used when Managed is true.
+	// +optional
+	AdminGroupObjectIDs []string `json:"adminGroupObjectIDs,omitempty"`
+
+	// ServerAppID is the ID of the Azure AD application that authenticates
+	// users to the Kubernetes API server.
+	// +optional
+	ServerAppID string `json:"serverAppID,omitempty"`
+
+	// ServerAppSecretSecretRef references the secret key that holds the
+	// secret of the Azure AD server application.
+	// +optional
+	ServerAppSecretSecretRef *xpv1.SecretKeySelector `json:"serverAppSecretSecretRef,omitempty"`
+
+	// ClientAppID is the ID of the Azure AD application that users
+	// authenticate with, e.g. through kubectl.
+	// +optional
+	ClientAppID string `json:"clientAppID,omitempty"`
+
+	// TenantID is the ID of the Azure AD tenant that users authenticate
+	// against. Defaults to the tenant of the cluster's subscription.
+	// +optional
+	TenantID string `json:"tenantID,omitempty"`
+}
+
+// AddonProfiles configures the addons of an AKS cluster.
+type AddonProfiles struct {
+	// HTTPApplicationRouting configures the HTTP application routing addon,
+	// which exposes applications through an ingress controller and a DNS
+	// zone created for the cluster. It is not meant for production use.
+	// +optional
+	HTTPApplicationRouting *AddonProfile `json:"httpApplicationRouting,omitempty"`
+
+	// Monitoring configures the monitoring (omsagent) addon. Enabling it
+	// requires LogAnalyticsWorkspaceID, which enables it by default.
+	// +optional
+	Monitoring *AddonProfile `json:"monitoring,omitempty"`
+
+	// AzurePolicy configures the Azure Policy addon, which enforces Azure
+	// Policy assignments within the cluster.
+	// +optional
+	AzurePolicy *AddonProfile `json:"azurePolicy,omitempty"`
+
+	// KubeDashboard configures the Kubernetes dashboard addon. It is not
+	// available to clusters that run Kubernetes 1.19 or later.
+	// +optional
+	KubeDashboard *AddonProfile `json:"kubeDashboard,omitempty"`
+}
+
+// An AddonProfile configures an addon of an AKS cluster.
+type AddonProfile struct {
+	// Enabled is whether the addon is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// An Identity is the managed identity used by an AKS cluster.
+type Identity struct {
+	// Type of the managed identity.
+	// +kubebuilder:validation:Enum=SystemAssigned;UserAssigned
+	Type string `json:"type"`
+
+	// UserAssignedIdentityID is the resource ID of the user-assigned managed
+	// identity, of the form /subscriptions/{subscriptionId}/resourceGroups/
+	// {resourceGroupName}/providers/Microsoft.ManagedIdentity/
+	// userAssignedIdentities/{identityName}. It is required when Type is
+	// UserAssigned.
+	// +optional
+	UserAssignedIdentityID string `json:"userAssignedIdentityID,omitempty"`
+}
+
+// An AKSClusterSpec defines the desired state of an AKSCluster.
+type AKSClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AKSClusterParameters `json:"forProvider"`
+}
+
+// AKSClusterObservation represents the observed state of an Azure Kubernetes
+// Engine cluster.
+type AKSClusterObservation struct {
+	// State is the current state of the cluster.
+	State string `json:"state,omitempty"`
+
+	// ProviderID is the external ID to identify this resource in the cloud
+	// provider.
+	ProviderID string `json:"providerID,omitempty"`
+
+	// Endpoint is the endpoint where the cluster can be reached
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// LastOperation represents the state of the last operation started by
+	// the controller, e.g. a scale or an upgrade of the cluster.
+	LastOperation apisv1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+
+	// ServicePrincipalSecretExpiry is when the secret of the cluster's
+	// service principal expires. The controller rotates the secret shortly
+	// before then.
+	ServicePrincipalSecretExpiry *metav1.Time `json:"servicePrincipalSecretExpiry,omitempty"`
+
+	// ApplicationObjectID is the object ID of the Azure AD application that
+	// the controller created for the cluster.
+	ApplicationObjectID string `json:"applicationObjectID,omitempty"`
+
+	// ApplicationID is the application (client) ID of the Azure AD
+	// application that the controller created for the cluster.
+	ApplicationID string `json:"applicationID,omitempty"`
+
+	// ServicePrincipalObjectID is the object ID of the service principal
+	// that the controller created for the cluster.
+	ServicePrincipalObjectID string `json:"servicePrincipalObjectID,omitempty"`
+}
+
+// An AKSClusterStatus represents the observed state of an AKSCluster.
+type AKSClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AKSClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AKSCluster is a managed resource that represents an Azure Kubernetes
+// Engine cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+// +kubebuilder:subresource:status
+type AKSCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AKSClusterSpec   `json:"spec"`
+	Status AKSClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AKSClusterList contains a list of AKSCluster.
+type AKSClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AKSCluster `json:"items"`
+}
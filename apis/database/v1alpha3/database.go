@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A SQLDatabaseObservation represents the observed state of a database of an
+// Azure SQL server.
+type SQLDatabaseObservation struct {
+	// ID - Resource ID
+	ID string `json:"id,omitempty"`
+
+	// Type - Resource type.
+	Type string `json:"type,omitempty"`
+}
+
+// A SQLDatabaseStatus represents the status of a database of an Azure SQL
+// server.
+type SQLDatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SQLDatabaseObservation `json:"atProvider,omitempty"`
+}
+
+// SQLDatabaseParameters define the desired state of a database of an Azure SQL
+// server.
+type SQLDatabaseParameters struct {
+	// ServerName - Name of the Database's server.
+	// +immutable
+	ServerName string `json:"serverName,omitempty"`
+
+	// ServerNameRef - A reference to the Database's server.
+	// +immutable
+	ServerNameRef *xpv1.Reference `json:"serverNameRef,omitempty"`
+
+	// ServerNameSelector - Selects a server to reference.
+	// +immutable
+	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
+
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the Database's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Charset - The charset of the database. The server's default charset is
+	// used if it is omitted.
+	// +optional
+	// +immutable
+	Charset *string `json:"charset,omitempty"`
+
+	// Collation - The collation of the database. The server's default
+	// collation is used if it is omitted.
+	// +optional
+	// +immutable
+	Collation *string `json:"collation,omitempty"`
+}
+
+// A SQLDatabaseSpec defines the desired state of a database of an Azure SQL
+// server.
+type SQLDatabaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SQLDatabaseParameters `json:"forProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MySQLDatabase is a managed resource that represents a database of an Azure
+// MySQL server. The external name of a MySQLDatabase is the name of the
+// database.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVER",type="string",JSONPath=".spec.forProvider.serverName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type MySQLDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SQLDatabaseSpec   `json:"spec"`
+	Status SQLDatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MySQLDatabaseList contains a list of MySQLDatabase.
+type MySQLDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MySQLDatabase `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PostgreSQLDatabase is a managed resource that represents a database of an
+// Azure PostgreSQL server. The external name of a PostgreSQLDatabase is the
+// name of the database.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVER",type="string",JSONPath=".spec.forProvider.serverName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type PostgreSQLDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SQLDatabaseSpec   `json:"spec"`
+	Status SQLDatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PostgreSQLDatabaseList contains a list of PostgreSQLDatabase.
+type PostgreSQLDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgreSQLDatabase `json:"items"`
+}
@@ -59,6 +59,12 @@ type FirewallRuleParameters struct {
 	// ServerNameSelector - Selects a MySQLServer to reference.
 	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
 
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
 	// ResourceGroupName - Name of the Firewall Rule's resource group.
 	ResourceGroupName string `json:"resourceGroupName,omitempty"`
 
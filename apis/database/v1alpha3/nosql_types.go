@@ -54,6 +54,12 @@ type CosmosDBAccountList struct {
 // CosmosDBAccountParameters define the desired state of an Azure CosmosDB
 // account.
 type CosmosDBAccountParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
 	// ResourceGroupName specifies the name of the resource group that should
 	// contain this Account.
 	// +immutable
@@ -98,6 +104,8 @@ type CosmosDBAccountObservation struct {
 
 	// State - current state of the account in Azure.
 	State string `json:"state"`
+	// Endpoint - The connection endpoint of the account.
+	Endpoint string `json:"endpoint,omitempty"`
 }
 
 // CosmosDBAccountProperties define the desired properties of an Azure CosmosDB account.
@@ -130,6 +138,13 @@ type CosmosDBAccountProperties struct {
 	// DB C* account
 	// + optional
 	EnableCassandraConnector *bool `json:"enableCassandraConnector,omitempty"`
+	// Capabilities - The Cosmos DB capabilities of the account. Use
+	// EnableCassandra, EnableGremlin or EnableTable to create a Cassandra,
+	// Gremlin or Table API account. Accounts of kind MongoDB serve the
+	// MongoDB API, and accounts without a capability serve the SQL API.
+	// +immutable
+	// +optional
+	Capabilities []string `json:"capabilities,omitempty"`
 }
 
 // CosmosDBAccountConsistencyPolicy the consistency policy for the Cosmos DB
@@ -180,3 +195,246 @@ type CosmosDBAccountStatus struct {
 	// + optional
 	AtProvider *CosmosDBAccountObservation `json:"atProvider,omitempty"`
 }
+
+// CosmosDBSQLDatabaseParameters define the desired state of a database of an
+// Azure CosmosDB account that serves the SQL API.
+type CosmosDBSQLDatabaseParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the account's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// AccountName - Name of the database's account.
+	// +immutable
+	// +optional
+	AccountName string `json:"accountName,omitempty"`
+
+	// AccountNameRef - A reference to a CosmosDBAccount to retrieve its name
+	// +immutable
+	// +optional
+	AccountNameRef *xpv1.Reference `json:"accountNameRef,omitempty"`
+
+	// AccountNameSelector - Selects a CosmosDBAccount to reference.
+	// +immutable
+	// +optional
+	AccountNameSelector *xpv1.Selector `json:"accountNameSelector,omitempty"`
+
+	// Throughput - The throughput of the database in request units per
+	// second, which its containers share. Containers provision their own
+	// throughput if it is omitted. Changing it scales the database in place.
+	// +kubebuilder:validation:Minimum=400
+	// +optional
+	Throughput *int32 `json:"throughput,omitempty"`
+}
+
+// CosmosDBSQLDatabaseObservation represents the observed state of a database
+// of an Azure CosmosDB account.
+type CosmosDBSQLDatabaseObservation struct {
+	// ID - Fully qualified resource identifier of the database.
+	ID string `json:"id,omitempty"`
+
+	// Throughput - The provisioned throughput of the database.
+	Throughput *int32 `json:"throughput,omitempty"`
+}
+
+// A CosmosDBSQLDatabaseSpec defines the desired state of a
+// CosmosDBSQLDatabase.
+type CosmosDBSQLDatabaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CosmosDBSQLDatabaseParameters `json:"forProvider"`
+}
+
+// A CosmosDBSQLDatabaseStatus represents the observed state of a
+// CosmosDBSQLDatabase.
+type CosmosDBSQLDatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CosmosDBSQLDatabaseObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CosmosDBSQLDatabase is a managed resource that represents a database of
+// an Azure CosmosDB account that serves the SQL API. The external name of a
+// CosmosDBSQLDatabase is the name of the database.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".spec.forProvider.accountName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type CosmosDBSQLDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CosmosDBSQLDatabaseSpec   `json:"spec"`
+	Status CosmosDBSQLDatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CosmosDBSQLDatabaseList contains a list of CosmosDBSQLDatabase.
+type CosmosDBSQLDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CosmosDBSQLDatabase `json:"items"`
+}
+
+// A CosmosDBSQLContainerPartitionKey is the key that the items of a
+// container are partitioned by.
+type CosmosDBSQLContainerPartitionKey struct {
+	// Paths - The paths of the key within the items of the container, e.g.
+	// /tenantId. Only one path is supported.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=1
+	Paths []string `json:"paths"`
+
+	// Kind - The algorithm used to partition the items. Defaults to Hash.
+	// +kubebuilder:validation:Enum=Hash;Range
+	// +optional
+	Kind *string `json:"kind,omitempty"`
+}
+
+// CosmosDBSQLContainerParameters define the desired state of a container of
+// a database of an Azure CosmosDB account that serves the SQL API.
+type CosmosDBSQLContainerParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the account's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// AccountName - Name of the account of the container's database.
+	// +immutable
+	// +optional
+	AccountName string `json:"accountName,omitempty"`
+
+	// AccountNameRef - A reference to a CosmosDBAccount to retrieve its name
+	// +immutable
+	// +optional
+	AccountNameRef *xpv1.Reference `json:"accountNameRef,omitempty"`
+
+	// AccountNameSelector - Selects a CosmosDBAccount to reference.
+	// +immutable
+	// +optional
+	AccountNameSelector *xpv1.Selector `json:"accountNameSelector,omitempty"`
+
+	// DatabaseName - Name of the container's database.
+	// +immutable
+	// +optional
+	DatabaseName string `json:"databaseName,omitempty"`
+
+	// DatabaseNameRef - A reference to a CosmosDBSQLDatabase to retrieve its
+	// name
+	// +immutable
+	// +optional
+	DatabaseNameRef *xpv1.Reference `json:"databaseNameRef,omitempty"`
+
+	// DatabaseNameSelector - Selects a CosmosDBSQLDatabase to reference.
+	// +immutable
+	// +optional
+	DatabaseNameSelector *xpv1.Selector `json:"databaseNameSelector,omitempty"`
+
+	// PartitionKey - The key that the items of the container are
+	// partitioned by.
+	// +immutable
+	PartitionKey CosmosDBSQLContainerPartitionKey `json:"partitionKey"`
+
+	// DefaultTTL - The time to live of the items of the container in
+	// seconds. Items never expire if it is -1, and unless they set their own
+	// time to live if it is omitted.
+	// +kubebuilder:validation:Minimum=-1
+	// +optional
+	DefaultTTL *int32 `json:"defaultTTL,omitempty"`
+
+	// Throughput - The throughput of the container in request units per
+	// second. The container shares the throughput of its database if it is
+	// omitted. Changing it scales the container in place.
+	// +kubebuilder:validation:Minimum=400
+	// +optional
+	Throughput *int32 `json:"throughput,omitempty"`
+}
+
+// CosmosDBSQLContainerObservation represents the observed state of a
+// container of a database of an Azure CosmosDB account.
+type CosmosDBSQLContainerObservation struct {
+	// ID - Fully qualified resource identifier of the container.
+	ID string `json:"id,omitempty"`
+
+	// Throughput - The provisioned throughput of the container.
+	Throughput *int32 `json:"throughput,omitempty"`
+}
+
+// A CosmosDBSQLContainerSpec defines the desired state of a
+// CosmosDBSQLContainer.
+type CosmosDBSQLContainerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CosmosDBSQLContainerParameters `json:"forProvider"`
+}
+
+// A CosmosDBSQLContainerStatus represents the observed state of a
+// CosmosDBSQLContainer.
+type CosmosDBSQLContainerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CosmosDBSQLContainerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CosmosDBSQLContainer is a managed resource that represents a container of
+// a database of an Azure CosmosDB account that serves the SQL API. The
+// external name of a CosmosDBSQLContainer is the name of the container.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DATABASE",type="string",JSONPath=".spec.forProvider.databaseName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type CosmosDBSQLContainer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CosmosDBSQLContainerSpec   `json:"spec"`
+	Status CosmosDBSQLContainerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CosmosDBSQLContainerList contains a list of CosmosDBSQLContainer.
+type CosmosDBSQLContainerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CosmosDBSQLContainer `json:"items"`
+}
@@ -69,6 +69,22 @@ var (
 	PostgreSQLServerFirewallRuleGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLServerFirewallRuleKind)
 )
 
+// MySQLDatabase type metadata.
+var (
+	MySQLDatabaseKind             = reflect.TypeOf(MySQLDatabase{}).Name()
+	MySQLDatabaseGroupKind        = schema.GroupKind{Group: Group, Kind: MySQLDatabaseKind}.String()
+	MySQLDatabaseKindAPIVersion   = MySQLDatabaseKind + "." + SchemeGroupVersion.String()
+	MySQLDatabaseGroupVersionKind = SchemeGroupVersion.WithKind(MySQLDatabaseKind)
+)
+
+// PostgreSQLDatabase type metadata.
+var (
+	PostgreSQLDatabaseKind             = reflect.TypeOf(PostgreSQLDatabase{}).Name()
+	PostgreSQLDatabaseGroupKind        = schema.GroupKind{Group: Group, Kind: PostgreSQLDatabaseKind}.String()
+	PostgreSQLDatabaseKindAPIVersion   = PostgreSQLDatabaseKind + "." + SchemeGroupVersion.String()
+	PostgreSQLDatabaseGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLDatabaseKind)
+)
+
 // CosmosDBAccount type metadata.
 var (
 	CosmosDBAccountKind             = reflect.TypeOf(CosmosDBAccount{}).Name()
@@ -77,10 +93,30 @@ var (
 	CosmosDBAccountGroupVersionKind = SchemeGroupVersion.WithKind(CosmosDBAccountKind)
 )
 
+// CosmosDBSQLDatabase type metadata.
+var (
+	CosmosDBSQLDatabaseKind             = reflect.TypeOf(CosmosDBSQLDatabase{}).Name()
+	CosmosDBSQLDatabaseGroupKind        = schema.GroupKind{Group: Group, Kind: CosmosDBSQLDatabaseKind}.String()
+	CosmosDBSQLDatabaseKindAPIVersion   = CosmosDBSQLDatabaseKind + "." + SchemeGroupVersion.String()
+	CosmosDBSQLDatabaseGroupVersionKind = SchemeGroupVersion.WithKind(CosmosDBSQLDatabaseKind)
+)
+
+// CosmosDBSQLContainer type metadata.
+var (
+	CosmosDBSQLContainerKind             = reflect.TypeOf(CosmosDBSQLContainer{}).Name()
+	CosmosDBSQLContainerGroupKind        = schema.GroupKind{Group: Group, Kind: CosmosDBSQLContainerKind}.String()
+	CosmosDBSQLContainerKindAPIVersion   = CosmosDBSQLContainerKind + "." + SchemeGroupVersion.String()
+	CosmosDBSQLContainerGroupVersionKind = SchemeGroupVersion.WithKind(CosmosDBSQLContainerKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&MySQLServerVirtualNetworkRule{}, &MySQLServerVirtualNetworkRuleList{})
 	SchemeBuilder.Register(&PostgreSQLServerVirtualNetworkRule{}, &PostgreSQLServerVirtualNetworkRuleList{})
 	SchemeBuilder.Register(&MySQLServerFirewallRule{}, &MySQLServerFirewallRuleList{})
 	SchemeBuilder.Register(&PostgreSQLServerFirewallRule{}, &PostgreSQLServerFirewallRuleList{})
+	SchemeBuilder.Register(&MySQLDatabase{}, &MySQLDatabaseList{})
+	SchemeBuilder.Register(&PostgreSQLDatabase{}, &PostgreSQLDatabaseList{})
 	SchemeBuilder.Register(&CosmosDBAccount{}, &CosmosDBAccountList{})
+	SchemeBuilder.Register(&CosmosDBSQLDatabase{}, &CosmosDBSQLDatabaseList{})
+	SchemeBuilder.Register(&CosmosDBSQLContainer{}, &CosmosDBSQLContainerList{})
 }
@@ -82,6 +82,12 @@ type PostgreSQLVirtualNetworkRuleSpec struct {
 	// PostgreSQLServer.
 	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
 
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
 	// ResourceGroupName - Name of the Virtual Network Rule's resource group.
 	ResourceGroupName string `json:"resourceGroupName,omitempty"`
 
@@ -137,6 +143,12 @@ type MySQLVirtualNetworkRuleSpec struct {
 	// ServerNameSelector - Selects a MySQLServer to reference.
 	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
 
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
 	// ResourceGroupName - Name of the Virtual Network Rule's resource group.
 	ResourceGroupName string `json:"resourceGroupName,omitempty"`
 
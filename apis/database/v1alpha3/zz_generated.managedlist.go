@@ -29,6 +29,33 @@ func (l *CosmosDBAccountList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this CosmosDBSQLContainerList.
+func (l *CosmosDBSQLContainerList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this CosmosDBSQLDatabaseList.
+func (l *CosmosDBSQLDatabaseList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this MySQLDatabaseList.
+func (l *MySQLDatabaseList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this MySQLServerFirewallRuleList.
 func (l *MySQLServerFirewallRuleList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -47,6 +74,15 @@ func (l *MySQLServerVirtualNetworkRuleList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this PostgreSQLDatabaseList.
+func (l *PostgreSQLDatabaseList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this PostgreSQLServerFirewallRuleList.
 func (l *PostgreSQLServerFirewallRuleList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -81,6 +81,12 @@ type MySQLServerConfigurationList struct {
 // SQLServerConfigurationParameters define the desired state of an Azure SQL
 // Database Server Configuration, either PostgreSQL or MySQL Configuration.
 type SQLServerConfigurationParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
 	// ResourceGroupName specifies the name of the resource group that should
 	// contain this SQLServer.
 	// +immutable
@@ -23,10 +23,25 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 )
 
+// SQLServerID extracts the resource ID of a MySQLServer or PostgreSQLServer.
+func SQLServerID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		switch s := mg.(type) {
+		case *MySQLServer:
+			return s.Status.AtProvider.ID
+		case *PostgreSQLServer:
+			return s.Status.AtProvider.ID
+		default:
+			return ""
+		}
+	}
+}
+
 // ResolveReferences of this MySQLServer.
 func (mg *MySQLServer) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -45,6 +60,19 @@ func (mg *MySQLServer) ResolveReferences(ctx context.Context, c client.Reader) e
 	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
 	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.sourceServerID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceServerID),
+		Reference:    mg.Spec.ForProvider.SourceServerIDRef,
+		Selector:     mg.Spec.ForProvider.SourceServerIDSelector,
+		To:           reference.To{Managed: &MySQLServer{}, List: &MySQLServerList{}},
+		Extract:      SQLServerID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceServerID")
+	}
+	mg.Spec.ForProvider.SourceServerID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceServerIDRef = rsp.ResolvedReference
 	return nil
 }
 
@@ -101,6 +129,19 @@ func (mg *PostgreSQLServer) ResolveReferences(ctx context.Context, c client.Read
 	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
 	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.sourceServerID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceServerID),
+		Reference:    mg.Spec.ForProvider.SourceServerIDRef,
+		Selector:     mg.Spec.ForProvider.SourceServerIDSelector,
+		To:           reference.To{Managed: &PostgreSQLServer{}, List: &PostgreSQLServerList{}},
+		Extract:      SQLServerID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceServerID")
+	}
+	mg.Spec.ForProvider.SourceServerID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceServerIDRef = rsp.ResolvedReference
 	return nil
 }
 
@@ -34,6 +34,9 @@ const (
 // PostgreSQLServerPort is the port PostgreSQLServer listens to.
 const PostgreSQLServerPort = "5432"
 
+// MySQLServerPort is the port MySQLServer listens to.
+const MySQLServerPort = "3306"
+
 // +kubebuilder:object:root=true
 
 // A MySQLServer is a managed resource that represents an Azure MySQL Database
@@ -118,10 +121,12 @@ type StorageProfile struct {
 	// +optional
 	GeoRedundantBackup *string `json:"geoRedundantBackup,omitempty"`
 
-	// StorageMB - Max storage allowed for a server.
+	// StorageMB - Max storage allowed for a server. It may be increased
+	// after creation, but not decreased.
 	StorageMB int `json:"storageMB"`
 
-	// StorageAutogrow - Enable Storage Auto Grow.
+	// StorageAutogrow - Enable Storage Auto Grow. Storage that has grown
+	// past StorageMB is kept.
 	// Possible values include: 'Enabled', 'Disabled'
 	// +kubebuilder:validation:Enum=Enabled;Disabled
 	// +optional
@@ -131,6 +136,12 @@ type StorageProfile struct {
 // SQLServerParameters define the desired state of an Azure SQL Database, either
 // PostgreSQL or MySQL.
 type SQLServerParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
 	// ResourceGroupName specifies the name of the resource group that should
 	// contain this SQLServer.
 	// +immutable
@@ -157,7 +168,13 @@ type SQLServerParameters struct {
 	// +immutable
 	AdministratorLogin string `json:"administratorLogin"`
 
-	// TODO(hasheddan): support AdministratorLoginPassword
+	// AdministratorLoginPasswordSecretRef references the key of a Kubernetes
+	// secret that contains the administrator's password. A random password is
+	// generated if it is omitted. Changes to the referenced password are
+	// applied to the server, which is never subject to credential rotation
+	// when it references a password.
+	// +optional
+	AdministratorLoginPasswordSecretRef *xpv1.SecretKeySelector `json:"administratorLoginPasswordSecretRef,omitempty"`
 
 	// MinimalTLSVersion - control TLS connection policy
 	MinimalTLSVersion string `json:"minimalTlsVersion,omitempty"`
@@ -183,6 +200,24 @@ type SQLServerParameters struct {
 	// +optional
 	SourceServerID *string `json:"sourceServerID,omitempty"`
 
+	// SourceServerIDRef - A reference to the server to restore from when
+	// restoring or creating replicas. It must be of the same kind as this
+	// server.
+	// +optional
+	SourceServerIDRef *xpv1.Reference `json:"sourceServerIDRef,omitempty"`
+
+	// SourceServerIDSelector - Selects the server to restore from when
+	// restoring or creating replicas. It must be of the same kind as this
+	// server.
+	// +optional
+	SourceServerIDSelector *xpv1.Selector `json:"sourceServerIDSelector,omitempty"`
+
+	// PromoteReplica stops the replication of a replica server from its
+	// source server, turning it into a standalone read-write server. A
+	// promoted server cannot become a replica again.
+	// +optional
+	PromoteReplica bool `json:"promoteReplica,omitempty"`
+
 	// Tags - Application-specific metadata in the form of key-value pairs.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
@@ -196,6 +231,19 @@ type SQLServerParameters struct {
 
 	// StorageProfile - Storage profile of a server.
 	StorageProfile StorageProfile `json:"storageProfile"`
+
+	// CredentialRotation configures the periodic regeneration of the
+	// administrator password of this server. The password can also be
+	// regenerated on demand using the azure.crossplane.io/rotate-credentials
+	// annotation. A new password is only written to the connection secret once
+	// Azure has confirmed that it was applied.
+	// +optional
+	CredentialRotation *apisv1alpha3.CredentialRotationPolicy `json:"credentialRotation,omitempty"`
+
+	// ConnectionSecretFormat customizes the keys written to the connection
+	// secret of this server, e.g. to add connection strings.
+	// +optional
+	ConnectionSecretFormat *apisv1alpha3.ConnectionSecretFormat `json:"connectionSecretFormat,omitempty"`
 }
 
 // CreateMode controls the creation behaviour
@@ -237,6 +285,14 @@ type SQLServerObservation struct {
 	// MasterServerID - The master server id of a replica server.
 	MasterServerID string `json:"masterServerId,omitempty"`
 
+	// ReplicationRole - The replication role of the server, i.e. None,
+	// Master or Replica.
+	ReplicationRole string `json:"replicationRole,omitempty"`
+
+	// StorageMB - The storage of the server. It may exceed the desired
+	// storage if storage auto-grow is enabled.
+	StorageMB int `json:"storageMB,omitempty"`
+
 	// LastOperation represents the state of the last operation started by the
 	// controller.
 	LastOperation apisv1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
@@ -246,4 +302,8 @@ type SQLServerObservation struct {
 type SQLServerStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          SQLServerObservation `json:"atProvider,omitempty"`
+
+	// CredentialRotation represents the state of the administrator password
+	// rotation of this server.
+	CredentialRotation apisv1alpha3.CredentialRotationStatus `json:"credentialRotation,omitempty"`
 }
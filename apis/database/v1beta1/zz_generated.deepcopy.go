@@ -22,6 +22,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -301,6 +302,11 @@ func (in *SQLServerConfigurationObservation) DeepCopy() *SQLServerConfigurationO
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SQLServerConfigurationParameters) DeepCopyInto(out *SQLServerConfigurationParameters) {
 	*out = *in
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
 	if in.ResourceGroupNameRef != nil {
 		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
 		*out = new(v1.Reference)
@@ -391,6 +397,11 @@ func (in *SQLServerObservation) DeepCopy() *SQLServerObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SQLServerParameters) DeepCopyInto(out *SQLServerParameters) {
 	*out = *in
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
 	if in.ResourceGroupNameRef != nil {
 		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
 		*out = new(v1.Reference)
@@ -402,6 +413,11 @@ func (in *SQLServerParameters) DeepCopyInto(out *SQLServerParameters) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.SKU.DeepCopyInto(&out.SKU)
+	if in.AdministratorLoginPasswordSecretRef != nil {
+		in, out := &in.AdministratorLoginPasswordSecretRef, &out.AdministratorLoginPasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 	if in.PublicNetworkAccess != nil {
 		in, out := &in.PublicNetworkAccess, &out.PublicNetworkAccess
 		*out = new(string)
@@ -421,6 +437,16 @@ func (in *SQLServerParameters) DeepCopyInto(out *SQLServerParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SourceServerIDRef != nil {
+		in, out := &in.SourceServerIDRef, &out.SourceServerIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SourceServerIDSelector != nil {
+		in, out := &in.SourceServerIDSelector, &out.SourceServerIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -429,6 +455,16 @@ func (in *SQLServerParameters) DeepCopyInto(out *SQLServerParameters) {
 		}
 	}
 	in.StorageProfile.DeepCopyInto(&out.StorageProfile)
+	if in.CredentialRotation != nil {
+		in, out := &in.CredentialRotation, &out.CredentialRotation
+		*out = new(v1alpha3.CredentialRotationPolicy)
+		**out = **in
+	}
+	if in.ConnectionSecretFormat != nil {
+		in, out := &in.ConnectionSecretFormat, &out.ConnectionSecretFormat
+		*out = new(v1alpha3.ConnectionSecretFormat)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLServerParameters.
@@ -463,6 +499,7 @@ func (in *SQLServerStatus) DeepCopyInto(out *SQLServerStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtProvider = in.AtProvider
+	in.CredentialRotation.DeepCopyInto(&out.CredentialRotation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLServerStatus.
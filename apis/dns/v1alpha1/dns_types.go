@@ -24,6 +24,12 @@ import (
 
 // ZoneParameters define the desired state of an Azure DNS Zone.
 type ZoneParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
 	// ResourceGroupName specifies the name of the resource group that should
 	// contain this DNS Zone.
 	// +immutable
@@ -143,6 +149,12 @@ const (
 
 // RecordSetParameters define the desired state of an Azure DNS RecordSet.
 type RecordSetParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
 	// ResourceGroupName specifies the name of the resource group that should
 	// contain this DNS Zone.
 	// +immutable
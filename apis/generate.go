@@ -19,12 +19,19 @@ limitations under the License.
 // NOTE(negz): See the below link for details on what is happening here.
 // https://github.com/golang/go/wiki/Modules#how-can-i-track-tool-dependencies-for-a-module
 
-// Remove existing CRDs
-//go:generate rm -rf ../package/crds
+// Remove existing CRDs and webhook configurations
+//go:generate rm -rf ../package/crds ../package/webhookconfigurations
 
 // Generate deepcopy methodsets and CRD manifests
 //go:generate go run -tags generate sigs.k8s.io/controller-tools/cmd/controller-gen object:headerFile=../hack/boilerplate.go.txt paths=./... crd:crdVersions=v1 output:artifacts:config=../package/crds
 
+// Convert between the versions of CRDs that have more than one using the
+// conversion webhook. controller-gen does not yet support generating this.
+//go:generate sed -i -e "s/^  group:/  conversion:\\n    strategy: Webhook\\n    webhook:\\n      conversionReviewVersions:\\n      - v1\\n  group:/" ../package/crds/compute.azure.crossplane.io_aksclusters.yaml ../package/crds/sql.azure.crossplane.io_sqlservers.yaml
+
+// Generate webhook configurations
+//go:generate go run -tags generate sigs.k8s.io/controller-tools/cmd/controller-gen webhook paths=../pkg/webhook/... output:artifacts:config=../package/webhookconfigurations
+
 // Generate crossplane-runtime methodsets (resource.Claim, etc)
 //go:generate go run -tags generate github.com/crossplane/crossplane-tools/cmd/angryjet generate-methodsets --header-file=../hack/boilerplate.go.txt ./...
 
@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ApplicationInsightsParameters defines the desired state of an Azure
+// Application Insights component.
+// https://docs.microsoft.com/en-us/rest/api/application-insights/components
+type ApplicationInsightsParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the component's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The Azure location the component is created in.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	Location string `json:"location"`
+
+	// Kind - The kind of application the component monitors, e.g. web, ios,
+	// java or other. It is only used to customize the Azure portal.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	Kind string `json:"kind"`
+
+	// ApplicationType - The type of application being monitored.
+	// +kubebuilder:validation:Enum=web;other
+	// +immutable
+	ApplicationType string `json:"applicationType"`
+
+	// WorkspaceResourceID - The resource ID of the Log Analytics workspace
+	// that the component's telemetry is ingested into.
+	// +optional
+	WorkspaceResourceID string `json:"workspaceResourceId,omitempty"`
+
+	// WorkspaceResourceIDRef - A reference to a LogAnalyticsWorkspace to
+	// retrieve its resource ID.
+	// +optional
+	WorkspaceResourceIDRef *xpv1.Reference `json:"workspaceResourceIdRef,omitempty"`
+
+	// WorkspaceResourceIDSelector - Selects a LogAnalyticsWorkspace to
+	// reference.
+	// +optional
+	WorkspaceResourceIDSelector *xpv1.Selector `json:"workspaceResourceIdSelector,omitempty"`
+
+	// RetentionInDays - The number of days telemetry is retained for.
+	// +kubebuilder:validation:Enum=30;60;90;120;180;270;365;550;730
+	// +optional
+	RetentionInDays *int32 `json:"retentionInDays,omitempty"`
+
+	// DisableIPMasking - Whether the client IP addresses of the telemetry are
+	// stored rather than masked.
+	// +optional
+	DisableIPMasking *bool `json:"disableIpMasking,omitempty"`
+
+	// DisableLocalAuth - Whether telemetry may only be sent using Azure
+	// Active Directory authentication rather than the instrumentation key.
+	// +optional
+	DisableLocalAuth *bool `json:"disableLocalAuth,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An ApplicationInsightsSpec defines the desired state of an
+// ApplicationInsights.
+type ApplicationInsightsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ApplicationInsightsParameters `json:"forProvider"`
+}
+
+// ApplicationInsightsObservation represents the observed state of the
+// Application Insights component in Azure.
+type ApplicationInsightsObservation struct {
+	// ID - Fully qualified resource identifier of the component.
+	ID string `json:"id,omitempty"`
+
+	// AppID - The unique ID of the application, which is used to query its
+	// telemetry.
+	AppID string `json:"appId,omitempty"`
+
+	// ProvisioningState - The provisioning state of the component.
+	ProvisioningState string `json:"provisioningState,omitempty"`
+}
+
+// An ApplicationInsightsStatus represents the observed state of an
+// ApplicationInsights.
+type ApplicationInsightsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApplicationInsightsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ApplicationInsights is a managed resource that represents an Azure
+// Application Insights component. Its instrumentation key and connection
+// string are written to its connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="APP-ID",type="string",JSONPath=".status.atProvider.appId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type ApplicationInsights struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationInsightsSpec   `json:"spec"`
+	Status ApplicationInsightsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationInsightsList contains a list of ApplicationInsights.
+type ApplicationInsightsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApplicationInsights `json:"items"`
+}
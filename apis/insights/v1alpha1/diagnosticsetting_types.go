@@ -0,0 +1,210 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DiagnosticLogSettings enable a category of platform logs.
+type DiagnosticLogSettings struct {
+	// Category - The name of the log category, e.g. AuditEvent. The
+	// available categories depend on the type of the resource.
+	// +kubebuilder:validation:MinLength:=1
+	Category string `json:"category"`
+
+	// Enabled - Whether the logs of the category are routed.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RetentionDays - The number of days the logs are retained for in the
+	// storage account destination. They are retained indefinitely if
+	// omitted or 0.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=365
+	// +optional
+	RetentionDays *int32 `json:"retentionDays,omitempty"`
+}
+
+// DiagnosticMetricSettings enable a category of platform metrics.
+type DiagnosticMetricSettings struct {
+	// Category - The name of the metric category. Most resources only
+	// support AllMetrics.
+	// +kubebuilder:validation:MinLength:=1
+	Category string `json:"category"`
+
+	// Enabled - Whether the metrics of the category are routed.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RetentionDays - The number of days the metrics are retained for in the
+	// storage account destination. They are retained indefinitely if
+	// omitted or 0.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=365
+	// +optional
+	RetentionDays *int32 `json:"retentionDays,omitempty"`
+}
+
+// DiagnosticSettingParameters defines the desired state of an Azure
+// diagnostic setting.
+// https://docs.microsoft.com/en-us/rest/api/monitor/diagnostic-settings
+type DiagnosticSettingParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceID - The ID of the resource whose logs and metrics are routed.
+	// +immutable
+	// +optional
+	ResourceID string `json:"resourceID,omitempty"`
+
+	// ResourceRef - A reference to the managed resource whose logs and
+	// metrics are routed, of any kind of this provider.
+	// +immutable
+	// +optional
+	ResourceRef *xpv1.TypedReference `json:"resourceRef,omitempty"`
+
+	// WorkspaceID - The ID of the Log Analytics workspace the logs and
+	// metrics are sent to.
+	// +optional
+	WorkspaceID *string `json:"workspaceID,omitempty"`
+
+	// WorkspaceIDRef - A reference to a LogAnalyticsWorkspace to retrieve
+	// its ID.
+	// +optional
+	WorkspaceIDRef *xpv1.Reference `json:"workspaceIDRef,omitempty"`
+
+	// WorkspaceIDSelector - Selects a reference to a LogAnalyticsWorkspace
+	// to retrieve its ID.
+	// +optional
+	WorkspaceIDSelector *xpv1.Selector `json:"workspaceIDSelector,omitempty"`
+
+	// LogAnalyticsDestinationType - Whether logs are sent to the legacy
+	// AzureDiagnostics table of the workspace, or to resource specific
+	// tables (Dedicated). Only some resources support the latter.
+	// +kubebuilder:validation:Enum=AzureDiagnostics;Dedicated
+	// +optional
+	LogAnalyticsDestinationType *string `json:"logAnalyticsDestinationType,omitempty"`
+
+	// StorageAccountID - The ID of the storage account the logs and metrics
+	// are archived to.
+	// +optional
+	StorageAccountID *string `json:"storageAccountID,omitempty"`
+
+	// StorageAccountIDRef - A reference to a storage Account to retrieve its
+	// ID.
+	// +optional
+	StorageAccountIDRef *xpv1.Reference `json:"storageAccountIDRef,omitempty"`
+
+	// StorageAccountIDSelector - Selects a reference to a storage Account to
+	// retrieve its ID.
+	// +optional
+	StorageAccountIDSelector *xpv1.Selector `json:"storageAccountIDSelector,omitempty"`
+
+	// EventHubAuthorizationRuleID - The ID of the Event Hub namespace
+	// authorization rule used to stream the logs and metrics to an Event
+	// Hub.
+	// +optional
+	EventHubAuthorizationRuleID *string `json:"eventHubAuthorizationRuleID,omitempty"`
+
+	// EventHubNamespaceRef - A reference to an EventHubNamespace whose
+	// RootManageSharedAccessKey authorization rule is used to stream the
+	// logs and metrics.
+	// +optional
+	EventHubNamespaceRef *xpv1.Reference `json:"eventHubNamespaceRef,omitempty"`
+
+	// EventHubNamespaceSelector - Selects a reference to an
+	// EventHubNamespace whose RootManageSharedAccessKey authorization rule is
+	// used to stream the logs and metrics.
+	// +optional
+	EventHubNamespaceSelector *xpv1.Selector `json:"eventHubNamespaceSelector,omitempty"`
+
+	// EventHubName - The name of the Event Hub the logs and metrics are
+	// streamed to. An Event Hub is created for each log category if
+	// omitted.
+	// +optional
+	EventHubName *string `json:"eventHubName,omitempty"`
+
+	// EventHubNameRef - A reference to an EventHub to retrieve its name.
+	// +optional
+	EventHubNameRef *xpv1.Reference `json:"eventHubNameRef,omitempty"`
+
+	// EventHubNameSelector - Selects a reference to an EventHub to retrieve
+	// its name.
+	// +optional
+	EventHubNameSelector *xpv1.Selector `json:"eventHubNameSelector,omitempty"`
+
+	// Logs - The categories of platform logs that are routed.
+	// +optional
+	Logs []DiagnosticLogSettings `json:"logs,omitempty"`
+
+	// Metrics - The categories of platform metrics that are routed.
+	// +optional
+	Metrics []DiagnosticMetricSettings `json:"metrics,omitempty"`
+}
+
+// A DiagnosticSettingSpec defines the desired state of a DiagnosticSetting.
+type DiagnosticSettingSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DiagnosticSettingParameters `json:"forProvider"`
+}
+
+// DiagnosticSettingObservation represents the observed state of the
+// diagnostic setting in Azure.
+type DiagnosticSettingObservation struct {
+	// ID - Fully qualified resource identifier of the diagnostic setting.
+	ID string `json:"id,omitempty"`
+}
+
+// A DiagnosticSettingStatus represents the observed state of a
+// DiagnosticSetting.
+type DiagnosticSettingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DiagnosticSettingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DiagnosticSetting is a managed resource that represents an Azure
+// diagnostic setting, which routes the platform logs and metrics of a
+// resource to a Log Analytics workspace, an Event Hub or a storage account.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type DiagnosticSetting struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DiagnosticSettingSpec   `json:"spec"`
+	Status DiagnosticSettingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DiagnosticSettingList contains a list of DiagnosticSetting.
+type DiagnosticSettingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DiagnosticSetting `json:"items"`
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// LogAnalyticsWorkspaceParameters defines the desired state of an Azure Log
+// Analytics workspace.
+// https://docs.microsoft.com/en-us/rest/api/loganalytics/workspaces
+type LogAnalyticsWorkspaceParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the workspace's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The Azure location the workspace is created in.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	Location string `json:"location"`
+
+	// SKUName - The pricing tier of the workspace. Defaults to PerGB2018.
+	// +kubebuilder:validation:Enum=Free;Standard;Premium;PerNode;PerGB2018;Standalone;CapacityReservation
+	// +optional
+	SKUName *string `json:"skuName,omitempty"`
+
+	// CapacityReservationLevel - The capacity reservation level in GB per
+	// day, when the CapacityReservation SKU is used.
+	// +optional
+	CapacityReservationLevel *int32 `json:"capacityReservationLevel,omitempty"`
+
+	// RetentionInDays - The number of days data is retained for.
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=730
+	// +optional
+	RetentionInDays *int32 `json:"retentionInDays,omitempty"`
+
+	// PublicNetworkAccessForIngestion - Whether data can be ingested over
+	// the public network.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	PublicNetworkAccessForIngestion *string `json:"publicNetworkAccessForIngestion,omitempty"`
+
+	// PublicNetworkAccessForQuery - Whether data can be queried over the
+	// public network.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	PublicNetworkAccessForQuery *string `json:"publicNetworkAccessForQuery,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A LogAnalyticsWorkspaceSpec defines the desired state of a
+// LogAnalyticsWorkspace.
+type LogAnalyticsWorkspaceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LogAnalyticsWorkspaceParameters `json:"forProvider"`
+}
+
+// LogAnalyticsWorkspaceObservation represents the observed state of the Log
+// Analytics workspace in Azure.
+type LogAnalyticsWorkspaceObservation struct {
+	// ID - Fully qualified resource identifier of the workspace.
+	ID string `json:"id,omitempty"`
+
+	// CustomerID - The workspace ID that agents use to send data to the
+	// workspace.
+	CustomerID string `json:"customerId,omitempty"`
+
+	// ProvisioningState - The provisioning state of the workspace.
+	ProvisioningState string `json:"provisioningState,omitempty"`
+}
+
+// A LogAnalyticsWorkspaceStatus represents the observed state of a
+// LogAnalyticsWorkspace.
+type LogAnalyticsWorkspaceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LogAnalyticsWorkspaceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LogAnalyticsWorkspace is a managed resource that represents an Azure Log
+// Analytics workspace. The workspace ID and its shared keys are written to
+// its connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.provisioningState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type LogAnalyticsWorkspace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LogAnalyticsWorkspaceSpec   `json:"spec"`
+	Status LogAnalyticsWorkspaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LogAnalyticsWorkspaceList contains a list of LogAnalyticsWorkspace.
+type LogAnalyticsWorkspaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogAnalyticsWorkspace `json:"items"`
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	messagingv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/messaging/v1alpha1"
+	storagev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+const (
+	errGetReferencedResource = "cannot get referenced resource"
+	errNoReferencedID        = "referenced resource does not report its ID yet"
+)
+
+// The paths at which managed resources report their Azure resource ID. Most
+// resources report it alongside their other observed fields, while older ones
+// report it directly in their status.
+var fieldPathsResourceID = []string{"status.atProvider.id", "status.id"}
+
+// LogAnalyticsWorkspaceID extracts status.atProvider.id from the supplied
+// managed resource, which must be a LogAnalyticsWorkspace.
+func LogAnalyticsWorkspaceID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		w, ok := mg.(*LogAnalyticsWorkspace)
+		if !ok {
+			return ""
+		}
+		return w.Status.AtProvider.ID
+	}
+}
+
+// ResolveReferences of this LogAnalyticsWorkspace
+func (mg *LogAnalyticsWorkspace) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ApplicationInsights
+func (mg *ApplicationInsights) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.workspaceResourceId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.WorkspaceResourceID,
+		Reference:    mg.Spec.ForProvider.WorkspaceResourceIDRef,
+		Selector:     mg.Spec.ForProvider.WorkspaceResourceIDSelector,
+		To:           reference.To{Managed: &LogAnalyticsWorkspace{}, List: &LogAnalyticsWorkspaceList{}},
+		Extract:      LogAnalyticsWorkspaceID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.workspaceResourceId")
+	}
+	mg.Spec.ForProvider.WorkspaceResourceID = rsp.ResolvedValue
+	mg.Spec.ForProvider.WorkspaceResourceIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// resolveResourceID returns the Azure resource ID reported by the managed
+// resource the supplied reference points to, which may be of any kind.
+func resolveResourceID(ctx context.Context, c client.Reader, ref *xpv1.TypedReference) (string, error) {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion(ref.APIVersion)
+	u.SetKind(ref.Kind)
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, u); err != nil {
+		return "", errors.Wrap(err, errGetReferencedResource)
+	}
+	p := fieldpath.Pave(u.Object)
+	for _, fp := range fieldPathsResourceID {
+		id, err := p.GetString(fp)
+		if fieldpath.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return "", errors.Wrap(err, errGetReferencedResource)
+		}
+		if id != "" {
+			return id, nil
+		}
+	}
+	return "", errors.New(errNoReferencedID)
+}
+
+// ResolveReferences of this DiagnosticSetting
+func (mg *DiagnosticSetting) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceID
+	if mg.Spec.ForProvider.ResourceID == "" && mg.Spec.ForProvider.ResourceRef != nil {
+		id, err := resolveResourceID(ctx, c, mg.Spec.ForProvider.ResourceRef)
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.resourceID")
+		}
+		mg.Spec.ForProvider.ResourceID = id
+	}
+
+	// Resolve spec.forProvider.workspaceID
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.WorkspaceID),
+		Reference:    mg.Spec.ForProvider.WorkspaceIDRef,
+		Selector:     mg.Spec.ForProvider.WorkspaceIDSelector,
+		To:           reference.To{Managed: &LogAnalyticsWorkspace{}, List: &LogAnalyticsWorkspaceList{}},
+		Extract:      LogAnalyticsWorkspaceID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.workspaceID")
+	}
+	mg.Spec.ForProvider.WorkspaceID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.WorkspaceIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.storageAccountID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.StorageAccountID),
+		Reference:    mg.Spec.ForProvider.StorageAccountIDRef,
+		Selector:     mg.Spec.ForProvider.StorageAccountIDSelector,
+		To:           reference.To{Managed: &storagev1alpha3.Account{}, List: &storagev1alpha3.AccountList{}},
+		Extract:      storagev1alpha3.AccountID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.storageAccountID")
+	}
+	mg.Spec.ForProvider.StorageAccountID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.StorageAccountIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.eventHubAuthorizationRuleID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.EventHubAuthorizationRuleID),
+		Reference:    mg.Spec.ForProvider.EventHubNamespaceRef,
+		Selector:     mg.Spec.ForProvider.EventHubNamespaceSelector,
+		To:           reference.To{Managed: &messagingv1alpha1.EventHubNamespace{}, List: &messagingv1alpha1.EventHubNamespaceList{}},
+		Extract:      messagingv1alpha1.EventHubNamespaceAuthorizationRuleID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.eventHubAuthorizationRuleID")
+	}
+	mg.Spec.ForProvider.EventHubAuthorizationRuleID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.EventHubNamespaceRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.eventHubName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.EventHubName),
+		Reference:    mg.Spec.ForProvider.EventHubNameRef,
+		Selector:     mg.Spec.ForProvider.EventHubNameSelector,
+		To:           reference.To{Managed: &messagingv1alpha1.EventHub{}, List: &messagingv1alpha1.EventHubList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.eventHubName")
+	}
+	mg.Spec.ForProvider.EventHubName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.EventHubNameRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -39,7 +39,17 @@ type KeyVaultSecretAttributesParameters struct {
 // https://docs.microsoft.com/en-us/rest/api/keyvault/#secret-operations
 type KeyVaultSecretParameters struct {
 	// VaultBaseURL - The vault name, for example https://myvault.vault.azure.net.
-	VaultBaseURL string `json:"vaultBaseUrl"`
+	// +optional
+	VaultBaseURL string `json:"vaultBaseUrl,omitempty"`
+
+	// VaultBaseURLRef - A reference to a KeyVault to retrieve its URI.
+	// +optional
+	VaultBaseURLRef *xpv1.Reference `json:"vaultBaseUrlRef,omitempty"`
+
+	// VaultBaseURLSelector - Select a reference to a KeyVault to retrieve
+	// its URI.
+	// +optional
+	VaultBaseURLSelector *xpv1.Selector `json:"vaultBaseUrlSelector,omitempty"`
 
 	// Name - The name of the secret
 	Name string `json:"name"`
@@ -0,0 +1,231 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// KeyVaultPermissions defines the permissions an identity has on the keys,
+// secrets, certificates and storage accounts of a Key Vault.
+type KeyVaultPermissions struct {
+	// Keys - Permissions to keys, e.g. get, list, create, delete.
+	// +optional
+	Keys []string `json:"keys,omitempty"`
+
+	// Secrets - Permissions to secrets, e.g. get, list, set, delete.
+	// +optional
+	Secrets []string `json:"secrets,omitempty"`
+
+	// Certificates - Permissions to certificates, e.g. get, list, create.
+	// +optional
+	Certificates []string `json:"certificates,omitempty"`
+
+	// Storage - Permissions to storage accounts, e.g. get, list, set.
+	// +optional
+	Storage []string `json:"storage,omitempty"`
+}
+
+// KeyVaultAccessPolicy grants an Azure Active Directory identity access to a
+// Key Vault.
+type KeyVaultAccessPolicy struct {
+	// TenantID - The Azure Active Directory tenant ID that should be used for
+	// authenticating requests to the key vault.
+	TenantID string `json:"tenantId"`
+
+	// ObjectID - The object ID of a user, service principal or security group
+	// in the Azure Active Directory tenant for the vault. The object ID must be
+	// unique for the list of access policies.
+	ObjectID string `json:"objectId"`
+
+	// ApplicationID - Application ID of the client making request on behalf
+	// of a principal.
+	// +optional
+	ApplicationID *string `json:"applicationId,omitempty"`
+
+	// Permissions - Permissions the identity has for keys, secrets,
+	// certificates and storage accounts.
+	Permissions KeyVaultPermissions `json:"permissions"`
+}
+
+// KeyVaultNetworkACLs restrict the networks a Key Vault can be reached from.
+type KeyVaultNetworkACLs struct {
+	// DefaultAction - The action taken when no IP or virtual network rule
+	// matches. Deny makes the vault reachable only from the allowed networks
+	// and over private endpoints.
+	// +kubebuilder:validation:Enum=Allow;Deny
+	DefaultAction string `json:"defaultAction"`
+
+	// Bypass - Whether trusted Azure services can bypass the rules.
+	// Defaults to AzureServices.
+	// +kubebuilder:validation:Enum=AzureServices;None
+	// +optional
+	Bypass *string `json:"bypass,omitempty"`
+
+	// IPRules - Public IP addresses or CIDR ranges allowed to reach the
+	// vault.
+	// +optional
+	IPRules []string `json:"ipRules,omitempty"`
+
+	// VirtualNetworkSubnetIDs - IDs of the subnets allowed to reach the vault.
+	// The subnets must have the Microsoft.KeyVault service endpoint enabled.
+	// +optional
+	VirtualNetworkSubnetIDs []string `json:"virtualNetworkSubnetIds,omitempty"`
+}
+
+// KeyVaultParameters defines the desired state of an Azure Key Vault.
+// https://docs.microsoft.com/en-us/rest/api/keyvault/keyvault/vaults
+type KeyVaultParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the Key Vault's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the Key Vault's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a reference to the Key Vault's
+	// resource group.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The Azure location the Key Vault should be created in.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	Location string `json:"location"`
+
+	// TenantID - The Azure Active Directory tenant ID that should be used for
+	// authenticating requests to the key vault.
+	TenantID string `json:"tenantId"`
+
+	// SKUName - Whether the key vault is a standard vault or a premium vault.
+	// +kubebuilder:validation:Enum=standard;premium
+	SKUName string `json:"skuName"`
+
+	// AccessPolicies - Identities that have access to the key vault. All
+	// identities must use the same tenant ID as the key vault. Access policies
+	// are ignored when EnableRBACAuthorization is true.
+	// +optional
+	AccessPolicies []KeyVaultAccessPolicy `json:"accessPolicies,omitempty"`
+
+	// EnabledForDeployment - Whether Azure Virtual Machines are permitted to
+	// retrieve certificates stored as secrets from the key vault.
+	// +optional
+	EnabledForDeployment *bool `json:"enabledForDeployment,omitempty"`
+
+	// EnabledForDiskEncryption - Whether Azure Disk Encryption is permitted to
+	// retrieve secrets from the vault and unwrap keys.
+	// +optional
+	EnabledForDiskEncryption *bool `json:"enabledForDiskEncryption,omitempty"`
+
+	// EnabledForTemplateDeployment - Whether Azure Resource Manager is
+	// permitted to retrieve secrets from the key vault.
+	// +optional
+	EnabledForTemplateDeployment *bool `json:"enabledForTemplateDeployment,omitempty"`
+
+	// EnableRBACAuthorization - Whether data actions are authorized using
+	// Azure role based access control instead of access policies.
+	// +optional
+	EnableRBACAuthorization *bool `json:"enableRbacAuthorization,omitempty"`
+
+	// EnableSoftDelete - Whether the soft delete functionality is enabled for
+	// this key vault. Azure enables soft delete by default and, once enabled,
+	// it cannot be disabled.
+	// +optional
+	EnableSoftDelete *bool `json:"enableSoftDelete,omitempty"`
+
+	// SoftDeleteRetentionInDays - The number of days soft deleted data is
+	// retained for. It can only be set when the key vault is created.
+	// +kubebuilder:validation:Minimum=7
+	// +kubebuilder:validation:Maximum=90
+	// +immutable
+	// +optional
+	SoftDeleteRetentionInDays *int32 `json:"softDeleteRetentionInDays,omitempty"`
+
+	// EnablePurgeProtection - Whether protection against purge is enabled for
+	// this vault. The setting is effective only if soft delete is also
+	// enabled, and once enabled it cannot be disabled.
+	// +optional
+	EnablePurgeProtection *bool `json:"enablePurgeProtection,omitempty"`
+
+	// NetworkACLs - Rules that restrict the networks the key vault can be
+	// reached from. The key vault is reachable from all networks if omitted.
+	// +optional
+	NetworkACLs *KeyVaultNetworkACLs `json:"networkAcls,omitempty"`
+
+	// Tags - Tags assigned to the key vault.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A KeyVaultSpec defines the desired state of a KeyVault.
+type KeyVaultSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KeyVaultParameters `json:"forProvider"`
+}
+
+// KeyVaultObservation represents the observed state of the Key Vault in Azure.
+type KeyVaultObservation struct {
+	// ID - Fully qualified identifier of the key vault.
+	ID string `json:"id,omitempty"`
+
+	// VaultURI - The URI of the vault for performing operations on keys and
+	// secrets.
+	VaultURI string `json:"vaultUri,omitempty"`
+}
+
+// A KeyVaultStatus represents the observed state of a KeyVault.
+type KeyVaultStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KeyVaultObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KeyVault is a managed resource that represents an Azure Key Vault.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="URI",type="string",JSONPath=".status.atProvider.vaultUri"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure},shortName=kv
+type KeyVault struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeyVaultSpec   `json:"spec"`
+	Status KeyVaultStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyVaultList contains a list of KeyVault.
+type KeyVaultList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KeyVault `json:"items"`
+}
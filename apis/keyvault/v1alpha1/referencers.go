@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+// VaultURI extracts status.atProvider.vaultUri from the supplied managed
+// resource, which must be a KeyVault.
+func VaultURI() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		kv, ok := mg.(*KeyVault)
+		if !ok {
+			return ""
+		}
+		return kv.Status.AtProvider.VaultURI
+	}
+}
+
+// KeyVaultID extracts status.atProvider.id from the supplied managed resource,
+// which must be a KeyVault.
+func KeyVaultID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		kv, ok := mg.(*KeyVault)
+		if !ok {
+			return ""
+		}
+		return kv.Status.AtProvider.ID
+	}
+}
+
+// ResolveReferences of this KeyVault
+func (mg *KeyVault) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this KeyVaultSecret
+func (mg *KeyVaultSecret) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vaultBaseUrl
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.VaultBaseURL,
+		Reference:    mg.Spec.ForProvider.VaultBaseURLRef,
+		Selector:     mg.Spec.ForProvider.VaultBaseURLSelector,
+		To:           reference.To{Managed: &KeyVault{}, List: &KeyVaultList{}},
+		Extract:      VaultURI(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vaultBaseUrl")
+	}
+	mg.Spec.ForProvider.VaultBaseURL = rsp.ResolvedValue
+	mg.Spec.ForProvider.VaultBaseURLRef = rsp.ResolvedReference
+
+	return nil
+}
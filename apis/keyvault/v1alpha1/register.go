@@ -37,6 +37,14 @@ var (
 	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
 )
 
+// KeyVault type metadata.
+var (
+	KeyVaultKind             = reflect.TypeOf(KeyVault{}).Name()
+	KeyVaultGroupKind        = schema.GroupKind{Group: Group, Kind: KeyVaultKind}.String()
+	KeyVaultKindAPIVersion   = KeyVaultKind + "." + SchemeGroupVersion.String()
+	KeyVaultGroupVersionKind = SchemeGroupVersion.WithKind(KeyVaultKind)
+)
+
 // KeyVaultSecret type metadata.
 var (
 	KeyVaultSecretKind             = reflect.TypeOf(KeyVaultSecret{}).Name()
@@ -46,5 +54,6 @@ var (
 )
 
 func init() {
+	SchemeBuilder.Register(&KeyVault{}, &KeyVaultList{})
 	SchemeBuilder.Register(&KeyVaultSecret{}, &KeyVaultSecretList{})
 }
@@ -22,9 +22,254 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyVault) DeepCopyInto(out *KeyVault) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyVault.
+func (in *KeyVault) DeepCopy() *KeyVault {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyVault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyVault) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyVaultAccessPolicy) DeepCopyInto(out *KeyVaultAccessPolicy) {
+	*out = *in
+	if in.ApplicationID != nil {
+		in, out := &in.ApplicationID, &out.ApplicationID
+		*out = new(string)
+		**out = **in
+	}
+	in.Permissions.DeepCopyInto(&out.Permissions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyVaultAccessPolicy.
+func (in *KeyVaultAccessPolicy) DeepCopy() *KeyVaultAccessPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyVaultAccessPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyVaultList) DeepCopyInto(out *KeyVaultList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyVault, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyVaultList.
+func (in *KeyVaultList) DeepCopy() *KeyVaultList {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyVaultList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyVaultList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyVaultNetworkACLs) DeepCopyInto(out *KeyVaultNetworkACLs) {
+	*out = *in
+	if in.Bypass != nil {
+		in, out := &in.Bypass, &out.Bypass
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPRules != nil {
+		in, out := &in.IPRules, &out.IPRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VirtualNetworkSubnetIDs != nil {
+		in, out := &in.VirtualNetworkSubnetIDs, &out.VirtualNetworkSubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyVaultNetworkACLs.
+func (in *KeyVaultNetworkACLs) DeepCopy() *KeyVaultNetworkACLs {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyVaultNetworkACLs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyVaultObservation) DeepCopyInto(out *KeyVaultObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyVaultObservation.
+func (in *KeyVaultObservation) DeepCopy() *KeyVaultObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyVaultObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyVaultParameters) DeepCopyInto(out *KeyVaultParameters) {
+	*out = *in
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessPolicies != nil {
+		in, out := &in.AccessPolicies, &out.AccessPolicies
+		*out = make([]KeyVaultAccessPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnabledForDeployment != nil {
+		in, out := &in.EnabledForDeployment, &out.EnabledForDeployment
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnabledForDiskEncryption != nil {
+		in, out := &in.EnabledForDiskEncryption, &out.EnabledForDiskEncryption
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnabledForTemplateDeployment != nil {
+		in, out := &in.EnabledForTemplateDeployment, &out.EnabledForTemplateDeployment
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableRBACAuthorization != nil {
+		in, out := &in.EnableRBACAuthorization, &out.EnableRBACAuthorization
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableSoftDelete != nil {
+		in, out := &in.EnableSoftDelete, &out.EnableSoftDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SoftDeleteRetentionInDays != nil {
+		in, out := &in.SoftDeleteRetentionInDays, &out.SoftDeleteRetentionInDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EnablePurgeProtection != nil {
+		in, out := &in.EnablePurgeProtection, &out.EnablePurgeProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NetworkACLs != nil {
+		in, out := &in.NetworkACLs, &out.NetworkACLs
+		*out = new(KeyVaultNetworkACLs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyVaultParameters.
+func (in *KeyVaultParameters) DeepCopy() *KeyVaultParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyVaultParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyVaultPermissions) DeepCopyInto(out *KeyVaultPermissions) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyVaultPermissions.
+func (in *KeyVaultPermissions) DeepCopy() *KeyVaultPermissions {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyVaultPermissions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyVaultSecret) DeepCopyInto(out *KeyVaultSecret) {
 	*out = *in
@@ -168,6 +413,16 @@ func (in *KeyVaultSecretObservation) DeepCopy() *KeyVaultSecretObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyVaultSecretParameters) DeepCopyInto(out *KeyVaultSecretParameters) {
 	*out = *in
+	if in.VaultBaseURLRef != nil {
+		in, out := &in.VaultBaseURLRef, &out.VaultBaseURLRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.VaultBaseURLSelector != nil {
+		in, out := &in.VaultBaseURLSelector, &out.VaultBaseURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	out.Value = in.Value
 	if in.ContentType != nil {
 		in, out := &in.ContentType, &out.ContentType
@@ -231,3 +486,37 @@ func (in *KeyVaultSecretStatus) DeepCopy() *KeyVaultSecretStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyVaultSpec) DeepCopyInto(out *KeyVaultSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyVaultSpec.
+func (in *KeyVaultSpec) DeepCopy() *KeyVaultSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyVaultSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyVaultStatus) DeepCopyInto(out *KeyVaultStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyVaultStatus.
+func (in *KeyVaultStatus) DeepCopy() *KeyVaultStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyVaultStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EventHubSKU defines the SKU of an Event Hubs namespace.
+type EventHubSKU struct {
+	// Name of the SKU.
+	// +kubebuilder:validation:Enum=Basic;Standard
+	Name string `json:"name"`
+
+	// Capacity - The number of throughput units of the namespace.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	// +optional
+	Capacity *int32 `json:"capacity,omitempty"`
+}
+
+// EventHubNamespaceParameters define the desired state of an Azure Event Hubs
+// namespace.
+type EventHubNamespaceParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the namespace's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The Azure location the namespace is created in.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	Location string `json:"location"`
+
+	// SKU of the namespace.
+	SKU EventHubSKU `json:"sku"`
+
+	// AutoInflateEnabled - Whether the throughput units of a Standard
+	// namespace are scaled up automatically.
+	// +optional
+	AutoInflateEnabled *bool `json:"autoInflateEnabled,omitempty"`
+
+	// MaximumThroughputUnits - The upper limit of throughput units when
+	// auto-inflate is enabled.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=20
+	// +optional
+	MaximumThroughputUnits *int32 `json:"maximumThroughputUnits,omitempty"`
+
+	// KafkaEnabled - Whether an Apache Kafka endpoint is enabled for the
+	// namespace.
+	// +optional
+	KafkaEnabled *bool `json:"kafkaEnabled,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An EventHubNamespaceSpec defines the desired state of an EventHubNamespace.
+type EventHubNamespaceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EventHubNamespaceParameters `json:"forProvider"`
+}
+
+// An EventHubNamespaceObservation represents the observed state of an Azure
+// Event Hubs namespace.
+type EventHubNamespaceObservation struct {
+	// ID - Resource ID.
+	ID string `json:"id,omitempty"`
+
+	// ProvisioningState - Provisioning state of the namespace.
+	ProvisioningState string `json:"provisioningState,omitempty"`
+
+	// ServiceBusEndpoint - Endpoint used to perform Event Hubs operations.
+	ServiceBusEndpoint string `json:"serviceBusEndpoint,omitempty"`
+}
+
+// An EventHubNamespaceStatus represents the observed state of an
+// EventHubNamespace.
+type EventHubNamespaceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EventHubNamespaceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EventHubNamespace is a managed resource that represents an Azure Event
+// Hubs namespace. The connection strings of the namespace's
+// RootManageSharedAccessKey authorization rule are written to its connection
+// secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.provisioningState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type EventHubNamespace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EventHubNamespaceSpec   `json:"spec"`
+	Status EventHubNamespaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EventHubNamespaceList contains a list of EventHubNamespace.
+type EventHubNamespaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventHubNamespace `json:"items"`
+}
+
+// EventHubCaptureDestination defines where captured events are archived.
+type EventHubCaptureDestination struct {
+	// StorageAccountID - Resource ID of the storage account events are
+	// captured to.
+	StorageAccountID string `json:"storageAccountId"`
+
+	// BlobContainer - Name of the blob container events are captured to.
+	BlobContainer string `json:"blobContainer"`
+
+	// ArchiveNameFormat - Name format of the capture blobs, e.g.
+	// {Namespace}/{EventHub}/{PartitionId}/{Year}/{Month}/{Day}/{Hour}/{Minute}/{Second}
+	// +optional
+	ArchiveNameFormat *string `json:"archiveNameFormat,omitempty"`
+}
+
+// EventHubCapture configures the capture of events to Azure Blob Storage.
+type EventHubCapture struct {
+	// Enabled - Whether capture is enabled.
+	Enabled bool `json:"enabled"`
+
+	// Encoding - The encoding of the capture blobs.
+	// +kubebuilder:validation:Enum=Avro;AvroDeflate
+	// +optional
+	Encoding *string `json:"encoding,omitempty"`
+
+	// IntervalInSeconds - The time window after which events are captured.
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=900
+	// +optional
+	IntervalInSeconds *int32 `json:"intervalInSeconds,omitempty"`
+
+	// SizeLimitInBytes - The amount of data after which events are captured.
+	// +kubebuilder:validation:Minimum=10485760
+	// +kubebuilder:validation:Maximum=524288000
+	// +optional
+	SizeLimitInBytes *int32 `json:"sizeLimitInBytes,omitempty"`
+
+	// SkipEmptyArchives - Whether empty capture blobs are skipped.
+	// +optional
+	SkipEmptyArchives *bool `json:"skipEmptyArchives,omitempty"`
+
+	// Destination of the captured events.
+	Destination EventHubCaptureDestination `json:"destination"`
+}
+
+// EventHubParameters define the desired state of an Azure Event Hub.
+type EventHubParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the Event Hub's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// NamespaceName - Name of the Event Hub's namespace.
+	// +immutable
+	// +optional
+	NamespaceName string `json:"namespaceName,omitempty"`
+
+	// NamespaceNameRef - A reference to an EventHubNamespace object to
+	// retrieve its name
+	// +immutable
+	// +optional
+	NamespaceNameRef *xpv1.Reference `json:"namespaceNameRef,omitempty"`
+
+	// NamespaceNameSelector - Selects an EventHubNamespace to reference.
+	// +immutable
+	// +optional
+	NamespaceNameSelector *xpv1.Selector `json:"namespaceNameSelector,omitempty"`
+
+	// MessageRetentionInDays - The number of days events are retained.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=7
+	// +optional
+	MessageRetentionInDays *int64 `json:"messageRetentionInDays,omitempty"`
+
+	// PartitionCount - The number of partitions of the Event Hub.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32
+	// +immutable
+	// +optional
+	PartitionCount *int64 `json:"partitionCount,omitempty"`
+
+	// Capture configures the capture of events to Azure Blob Storage.
+	// +optional
+	Capture *EventHubCapture `json:"capture,omitempty"`
+
+	// ConsumerGroups - Names of the consumer groups of the Event Hub, in
+	// addition to the $Default consumer group. Consumer groups that are not
+	// listed are deleted.
+	// +optional
+	ConsumerGroups []string `json:"consumerGroups,omitempty"`
+
+	// AuthorizationRights - The rights of the Event Hub authorization rule
+	// whose connection strings are written to the connection secret.
+	// +optional
+	AuthorizationRights []string `json:"authorizationRights,omitempty"`
+}
+
+// An EventHubSpec defines the desired state of an EventHub.
+type EventHubSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EventHubParameters `json:"forProvider"`
+}
+
+// An EventHubObservation represents the observed state of an Azure Event Hub.
+type EventHubObservation struct {
+	// ID - Resource ID.
+	ID string `json:"id,omitempty"`
+
+	// Status - Status of the Event Hub.
+	Status string `json:"status,omitempty"`
+
+	// PartitionIDs - Identifiers of the partitions of the Event Hub.
+	PartitionIDs []string `json:"partitionIds,omitempty"`
+}
+
+// An EventHubStatus represents the observed state of an EventHub.
+type EventHubStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EventHubObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EventHub is a managed resource that represents an Azure Event Hub. The
+// controller manages an Event Hub authorization rule named crossplane and
+// writes its connection strings to the Event Hub's connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAMESPACE",type="string",JSONPath=".spec.forProvider.namespaceName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type EventHub struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EventHubSpec   `json:"spec"`
+	Status EventHubStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EventHubList contains a list of EventHub.
+type EventHubList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventHub `json:"items"`
+}
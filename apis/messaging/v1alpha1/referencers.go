@@ -0,0 +1,242 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+// RootAuthorizationRuleName is the name of the authorization rule Azure
+// creates with every Event Hubs and Service Bus namespace.
+const RootAuthorizationRuleName = "RootManageSharedAccessKey"
+
+// EventHubNamespaceAuthorizationRuleID extracts the ID of the
+// RootManageSharedAccessKey authorization rule of the supplied managed
+// resource, which must be an EventHubNamespace.
+func EventHubNamespaceAuthorizationRuleID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		n, ok := mg.(*EventHubNamespace)
+		if !ok || n.Status.AtProvider.ID == "" {
+			return ""
+		}
+		return n.Status.AtProvider.ID + "/authorizationRules/" + RootAuthorizationRuleName
+	}
+}
+
+// ResolveReferences of this ServiceBusNamespace
+func (mg *ServiceBusNamespace) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ServiceBusQueue
+func (mg *ServiceBusQueue) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.namespaceName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.NamespaceName,
+		Reference:    mg.Spec.ForProvider.NamespaceNameRef,
+		Selector:     mg.Spec.ForProvider.NamespaceNameSelector,
+		To:           reference.To{Managed: &ServiceBusNamespace{}, List: &ServiceBusNamespaceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.namespaceName")
+	}
+	mg.Spec.ForProvider.NamespaceName = rsp.ResolvedValue
+	mg.Spec.ForProvider.NamespaceNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ServiceBusTopic
+func (mg *ServiceBusTopic) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.namespaceName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.NamespaceName,
+		Reference:    mg.Spec.ForProvider.NamespaceNameRef,
+		Selector:     mg.Spec.ForProvider.NamespaceNameSelector,
+		To:           reference.To{Managed: &ServiceBusNamespace{}, List: &ServiceBusNamespaceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.namespaceName")
+	}
+	mg.Spec.ForProvider.NamespaceName = rsp.ResolvedValue
+	mg.Spec.ForProvider.NamespaceNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ServiceBusSubscription
+func (mg *ServiceBusSubscription) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.namespaceName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.NamespaceName,
+		Reference:    mg.Spec.ForProvider.NamespaceNameRef,
+		Selector:     mg.Spec.ForProvider.NamespaceNameSelector,
+		To:           reference.To{Managed: &ServiceBusNamespace{}, List: &ServiceBusNamespaceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.namespaceName")
+	}
+	mg.Spec.ForProvider.NamespaceName = rsp.ResolvedValue
+	mg.Spec.ForProvider.NamespaceNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.topicName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.TopicName,
+		Reference:    mg.Spec.ForProvider.TopicNameRef,
+		Selector:     mg.Spec.ForProvider.TopicNameSelector,
+		To:           reference.To{Managed: &ServiceBusTopic{}, List: &ServiceBusTopicList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.topicName")
+	}
+	mg.Spec.ForProvider.TopicName = rsp.ResolvedValue
+	mg.Spec.ForProvider.TopicNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this EventHubNamespace
+func (mg *EventHubNamespace) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this EventHub
+func (mg *EventHub) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.namespaceName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.NamespaceName,
+		Reference:    mg.Spec.ForProvider.NamespaceNameRef,
+		Selector:     mg.Spec.ForProvider.NamespaceNameSelector,
+		To:           reference.To{Managed: &EventHubNamespace{}, List: &EventHubNamespaceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.namespaceName")
+	}
+	mg.Spec.ForProvider.NamespaceName = rsp.ResolvedValue
+	mg.Spec.ForProvider.NamespaceNameRef = rsp.ResolvedReference
+
+	return nil
+}
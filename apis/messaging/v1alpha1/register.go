@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "messaging.azure.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// ServiceBusNamespace type metadata.
+var (
+	ServiceBusNamespaceKind             = reflect.TypeOf(ServiceBusNamespace{}).Name()
+	ServiceBusNamespaceGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceBusNamespaceKind}.String()
+	ServiceBusNamespaceKindAPIVersion   = ServiceBusNamespaceKind + "." + SchemeGroupVersion.String()
+	ServiceBusNamespaceGroupVersionKind = SchemeGroupVersion.WithKind(ServiceBusNamespaceKind)
+)
+
+// ServiceBusQueue type metadata.
+var (
+	ServiceBusQueueKind             = reflect.TypeOf(ServiceBusQueue{}).Name()
+	ServiceBusQueueGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceBusQueueKind}.String()
+	ServiceBusQueueKindAPIVersion   = ServiceBusQueueKind + "." + SchemeGroupVersion.String()
+	ServiceBusQueueGroupVersionKind = SchemeGroupVersion.WithKind(ServiceBusQueueKind)
+)
+
+// ServiceBusTopic type metadata.
+var (
+	ServiceBusTopicKind             = reflect.TypeOf(ServiceBusTopic{}).Name()
+	ServiceBusTopicGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceBusTopicKind}.String()
+	ServiceBusTopicKindAPIVersion   = ServiceBusTopicKind + "." + SchemeGroupVersion.String()
+	ServiceBusTopicGroupVersionKind = SchemeGroupVersion.WithKind(ServiceBusTopicKind)
+)
+
+// ServiceBusSubscription type metadata.
+var (
+	ServiceBusSubscriptionKind             = reflect.TypeOf(ServiceBusSubscription{}).Name()
+	ServiceBusSubscriptionGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceBusSubscriptionKind}.String()
+	ServiceBusSubscriptionKindAPIVersion   = ServiceBusSubscriptionKind + "." + SchemeGroupVersion.String()
+	ServiceBusSubscriptionGroupVersionKind = SchemeGroupVersion.WithKind(ServiceBusSubscriptionKind)
+)
+
+// EventHubNamespace type metadata.
+var (
+	EventHubNamespaceKind             = reflect.TypeOf(EventHubNamespace{}).Name()
+	EventHubNamespaceGroupKind        = schema.GroupKind{Group: Group, Kind: EventHubNamespaceKind}.String()
+	EventHubNamespaceKindAPIVersion   = EventHubNamespaceKind + "." + SchemeGroupVersion.String()
+	EventHubNamespaceGroupVersionKind = SchemeGroupVersion.WithKind(EventHubNamespaceKind)
+)
+
+// EventHub type metadata.
+var (
+	EventHubKind             = reflect.TypeOf(EventHub{}).Name()
+	EventHubGroupKind        = schema.GroupKind{Group: Group, Kind: EventHubKind}.String()
+	EventHubKindAPIVersion   = EventHubKind + "." + SchemeGroupVersion.String()
+	EventHubGroupVersionKind = SchemeGroupVersion.WithKind(EventHubKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServiceBusNamespace{}, &ServiceBusNamespaceList{})
+	SchemeBuilder.Register(&ServiceBusQueue{}, &ServiceBusQueueList{})
+	SchemeBuilder.Register(&ServiceBusTopic{}, &ServiceBusTopicList{})
+	SchemeBuilder.Register(&ServiceBusSubscription{}, &ServiceBusSubscriptionList{})
+	SchemeBuilder.Register(&EventHubNamespace{}, &EventHubNamespaceList{})
+	SchemeBuilder.Register(&EventHub{}, &EventHubList{})
+}
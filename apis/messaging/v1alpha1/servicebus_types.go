@@ -0,0 +1,565 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ServiceBusSKU defines the SKU of a Service Bus namespace.
+type ServiceBusSKU struct {
+	// Name of the SKU.
+	// +kubebuilder:validation:Enum=Basic;Standard;Premium
+	Name string `json:"name"`
+
+	// Capacity - The messaging units of a Premium namespace, one of 1, 2, 4,
+	// 8 or 16.
+	// +optional
+	Capacity *int32 `json:"capacity,omitempty"`
+}
+
+// ServiceBusNamespaceParameters define the desired state of an Azure Service
+// Bus namespace.
+type ServiceBusNamespaceParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the namespace's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The Azure location the namespace is created in.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	Location string `json:"location"`
+
+	// SKU of the namespace.
+	SKU ServiceBusSKU `json:"sku"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ServiceBusNamespaceSpec defines the desired state of a
+// ServiceBusNamespace.
+type ServiceBusNamespaceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceBusNamespaceParameters `json:"forProvider"`
+}
+
+// A ServiceBusNamespaceObservation represents the observed state of an Azure
+// Service Bus namespace.
+type ServiceBusNamespaceObservation struct {
+	// ID - Resource ID.
+	ID string `json:"id,omitempty"`
+
+	// ProvisioningState - Provisioning state of the namespace.
+	ProvisioningState string `json:"provisioningState,omitempty"`
+
+	// ServiceBusEndpoint - Endpoint used to perform Service Bus operations.
+	ServiceBusEndpoint string `json:"serviceBusEndpoint,omitempty"`
+}
+
+// A ServiceBusNamespaceStatus represents the observed state of a
+// ServiceBusNamespace.
+type ServiceBusNamespaceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceBusNamespaceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceBusNamespace is a managed resource that represents an Azure Service
+// Bus namespace. The connection strings of the namespace's
+// RootManageSharedAccessKey authorization rule are written to its connection
+// secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.provisioningState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type ServiceBusNamespace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceBusNamespaceSpec   `json:"spec"`
+	Status ServiceBusNamespaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceBusNamespaceList contains a list of ServiceBusNamespace.
+type ServiceBusNamespaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceBusNamespace `json:"items"`
+}
+
+// ServiceBusQueueParameters define the desired state of an Azure Service Bus
+// queue.
+type ServiceBusQueueParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the queue's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// NamespaceName - Name of the queue's Service Bus namespace.
+	// +immutable
+	// +optional
+	NamespaceName string `json:"namespaceName,omitempty"`
+
+	// NamespaceNameRef - A reference to a ServiceBusNamespace object to
+	// retrieve its name
+	// +immutable
+	// +optional
+	NamespaceNameRef *xpv1.Reference `json:"namespaceNameRef,omitempty"`
+
+	// NamespaceNameSelector - Selects a ServiceBusNamespace to reference.
+	// +immutable
+	// +optional
+	NamespaceNameSelector *xpv1.Selector `json:"namespaceNameSelector,omitempty"`
+
+	// LockDuration - ISO 8601 duration of a peek-lock, at most 5 minutes.
+	// +optional
+	LockDuration *string `json:"lockDuration,omitempty"`
+
+	// MaxSizeInMegabytes - The maximum size of the queue in megabytes.
+	// +optional
+	MaxSizeInMegabytes *int32 `json:"maxSizeInMegabytes,omitempty"`
+
+	// RequiresDuplicateDetection - Whether the queue requires duplicate
+	// detection.
+	// +immutable
+	// +optional
+	RequiresDuplicateDetection *bool `json:"requiresDuplicateDetection,omitempty"`
+
+	// DuplicateDetectionHistoryTimeWindow - ISO 8601 duration of the
+	// duplicate detection history.
+	// +optional
+	DuplicateDetectionHistoryTimeWindow *string `json:"duplicateDetectionHistoryTimeWindow,omitempty"`
+
+	// RequiresSession - Whether the queue supports sessions.
+	// +immutable
+	// +optional
+	RequiresSession *bool `json:"requiresSession,omitempty"`
+
+	// DefaultMessageTimeToLive - ISO 8601 duration after which a message
+	// expires, unless the message sets its own time to live.
+	// +optional
+	DefaultMessageTimeToLive *string `json:"defaultMessageTimeToLive,omitempty"`
+
+	// DeadLetteringOnMessageExpiration - Whether expired messages are moved
+	// to the dead-letter queue.
+	// +optional
+	DeadLetteringOnMessageExpiration *bool `json:"deadLetteringOnMessageExpiration,omitempty"`
+
+	// MaxDeliveryCount - The number of deliveries after which a message is
+	// dead-lettered.
+	// +optional
+	MaxDeliveryCount *int32 `json:"maxDeliveryCount,omitempty"`
+
+	// EnableBatchedOperations - Whether server-side batched operations are
+	// enabled.
+	// +optional
+	EnableBatchedOperations *bool `json:"enableBatchedOperations,omitempty"`
+
+	// EnablePartitioning - Whether the queue is partitioned across multiple
+	// message brokers.
+	// +immutable
+	// +optional
+	EnablePartitioning *bool `json:"enablePartitioning,omitempty"`
+
+	// AutoDeleteOnIdle - ISO 8601 idle duration after which the queue is
+	// deleted, at least 5 minutes.
+	// +optional
+	AutoDeleteOnIdle *string `json:"autoDeleteOnIdle,omitempty"`
+
+	// ForwardTo - Name of the queue or topic messages are forwarded to.
+	// +optional
+	ForwardTo *string `json:"forwardTo,omitempty"`
+
+	// ForwardDeadLetteredMessagesTo - Name of the queue or topic dead-lettered
+	// messages are forwarded to.
+	// +optional
+	ForwardDeadLetteredMessagesTo *string `json:"forwardDeadLetteredMessagesTo,omitempty"`
+
+	// AuthorizationRights - The rights of the queue authorization rule whose
+	// connection strings are written to the connection secret.
+	// +optional
+	AuthorizationRights []string `json:"authorizationRights,omitempty"`
+}
+
+// A ServiceBusQueueSpec defines the desired state of a ServiceBusQueue.
+type ServiceBusQueueSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceBusQueueParameters `json:"forProvider"`
+}
+
+// A ServiceBusEntityObservation represents the observed state of an Azure
+// Service Bus queue, topic or subscription.
+type ServiceBusEntityObservation struct {
+	// ID - Resource ID.
+	ID string `json:"id,omitempty"`
+
+	// Status - Status of the messaging entity.
+	Status string `json:"status,omitempty"`
+}
+
+// A ServiceBusQueueStatus represents the observed state of a ServiceBusQueue.
+type ServiceBusQueueStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceBusEntityObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceBusQueue is a managed resource that represents an Azure Service Bus
+// queue. The controller manages a queue authorization rule named crossplane
+// and writes its connection strings to the queue's connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAMESPACE",type="string",JSONPath=".spec.forProvider.namespaceName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type ServiceBusQueue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceBusQueueSpec   `json:"spec"`
+	Status ServiceBusQueueStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceBusQueueList contains a list of ServiceBusQueue.
+type ServiceBusQueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceBusQueue `json:"items"`
+}
+
+// ServiceBusTopicParameters define the desired state of an Azure Service Bus
+// topic.
+type ServiceBusTopicParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the topic's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// NamespaceName - Name of the topic's Service Bus namespace.
+	// +immutable
+	// +optional
+	NamespaceName string `json:"namespaceName,omitempty"`
+
+	// NamespaceNameRef - A reference to a ServiceBusNamespace object to
+	// retrieve its name
+	// +immutable
+	// +optional
+	NamespaceNameRef *xpv1.Reference `json:"namespaceNameRef,omitempty"`
+
+	// NamespaceNameSelector - Selects a ServiceBusNamespace to reference.
+	// +immutable
+	// +optional
+	NamespaceNameSelector *xpv1.Selector `json:"namespaceNameSelector,omitempty"`
+
+	// MaxSizeInMegabytes - The maximum size of the topic in megabytes.
+	// +optional
+	MaxSizeInMegabytes *int32 `json:"maxSizeInMegabytes,omitempty"`
+
+	// RequiresDuplicateDetection - Whether the topic requires duplicate
+	// detection.
+	// +immutable
+	// +optional
+	RequiresDuplicateDetection *bool `json:"requiresDuplicateDetection,omitempty"`
+
+	// DuplicateDetectionHistoryTimeWindow - ISO 8601 duration of the
+	// duplicate detection history.
+	// +optional
+	DuplicateDetectionHistoryTimeWindow *string `json:"duplicateDetectionHistoryTimeWindow,omitempty"`
+
+	// DefaultMessageTimeToLive - ISO 8601 duration after which a message
+	// expires, unless the message sets its own time to live.
+	// +optional
+	DefaultMessageTimeToLive *string `json:"defaultMessageTimeToLive,omitempty"`
+
+	// EnableBatchedOperations - Whether server-side batched operations are
+	// enabled.
+	// +optional
+	EnableBatchedOperations *bool `json:"enableBatchedOperations,omitempty"`
+
+	// SupportOrdering - Whether the topic supports ordering.
+	// +optional
+	SupportOrdering *bool `json:"supportOrdering,omitempty"`
+
+	// EnablePartitioning - Whether the topic is partitioned across multiple
+	// message brokers.
+	// +immutable
+	// +optional
+	EnablePartitioning *bool `json:"enablePartitioning,omitempty"`
+
+	// AutoDeleteOnIdle - ISO 8601 idle duration after which the topic is
+	// deleted, at least 5 minutes.
+	// +optional
+	AutoDeleteOnIdle *string `json:"autoDeleteOnIdle,omitempty"`
+
+	// AuthorizationRights - The rights of the topic authorization rule whose
+	// connection strings are written to the connection secret.
+	// +optional
+	AuthorizationRights []string `json:"authorizationRights,omitempty"`
+}
+
+// A ServiceBusTopicSpec defines the desired state of a ServiceBusTopic.
+type ServiceBusTopicSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceBusTopicParameters `json:"forProvider"`
+}
+
+// A ServiceBusTopicStatus represents the observed state of a ServiceBusTopic.
+type ServiceBusTopicStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceBusEntityObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceBusTopic is a managed resource that represents an Azure Service Bus
+// topic. The controller manages a topic authorization rule named crossplane
+// and writes its connection strings to the topic's connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAMESPACE",type="string",JSONPath=".spec.forProvider.namespaceName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type ServiceBusTopic struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceBusTopicSpec   `json:"spec"`
+	Status ServiceBusTopicStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceBusTopicList contains a list of ServiceBusTopic.
+type ServiceBusTopicList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceBusTopic `json:"items"`
+}
+
+// ServiceBusSubscriptionParameters define the desired state of an Azure
+// Service Bus topic subscription.
+type ServiceBusSubscriptionParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the subscription's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// NamespaceName - Name of the subscription's Service Bus namespace.
+	// +immutable
+	// +optional
+	NamespaceName string `json:"namespaceName,omitempty"`
+
+	// NamespaceNameRef - A reference to a ServiceBusNamespace object to
+	// retrieve its name
+	// +immutable
+	// +optional
+	NamespaceNameRef *xpv1.Reference `json:"namespaceNameRef,omitempty"`
+
+	// NamespaceNameSelector - Selects a ServiceBusNamespace to reference.
+	// +immutable
+	// +optional
+	NamespaceNameSelector *xpv1.Selector `json:"namespaceNameSelector,omitempty"`
+
+	// TopicName - Name of the subscription's topic.
+	// +immutable
+	// +optional
+	TopicName string `json:"topicName,omitempty"`
+
+	// TopicNameRef - A reference to a ServiceBusTopic object to retrieve its
+	// name
+	// +immutable
+	// +optional
+	TopicNameRef *xpv1.Reference `json:"topicNameRef,omitempty"`
+
+	// TopicNameSelector - Selects a ServiceBusTopic to reference.
+	// +immutable
+	// +optional
+	TopicNameSelector *xpv1.Selector `json:"topicNameSelector,omitempty"`
+
+	// LockDuration - ISO 8601 duration of a peek-lock, at most 5 minutes.
+	// +optional
+	LockDuration *string `json:"lockDuration,omitempty"`
+
+	// RequiresSession - Whether the subscription supports sessions.
+	// +immutable
+	// +optional
+	RequiresSession *bool `json:"requiresSession,omitempty"`
+
+	// DefaultMessageTimeToLive - ISO 8601 duration after which a message
+	// expires, unless the message sets its own time to live.
+	// +optional
+	DefaultMessageTimeToLive *string `json:"defaultMessageTimeToLive,omitempty"`
+
+	// DeadLetteringOnMessageExpiration - Whether expired messages are moved
+	// to the dead-letter queue.
+	// +optional
+	DeadLetteringOnMessageExpiration *bool `json:"deadLetteringOnMessageExpiration,omitempty"`
+
+	// DeadLetteringOnFilterEvaluationExceptions - Whether messages that fail
+	// filter evaluation are moved to the dead-letter queue.
+	// +optional
+	DeadLetteringOnFilterEvaluationExceptions *bool `json:"deadLetteringOnFilterEvaluationExceptions,omitempty"`
+
+	// MaxDeliveryCount - The number of deliveries after which a message is
+	// dead-lettered.
+	// +optional
+	MaxDeliveryCount *int32 `json:"maxDeliveryCount,omitempty"`
+
+	// EnableBatchedOperations - Whether server-side batched operations are
+	// enabled.
+	// +optional
+	EnableBatchedOperations *bool `json:"enableBatchedOperations,omitempty"`
+
+	// AutoDeleteOnIdle - ISO 8601 idle duration after which the subscription
+	// is deleted, at least 5 minutes.
+	// +optional
+	AutoDeleteOnIdle *string `json:"autoDeleteOnIdle,omitempty"`
+
+	// ForwardTo - Name of the queue or topic messages are forwarded to.
+	// +optional
+	ForwardTo *string `json:"forwardTo,omitempty"`
+
+	// ForwardDeadLetteredMessagesTo - Name of the queue or topic dead-lettered
+	// messages are forwarded to.
+	// +optional
+	ForwardDeadLetteredMessagesTo *string `json:"forwardDeadLetteredMessagesTo,omitempty"`
+}
+
+// A ServiceBusSubscriptionSpec defines the desired state of a
+// ServiceBusSubscription.
+type ServiceBusSubscriptionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceBusSubscriptionParameters `json:"forProvider"`
+}
+
+// A ServiceBusSubscriptionStatus represents the observed state of a
+// ServiceBusSubscription.
+type ServiceBusSubscriptionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceBusEntityObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceBusSubscription is a managed resource that represents an Azure
+// Service Bus topic subscription. Receivers use the connection secret of the
+// subscription's topic.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TOPIC",type="string",JSONPath=".spec.forProvider.topicName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type ServiceBusSubscription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceBusSubscriptionSpec   `json:"spec"`
+	Status ServiceBusSubscriptionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceBusSubscriptionList contains a list of ServiceBusSubscription.
+type ServiceBusSubscriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceBusSubscription `json:"items"`
+}
@@ -0,0 +1,386 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ApplicationGatewaySKU is the SKU of an Application Gateway.
+type ApplicationGatewaySKU struct {
+	// Name - Name of the SKU.
+	// +kubebuilder:validation:Enum=Standard_Small;Standard_Medium;Standard_Large;WAF_Medium;WAF_Large;Standard_v2;WAF_v2
+	Name string `json:"name"`
+
+	// Tier - Tier of the SKU.
+	// +kubebuilder:validation:Enum=Standard;WAF;Standard_v2;WAF_v2
+	Tier string `json:"tier"`
+
+	// Capacity - The number of instances of the Application Gateway.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=125
+	// +optional
+	Capacity *int32 `json:"capacity,omitempty"`
+}
+
+// ApplicationGatewayFrontendIPConfiguration is a frontend IP address of an
+// Application Gateway. It is either public, or private within the subnet of
+// the Application Gateway.
+type ApplicationGatewayFrontendIPConfiguration struct {
+	// Name - The name of the frontend IP configuration, unique within the
+	// Application Gateway.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// PublicIPAddressID - The ID of the public IP address of a public
+	// frontend.
+	// +optional
+	PublicIPAddressID *string `json:"publicIPAddressID,omitempty"`
+
+	// PublicIPAddressIDRef - A reference to a PublicIPAddress to retrieve its
+	// ID.
+	// +optional
+	PublicIPAddressIDRef *xpv1.Reference `json:"publicIPAddressIDRef,omitempty"`
+
+	// PublicIPAddressIDSelector - Selects a reference to a PublicIPAddress to
+	// retrieve its ID.
+	// +optional
+	PublicIPAddressIDSelector *xpv1.Selector `json:"publicIPAddressIDSelector,omitempty"`
+
+	// PrivateIPAddress - The static private IP address of a private frontend.
+	// Azure allocates one dynamically if it is omitted.
+	// +optional
+	PrivateIPAddress *string `json:"privateIPAddress,omitempty"`
+}
+
+// ApplicationGatewayFrontendPort is a frontend port of an Application
+// Gateway.
+type ApplicationGatewayFrontendPort struct {
+	// Name - The name of the frontend port, unique within the Application
+	// Gateway.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// Port - The port number.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=65535
+	Port int32 `json:"port"`
+}
+
+// ApplicationGatewayBackendAddressPool is a pool of backend addresses of an
+// Application Gateway.
+type ApplicationGatewayBackendAddressPool struct {
+	// Name - The name of the backend address pool, unique within the
+	// Application Gateway.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// IPAddresses - The IP addresses of the backends.
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+
+	// FQDNs - The fully qualified domain names of the backends.
+	// +optional
+	FQDNs []string `json:"fqdns,omitempty"`
+}
+
+// ApplicationGatewayBackendHTTPSettings configures how an Application
+// Gateway connects to its backends.
+type ApplicationGatewayBackendHTTPSettings struct {
+	// Name - The name of the backend HTTP settings, unique within the
+	// Application Gateway.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// Port - The port of the backends.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=65535
+	Port int32 `json:"port"`
+
+	// Protocol - The protocol used to connect to the backends.
+	// +kubebuilder:validation:Enum=Http;Https
+	Protocol string `json:"protocol"`
+
+	// CookieBasedAffinity - Whether requests of a client session are sent to
+	// the same backend. Defaults to Disabled.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	CookieBasedAffinity *string `json:"cookieBasedAffinity,omitempty"`
+
+	// RequestTimeout - The number of seconds after which a request to a
+	// backend times out.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=86400
+	// +optional
+	RequestTimeout *int32 `json:"requestTimeout,omitempty"`
+
+	// HostName - The host header sent to the backends.
+	// +optional
+	HostName *string `json:"hostName,omitempty"`
+
+	// PickHostNameFromBackendAddress - Whether the host header sent to a
+	// backend is its address.
+	// +optional
+	PickHostNameFromBackendAddress *bool `json:"pickHostNameFromBackendAddress,omitempty"`
+
+	// Path - The path prefix of requests sent to the backends.
+	// +optional
+	Path *string `json:"path,omitempty"`
+}
+
+// ApplicationGatewayHTTPListener is an HTTP listener of an Application
+// Gateway. HTTPS listeners are not supported yet.
+type ApplicationGatewayHTTPListener struct {
+	// Name - The name of the listener, unique within the Application Gateway.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// FrontendIPConfigurationName - The name of the frontend IP configuration
+	// the listener listens on.
+	FrontendIPConfigurationName string `json:"frontendIPConfigurationName"`
+
+	// FrontendPortName - The name of the frontend port the listener listens
+	// on.
+	FrontendPortName string `json:"frontendPortName"`
+
+	// Protocol - The protocol of the listener.
+	// +kubebuilder:validation:Enum=Http
+	Protocol string `json:"protocol"`
+
+	// HostName - The host name the listener accepts requests for. A listener
+	// without one accepts requests for any host.
+	// +optional
+	HostName *string `json:"hostName,omitempty"`
+}
+
+// ApplicationGatewayRequestRoutingRule routes the requests received by a
+// listener of an Application Gateway to a backend address pool.
+type ApplicationGatewayRequestRoutingRule struct {
+	// Name - The name of the rule, unique within the Application Gateway.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// RuleType - The type of the rule.
+	// +kubebuilder:validation:Enum=Basic
+	RuleType string `json:"ruleType"`
+
+	// HTTPListenerName - The name of the listener whose requests are routed.
+	HTTPListenerName string `json:"httpListenerName"`
+
+	// BackendAddressPoolName - The name of the backend address pool the
+	// requests are routed to.
+	BackendAddressPoolName string `json:"backendAddressPoolName"`
+
+	// BackendHTTPSettingsName - The name of the backend HTTP settings used to
+	// connect to the backend address pool.
+	BackendHTTPSettingsName string `json:"backendHttpSettingsName"`
+}
+
+// ApplicationGatewayWAFConfiguration configures the web application firewall
+// of an Application Gateway. It requires a WAF or WAF_v2 SKU.
+type ApplicationGatewayWAFConfiguration struct {
+	// Enabled - Whether the web application firewall is enabled.
+	Enabled bool `json:"enabled"`
+
+	// FirewallMode - Whether the web application firewall only logs, or also
+	// blocks, the requests that match its rules.
+	// +kubebuilder:validation:Enum=Detection;Prevention
+	FirewallMode string `json:"firewallMode"`
+
+	// RuleSetType - The type of the rule set. Only OWASP is supported.
+	// +kubebuilder:validation:Enum=OWASP
+	RuleSetType string `json:"ruleSetType"`
+
+	// RuleSetVersion - The version of the rule set, e.g. 3.1.
+	RuleSetVersion string `json:"ruleSetVersion"`
+
+	// RequestBodyCheck - Whether the web application firewall inspects the
+	// request body.
+	// +optional
+	RequestBodyCheck *bool `json:"requestBodyCheck,omitempty"`
+
+	// MaxRequestBodySizeInKB - The maximum size of an inspected request body.
+	// +kubebuilder:validation:Minimum:=8
+	// +kubebuilder:validation:Maximum:=128
+	// +optional
+	MaxRequestBodySizeInKB *int32 `json:"maxRequestBodySizeInKb,omitempty"`
+
+	// FileUploadLimitInMB - The maximum size of an uploaded file.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=750
+	// +optional
+	FileUploadLimitInMB *int32 `json:"fileUploadLimitInMb,omitempty"`
+}
+
+// ApplicationGatewayParameters defines the desired state of an
+// ApplicationGateway.
+type ApplicationGatewayParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the Application Gateway's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the the Application Gateway's
+	// resource group.
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to the Application
+	// Gateway's resource group.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - Resource location.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	Location string `json:"location"`
+
+	// SKU - The SKU of the Application Gateway.
+	SKU ApplicationGatewaySKU `json:"sku"`
+
+	// SubnetID - The ID of the subnet the Application Gateway is deployed to.
+	// The subnet must not contain other kinds of resources.
+	// +immutable
+	// +optional
+	SubnetID string `json:"subnetID,omitempty"`
+
+	// SubnetIDRef - A reference to a Subnet to retrieve its ID.
+	// +immutable
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIDRef,omitempty"`
+
+	// SubnetIDSelector - Selects a reference to a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIDSelector,omitempty"`
+
+	// FrontendIPConfigurations - The frontend IP addresses of the Application
+	// Gateway.
+	// +kubebuilder:validation:MinItems:=1
+	FrontendIPConfigurations []ApplicationGatewayFrontendIPConfiguration `json:"frontendIPConfigurations"`
+
+	// FrontendPorts - The frontend ports of the Application Gateway.
+	// +kubebuilder:validation:MinItems:=1
+	FrontendPorts []ApplicationGatewayFrontendPort `json:"frontendPorts"`
+
+	// BackendAddressPools - The backend address pools of the Application
+	// Gateway.
+	// +kubebuilder:validation:MinItems:=1
+	BackendAddressPools []ApplicationGatewayBackendAddressPool `json:"backendAddressPools"`
+
+	// BackendHTTPSettings - The backend HTTP settings of the Application
+	// Gateway.
+	// +kubebuilder:validation:MinItems:=1
+	BackendHTTPSettings []ApplicationGatewayBackendHTTPSettings `json:"backendHttpSettings"`
+
+	// HTTPListeners - The listeners of the Application Gateway.
+	// +kubebuilder:validation:MinItems:=1
+	HTTPListeners []ApplicationGatewayHTTPListener `json:"httpListeners"`
+
+	// RequestRoutingRules - The request routing rules of the Application
+	// Gateway.
+	// +kubebuilder:validation:MinItems:=1
+	RequestRoutingRules []ApplicationGatewayRequestRoutingRule `json:"requestRoutingRules"`
+
+	// WAFConfiguration - The web application firewall configuration of the
+	// Application Gateway.
+	// +optional
+	WAFConfiguration *ApplicationGatewayWAFConfiguration `json:"wafConfiguration,omitempty"`
+
+	// EnableHTTP2 - Whether the Application Gateway supports HTTP/2.
+	// +optional
+	EnableHTTP2 *bool `json:"enableHttp2,omitempty"`
+
+	// ManagedByIngressController - Whether the listeners, backend address
+	// pools, backend HTTP settings, frontend ports and request routing rules
+	// are managed by the Application Gateway Ingress Controller (AGIC). When
+	// true they are only used to create the Application Gateway, and changes
+	// AGIC makes to them are not reverted.
+	// +optional
+	ManagedByIngressController bool `json:"managedByIngressController,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An ApplicationGatewaySpec defines the desired state of an
+// ApplicationGateway.
+type ApplicationGatewaySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ApplicationGatewayParameters `json:"forProvider"`
+}
+
+// An ApplicationGatewayObservation represents the observed state of an
+// ApplicationGateway.
+type ApplicationGatewayObservation struct {
+	// State - The provisioning state of this ApplicationGateway.
+	State string `json:"state,omitempty"`
+
+	// OperationalState - Whether this ApplicationGateway is running.
+	OperationalState string `json:"operationalState,omitempty"`
+
+	// Etag - A unique string that changes whenever the resource is updated.
+	Etag string `json:"etag,omitempty"`
+
+	// ID of this ApplicationGateway.
+	ID string `json:"id,omitempty"`
+
+	// ResourceGUID - The GUID of this ApplicationGateway.
+	ResourceGUID string `json:"resourceGuid,omitempty"`
+}
+
+// An ApplicationGatewayStatus represents the observed state of an
+// ApplicationGateway.
+type ApplicationGatewayStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApplicationGatewayObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ApplicationGateway is a managed resource that represents an Azure
+// Application Gateway.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.operationalState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type ApplicationGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationGatewaySpec   `json:"spec"`
+	Status ApplicationGatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationGatewayList contains a list of ApplicationGateway items
+type ApplicationGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApplicationGateway `json:"items"`
+}
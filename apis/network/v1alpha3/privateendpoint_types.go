@@ -0,0 +1,213 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PrivateEndpointParameters defines the desired state of a PrivateEndpoint.
+type PrivateEndpointParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the Private Endpoint's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the the Private Endpoint's
+	// resource group.
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to the Private
+	// Endpoint's resource group.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - Resource location. It must be the location of the virtual
+	// network of the subnet.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	Location string `json:"location"`
+
+	// SubnetID - The ID of the subnet the network interface of the Private
+	// Endpoint is placed in.
+	// +immutable
+	// +optional
+	SubnetID string `json:"subnetID,omitempty"`
+
+	// SubnetIDRef - A reference to a Subnet to retrieve its ID.
+	// +immutable
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIDRef,omitempty"`
+
+	// SubnetIDSelector - Selects a reference to a Subnet to retrieve its ID.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIDSelector,omitempty"`
+
+	// PrivateLinkServiceID - The ID of the resource the Private Endpoint
+	// connects to, e.g. a storage account, SQL server or key vault. It may
+	// instead be resolved from one of the storageAccountRef, sqlServerRef or
+	// keyVaultRef references.
+	// +immutable
+	// +optional
+	PrivateLinkServiceID string `json:"privateLinkServiceID,omitempty"`
+
+	// StorageAccountRef - A reference to a storage Account to connect to.
+	// +immutable
+	// +optional
+	StorageAccountRef *xpv1.Reference `json:"storageAccountRef,omitempty"`
+
+	// StorageAccountSelector - Selects a reference to a storage Account to
+	// connect to.
+	// +optional
+	StorageAccountSelector *xpv1.Selector `json:"storageAccountSelector,omitempty"`
+
+	// SQLServerRef - A reference to a SQLServer to connect to.
+	// +immutable
+	// +optional
+	SQLServerRef *xpv1.Reference `json:"sqlServerRef,omitempty"`
+
+	// SQLServerSelector - Selects a reference to a SQLServer to connect to.
+	// +optional
+	SQLServerSelector *xpv1.Selector `json:"sqlServerSelector,omitempty"`
+
+	// KeyVaultRef - A reference to a KeyVault to connect to.
+	// +immutable
+	// +optional
+	KeyVaultRef *xpv1.Reference `json:"keyVaultRef,omitempty"`
+
+	// KeyVaultSelector - Selects a reference to a KeyVault to connect to.
+	// +optional
+	KeyVaultSelector *xpv1.Selector `json:"keyVaultSelector,omitempty"`
+
+	// GroupIDs - The sub-resources of the connected resource the Private
+	// Endpoint connects to, e.g. blob for a storage account, sqlServer for
+	// a SQL server or vault for a key vault.
+	// +kubebuilder:validation:MinItems:=1
+	// +immutable
+	GroupIDs []string `json:"groupIDs"`
+
+	// ManualApproval - Whether the connection must be approved by the owner
+	// of the connected resource. Approval is not required when the
+	// credentials of the provider can access the connected resource.
+	// +immutable
+	// +optional
+	ManualApproval bool `json:"manualApproval,omitempty"`
+
+	// RequestMessage - A message sent to the owner of the connected resource
+	// with a manual approval request.
+	// +kubebuilder:validation:MaxLength:=140
+	// +immutable
+	// +optional
+	RequestMessage *string `json:"requestMessage,omitempty"`
+
+	// PrivateDNSZoneIDs - The IDs of the private DNS zones, e.g.
+	// privatelink.blob.core.windows.net, the A records of the Private
+	// Endpoint are registered in. This makes the connected resource resolve
+	// to its private IP address in the virtual networks linked to the zones.
+	// +optional
+	PrivateDNSZoneIDs []string `json:"privateDNSZoneIDs,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A PrivateEndpointSpec defines the desired state of a PrivateEndpoint.
+type PrivateEndpointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PrivateEndpointParameters `json:"forProvider"`
+}
+
+// A PrivateEndpointDNSConfig is a DNS name of the connected resource and the
+// private IP addresses it resolves to through the Private Endpoint.
+type PrivateEndpointDNSConfig struct {
+	// FQDN - The fully qualified domain name.
+	FQDN string `json:"fqdn,omitempty"`
+
+	// IPAddresses - The private IP addresses of the domain name.
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+}
+
+// A PrivateEndpointObservation represents the observed state of a
+// PrivateEndpoint.
+type PrivateEndpointObservation struct {
+	// State - The provisioning state of this PrivateEndpoint.
+	State string `json:"state,omitempty"`
+
+	// ID of this PrivateEndpoint.
+	ID string `json:"id,omitempty"`
+
+	// ConnectionStatus - The status of the connection to the connected
+	// resource, i.e. Pending, Approved, Rejected or Disconnected.
+	ConnectionStatus string `json:"connectionStatus,omitempty"`
+
+	// ConnectionDescription - The reason given for the status of the
+	// connection.
+	ConnectionDescription string `json:"connectionDescription,omitempty"`
+
+	// NetworkInterfaceIDs - The IDs of the network interfaces of this
+	// PrivateEndpoint.
+	NetworkInterfaceIDs []string `json:"networkInterfaceIDs,omitempty"`
+
+	// CustomDNSConfigs - The DNS names of the connected resource and their
+	// private IP addresses, for use with DNS servers other than Azure's.
+	CustomDNSConfigs []PrivateEndpointDNSConfig `json:"customDNSConfigs,omitempty"`
+}
+
+// A PrivateEndpointStatus represents the observed state of a
+// PrivateEndpoint.
+type PrivateEndpointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PrivateEndpointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PrivateEndpoint is a managed resource that represents an Azure Private
+// Endpoint, which makes a storage account, SQL server, key vault or other
+// resource reachable over a private IP address of a virtual network.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CONNECTION",type="string",JSONPath=".status.atProvider.connectionStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type PrivateEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PrivateEndpointSpec   `json:"spec"`
+	Status PrivateEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PrivateEndpointList contains a list of PrivateEndpoint items
+type PrivateEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PrivateEndpoint `json:"items"`
+}
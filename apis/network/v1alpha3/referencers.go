@@ -25,6 +25,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	keyvaultv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/keyvault/v1alpha1"
+	sqlv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/sql/v1alpha1"
+	storagev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
 	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 )
 
@@ -40,6 +43,30 @@ func SubnetID() reference.ExtractValueFn {
 	}
 }
 
+// PublicIPAddressID extracts status.atProvider.id from the supplied managed
+// resource, which must be a PublicIPAddress.
+func PublicIPAddressID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		p, ok := mg.(*PublicIPAddress)
+		if !ok {
+			return ""
+		}
+		return p.Status.AtProvider.ID
+	}
+}
+
+// SecurityGroupID extracts status.atProvider.id from the supplied managed
+// resource, which must be a SecurityGroup.
+func SecurityGroupID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		s, ok := mg.(*SecurityGroup)
+		if !ok {
+			return ""
+		}
+		return s.Status.AtProvider.ID
+	}
+}
+
 // ResolveReferences of this VirtualNetwork
 func (mg *VirtualNetwork) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -93,6 +120,20 @@ func (mg *Subnet) ResolveReferences(ctx context.Context, c client.Reader) error
 	mg.Spec.VirtualNetworkName = rsp.ResolvedValue
 	mg.Spec.VirtualNetworkNameRef = rsp.ResolvedReference
 
+	// Resolve spec.properties.networkSecurityGroupID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.NetworkSecurityGroupID,
+		Reference:    mg.Spec.NetworkSecurityGroupIDRef,
+		Selector:     mg.Spec.NetworkSecurityGroupIDSelector,
+		To:           reference.To{Managed: &SecurityGroup{}, List: &SecurityGroupList{}},
+		Extract:      SecurityGroupID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.properties.networkSecurityGroupID")
+	}
+	mg.Spec.NetworkSecurityGroupID = rsp.ResolvedValue
+	mg.Spec.NetworkSecurityGroupIDRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -116,3 +157,157 @@ func (mg *PublicIPAddress) ResolveReferences(ctx context.Context, c client.Reade
 
 	return nil
 }
+
+// ResolveReferences of this SecurityGroup
+func (mg *SecurityGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ApplicationGateway
+func (mg *ApplicationGateway) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.subnetID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.SubnetID,
+		Reference:    mg.Spec.ForProvider.SubnetIDRef,
+		Selector:     mg.Spec.ForProvider.SubnetIDSelector,
+		To:           reference.To{Managed: &Subnet{}, List: &SubnetList{}},
+		Extract:      SubnetID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetID")
+	}
+	mg.Spec.ForProvider.SubnetID = rsp.ResolvedValue
+	mg.Spec.ForProvider.SubnetIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.frontendIPConfigurations[*].publicIPAddressID
+	for i := range mg.Spec.ForProvider.FrontendIPConfigurations {
+		fe := &mg.Spec.ForProvider.FrontendIPConfigurations[i]
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(fe.PublicIPAddressID),
+			Reference:    fe.PublicIPAddressIDRef,
+			Selector:     fe.PublicIPAddressIDSelector,
+			To:           reference.To{Managed: &PublicIPAddress{}, List: &PublicIPAddressList{}},
+			Extract:      PublicIPAddressID(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.frontendIPConfigurations[%d].publicIPAddressID", i)
+		}
+		fe.PublicIPAddressID = reference.ToPtrValue(rsp.ResolvedValue)
+		fe.PublicIPAddressIDRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this PrivateEndpoint
+func (mg *PrivateEndpoint) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.subnetID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.SubnetID,
+		Reference:    mg.Spec.ForProvider.SubnetIDRef,
+		Selector:     mg.Spec.ForProvider.SubnetIDSelector,
+		To:           reference.To{Managed: &Subnet{}, List: &SubnetList{}},
+		Extract:      SubnetID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetID")
+	}
+	mg.Spec.ForProvider.SubnetID = rsp.ResolvedValue
+	mg.Spec.ForProvider.SubnetIDRef = rsp.ResolvedReference
+
+	// The storage account, SQL server and key vault references all resolve
+	// spec.forProvider.privateLinkServiceID. Once one of them resolved it the
+	// others are no-ops.
+
+	// Resolve spec.forProvider.privateLinkServiceID from a storage Account
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.PrivateLinkServiceID,
+		Reference:    mg.Spec.ForProvider.StorageAccountRef,
+		Selector:     mg.Spec.ForProvider.StorageAccountSelector,
+		To:           reference.To{Managed: &storagev1alpha3.Account{}, List: &storagev1alpha3.AccountList{}},
+		Extract:      storagev1alpha3.AccountID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.storageAccountRef")
+	}
+	mg.Spec.ForProvider.PrivateLinkServiceID = rsp.ResolvedValue
+	mg.Spec.ForProvider.StorageAccountRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.privateLinkServiceID from a SQLServer
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.PrivateLinkServiceID,
+		Reference:    mg.Spec.ForProvider.SQLServerRef,
+		Selector:     mg.Spec.ForProvider.SQLServerSelector,
+		To:           reference.To{Managed: &sqlv1alpha1.SQLServer{}, List: &sqlv1alpha1.SQLServerList{}},
+		Extract:      sqlv1alpha1.SQLServerID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sqlServerRef")
+	}
+	mg.Spec.ForProvider.PrivateLinkServiceID = rsp.ResolvedValue
+	mg.Spec.ForProvider.SQLServerRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.privateLinkServiceID from a KeyVault
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.PrivateLinkServiceID,
+		Reference:    mg.Spec.ForProvider.KeyVaultRef,
+		Selector:     mg.Spec.ForProvider.KeyVaultSelector,
+		To:           reference.To{Managed: &keyvaultv1alpha1.KeyVault{}, List: &keyvaultv1alpha1.KeyVaultList{}},
+		Extract:      keyvaultv1alpha1.KeyVaultID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.keyVaultRef")
+	}
+	mg.Spec.ForProvider.PrivateLinkServiceID = rsp.ResolvedValue
+	mg.Spec.ForProvider.KeyVaultRef = rsp.ResolvedReference
+
+	return nil
+}
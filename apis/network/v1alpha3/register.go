@@ -61,8 +61,35 @@ var (
 	PublicIPAddressGroupVersionKind = SchemeGroupVersion.WithKind(PublicIPAddressKind)
 )
 
+// SecurityGroup type metadata.
+var (
+	SecurityGroupKind             = reflect.TypeOf(SecurityGroup{}).Name()
+	SecurityGroupGroupKind        = schema.GroupKind{Group: Group, Kind: SecurityGroupKind}.String()
+	SecurityGroupKindAPIVersion   = SecurityGroupKind + "." + SchemeGroupVersion.String()
+	SecurityGroupGroupVersionKind = SchemeGroupVersion.WithKind(SecurityGroupKind)
+)
+
+// ApplicationGateway type metadata.
+var (
+	ApplicationGatewayKind             = reflect.TypeOf(ApplicationGateway{}).Name()
+	ApplicationGatewayGroupKind        = schema.GroupKind{Group: Group, Kind: ApplicationGatewayKind}.String()
+	ApplicationGatewayKindAPIVersion   = ApplicationGatewayKind + "." + SchemeGroupVersion.String()
+	ApplicationGatewayGroupVersionKind = SchemeGroupVersion.WithKind(ApplicationGatewayKind)
+)
+
+// PrivateEndpoint type metadata.
+var (
+	PrivateEndpointKind             = reflect.TypeOf(PrivateEndpoint{}).Name()
+	PrivateEndpointGroupKind        = schema.GroupKind{Group: Group, Kind: PrivateEndpointKind}.String()
+	PrivateEndpointKindAPIVersion   = PrivateEndpointKind + "." + SchemeGroupVersion.String()
+	PrivateEndpointGroupVersionKind = SchemeGroupVersion.WithKind(PrivateEndpointKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&VirtualNetwork{}, &VirtualNetworkList{})
 	SchemeBuilder.Register(&Subnet{}, &SubnetList{})
 	SchemeBuilder.Register(&PublicIPAddress{}, &PublicIPAddressList{})
+	SchemeBuilder.Register(&SecurityGroup{}, &SecurityGroupList{})
+	SchemeBuilder.Register(&ApplicationGateway{}, &ApplicationGatewayList{})
+	SchemeBuilder.Register(&PrivateEndpoint{}, &PrivateEndpointList{})
 }
@@ -53,6 +53,12 @@ type VirtualNetworkPropertiesFormat struct {
 type VirtualNetworkSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
 	// ResourceGroupName - Name of the Virtual Network's resource group.
 	ResourceGroupName string `json:"resourceGroupName,omitempty"`
 
@@ -150,6 +156,21 @@ type SubnetPropertiesFormat struct {
 
 	// ServiceEndpoints - An array of service endpoints.
 	ServiceEndpoints []ServiceEndpointPropertiesFormat `json:"serviceEndpoints,omitempty"`
+
+	// NetworkSecurityGroupID - The ID of the Network Security Group attached
+	// to the subnet.
+	// +optional
+	NetworkSecurityGroupID string `json:"networkSecurityGroupID,omitempty"`
+
+	// NetworkSecurityGroupIDRef - A reference to a SecurityGroup to retrieve
+	// its ID.
+	// +optional
+	NetworkSecurityGroupIDRef *xpv1.Reference `json:"networkSecurityGroupIDRef,omitempty"`
+
+	// NetworkSecurityGroupIDSelector - Selects a reference to a SecurityGroup
+	// to retrieve its ID.
+	// +optional
+	NetworkSecurityGroupIDSelector *xpv1.Selector `json:"networkSecurityGroupIDSelector,omitempty"`
 }
 
 // A SubnetSpec defines the desired state of a Subnet.
@@ -166,6 +187,12 @@ type SubnetSpec struct {
 	// retrieve its name
 	VirtualNetworkNameSelector *xpv1.Selector `json:"virtualNetworkNameSelector,omitempty"`
 
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
 	// ResourceGroupName - Name of the Subnet's resource group.
 	ResourceGroupName string `json:"resourceGroupName,omitempty"`
 
@@ -263,6 +290,12 @@ type IPTag struct {
 
 // PublicIPAddressProperties defines properties of the PublicIPAddress.
 type PublicIPAddressProperties struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
 	// ResourceGroupName - Name of the Public IP address's resource group.
 	// +immutable
 	ResourceGroupName string `json:"resourceGroupName,omitempty"`
@@ -303,6 +336,13 @@ type PublicIPAddressProperties struct {
 	// +optional
 	PublicIPPrefixID *string `json:"publicIPPrefixID,omitempty"`
 
+	// Zones - A list of availability zones denoting where the IP address
+	// needs to come from. Specifying more than one zone makes a Standard SKU
+	// IP address zone-redundant.
+	// +immutable
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
 	// PublicIPAddressDNSSettings - The FQDN of the DNS record associated with the public IP address.
 	// +optional
 	PublicIPAddressDNSSettings *PublicIPAddressDNSSettings `json:"dnsSettings,omitempty"`
@@ -424,3 +464,170 @@ type PublicIPAddressList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []PublicIPAddress `json:"items"`
 }
+
+// SecurityRule is a rule of a SecurityGroup that allows or denies network
+// traffic.
+type SecurityRule struct {
+	// Name - The name of the rule, unique within the security group.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// Description - A description of the rule. Restricted to 140 characters.
+	// +kubebuilder:validation:MaxLength:=140
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Protocol - The network protocol the rule applies to.
+	// +kubebuilder:validation:Enum=Tcp;Udp;Icmp;Esp;*
+	Protocol string `json:"protocol"`
+
+	// SourcePortRange - The source port or range, e.g. 80 or 1024-65535.
+	// Asterisk '*' matches all ports.
+	// +optional
+	SourcePortRange *string `json:"sourcePortRange,omitempty"`
+
+	// SourcePortRanges - The source ports or ranges.
+	// +optional
+	SourcePortRanges []string `json:"sourcePortRanges,omitempty"`
+
+	// DestinationPortRange - The destination port or range, e.g. 443 or
+	// 8000-8999. Asterisk '*' matches all ports.
+	// +optional
+	DestinationPortRange *string `json:"destinationPortRange,omitempty"`
+
+	// DestinationPortRanges - The destination ports or ranges.
+	// +optional
+	DestinationPortRanges []string `json:"destinationPortRanges,omitempty"`
+
+	// SourceAddressPrefix - The source CIDR or IP range. Asterisk '*' matches
+	// all source IPs. Service tags such as VirtualNetwork, AzureLoadBalancer
+	// and Internet can also be used.
+	// +optional
+	SourceAddressPrefix *string `json:"sourceAddressPrefix,omitempty"`
+
+	// SourceAddressPrefixes - The source CIDRs or IP ranges.
+	// +optional
+	SourceAddressPrefixes []string `json:"sourceAddressPrefixes,omitempty"`
+
+	// DestinationAddressPrefix - The destination CIDR or IP range. Asterisk
+	// '*' matches all destination IPs. Service tags such as VirtualNetwork,
+	// AzureLoadBalancer and Internet can also be used.
+	// +optional
+	DestinationAddressPrefix *string `json:"destinationAddressPrefix,omitempty"`
+
+	// DestinationAddressPrefixes - The destination CIDRs or IP ranges.
+	// +optional
+	DestinationAddressPrefixes []string `json:"destinationAddressPrefixes,omitempty"`
+
+	// Access - Whether the rule allows or denies network traffic.
+	// +kubebuilder:validation:Enum=Allow;Deny
+	Access string `json:"access"`
+
+	// Priority - The priority of the rule, unique within the security group
+	// and direction. Rules with a lower number are evaluated first.
+	// +kubebuilder:validation:Minimum:=100
+	// +kubebuilder:validation:Maximum:=4096
+	Priority int32 `json:"priority"`
+
+	// Direction - Whether the rule is evaluated on inbound or outbound
+	// traffic.
+	// +kubebuilder:validation:Enum=Inbound;Outbound
+	Direction string `json:"direction"`
+}
+
+// SecurityGroupParameters defines the desired state of a SecurityGroup.
+type SecurityGroupParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the Network Security Group's resource group.
+	// +immutable
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to the the Network Security Group's
+	// resource group.
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Select a reference to the Network Security
+	// Group's resource group.
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - Resource location.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	Location string `json:"location"`
+
+	// SecurityRules - The complete set of security rules of the Network
+	// Security Group. Rules that are added, changed or removed outside of
+	// Crossplane are reverted.
+	// +optional
+	SecurityRules []SecurityRule `json:"securityRules,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A SecurityGroupSpec defines the desired state of a SecurityGroup.
+type SecurityGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecurityGroupParameters `json:"forProvider"`
+}
+
+// A SecurityGroupObservation represents the observed state of a
+// SecurityGroup.
+type SecurityGroupObservation struct {
+	// State of this SecurityGroup.
+	State string `json:"state,omitempty"`
+
+	// Etag - A unique string that changes whenever the resource is updated.
+	Etag string `json:"etag,omitempty"`
+
+	// ID of this SecurityGroup.
+	ID string `json:"id,omitempty"`
+
+	// ResourceGUID - The GUID of this SecurityGroup.
+	ResourceGUID string `json:"resourceGuid,omitempty"`
+
+	// Subnets - The IDs of the subnets the SecurityGroup is attached to.
+	Subnets []string `json:"subnets,omitempty"`
+}
+
+// A SecurityGroupStatus represents the observed state of a SecurityGroup.
+type SecurityGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SecurityGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecurityGroup is a managed resource that represents an Azure Network
+// Security Group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure},shortName=nsg
+type SecurityGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecurityGroupSpec   `json:"spec"`
+	Status SecurityGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityGroupList contains a list of SecurityGroup items
+type SecurityGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityGroup `json:"items"`
+}
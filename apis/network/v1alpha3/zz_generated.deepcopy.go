@@ -46,37 +46,673 @@ func (in *AddressSpace) DeepCopy() *AddressSpace {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationGateway) DeepCopyInto(out *ApplicationGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationGateway.
+func (in *ApplicationGateway) DeepCopy() *ApplicationGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationGatewayBackendAddressPool) DeepCopyInto(out *ApplicationGatewayBackendAddressPool) {
+	*out = *in
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FQDNs != nil {
+		in, out := &in.FQDNs, &out.FQDNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationGatewayBackendAddressPool.
+func (in *ApplicationGatewayBackendAddressPool) DeepCopy() *ApplicationGatewayBackendAddressPool {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationGatewayBackendAddressPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationGatewayBackendHTTPSettings) DeepCopyInto(out *ApplicationGatewayBackendHTTPSettings) {
+	*out = *in
+	if in.CookieBasedAffinity != nil {
+		in, out := &in.CookieBasedAffinity, &out.CookieBasedAffinity
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequestTimeout != nil {
+		in, out := &in.RequestTimeout, &out.RequestTimeout
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HostName != nil {
+		in, out := &in.HostName, &out.HostName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PickHostNameFromBackendAddress != nil {
+		in, out := &in.PickHostNameFromBackendAddress, &out.PickHostNameFromBackendAddress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationGatewayBackendHTTPSettings.
+func (in *ApplicationGatewayBackendHTTPSettings) DeepCopy() *ApplicationGatewayBackendHTTPSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationGatewayBackendHTTPSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationGatewayFrontendIPConfiguration) DeepCopyInto(out *ApplicationGatewayFrontendIPConfiguration) {
+	*out = *in
+	if in.PublicIPAddressID != nil {
+		in, out := &in.PublicIPAddressID, &out.PublicIPAddressID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIPAddressIDRef != nil {
+		in, out := &in.PublicIPAddressIDRef, &out.PublicIPAddressIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PublicIPAddressIDSelector != nil {
+		in, out := &in.PublicIPAddressIDSelector, &out.PublicIPAddressIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationGatewayFrontendIPConfiguration.
+func (in *ApplicationGatewayFrontendIPConfiguration) DeepCopy() *ApplicationGatewayFrontendIPConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationGatewayFrontendIPConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationGatewayFrontendPort) DeepCopyInto(out *ApplicationGatewayFrontendPort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationGatewayFrontendPort.
+func (in *ApplicationGatewayFrontendPort) DeepCopy() *ApplicationGatewayFrontendPort {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationGatewayFrontendPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationGatewayHTTPListener) DeepCopyInto(out *ApplicationGatewayHTTPListener) {
+	*out = *in
+	if in.HostName != nil {
+		in, out := &in.HostName, &out.HostName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationGatewayHTTPListener.
+func (in *ApplicationGatewayHTTPListener) DeepCopy() *ApplicationGatewayHTTPListener {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationGatewayHTTPListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationGatewayList) DeepCopyInto(out *ApplicationGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApplicationGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationGatewayList.
+func (in *ApplicationGatewayList) DeepCopy() *ApplicationGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationGatewayObservation) DeepCopyInto(out *ApplicationGatewayObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationGatewayObservation.
+func (in *ApplicationGatewayObservation) DeepCopy() *ApplicationGatewayObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationGatewayObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationGatewayParameters) DeepCopyInto(out *ApplicationGatewayParameters) {
+	*out = *in
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.SKU.DeepCopyInto(&out.SKU)
+	if in.SubnetIDRef != nil {
+		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FrontendIPConfigurations != nil {
+		in, out := &in.FrontendIPConfigurations, &out.FrontendIPConfigurations
+		*out = make([]ApplicationGatewayFrontendIPConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FrontendPorts != nil {
+		in, out := &in.FrontendPorts, &out.FrontendPorts
+		*out = make([]ApplicationGatewayFrontendPort, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackendAddressPools != nil {
+		in, out := &in.BackendAddressPools, &out.BackendAddressPools
+		*out = make([]ApplicationGatewayBackendAddressPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BackendHTTPSettings != nil {
+		in, out := &in.BackendHTTPSettings, &out.BackendHTTPSettings
+		*out = make([]ApplicationGatewayBackendHTTPSettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HTTPListeners != nil {
+		in, out := &in.HTTPListeners, &out.HTTPListeners
+		*out = make([]ApplicationGatewayHTTPListener, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RequestRoutingRules != nil {
+		in, out := &in.RequestRoutingRules, &out.RequestRoutingRules
+		*out = make([]ApplicationGatewayRequestRoutingRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.WAFConfiguration != nil {
+		in, out := &in.WAFConfiguration, &out.WAFConfiguration
+		*out = new(ApplicationGatewayWAFConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnableHTTP2 != nil {
+		in, out := &in.EnableHTTP2, &out.EnableHTTP2
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationGatewayParameters.
+func (in *ApplicationGatewayParameters) DeepCopy() *ApplicationGatewayParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationGatewayParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationGatewayRequestRoutingRule) DeepCopyInto(out *ApplicationGatewayRequestRoutingRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationGatewayRequestRoutingRule.
+func (in *ApplicationGatewayRequestRoutingRule) DeepCopy() *ApplicationGatewayRequestRoutingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationGatewayRequestRoutingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationGatewaySKU) DeepCopyInto(out *ApplicationGatewaySKU) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationGatewaySKU.
+func (in *ApplicationGatewaySKU) DeepCopy() *ApplicationGatewaySKU {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationGatewaySKU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationGatewaySpec) DeepCopyInto(out *ApplicationGatewaySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationGatewaySpec.
+func (in *ApplicationGatewaySpec) DeepCopy() *ApplicationGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationGatewayStatus) DeepCopyInto(out *ApplicationGatewayStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationGatewayStatus.
+func (in *ApplicationGatewayStatus) DeepCopy() *ApplicationGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationGatewayWAFConfiguration) DeepCopyInto(out *ApplicationGatewayWAFConfiguration) {
+	*out = *in
+	if in.RequestBodyCheck != nil {
+		in, out := &in.RequestBodyCheck, &out.RequestBodyCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxRequestBodySizeInKB != nil {
+		in, out := &in.MaxRequestBodySizeInKB, &out.MaxRequestBodySizeInKB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FileUploadLimitInMB != nil {
+		in, out := &in.FileUploadLimitInMB, &out.FileUploadLimitInMB
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationGatewayWAFConfiguration.
+func (in *ApplicationGatewayWAFConfiguration) DeepCopy() *ApplicationGatewayWAFConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationGatewayWAFConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPConfiguration) DeepCopyInto(out *IPConfiguration) {
 	*out = *in
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPConfiguration.
+func (in *IPConfiguration) DeepCopy() *IPConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(IPConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPTag) DeepCopyInto(out *IPTag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPTag.
+func (in *IPTag) DeepCopy() *IPTag {
+	if in == nil {
+		return nil
+	}
+	out := new(IPTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateEndpoint) DeepCopyInto(out *PrivateEndpoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateEndpoint.
+func (in *PrivateEndpoint) DeepCopy() *PrivateEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PrivateEndpoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateEndpointDNSConfig) DeepCopyInto(out *PrivateEndpointDNSConfig) {
+	*out = *in
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateEndpointDNSConfig.
+func (in *PrivateEndpointDNSConfig) DeepCopy() *PrivateEndpointDNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateEndpointDNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateEndpointList) DeepCopyInto(out *PrivateEndpointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PrivateEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateEndpointList.
+func (in *PrivateEndpointList) DeepCopy() *PrivateEndpointList {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateEndpointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PrivateEndpointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateEndpointObservation) DeepCopyInto(out *PrivateEndpointObservation) {
+	*out = *in
+	if in.NetworkInterfaceIDs != nil {
+		in, out := &in.NetworkInterfaceIDs, &out.NetworkInterfaceIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomDNSConfigs != nil {
+		in, out := &in.CustomDNSConfigs, &out.CustomDNSConfigs
+		*out = make([]PrivateEndpointDNSConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateEndpointObservation.
+func (in *PrivateEndpointObservation) DeepCopy() *PrivateEndpointObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateEndpointObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateEndpointParameters) DeepCopyInto(out *PrivateEndpointParameters) {
+	*out = *in
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetIDRef != nil {
+		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageAccountRef != nil {
+		in, out := &in.StorageAccountRef, &out.StorageAccountRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.StorageAccountSelector != nil {
+		in, out := &in.StorageAccountSelector, &out.StorageAccountSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SQLServerRef != nil {
+		in, out := &in.SQLServerRef, &out.SQLServerRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SQLServerSelector != nil {
+		in, out := &in.SQLServerSelector, &out.SQLServerSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeyVaultRef != nil {
+		in, out := &in.KeyVaultRef, &out.KeyVaultRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.KeyVaultSelector != nil {
+		in, out := &in.KeyVaultSelector, &out.KeyVaultSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDs != nil {
+		in, out := &in.GroupIDs, &out.GroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequestMessage != nil {
+		in, out := &in.RequestMessage, &out.RequestMessage
 		*out = new(string)
 		**out = **in
 	}
+	if in.PrivateDNSZoneIDs != nil {
+		in, out := &in.PrivateDNSZoneIDs, &out.PrivateDNSZoneIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPConfiguration.
-func (in *IPConfiguration) DeepCopy() *IPConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateEndpointParameters.
+func (in *PrivateEndpointParameters) DeepCopy() *PrivateEndpointParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(IPConfiguration)
+	out := new(PrivateEndpointParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPTag) DeepCopyInto(out *IPTag) {
+func (in *PrivateEndpointSpec) DeepCopyInto(out *PrivateEndpointSpec) {
 	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPTag.
-func (in *IPTag) DeepCopy() *IPTag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateEndpointSpec.
+func (in *PrivateEndpointSpec) DeepCopy() *PrivateEndpointSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IPTag)
+	out := new(PrivateEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateEndpointStatus) DeepCopyInto(out *PrivateEndpointStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateEndpointStatus.
+func (in *PrivateEndpointStatus) DeepCopy() *PrivateEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateEndpointStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -218,6 +854,11 @@ func (in *PublicIPAddressObservation) DeepCopy() *PublicIPAddressObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicIPAddressProperties) DeepCopyInto(out *PublicIPAddressProperties) {
 	*out = *in
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
 	if in.ResourceGroupNameRef != nil {
 		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
 		*out = new(v1.Reference)
@@ -238,6 +879,11 @@ func (in *PublicIPAddressProperties) DeepCopyInto(out *PublicIPAddressProperties
 		*out = new(string)
 		**out = **in
 	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.PublicIPAddressDNSSettings != nil {
 		in, out := &in.PublicIPAddressDNSSettings, &out.PublicIPAddressDNSSettings
 		*out = new(PublicIPAddressDNSSettings)
@@ -321,6 +967,223 @@ func (in *SKU) DeepCopy() *SKU {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroup.
+func (in *SecurityGroup) DeepCopy() *SecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupList) DeepCopyInto(out *SecurityGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecurityGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupList.
+func (in *SecurityGroupList) DeepCopy() *SecurityGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupObservation) DeepCopyInto(out *SecurityGroupObservation) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupObservation.
+func (in *SecurityGroupObservation) DeepCopy() *SecurityGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupParameters) DeepCopyInto(out *SecurityGroupParameters) {
+	*out = *in
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityRules != nil {
+		in, out := &in.SecurityRules, &out.SecurityRules
+		*out = make([]SecurityRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupParameters.
+func (in *SecurityGroupParameters) DeepCopy() *SecurityGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupSpec) DeepCopyInto(out *SecurityGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupSpec.
+func (in *SecurityGroupSpec) DeepCopy() *SecurityGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupStatus) DeepCopyInto(out *SecurityGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupStatus.
+func (in *SecurityGroupStatus) DeepCopy() *SecurityGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityRule) DeepCopyInto(out *SecurityRule) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourcePortRange != nil {
+		in, out := &in.SourcePortRange, &out.SourcePortRange
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourcePortRanges != nil {
+		in, out := &in.SourcePortRanges, &out.SourcePortRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationPortRange != nil {
+		in, out := &in.DestinationPortRange, &out.DestinationPortRange
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationPortRanges != nil {
+		in, out := &in.DestinationPortRanges, &out.DestinationPortRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceAddressPrefix != nil {
+		in, out := &in.SourceAddressPrefix, &out.SourceAddressPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceAddressPrefixes != nil {
+		in, out := &in.SourceAddressPrefixes, &out.SourceAddressPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationAddressPrefix != nil {
+		in, out := &in.DestinationAddressPrefix, &out.DestinationAddressPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationAddressPrefixes != nil {
+		in, out := &in.DestinationAddressPrefixes, &out.DestinationAddressPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityRule.
+func (in *SecurityRule) DeepCopy() *SecurityRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceEndpointPropertiesFormat) DeepCopyInto(out *ServiceEndpointPropertiesFormat) {
 	*out = *in
@@ -410,6 +1273,16 @@ func (in *SubnetPropertiesFormat) DeepCopyInto(out *SubnetPropertiesFormat) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NetworkSecurityGroupIDRef != nil {
+		in, out := &in.NetworkSecurityGroupIDRef, &out.NetworkSecurityGroupIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.NetworkSecurityGroupIDSelector != nil {
+		in, out := &in.NetworkSecurityGroupIDSelector, &out.NetworkSecurityGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetPropertiesFormat.
@@ -436,6 +1309,11 @@ func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
 	if in.ResourceGroupNameRef != nil {
 		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
 		*out = new(v1.Reference)
@@ -554,6 +1432,11 @@ func (in *VirtualNetworkPropertiesFormat) DeepCopy() *VirtualNetworkPropertiesFo
 func (in *VirtualNetworkSpec) DeepCopyInto(out *VirtualNetworkSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
 	if in.ResourceGroupNameRef != nil {
 		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
 		*out = new(v1.Reference)
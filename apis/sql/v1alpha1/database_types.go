@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+// SQLDatabaseParameters defines the desired state of an Azure SQL database.
+// https://docs.microsoft.com/en-us/rest/api/sql/databases
+type SQLDatabaseParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the database's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// ServerName - Name of the database's server.
+	// +immutable
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+
+	// ServerNameRef - A reference to the database's server.
+	// +immutable
+	// +optional
+	ServerNameRef *xpv1.Reference `json:"serverNameRef,omitempty"`
+
+	// ServerNameSelector - Selects a server to reference.
+	// +immutable
+	// +optional
+	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
+
+	// Location - The Azure location of the database. It must be the location
+	// of its server.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	Location string `json:"location"`
+
+	// SKU - The SKU of the database, e.g. S0 or GP_Gen5_2. Databases in an
+	// elastic pool use the ElasticPool SKU, which Azure sets.
+	// +optional
+	SKU *SKU `json:"sku,omitempty"`
+
+	// ElasticPoolID - The ID of the elastic pool the database is placed in.
+	// +optional
+	ElasticPoolID *string `json:"elasticPoolId,omitempty"`
+
+	// ElasticPoolIDRef - A reference to the ElasticPool to place the
+	// database in.
+	// +optional
+	ElasticPoolIDRef *xpv1.Reference `json:"elasticPoolIdRef,omitempty"`
+
+	// ElasticPoolIDSelector - Selects an ElasticPool to place the database in.
+	// +optional
+	ElasticPoolIDSelector *xpv1.Selector `json:"elasticPoolIdSelector,omitempty"`
+
+	// Collation - The collation of the database.
+	// +immutable
+	// +optional
+	Collation *string `json:"collation,omitempty"`
+
+	// MaxSizeBytes - The storage limit of the database in bytes.
+	// +optional
+	MaxSizeBytes *int64 `json:"maxSizeBytes,omitempty"`
+
+	// ZoneRedundant - Whether the database is spread across availability
+	// zones.
+	// +optional
+	ZoneRedundant *bool `json:"zoneRedundant,omitempty"`
+
+	// LicenseType - The license type of vCore databases.
+	// +kubebuilder:validation:Enum=LicenseIncluded;BasePrice
+	// +optional
+	LicenseType *string `json:"licenseType,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A SQLDatabaseSpec defines the desired state of a SQLDatabase.
+type SQLDatabaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SQLDatabaseParameters `json:"forProvider"`
+}
+
+// SQLDatabaseObservation represents the observed state of the Azure SQL
+// database.
+type SQLDatabaseObservation struct {
+	// ID - Fully qualified resource identifier of the database.
+	ID string `json:"id,omitempty"`
+
+	// Status - The status of the database.
+	Status string `json:"status,omitempty"`
+
+	// DatabaseID - The ID Azure SQL assigned to the database.
+	DatabaseID string `json:"databaseId,omitempty"`
+
+	// CurrentServiceObjectiveName - The service level objective the database
+	// currently has, e.g. S0.
+	CurrentServiceObjectiveName string `json:"currentServiceObjectiveName,omitempty"`
+
+	// LastOperation represents the state of the last long-running operation
+	// started on the database by the controller.
+	LastOperation v1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+}
+
+// A SQLDatabaseStatus represents the observed state of a SQLDatabase.
+type SQLDatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SQLDatabaseObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SQLDatabase is a managed resource that represents an Azure SQL database,
+// either a single database or one placed in an ElasticPool. The external name
+// of a SQLDatabase is the name of the database.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVER",type="string",JSONPath=".spec.forProvider.serverName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type SQLDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SQLDatabaseSpec   `json:"spec"`
+	Status SQLDatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SQLDatabaseList contains a list of SQLDatabase.
+type SQLDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SQLDatabase `json:"items"`
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+// A SKU of an Azure SQL database or elastic pool.
+type SKU struct {
+	// Name - The name of the SKU, e.g. S0, GP_Gen5_2 or StandardPool.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// Tier - The tier or edition of the SKU, e.g. Standard or
+	// GeneralPurpose.
+	// +optional
+	Tier *string `json:"tier,omitempty"`
+
+	// Family - The hardware family of vCore SKUs, e.g. Gen5.
+	// +optional
+	Family *string `json:"family,omitempty"`
+
+	// Capacity - The capacity of the SKU, in DTUs or vCores.
+	// +optional
+	Capacity *int32 `json:"capacity,omitempty"`
+}
+
+// ElasticPoolPerDatabaseSettings are the limits each database of an elastic
+// pool is subject to.
+type ElasticPoolPerDatabaseSettings struct {
+	// MinCapacity - The capacity each database is guaranteed, in DTUs or
+	// vCores, e.g. 0.25.
+	// +optional
+	MinCapacity *resource.Quantity `json:"minCapacity,omitempty"`
+
+	// MaxCapacity - The capacity each database can use at most, in DTUs or
+	// vCores.
+	// +optional
+	MaxCapacity *resource.Quantity `json:"maxCapacity,omitempty"`
+}
+
+// ElasticPoolParameters defines the desired state of an Azure SQL elastic
+// pool.
+// https://docs.microsoft.com/en-us/rest/api/sql/elasticpools
+type ElasticPoolParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the elastic pool's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// ServerName - Name of the elastic pool's server.
+	// +immutable
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+
+	// ServerNameRef - A reference to the elastic pool's server.
+	// +immutable
+	// +optional
+	ServerNameRef *xpv1.Reference `json:"serverNameRef,omitempty"`
+
+	// ServerNameSelector - Selects a server to reference.
+	// +immutable
+	// +optional
+	ServerNameSelector *xpv1.Selector `json:"serverNameSelector,omitempty"`
+
+	// Location - The Azure location of the elastic pool. It must be the
+	// location of its server.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	Location string `json:"location"`
+
+	// SKU - The SKU of the elastic pool, e.g. StandardPool or GP_Gen5.
+	SKU SKU `json:"sku"`
+
+	// MaxSizeBytes - The storage limit of the elastic pool in bytes.
+	// +optional
+	MaxSizeBytes *int64 `json:"maxSizeBytes,omitempty"`
+
+	// PerDatabaseSettings - The limits each database of the elastic pool is
+	// subject to.
+	// +optional
+	PerDatabaseSettings *ElasticPoolPerDatabaseSettings `json:"perDatabaseSettings,omitempty"`
+
+	// ZoneRedundant - Whether the elastic pool is spread across availability
+	// zones.
+	// +optional
+	ZoneRedundant *bool `json:"zoneRedundant,omitempty"`
+
+	// LicenseType - The license type of vCore elastic pools.
+	// +kubebuilder:validation:Enum=LicenseIncluded;BasePrice
+	// +optional
+	LicenseType *string `json:"licenseType,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An ElasticPoolSpec defines the desired state of an ElasticPool.
+type ElasticPoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ElasticPoolParameters `json:"forProvider"`
+}
+
+// ElasticPoolObservation represents the observed state of the Azure SQL
+// elastic pool.
+type ElasticPoolObservation struct {
+	// ID - Fully qualified resource identifier of the elastic pool.
+	ID string `json:"id,omitempty"`
+
+	// State - The state of the elastic pool.
+	State string `json:"state,omitempty"`
+
+	// LastOperation represents the state of the last long-running operation
+	// started on the elastic pool by the controller.
+	LastOperation v1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+}
+
+// An ElasticPoolStatus represents the observed state of an ElasticPool.
+type ElasticPoolStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ElasticPoolObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ElasticPool is a managed resource that represents an Azure SQL elastic
+// pool, whose resources are shared by the SQLDatabases placed in it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVER",type="string",JSONPath=".spec.forProvider.serverName"
+// +kubebuilder:printcolumn:name="SKU",type="string",JSONPath=".spec.forProvider.sku.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type ElasticPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ElasticPoolSpec   `json:"spec"`
+	Status ElasticPoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ElasticPoolList contains a list of ElasticPool.
+type ElasticPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ElasticPool `json:"items"`
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/crossplane-contrib/provider-azure/apis/sql/v1alpha1"
+)
+
+// ConvertTo converts this SQLServer to the v1alpha1 hub version.
+func (in *SQLServer) ConvertTo(hub conversion.Hub) error {
+	out := hub.(*v1alpha1.SQLServer)
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec.ResourceSpec = in.Spec.ResourceSpec
+
+	p := in.Spec.ForProvider
+	out.Spec.ForProvider = v1alpha1.SQLServerParameters{
+		SubscriptionID:            p.SubscriptionID,
+		ResourceGroupName:         p.ResourceGroupName,
+		ResourceGroupNameRef:      p.ResourceGroupNameRef,
+		ResourceGroupNameSelector: p.ResourceGroupNameSelector,
+		Location:                  p.Location,
+		AdministratorLogin:        p.AdministratorLogin,
+		Version:                   p.Version,
+		MinimalTLSVersion:         p.MinimalTLSVersion,
+		PublicNetworkAccess:       p.PublicNetworkAccess,
+		Tags:                      p.Tags,
+		ConnectionSecretFormat:    p.ConnectionSecretFormat,
+	}
+
+	o := in.Status.AtProvider
+	out.Status = v1alpha1.SQLServerStatus{
+		ResourceStatus: in.Status.ResourceStatus,
+		AtProvider: v1alpha1.SQLServerObservation{
+			ID:                       o.ID,
+			State:                    o.State,
+			FullyQualifiedDomainName: o.FullyQualifiedDomainName,
+			LastOperation:            o.LastOperation,
+		},
+	}
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub version to this SQLServer.
+func (in *SQLServer) ConvertFrom(hub conversion.Hub) error {
+	h := hub.(*v1alpha1.SQLServer)
+	in.ObjectMeta = h.ObjectMeta
+	in.Spec.ResourceSpec = h.Spec.ResourceSpec
+
+	p := h.Spec.ForProvider
+	in.Spec.ForProvider = SQLServerParameters{
+		SubscriptionID:            p.SubscriptionID,
+		ResourceGroupName:         p.ResourceGroupName,
+		ResourceGroupNameRef:      p.ResourceGroupNameRef,
+		ResourceGroupNameSelector: p.ResourceGroupNameSelector,
+		Location:                  p.Location,
+		AdministratorLogin:        p.AdministratorLogin,
+		Version:                   p.Version,
+		MinimalTLSVersion:         p.MinimalTLSVersion,
+		PublicNetworkAccess:       p.PublicNetworkAccess,
+		Tags:                      p.Tags,
+		ConnectionSecretFormat:    p.ConnectionSecretFormat,
+	}
+
+	o := h.Status.AtProvider
+	in.Status = SQLServerStatus{
+		ResourceStatus: h.Status.ResourceStatus,
+		AtProvider: SQLServerObservation{
+			ID:                       o.ID,
+			State:                    o.State,
+			FullyQualifiedDomainName: o.FullyQualifiedDomainName,
+			LastOperation:            o.LastOperation,
+		},
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+// SQLServerParameters defines the desired state of an Azure SQL logical
+// server.
+// https://docs.microsoft.com/en-us/rest/api/sql/servers
+type SQLServerParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the server's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The Azure location the server is created in.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	Location string `json:"location"`
+
+	// AdministratorLogin - The administrator's login name of the server. Its
+	// password is generated and written to the connection secret of the
+	// server.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	AdministratorLogin string `json:"administratorLogin"`
+
+	// Version - The version of the server. Defaults to 12.0.
+	// +immutable
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// MinimalTLSVersion - The minimal TLS version clients must use to
+	// connect to the server.
+	// +kubebuilder:validation:Enum="1.0";"1.1";"1.2"
+	// +optional
+	MinimalTLSVersion *string `json:"minimalTLSVersion,omitempty"`
+
+	// PublicNetworkAccess - Whether the server can be reached over the
+	// public network.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	PublicNetworkAccess *string `json:"publicNetworkAccess,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// ConnectionSecretFormat customizes the keys written to the connection
+	// secret of this server, e.g. to add connection strings.
+	// +optional
+	ConnectionSecretFormat *v1alpha3.ConnectionSecretFormat `json:"connectionSecretFormat,omitempty"`
+}
+
+// A SQLServerSpec defines the desired state of a SQLServer.
+type SQLServerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SQLServerParameters `json:"forProvider"`
+}
+
+// SQLServerObservation represents the observed state of the Azure SQL logical
+// server.
+type SQLServerObservation struct {
+	// ID - Fully qualified resource identifier of the server.
+	ID string `json:"id,omitempty"`
+
+	// State - The state of the server.
+	State string `json:"state,omitempty"`
+
+	// FullyQualifiedDomainName - The fully qualified domain name of the
+	// server.
+	FullyQualifiedDomainName string `json:"fullyQualifiedDomainName,omitempty"`
+
+	// LastOperation represents the state of the last long-running operation
+	// started on the server by the controller.
+	LastOperation v1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+}
+
+// A SQLServerStatus represents the observed state of a SQLServer.
+type SQLServerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SQLServerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SQLServer is a managed resource that represents an Azure SQL logical
+// server, which hosts SQLDatabases and ElasticPools. The endpoint, port and
+// administrator credentials of the server are written to its connection
+// secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type SQLServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SQLServerSpec   `json:"spec"`
+	Status SQLServerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SQLServerList contains a list of SQLServer.
+type SQLServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SQLServer `json:"items"`
+}
@@ -53,7 +53,16 @@ var (
 	ContainerGroupVersionKind = SchemeGroupVersion.WithKind(ContainerKind)
 )
 
+// FileShare type metadata.
+var (
+	FileShareKind             = reflect.TypeOf(FileShare{}).Name()
+	FileShareGroupKind        = schema.GroupKind{Group: Group, Kind: FileShareKind}.String()
+	FileShareKindAPIVersion   = FileShareKind + "." + SchemeGroupVersion.String()
+	FileShareGroupVersionKind = SchemeGroupVersion.WithKind(FileShareKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Account{}, &AccountList{})
 	SchemeBuilder.Register(&Container{}, &ContainerList{})
+	SchemeBuilder.Register(&FileShare{}, &FileShareList{})
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 
 	storagev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
+	apisv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 )
 
 // MockAccount builder for testing account object
@@ -77,6 +78,12 @@ func (ta *MockAccount) WithFinalizers(f []string) *MockAccount {
 	return ta
 }
 
+// WithAnnotations sets annotations
+func (ta *MockAccount) WithAnnotations(a map[string]string) *MockAccount {
+	ta.Account.ObjectMeta.Annotations = a
+	return ta
+}
+
 // WithSpecProvider set a provider
 func (ta *MockAccount) WithSpecProvider(name string) *MockAccount {
 	ta.Spec.ProviderReference = &xpv1.Reference{Name: name}
@@ -121,6 +128,12 @@ func (ta *MockAccount) WithSpecWriteConnectionSecretToReference(ns, name string)
 	return ta
 }
 
+// WithStatusLastOperation sets the last operation of the status
+func (ta *MockAccount) WithStatusLastOperation(op apisv1alpha3.AsyncOperation) *MockAccount {
+	ta.Status.LastOperation = op
+	return ta
+}
+
 // WithStatusConditions sets the storage account's conditioned status.
 func (ta *MockAccount) WithStatusConditions(c ...xpv1.Condition) *MockAccount {
 	ta.Status.SetConditions(c...)
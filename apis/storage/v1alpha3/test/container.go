@@ -87,6 +87,12 @@ func (tc *MockContainer) WithFinalizers(f []string) *MockContainer {
 	return tc
 }
 
+// WithAnnotations sets annotations
+func (tc *MockContainer) WithAnnotations(a map[string]string) *MockContainer {
+	tc.Container.ObjectMeta.Annotations = a
+	return tc
+}
+
 // WithSpecProviderRef sets spec account reference value
 func (tc *MockContainer) WithSpecProviderRef(name string) *MockContainer {
 	tc.Container.Spec.ProviderReference = &xpv1.Reference{Name: name}
@@ -21,15 +21,67 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 )
 
 // AccountParameters define the desired state of an Azure Blob Storage Account.
 type AccountParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
 	// ResourceGroupName specifies the resource group for this Account.
 	ResourceGroupName string `json:"resourceGroupName"`
 
 	// StorageAccountSpec specifies the desired state of this Account.
 	StorageAccountSpec *StorageAccountSpec `json:"storageAccountSpec"`
+
+	// SharedAccessSignature configures a shared access signature (SAS) token
+	// to write to the connection secret of this Account. The token is
+	// regenerated before it expires.
+	// +optional
+	SharedAccessSignature *SharedAccessSignatureParameters `json:"sharedAccessSignature,omitempty"`
+
+	// CredentialRotation configures the periodic regeneration of the access
+	// keys of this Account. While it is set only the access key that is not
+	// due to be regenerated next is written to the connection secret, as its
+	// password.
+	// +optional
+	CredentialRotation *apisv1alpha3.CredentialRotationPolicy `json:"credentialRotation,omitempty"`
+
+	// PublicNetworkAccess - Whether this Account can be reached over the
+	// public internet. When Disabled it is only reachable over private
+	// endpoints, regardless of its network rules. The setting is applied as
+	// soon as the Account is created, and reverted if changed outside of
+	// Crossplane. Azure's setting is left alone if omitted.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	PublicNetworkAccess *string `json:"publicNetworkAccess,omitempty"`
+}
+
+// SharedAccessSignatureParameters define a shared access signature (SAS)
+// token that grants access to the blobs of an Account.
+type SharedAccessSignatureParameters struct {
+	// Container scopes the token to the named blob container of the Account.
+	// The token grants access to all containers of the Account if omitted.
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// Permissions granted by the token, as any combination of r (read),
+	// a (add), c (create), w (write), d (delete), l (list), u (update) and
+	// p (process). Defaults to rl.
+	// +kubebuilder:validation:Pattern=`^[racwdlup]+$`
+	// +optional
+	Permissions string `json:"permissions,omitempty"`
+
+	// Validity of the token, e.g. 24h. The token is regenerated once less
+	// than a quarter of its validity remains, or when these parameters
+	// change. Defaults to 24h.
+	// +optional
+	Validity *metav1.Duration `json:"validity,omitempty"`
 }
 
 // An AccountSpec defines the desired state of an Account.
@@ -43,6 +95,22 @@ type AccountStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 
 	*StorageAccountStatus `json:",inline"`
+
+	// LastOperation represents the state of the last operation started by the
+	// controller.
+	LastOperation apisv1alpha3.AsyncOperation `json:"lastOperation,omitempty"`
+
+	// SharedAccessSignatureExpiry is the time at which the shared access
+	// signature token in the connection secret of this Account expires.
+	SharedAccessSignatureExpiry *metav1.Time `json:"sharedAccessSignatureExpiry,omitempty"`
+
+	// SharedAccessSignatureParametersHash identifies the parameters of the
+	// shared access signature token in the connection secret of this Account.
+	SharedAccessSignatureParametersHash string `json:"sharedAccessSignatureParametersHash,omitempty"`
+
+	// CredentialRotation represents the state of the access key rotation of
+	// this Account.
+	CredentialRotation apisv1alpha3.CredentialRotationStatus `json:"credentialRotation,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -119,3 +187,120 @@ type ContainerList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []Container `json:"items"`
 }
+
+// FileShareParameters define the desired state of an Azure Files share.
+type FileShareParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the storage account's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// AccountName - Name of the storage account of the share.
+	// +immutable
+	// +optional
+	AccountName string `json:"accountName,omitempty"`
+
+	// AccountNameRef - A reference to an Account to retrieve its name
+	// +immutable
+	// +optional
+	AccountNameRef *xpv1.Reference `json:"accountNameRef,omitempty"`
+
+	// AccountNameSelector - Selects an Account to reference.
+	// +immutable
+	// +optional
+	AccountNameSelector *xpv1.Selector `json:"accountNameSelector,omitempty"`
+
+	// ShareQuota - The maximum size of the share in gigabytes. It may be at
+	// most 5120, or 102400 for accounts with large file shares enabled.
+	// Defaults to the maximum size.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=102400
+	// +optional
+	ShareQuota *int32 `json:"shareQuota,omitempty"`
+
+	// EnabledProtocols - The protocol that serves the share. Defaults to SMB.
+	// +kubebuilder:validation:Enum=SMB;NFS
+	// +immutable
+	// +optional
+	EnabledProtocols *string `json:"enabledProtocols,omitempty"`
+
+	// AccessTier - The access tier of the share. General purpose v2
+	// accounts support TransactionOptimized, Hot and Cool, while FileStorage
+	// accounts support Premium.
+	// +kubebuilder:validation:Enum=TransactionOptimized;Hot;Cool;Premium
+	// +optional
+	AccessTier *string `json:"accessTier,omitempty"`
+
+	// Metadata - Name-value pairs to associate with the share.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// FileShareObservation represents the observed state of an Azure Files
+// share.
+type FileShareObservation struct {
+	// ID - Fully qualified resource identifier of the share.
+	ID string `json:"id,omitempty"`
+
+	// ShareUsageBytes - The approximate size of the data stored on the
+	// share.
+	ShareUsageBytes *int64 `json:"shareUsageBytes,omitempty"`
+}
+
+// A FileShareSpec defines the desired state of a FileShare.
+type FileShareSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FileShareParameters `json:"forProvider"`
+}
+
+// A FileShareStatus represents the observed state of a FileShare.
+type FileShareStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FileShareObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FileShare is a managed resource that represents an Azure Files share of
+// a storage Account. The external name of a FileShare is the name of the
+// share. Its connection secret holds the name and key of its storage account
+// in the format expected by the Azure Files CSI driver.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STORAGE_ACCOUNT",type="string",JSONPath=".spec.forProvider.accountName"
+// +kubebuilder:printcolumn:name="QUOTA",type="integer",JSONPath=".spec.forProvider.shareQuota"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type FileShare struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              FileShareSpec   `json:"spec"`
+	Status            FileShareStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FileShareList contains a list of FileShare.
+type FileShareList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FileShare `json:"items"`
+}
@@ -23,6 +23,9 @@ package v1alpha3
 
 import (
 	"github.com/Azure/azure-storage-blob-go/azblob"
+	apisv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	commonv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -88,11 +91,31 @@ func (in *AccountList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccountParameters) DeepCopyInto(out *AccountParameters) {
 	*out = *in
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
 	if in.StorageAccountSpec != nil {
 		in, out := &in.StorageAccountSpec, &out.StorageAccountSpec
 		*out = new(StorageAccountSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SharedAccessSignature != nil {
+		in, out := &in.SharedAccessSignature, &out.SharedAccessSignature
+		*out = new(SharedAccessSignatureParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialRotation != nil {
+		in, out := &in.CredentialRotation, &out.CredentialRotation
+		*out = new(apisv1alpha3.CredentialRotationPolicy)
+		**out = **in
+	}
+	if in.PublicNetworkAccess != nil {
+		in, out := &in.PublicNetworkAccess, &out.PublicNetworkAccess
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountParameters.
@@ -131,6 +154,12 @@ func (in *AccountStatus) DeepCopyInto(out *AccountStatus) {
 		*out = new(StorageAccountStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	out.LastOperation = in.LastOperation
+	if in.SharedAccessSignatureExpiry != nil {
+		in, out := &in.SharedAccessSignatureExpiry, &out.SharedAccessSignatureExpiry
+		*out = (*in).DeepCopy()
+	}
+	in.CredentialRotation.DeepCopyInto(&out.CredentialRotation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountStatus.
@@ -327,6 +356,181 @@ func (in *Endpoints) DeepCopy() *Endpoints {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileShare) DeepCopyInto(out *FileShare) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileShare.
+func (in *FileShare) DeepCopy() *FileShare {
+	if in == nil {
+		return nil
+	}
+	out := new(FileShare)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FileShare) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileShareList) DeepCopyInto(out *FileShareList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FileShare, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileShareList.
+func (in *FileShareList) DeepCopy() *FileShareList {
+	if in == nil {
+		return nil
+	}
+	out := new(FileShareList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FileShareList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileShareObservation) DeepCopyInto(out *FileShareObservation) {
+	*out = *in
+	if in.ShareUsageBytes != nil {
+		in, out := &in.ShareUsageBytes, &out.ShareUsageBytes
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileShareObservation.
+func (in *FileShareObservation) DeepCopy() *FileShareObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FileShareObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileShareParameters) DeepCopyInto(out *FileShareParameters) {
+	*out = *in
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceGroupNameRef != nil {
+		in, out := &in.ResourceGroupNameRef, &out.ResourceGroupNameRef
+		*out = new(commonv1.Reference)
+		**out = **in
+	}
+	if in.ResourceGroupNameSelector != nil {
+		in, out := &in.ResourceGroupNameSelector, &out.ResourceGroupNameSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccountNameRef != nil {
+		in, out := &in.AccountNameRef, &out.AccountNameRef
+		*out = new(commonv1.Reference)
+		**out = **in
+	}
+	if in.AccountNameSelector != nil {
+		in, out := &in.AccountNameSelector, &out.AccountNameSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShareQuota != nil {
+		in, out := &in.ShareQuota, &out.ShareQuota
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EnabledProtocols != nil {
+		in, out := &in.EnabledProtocols, &out.EnabledProtocols
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessTier != nil {
+		in, out := &in.AccessTier, &out.AccessTier
+		*out = new(string)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileShareParameters.
+func (in *FileShareParameters) DeepCopy() *FileShareParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FileShareParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileShareSpec) DeepCopyInto(out *FileShareSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileShareSpec.
+func (in *FileShareSpec) DeepCopy() *FileShareSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FileShareSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileShareStatus) DeepCopyInto(out *FileShareStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileShareStatus.
+func (in *FileShareStatus) DeepCopy() *FileShareStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FileShareStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPRule) DeepCopyInto(out *IPRule) {
 	*out = *in
@@ -397,6 +601,26 @@ func (in *NetworkRuleSet) DeepCopy() *NetworkRuleSet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedAccessSignatureParameters) DeepCopyInto(out *SharedAccessSignatureParameters) {
+	*out = *in
+	if in.Validity != nil {
+		in, out := &in.Validity, &out.Validity
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedAccessSignatureParameters.
+func (in *SharedAccessSignatureParameters) DeepCopy() *SharedAccessSignatureParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedAccessSignatureParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sku) DeepCopyInto(out *Sku) {
 	*out = *in
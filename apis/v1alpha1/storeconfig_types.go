@@ -25,6 +25,28 @@ import (
 // A StoreConfigSpec defines the desired state of a ProviderConfig.
 type StoreConfigSpec struct {
 	xpv1.SecretStoreConfig `json:",inline"`
+
+	// AzureKeyVault configures an Azure Key Vault secret store. When set,
+	// connection details are written to the Key Vault and the type field is
+	// ignored.
+	// +optional
+	AzureKeyVault *AzureKeyVaultSecretStoreConfig `json:"azureKeyVault,omitempty"`
+}
+
+// AzureKeyVaultSecretStoreConfig configures an Azure Key Vault secret store.
+// Each connection secret is stored as a single Key Vault secret holding a
+// JSON object of its keys and values. The Key Vault secret is named after the
+// scope and name of the connection secret, with any character that Key Vault
+// does not allow replaced by a dash.
+type AzureKeyVaultSecretStoreConfig struct {
+	// VaultBaseURL of the Key Vault connection details are written to, for
+	// example https://myvault.vault.azure.net.
+	VaultBaseURL string `json:"vaultBaseUrl"`
+
+	// ProviderConfigReference references the ProviderConfig whose
+	// credentials are used to access the Key Vault. The identity must be
+	// allowed to get, set and delete secrets.
+	ProviderConfigReference xpv1.Reference `json:"providerConfigRef"`
 }
 
 // A StoreConfigStatus represents the status of a StoreConfig.
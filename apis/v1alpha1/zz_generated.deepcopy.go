@@ -25,6 +25,22 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureKeyVaultSecretStoreConfig) DeepCopyInto(out *AzureKeyVaultSecretStoreConfig) {
+	*out = *in
+	out.ProviderConfigReference = in.ProviderConfigReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureKeyVaultSecretStoreConfig.
+func (in *AzureKeyVaultSecretStoreConfig) DeepCopy() *AzureKeyVaultSecretStoreConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureKeyVaultSecretStoreConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StoreConfig) DeepCopyInto(out *StoreConfig) {
 	*out = *in
@@ -88,6 +104,11 @@ func (in *StoreConfigList) DeepCopyObject() runtime.Object {
 func (in *StoreConfigSpec) DeepCopyInto(out *StoreConfigSpec) {
 	*out = *in
 	in.SecretStoreConfig.DeepCopyInto(&out.SecretStoreConfig)
+	if in.AzureKeyVault != nil {
+		in, out := &in.AzureKeyVault, &out.AzureKeyVault
+		*out = new(AzureKeyVaultSecretStoreConfig)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreConfigSpec.
@@ -0,0 +1,227 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TypeCannotUpdateImmutableField resources have had a field that cannot be
+// changed after creation edited.
+const TypeCannotUpdateImmutableField xpv1.ConditionType = "CannotUpdateImmutableField"
+
+// Reasons a resource does or does not have immutable field changes.
+const (
+	ReasonImmutableFieldChanged    xpv1.ConditionReason = "ImmutableFieldChanged"
+	ReasonImmutableFieldsUnchanged xpv1.ConditionReason = "ImmutableFieldsUnchanged"
+)
+
+// TypeAdvisorRecommendations resources have recommendations from Azure
+// Advisor, e.g. to right-size or secure them.
+const TypeAdvisorRecommendations xpv1.ConditionType = "AdvisorRecommendations"
+
+// Reasons a resource does or does not have Azure Advisor recommendations.
+const (
+	ReasonRecommendationsFound xpv1.ConditionReason = "RecommendationsFound"
+	ReasonNoRecommendations    xpv1.ConditionReason = "NoRecommendations"
+)
+
+// TypeCannotShrinkStorage resources have had their storage size decreased,
+// which Azure does not support.
+const TypeCannotShrinkStorage xpv1.ConditionType = "CannotShrinkStorage"
+
+// Reasons a resource does or does not have its storage size decreased.
+const (
+	ReasonStorageShrinkRequested   xpv1.ConditionReason = "StorageShrinkRequested"
+	ReasonNoStorageShrinkRequested xpv1.ConditionReason = "NoStorageShrinkRequested"
+)
+
+// TypeDrifted resources whose management policy is ObserveOnly have an
+// external resource that differs from their desired state.
+const TypeDrifted xpv1.ConditionType = "Drifted"
+
+// Reasons a resource has or has not drifted from its desired state.
+const (
+	ReasonDriftDetected xpv1.ConditionReason = "DriftDetected"
+	ReasonNoDrift       xpv1.ConditionReason = "NoDrift"
+)
+
+// TypeDeletionBlocked resources have been deleted, but their external
+// resource is protected from deletion.
+const TypeDeletionBlocked xpv1.ConditionType = "DeletionBlocked"
+
+// ReasonDeletionProtected resources are protected from deletion.
+const ReasonDeletionProtected xpv1.ConditionReason = "DeletionProtected"
+
+// ReasonQuotaExceeded resources cannot be created because the subscription
+// does not have enough quota left.
+const ReasonQuotaExceeded xpv1.ConditionReason = "QuotaExceeded"
+
+// ReasonZoneUnavailable resources cannot be created because they request
+// availability zones that their location or VM size does not support.
+const ReasonZoneUnavailable xpv1.ConditionReason = "ZoneUnavailable"
+
+// CannotUpdateImmutableField returns a condition that indicates the supplied
+// fields of the resource were changed after creation and that the external
+// resource will not be updated until they are reverted.
+func CannotUpdateImmutableField(fields ...string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCannotUpdateImmutableField,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonImmutableFieldChanged,
+		Message:            "cannot update immutable fields: " + strings.Join(fields, ", "),
+	}
+}
+
+// ImmutableFieldsUnchanged returns a condition that indicates none of the
+// immutable fields of the resource differ from the external resource.
+func ImmutableFieldsUnchanged() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCannotUpdateImmutableField,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonImmutableFieldsUnchanged,
+	}
+}
+
+// CannotShrinkStorage returns a condition that indicates the desired storage
+// size of the resource is less than its current size, and that its storage
+// will not be shrunk.
+func CannotShrinkStorage(desiredMB, currentMB int) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCannotShrinkStorage,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonStorageShrinkRequested,
+		Message:            fmt.Sprintf("cannot shrink storage from %d MB to %d MB", currentMB, desiredMB),
+	}
+}
+
+// NoStorageShrinkRequested returns a condition that indicates the desired
+// storage size of the resource is not less than its current size.
+func NoStorageShrinkRequested() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCannotShrinkStorage,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoStorageShrinkRequested,
+	}
+}
+
+// QuotaExceeded returns a condition that indicates the resource is not ready
+// because the subscription does not have enough quota left to create it.
+func QuotaExceeded(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonQuotaExceeded,
+		Message:            msg,
+	}
+}
+
+// ZoneUnavailable returns a condition that indicates the resource is not ready
+// because it requests availability zones that are not supported.
+func ZoneUnavailable(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonZoneUnavailable,
+		Message:            msg,
+	}
+}
+
+// AzureError returns a condition that indicates the resource is not ready
+// because Azure rejected a request to create it with the supplied error code,
+// e.g. InvalidParameter, and message.
+func AzureError(code, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             xpv1.ConditionReason(code),
+		Message:            msg,
+	}
+}
+
+// AdvisorRecommendations returns a condition that indicates Azure Advisor has
+// the supplied recommendations for the resource.
+func AdvisorRecommendations(recs ...string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeAdvisorRecommendations,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRecommendationsFound,
+		Message:            strings.Join(recs, "; "),
+	}
+}
+
+// NoAdvisorRecommendations returns a condition that indicates Azure Advisor
+// has no recommendations for the resource.
+func NoAdvisorRecommendations() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeAdvisorRecommendations,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoRecommendations,
+	}
+}
+
+// DeletionBlocked returns a condition that indicates the external resource
+// is not deleted because it is protected from deletion, and what to do to
+// delete it.
+func DeletionBlocked(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionBlocked,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeletionProtected,
+		Message:            msg,
+	}
+}
+
+// Drifted returns a condition that indicates the external resource differs
+// from the desired state of the resource, and that it will not be updated
+// because the resource is only observed.
+func Drifted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDrifted,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDriftDetected,
+		Message:            "the external resource differs from the desired state and is not updated because the management policy is ObserveOnly",
+	}
+}
+
+// NotDrifted returns a condition that indicates the external resource matches
+// the desired state of the resource.
+func NotDrifted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDrifted,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoDrift,
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Reasons a ProviderConfig is or is not ready.
+const (
+	ReasonCredentialsValid   xpv1.ConditionReason = "CredentialsValid"
+	ReasonCredentialsInvalid xpv1.ConditionReason = "CredentialsInvalid"
+)
+
+// CredentialsValid returns a condition that indicates a token could be
+// acquired using the credentials of a ProviderConfig, and that they can
+// access the subscription they specify.
+func CredentialsValid() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCredentialsValid,
+	}
+}
+
+// CredentialsInvalid returns a condition that indicates the credentials of
+// a ProviderConfig could not be used to authenticate to Azure, or to access
+// the subscription they specify, for the supplied reason.
+func CredentialsInvalid(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCredentialsInvalid,
+		Message:            msg,
+	}
+}
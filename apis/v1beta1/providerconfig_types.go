@@ -35,6 +35,28 @@ type ProviderCredentials struct {
 	Source xpv1.CredentialsSource `json:"source"`
 
 	xpv1.CommonCredentialSelectors `json:",inline"`
+
+	// UseMSI causes the provider to authenticate using the Azure Managed
+	// Service Identity assigned to the node or pod it runs on, rather than
+	// the client secret found in the credentials. The credentials must still
+	// supply the subscriptionId and, optionally, the tenantId and endpoints.
+	// +optional
+	UseMSI bool `json:"useMSI,omitempty"`
+
+	// IdentityClientID is the client ID of the user-assigned managed identity
+	// to authenticate as. The system-assigned identity is used when it is
+	// omitted. It is ignored unless useMSI is true.
+	// +optional
+	IdentityClientID *string `json:"identityClientID,omitempty"`
+
+	// UseWorkloadIdentity causes the provider to authenticate by exchanging
+	// the Kubernetes service account token of its pod for an Azure AD token,
+	// using Azure AD workload identity federation. The tenantId, clientId and
+	// federatedTokenFile are read from the environment variables set by the
+	// workload identity webhook unless the credentials supply them. The
+	// credentials must still supply the subscriptionId.
+	// +optional
+	UseWorkloadIdentity bool `json:"useWorkloadIdentity,omitempty"`
 }
 
 // A ProviderConfigStatus represents the status of a ProviderConfig.
@@ -46,6 +68,7 @@ type ProviderConfigStatus struct {
 
 // A ProviderConfig configures an Azure 'provider', i.e. a connection to a particular
 // Azure account using a particular Azure Service Principal.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentialsSecretRef.name",priority=1
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,azure}
 // +kubebuilder:subresource:status
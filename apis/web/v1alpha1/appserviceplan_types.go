@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Operating systems of App Service plans.
+const (
+	OSTypeLinux   = "Linux"
+	OSTypeWindows = "Windows"
+)
+
+// AppServicePlanSKU defines the SKU of an App Service plan.
+type AppServicePlanSKU struct {
+	// Name of the SKU, e.g. B1, S1 or P1v3.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// Capacity is the number of instances the plan runs on.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Capacity *int32 `json:"capacity,omitempty"`
+}
+
+// AppServicePlanParameters define the desired state of an Azure App Service
+// plan.
+type AppServicePlanParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the plan's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The Azure location the plan is created in.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	Location string `json:"location"`
+
+	// OSType is the operating system the apps of the plan run on. Defaults
+	// to Windows.
+	// +kubebuilder:validation:Enum=Linux;Windows
+	// +immutable
+	// +optional
+	OSType *string `json:"osType,omitempty"`
+
+	// SKU of the plan.
+	SKU AppServicePlanSKU `json:"sku"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An AppServicePlanSpec defines the desired state of an AppServicePlan.
+type AppServicePlanSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AppServicePlanParameters `json:"forProvider"`
+}
+
+// An AppServicePlanObservation represents the observed state of an Azure
+// App Service plan.
+type AppServicePlanObservation struct {
+	// ID - Resource ID.
+	ID string `json:"id,omitempty"`
+
+	// Status of the plan, e.g. Ready.
+	Status string `json:"status,omitempty"`
+
+	// ProvisioningState - Provisioning state of the plan.
+	ProvisioningState string `json:"provisioningState,omitempty"`
+
+	// NumberOfSites is the number of apps the plan hosts.
+	NumberOfSites *int32 `json:"numberOfSites,omitempty"`
+}
+
+// An AppServicePlanStatus represents the observed state of an
+// AppServicePlan.
+type AppServicePlanStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AppServicePlanObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AppServicePlan is a managed resource that represents an Azure App
+// Service plan.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SKU",type="string",JSONPath=".spec.forProvider.sku.name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type AppServicePlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AppServicePlanSpec   `json:"spec"`
+	Status AppServicePlanStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AppServicePlanList contains a list of AppServicePlan.
+type AppServicePlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AppServicePlan `json:"items"`
+}
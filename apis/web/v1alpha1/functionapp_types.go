@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// FunctionAppParameters define the desired state of an Azure Functions
+// function app. The app runs on the App Service plan it references, which
+// determines whether it is hosted on a consumption (Y1), premium (EP1-EP3)
+// or dedicated plan.
+type FunctionAppParameters struct {
+	// StorageAccountName is the name of the storage account the Functions
+	// host stores its state in. It must be in the resource group of the
+	// app.
+	// +immutable
+	// +optional
+	StorageAccountName string `json:"storageAccountName,omitempty"`
+
+	// StorageAccountNameRef - A reference to a storage Account to retrieve
+	// its name.
+	// +immutable
+	// +optional
+	StorageAccountNameRef *xpv1.Reference `json:"storageAccountNameRef,omitempty"`
+
+	// StorageAccountNameSelector - Selects a storage Account to reference.
+	// +immutable
+	// +optional
+	StorageAccountNameSelector *xpv1.Selector `json:"storageAccountNameSelector,omitempty"`
+
+	// ApplicationInsightsName is the name of the Application Insights
+	// component the app sends its telemetry to. It must be in the resource
+	// group of the app.
+	// +optional
+	ApplicationInsightsName *string `json:"applicationInsightsName,omitempty"`
+
+	// ApplicationInsightsNameRef - A reference to an ApplicationInsights to
+	// retrieve its name.
+	// +optional
+	ApplicationInsightsNameRef *xpv1.Reference `json:"applicationInsightsNameRef,omitempty"`
+
+	// ApplicationInsightsNameSelector - Selects an ApplicationInsights to
+	// reference.
+	// +optional
+	ApplicationInsightsNameSelector *xpv1.Selector `json:"applicationInsightsNameSelector,omitempty"`
+
+	// WorkerRuntime is the language worker the Functions host runs.
+	// +kubebuilder:validation:Enum=dotnet;dotnet-isolated;node;python;java;powershell;custom
+	WorkerRuntime string `json:"workerRuntime"`
+
+	// ExtensionVersion is the version of the Functions runtime. It defaults
+	// to ~4.
+	// +optional
+	ExtensionVersion *string `json:"extensionVersion,omitempty"`
+
+	WebAppParameters `json:",inline"`
+}
+
+// A FunctionAppSpec defines the desired state of a FunctionApp.
+type FunctionAppSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FunctionAppParameters `json:"forProvider"`
+}
+
+// A FunctionAppStatus represents the observed state of a FunctionApp.
+type FunctionAppStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WebAppObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FunctionApp is a managed resource that represents an Azure Functions
+// function app. Its default host name and default host key are written to
+// its connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="RUNTIME",type="string",JSONPath=".spec.forProvider.workerRuntime"
+// +kubebuilder:printcolumn:name="HOST",type="string",JSONPath=".status.atProvider.defaultHostName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type FunctionApp struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FunctionAppSpec   `json:"spec"`
+	Status FunctionAppStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FunctionAppList contains a list of FunctionApp.
+type FunctionAppList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FunctionApp `json:"items"`
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	insightsv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/insights/v1alpha1"
+	storagev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+// AppServicePlanID extracts status.atProvider.id from the supplied managed
+// resource, which must be an AppServicePlan.
+func AppServicePlanID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		p, ok := mg.(*AppServicePlan)
+		if !ok {
+			return ""
+		}
+		return p.Status.AtProvider.ID
+	}
+}
+
+// ResolveReferences of this AppServicePlan.
+func (mg *AppServicePlan) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ResourceGroupName,
+		Reference:    mg.Spec.ForProvider.ResourceGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	mg.Spec.ForProvider.ResourceGroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ResourceGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this WebApp.
+func (mg *WebApp) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return resolveWebAppReferences(ctx, reference.NewAPIResolver(c, mg), &mg.Spec.ForProvider)
+}
+
+// ResolveReferences of this WebAppSlot.
+func (mg *WebAppSlot) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.webAppName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.WebAppName,
+		Reference:    mg.Spec.ForProvider.WebAppNameRef,
+		Selector:     mg.Spec.ForProvider.WebAppNameSelector,
+		To:           reference.To{Managed: &WebApp{}, List: &WebAppList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.webAppName")
+	}
+	mg.Spec.ForProvider.WebAppName = rsp.ResolvedValue
+	mg.Spec.ForProvider.WebAppNameRef = rsp.ResolvedReference
+
+	return resolveWebAppReferences(ctx, r, &mg.Spec.ForProvider.WebAppParameters)
+}
+
+// ResolveReferences of this FunctionApp.
+func (mg *FunctionApp) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	if err := resolveWebAppReferences(ctx, r, &mg.Spec.ForProvider.WebAppParameters); err != nil {
+		return err
+	}
+
+	// Resolve spec.forProvider.storageAccountName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.StorageAccountName,
+		Reference:    mg.Spec.ForProvider.StorageAccountNameRef,
+		Selector:     mg.Spec.ForProvider.StorageAccountNameSelector,
+		To:           reference.To{Managed: &storagev1alpha3.Account{}, List: &storagev1alpha3.AccountList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.storageAccountName")
+	}
+	mg.Spec.ForProvider.StorageAccountName = rsp.ResolvedValue
+	mg.Spec.ForProvider.StorageAccountNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.applicationInsightsName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ApplicationInsightsName),
+		Reference:    mg.Spec.ForProvider.ApplicationInsightsNameRef,
+		Selector:     mg.Spec.ForProvider.ApplicationInsightsNameSelector,
+		To:           reference.To{Managed: &insightsv1alpha1.ApplicationInsights{}, List: &insightsv1alpha1.ApplicationInsightsList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.applicationInsightsName")
+	}
+	mg.Spec.ForProvider.ApplicationInsightsName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ApplicationInsightsNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+func resolveWebAppReferences(ctx context.Context, r *reference.APIResolver, p *WebAppParameters) error {
+	// Resolve spec.forProvider.resourceGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: p.ResourceGroupName,
+		Reference:    p.ResourceGroupNameRef,
+		Selector:     p.ResourceGroupNameSelector,
+		To:           reference.To{Managed: &v1alpha3.ResourceGroup{}, List: &v1alpha3.ResourceGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceGroupName")
+	}
+	p.ResourceGroupName = rsp.ResolvedValue
+	p.ResourceGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.serverFarmID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: p.ServerFarmID,
+		Reference:    p.ServerFarmIDRef,
+		Selector:     p.ServerFarmIDSelector,
+		To:           reference.To{Managed: &AppServicePlan{}, List: &AppServicePlanList{}},
+		Extract:      AppServicePlanID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serverFarmID")
+	}
+	p.ServerFarmID = rsp.ResolvedValue
+	p.ServerFarmIDRef = rsp.ResolvedReference
+
+	return nil
+}
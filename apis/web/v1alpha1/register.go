@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "web.azure.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// AppServicePlan type metadata.
+var (
+	AppServicePlanKind             = reflect.TypeOf(AppServicePlan{}).Name()
+	AppServicePlanGroupKind        = schema.GroupKind{Group: Group, Kind: AppServicePlanKind}.String()
+	AppServicePlanKindAPIVersion   = AppServicePlanKind + "." + SchemeGroupVersion.String()
+	AppServicePlanGroupVersionKind = SchemeGroupVersion.WithKind(AppServicePlanKind)
+)
+
+// WebApp type metadata.
+var (
+	WebAppKind             = reflect.TypeOf(WebApp{}).Name()
+	WebAppGroupKind        = schema.GroupKind{Group: Group, Kind: WebAppKind}.String()
+	WebAppKindAPIVersion   = WebAppKind + "." + SchemeGroupVersion.String()
+	WebAppGroupVersionKind = SchemeGroupVersion.WithKind(WebAppKind)
+)
+
+// WebAppSlot type metadata.
+var (
+	WebAppSlotKind             = reflect.TypeOf(WebAppSlot{}).Name()
+	WebAppSlotGroupKind        = schema.GroupKind{Group: Group, Kind: WebAppSlotKind}.String()
+	WebAppSlotKindAPIVersion   = WebAppSlotKind + "." + SchemeGroupVersion.String()
+	WebAppSlotGroupVersionKind = SchemeGroupVersion.WithKind(WebAppSlotKind)
+)
+
+// FunctionApp type metadata.
+var (
+	FunctionAppKind             = reflect.TypeOf(FunctionApp{}).Name()
+	FunctionAppGroupKind        = schema.GroupKind{Group: Group, Kind: FunctionAppKind}.String()
+	FunctionAppKindAPIVersion   = FunctionAppKind + "." + SchemeGroupVersion.String()
+	FunctionAppGroupVersionKind = SchemeGroupVersion.WithKind(FunctionAppKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AppServicePlan{}, &AppServicePlanList{})
+	SchemeBuilder.Register(&WebApp{}, &WebAppList{})
+	SchemeBuilder.Register(&WebAppSlot{}, &WebAppSlotList{})
+	SchemeBuilder.Register(&FunctionApp{}, &FunctionAppList{})
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// WebAppSiteConfig configures the runtime of a web app.
+type WebAppSiteConfig struct {
+	// LinuxFXVersion is the runtime stack of an app on Linux, e.g.
+	// NODE|18-lts or DOCKER|nginx:latest.
+	// +optional
+	LinuxFXVersion *string `json:"linuxFXVersion,omitempty"`
+
+	// WindowsFXVersion is the container image of an app in a Windows
+	// container, e.g. DOCKER|mcr.microsoft.com/dotnet/samples:aspnetapp.
+	// +optional
+	WindowsFXVersion *string `json:"windowsFXVersion,omitempty"`
+
+	// NetFrameworkVersion is the .NET runtime of an app on Windows, e.g.
+	// v6.0.
+	// +optional
+	NetFrameworkVersion *string `json:"netFrameworkVersion,omitempty"`
+
+	// AlwaysOn keeps the app loaded even when it receives no traffic.
+	// +optional
+	AlwaysOn *bool `json:"alwaysOn,omitempty"`
+
+	// MinTLSVersion is the minimum TLS version required by the app.
+	// +kubebuilder:validation:Enum="1.0";"1.1";"1.2"
+	// +optional
+	MinTLSVersion *string `json:"minTLSVersion,omitempty"`
+}
+
+// A WebAppSetting is an application setting, which is exposed to a web app
+// as an environment variable.
+type WebAppSetting struct {
+	// Name of the setting.
+	Name string `json:"name"`
+
+	// Value of the setting.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// ValueSecretRef references a secret key that holds the value of the
+	// setting. It takes precedence over Value.
+	// +optional
+	ValueSecretRef *xpv1.SecretKeySelector `json:"valueSecretRef,omitempty"`
+}
+
+// A WebAppConnectionString is a connection string of a web app.
+type WebAppConnectionString struct {
+	// Name of the connection string.
+	Name string `json:"name"`
+
+	// Type of the database the connection string connects to.
+	// +kubebuilder:validation:Enum=ApiHub;Custom;DocDb;EventHub;MySql;NotificationHub;PostgreSQL;RedisCache;ServiceBus;SQLAzure;SQLServer
+	Type string `json:"type"`
+
+	// ValueSecretRef references a secret key that holds the connection
+	// string.
+	ValueSecretRef xpv1.SecretKeySelector `json:"valueSecretRef"`
+}
+
+// WebAppParameters define the desired state of an Azure App Service web
+// app.
+type WebAppParameters struct {
+	// SubscriptionID is the ID of the subscription the resource is managed
+	// in. It defaults to the subscription of the provider's credentials,
+	// which must be able to access it.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// ResourceGroupName - Name of the app's resource group.
+	// +immutable
+	// +optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+
+	// ResourceGroupNameRef - A reference to a ResourceGroup object to retrieve
+	// its name
+	// +immutable
+	// +optional
+	ResourceGroupNameRef *xpv1.Reference `json:"resourceGroupNameRef,omitempty"`
+
+	// ResourceGroupNameSelector - Selects a ResourceGroup to reference.
+	// +immutable
+	// +optional
+	ResourceGroupNameSelector *xpv1.Selector `json:"resourceGroupNameSelector,omitempty"`
+
+	// Location - The Azure location the app is created in. It must be the
+	// location of its App Service plan.
+	// +kubebuilder:validation:MinLength:=1
+	// +immutable
+	Location string `json:"location"`
+
+	// ServerFarmID is the resource ID of the App Service plan the app runs
+	// on.
+	// +optional
+	ServerFarmID string `json:"serverFarmID,omitempty"`
+
+	// ServerFarmIDRef - A reference to an AppServicePlan to retrieve its ID
+	// +optional
+	ServerFarmIDRef *xpv1.Reference `json:"serverFarmIDRef,omitempty"`
+
+	// ServerFarmIDSelector - Selects an AppServicePlan to reference.
+	// +optional
+	ServerFarmIDSelector *xpv1.Selector `json:"serverFarmIDSelector,omitempty"`
+
+	// SiteConfig configures the runtime of the app.
+	// +optional
+	SiteConfig *WebAppSiteConfig `json:"siteConfig,omitempty"`
+
+	// HTTPSOnly redirects HTTP requests to HTTPS.
+	// +optional
+	HTTPSOnly *bool `json:"httpsOnly,omitempty"`
+
+	// AppSettings of the app. Settings that are not specified are removed.
+	// +optional
+	AppSettings []WebAppSetting `json:"appSettings,omitempty"`
+
+	// ConnectionStrings of the app. Connection strings that are not
+	// specified are removed.
+	// +optional
+	ConnectionStrings []WebAppConnectionString `json:"connectionStrings,omitempty"`
+
+	// Tags - Resource tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A WebAppSpec defines the desired state of a WebApp.
+type WebAppSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WebAppParameters `json:"forProvider"`
+}
+
+// A WebAppObservation represents the observed state of an Azure App Service
+// web app or deployment slot.
+type WebAppObservation struct {
+	// ID - Resource ID.
+	ID string `json:"id,omitempty"`
+
+	// State of the app, e.g. Running.
+	State string `json:"state,omitempty"`
+
+	// DefaultHostName is the host name the app is served on.
+	DefaultHostName string `json:"defaultHostName,omitempty"`
+}
+
+// A WebAppStatus represents the observed state of a WebApp.
+type WebAppStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WebAppObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WebApp is a managed resource that represents an Azure App Service web
+// app. Its default host name is written to its connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="HOST",type="string",JSONPath=".status.atProvider.defaultHostName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type WebApp struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WebAppSpec   `json:"spec"`
+	Status WebAppStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WebAppList contains a list of WebApp.
+type WebAppList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WebApp `json:"items"`
+}
+
+// WebAppSlotParameters define the desired state of a deployment slot of an
+// Azure App Service web app.
+type WebAppSlotParameters struct {
+	// WebAppName is the name of the app the slot belongs to.
+	// +immutable
+	// +optional
+	WebAppName string `json:"webAppName,omitempty"`
+
+	// WebAppNameRef - A reference to a WebApp to retrieve its name
+	// +immutable
+	// +optional
+	WebAppNameRef *xpv1.Reference `json:"webAppNameRef,omitempty"`
+
+	// WebAppNameSelector - Selects a WebApp to reference.
+	// +immutable
+	// +optional
+	WebAppNameSelector *xpv1.Selector `json:"webAppNameSelector,omitempty"`
+
+	WebAppParameters `json:",inline"`
+}
+
+// A WebAppSlotSpec defines the desired state of a WebAppSlot.
+type WebAppSlotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WebAppSlotParameters `json:"forProvider"`
+}
+
+// A WebAppSlotStatus represents the observed state of a WebAppSlot.
+type WebAppSlotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WebAppObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WebAppSlot is a managed resource that represents a deployment slot of an
+// Azure App Service web app. Its external name is the name of the slot. Its
+// default host name is written to its connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="APP",type="string",JSONPath=".spec.forProvider.webAppName"
+// +kubebuilder:printcolumn:name="HOST",type="string",JSONPath=".status.atProvider.defaultHostName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,azure}
+type WebAppSlot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WebAppSlotSpec   `json:"spec"`
+	Status WebAppSlotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WebAppSlotList contains a list of WebAppSlot.
+type WebAppSlotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WebAppSlot `json:"items"`
+}
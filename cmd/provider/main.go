@@ -20,6 +20,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"gopkg.in/alecthomas/kingpin.v2"
@@ -38,8 +39,10 @@ import (
 
 	"github.com/crossplane-contrib/provider-azure/apis"
 	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller"
 	"github.com/crossplane-contrib/provider-azure/pkg/features"
+	"github.com/crossplane-contrib/provider-azure/pkg/webhook"
 )
 
 func main() {
@@ -48,11 +51,20 @@ func main() {
 		debug            = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
 		syncInterval     = app.Flag("sync", "Sync interval controls how often all resources will be double checked for drift.").Short('s').Default("1h").Duration()
 		pollInterval     = app.Flag("poll", "Poll interval controls how often an individual resource should be checked for drift.").Default("1m").Duration()
+		shutdownTimeout  = app.Flag("graceful-shutdown-timeout", "How long in-flight reconciles may take to finish, e.g. to record the Azure operations they started, when the provider is stopped. Should be shorter than the termination grace period of the provider pod.").Default("25s").Duration()
 		leaderElection   = app.Flag("leader-election", "Use leader election for the conroller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
 		maxReconcileRate = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
-
-		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
-		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
+		maxConcurrent    = app.Flag("max-concurrent-reconciles", "The maximum number of resources of a kind, e.g. SQLServer or SQLServer.sql.azure.crossplane.io, that may be reconciled concurrently. May be repeated. Kinds that are not specified use --max-reconcile-rate.").PlaceHolder("KIND=N").StringMap()
+		azureReadRate    = app.Flag("azure-read-rate", "The maximum rate per second at which read requests are sent to the Azure Resource Manager API. Zero disables the limit.").Default(strconv.Itoa(azure.DefaultReadsPerSecond)).Int()
+		azureWriteRate   = app.Flag("azure-write-rate", "The maximum rate per second at which write requests are sent to the Azure Resource Manager API. Zero disables the limit.").Default(strconv.Itoa(azure.DefaultWritesPerSecond)).Int()
+
+		namespace                     = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
+		enableExternalSecretStores    = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
+		enableNamespaceProviderConfig = app.Flag("enable-namespace-provider-config", "Enable defaulting the ProviderConfig of managed resources from the namespace of their claim.").Default("false").Envar("ENABLE_NAMESPACE_PROVIDER_CONFIG").Bool()
+		enableAdvisorRecommendations  = app.Flag("enable-advisor-recommendations", "Enable surfacing Azure Advisor recommendations on managed resources.").Default("false").Envar("ENABLE_ADVISOR_RECOMMENDATIONS").Bool()
+		enableCostReporting           = app.Flag("enable-cost-reporting", "Enable reporting the month-to-date cost of managed resources.").Default("false").Envar("ENABLE_COST_REPORTING").Bool()
+		webhookTLSCertDir             = app.Flag("webhook-tls-cert-dir", "The directory of the TLS certificate and key, tls.crt and tls.key, that the webhook server serves. Admission and conversion webhooks are disabled if it is not set.").Envar("WEBHOOK_TLS_CERT_DIR").String()
+		simulation                    = app.Flag("simulation", "Run the storage and resource group controllers against a local API simulator such as Azurite, or recorded API responses, instead of Azure.").Default("false").Envar("SIMULATION").Bool()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -67,6 +79,12 @@ func main() {
 
 	log.Debug("Starting", "sync-period", syncInterval.String())
 
+	azure.SetThrottle(azure.NewThrottle(*azureReadRate, *azureWriteRate))
+
+	mcr, err := azure.ParseMaxConcurrentReconciles(*maxConcurrent)
+	kingpin.FatalIfError(err, "Cannot parse maximum concurrent reconciles")
+	azure.SetMaxConcurrentReconciles(mcr)
+
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
@@ -85,6 +103,13 @@ func main() {
 		LeaderElectionResourceLock: resourcelock.LeasesResourceLock,
 		LeaseDuration:              func() *time.Duration { d := 60 * time.Second; return &d }(),
 		RenewDeadline:              func() *time.Duration { d := 50 * time.Second; return &d }(),
+
+		// Reconcilers record the long-running Azure operations they start,
+		// so that they can resume rather than start them again. We let them
+		// finish doing so when we're stopped.
+		GracefulShutdownTimeout: shutdownTimeout,
+
+		CertDir: *webhookTLSCertDir,
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add Azure APIs to scheme")
@@ -116,6 +141,29 @@ func main() {
 		})), "cannot create default store config")
 	}
 
+	if *enableNamespaceProviderConfig {
+		o.Features.Enable(features.EnableAlphaNamespaceProviderConfig)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaNamespaceProviderConfig)
+	}
+
+	if *enableAdvisorRecommendations {
+		o.Features.Enable(features.EnableAlphaAdvisorRecommendations)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaAdvisorRecommendations)
+	}
+
+	if *enableCostReporting {
+		o.Features.Enable(features.EnableAlphaCostReporting)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaCostReporting)
+	}
+
+	if *simulation {
+		o.Features.Enable(features.EnableSimulation)
+		log.Info("Simulation mode enabled, Azure API requests will not be authorized", "flag", features.EnableSimulation)
+	}
+
 	kingpin.FatalIfError(controller.Setup(mgr, o), "Cannot setup Azure controllers")
+	if *webhookTLSCertDir != "" {
+		kingpin.FatalIfError(webhook.Setup(mgr), "Cannot setup Azure webhooks")
+	}
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
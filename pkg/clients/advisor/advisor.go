@@ -0,0 +1,78 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package advisor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Azure/azure-sdk-for-go/services/advisor/mgmt/2020-01-01/advisor"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const errListRecommendations = "cannot list Advisor recommendations"
+
+// A RecommendationsAPI lists the Azure Advisor recommendations of Azure
+// resources.
+type RecommendationsAPI interface {
+	ListRecommendations(ctx context.Context, resourceID string) ([]advisor.ResourceRecommendationBase, error)
+}
+
+// A RecommendationsClient lists Azure Advisor recommendations.
+type RecommendationsClient struct {
+	client advisor.RecommendationsClient
+}
+
+// NewRecommendationsClient returns a RecommendationsClient for the
+// subscription of the supplied credentials.
+func NewRecommendationsClient(creds map[string]string, auth autorest.Authorizer) RecommendationsAPI {
+	c := advisor.NewRecommendationsClient(creds[azure.CredentialsKeySubscriptionID])
+	c.Authorizer = auth
+	_ = c.AddToUserAgent(azure.UserAgent)
+	return &RecommendationsClient{client: c}
+}
+
+// ListRecommendations returns the Advisor recommendations of the Azure
+// resource with the supplied ID.
+func (c *RecommendationsClient) ListRecommendations(ctx context.Context, resourceID string) ([]advisor.ResourceRecommendationBase, error) {
+	recs := []advisor.ResourceRecommendationBase{}
+	l, err := c.client.ListComplete(ctx, fmt.Sprintf("ResourceId eq '%s'", resourceID), nil, "")
+	for ; err == nil && l.NotDone(); err = l.NextWithContext(ctx) {
+		recs = append(recs, l.Value())
+	}
+	return recs, errors.Wrap(err, errListRecommendations)
+}
+
+// Summarize returns a sorted, one line summary of each of the supplied
+// recommendations, e.g. "Security (High impact): Enable Azure Defender".
+func Summarize(recs []advisor.ResourceRecommendationBase) []string {
+	s := make([]string, 0, len(recs))
+	for _, r := range recs {
+		p := r.RecommendationProperties
+		if p == nil || p.ShortDescription == nil {
+			continue
+		}
+		s = append(s, fmt.Sprintf("%s (%s impact): %s", p.Category, p.Impact, to.String(p.ShortDescription.Problem)))
+	}
+	sort.Strings(s)
+	return s
+}
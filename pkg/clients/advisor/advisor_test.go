@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package advisor
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/advisor/mgmt/2020-01-01/advisor"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSummarize(t *testing.T) {
+	rec := func(c advisor.Category, i advisor.Impact, problem string) advisor.ResourceRecommendationBase {
+		return advisor.ResourceRecommendationBase{
+			RecommendationProperties: &advisor.RecommendationProperties{
+				Category:         c,
+				Impact:           i,
+				ShortDescription: &advisor.ShortDescription{Problem: to.StringPtr(problem)},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		recs []advisor.ResourceRecommendationBase
+		want []string
+	}{
+		"NoRecommendations": {
+			want: []string{},
+		},
+		"Recommendations": {
+			recs: []advisor.ResourceRecommendationBase{
+				rec(advisor.Security, advisor.High, "Enable Azure Defender"),
+				{},
+				rec(advisor.Cost, advisor.Medium, "Right-size underutilized servers"),
+			},
+			want: []string{
+				"Cost (Medium impact): Right-size underutilized servers",
+				"Security (High impact): Enable Azure Defender",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Summarize(tc.recs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Summarize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
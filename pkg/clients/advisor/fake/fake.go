@@ -0,0 +1,38 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/advisor/mgmt/2020-01-01/advisor"
+
+	advisorclients "github.com/crossplane-contrib/provider-azure/pkg/clients/advisor"
+)
+
+var _ advisorclients.RecommendationsAPI = &MockRecommendationsAPI{}
+
+// MockRecommendationsAPI is a fake implementation of advisor.RecommendationsAPI.
+type MockRecommendationsAPI struct {
+	MockListRecommendations func(ctx context.Context, resourceID string) ([]advisor.ResourceRecommendationBase, error)
+}
+
+// ListRecommendations calls the MockRecommendationsAPI's MockListRecommendations
+// method.
+func (m *MockRecommendationsAPI) ListRecommendations(ctx context.Context, resourceID string) ([]advisor.ResourceRecommendationBase, error) {
+	return m.MockListRecommendations(ctx, resourceID)
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultResourceManagerEndpoint is the Azure Resource Manager endpoint of
+	// the public Azure cloud. It is used when the credentials do not specify
+	// one.
+	DefaultResourceManagerEndpoint = "https://management.azure.com/"
+
+	scopeDefaultSuffix = "/.default"
+
+	errNewClientSecretCredential    = "cannot create client secret credential"
+	errNewManagedIdentityCredential = "cannot create managed identity credential"
+	errNewCertificateCredential     = "cannot create client certificate credential"
+	errDecodeCertificate            = "cannot decode client certificate"
+	errParseCertificate             = "cannot parse client certificate"
+	errGetToken                     = "cannot get Azure AD token"
+)
+
+// NewTokenCredential returns an azidentity credential built from the supplied
+// credentials content. The returned credential caches and refreshes tokens
+// by itself, and can be shared by any number of clients, whether they are
+// built on the track 1 (autorest) or the track 2 (azcore) Azure SDK. A
+// managed identity credential is returned when the content enables MSI, a
+// federated credential when it enables workload identity, and a client
+// certificate credential when it supplies a client certificate. Otherwise a
+// client secret credential is returned.
+func NewTokenCredential(creds map[string]string) (azcore.TokenCredential, error) {
+	cfg := cloud.AzurePublic
+	if ep := creds[CredentialsKeyActiveDirectoryEndpointURL]; ep != "" {
+		cfg.ActiveDirectoryAuthorityHost = ep
+	}
+	opts := azcore.ClientOptions{Cloud: cfg}
+
+	if UseMSI(creds) {
+		o := &azidentity.ManagedIdentityCredentialOptions{ClientOptions: opts}
+		if id := creds[CredentialsKeyIdentityClientID]; id != "" {
+			o.ID = azidentity.ClientID(id)
+		}
+		cred, err := azidentity.NewManagedIdentityCredential(o)
+		return cred, errors.Wrap(err, errNewManagedIdentityCredential)
+	}
+
+	if UseWorkloadIdentity(creds) {
+		return NewFederatedCredential(creds)
+	}
+
+	if c := creds[CredentialsKeyClientCertificate]; c != "" {
+		certs, key, err := ParseClientCertificate(c, creds[CredentialsKeyClientCertificatePassword])
+		if err != nil {
+			return nil, err
+		}
+		cred, err := azidentity.NewClientCertificateCredential(creds[CredentialsKeyTenantID], creds[CredentialsKeyClientID], certs, key,
+			&azidentity.ClientCertificateCredentialOptions{ClientOptions: opts})
+		return cred, errors.Wrap(err, errNewCertificateCredential)
+	}
+
+	cred, err := azidentity.NewClientSecretCredential(creds[CredentialsKeyTenantID], creds[CredentialsKeyClientID], creds[CredentialsKeyClientSecret],
+		&azidentity.ClientSecretCredentialOptions{ClientOptions: opts})
+	return cred, errors.Wrap(err, errNewClientSecretCredential)
+}
+
+// ParseClientCertificate parses the certificates and private key found in
+// the supplied client certificate, which may either be PEM encoded, or be a
+// base64 encoded PEM or PKCS#12 (PFX) file. The password is only required
+// to decrypt a PKCS#12 file.
+func ParseClientCertificate(cert, password string) ([]*x509.Certificate, crypto.PrivateKey, error) {
+	data := []byte(cert)
+	if !strings.HasPrefix(strings.TrimSpace(cert), "-----BEGIN") {
+		d, err := base64.StdEncoding.DecodeString(strings.TrimSpace(cert))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errDecodeCertificate)
+		}
+		data = d
+	}
+	certs, key, err := azidentity.ParseCertificates(data, []byte(password))
+	return certs, key, errors.Wrap(err, errParseCertificate)
+}
+
+// UseMSI returns true if the supplied credentials content enables Azure
+// Managed Service Identity authentication.
+func UseMSI(creds map[string]string) bool {
+	return strings.EqualFold(creds[CredentialsKeyUseMSI], "true")
+}
+
+// WithManagedIdentity returns the supplied credentials content with Azure
+// Managed Service Identity authentication enabled if useMSI is true, using
+// the supplied user-assigned identity client ID if it is not empty.
+func WithManagedIdentity(creds map[string]string, useMSI bool, clientID string) map[string]string {
+	if !useMSI {
+		return creds
+	}
+	if creds == nil {
+		creds = map[string]string{}
+	}
+	creds[CredentialsKeyUseMSI] = "true"
+	if clientID != "" {
+		creds[CredentialsKeyIdentityClientID] = clientID
+	}
+	return creds
+}
+
+// NewAuthorizer returns an authorizer for the Azure Resource Manager endpoint
+// found in the supplied credentials content. Requests it authorizes are rate
+// limited by the Throttle shared by all clients; see SetThrottle.
+func NewAuthorizer(creds map[string]string) (autorest.Authorizer, error) {
+	cred, err := NewTokenCredential(creds)
+	if err != nil {
+		return nil, err
+	}
+	ep := creds[CredentialsKeyResourceManagerEndpointURL]
+	if ep == "" {
+		ep = DefaultResourceManagerEndpoint
+	}
+	return NewThrottledAuthorizer(NewBearerAuthorizer(cred, ep), sharedThrottle()), nil
+}
+
+// NewBearerAuthorizer returns an autorest.Authorizer that authorizes requests
+// using bearer tokens issued by the supplied credential for the supplied
+// resource, e.g. https://management.azure.com/. It lets the track 1 clients
+// use azidentity credentials until they are migrated to the track 2 SDK.
+//
+// Only authentication has moved to azidentity so far. Every client of this
+// provider is still built on the track 1 SDK, and the AKS client still
+// manages service principals through the deprecated Azure AD Graph API
+// (graphrbac) rather than Microsoft Graph.
+func NewBearerAuthorizer(cred azcore.TokenCredential, resource string) autorest.Authorizer {
+	return &bearerAuthorizer{
+		cred:   cred,
+		scopes: []string{strings.TrimSuffix(resource, "/") + scopeDefaultSuffix},
+	}
+}
+
+type bearerAuthorizer struct {
+	cred   azcore.TokenCredential
+	scopes []string
+}
+
+// WithAuthorization returns a PrepareDecorator that adds an Authorization
+// header containing a bearer token issued by the underlying credential.
+func (a *bearerAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+			tk, err := a.cred.GetToken(r.Context(), policy.TokenRequestOptions{Scopes: a.scopes})
+			if err != nil {
+				return r, errors.Wrap(err, errGetToken)
+			}
+			return autorest.Prepare(r, autorest.WithBearerAuthorization(tk.Token))
+		})
+	}
+}
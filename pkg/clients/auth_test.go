@@ -0,0 +1,251 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type fakeCredential struct {
+	token  string
+	err    error
+	scopes []string
+}
+
+func (c *fakeCredential) GetToken(_ context.Context, o policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	c.scopes = o.Scopes
+	return azcore.AccessToken{Token: c.token}, c.err
+}
+
+func TestBearerAuthorizer(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		header string
+		scopes []string
+		err    error
+	}
+	cases := map[string]struct {
+		cred     *fakeCredential
+		resource string
+		want     want
+	}{
+		"Success": {
+			cred:     &fakeCredential{token: "cool-token"},
+			resource: DefaultResourceManagerEndpoint,
+			want: want{
+				header: "Bearer cool-token",
+				scopes: []string{"https://management.azure.com/.default"},
+			},
+		},
+		"NoTrailingSlash": {
+			cred:     &fakeCredential{token: "cool-token"},
+			resource: "https://graph.windows.net",
+			want: want{
+				header: "Bearer cool-token",
+				scopes: []string{"https://graph.windows.net/.default"},
+			},
+		},
+		"GetTokenFailed": {
+			cred:     &fakeCredential{err: errBoom},
+			resource: DefaultResourceManagerEndpoint,
+			want: want{
+				scopes: []string{"https://management.azure.com/.default"},
+				err:    errors.Wrap(errBoom, errGetToken),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			a := NewBearerAuthorizer(tc.cred, tc.resource)
+			r, _ := http.NewRequest(http.MethodGet, "https://crossplane.io", nil)
+			r, err := autorest.Prepare(r, a.WithAuthorization())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Prepare(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.scopes, tc.cred.scopes); diff != "" {
+				t.Errorf("GetToken(...): -want scopes, +got scopes:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.header, r.Header.Get("Authorization")); diff != "" {
+				t.Errorf("Prepare(...): -want header, +got header:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithManagedIdentity(t *testing.T) {
+	type args struct {
+		creds    map[string]string
+		useMSI   bool
+		clientID string
+	}
+	cases := map[string]struct {
+		args args
+		want map[string]string
+	}{
+		"Disabled": {
+			args: args{
+				creds: map[string]string{CredentialsKeySubscriptionID: "sub"},
+			},
+			want: map[string]string{CredentialsKeySubscriptionID: "sub"},
+		},
+		"SystemAssigned": {
+			args: args{
+				creds:  map[string]string{CredentialsKeySubscriptionID: "sub"},
+				useMSI: true,
+			},
+			want: map[string]string{CredentialsKeySubscriptionID: "sub", CredentialsKeyUseMSI: "true"},
+		},
+		"UserAssigned": {
+			args: args{
+				useMSI:   true,
+				clientID: "cool-identity",
+			},
+			want: map[string]string{CredentialsKeyUseMSI: "true", CredentialsKeyIdentityClientID: "cool-identity"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := WithManagedIdentity(tc.args.creds, tc.args.useMSI, tc.args.clientID)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("WithManagedIdentity(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// newCertificate returns a PEM encoded self-signed certificate and its RSA
+// private key, which is the only kind Azure AD accepts.
+func newCertificate(t *testing.T) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "crossplane"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})) +
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: k}))
+}
+
+func TestParseClientCertificate(t *testing.T) {
+	cert := newCertificate(t)
+
+	cases := map[string]struct {
+		cert    string
+		wantErr bool
+	}{
+		"PEM": {
+			cert: cert,
+		},
+		"Base64PEM": {
+			cert: base64.StdEncoding.EncodeToString([]byte(cert)),
+		},
+		"NotBase64": {
+			cert:    "not a certificate!",
+			wantErr: true,
+		},
+		"NotCertificate": {
+			cert:    base64.StdEncoding.EncodeToString([]byte("not a certificate")),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			certs, key, err := ParseClientCertificate(tc.cert, "")
+			if diff := cmp.Diff(tc.wantErr, err != nil); diff != "" {
+				t.Fatalf("ParseClientCertificate(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.wantErr {
+				return
+			}
+			if len(certs) != 1 || key == nil {
+				t.Errorf("ParseClientCertificate(...): want 1 certificate and a key, got %d certificates and key %v", len(certs), key)
+			}
+		})
+	}
+}
+
+func TestNewTokenCredential(t *testing.T) {
+	cases := map[string]struct {
+		creds map[string]string
+		want  azcore.TokenCredential
+	}{
+		"ClientSecret": {
+			creds: map[string]string{CredentialsKeyTenantID: "tenant", CredentialsKeyClientID: "client", CredentialsKeyClientSecret: "secret"},
+			want:  &azidentity.ClientSecretCredential{},
+		},
+		"ClientCertificate": {
+			creds: map[string]string{CredentialsKeyTenantID: "tenant", CredentialsKeyClientID: "client", CredentialsKeyClientCertificate: newCertificate(t)},
+			want:  &azidentity.ClientCertificateCredential{},
+		},
+		"ManagedIdentity": {
+			creds: map[string]string{CredentialsKeyUseMSI: "true", CredentialsKeyIdentityClientID: "cool-identity"},
+			want:  &azidentity.ManagedIdentityCredential{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewTokenCredential(tc.creds)
+			if err != nil {
+				t.Fatalf("NewTokenCredential(...): %s", err)
+			}
+			if diff := cmp.Diff(reflect.TypeOf(tc.want).String(), reflect.TypeOf(got).String()); diff != "" {
+				t.Errorf("NewTokenCredential(...): -want type, +got type:\n%s", diff)
+			}
+		})
+	}
+}
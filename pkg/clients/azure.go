@@ -20,17 +20,18 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
@@ -43,7 +44,16 @@ const (
 	// AsyncOperationStatusInProgress is the status value for AsyncOperation type
 	// that indicates the operation is still ongoing.
 	AsyncOperationStatusInProgress = "InProgress"
-	asyncOperationPollingMethod    = "AsyncOperation"
+	// AsyncOperationStatusSucceeded is the status value for AsyncOperation
+	// type that indicates the operation has completed successfully.
+	AsyncOperationStatusSucceeded = "Succeeded"
+	// AsyncOperationStatusFailed is the status value for AsyncOperation type
+	// that indicates the operation has failed.
+	AsyncOperationStatusFailed = "Failed"
+	// AsyncOperationStatusCanceled is the status value for AsyncOperation type
+	// that indicates the operation was canceled.
+	AsyncOperationStatusCanceled = "Canceled"
+	asyncOperationPollingMethod  = "AsyncOperation"
 )
 
 // Error strings.
@@ -81,72 +91,139 @@ const (
 	CredentialsKeySQLManagementEndpointURL       = "sqlManagementEndpointUrl"
 	CredentialsKeyGalleryEndpointURL             = "galleryEndpointUrl"
 	CredentialsManagementEndpointURL             = "managementEndpointUrl"
+
+	// CredentialsKeyClientCertificate may be supplied instead of
+	// CredentialsKeyClientSecret to authenticate using a client certificate.
+	// It contains a PEM encoded certificate and private key, or a base64
+	// encoded PEM or PKCS#12 (PFX) file, optionally protected by
+	// CredentialsKeyClientCertificatePassword.
+	CredentialsKeyClientCertificate         = "clientCertificate"
+	CredentialsKeyClientCertificatePassword = "clientCertificatePassword"
+
+	// CredentialsKeyUseMSI and CredentialsKeyIdentityClientID are not part
+	// of the Azure credentials file. They are set from the useMSI and
+	// identityClientID fields of a ProviderConfig, but may also be supplied
+	// in the credentials content directly.
+	CredentialsKeyUseMSI           = "useMSI"
+	CredentialsKeyIdentityClientID = "identityClientId"
+
+	// CredentialsKeyUseWorkloadIdentity is set from the useWorkloadIdentity
+	// field of a ProviderConfig, but may also be supplied in the credentials
+	// content directly, as may CredentialsKeyFederatedTokenFile.
+	CredentialsKeyUseWorkloadIdentity = "useWorkloadIdentity"
+	CredentialsKeyFederatedTokenFile  = "federatedTokenFile"
+)
+
+// Connection secret keys that identify the Azure resource a managed resource
+// represents. They are published by every controller that writes a connection
+// secret, so consumers can locate the resource without reading the managed
+// resource.
+const (
+	ConnectionSecretKeyResourceID     = "resourceId"
+	ConnectionSecretKeyLocation       = "location"
+	ConnectionSecretKeyResourceGroup  = "resourceGroup"
+	ConnectionSecretKeySubscriptionID = "subscriptionId"
 )
 
 // GetAuthInfo figures out how to connect to Azure API and returns the necessary
 // information to be used for controllers to construct their specific clients.
+// The subscription ID in the returned content is the one the managed resource
+// overrides the subscription of the provider's credentials with, if any.
 func GetAuthInfo(ctx context.Context, c client.Client, mg resource.Managed) (content map[string]string, authorizer autorest.Authorizer, err error) {
+	content, err = GetCredentials(ctx, c, mg)
+	if err != nil {
+		return nil, nil, err
+	}
+	authorizer, err = NewAuthorizer(content)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errGetAuthorizer)
+	}
+	content, err = subscriptionOverrider.Override(ctx, mg, content, authorizer)
+	return content, authorizer, err
+}
+
+// GetCredentials returns the credentials content of the Provider or
+// ProviderConfig the supplied managed resource references. Unlike GetAuthInfo
+// it neither authenticates using the content nor applies the subscription the
+// managed resource overrides it with.
+func GetCredentials(ctx context.Context, c client.Client, mg resource.Managed) (map[string]string, error) {
 	switch {
 	case mg.GetProviderConfigReference() != nil:
-		return UseProviderConfig(ctx, c, mg)
+		return providerConfigCredentials(ctx, c, mg)
 	case mg.GetProviderReference() != nil:
-		return UseProvider(ctx, c, mg)
+		return providerCredentials(ctx, c, mg)
 	default:
-		return nil, nil, errors.New(errNeitherPCNorPGiven)
+		return nil, errors.New(errNeitherPCNorPGiven)
 	}
 }
 
 // UseProvider to return the necessary information to construct an Azure client.
 // Deprecated: Use UseProviderConfig
 func UseProvider(ctx context.Context, c client.Client, mg resource.Managed) (content map[string]string, authorizer autorest.Authorizer, err error) {
+	m, err := providerCredentials(ctx, c, mg)
+	if err != nil {
+		return nil, nil, err
+	}
+	a, err := NewAuthorizer(m)
+	return m, a, errors.Wrap(err, errGetAuthorizer)
+}
+
+// UseProviderConfig to return the necessary information to construct an Azure
+// client.
+func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed) (content map[string]string, authorizer autorest.Authorizer, err error) {
+	m, err := providerConfigCredentials(ctx, c, mg)
+	if err != nil {
+		return nil, nil, err
+	}
+	a, err := NewAuthorizer(m)
+	return m, a, errors.Wrap(err, errGetAuthorizer)
+}
+
+func providerCredentials(ctx context.Context, c client.Client, mg resource.Managed) (map[string]string, error) {
 	p := &v1alpha3.Provider{}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderReference().Name}, p); err != nil {
-		return nil, nil, errors.Wrap(err, errGetProvider)
+		return nil, errors.Wrap(err, errGetProvider)
 	}
 
 	ref := p.Spec.CredentialsSecretRef
 	s := &corev1.Secret{}
 	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	m := map[string]string{}
 	if err := json.Unmarshal(s.Data[ref.Key], &m); err != nil {
-		return nil, nil, errors.Wrap(err, errUnmarshalCredentialSecret)
+		return nil, errors.Wrap(err, errUnmarshalCredentialSecret)
 	}
-	cfg := auth.NewClientCredentialsConfig(m[CredentialsKeyClientID], m[CredentialsKeyClientSecret], m[CredentialsKeyTenantID])
-	cfg.AADEndpoint = m[CredentialsKeyActiveDirectoryEndpointURL]
-	cfg.Resource = m[CredentialsKeyResourceManagerEndpointURL]
-
-	a, err := cfg.Authorizer()
-	return m, a, errors.Wrap(err, errGetAuthorizer)
+	return WithManagedIdentity(m, p.Spec.UseMSI, to.String(p.Spec.IdentityClientID)), nil
 }
 
-// UseProviderConfig to return the necessary information to construct an Azure
-// client.
-func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed) (content map[string]string, authorizer autorest.Authorizer, err error) {
-	pc := &v1beta1.ProviderConfig{}
+func providerConfigCredentials(ctx context.Context, c client.Client, mg resource.Managed) (map[string]string, error) {
 	t := resource.NewProviderConfigUsageTracker(c, &v1beta1.ProviderConfigUsage{})
 	if err := t.Track(ctx, mg); err != nil {
-		return nil, nil, errors.Wrap(err, errTrackProviderConfigUsage)
+		return nil, errors.Wrap(err, errTrackProviderConfigUsage)
 	}
-	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, nil, errors.Wrap(err, errGetProviderConfig)
+	return ProviderConfigCredentials(ctx, c, mg.GetProviderConfigReference().Name)
+}
+
+// ProviderConfigCredentials returns the credentials content of the named
+// ProviderConfig. It is used by consumers that are not managed resources,
+// such as connection secret stores, and therefore do not track usage.
+func ProviderConfigCredentials(ctx context.Context, c client.Client, name string) (map[string]string, error) {
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetProviderConfig)
 	}
 
 	data, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, c, pc.Spec.Credentials.CommonCredentialSelectors)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "cannot get credentials")
+		return nil, errors.Wrap(err, "cannot get credentials")
 	}
 	m := map[string]string{}
 	if err := json.Unmarshal(data, &m); err != nil {
-		return nil, nil, errors.Wrap(err, errUnmarshalCredentialSecret)
+		return nil, errors.Wrap(err, errUnmarshalCredentialSecret)
 	}
-	cfg := auth.NewClientCredentialsConfig(m[CredentialsKeyClientID], m[CredentialsKeyClientSecret], m[CredentialsKeyTenantID])
-	cfg.AADEndpoint = m[CredentialsKeyActiveDirectoryEndpointURL]
-	cfg.Resource = m[CredentialsKeyResourceManagerEndpointURL]
-
-	a, err := cfg.Authorizer()
-	return m, a, errors.Wrap(err, errGetAuthorizer)
+	m = WithManagedIdentity(m, pc.Spec.Credentials.UseMSI, to.String(pc.Spec.Credentials.IdentityClientID))
+	return WithWorkloadIdentity(m, pc.Spec.Credentials.UseWorkloadIdentity), nil
 }
 
 // Client struct that represents the information needed to connect to the Azure services as a client
@@ -171,17 +248,12 @@ type Credentials struct {
 // NewClient returns a client that can be used to connect to Azure services
 // using the supplied JSON credentials.
 func NewClient(credentials []byte) (*Client, error) {
-	creds := Credentials{}
-	if err := json.Unmarshal(credentials, &creds); err != nil {
+	m := map[string]string{}
+	if err := json.Unmarshal(credentials, &m); err != nil {
 		return nil, errors.Wrap(err, "failed to unmarshal azure client secret data")
 	}
 
-	// create a config object from the loaded credentials data
-	config := auth.NewClientCredentialsConfig(creds.ClientID, creds.ClientSecret, creds.TenantID)
-	config.AADEndpoint = creds.ActiveDirectoryEndpointURL
-	config.Resource = creds.ResourceManagerEndpointURL
-
-	authorizer, err := config.Authorizer()
+	authorizer, err := NewAuthorizer(m)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get authorizer from config")
 	}
@@ -189,12 +261,13 @@ func NewClient(credentials []byte) (*Client, error) {
 	return &Client{
 		Authorizer: authorizer,
 		Credentials: Credentials{
-			SubscriptionID:                 creds.SubscriptionID,
-			ClientID:                       creds.ClientID,
-			ClientSecret:                   creds.ClientSecret,
-			TenantID:                       creds.TenantID,
-			ActiveDirectoryEndpointURL:     creds.ActiveDirectoryEndpointURL,
-			ActiveDirectoryGraphResourceID: creds.ActiveDirectoryGraphResourceID,
+			SubscriptionID:                 m[CredentialsKeySubscriptionID],
+			ClientID:                       m[CredentialsKeyClientID],
+			ClientSecret:                   m[CredentialsKeyClientSecret],
+			TenantID:                       m[CredentialsKeyTenantID],
+			ActiveDirectoryEndpointURL:     m[CredentialsKeyActiveDirectoryEndpointURL],
+			ResourceManagerEndpointURL:     m[CredentialsKeyResourceManagerEndpointURL],
+			ActiveDirectoryGraphResourceID: m[CredentialsKeyActiveDirectoryGraphResourceID],
 		},
 	}, nil
 }
@@ -211,7 +284,9 @@ func ValidateClient(client *Client) error {
 }
 
 // FetchAsyncOperation updates the given operation object with the most up-to-date
-// status retrieved from Azure API.
+// status retrieved from Azure API. The error message of a failed operation is
+// annotated with the correlation and request IDs of the response that
+// reported the failure, which are also recorded in the operation.
 func FetchAsyncOperation(ctx context.Context, client autorest.Sender, as *v1alpha3.AsyncOperation) error {
 	if as == nil || as.PollingURL == "" || as.Method == "" {
 		return nil
@@ -239,14 +314,72 @@ func FetchAsyncOperation(ctx context.Context, client autorest.Sender, as *v1alph
 	// status. But DoneWithContext returns uses the same error variable for both
 	// cases, so, we make a compromise and not return the error even if it's
 	// related to fetch call.
-	_, err = op.DoneWithContext(ctx, client)
+	done, err := op.DoneWithContext(ctx, client)
 	as.Status = op.Status()
+	asyncOperations.observe(as.PollingURL, as.Method, as.Status, done, time.Now())
 	if err != nil {
-		as.ErrorMessage = err.Error()
+		if cid, rid := requestIDs(op.Response()); cid != "" || rid != "" {
+			as.CorrelationRequestID, as.RequestID = cid, rid
+		}
+		as.ErrorMessage = withResponseRequestIDs(err, op.Response()).Error()
 	}
 	return nil
 }
 
+// NewAsyncOperation returns an AsyncOperation that records the supplied
+// long-running operation, which was started using the supplied HTTP method.
+// The returned AsyncOperation may be persisted in the status of a managed
+// resource and later polled using FetchAsyncOperation, including by a
+// controller that was restarted after the operation was started. The
+// correlation and request IDs of the request that started the operation are
+// recorded too, so that it can be traced by Azure support.
+func NewAsyncOperation(method string, f azure.FutureAPI) v1alpha3.AsyncOperation {
+	if f == nil {
+		return v1alpha3.AsyncOperation{}
+	}
+	cid, rid := requestIDs(f.Response())
+	return v1alpha3.AsyncOperation{
+		Method:               method,
+		PollingURL:           f.PollingURL(),
+		Status:               f.Status(),
+		CorrelationRequestID: cid,
+		RequestID:            rid,
+	}
+}
+
+// AsyncOperationInProgress returns true if the supplied AsyncOperation was
+// started using the supplied HTTP method and has not yet completed.
+func AsyncOperationInProgress(as v1alpha3.AsyncOperation, method string) bool {
+	return as.Method == method && as.Status == AsyncOperationStatusInProgress
+}
+
+// AsyncOperationFailed returns true if the supplied AsyncOperation has
+// completed without succeeding.
+func AsyncOperationFailed(as v1alpha3.AsyncOperation) bool {
+	return as.Status == AsyncOperationStatusFailed || as.Status == AsyncOperationStatusCanceled
+}
+
+// ResourceConnectionDetails returns the connection details that identify the
+// Azure resource with the supplied ID and location. The subscription and
+// resource group are parsed from the ID. Details that are not yet known, e.g.
+// because the resource has not been observed, are omitted.
+func ResourceConnectionDetails(id, location string) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if id != "" {
+		cd[ConnectionSecretKeyResourceID] = []byte(id)
+	}
+	if location != "" {
+		cd[ConnectionSecretKeyLocation] = []byte(location)
+	}
+	r, err := azure.ParseResourceID(id)
+	if err != nil {
+		return cd
+	}
+	cd[ConnectionSecretKeySubscriptionID] = []byte(r.SubscriptionID)
+	cd[ConnectionSecretKeyResourceGroup] = []byte(r.ResourceGroup)
+	return cd
+}
+
 // IsNotFound returns a value indicating whether the given error represents that the resource was not found.
 func IsNotFound(err error) bool {
 	detailedError, ok := err.(autorest.DetailedError)
@@ -448,6 +581,17 @@ func LateInitializeInt32PtrFromInt32Ptr(in *int32, from *int32) *int32 {
 	return nil
 }
 
+// LateInitializeInt64PtrFromInt64Ptr late-inits *int64
+func LateInitializeInt64PtrFromInt64Ptr(in *int64, from *int64) *int64 {
+	if in != nil {
+		return in
+	}
+	if from != nil {
+		return to.Int64Ptr(*from)
+	}
+	return nil
+}
+
 // LateInitializeStringValArrFromArrPtr late-inits []string
 func LateInitializeStringValArrFromArrPtr(in []string, from *[]string) []string {
 	if in != nil {
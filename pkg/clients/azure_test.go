@@ -26,9 +26,11 @@ import (
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/google/go-cmp/cmp"
 	"github.com/onsi/gomega"
 
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
@@ -55,6 +57,7 @@ func TestNewClient(t *testing.T) {
 	g.Expect(err).NotTo(gomega.HaveOccurred())
 	g.Expect(client).NotTo(gomega.BeNil())
 	g.Expect(client.SubscriptionID).To(gomega.Equal("bf1b0e59-93da-42e0-82c6-5a1d94227911"))
+	g.Expect(client.ResourceManagerEndpointURL).To(gomega.Equal("https://management.azure.com/"))
 }
 
 func TestFetchAsyncOperation(t *testing.T) {
@@ -104,6 +107,37 @@ func TestFetchAsyncOperation(t *testing.T) {
 				},
 			},
 		},
+		"FailureWithRequestIDs": {
+			args: args{
+				as: &v1alpha3.AsyncOperation{
+					Method:     http.MethodPut,
+					PollingURL: pollingURL,
+				},
+				sender: autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+					req.URL, _ = url.Parse("https://crossplane.io/resource1")
+					return &http.Response{
+						Request:    req,
+						StatusCode: http.StatusOK,
+						Header: http.Header{
+							"X-Ms-Correlation-Request-Id": []string{"cool-correlation"},
+							"X-Ms-Request-Id":             []string{"cool-request"},
+						},
+						Body:          ioutil.NopCloser(strings.NewReader(errorResponse)),
+						ContentLength: int64(len([]byte(errorResponse))),
+					}, nil
+				}),
+			},
+			want: want{
+				op: &v1alpha3.AsyncOperation{
+					Method:               http.MethodPut,
+					PollingURL:           pollingURL,
+					Status:               errorStatus,
+					ErrorMessage:         errorMessage + " (correlation ID: cool-correlation, request ID: cool-request)",
+					CorrelationRequestID: "cool-correlation",
+					RequestID:            "cool-request",
+				},
+			},
+		},
 		"Failure": {
 			args: args{
 				as: &v1alpha3.AsyncOperation{
@@ -145,6 +179,142 @@ func TestFetchAsyncOperation(t *testing.T) {
 
 }
 
+func TestNewAsyncOperation(t *testing.T) {
+	pollingURL := "https://crossplane.io"
+	f := &azure.Future{}
+	if err := f.UnmarshalJSON([]byte(fmt.Sprintf(`{"method": "PUT", "pollingMethod": "AsyncOperation", "pollingURI": "%s", "lroState": "%s"}`, pollingURL, AsyncOperationStatusInProgress))); err != nil {
+		t.Fatalf("UnmarshalJSON(...): %s", err)
+	}
+
+	started, err := azure.NewFutureFromResponse(&http.Response{
+		StatusCode: http.StatusCreated,
+		Request:    &http.Request{Method: http.MethodPut, URL: &url.URL{Scheme: "https", Host: "crossplane.io", Path: "/resource1"}},
+		Header: http.Header{
+			"Azure-Asyncoperation":        []string{pollingURL},
+			"X-Ms-Correlation-Request-Id": []string{"cool-correlation"},
+			"X-Ms-Request-Id":             []string{"cool-request"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewFutureFromResponse(...): %s", err)
+	}
+
+	type args struct {
+		method string
+		f      azure.FutureAPI
+	}
+	type want struct {
+		op         v1alpha3.AsyncOperation
+		inProgress bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoFuture": {
+			args: args{method: http.MethodPut},
+		},
+		"InProgress": {
+			args: args{method: http.MethodPut, f: f},
+			want: want{
+				op: v1alpha3.AsyncOperation{
+					Method:     http.MethodPut,
+					PollingURL: pollingURL,
+					Status:     AsyncOperationStatusInProgress,
+				},
+				inProgress: true,
+			},
+		},
+		"WithRequestIDs": {
+			args: args{method: http.MethodPut, f: &started},
+			want: want{
+				op: v1alpha3.AsyncOperation{
+					Method:               http.MethodPut,
+					PollingURL:           pollingURL,
+					Status:               AsyncOperationStatusInProgress,
+					CorrelationRequestID: "cool-correlation",
+					RequestID:            "cool-request",
+				},
+				inProgress: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			op := NewAsyncOperation(tc.args.method, tc.args.f)
+			if diff := cmp.Diff(tc.want.op, op); diff != "" {
+				t.Errorf("NewAsyncOperation(...): -want, +got:\n%s", diff)
+			}
+			if got := AsyncOperationInProgress(op, http.MethodPut); got != tc.want.inProgress {
+				t.Errorf("AsyncOperationInProgress(...): want %t, got %t", tc.want.inProgress, got)
+			}
+		})
+	}
+}
+
+func TestAsyncOperationFailed(t *testing.T) {
+	cases := map[string]struct {
+		status string
+		want   bool
+	}{
+		"Unknown":    {status: "", want: false},
+		"InProgress": {status: AsyncOperationStatusInProgress, want: false},
+		"Succeeded":  {status: AsyncOperationStatusSucceeded, want: false},
+		"Failed":     {status: AsyncOperationStatusFailed, want: true},
+		"Canceled":   {status: AsyncOperationStatusCanceled, want: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := AsyncOperationFailed(v1alpha3.AsyncOperation{Status: tc.status}); got != tc.want {
+				t.Errorf("AsyncOperationFailed(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestResourceConnectionDetails(t *testing.T) {
+	id := "/subscriptions/coolsub/resourceGroups/coolgroup/providers/Microsoft.Cache/Redis/coolcache"
+
+	cases := map[string]struct {
+		id       string
+		location string
+		want     managed.ConnectionDetails
+	}{
+		"NotObserved": {
+			want: managed.ConnectionDetails{},
+		},
+		"InvalidID": {
+			id:       "cool",
+			location: "westus2",
+			want: managed.ConnectionDetails{
+				ConnectionSecretKeyResourceID: []byte("cool"),
+				ConnectionSecretKeyLocation:   []byte("westus2"),
+			},
+		},
+		"Successful": {
+			id:       id,
+			location: "westus2",
+			want: managed.ConnectionDetails{
+				ConnectionSecretKeyResourceID:     []byte(id),
+				ConnectionSecretKeyLocation:       []byte("westus2"),
+				ConnectionSecretKeySubscriptionID: []byte("coolsub"),
+				ConnectionSecretKeyResourceGroup:  []byte("coolgroup"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ResourceConnectionDetails(tc.id, tc.location)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ResourceConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestIsNotFound(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
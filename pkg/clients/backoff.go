@@ -0,0 +1,255 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Defaults of the Backoff policy used by controllers whose Azure API calls
+// are prone to throttling.
+const (
+	DefaultBackoffBase   = 1 * time.Second
+	DefaultBackoffMax    = 5 * time.Minute
+	DefaultBackoffJitter = 0.2
+)
+
+// HeaderRetryAfter is the response header in which Azure Resource Manager
+// tells throttled clients how long to wait before retrying.
+const HeaderRetryAfter = "Retry-After"
+
+// RetryAfter returns how long Azure asked us to wait before retrying the
+// throttled API call the supplied error stems from. It returns false if the
+// error does not stem from a throttled call, or if Azure did not say.
+func RetryAfter(err error, now time.Time) (time.Duration, bool) {
+	de := autorest.DetailedError{}
+	if !errors.As(err, &de) || de.Response == nil || de.Response.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	v := de.Response.Header.Get(HeaderRetryAfter)
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// A Backoff is a controller-runtime rate limiter that requeues managed
+// resources whose Azure API calls keep failing with a jittered, exponentially
+// increasing delay up to a maximum. Managed resources whose calls were
+// throttled are not requeued before the Retry-After delay returned by Azure
+// has elapsed, so that throttled subscriptions are not hammered. Managed
+// resources whose calls failed with a terminal error are requeued after the
+// maximum delay; they are reconciled again as soon as they are changed.
+type Backoff struct {
+	base   time.Duration
+	max    time.Duration
+	jitter float64
+
+	now  func() time.Time
+	rand func() float64
+
+	mu         sync.Mutex
+	failures   map[interface{}]int
+	retryAfter map[interface{}]time.Time
+	terminal   map[interface{}]bool
+}
+
+// A BackoffOption configures a Backoff.
+type BackoffOption func(*Backoff)
+
+// WithBackoffDelays configures the base and maximum delay of a Backoff.
+func WithBackoffDelays(base, max time.Duration) BackoffOption {
+	return func(b *Backoff) {
+		b.base = base
+		b.max = max
+	}
+}
+
+// WithBackoffJitter configures the fraction of each delay that a Backoff
+// randomizes, such that resources that failed together are not requeued
+// together.
+func WithBackoffJitter(j float64) BackoffOption {
+	return func(b *Backoff) {
+		b.jitter = j
+	}
+}
+
+// NewBackoff returns a new Backoff.
+func NewBackoff(o ...BackoffOption) *Backoff {
+	b := &Backoff{
+		base:       DefaultBackoffBase,
+		max:        DefaultBackoffMax,
+		jitter:     DefaultBackoffJitter,
+		now:        time.Now,
+		rand:       rand.Float64, // nolint:gosec // Jitter needn't be cryptographically secure.
+		failures:   map[interface{}]int{},
+		retryAfter: map[interface{}]time.Time{},
+		terminal:   map[interface{}]bool{},
+	}
+	for _, fn := range o {
+		fn(b)
+	}
+	return b
+}
+
+// When returns how long to wait before requeueing the supplied item.
+func (b *Backoff) When(item interface{}) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	n := b.failures[item]
+	b.failures[item] = n + 1
+
+	if b.terminal[item] {
+		delete(b.terminal, item)
+		delete(b.retryAfter, item)
+		return b.max
+	}
+
+	d := b.max
+	if e := float64(b.base) * math.Pow(2, float64(n)); e < float64(b.max) {
+		d = time.Duration(e)
+	}
+	// Jitter only ever shortens the delay, so that it never exceeds the max.
+	d -= time.Duration(b.jitter * b.rand() * float64(d))
+
+	if t, ok := b.retryAfter[item]; ok {
+		delete(b.retryAfter, item)
+		if ra := t.Sub(b.now()); ra > d {
+			d = ra
+		}
+	}
+	return d
+}
+
+// Forget that the supplied item ever failed.
+func (b *Backoff) Forget(item interface{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.failures, item)
+	delete(b.retryAfter, item)
+	delete(b.terminal, item)
+}
+
+// NumRequeues returns how many times the supplied item has been requeued
+// since it was last forgotten.
+func (b *Backoff) NumRequeues(item interface{}) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.failures[item]
+}
+
+// Observe the supplied error, returned while reconciling the supplied managed
+// resource. The next requeue of a managed resource whose Azure API call was
+// throttled is delayed until at least the time Azure asked us to wait, while
+// that of a managed resource whose call failed terminally is delayed by the
+// maximum delay.
+func (b *Backoff) Observe(mg resource.Managed, err error) {
+	if err == nil {
+		return
+	}
+	now := b.now()
+	item := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: mg.GetNamespace(), Name: mg.GetName()}}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if IsTerminal(err) {
+		b.terminal[item] = true
+	}
+	if d, ok := RetryAfter(err, now); ok {
+		b.retryAfter[item] = now.Add(d)
+	}
+}
+
+// ForControllerRuntime returns the supplied options for controller-runtime,
+// using this Backoff as the controller's rate limiter.
+func (b *Backoff) ForControllerRuntime(o controller.Options) crcontroller.Options {
+	co := o.ForControllerRuntime()
+	co.RateLimiter = b
+	return co
+}
+
+// NewBackoffConnecter returns a managed.ExternalConnecter whose external
+// clients report the errors they return to the supplied Backoff.
+func NewBackoffConnecter(b *Backoff, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &backoffConnecter{ExternalConnecter: c, backoff: b}
+}
+
+type backoffConnecter struct {
+	managed.ExternalConnecter
+	backoff *Backoff
+}
+
+func (c *backoffConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		c.backoff.Observe(mg, err)
+		return nil, err
+	}
+	return &backoffExternal{ExternalClient: e, backoff: c.backoff}, nil
+}
+
+type backoffExternal struct {
+	managed.ExternalClient
+	backoff *Backoff
+}
+
+func (e *backoffExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	e.backoff.Observe(mg, err)
+	return o, err
+}
+
+func (e *backoffExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.ExternalClient.Create(ctx, mg)
+	e.backoff.Observe(mg, err)
+	return c, err
+}
+
+func (e *backoffExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.ExternalClient.Update(ctx, mg)
+	e.backoff.Observe(mg, err)
+	return u, err
+}
+
+func (e *backoffExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	err := e.ExternalClient.Delete(ctx, mg)
+	e.backoff.Observe(mg, err)
+	return err
+}
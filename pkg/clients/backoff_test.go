@@ -0,0 +1,197 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func throttled(retryAfter string) error {
+	h := http.Header{}
+	if retryAfter != "" {
+		h.Set(HeaderRetryAfter, retryAfter)
+	}
+	return autorest.DetailedError{
+		Original:   errors.New("boom"),
+		StatusCode: http.StatusTooManyRequests,
+		Response:   &http.Response{StatusCode: http.StatusTooManyRequests, Header: h},
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2022, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	type want struct {
+		d  time.Duration
+		ok bool
+	}
+
+	cases := map[string]struct {
+		err  error
+		want want
+	}{
+		"NoError": {},
+		"NotAnAzureError": {
+			err: errors.New("boom"),
+		},
+		"NotThrottled": {
+			err: autorest.DetailedError{Response: &http.Response{StatusCode: http.StatusConflict, Header: http.Header{HeaderRetryAfter: []string{"10"}}}},
+		},
+		"NoRetryAfter": {
+			err: throttled(""),
+		},
+		"Seconds": {
+			err:  errors.Wrap(throttled("17"), "cannot get thing"),
+			want: want{d: 17 * time.Second, ok: true},
+		},
+		"Date": {
+			err:  throttled(now.Add(time.Minute).Format(http.TimeFormat)),
+			want: want{d: time.Minute, ok: true},
+		},
+		"DateInThePast": {
+			err:  throttled(now.Add(-time.Minute).Format(http.TimeFormat)),
+			want: want{ok: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, ok := RetryAfter(tc.err, now)
+			if diff := cmp.Diff(tc.want, want{d: d, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("RetryAfter(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	now := time.Date(2022, time.March, 1, 12, 0, 0, 0, time.UTC)
+	mg := &fake.Managed{}
+	mg.SetName("cool")
+	item := reconcile.Request{NamespacedName: types.NamespacedName{Name: "cool"}}
+
+	cases := map[string]struct {
+		b       *Backoff
+		failing int
+		err     error
+		want    time.Duration
+	}{
+		"FirstFailure": {
+			b:       NewBackoff(WithBackoffJitter(0)),
+			failing: 1,
+			want:    DefaultBackoffBase,
+		},
+		"Exponential": {
+			b:       NewBackoff(WithBackoffJitter(0)),
+			failing: 4,
+			want:    8 * DefaultBackoffBase,
+		},
+		"MaxBackoff": {
+			b:       NewBackoff(WithBackoffDelays(time.Second, 10*time.Second), WithBackoffJitter(0)),
+			failing: 100,
+			want:    10 * time.Second,
+		},
+		"Jitter": {
+			b:       NewBackoff(WithBackoffDelays(10*time.Second, time.Minute), WithBackoffJitter(0.5)),
+			failing: 1,
+			want:    7500 * time.Millisecond,
+		},
+		"RetryAfterExceedsBackoff": {
+			b:       NewBackoff(WithBackoffJitter(0)),
+			failing: 1,
+			err:     throttled("30"),
+			want:    30 * time.Second,
+		},
+		"Terminal": {
+			b:       NewBackoff(WithBackoffJitter(0)),
+			failing: 1,
+			err:     &azure.ServiceError{Code: "InvalidParameter"},
+			want:    DefaultBackoffMax,
+		},
+		"BackoffExceedsRetryAfter": {
+			b:       NewBackoff(WithBackoffJitter(0)),
+			failing: 3,
+			err:     throttled("1"),
+			want:    4 * DefaultBackoffBase,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.b.now = func() time.Time { return now }
+			tc.b.rand = func() float64 { return 0.5 }
+
+			var got time.Duration
+			for i := 0; i < tc.failing; i++ {
+				if i == tc.failing-1 {
+					tc.b.Observe(mg, tc.err)
+				}
+				got = tc.b.When(item)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("When(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.failing, tc.b.NumRequeues(item)); diff != "" {
+				t.Errorf("NumRequeues(...): -want, +got:\n%s", diff)
+			}
+
+			tc.b.Forget(item)
+			if diff := cmp.Diff(0, tc.b.NumRequeues(item)); diff != "" {
+				t.Errorf("Forget(...): -want requeues, +got requeues:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackoffConnecter(t *testing.T) {
+	now := time.Date(2022, time.March, 1, 12, 0, 0, 0, time.UTC)
+	mg := &fake.Managed{}
+	mg.SetName("cool")
+	item := reconcile.Request{NamespacedName: types.NamespacedName{Name: "cool"}}
+
+	b := NewBackoff(WithBackoffJitter(0))
+	b.now = func() time.Time { return now }
+
+	c := NewBackoffConnecter(b, managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &fakeExternal{err: throttled("42")}, nil
+	}))
+	e, err := c.Connect(context.Background(), mg)
+	if err != nil {
+		t.Fatalf("Connect(...): %v", err)
+	}
+
+	if _, err := e.Observe(context.Background(), mg); err == nil {
+		t.Errorf("Observe(...): want error, got nil")
+	}
+	if diff := cmp.Diff(42*time.Second, b.When(item)); diff != "" {
+		t.Errorf("When(...): -want, +got:\n%s", diff)
+	}
+}
@@ -19,23 +19,31 @@ package compute
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/authorization/mgmt/2015-07-01/authorization"
 	authorizationmgmt "github.com/Azure/azure-sdk-for-go/services/authorization/mgmt/2015-07-01/authorization"
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azurev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 )
 
@@ -48,24 +56,103 @@ const (
 	// access them.
 	NetworkContributorRoleID = "/providers/Microsoft.Authorization/roleDefinitions/4d97b98b-1d4f-4787-a291-c67834d212e7"
 
+	// TagKeyInlineNodePool tags the node pools declared inline in an
+	// AKSCluster. The AKSCluster controller leaves node pools without it,
+	// e.g. those of AKSNodePool resources, untouched.
+	TagKeyInlineNodePool = "crossplane-inline-node-pool"
+
+	// MonitoringAddonName is the name of the addon that sends the container
+	// logs and metrics of a cluster to a Log Analytics workspace.
+	MonitoringAddonName = "omsagent"
+
+	// MonitoringAddonWorkspaceKey is the configuration key of the monitoring
+	// addon under which the resource ID of its workspace is set.
+	MonitoringAddonWorkspaceKey = "logAnalyticsWorkspaceResourceID"
+
+	// HTTPApplicationRoutingAddonName is the name of the HTTP application
+	// routing addon.
+	HTTPApplicationRoutingAddonName = "httpApplicationRouting"
+
+	// AzurePolicyAddonName is the name of the Azure Policy addon.
+	AzurePolicyAddonName = "azurepolicy"
+
+	// KubeDashboardAddonName is the name of the Kubernetes dashboard addon.
+	KubeDashboardAddonName = "kubeDashboard"
+
+	// SpotNodeTaint is the taint AKS adds to the nodes of spot node pools.
+	SpotNodeTaint = "kubernetes.azure.com/scalesetpriority=spot:NoSchedule"
+
+	// SpotNodeLabelKey is the key of the label AKS adds to the nodes of spot
+	// node pools.
+	SpotNodeLabelKey = "kubernetes.azure.com/scalesetpriority"
+
 	appCredsValidYears = 5
+
+	provisioningStateSucceeded = "Succeeded"
+	provisioningStateFailed    = "Failed"
+	provisioningStateCanceled  = "Canceled"
+
+	errNoClusterProperties  = "managed cluster has no properties"
+	errPrivateClusterSubnet = "a private cluster requires a vnetSubnetID"
+	errPrivateClusterIPs    = "a private cluster does not support apiServerAuthorizedIPRanges"
+	errUDRSubnet            = "the userDefinedRouting outbound type requires a vnetSubnetID"
+	errUDRLoadBalancer      = "a loadBalancerProfile requires the loadBalancer outbound type"
+	errAvailabilitySetZones = "availability zones require a VirtualMachineScaleSets agent pool"
+	errMonitoringWorkspace  = "the monitoring addon requires a logAnalyticsWorkspaceID"
+	errFmtSpotSettings      = "node pool %s: scaleSetEvictionPolicy and spotMaxPrice require a Spot scaleSetPriority"
+	errFmtUpdateNodePool    = "cannot update node pool %s"
+	errFmtDeleteNodePool    = "cannot delete node pool %s"
+	errNoApplication        = "cannot find the service principal application"
+	errFmtRemoveCredential  = "cannot remove the unused password credential of the service principal application (%s)"
+
+	resourceTypeVirtualMachines = "virtualMachines"
+	capabilityVCPUs             = "vCPUs"
+	usageNameCores              = "cores"
 )
 
 // An AKSClient can create, read, and delete AKS clusters and the various other
 // resources they require.
 type AKSClient interface {
 	GetManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error)
-	EnsureManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error
+	EnsureManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster, s ManagedClusterSecrets) error
+	UpdateManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error
 	DeleteManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error
 	GetKubeConfig(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error)
+	GetUserKubeConfig(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error)
+	GetServicePrincipalSecretExpiry(ctx context.Context, ac *v1alpha3.AKSCluster) (time.Time, error)
+	RotateServicePrincipalSecret(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) (time.Time, error)
+	GetRESTClient() autorest.Sender
+}
+
+// ManagedClusterSecrets are the secret values an AKS cluster is created with,
+// which are read from Kubernetes secrets rather than the spec of the cluster.
+type ManagedClusterSecrets struct {
+	// ServicePrincipal is the secret of the service principal of the
+	// cluster. Clusters that use a managed identity have none.
+	ServicePrincipal string
+
+	// AADServerApp is the secret of the Azure AD server application of the
+	// cluster, if any.
+	AADServerApp string
+
+	// SSHPublicKey is authorized to log in to the Linux nodes of the
+	// cluster, in addition to the keys in its spec.
+	SSHPublicKey string
+
+	// WindowsAdminPassword is the password of the administrator account of
+	// the Windows nodes of the cluster.
+	WindowsAdminPassword string
 }
 
 // An AggregateClient aggregates the various clients used by the AKS controller.
 type AggregateClient struct {
 	ManagedClusters   containerservice.ManagedClustersClient
+	AgentPools        containerservice.AgentPoolsClient
 	Applications      graphrbac.ApplicationsClient
 	ServicePrincipals graphrbac.ServicePrincipalsClient
 	RoleAssignments   authorization.RoleAssignmentsClient
+	ResourceSKUs      compute.ResourceSkusClient
+	Usages            compute.UsageClient
 }
 
 // NewAggregateClient produces the various clients used by the AKS controller.
@@ -74,27 +161,31 @@ func NewAggregateClient(creds map[string]string, auth autorest.Authorizer) (AKSC
 	mcc.Authorizer = auth
 	_ = mcc.AddToUserAgent(azure.UserAgent)
 
+	apc := containerservice.NewAgentPoolsClient(creds[azure.CredentialsKeySubscriptionID])
+	apc.Authorizer = auth
+	_ = apc.AddToUserAgent(azure.UserAgent)
+
 	rac := authorization.NewRoleAssignmentsClient(creds[azure.CredentialsKeySubscriptionID])
 	rac.Authorizer = auth
 	_ = rac.AddToUserAgent(azure.UserAgent)
 
-	cfg, err := adal.NewOAuthConfig(creds[azure.CredentialsKeyActiveDirectoryEndpointURL], creds[azure.CredentialsKeyTenantID])
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot create OAuth configuration")
-	}
+	rsc := compute.NewResourceSkusClient(creds[azure.CredentialsKeySubscriptionID])
+	rsc.Authorizer = auth
+	_ = rsc.AddToUserAgent(azure.UserAgent)
 
-	token, err := adal.NewServicePrincipalToken(*cfg,
-		creds[azure.CredentialsKeyClientID],
-		creds[azure.CredentialsKeyClientSecret],
-		creds[azure.CredentialsKeyActiveDirectoryGraphResourceID])
+	uc := compute.NewUsageClient(creds[azure.CredentialsKeySubscriptionID])
+	uc.Authorizer = auth
+	_ = uc.AddToUserAgent(azure.UserAgent)
+
+	// The Graph API is authorized using the same credential as the Azure
+	// Resource Manager API, but with a different resource. These clients use
+	// the deprecated Azure AD Graph API; they have not yet been migrated to
+	// Microsoft Graph.
+	cred, err := azure.NewTokenCredential(creds)
 	if err != nil {
-		return nil, errors.Wrap(err, "cannot create service principal token")
-	}
-	if err := token.Refresh(); err != nil {
-		return nil, errors.Wrap(err, "cannot refresh service principal token")
+		return nil, err
 	}
-
-	ta := autorest.NewBearerAuthorizer(token)
+	ta := azure.NewBearerAuthorizer(cred, creds[azure.CredentialsKeyActiveDirectoryGraphResourceID])
 
 	ac := graphrbac.NewApplicationsClient(creds[azure.CredentialsKeyTenantID])
 	ac.Authorizer = ta
@@ -106,9 +197,12 @@ func NewAggregateClient(creds map[string]string, auth autorest.Authorizer) (AKSC
 
 	return AggregateClient{
 		ManagedClusters:   mcc,
+		AgentPools:        apc,
 		Applications:      ac,
 		ServicePrincipals: spc,
 		RoleAssignments:   rac,
+		ResourceSKUs:      rsc,
+		Usages:            uc,
 	}, nil
 }
 
@@ -118,32 +212,612 @@ func (c AggregateClient) GetManagedCluster(ctx context.Context, ac *v1alpha3.AKS
 }
 
 // EnsureManagedCluster ensures the supplied AKS cluster exists, including
-// ensuring any required service principals and role assignments exist.
-func (c AggregateClient) EnsureManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error {
-	app, err := c.ensureApplication(ctx, meta.GetExternalName(ac), secret)
+// ensuring any required service principals and role assignments exist, and
+// records the IDs of the application and service principal in its status.
+// Clusters that use a managed identity need no service principal, so the
+// supplied service principal secret is ignored for them.
+func (c AggregateClient) EnsureManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster, s ManagedClusterSecrets) error {
+	// Fail fast, before creating any service principals, if the cluster could
+	// never be created.
+	if err := validateNetwork(ac.Spec.AKSClusterParameters); err != nil {
+		return err
+	}
+	if err := validateAddons(ac.Spec.AKSClusterParameters); err != nil {
+		return err
+	}
+	if err := validateNodePools(ac.Spec.AKSClusterParameters); err != nil {
+		return err
+	}
+	if err := c.validateNodePool(ctx, ac); err != nil {
+		return err
+	}
+
+	if ac.Spec.Identity != nil {
+		_, err := c.ManagedClusters.CreateOrUpdate(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), newManagedCluster(ac, "", s))
+		return err
+	}
+
+	app, err := c.ensureApplication(ctx, meta.GetExternalName(ac), s.ServicePrincipal)
 	if err != nil {
 		return err
 	}
 
+	ac.Status.ApplicationObjectID = to.String(app.ObjectID)
+	ac.Status.ApplicationID = to.String(app.AppID)
+
 	sp, err := c.ensureServicePrincipal(ctx, to.String(app.AppID))
 	if err != nil {
 		return err
 	}
+	ac.Status.ServicePrincipalObjectID = to.String(sp.ObjectID)
 
 	if err := c.ensureRoleAssignment(ctx, to.String(sp.ObjectID), NetworkContributorRoleID, ac.Spec.VnetSubnetID); err != nil {
 		return err
 	}
 
-	mc := newManagedCluster(ac, to.String(app.AppID), secret)
+	mc := newManagedCluster(ac, to.String(app.AppID), s)
 	_, err = c.ManagedClusters.CreateOrUpdate(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), mc)
 	return err
 }
 
+// UpdateManagedCluster starts an in-place upgrade or scale of the supplied AKS
+// cluster to its desired Kubernetes version, node count, tags and addons,
+// and records the started operation in its status. Version upgrades are
+// rolled out in phases: the control plane is upgraded first, then the default node pool,
+// then any other node pools. Node pools are created, updated and deleted once
+// the cluster itself is up to date. Azure runs one operation per cluster at a
+// time, so at most one operation is started per call.
+func (c AggregateClient) UpdateManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error {
+	mc, err := c.ManagedClusters.Get(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac))
+	if err != nil {
+		return err
+	}
+	if mc.ManagedClusterProperties == nil {
+		return errors.New(errNoClusterProperties)
+	}
+
+	p := ac.Spec.AKSClusterParameters
+	switch {
+	case !isClusterUpToDate(p, mc):
+		// The version of the node pools is left as is, so that only the
+		// control plane is upgraded.
+		mc.Tags = azure.ToStringPtrMap(p.Tags)
+		mc.KubernetesVersion = to.StringPtr(p.Version)
+		setAddonProfiles(&mc, p)
+		setAuthorizedIPRanges(&mc, p)
+		setLoadBalancerProfile(&mc, p)
+		if ap := defaultNodePool(mc); ap != nil {
+			ap.NodeTaints = azure.ToStringArrayPtr(p.NodeTaints)
+			ap.NodeLabels = azure.ToStringPtrMap(p.NodeLabels)
+			setAutoScaling(ap, p)
+			if !p.EnableAutoScaling {
+				ap.Count = to.Int32Ptr(desiredNodeCount(p))
+			}
+		}
+	case !isDefaultNodePoolVersionUpToDate(p, mc):
+		defaultNodePool(mc).OrchestratorVersion = to.StringPtr(p.Version)
+	default:
+		return c.updateNodePools(ctx, ac, mc)
+	}
+
+	op, err := c.ManagedClusters.CreateOrUpdate(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), mc)
+	if err != nil {
+		return err
+	}
+	ac.Status.LastOperation = azure.NewAsyncOperation(http.MethodPut, op.FutureAPI)
+	return nil
+}
+
+func (c AggregateClient) updateNodePools(ctx context.Context, ac *v1alpha3.AKSCluster, mc containerservice.ManagedCluster) error {
+	observed := observedNodePools(mc)
+	for _, np := range ac.Spec.NodePools {
+		if o, ok := observed[np.Name]; ok && isNodePoolUpToDate(np, ac.Spec.Version, o) {
+			continue
+		}
+		op, err := c.AgentPools.CreateOrUpdate(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), np.Name, newAgentPool(ac, np))
+		if err != nil {
+			return errors.Wrapf(err, errFmtUpdateNodePool, np.Name)
+		}
+		ac.Status.LastOperation = azure.NewAsyncOperation(http.MethodPut, op.FutureAPI)
+		return nil
+	}
+
+	desired := map[string]bool{}
+	for _, np := range ac.Spec.NodePools {
+		desired[np.Name] = true
+	}
+	for name := range observed {
+		if desired[name] {
+			continue
+		}
+		op, err := c.AgentPools.Delete(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), name)
+		if err != nil {
+			return errors.Wrapf(err, errFmtDeleteNodePool, name)
+		}
+		ac.Status.LastOperation = azure.NewAsyncOperation(http.MethodDelete, op.FutureAPI)
+		return nil
+	}
+	return nil
+}
+
+// GetServicePrincipalSecretExpiry returns when the latest secret of the
+// service principal of the supplied AKS cluster expires. It returns the zero
+// time if the cluster has no service principal application.
+func (c AggregateClient) GetServicePrincipalSecretExpiry(ctx context.Context, ac *v1alpha3.AKSCluster) (time.Time, error) {
+	app, err := c.getApplication(ctx, meta.GetExternalName(ac))
+	if err != nil || app == nil {
+		return time.Time{}, err
+	}
+	pcs, err := c.Applications.ListPasswordCredentials(ctx, to.String(app.ObjectID))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return latestExpiry(pcs.Value), nil
+}
+
+// RotateServicePrincipalSecret adds the supplied secret to the service
+// principal application of the supplied AKS cluster, starts an update of the
+// cluster's service principal profile to use it, and records the started
+// operation in its status. Secrets that have not yet expired are kept, so that
+// the cluster may keep using its current secret until the update completes.
+// It returns when the new secret expires.
+func (c AggregateClient) RotateServicePrincipalSecret(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) (time.Time, error) {
+	app, err := c.getApplication(ctx, meta.GetExternalName(ac))
+	if err != nil {
+		return time.Time{}, err
+	}
+	if app == nil {
+		return time.Time{}, errors.New(errNoApplication)
+	}
+	pcs, err := c.Applications.ListPasswordCredentials(ctx, to.String(app.ObjectID))
+	if err != nil {
+		return time.Time{}, err
+	}
+	pc, err := newPasswordCredential(secret)
+	if err != nil {
+		return time.Time{}, err
+	}
+	p := graphrbac.PasswordCredentialsUpdateParameters{Value: unexpiredPasswordCredentials(pcs.Value, pc, time.Now())}
+	if _, err := c.Applications.UpdatePasswordCredentials(ctx, to.String(app.ObjectID), p); err != nil {
+		return time.Time{}, err
+	}
+
+	sp := containerservice.ManagedClusterServicePrincipalProfile{ClientID: app.AppID, Secret: to.StringPtr(secret)}
+	op, err := c.ManagedClusters.ResetServicePrincipalProfile(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), sp)
+	if err != nil {
+		// The cluster does not use the new password, which is never published,
+		// so we remove it rather than leave another valid credential behind
+		// each time the rotation is retried.
+		p.Value = withoutPasswordCredential(p.Value, to.String(pc.KeyID))
+		if _, rerr := c.Applications.UpdatePasswordCredentials(ctx, to.String(app.ObjectID), p); rerr != nil {
+			return time.Time{}, errors.Wrapf(err, errFmtRemoveCredential, rerr)
+		}
+		return time.Time{}, err
+	}
+	ac.Status.LastOperation = azure.NewAsyncOperation(http.MethodPost, op.FutureAPI)
+	return pc.EndDate.Time, nil
+}
+
+// GetRESTClient returns the underlying REST client that the client object uses.
+func (c AggregateClient) GetRESTClient() autorest.Sender {
+	return c.ManagedClusters.Client
+}
+
+// LateInitialize fills the empty fields of the supplied parameters with the
+// values Azure chose for the supplied cluster, e.g. its network ranges. A
+// Kubernetes version that omits its patch version, e.g. 1.22, is replaced by
+// the patch version the cluster actually runs, e.g. 1.22.6, so that the
+// cluster is not considered to need an upgrade.
+func LateInitialize(p *v1alpha3.AKSClusterParameters, mc containerservice.ManagedCluster) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, mc.Tags)
+	if mc.Sku != nil && p.SKUTier == "" {
+		p.SKUTier = string(mc.Sku.Tier)
+	}
+	if mc.ManagedClusterProperties == nil {
+		return
+	}
+	if v := to.String(mc.KubernetesVersion); strings.HasPrefix(v, p.Version+".") && strings.Count(p.Version, ".") == 1 {
+		p.Version = v
+	}
+	if p.DNSNamePrefix == "" {
+		p.DNSNamePrefix = to.String(mc.DNSPrefix)
+	}
+	if p.NodeResourceGroup == "" {
+		p.NodeResourceGroup = to.String(mc.NodeResourceGroup)
+	}
+	if ap := defaultNodePool(mc); ap != nil {
+		if p.NodeVMSize == "" {
+			p.NodeVMSize = to.String(ap.VMSize)
+		}
+		p.NodeCount = azure.LateInitializeIntPtrFromInt32Ptr(p.NodeCount, ap.Count)
+		if p.AgentPoolType == "" {
+			p.AgentPoolType = string(ap.Type)
+		}
+		p.NodeOSDiskSizeGB = azure.LateInitializeIntPtrFromInt32Ptr(p.NodeOSDiskSizeGB, ap.OsDiskSizeGB)
+		p.NodeMaxPods = azure.LateInitializeIntPtrFromInt32Ptr(p.NodeMaxPods, ap.MaxPods)
+	}
+	if np := mc.NetworkProfile; np != nil {
+		if p.NetworkPlugin == "" {
+			p.NetworkPlugin = string(np.NetworkPlugin)
+		}
+		if p.NetworkPolicy == "" {
+			p.NetworkPolicy = string(np.NetworkPolicy)
+		}
+		if p.PodCIDR == "" {
+			p.PodCIDR = to.String(np.PodCidr)
+		}
+		if p.ServiceCIDR == "" {
+			p.ServiceCIDR = to.String(np.ServiceCidr)
+		}
+		if p.DNSServiceIP == "" {
+			p.DNSServiceIP = to.String(np.DNSServiceIP)
+		}
+		if p.DockerBridgeCIDR == "" {
+			p.DockerBridgeCIDR = to.String(np.DockerBridgeCidr)
+		}
+		if p.OutboundType == "" {
+			p.OutboundType = string(np.OutboundType)
+		}
+	}
+}
+
+// IsUpToDate returns true if the supplied AKS cluster runs the desired
+// Kubernetes version and node count, and has the desired tags and node pools.
+func IsUpToDate(p v1alpha3.AKSClusterParameters, mc containerservice.ManagedCluster) bool {
+	if !isClusterUpToDate(p, mc) || !isDefaultNodePoolVersionUpToDate(p, mc) {
+		return false
+	}
+	observed := observedNodePools(mc)
+	if len(observed) != len(p.NodePools) {
+		return false
+	}
+	for _, np := range p.NodePools {
+		if o, ok := observed[np.Name]; !ok || !isNodePoolUpToDate(np, p.Version, o) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsControlPlaneUpgraded returns true if the control plane of the supplied
+// AKS cluster runs the desired Kubernetes version.
+func IsControlPlaneUpgraded(p v1alpha3.AKSClusterParameters, mc containerservice.ManagedCluster) bool {
+	return mc.ManagedClusterProperties == nil || p.Version == to.String(mc.KubernetesVersion)
+}
+
+// ResumeAsyncOperation re-derives the status of the supplied operation from
+// the provisioning state of the supplied AKS cluster. It is meant for
+// operations that can no longer be polled, e.g. because their polling URL
+// expired or could not be parsed after the controller restarted. Azure runs
+// one operation per cluster at a time, so the operation is in progress for as
+// long as the cluster is provisioning. Once the cluster stops provisioning the
+// operation is considered finished and is no longer polled.
+func ResumeAsyncOperation(op *azurev1alpha3.AsyncOperation, mc containerservice.ManagedCluster) {
+	if op.Method == "" && op.PollingURL == "" {
+		return
+	}
+	var state string
+	if mc.ManagedClusterProperties != nil {
+		state = to.String(mc.ProvisioningState)
+	}
+	switch state {
+	case provisioningStateSucceeded, provisioningStateFailed, provisioningStateCanceled:
+		op.Status = state
+		op.PollingURL = ""
+	default:
+		op.Status = azure.AsyncOperationStatusInProgress
+	}
+}
+
+// AreNodesUpgraded returns true if the default node pool and the node pools
+// declared inline of the supplied AKS cluster run the desired Kubernetes
+// version.
+func AreNodesUpgraded(p v1alpha3.AKSClusterParameters, mc containerservice.ManagedCluster) bool {
+	if !isDefaultNodePoolVersionUpToDate(p, mc) {
+		return false
+	}
+	for _, np := range observedNodePools(mc) {
+		if !isVersionUpToDate(p.Version, np.OrchestratorVersion) {
+			return false
+		}
+	}
+	return true
+}
+
+func isClusterUpToDate(p v1alpha3.AKSClusterParameters, mc containerservice.ManagedCluster) bool {
+	if !cmp.Equal(p.Tags, azure.ToStringMap(mc.Tags), cmpopts.EquateEmpty()) {
+		return false
+	}
+	if !IsControlPlaneUpgraded(p, mc) {
+		return false
+	}
+	if ap := defaultNodePool(mc); ap != nil && !isDefaultNodePoolUpToDate(p, *ap) {
+		return false
+	}
+	if !areAuthorizedIPRangesUpToDate(p, mc) || !isLoadBalancerProfileUpToDate(p.LoadBalancerProfile, mc) {
+		return false
+	}
+	return areAddonsUpToDate(p, mc)
+}
+
+// isLoadBalancerProfileUpToDate returns true if the load balancer of the
+// supplied cluster has the desired outbound configuration. Settings that are
+// not desired are left to Azure.
+func isLoadBalancerProfileUpToDate(lb *v1alpha3.AKSClusterLoadBalancerProfile, mc containerservice.ManagedCluster) bool {
+	if lb == nil {
+		return true
+	}
+	observed := &containerservice.ManagedClusterLoadBalancerProfile{}
+	if mc.NetworkProfile != nil && mc.NetworkProfile.LoadBalancerProfile != nil {
+		observed = mc.NetworkProfile.LoadBalancerProfile
+	}
+	if lb.ManagedOutboundIPCount != nil {
+		if observed.ManagedOutboundIPs == nil || to.Int32(observed.ManagedOutboundIPs.Count) != int32(*lb.ManagedOutboundIPCount) {
+			return false
+		}
+	}
+	if lb.IdleTimeoutInMinutes != nil && to.Int32(observed.IdleTimeoutInMinutes) != int32(*lb.IdleTimeoutInMinutes) {
+		return false
+	}
+	return true
+}
+
+// setLoadBalancerProfile applies the desired outbound configuration of the
+// supplied parameters to the load balancer profile of the supplied cluster.
+func setLoadBalancerProfile(mc *containerservice.ManagedCluster, p v1alpha3.AKSClusterParameters) {
+	if p.LoadBalancerProfile == nil {
+		return
+	}
+	if mc.NetworkProfile == nil {
+		mc.NetworkProfile = &containerservice.NetworkProfile{}
+	}
+	if mc.NetworkProfile.LoadBalancerProfile == nil {
+		mc.NetworkProfile.LoadBalancerProfile = &containerservice.ManagedClusterLoadBalancerProfile{}
+	}
+	lb := mc.NetworkProfile.LoadBalancerProfile
+	if p.LoadBalancerProfile.ManagedOutboundIPCount != nil {
+		lb.ManagedOutboundIPs = &containerservice.ManagedClusterLoadBalancerProfileManagedOutboundIPs{
+			Count: azure.ToInt32(p.LoadBalancerProfile.ManagedOutboundIPCount),
+		}
+		// Managed IPs replace any IPs or prefixes that were supplied.
+		lb.OutboundIPs = nil
+		lb.OutboundIPPrefixes = nil
+	}
+	if p.LoadBalancerProfile.IdleTimeoutInMinutes != nil {
+		lb.IdleTimeoutInMinutes = azure.ToInt32(p.LoadBalancerProfile.IdleTimeoutInMinutes)
+	}
+}
+
+// newLoadBalancerProfile returns the load balancer profile described by the
+// supplied parameters, or nil if none is.
+func newLoadBalancerProfile(lb *v1alpha3.AKSClusterLoadBalancerProfile) *containerservice.ManagedClusterLoadBalancerProfile {
+	if lb == nil {
+		return nil
+	}
+	out := &containerservice.ManagedClusterLoadBalancerProfile{
+		IdleTimeoutInMinutes: azure.ToInt32(lb.IdleTimeoutInMinutes),
+	}
+	if lb.ManagedOutboundIPCount != nil {
+		out.ManagedOutboundIPs = &containerservice.ManagedClusterLoadBalancerProfileManagedOutboundIPs{
+			Count: azure.ToInt32(lb.ManagedOutboundIPCount),
+		}
+	}
+	return out
+}
+
+// areAuthorizedIPRangesUpToDate returns true if the API server of the
+// supplied cluster is restricted to the desired IP ranges, in any order.
+func areAuthorizedIPRangesUpToDate(p v1alpha3.AKSClusterParameters, mc containerservice.ManagedCluster) bool {
+	var observed []string
+	if mc.APIServerAccessProfile != nil {
+		observed = azure.ToStringArray(mc.APIServerAccessProfile.AuthorizedIPRanges)
+	}
+	return cmp.Equal(p.APIServerAuthorizedIPRanges, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+	)
+}
+
+// setAuthorizedIPRanges restricts the API server of the supplied cluster to
+// the IP ranges of the supplied parameters. An empty list is sent rather than
+// none when no ranges are desired, so that any existing ranges are removed.
+func setAuthorizedIPRanges(mc *containerservice.ManagedCluster, p v1alpha3.AKSClusterParameters) {
+	if mc.APIServerAccessProfile == nil {
+		if len(p.APIServerAuthorizedIPRanges) == 0 {
+			return
+		}
+		mc.APIServerAccessProfile = &containerservice.ManagedClusterAPIServerAccessProfile{}
+	}
+	ranges := make([]string, len(p.APIServerAuthorizedIPRanges))
+	copy(ranges, p.APIServerAuthorizedIPRanges)
+	mc.APIServerAccessProfile.AuthorizedIPRanges = &ranges
+}
+
+// isMonitoringEnabled returns true if the supplied parameters desire the
+// monitoring addon. It is enabled whenever a workspace is supplied, unless
+// the addon profiles explicitly disable it.
+func isMonitoringEnabled(p v1alpha3.AKSClusterParameters) bool {
+	if p.LogAnalyticsWorkspaceID == "" {
+		return false
+	}
+	return p.AddonProfiles == nil || p.AddonProfiles.Monitoring == nil || p.AddonProfiles.Monitoring.Enabled
+}
+
+// desiredAddons returns the desired addon profiles of the supplied
+// parameters, other than the monitoring addon, keyed by their Azure name.
+// Addons that are not specified are omitted.
+func desiredAddons(p v1alpha3.AKSClusterParameters) map[string]v1alpha3.AKSClusterAddonProfile {
+	out := map[string]v1alpha3.AKSClusterAddonProfile{}
+	if p.AddonProfiles == nil {
+		return out
+	}
+	for name, ap := range map[string]*v1alpha3.AKSClusterAddonProfile{
+		HTTPApplicationRoutingAddonName: p.AddonProfiles.HTTPApplicationRouting,
+		AzurePolicyAddonName:            p.AddonProfiles.AzurePolicy,
+		KubeDashboardAddonName:          p.AddonProfiles.KubeDashboard,
+	} {
+		if ap != nil {
+			out[name] = *ap
+		}
+	}
+	return out
+}
+
+// areAddonsUpToDate returns true if the monitoring addon of the supplied
+// cluster is enabled and sends to the desired workspace, or is not enabled if
+// it is not desired, and every specified addon is enabled or disabled as
+// desired.
+func areAddonsUpToDate(p v1alpha3.AKSClusterParameters, mc containerservice.ManagedCluster) bool {
+	var observed map[string]*containerservice.ManagedClusterAddonProfile
+	if mc.ManagedClusterProperties != nil {
+		observed = mc.AddonProfiles
+	}
+	workspace := ""
+	if ap, ok := observed[MonitoringAddonName]; ok && ap != nil && to.Bool(ap.Enabled) {
+		workspace = to.String(ap.Config[MonitoringAddonWorkspaceKey])
+	}
+	want := ""
+	if isMonitoringEnabled(p) {
+		want = p.LogAnalyticsWorkspaceID
+	}
+	// Azure does not preserve the case of resource IDs.
+	if !strings.EqualFold(want, workspace) {
+		return false
+	}
+	for name, d := range desiredAddons(p) {
+		ap := observed[name]
+		if d.Enabled != (ap != nil && to.Bool(ap.Enabled)) {
+			return false
+		}
+	}
+	return true
+}
+
+// setAddonProfiles sets the desired addon profiles on the supplied cluster.
+// The monitoring addon is enabled if a workspace is desired, and disabled if
+// it was enabled but is not desired anymore. Other addons are only changed
+// if they are specified.
+func setAddonProfiles(mc *containerservice.ManagedCluster, p v1alpha3.AKSClusterParameters) {
+	if mc.AddonProfiles == nil {
+		mc.AddonProfiles = map[string]*containerservice.ManagedClusterAddonProfile{}
+	}
+	switch ap, ok := mc.AddonProfiles[MonitoringAddonName]; {
+	case isMonitoringEnabled(p):
+		mc.AddonProfiles[MonitoringAddonName] = &containerservice.ManagedClusterAddonProfile{
+			Enabled: to.BoolPtr(true),
+			Config:  map[string]*string{MonitoringAddonWorkspaceKey: to.StringPtr(p.LogAnalyticsWorkspaceID)},
+		}
+	case ok && ap != nil:
+		ap.Enabled = to.BoolPtr(false)
+		ap.Config = nil
+	}
+	for name, d := range desiredAddons(p) {
+		if ap, ok := mc.AddonProfiles[name]; ok && ap != nil {
+			ap.Enabled = to.BoolPtr(d.Enabled)
+			continue
+		}
+		mc.AddonProfiles[name] = &containerservice.ManagedClusterAddonProfile{Enabled: to.BoolPtr(d.Enabled)}
+	}
+	if len(mc.AddonProfiles) == 0 {
+		mc.AddonProfiles = nil
+	}
+}
+
+func isDefaultNodePoolVersionUpToDate(p v1alpha3.AKSClusterParameters, mc containerservice.ManagedCluster) bool {
+	ap := defaultNodePool(mc)
+	return ap == nil || isVersionUpToDate(p.Version, ap.OrchestratorVersion)
+}
+
+// isVersionUpToDate returns true if the supplied node pool version is the
+// desired one. A node pool without a version runs the version of the control
+// plane.
+func isVersionUpToDate(want string, got *string) bool {
+	return got == nil || to.String(got) == want
+}
+
+// defaultNodePool returns the default agent pool profile of the supplied
+// cluster, or nil if it has none.
+func defaultNodePool(mc containerservice.ManagedCluster) *containerservice.ManagedClusterAgentPoolProfile {
+	if mc.ManagedClusterProperties == nil || mc.AgentPoolProfiles == nil {
+		return nil
+	}
+	for i := range *mc.AgentPoolProfiles {
+		if to.String((*mc.AgentPoolProfiles)[i].Name) == AgentPoolProfileName {
+			return &(*mc.AgentPoolProfiles)[i]
+		}
+	}
+	return nil
+}
+
+// isDefaultNodePoolUpToDate returns true if the supplied default agent pool
+// profile has the desired node taints and labels and autoscaler
+// configuration, and the desired node count unless the autoscaler owns it.
+func isDefaultNodePoolUpToDate(p v1alpha3.AKSClusterParameters, ap containerservice.ManagedClusterAgentPoolProfile) bool {
+	if !cmp.Equal(p.NodeTaints, azure.ToStringArray(ap.NodeTaints), cmpopts.EquateEmpty()) ||
+		!cmp.Equal(p.NodeLabels, azure.ToStringMap(ap.NodeLabels), cmpopts.EquateEmpty()) {
+		return false
+	}
+	if to.Bool(ap.EnableAutoScaling) != p.EnableAutoScaling {
+		return false
+	}
+	if p.EnableAutoScaling {
+		return cmp.Equal(azure.ToInt32(p.MinCount), ap.MinCount) && cmp.Equal(azure.ToInt32(p.MaxCount), ap.MaxCount)
+	}
+	return to.Int32(ap.Count) == desiredNodeCount(p)
+}
+
+// observedNodePools returns the agent pool profiles of the supplied cluster
+// that were declared inline, by name.
+func observedNodePools(mc containerservice.ManagedCluster) map[string]containerservice.ManagedClusterAgentPoolProfile {
+	pools := map[string]containerservice.ManagedClusterAgentPoolProfile{}
+	if mc.ManagedClusterProperties == nil || mc.AgentPoolProfiles == nil {
+		return pools
+	}
+	for _, p := range *mc.AgentPoolProfiles {
+		if _, ok := p.Tags[TagKeyInlineNodePool]; ok {
+			pools[to.String(p.Name)] = p
+		}
+	}
+	return pools
+}
+
+// isNodePoolUpToDate compares the mutable fields of the supplied node pool.
+// The taint and label AKS adds to spot node pools are ignored.
+func isNodePoolUpToDate(np v1alpha3.AKSClusterNodePool, version string, p containerservice.ManagedClusterAgentPoolProfile) bool {
+	return to.Int32(p.Count) == nodePoolCount(np.Count) &&
+		isVersionUpToDate(version, p.OrchestratorVersion) &&
+		cmp.Equal(withoutSpotTaint(np.Taints), withoutSpotTaint(azure.ToStringArray(p.NodeTaints)), cmpopts.EquateEmpty()) &&
+		cmp.Equal(withoutSpotLabel(np.Labels), withoutSpotLabel(azure.ToStringMap(p.NodeLabels)), cmpopts.EquateEmpty())
+}
+
+func withoutSpotTaint(taints []string) []string {
+	out := make([]string, 0, len(taints))
+	for _, t := range taints {
+		if t != SpotNodeTaint {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+func withoutSpotLabel(labels map[string]string) map[string]string {
+	out := make(map[string]string, len(labels))
+	for k, v := range labels {
+		if k != SpotNodeLabelKey {
+			out[k] = v
+		}
+	}
+	return out
+}
+
 // DeleteManagedCluster deletes the supplied AKS cluster, including its service
-// principals and any role assignments.
+// principals and any role assignments unless its service principal deletion
+// policy orphans them.
 func (c AggregateClient) DeleteManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error {
-	if err := c.deleteApplication(ctx, meta.GetExternalName(ac)); err != nil {
-		return err
+	if ac.Spec.Identity == nil && ac.Spec.ServicePrincipalDeletionPolicy != xpv1.DeletionOrphan {
+		if err := c.deleteApplication(ctx, ac); err != nil {
+			return err
+		}
 	}
 	_, err := c.ManagedClusters.Delete(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac))
 	return err
@@ -152,11 +826,24 @@ func (c AggregateClient) DeleteManagedCluster(ctx context.Context, ac *v1alpha3.
 // GetKubeConfig produces a kubeconfig file that configures access to the
 // supplied AKS cluster.
 func (c AggregateClient) GetKubeConfig(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error) {
-	creds, err := c.ManagedClusters.ListClusterAdminCredentials(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac))
+	creds, err := c.ManagedClusters.ListClusterAdminCredentials(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), "")
 	if err != nil {
 		return nil, err
 	}
+	return kubeconfig(creds)
+}
 
+// GetUserKubeConfig produces a kubeconfig file that configures access to the
+// supplied AKS cluster as an Azure AD user, who is prompted to authenticate.
+func (c AggregateClient) GetUserKubeConfig(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error) {
+	creds, err := c.ManagedClusters.ListClusterUserCredentials(ctx, ac.Spec.ResourceGroupName, meta.GetExternalName(ac), "")
+	if err != nil {
+		return nil, err
+	}
+	return kubeconfig(creds)
+}
+
+func kubeconfig(creds containerservice.CredentialResults) ([]byte, error) {
 	// TODO(negz): It's not clear in what case this would contain more than one kubeconfig file.
 	// https://docs.microsoft.com/en-us/rest/api/aks/managedclusters/listclusteradmincredentials#credentialresults
 	if creds.Kubeconfigs == nil || len(*creds.Kubeconfigs) == 0 || (*creds.Kubeconfigs)[0].Value == nil {
@@ -168,6 +855,223 @@ func (c AggregateClient) GetKubeConfig(ctx context.Context, ac *v1alpha3.AKSClus
 	return *((*creds.Kubeconfigs)[0].Value), nil
 }
 
+func (c AggregateClient) validateNodePool(ctx context.Context, ac *v1alpha3.AKSCluster) error {
+	skus := []compute.ResourceSku{}
+	l, err := c.ResourceSKUs.ListComplete(ctx, fmt.Sprintf("location eq '%s'", ac.Spec.Location), "")
+	for ; err == nil && l.NotDone(); err = l.NextWithContext(ctx) {
+		skus = append(skus, l.Value())
+	}
+	if err != nil {
+		return errors.Wrap(err, "cannot list resource SKUs")
+	}
+	sku, err := ValidateVMSize(skus, ac.Spec.Location, ac.Spec.NodeVMSize)
+	if err != nil {
+		return err
+	}
+	if err := ValidateZones(sku, ac.Spec.Location, ac.Spec.Zones); err != nil {
+		return err
+	}
+	for _, np := range ac.Spec.NodePools {
+		npSKU, err := ValidateVMSize(skus, ac.Spec.Location, np.VMSize)
+		if err != nil {
+			return err
+		}
+		if err := ValidateZones(npSKU, ac.Spec.Location, np.Zones); err != nil {
+			return err
+		}
+	}
+
+	usages := []compute.Usage{}
+	u, err := c.Usages.ListComplete(ctx, ac.Spec.Location)
+	for ; err == nil && u.NotDone(); err = u.NextWithContext(ctx) {
+		usages = append(usages, u.Value())
+	}
+	if err != nil {
+		return errors.Wrap(err, "cannot list compute usages")
+	}
+	count := v1alpha3.DefaultNodeCount
+	if ac.Spec.NodeCount != nil {
+		count = *ac.Spec.NodeCount
+	}
+	return CheckQuota(usages, sku, count)
+}
+
+// validateNetwork returns an error if the network or zone configuration of
+// the supplied parameters is incomplete or inconsistent.
+func validateNetwork(p v1alpha3.AKSClusterParameters) error {
+	if p.PrivateCluster && p.VnetSubnetID == "" {
+		return errors.New(errPrivateClusterSubnet)
+	}
+	if p.PrivateCluster && len(p.APIServerAuthorizedIPRanges) > 0 {
+		return errors.New(errPrivateClusterIPs)
+	}
+	if containerservice.OutboundType(p.OutboundType) == containerservice.OutboundTypeUserDefinedRouting {
+		if p.VnetSubnetID == "" {
+			return errors.New(errUDRSubnet)
+		}
+		if p.LoadBalancerProfile != nil {
+			return errors.New(errUDRLoadBalancer)
+		}
+	}
+	if len(p.Zones) > 0 && agentPoolType(p.AgentPoolType) != containerservice.AgentPoolTypeVirtualMachineScaleSets {
+		return zoneUnavailableError{errors.New(errAvailabilitySetZones)}
+	}
+	return nil
+}
+
+// validateAddons returns an error if the addon configuration of the supplied
+// parameters is inconsistent.
+func validateAddons(p v1alpha3.AKSClusterParameters) error {
+	if p.AddonProfiles != nil && p.AddonProfiles.Monitoring != nil && p.AddonProfiles.Monitoring.Enabled && p.LogAnalyticsWorkspaceID == "" {
+		return errors.New(errMonitoringWorkspace)
+	}
+	return nil
+}
+
+// validateNodePools returns an error if the spot configuration of any node
+// pool of the supplied parameters is inconsistent.
+func validateNodePools(p v1alpha3.AKSClusterParameters) error {
+	for _, np := range p.NodePools {
+		if containerservice.ScaleSetPriority(np.ScaleSetPriority) == containerservice.ScaleSetPrioritySpot {
+			continue
+		}
+		if np.ScaleSetEvictionPolicy != "" || np.SpotMaxPrice != nil {
+			return errors.Errorf(errFmtSpotSettings, np.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateVMSize returns the SKU of the supplied VM size, or an error if that
+// size is not available to the subscription in the supplied location,
+// according to the supplied resource SKUs of that location.
+func ValidateVMSize(skus []compute.ResourceSku, location, size string) (compute.ResourceSku, error) {
+	if len(skus) == 0 {
+		return compute.ResourceSku{}, errors.Errorf("location %s is not available to the subscription", location)
+	}
+	for _, sku := range skus {
+		if to.String(sku.ResourceType) != resourceTypeVirtualMachines || !strings.EqualFold(to.String(sku.Name), size) {
+			continue
+		}
+		if !containsLocation(to.StringSlice(sku.Locations), location) {
+			continue
+		}
+		if sku.Restrictions == nil {
+			return sku, nil
+		}
+		for _, r := range *sku.Restrictions {
+			if r.Type == compute.ResourceSkuRestrictionsTypeLocation && containsLocation(to.StringSlice(r.Values), location) {
+				return compute.ResourceSku{}, errors.Errorf("VM size %s is not available to the subscription in location %s: %s", size, location, r.ReasonCode)
+			}
+		}
+		return sku, nil
+	}
+	return compute.ResourceSku{}, errors.Errorf("VM size %s is not offered in location %s", size, location)
+}
+
+// ValidateZones returns an error if the supplied VM SKU is not available in
+// each of the supplied availability zones of the supplied location.
+func ValidateZones(sku compute.ResourceSku, location string, zones []string) error {
+	if len(zones) == 0 {
+		return nil
+	}
+	available := map[string]bool{}
+	if sku.LocationInfo != nil {
+		for _, li := range *sku.LocationInfo {
+			if !containsLocation([]string{to.String(li.Location)}, location) {
+				continue
+			}
+			for _, z := range to.StringSlice(li.Zones) {
+				available[z] = true
+			}
+		}
+	}
+	if sku.Restrictions != nil {
+		for _, r := range *sku.Restrictions {
+			if r.Type != compute.ResourceSkuRestrictionsTypeZone || r.RestrictionInfo == nil || !containsLocation(to.StringSlice(r.RestrictionInfo.Locations), location) {
+				continue
+			}
+			for _, z := range to.StringSlice(r.RestrictionInfo.Zones) {
+				delete(available, z)
+			}
+		}
+	}
+	if len(available) == 0 {
+		return zoneUnavailableError{errors.Errorf("VM size %s does not support availability zones in location %s", to.String(sku.Name), location)}
+	}
+	for _, z := range zones {
+		if !available[z] {
+			return zoneUnavailableError{errors.Errorf("VM size %s is not available in zone %s of location %s", to.String(sku.Name), z, location)}
+		}
+	}
+	return nil
+}
+
+type zoneUnavailableError struct{ error }
+
+// Terminal indicates the cluster will not be created until its zones are
+// changed.
+func (zoneUnavailableError) Terminal() bool { return true }
+
+// IsZoneUnavailable returns true if the supplied error indicates a resource
+// requests availability zones that are not supported.
+func IsZoneUnavailable(err error) bool {
+	return errors.As(err, &zoneUnavailableError{})
+}
+
+type quotaExceededError struct{ error }
+
+// Terminal indicates the cluster will not be created until its node count or
+// VM size, or the quota of its subscription, is changed.
+func (quotaExceededError) Terminal() bool { return true }
+
+// IsQuotaExceeded returns true if the supplied error indicates the
+// subscription does not have enough quota left to create a resource.
+func IsQuotaExceeded(err error) bool {
+	return errors.As(err, &quotaExceededError{})
+}
+
+// CheckQuota returns an error satisfying IsQuotaExceeded if the supplied
+// usages do not leave enough vCPUs, either of the family of the supplied VM
+// size or in total, to create count VMs of that size.
+func CheckQuota(usages []compute.Usage, sku compute.ResourceSku, count int) error {
+	vcpus := 0
+	if sku.Capabilities != nil {
+		for _, c := range *sku.Capabilities {
+			if to.String(c.Name) == capabilityVCPUs {
+				vcpus, _ = strconv.Atoi(to.String(c.Value))
+			}
+		}
+	}
+	required := int64(vcpus * count)
+	for _, u := range usages {
+		if u.Name == nil || u.Limit == nil || u.CurrentValue == nil {
+			continue
+		}
+		name := to.String(u.Name.Value)
+		if !strings.EqualFold(name, to.String(sku.Family)) && !strings.EqualFold(name, usageNameCores) {
+			continue
+		}
+		if available := *u.Limit - int64(*u.CurrentValue); available < required {
+			return quotaExceededError{errors.Errorf("%d VMs of size %s require %d vCPUs but only %d of the %d %s quota are available",
+				count, to.String(sku.Name), required, available, *u.Limit, to.String(u.Name.LocalizedValue))}
+		}
+	}
+	return nil
+}
+
+// Azure location names are case insensitive, and are sometimes reported using
+// their display name, e.g. "West US 2" rather than "westus2".
+func containsLocation(locations []string, location string) bool {
+	normalize := func(l string) string { return strings.ToLower(strings.ReplaceAll(l, " ", "")) }
+	for _, l := range locations {
+		if normalize(l) == normalize(location) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c AggregateClient) ensureApplication(ctx context.Context, name, secret string) (graphrbac.Application, error) {
 	pc, err := newPasswordCredential(secret)
 	if err != nil {
@@ -249,8 +1153,31 @@ func (c AggregateClient) ensureRoleAssignment(ctx context.Context, principalID,
 	return err
 }
 
-func (c AggregateClient) deleteApplication(ctx context.Context, name string) error {
+// getApplication returns the application with the supplied display name, or
+// nil if there is none.
+func (c AggregateClient) getApplication(ctx context.Context, name string) (*graphrbac.Application, error) {
 	filter := fmt.Sprintf("displayName eq '%s'", name)
+	for l, err := c.Applications.ListComplete(ctx, filter); l.NotDone(); err = l.NextWithContext(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		app := l.Value()
+		return &app, nil // nolint:staticcheck
+	}
+	return nil, nil
+}
+
+// deleteApplication deletes the application of the supplied AKS cluster, and
+// with it its service principal. The application is looked up by its display
+// name if its object ID was never recorded, e.g. for clusters that were
+// created before it was.
+func (c AggregateClient) deleteApplication(ctx context.Context, ac *v1alpha3.AKSCluster) error {
+	if id := ac.Status.ApplicationObjectID; id != "" {
+		_, err := c.Applications.Delete(ctx, id)
+		return resource.Ignore(azure.IsNotFound, err)
+	}
+
+	filter := fmt.Sprintf("displayName eq '%s'", meta.GetExternalName(ac))
 	for l, err := c.Applications.ListComplete(ctx, filter); l.NotDone(); err = l.NextWithContext(ctx) {
 		if err != nil {
 			return err
@@ -264,48 +1191,311 @@ func (c AggregateClient) deleteApplication(ctx context.Context, name string) err
 	return nil
 }
 
-func newManagedCluster(c *v1alpha3.AKSCluster, appID, secret string) containerservice.ManagedCluster {
-	nodeCount := int32(v1alpha3.DefaultNodeCount)
-	if c.Spec.NodeCount != nil {
-		nodeCount = int32(*c.Spec.NodeCount)
+// setAutoScaling configures the autoscaler of the supplied default agent
+// pool profile. Azure rejects node count bounds when the autoscaler is
+// disabled, so they are cleared in that case.
+func setAutoScaling(ap *containerservice.ManagedClusterAgentPoolProfile, p v1alpha3.AKSClusterParameters) {
+	ap.EnableAutoScaling = to.BoolPtr(p.EnableAutoScaling)
+	ap.MinCount = nil
+	ap.MaxCount = nil
+	if p.EnableAutoScaling {
+		ap.MinCount = azure.ToInt32(p.MinCount)
+		ap.MaxCount = azure.ToInt32(p.MaxCount)
+	}
+}
+
+func desiredNodeCount(p v1alpha3.AKSClusterParameters) int32 {
+	if p.NodeCount == nil {
+		return int32(v1alpha3.DefaultNodeCount)
+	}
+	return int32(*p.NodeCount)
+}
+
+func nodePoolCount(count *int) int32 {
+	if count == nil {
+		return int32(v1alpha3.DefaultNodeCount)
 	}
+	return int32(*count)
+}
+
+func inlineNodePoolTags() map[string]*string {
+	return map[string]*string{TagKeyInlineNodePool: to.StringPtr("true")}
+}
+
+func agentPoolType(t string) containerservice.AgentPoolType {
+	if t == "" {
+		return containerservice.AgentPoolTypeVirtualMachineScaleSets
+	}
+	return containerservice.AgentPoolType(t)
+}
+
+func osType(t string) containerservice.OSType {
+	if t == "" {
+		return containerservice.OSTypeLinux
+	}
+	return containerservice.OSType(t)
+}
+
+// spotMaxPrice returns the supplied price as a float, or nil if it is unset.
+func spotMaxPrice(q *k8sresource.Quantity) *float64 {
+	if q == nil {
+		return nil
+	}
+	f := q.AsApproximateFloat64()
+	return &f
+}
+
+func newAgentPool(c *v1alpha3.AKSCluster, np v1alpha3.AKSClusterNodePool) containerservice.AgentPool {
+	return containerservice.AgentPool{
+		ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+			Count:               to.Int32Ptr(nodePoolCount(np.Count)),
+			VMSize:              to.StringPtr(np.VMSize),
+			OsType:              osType(np.OSType),
+			OsDiskSizeGB:        azure.ToInt32(np.OSDiskSizeGB),
+			MaxPods:             azure.ToInt32(np.MaxPods),
+			VnetSubnetID:        azure.ToStringPtr(c.Spec.VnetSubnetID),
+			AvailabilityZones:   azure.ToStringArrayPtr(np.Zones),
+			OrchestratorVersion: to.StringPtr(c.Spec.Version),
+			NodeTaints:          azure.ToStringArrayPtr(np.Taints),
+			NodeLabels:          azure.ToStringPtrMap(np.Labels),
+			Tags:                inlineNodePoolTags(),
+			Mode:                containerservice.AgentPoolModeUser,
+			Type:                containerservice.AgentPoolTypeVirtualMachineScaleSets,
+
+			ScaleSetPriority:       containerservice.ScaleSetPriority(np.ScaleSetPriority),
+			ScaleSetEvictionPolicy: containerservice.ScaleSetEvictionPolicy(np.ScaleSetEvictionPolicy),
+			SpotMaxPrice:           spotMaxPrice(np.SpotMaxPrice),
+		},
+	}
+}
+
+func newManagedCluster(c *v1alpha3.AKSCluster, appID string, s ManagedClusterSecrets) containerservice.ManagedCluster {
+	count := desiredNodeCount(c.Spec.AKSClusterParameters)
 
 	p := containerservice.ManagedCluster{
 		Name:     to.StringPtr(meta.GetExternalName(c)),
 		Location: to.StringPtr(c.Spec.Location),
+		Tags:     azure.ToStringPtrMap(c.Spec.Tags),
 		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
 			KubernetesVersion: to.StringPtr(c.Spec.Version),
 			DNSPrefix:         to.StringPtr(c.Spec.DNSNamePrefix),
+			NodeResourceGroup: azure.ToStringPtr(c.Spec.NodeResourceGroup),
 			AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{
 				{
-					Name:   to.StringPtr(AgentPoolProfileName),
-					Count:  &nodeCount,
-					VMSize: containerservice.VMSizeTypes(c.Spec.NodeVMSize),
+					Name:              to.StringPtr(AgentPoolProfileName),
+					Count:             &count,
+					VMSize:            azure.ToStringPtr(c.Spec.NodeVMSize),
+					VnetSubnetID:      azure.ToStringPtr(c.Spec.VnetSubnetID),
+					AvailabilityZones: azure.ToStringArrayPtr(c.Spec.Zones),
+					// Clusters must have at least one System pool, and
+					// scale sets are required by most features that were
+					// introduced after the 2018-03-31 API version.
+					Mode:         containerservice.AgentPoolModeSystem,
+					Type:         agentPoolType(c.Spec.AgentPoolType),
+					OsDiskSizeGB: azure.ToInt32(c.Spec.NodeOSDiskSizeGB),
+					MaxPods:      azure.ToInt32(c.Spec.NodeMaxPods),
+					NodeTaints:   azure.ToStringArrayPtr(c.Spec.NodeTaints),
+					NodeLabels:   azure.ToStringPtrMap(c.Spec.NodeLabels),
 				},
 			},
 			ServicePrincipalProfile: &containerservice.ManagedClusterServicePrincipalProfile{
 				ClientID: to.StringPtr(appID),
-				Secret:   to.StringPtr(secret),
+				Secret:   to.StringPtr(s.ServicePrincipal),
 			},
 			EnableRBAC: to.BoolPtr(!c.Spec.DisableRBAC),
 		},
 	}
 
-	if c.Spec.VnetSubnetID != "" {
-		p.ManagedClusterProperties.NetworkProfile = &containerservice.NetworkProfile{NetworkPlugin: containerservice.Azure}
-		p.ManagedClusterProperties.AgentPoolProfiles = &[]containerservice.ManagedClusterAgentPoolProfile{
-			{
-				Name:         to.StringPtr(AgentPoolProfileName),
-				Count:        &nodeCount,
-				VMSize:       containerservice.VMSizeTypes(c.Spec.NodeVMSize),
-				VnetSubnetID: to.StringPtr(c.Spec.VnetSubnetID),
-			},
+	if c.Spec.EnableAutoScaling {
+		setAutoScaling(&(*p.AgentPoolProfiles)[0], c.Spec.AKSClusterParameters)
+	}
+
+	for _, np := range c.Spec.NodePools {
+		*p.AgentPoolProfiles = append(*p.AgentPoolProfiles, containerservice.ManagedClusterAgentPoolProfile{
+			Name:              to.StringPtr(np.Name),
+			Count:             to.Int32Ptr(nodePoolCount(np.Count)),
+			VMSize:            to.StringPtr(np.VMSize),
+			OsType:            osType(np.OSType),
+			OsDiskSizeGB:      azure.ToInt32(np.OSDiskSizeGB),
+			MaxPods:           azure.ToInt32(np.MaxPods),
+			VnetSubnetID:      azure.ToStringPtr(c.Spec.VnetSubnetID),
+			AvailabilityZones: azure.ToStringArrayPtr(np.Zones),
+			NodeTaints:        azure.ToStringArrayPtr(np.Taints),
+			NodeLabels:        azure.ToStringPtrMap(np.Labels),
+			Tags:              inlineNodePoolTags(),
+			Mode:              containerservice.AgentPoolModeUser,
+			Type:              containerservice.AgentPoolTypeVirtualMachineScaleSets,
+
+			ScaleSetPriority:       containerservice.ScaleSetPriority(np.ScaleSetPriority),
+			ScaleSetEvictionPolicy: containerservice.ScaleSetEvictionPolicy(np.ScaleSetEvictionPolicy),
+			SpotMaxPrice:           spotMaxPrice(np.SpotMaxPrice),
+		})
+	}
+
+	if id := c.Spec.Identity; id != nil {
+		p.ManagedClusterProperties.ServicePrincipalProfile = nil
+		p.Identity = &containerservice.ManagedClusterIdentity{Type: containerservice.ResourceIdentityType(id.Type)}
+		if id.Type == v1alpha3.AKSClusterIdentityTypeUserAssigned {
+			p.Identity.UserAssignedIdentities = map[string]*containerservice.ManagedClusterIdentityUserAssignedIdentitiesValue{
+				id.UserAssignedIdentityID: {},
+			}
+		}
+	}
+
+	if c.Spec.SKUTier != "" {
+		p.Sku = &containerservice.ManagedClusterSKU{
+			Name: containerservice.ManagedClusterSKUNameBasic,
+			Tier: containerservice.ManagedClusterSKUTier(c.Spec.SKUTier),
+		}
+	}
+
+	p.ManagedClusterProperties.NetworkProfile = newNetworkProfile(c.Spec.AKSClusterParameters)
+
+	p.ManagedClusterProperties.AadProfile = newAADProfile(c.Spec.AADProfile, s.AADServerApp)
+	p.ManagedClusterProperties.LinuxProfile = newLinuxProfile(c.Spec.LinuxProfile, s.SSHPublicKey)
+	p.ManagedClusterProperties.WindowsProfile = newWindowsProfile(c.Spec.WindowsProfile, s.WindowsAdminPassword)
+
+	setAddonProfiles(&p, c.Spec.AKSClusterParameters)
+
+	if c.Spec.PrivateCluster {
+		p.ManagedClusterProperties.APIServerAccessProfile = &containerservice.ManagedClusterAPIServerAccessProfile{
+			EnablePrivateCluster: to.BoolPtr(true),
+		}
+	}
+	if len(c.Spec.APIServerAuthorizedIPRanges) > 0 {
+		p.ManagedClusterProperties.APIServerAccessProfile = &containerservice.ManagedClusterAPIServerAccessProfile{
+			AuthorizedIPRanges: azure.ToStringArrayPtr(c.Spec.APIServerAuthorizedIPRanges),
 		}
 	}
 
 	return p
 }
 
+// newAADProfile returns the Azure AD profile described by the supplied
+// parameters, using the supplied server application secret unless the
+// integration is managed by AKS.
+func newAADProfile(p *v1alpha3.AKSClusterAADProfile, secret string) *containerservice.ManagedClusterAADProfile {
+	if p == nil {
+		return nil
+	}
+	if p.Managed {
+		return &containerservice.ManagedClusterAADProfile{
+			Managed:             to.BoolPtr(true),
+			AdminGroupObjectIDs: azure.ToStringArrayPtr(p.AdminGroupObjectIDs),
+			TenantID:            azure.ToStringPtr(p.TenantID),
+		}
+	}
+	return &containerservice.ManagedClusterAADProfile{
+		ServerAppID:     azure.ToStringPtr(p.ServerAppID),
+		ServerAppSecret: azure.ToStringPtr(secret),
+		ClientAppID:     azure.ToStringPtr(p.ClientAppID),
+		TenantID:        azure.ToStringPtr(p.TenantID),
+	}
+}
+
+// newLinuxProfile returns the Linux profile described by the supplied
+// parameters, authorizing the supplied SSH public key in addition to those
+// they list.
+func newLinuxProfile(p *v1alpha3.AKSClusterLinuxProfile, key string) *containerservice.LinuxProfile {
+	if p == nil {
+		return nil
+	}
+	keys := make([]containerservice.SSHPublicKey, 0, len(p.SSHPublicKeys)+1)
+	for _, k := range p.SSHPublicKeys {
+		keys = append(keys, containerservice.SSHPublicKey{KeyData: to.StringPtr(k)})
+	}
+	if key != "" {
+		keys = append(keys, containerservice.SSHPublicKey{KeyData: to.StringPtr(key)})
+	}
+	return &containerservice.LinuxProfile{
+		AdminUsername: to.StringPtr(p.AdminUsername),
+		SSH:           &containerservice.SSHConfiguration{PublicKeys: &keys},
+	}
+}
+
+// newWindowsProfile returns the Windows profile described by the supplied
+// parameters, using the supplied administrator password.
+func newWindowsProfile(p *v1alpha3.AKSClusterWindowsProfile, password string) *containerservice.ManagedClusterWindowsProfile {
+	if p == nil {
+		return nil
+	}
+	return &containerservice.ManagedClusterWindowsProfile{
+		AdminUsername: to.StringPtr(p.AdminUsername),
+		AdminPassword: to.StringPtr(password),
+		LicenseType:   containerservice.LicenseType(p.LicenseType),
+	}
+}
+
+// newNetworkProfile returns the network profile described by the supplied
+// parameters, or nil if they leave it to Azure.
+func newNetworkProfile(p v1alpha3.AKSClusterParameters) *containerservice.NetworkProfile {
+	np := &containerservice.NetworkProfile{
+		NetworkPlugin:       containerservice.NetworkPlugin(p.NetworkPlugin),
+		NetworkPolicy:       containerservice.NetworkPolicy(p.NetworkPolicy),
+		PodCidr:             azure.ToStringPtr(p.PodCIDR),
+		ServiceCidr:         azure.ToStringPtr(p.ServiceCIDR),
+		DNSServiceIP:        azure.ToStringPtr(p.DNSServiceIP),
+		DockerBridgeCidr:    azure.ToStringPtr(p.DockerBridgeCIDR),
+		OutboundType:        containerservice.OutboundType(p.OutboundType),
+		LoadBalancerProfile: newLoadBalancerProfile(p.LoadBalancerProfile),
+	}
+	if np.NetworkPlugin == "" && p.VnetSubnetID != "" {
+		np.NetworkPlugin = containerservice.NetworkPluginAzure
+	}
+	if cmp.Equal(np, &containerservice.NetworkProfile{}) {
+		return nil
+	}
+	return np
+}
+
+// latestExpiry returns when the latest expiring of the supplied password
+// credentials expires, or the zero time if there are none.
+func latestExpiry(pcs *[]graphrbac.PasswordCredential) time.Time {
+	latest := time.Time{}
+	if pcs == nil {
+		return latest
+	}
+	for _, pc := range *pcs {
+		if pc.EndDate != nil && pc.EndDate.Time.After(latest) {
+			latest = pc.EndDate.Time
+		}
+	}
+	return latest
+}
+
+// unexpiredPasswordCredentials returns the supplied password credentials that
+// have not expired at the supplied time, followed by the new credential.
+// Graph API never returns the values of existing credentials, so they are
+// identified by their key ID.
+func unexpiredPasswordCredentials(pcs *[]graphrbac.PasswordCredential, pc graphrbac.PasswordCredential, now time.Time) *[]graphrbac.PasswordCredential {
+	keep := []graphrbac.PasswordCredential{}
+	if pcs != nil {
+		for _, e := range *pcs {
+			if e.EndDate != nil && e.EndDate.Time.Before(now) {
+				continue
+			}
+			e.Value = nil
+			keep = append(keep, e)
+		}
+	}
+	keep = append(keep, pc)
+	return &keep
+}
+
+// withoutPasswordCredential returns the supplied password credentials without
+// the one with the supplied key ID.
+func withoutPasswordCredential(pcs *[]graphrbac.PasswordCredential, keyID string) *[]graphrbac.PasswordCredential {
+	keep := []graphrbac.PasswordCredential{}
+	if pcs != nil {
+		for _, e := range *pcs {
+			if to.String(e.KeyID) != keyID {
+				keep = append(keep, e)
+			}
+		}
+	}
+	return &keep
+}
+
 func newPasswordCredential(secret string) (graphrbac.PasswordCredential, error) {
 	keyID, err := uuid.NewRandom()
 	return graphrbac.PasswordCredential{
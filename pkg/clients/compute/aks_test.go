@@ -0,0 +1,1614 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azurev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const (
+	name            = "cool-aks"
+	location        = "westus2"
+	version         = "1.21.2"
+	dnsPrefix       = "cool"
+	vmSize          = "Standard_B2s"
+	subnetID        = "/subscriptions/coolsub/resourceGroups/coolrg/providers/Microsoft.Network/virtualNetworks/coolnet/subnets/coolsubnet"
+	nodeRG          = "cool-nodes"
+	appID           = "cool-app"
+	appSecret       = "cool-secret"
+	identityID      = "/subscriptions/coolsub/resourceGroups/coolrg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/coolid"
+	workspaceID     = "/subscriptions/coolsub/resourceGroups/coolRG/providers/Microsoft.OperationalInsights/workspaces/coolworkspace"
+	nodeCount   int = 3
+)
+
+type aksModifier func(*v1alpha3.AKSCluster)
+
+func withSpec(f func(*v1alpha3.AKSClusterParameters)) aksModifier {
+	return func(c *v1alpha3.AKSCluster) { f(&c.Spec.AKSClusterParameters) }
+}
+
+func aksCluster(m ...aksModifier) *v1alpha3.AKSCluster {
+	c := &v1alpha3.AKSCluster{
+		Spec: v1alpha3.AKSClusterSpec{
+			AKSClusterParameters: v1alpha3.AKSClusterParameters{
+				Location:      location,
+				Version:       version,
+				NodeVMSize:    vmSize,
+				DNSNamePrefix: dnsPrefix,
+			},
+		},
+	}
+	meta.SetExternalName(c, name)
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestNewManagedCluster(t *testing.T) {
+	count := int32(nodeCount)
+	defaultCount := int32(v1alpha3.DefaultNodeCount)
+
+	cases := map[string]struct {
+		c    *v1alpha3.AKSCluster
+		want containerservice.ManagedCluster
+	}{
+		"Minimal": {
+			c: aksCluster(),
+			want: containerservice.ManagedCluster{
+				Name:     to.StringPtr(name),
+				Location: to.StringPtr(location),
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					KubernetesVersion: to.StringPtr(version),
+					DNSPrefix:         to.StringPtr(dnsPrefix),
+					AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{
+						{
+							Name:   to.StringPtr(AgentPoolProfileName),
+							Count:  &defaultCount,
+							VMSize: to.StringPtr(vmSize),
+							Mode:   containerservice.AgentPoolModeSystem,
+							Type:   containerservice.AgentPoolTypeVirtualMachineScaleSets,
+						},
+					},
+					ServicePrincipalProfile: &containerservice.ManagedClusterServicePrincipalProfile{
+						ClientID: to.StringPtr(appID),
+						Secret:   to.StringPtr(appSecret),
+					},
+					EnableRBAC: to.BoolPtr(true),
+				},
+			},
+		},
+		"Full": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				n := nodeCount
+				p.NodeCount = &n
+				p.VnetSubnetID = subnetID
+				p.NodeResourceGroup = nodeRG
+				p.SKUTier = string(containerservice.ManagedClusterSKUTierPaid)
+				p.DisableRBAC = true
+				p.Zones = []string{"1", "2"}
+				p.Tags = map[string]string{"team": "cool"}
+				p.NodeOSDiskSizeGB = to.IntPtr(128)
+				p.NodeMaxPods = to.IntPtr(50)
+				p.NodeTaints = []string{"CriticalAddonsOnly=true:NoSchedule"}
+				p.NodeLabels = map[string]string{"pool": "system"}
+				p.NodePools = []v1alpha3.AKSClusterNodePool{{Name: "win", VMSize: vmSize, OSType: "Windows", OSDiskSizeGB: to.IntPtr(256), MaxPods: to.IntPtr(30), Labels: map[string]string{"os": "windows"}}}
+			})),
+			want: containerservice.ManagedCluster{
+				Name:     to.StringPtr(name),
+				Location: to.StringPtr(location),
+				Tags:     map[string]*string{"team": to.StringPtr("cool")},
+				Sku: &containerservice.ManagedClusterSKU{
+					Name: containerservice.ManagedClusterSKUNameBasic,
+					Tier: containerservice.ManagedClusterSKUTierPaid,
+				},
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					KubernetesVersion: to.StringPtr(version),
+					DNSPrefix:         to.StringPtr(dnsPrefix),
+					NodeResourceGroup: to.StringPtr(nodeRG),
+					AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{
+						{
+							Name:              to.StringPtr(AgentPoolProfileName),
+							Count:             &count,
+							VMSize:            to.StringPtr(vmSize),
+							VnetSubnetID:      to.StringPtr(subnetID),
+							AvailabilityZones: &[]string{"1", "2"},
+							Mode:              containerservice.AgentPoolModeSystem,
+							Type:              containerservice.AgentPoolTypeVirtualMachineScaleSets,
+							OsDiskSizeGB:      to.Int32Ptr(128),
+							MaxPods:           to.Int32Ptr(50),
+							NodeTaints:        &[]string{"CriticalAddonsOnly=true:NoSchedule"},
+							NodeLabels:        map[string]*string{"pool": to.StringPtr("system")},
+						},
+						{
+							Name:         to.StringPtr("win"),
+							Count:        &defaultCount,
+							VMSize:       to.StringPtr(vmSize),
+							OsType:       containerservice.OSTypeWindows,
+							OsDiskSizeGB: to.Int32Ptr(256),
+							MaxPods:      to.Int32Ptr(30),
+							VnetSubnetID: to.StringPtr(subnetID),
+							NodeLabels:   map[string]*string{"os": to.StringPtr("windows")},
+							Tags:         inlineNodePoolTags(),
+							Mode:         containerservice.AgentPoolModeUser,
+							Type:         containerservice.AgentPoolTypeVirtualMachineScaleSets,
+						},
+					},
+					ServicePrincipalProfile: &containerservice.ManagedClusterServicePrincipalProfile{
+						ClientID: to.StringPtr(appID),
+						Secret:   to.StringPtr(appSecret),
+					},
+					EnableRBAC:     to.BoolPtr(false),
+					NetworkProfile: &containerservice.NetworkProfile{NetworkPlugin: containerservice.NetworkPluginAzure},
+				},
+			},
+		},
+		"AutoScaling": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.EnableAutoScaling = true
+				p.MinCount = to.IntPtr(1)
+				p.MaxCount = to.IntPtr(nodeCount)
+			})),
+			want: containerservice.ManagedCluster{
+				Name:     to.StringPtr(name),
+				Location: to.StringPtr(location),
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					KubernetesVersion: to.StringPtr(version),
+					DNSPrefix:         to.StringPtr(dnsPrefix),
+					AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{
+						{
+							Name:              to.StringPtr(AgentPoolProfileName),
+							Count:             &defaultCount,
+							VMSize:            to.StringPtr(vmSize),
+							EnableAutoScaling: to.BoolPtr(true),
+							MinCount:          to.Int32Ptr(1),
+							MaxCount:          &count,
+							Mode:              containerservice.AgentPoolModeSystem,
+							Type:              containerservice.AgentPoolTypeVirtualMachineScaleSets,
+						},
+					},
+					ServicePrincipalProfile: &containerservice.ManagedClusterServicePrincipalProfile{
+						ClientID: to.StringPtr(appID),
+						Secret:   to.StringPtr(appSecret),
+					},
+					EnableRBAC: to.BoolPtr(true),
+				},
+			},
+		},
+		"AuthorizedIPRanges": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.APIServerAuthorizedIPRanges = []string{"203.0.113.0/24"}
+			})),
+			want: containerservice.ManagedCluster{
+				Name:     to.StringPtr(name),
+				Location: to.StringPtr(location),
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					KubernetesVersion: to.StringPtr(version),
+					DNSPrefix:         to.StringPtr(dnsPrefix),
+					AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{
+						{
+							Name:   to.StringPtr(AgentPoolProfileName),
+							Count:  &defaultCount,
+							VMSize: to.StringPtr(vmSize),
+							Mode:   containerservice.AgentPoolModeSystem,
+							Type:   containerservice.AgentPoolTypeVirtualMachineScaleSets,
+						},
+					},
+					ServicePrincipalProfile: &containerservice.ManagedClusterServicePrincipalProfile{
+						ClientID: to.StringPtr(appID),
+						Secret:   to.StringPtr(appSecret),
+					},
+					EnableRBAC: to.BoolPtr(true),
+					APIServerAccessProfile: &containerservice.ManagedClusterAPIServerAccessProfile{
+						AuthorizedIPRanges: &[]string{"203.0.113.0/24"},
+					},
+				},
+			},
+		},
+		"SpotNodePool": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				price := resource.MustParse("0.05")
+				p.NodePools = []v1alpha3.AKSClusterNodePool{{
+					Name:                   "spot",
+					VMSize:                 vmSize,
+					ScaleSetPriority:       string(containerservice.ScaleSetPrioritySpot),
+					ScaleSetEvictionPolicy: string(containerservice.ScaleSetEvictionPolicyDeallocate),
+					SpotMaxPrice:           &price,
+				}}
+			})),
+			want: containerservice.ManagedCluster{
+				Name:     to.StringPtr(name),
+				Location: to.StringPtr(location),
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					KubernetesVersion: to.StringPtr(version),
+					DNSPrefix:         to.StringPtr(dnsPrefix),
+					AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{
+						{
+							Name:   to.StringPtr(AgentPoolProfileName),
+							Count:  &defaultCount,
+							VMSize: to.StringPtr(vmSize),
+							Mode:   containerservice.AgentPoolModeSystem,
+							Type:   containerservice.AgentPoolTypeVirtualMachineScaleSets,
+						},
+						{
+							Name:                   to.StringPtr("spot"),
+							Count:                  &defaultCount,
+							VMSize:                 to.StringPtr(vmSize),
+							OsType:                 containerservice.OSTypeLinux,
+							Tags:                   inlineNodePoolTags(),
+							Mode:                   containerservice.AgentPoolModeUser,
+							Type:                   containerservice.AgentPoolTypeVirtualMachineScaleSets,
+							ScaleSetPriority:       containerservice.ScaleSetPrioritySpot,
+							ScaleSetEvictionPolicy: containerservice.ScaleSetEvictionPolicyDeallocate,
+							SpotMaxPrice:           to.Float64Ptr(0.05),
+						},
+					},
+					ServicePrincipalProfile: &containerservice.ManagedClusterServicePrincipalProfile{
+						ClientID: to.StringPtr(appID),
+						Secret:   to.StringPtr(appSecret),
+					},
+					EnableRBAC: to.BoolPtr(true),
+				},
+			},
+		},
+		"UserAssignedIdentity": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.Identity = &v1alpha3.AKSClusterIdentity{
+					Type:                   v1alpha3.AKSClusterIdentityTypeUserAssigned,
+					UserAssignedIdentityID: identityID,
+				}
+			})),
+			want: containerservice.ManagedCluster{
+				Name:     to.StringPtr(name),
+				Location: to.StringPtr(location),
+				Identity: &containerservice.ManagedClusterIdentity{
+					Type: containerservice.ResourceIdentityTypeUserAssigned,
+					UserAssignedIdentities: map[string]*containerservice.ManagedClusterIdentityUserAssignedIdentitiesValue{
+						identityID: {},
+					},
+				},
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					KubernetesVersion: to.StringPtr(version),
+					DNSPrefix:         to.StringPtr(dnsPrefix),
+					AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{
+						{
+							Name:   to.StringPtr(AgentPoolProfileName),
+							Count:  &defaultCount,
+							VMSize: to.StringPtr(vmSize),
+							Mode:   containerservice.AgentPoolModeSystem,
+							Type:   containerservice.AgentPoolTypeVirtualMachineScaleSets,
+						},
+					},
+					EnableRBAC: to.BoolPtr(true),
+				},
+			},
+		},
+		"Monitoring": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.LogAnalyticsWorkspaceID = workspaceID
+			})),
+			want: containerservice.ManagedCluster{
+				Name:     to.StringPtr(name),
+				Location: to.StringPtr(location),
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					KubernetesVersion: to.StringPtr(version),
+					DNSPrefix:         to.StringPtr(dnsPrefix),
+					AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{
+						{
+							Name:   to.StringPtr(AgentPoolProfileName),
+							Count:  &defaultCount,
+							VMSize: to.StringPtr(vmSize),
+							Mode:   containerservice.AgentPoolModeSystem,
+							Type:   containerservice.AgentPoolTypeVirtualMachineScaleSets,
+						},
+					},
+					ServicePrincipalProfile: &containerservice.ManagedClusterServicePrincipalProfile{
+						ClientID: to.StringPtr(appID),
+						Secret:   to.StringPtr(appSecret),
+					},
+					EnableRBAC: to.BoolPtr(true),
+					AddonProfiles: map[string]*containerservice.ManagedClusterAddonProfile{
+						MonitoringAddonName: {
+							Enabled: to.BoolPtr(true),
+							Config:  map[string]*string{MonitoringAddonWorkspaceKey: to.StringPtr(workspaceID)},
+						},
+					},
+				},
+			},
+		},
+		"Addons": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.LogAnalyticsWorkspaceID = workspaceID
+				p.AddonProfiles = &v1alpha3.AKSClusterAddonProfiles{
+					HTTPApplicationRouting: &v1alpha3.AKSClusterAddonProfile{Enabled: true},
+					Monitoring:             &v1alpha3.AKSClusterAddonProfile{Enabled: false},
+					AzurePolicy:            &v1alpha3.AKSClusterAddonProfile{Enabled: true},
+				}
+			})),
+			want: containerservice.ManagedCluster{
+				Name:     to.StringPtr(name),
+				Location: to.StringPtr(location),
+				ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+					KubernetesVersion: to.StringPtr(version),
+					DNSPrefix:         to.StringPtr(dnsPrefix),
+					AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{
+						{
+							Name:   to.StringPtr(AgentPoolProfileName),
+							Count:  &defaultCount,
+							VMSize: to.StringPtr(vmSize),
+							Mode:   containerservice.AgentPoolModeSystem,
+							Type:   containerservice.AgentPoolTypeVirtualMachineScaleSets,
+						},
+					},
+					ServicePrincipalProfile: &containerservice.ManagedClusterServicePrincipalProfile{
+						ClientID: to.StringPtr(appID),
+						Secret:   to.StringPtr(appSecret),
+					},
+					EnableRBAC: to.BoolPtr(true),
+					AddonProfiles: map[string]*containerservice.ManagedClusterAddonProfile{
+						HTTPApplicationRoutingAddonName: {Enabled: to.BoolPtr(true)},
+						AzurePolicyAddonName:            {Enabled: to.BoolPtr(true)},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := newManagedCluster(tc.c, appID, ManagedClusterSecrets{ServicePrincipal: appSecret})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("newManagedCluster(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewNetworkProfile(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.AKSClusterParameters
+		want *containerservice.NetworkProfile
+	}{
+		"Default": {
+			p:    v1alpha3.AKSClusterParameters{},
+			want: nil,
+		},
+		"Subnet": {
+			p:    v1alpha3.AKSClusterParameters{VnetSubnetID: subnetID},
+			want: &containerservice.NetworkProfile{NetworkPlugin: containerservice.NetworkPluginAzure},
+		},
+		"Kubenet": {
+			p: v1alpha3.AKSClusterParameters{
+				VnetSubnetID:     subnetID,
+				NetworkPlugin:    string(containerservice.NetworkPluginKubenet),
+				NetworkPolicy:    string(containerservice.NetworkPolicyCalico),
+				PodCIDR:          "10.244.0.0/16",
+				ServiceCIDR:      "10.0.0.0/16",
+				DNSServiceIP:     "10.0.0.10",
+				DockerBridgeCIDR: "172.17.0.1/16",
+			},
+			want: &containerservice.NetworkProfile{
+				NetworkPlugin:    containerservice.NetworkPluginKubenet,
+				NetworkPolicy:    containerservice.NetworkPolicyCalico,
+				PodCidr:          to.StringPtr("10.244.0.0/16"),
+				ServiceCidr:      to.StringPtr("10.0.0.0/16"),
+				DNSServiceIP:     to.StringPtr("10.0.0.10"),
+				DockerBridgeCidr: to.StringPtr("172.17.0.1/16"),
+			},
+		},
+		"UserDefinedRouting": {
+			p: v1alpha3.AKSClusterParameters{
+				VnetSubnetID: subnetID,
+				OutboundType: string(containerservice.OutboundTypeUserDefinedRouting),
+			},
+			want: &containerservice.NetworkProfile{
+				NetworkPlugin: containerservice.NetworkPluginAzure,
+				OutboundType:  containerservice.OutboundTypeUserDefinedRouting,
+			},
+		},
+		"LoadBalancerProfile": {
+			p: v1alpha3.AKSClusterParameters{
+				LoadBalancerProfile: &v1alpha3.AKSClusterLoadBalancerProfile{
+					ManagedOutboundIPCount: to.IntPtr(2),
+					IdleTimeoutInMinutes:   to.IntPtr(10),
+				},
+			},
+			want: &containerservice.NetworkProfile{
+				LoadBalancerProfile: &containerservice.ManagedClusterLoadBalancerProfile{
+					ManagedOutboundIPs:   &containerservice.ManagedClusterLoadBalancerProfileManagedOutboundIPs{Count: to.Int32Ptr(2)},
+					IdleTimeoutInMinutes: to.Int32Ptr(10),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := newNetworkProfile(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("newNetworkProfile(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateNetwork(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.AKSClusterParameters
+		want error
+	}{
+		"Public": {
+			p: v1alpha3.AKSClusterParameters{},
+		},
+		"Private": {
+			p: v1alpha3.AKSClusterParameters{PrivateCluster: true, VnetSubnetID: subnetID},
+		},
+		"PrivateWithoutSubnet": {
+			p:    v1alpha3.AKSClusterParameters{PrivateCluster: true},
+			want: errors.New(errPrivateClusterSubnet),
+		},
+		"PrivateWithAuthorizedIPRanges": {
+			p:    v1alpha3.AKSClusterParameters{PrivateCluster: true, VnetSubnetID: subnetID, APIServerAuthorizedIPRanges: []string{"203.0.113.0/24"}},
+			want: errors.New(errPrivateClusterIPs),
+		},
+		"UserDefinedRouting": {
+			p: v1alpha3.AKSClusterParameters{VnetSubnetID: subnetID, OutboundType: string(containerservice.OutboundTypeUserDefinedRouting)},
+		},
+		"UserDefinedRoutingWithoutSubnet": {
+			p:    v1alpha3.AKSClusterParameters{OutboundType: string(containerservice.OutboundTypeUserDefinedRouting)},
+			want: errors.New(errUDRSubnet),
+		},
+		"UserDefinedRoutingWithLoadBalancer": {
+			p: v1alpha3.AKSClusterParameters{
+				VnetSubnetID:        subnetID,
+				OutboundType:        string(containerservice.OutboundTypeUserDefinedRouting),
+				LoadBalancerProfile: &v1alpha3.AKSClusterLoadBalancerProfile{IdleTimeoutInMinutes: to.IntPtr(10)},
+			},
+			want: errors.New(errUDRLoadBalancer),
+		},
+		"ZonesOnScaleSet": {
+			p: v1alpha3.AKSClusterParameters{Zones: []string{"1"}},
+		},
+		"ZonesOnAvailabilitySet": {
+			p:    v1alpha3.AKSClusterParameters{Zones: []string{"1"}, AgentPoolType: string(containerservice.AgentPoolTypeAvailabilitySet)},
+			want: zoneUnavailableError{errors.New(errAvailabilitySetZones)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateNetwork(tc.p)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("validateNetwork(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateAddons(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.AKSClusterParameters
+		want error
+	}{
+		"NoAddons": {
+			p: v1alpha3.AKSClusterParameters{},
+		},
+		"Monitoring": {
+			p: v1alpha3.AKSClusterParameters{
+				LogAnalyticsWorkspaceID: workspaceID,
+				AddonProfiles:           &v1alpha3.AKSClusterAddonProfiles{Monitoring: &v1alpha3.AKSClusterAddonProfile{Enabled: true}},
+			},
+		},
+		"MonitoringWithoutWorkspace": {
+			p: v1alpha3.AKSClusterParameters{
+				AddonProfiles: &v1alpha3.AKSClusterAddonProfiles{Monitoring: &v1alpha3.AKSClusterAddonProfile{Enabled: true}},
+			},
+			want: errors.New(errMonitoringWorkspace),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateAddons(tc.p)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("validateAddons(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateNodePools(t *testing.T) {
+	price := resource.MustParse("-1")
+	cases := map[string]struct {
+		p    v1alpha3.AKSClusterParameters
+		want error
+	}{
+		"Regular": {
+			p: v1alpha3.AKSClusterParameters{NodePools: []v1alpha3.AKSClusterNodePool{{Name: "regular"}}},
+		},
+		"Spot": {
+			p: v1alpha3.AKSClusterParameters{NodePools: []v1alpha3.AKSClusterNodePool{{
+				Name:                   "spot",
+				ScaleSetPriority:       string(containerservice.ScaleSetPrioritySpot),
+				ScaleSetEvictionPolicy: string(containerservice.ScaleSetEvictionPolicyDelete),
+				SpotMaxPrice:           &price,
+			}}},
+		},
+		"EvictionPolicyWithoutSpot": {
+			p: v1alpha3.AKSClusterParameters{NodePools: []v1alpha3.AKSClusterNodePool{{
+				Name:                   "regular",
+				ScaleSetEvictionPolicy: string(containerservice.ScaleSetEvictionPolicyDelete),
+			}}},
+			want: errors.Errorf(errFmtSpotSettings, "regular"),
+		},
+		"MaxPriceWithoutSpot": {
+			p: v1alpha3.AKSClusterParameters{NodePools: []v1alpha3.AKSClusterNodePool{{
+				Name:             "regular",
+				ScaleSetPriority: string(containerservice.ScaleSetPriorityRegular),
+				SpotMaxPrice:     &price,
+			}}},
+			want: errors.Errorf(errFmtSpotSettings, "regular"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateNodePools(tc.p)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("validateNodePools(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetAddonProfiles(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.AKSClusterParameters
+		mc   containerservice.ManagedCluster
+		want containerservice.ManagedCluster
+	}{
+		"Unmanaged": {
+			p: v1alpha3.AKSClusterParameters{},
+			mc: containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+				AddonProfiles: map[string]*containerservice.ManagedClusterAddonProfile{
+					AzurePolicyAddonName: {Enabled: to.BoolPtr(true)},
+				},
+			}},
+			want: containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+				AddonProfiles: map[string]*containerservice.ManagedClusterAddonProfile{
+					AzurePolicyAddonName: {Enabled: to.BoolPtr(true)},
+				},
+			}},
+		},
+		"Disable": {
+			p: v1alpha3.AKSClusterParameters{
+				LogAnalyticsWorkspaceID: workspaceID,
+				AddonProfiles: &v1alpha3.AKSClusterAddonProfiles{
+					Monitoring:    &v1alpha3.AKSClusterAddonProfile{Enabled: false},
+					KubeDashboard: &v1alpha3.AKSClusterAddonProfile{Enabled: false},
+				},
+			},
+			mc: containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+				AddonProfiles: map[string]*containerservice.ManagedClusterAddonProfile{
+					MonitoringAddonName: {
+						Enabled: to.BoolPtr(true),
+						Config:  map[string]*string{MonitoringAddonWorkspaceKey: to.StringPtr(workspaceID)},
+					},
+					KubeDashboardAddonName: {Enabled: to.BoolPtr(true)},
+				},
+			}},
+			want: containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+				AddonProfiles: map[string]*containerservice.ManagedClusterAddonProfile{
+					MonitoringAddonName:    {Enabled: to.BoolPtr(false)},
+					KubeDashboardAddonName: {Enabled: to.BoolPtr(false)},
+				},
+			}},
+		},
+		"Enable": {
+			p: v1alpha3.AKSClusterParameters{
+				AddonProfiles: &v1alpha3.AKSClusterAddonProfiles{
+					HTTPApplicationRouting: &v1alpha3.AKSClusterAddonProfile{Enabled: true},
+				},
+			},
+			mc: containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{}},
+			want: containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+				AddonProfiles: map[string]*containerservice.ManagedClusterAddonProfile{
+					HTTPApplicationRoutingAddonName: {Enabled: to.BoolPtr(true)},
+				},
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			setAddonProfiles(&tc.mc, tc.p)
+			if diff := cmp.Diff(tc.want, tc.mc); diff != "" {
+				t.Errorf("setAddonProfiles(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetAuthorizedIPRanges(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.AKSClusterParameters
+		mc   containerservice.ManagedCluster
+		want *containerservice.ManagedClusterAPIServerAccessProfile
+	}{
+		"NoneDesired": {
+			mc: containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{}},
+		},
+		"Added": {
+			p:    v1alpha3.AKSClusterParameters{APIServerAuthorizedIPRanges: []string{"203.0.113.0/24"}},
+			mc:   containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{}},
+			want: &containerservice.ManagedClusterAPIServerAccessProfile{AuthorizedIPRanges: &[]string{"203.0.113.0/24"}},
+		},
+		"Removed": {
+			mc: containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+				APIServerAccessProfile: &containerservice.ManagedClusterAPIServerAccessProfile{AuthorizedIPRanges: &[]string{"203.0.113.0/24"}},
+			}},
+			want: &containerservice.ManagedClusterAPIServerAccessProfile{AuthorizedIPRanges: &[]string{}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			setAuthorizedIPRanges(&tc.mc, tc.p)
+			if diff := cmp.Diff(tc.want, tc.mc.APIServerAccessProfile); diff != "" {
+				t.Errorf("setAuthorizedIPRanges(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetLoadBalancerProfile(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.AKSClusterParameters
+		mc   containerservice.ManagedCluster
+		want *containerservice.NetworkProfile
+	}{
+		"NoneDesired": {
+			mc: containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{}},
+		},
+		"ManagedOutboundIPs": {
+			p: v1alpha3.AKSClusterParameters{
+				LoadBalancerProfile: &v1alpha3.AKSClusterLoadBalancerProfile{ManagedOutboundIPCount: to.IntPtr(2)},
+			},
+			mc: containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+				NetworkProfile: &containerservice.NetworkProfile{
+					NetworkPlugin: containerservice.NetworkPluginKubenet,
+					LoadBalancerProfile: &containerservice.ManagedClusterLoadBalancerProfile{
+						OutboundIPs:          &containerservice.ManagedClusterLoadBalancerProfileOutboundIPs{},
+						IdleTimeoutInMinutes: to.Int32Ptr(30),
+					},
+				},
+			}},
+			want: &containerservice.NetworkProfile{
+				NetworkPlugin: containerservice.NetworkPluginKubenet,
+				LoadBalancerProfile: &containerservice.ManagedClusterLoadBalancerProfile{
+					ManagedOutboundIPs:   &containerservice.ManagedClusterLoadBalancerProfileManagedOutboundIPs{Count: to.Int32Ptr(2)},
+					IdleTimeoutInMinutes: to.Int32Ptr(30),
+				},
+			},
+		},
+		"IdleTimeout": {
+			p: v1alpha3.AKSClusterParameters{
+				LoadBalancerProfile: &v1alpha3.AKSClusterLoadBalancerProfile{IdleTimeoutInMinutes: to.IntPtr(10)},
+			},
+			mc: containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{}},
+			want: &containerservice.NetworkProfile{
+				LoadBalancerProfile: &containerservice.ManagedClusterLoadBalancerProfile{IdleTimeoutInMinutes: to.Int32Ptr(10)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			setLoadBalancerProfile(&tc.mc, tc.p)
+			if diff := cmp.Diff(tc.want, tc.mc.NetworkProfile); diff != "" {
+				t.Errorf("setLoadBalancerProfile(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewAADProfile(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha3.AKSClusterAADProfile
+		want *containerservice.ManagedClusterAADProfile
+	}{
+		"Disabled": {
+			p:    nil,
+			want: nil,
+		},
+		"Managed": {
+			p: &v1alpha3.AKSClusterAADProfile{
+				Managed:             true,
+				AdminGroupObjectIDs: []string{"admins"},
+				ServerAppID:         "ignored",
+			},
+			want: &containerservice.ManagedClusterAADProfile{
+				Managed:             to.BoolPtr(true),
+				AdminGroupObjectIDs: &[]string{"admins"},
+			},
+		},
+		"Legacy": {
+			p: &v1alpha3.AKSClusterAADProfile{
+				ServerAppID: "server-app",
+				ClientAppID: "client-app",
+				TenantID:    "tenant",
+			},
+			want: &containerservice.ManagedClusterAADProfile{
+				ServerAppID:     to.StringPtr("server-app"),
+				ServerAppSecret: to.StringPtr(appSecret),
+				ClientAppID:     to.StringPtr("client-app"),
+				TenantID:        to.StringPtr("tenant"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := newAADProfile(tc.p, appSecret)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("newAADProfile(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewLinuxProfile(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha3.AKSClusterLinuxProfile
+		key  string
+		want *containerservice.LinuxProfile
+	}{
+		"Omitted": {
+			p:    nil,
+			key:  "ignored",
+			want: nil,
+		},
+		"KeysAndSecretKey": {
+			p: &v1alpha3.AKSClusterLinuxProfile{
+				AdminUsername: "azureuser",
+				SSHPublicKeys: []string{"ssh-rsa A"},
+			},
+			key: "ssh-rsa B",
+			want: &containerservice.LinuxProfile{
+				AdminUsername: to.StringPtr("azureuser"),
+				SSH: &containerservice.SSHConfiguration{PublicKeys: &[]containerservice.SSHPublicKey{
+					{KeyData: to.StringPtr("ssh-rsa A")},
+					{KeyData: to.StringPtr("ssh-rsa B")},
+				}},
+			},
+		},
+		"NoSecretKey": {
+			p: &v1alpha3.AKSClusterLinuxProfile{
+				AdminUsername: "azureuser",
+				SSHPublicKeys: []string{"ssh-rsa A"},
+			},
+			want: &containerservice.LinuxProfile{
+				AdminUsername: to.StringPtr("azureuser"),
+				SSH: &containerservice.SSHConfiguration{PublicKeys: &[]containerservice.SSHPublicKey{
+					{KeyData: to.StringPtr("ssh-rsa A")},
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := newLinuxProfile(tc.p, tc.key)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("newLinuxProfile(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	count := int32(nodeCount)
+	cluster := func(version string, count int32, pools ...containerservice.ManagedClusterAgentPoolProfile) containerservice.ManagedCluster {
+		profiles := append([]containerservice.ManagedClusterAgentPoolProfile{
+			{Name: to.StringPtr(AgentPoolProfileName), Count: &count},
+		}, pools...)
+		return containerservice.ManagedCluster{
+			ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+				KubernetesVersion: to.StringPtr(version),
+				AgentPoolProfiles: &profiles,
+			},
+		}
+	}
+	withPool := aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+		p.NodePools = []v1alpha3.AKSClusterNodePool{{
+			Name:   "gpu",
+			VMSize: vmSize,
+			Taints: []string{"gpu=true:NoSchedule"},
+			Labels: map[string]string{"gpu": "true"},
+		}}
+	}))
+	gpuPool := containerservice.ManagedClusterAgentPoolProfile{
+		Name:       to.StringPtr("gpu"),
+		Count:      to.Int32Ptr(1),
+		VMSize:     to.StringPtr(vmSize),
+		NodeTaints: &[]string{"gpu=true:NoSchedule"},
+		NodeLabels: map[string]*string{"gpu": to.StringPtr("true")},
+		Tags:       inlineNodePoolTags(),
+	}
+	defaultCount := int32(v1alpha3.DefaultNodeCount)
+	autoScaling := aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+		p.EnableAutoScaling = true
+		p.MinCount = to.IntPtr(1)
+		p.MaxCount = to.IntPtr(nodeCount)
+	}))
+	autoScaled := func(min, max *int32) containerservice.ManagedCluster {
+		// The autoscaler owns the node count, which thus differs from the
+		// desired one.
+		mc := cluster(version, 2)
+		(*mc.AgentPoolProfiles)[0].EnableAutoScaling = to.BoolPtr(true)
+		(*mc.AgentPoolProfiles)[0].MinCount = min
+		(*mc.AgentPoolProfiles)[0].MaxCount = max
+		return mc
+	}
+	monitored := aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+		p.LogAnalyticsWorkspaceID = workspaceID
+	}))
+	withMonitoring := func(mc containerservice.ManagedCluster, enabled bool, workspace string) containerservice.ManagedCluster {
+		mc.AddonProfiles = map[string]*containerservice.ManagedClusterAddonProfile{
+			MonitoringAddonName: {
+				Enabled: to.BoolPtr(enabled),
+				Config:  map[string]*string{MonitoringAddonWorkspaceKey: to.StringPtr(workspace)},
+			},
+		}
+		return mc
+	}
+	withAddon := func(ap *v1alpha3.AKSClusterAddonProfiles) *v1alpha3.AKSCluster {
+		return aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+			p.AddonProfiles = ap
+		}))
+	}
+	withAddonProfile := func(mc containerservice.ManagedCluster, name string, enabled bool) containerservice.ManagedCluster {
+		mc.AddonProfiles = map[string]*containerservice.ManagedClusterAddonProfile{
+			name: {Enabled: to.BoolPtr(enabled)},
+		}
+		return mc
+	}
+
+	cases := map[string]struct {
+		c    *v1alpha3.AKSCluster
+		mc   containerservice.ManagedCluster
+		want bool
+	}{
+		"UpToDate": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				n := nodeCount
+				p.NodeCount = &n
+			})),
+			mc:   cluster(version, count),
+			want: true,
+		},
+		"DefaultNodeCount": {
+			c:    aksCluster(),
+			mc:   cluster(version, int32(v1alpha3.DefaultNodeCount)),
+			want: true,
+		},
+		"VersionChanged": {
+			c:    aksCluster(),
+			mc:   cluster("1.20.9", int32(v1alpha3.DefaultNodeCount)),
+			want: false,
+		},
+		"NodeVersionChanged": {
+			c: aksCluster(),
+			mc: func() containerservice.ManagedCluster {
+				mc := cluster(version, defaultCount)
+				(*mc.AgentPoolProfiles)[0].OrchestratorVersion = to.StringPtr("1.20.9")
+				return mc
+			}(),
+			want: false,
+		},
+		"NodePoolVersionChanged": {
+			c: withPool,
+			mc: func() containerservice.ManagedCluster {
+				p := gpuPool
+				p.OrchestratorVersion = to.StringPtr("1.20.9")
+				return cluster(version, defaultCount, p)
+			}(),
+			want: false,
+		},
+		"NodeCountChanged": {
+			c:    aksCluster(),
+			mc:   cluster(version, count),
+			want: false,
+		},
+		"NodeTaintsChanged": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.NodeTaints = []string{"CriticalAddonsOnly=true:NoSchedule"}
+			})),
+			mc:   cluster(version, defaultCount),
+			want: false,
+		},
+		"NodeLabelsUpToDate": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.NodeLabels = map[string]string{"pool": "system"}
+			})),
+			mc: func() containerservice.ManagedCluster {
+				mc := cluster(version, defaultCount)
+				(*mc.AgentPoolProfiles)[0].NodeLabels = map[string]*string{"pool": to.StringPtr("system")}
+				return mc
+			}(),
+			want: true,
+		},
+		"NodeLabelsChanged": {
+			c: aksCluster(),
+			mc: func() containerservice.ManagedCluster {
+				mc := cluster(version, defaultCount)
+				(*mc.AgentPoolProfiles)[0].NodeLabels = map[string]*string{"pool": to.StringPtr("system")}
+				return mc
+			}(),
+			want: false,
+		},
+		"NodePoolUpToDate": {
+			c:    withPool,
+			mc:   cluster(version, defaultCount, gpuPool),
+			want: true,
+		},
+		"NodePoolMissing": {
+			c:    withPool,
+			mc:   cluster(version, defaultCount),
+			want: false,
+		},
+		"NodePoolChanged": {
+			c: withPool,
+			mc: cluster(version, defaultCount, containerservice.ManagedClusterAgentPoolProfile{
+				Name:   to.StringPtr("gpu"),
+				Count:  to.Int32Ptr(3),
+				VMSize: to.StringPtr(vmSize),
+				Tags:   inlineNodePoolTags(),
+			}),
+			want: false,
+		},
+		"AuthorizedIPRangesUpToDate": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.APIServerAuthorizedIPRanges = []string{"203.0.113.0/24", "198.51.100.0/24"}
+			})),
+			mc: func() containerservice.ManagedCluster {
+				mc := cluster(version, defaultCount)
+				mc.APIServerAccessProfile = &containerservice.ManagedClusterAPIServerAccessProfile{
+					AuthorizedIPRanges: &[]string{"198.51.100.0/24", "203.0.113.0/24"},
+				}
+				return mc
+			}(),
+			want: true,
+		},
+		"AuthorizedIPRangesChanged": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.APIServerAuthorizedIPRanges = []string{"203.0.113.0/24"}
+			})),
+			mc:   cluster(version, defaultCount),
+			want: false,
+		},
+		"AuthorizedIPRangesRemoved": {
+			c: aksCluster(),
+			mc: func() containerservice.ManagedCluster {
+				mc := cluster(version, defaultCount)
+				mc.APIServerAccessProfile = &containerservice.ManagedClusterAPIServerAccessProfile{
+					AuthorizedIPRanges: &[]string{"203.0.113.0/24"},
+				}
+				return mc
+			}(),
+			want: false,
+		},
+		"LoadBalancerProfileUpToDate": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.LoadBalancerProfile = &v1alpha3.AKSClusterLoadBalancerProfile{ManagedOutboundIPCount: to.IntPtr(2)}
+			})),
+			mc: func() containerservice.ManagedCluster {
+				mc := cluster(version, defaultCount)
+				mc.NetworkProfile = &containerservice.NetworkProfile{
+					LoadBalancerProfile: &containerservice.ManagedClusterLoadBalancerProfile{
+						ManagedOutboundIPs:   &containerservice.ManagedClusterLoadBalancerProfileManagedOutboundIPs{Count: to.Int32Ptr(2)},
+						IdleTimeoutInMinutes: to.Int32Ptr(30),
+					},
+				}
+				return mc
+			}(),
+			want: true,
+		},
+		"LoadBalancerProfileChanged": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.LoadBalancerProfile = &v1alpha3.AKSClusterLoadBalancerProfile{IdleTimeoutInMinutes: to.IntPtr(10)}
+			})),
+			mc: func() containerservice.ManagedCluster {
+				mc := cluster(version, defaultCount)
+				mc.NetworkProfile = &containerservice.NetworkProfile{
+					LoadBalancerProfile: &containerservice.ManagedClusterLoadBalancerProfile{IdleTimeoutInMinutes: to.Int32Ptr(30)},
+				}
+				return mc
+			}(),
+			want: false,
+		},
+		"SpotNodePoolUpToDate": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.NodePools = []v1alpha3.AKSClusterNodePool{{
+					Name:             "spot",
+					VMSize:           vmSize,
+					ScaleSetPriority: string(containerservice.ScaleSetPrioritySpot),
+				}}
+			})),
+			mc: cluster(version, defaultCount, containerservice.ManagedClusterAgentPoolProfile{
+				Name:             to.StringPtr("spot"),
+				Count:            to.Int32Ptr(1),
+				VMSize:           to.StringPtr(vmSize),
+				ScaleSetPriority: containerservice.ScaleSetPrioritySpot,
+				NodeTaints:       &[]string{SpotNodeTaint},
+				NodeLabels:       map[string]*string{SpotNodeLabelKey: to.StringPtr("spot")},
+				Tags:             inlineNodePoolTags(),
+			}),
+			want: true,
+		},
+		"NodePoolRemoved": {
+			c:    aksCluster(),
+			mc:   cluster(version, defaultCount, gpuPool),
+			want: false,
+		},
+		"StandaloneNodePool": {
+			c: aksCluster(),
+			mc: cluster(version, defaultCount, containerservice.ManagedClusterAgentPoolProfile{
+				Name:  to.StringPtr("standalone"),
+				Count: to.Int32Ptr(3),
+			}),
+			want: true,
+		},
+		"AutoScalingUpToDate": {
+			c:    autoScaling,
+			mc:   autoScaled(to.Int32Ptr(1), &count),
+			want: true,
+		},
+		"AutoScalingBoundsChanged": {
+			c:    autoScaling,
+			mc:   autoScaled(to.Int32Ptr(2), &count),
+			want: false,
+		},
+		"AutoScalingDisabled": {
+			c:    aksCluster(),
+			mc:   autoScaled(to.Int32Ptr(1), &count),
+			want: false,
+		},
+		"TagsChanged": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.Tags = map[string]string{"team": "cool"}
+			})),
+			mc:   cluster(version, int32(v1alpha3.DefaultNodeCount)),
+			want: false,
+		},
+		"MonitoringUpToDate": {
+			c:    monitored,
+			mc:   withMonitoring(cluster(version, defaultCount), true, strings.ToLower(workspaceID)),
+			want: true,
+		},
+		"MonitoringMissing": {
+			c:    monitored,
+			mc:   cluster(version, defaultCount),
+			want: false,
+		},
+		"MonitoringWorkspaceChanged": {
+			c:    monitored,
+			mc:   withMonitoring(cluster(version, defaultCount), true, "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.OperationalInsights/workspaces/other"),
+			want: false,
+		},
+		"MonitoringRemoved": {
+			c:    aksCluster(),
+			mc:   withMonitoring(cluster(version, defaultCount), true, workspaceID),
+			want: false,
+		},
+		"MonitoringDisabled": {
+			c:    aksCluster(),
+			mc:   withMonitoring(cluster(version, defaultCount), false, ""),
+			want: true,
+		},
+		"MonitoringExplicitlyDisabled": {
+			c: aksCluster(withSpec(func(p *v1alpha3.AKSClusterParameters) {
+				p.LogAnalyticsWorkspaceID = workspaceID
+				p.AddonProfiles = &v1alpha3.AKSClusterAddonProfiles{Monitoring: &v1alpha3.AKSClusterAddonProfile{Enabled: false}}
+			})),
+			mc:   withMonitoring(cluster(version, defaultCount), true, workspaceID),
+			want: false,
+		},
+		"AddonUpToDate": {
+			c:    withAddon(&v1alpha3.AKSClusterAddonProfiles{AzurePolicy: &v1alpha3.AKSClusterAddonProfile{Enabled: true}}),
+			mc:   withAddonProfile(cluster(version, defaultCount), AzurePolicyAddonName, true),
+			want: true,
+		},
+		"AddonNeedsEnabling": {
+			c:    withAddon(&v1alpha3.AKSClusterAddonProfiles{AzurePolicy: &v1alpha3.AKSClusterAddonProfile{Enabled: true}}),
+			mc:   cluster(version, defaultCount),
+			want: false,
+		},
+		"AddonNeedsDisabling": {
+			c:    withAddon(&v1alpha3.AKSClusterAddonProfiles{HTTPApplicationRouting: &v1alpha3.AKSClusterAddonProfile{Enabled: false}}),
+			mc:   withAddonProfile(cluster(version, defaultCount), HTTPApplicationRoutingAddonName, true),
+			want: false,
+		},
+		"AddonUnmanaged": {
+			c:    aksCluster(),
+			mc:   withAddonProfile(cluster(version, defaultCount), KubeDashboardAddonName, true),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.c.Spec.AKSClusterParameters, tc.mc)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	count := int32(3)
+	mc := containerservice.ManagedCluster{
+		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			KubernetesVersion: to.StringPtr("1.22.6"),
+			DNSPrefix:         to.StringPtr("cool-dns"),
+			NodeResourceGroup: to.StringPtr("MC_cool"),
+			AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{{
+				Name:         to.StringPtr(AgentPoolProfileName),
+				Count:        &count,
+				VMSize:       to.StringPtr("Standard_D2s_v3"),
+				Type:         containerservice.AgentPoolTypeVirtualMachineScaleSets,
+				OsDiskSizeGB: to.Int32Ptr(128),
+				MaxPods:      to.Int32Ptr(110),
+			}},
+			NetworkProfile: &containerservice.NetworkProfile{
+				NetworkPlugin:    containerservice.NetworkPluginKubenet,
+				PodCidr:          to.StringPtr("10.244.0.0/16"),
+				ServiceCidr:      to.StringPtr("10.0.0.0/16"),
+				DNSServiceIP:     to.StringPtr("10.0.0.10"),
+				DockerBridgeCidr: to.StringPtr("172.17.0.1/16"),
+				OutboundType:     containerservice.OutboundTypeLoadBalancer,
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha3.AKSClusterParameters
+		mc   containerservice.ManagedCluster
+		want v1alpha3.AKSClusterParameters
+	}{
+		"NotObserved": {
+			p:    v1alpha3.AKSClusterParameters{Version: "1.22"},
+			want: v1alpha3.AKSClusterParameters{Version: "1.22"},
+		},
+		"Empty": {
+			p:  v1alpha3.AKSClusterParameters{Version: "1.22"},
+			mc: mc,
+			want: v1alpha3.AKSClusterParameters{
+				Version:           "1.22.6",
+				DNSNamePrefix:     "cool-dns",
+				NodeResourceGroup: "MC_cool",
+				NodeVMSize:        "Standard_D2s_v3",
+				NodeCount:         to.IntPtr(3),
+				AgentPoolType:     string(containerservice.AgentPoolTypeVirtualMachineScaleSets),
+				NodeOSDiskSizeGB:  to.IntPtr(128),
+				NodeMaxPods:       to.IntPtr(110),
+				NetworkPlugin:     string(containerservice.NetworkPluginKubenet),
+				PodCIDR:           "10.244.0.0/16",
+				ServiceCIDR:       "10.0.0.0/16",
+				DNSServiceIP:      "10.0.0.10",
+				DockerBridgeCIDR:  "172.17.0.1/16",
+				OutboundType:      string(containerservice.OutboundTypeLoadBalancer),
+			},
+		},
+		"Set": {
+			p: v1alpha3.AKSClusterParameters{
+				Version:           "1.23",
+				DNSNamePrefix:     "my-dns",
+				NodeResourceGroup: "MC_mine",
+				NodeVMSize:        vmSize,
+				NodeCount:         to.IntPtr(1),
+				AgentPoolType:     string(containerservice.AgentPoolTypeAvailabilitySet),
+				NodeOSDiskSizeGB:  to.IntPtr(64),
+				NodeMaxPods:       to.IntPtr(30),
+				NetworkPlugin:     string(containerservice.NetworkPluginAzure),
+				ServiceCIDR:       "10.1.0.0/16",
+				DNSServiceIP:      "10.1.0.10",
+				DockerBridgeCIDR:  "172.18.0.1/16",
+				OutboundType:      string(containerservice.OutboundTypeUserDefinedRouting),
+			},
+			mc: mc,
+			want: v1alpha3.AKSClusterParameters{
+				Version:           "1.23",
+				DNSNamePrefix:     "my-dns",
+				NodeResourceGroup: "MC_mine",
+				NodeVMSize:        vmSize,
+				NodeCount:         to.IntPtr(1),
+				AgentPoolType:     string(containerservice.AgentPoolTypeAvailabilitySet),
+				NodeOSDiskSizeGB:  to.IntPtr(64),
+				NodeMaxPods:       to.IntPtr(30),
+				NetworkPlugin:     string(containerservice.NetworkPluginAzure),
+				PodCIDR:           "10.244.0.0/16",
+				ServiceCIDR:       "10.1.0.0/16",
+				DNSServiceIP:      "10.1.0.10",
+				DockerBridgeCIDR:  "172.18.0.1/16",
+				OutboundType:      string(containerservice.OutboundTypeUserDefinedRouting),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(&tc.p, tc.mc)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateVMSize(t *testing.T) {
+	vm := func(name string, locations []string, r ...compute.ResourceSkuRestrictions) compute.ResourceSku {
+		sku := compute.ResourceSku{
+			ResourceType: to.StringPtr(resourceTypeVirtualMachines),
+			Name:         to.StringPtr(name),
+			Locations:    &locations,
+		}
+		if len(r) > 0 {
+			sku.Restrictions = &r
+		}
+		return sku
+	}
+
+	cases := map[string]struct {
+		skus []compute.ResourceSku
+		size string
+		want error
+	}{
+		"LocationNotAvailable": {
+			size: vmSize,
+			want: errors.Errorf("location %s is not available to the subscription", location),
+		},
+		"SizeNotOffered": {
+			skus: []compute.ResourceSku{vm("Standard_D2s_v3", []string{location})},
+			size: vmSize,
+			want: errors.Errorf("VM size %s is not offered in location %s", vmSize, location),
+		},
+		"SizeRestricted": {
+			skus: []compute.ResourceSku{vm(vmSize, []string{location}, compute.ResourceSkuRestrictions{
+				Type:       compute.ResourceSkuRestrictionsTypeLocation,
+				Values:     &[]string{location},
+				ReasonCode: compute.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription,
+			})},
+			size: vmSize,
+			want: errors.Errorf("VM size %s is not available to the subscription in location %s: NotAvailableForSubscription", vmSize, location),
+		},
+		"SizeRestrictedInZone": {
+			skus: []compute.ResourceSku{vm(vmSize, []string{location}, compute.ResourceSkuRestrictions{
+				Type:       compute.ResourceSkuRestrictionsTypeZone,
+				Values:     &[]string{location},
+				ReasonCode: compute.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription,
+			})},
+			size: vmSize,
+		},
+		"SizeAvailable": {
+			skus: []compute.ResourceSku{
+				{ResourceType: to.StringPtr("disks"), Name: to.StringPtr("Premium_LRS")},
+				vm("standard_b2s", []string{"WestUS2"}),
+			},
+			size: vmSize,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ValidateVMSize(tc.skus, location, tc.size)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateVMSize(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckQuota(t *testing.T) {
+	sku := compute.ResourceSku{
+		Name:         to.StringPtr(vmSize),
+		Family:       to.StringPtr("standardBSFamily"),
+		Capabilities: &[]compute.ResourceSkuCapabilities{{Name: to.StringPtr(capabilityVCPUs), Value: to.StringPtr("2")}},
+	}
+	usage := func(name, localized string, current int32, limit int64) compute.Usage {
+		return compute.Usage{
+			Name:         &compute.UsageName{Value: to.StringPtr(name), LocalizedValue: to.StringPtr(localized)},
+			CurrentValue: &current,
+			Limit:        &limit,
+		}
+	}
+
+	cases := map[string]struct {
+		usages []compute.Usage
+		count  int
+		want   error
+	}{
+		"EnoughQuota": {
+			usages: []compute.Usage{
+				usage("cores", "Total Regional vCPUs", 4, 10),
+				usage("standardBSFamily", "Standard BS Family vCPUs", 0, 10),
+				usage("standardDSv3Family", "Standard DSv3 Family vCPUs", 10, 10),
+			},
+			count: nodeCount,
+		},
+		"FamilyQuotaExceeded": {
+			usages: []compute.Usage{
+				usage("cores", "Total Regional vCPUs", 0, 100),
+				usage("standardBSFamily", "Standard BS Family vCPUs", 6, 10),
+			},
+			count: nodeCount,
+			want: quotaExceededError{errors.Errorf("%d VMs of size %s require %d vCPUs but only %d of the %d %s quota are available",
+				nodeCount, vmSize, 6, 4, 10, "Standard BS Family vCPUs")},
+		},
+		"RegionalQuotaExceeded": {
+			usages: []compute.Usage{
+				usage("cores", "Total Regional vCPUs", 9, 10),
+			},
+			count: 1,
+			want: quotaExceededError{errors.Errorf("%d VMs of size %s require %d vCPUs but only %d of the %d %s quota are available",
+				1, vmSize, 2, 1, 10, "Total Regional vCPUs")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := CheckQuota(tc.usages, sku, tc.count)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("CheckQuota(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want != nil, IsQuotaExceeded(err)); diff != "" {
+				t.Errorf("IsQuotaExceeded(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateZones(t *testing.T) {
+	sku := func(r ...compute.ResourceSkuRestrictions) compute.ResourceSku {
+		s := compute.ResourceSku{
+			Name: to.StringPtr(vmSize),
+			LocationInfo: &[]compute.ResourceSkuLocationInfo{
+				{Location: to.StringPtr("eastus"), Zones: &[]string{"1", "2", "3"}},
+				{Location: to.StringPtr("WestUS2"), Zones: &[]string{"1", "2", "3"}},
+			},
+		}
+		if len(r) > 0 {
+			s.Restrictions = &r
+		}
+		return s
+	}
+
+	cases := map[string]struct {
+		sku   compute.ResourceSku
+		zones []string
+		want  error
+	}{
+		"NoZones": {
+			sku: compute.ResourceSku{Name: to.StringPtr(vmSize)},
+		},
+		"ZonesAvailable": {
+			sku:   sku(),
+			zones: []string{"1", "2", "3"},
+		},
+		"ZoneNotOffered": {
+			sku:   sku(),
+			zones: []string{"1", "4"},
+			want:  zoneUnavailableError{errors.Errorf("VM size %s is not available in zone %s of location %s", vmSize, "4", location)},
+		},
+		"ZoneRestricted": {
+			sku: sku(compute.ResourceSkuRestrictions{
+				Type:            compute.ResourceSkuRestrictionsTypeZone,
+				RestrictionInfo: &compute.ResourceSkuRestrictionInfo{Locations: &[]string{location}, Zones: &[]string{"2"}},
+			}),
+			zones: []string{"1", "2"},
+			want:  zoneUnavailableError{errors.Errorf("VM size %s is not available in zone %s of location %s", vmSize, "2", location)},
+		},
+		"NoZonesInLocation": {
+			sku: compute.ResourceSku{
+				Name:         to.StringPtr(vmSize),
+				LocationInfo: &[]compute.ResourceSkuLocationInfo{{Location: to.StringPtr(location)}},
+			},
+			zones: []string{"1"},
+			want:  zoneUnavailableError{errors.Errorf("VM size %s does not support availability zones in location %s", vmSize, location)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateZones(tc.sku, location, tc.zones)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateZones(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnexpiredPasswordCredentials(t *testing.T) {
+	now := time.Now()
+	expired := graphrbac.PasswordCredential{
+		KeyID:   to.StringPtr("expired"),
+		EndDate: &date.Time{Time: now.Add(-time.Hour)},
+	}
+	current := graphrbac.PasswordCredential{
+		KeyID:   to.StringPtr("current"),
+		EndDate: &date.Time{Time: now.Add(time.Hour)},
+		Value:   to.StringPtr("hidden"),
+	}
+	pc := graphrbac.PasswordCredential{
+		KeyID:   to.StringPtr("new"),
+		EndDate: &date.Time{Time: now.AddDate(appCredsValidYears, 0, 0)},
+		Value:   to.StringPtr("secret"),
+	}
+
+	cases := map[string]struct {
+		pcs  *[]graphrbac.PasswordCredential
+		want *[]graphrbac.PasswordCredential
+	}{
+		"NoCredentials": {
+			want: &[]graphrbac.PasswordCredential{pc},
+		},
+		"ExpiredCredentialsRemoved": {
+			pcs: &[]graphrbac.PasswordCredential{expired, current},
+			want: &[]graphrbac.PasswordCredential{
+				{KeyID: to.StringPtr("current"), EndDate: current.EndDate},
+				pc,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := unexpiredPasswordCredentials(tc.pcs, pc, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpiredPasswordCredentials(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithoutPasswordCredential(t *testing.T) {
+	current := graphrbac.PasswordCredential{KeyID: to.StringPtr("current")}
+	pc := graphrbac.PasswordCredential{KeyID: to.StringPtr("new")}
+
+	cases := map[string]struct {
+		pcs  *[]graphrbac.PasswordCredential
+		want *[]graphrbac.PasswordCredential
+	}{
+		"NoCredentials": {
+			want: &[]graphrbac.PasswordCredential{},
+		},
+		"CredentialRemoved": {
+			pcs:  &[]graphrbac.PasswordCredential{current, pc},
+			want: &[]graphrbac.PasswordCredential{current},
+		},
+		"CredentialNotFound": {
+			pcs:  &[]graphrbac.PasswordCredential{current},
+			want: &[]graphrbac.PasswordCredential{current},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := withoutPasswordCredential(tc.pcs, "new")
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("withoutPasswordCredential(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLatestExpiry(t *testing.T) {
+	now := time.Now()
+
+	cases := map[string]struct {
+		pcs  *[]graphrbac.PasswordCredential
+		want time.Time
+	}{
+		"NoCredentials": {
+			want: time.Time{},
+		},
+		"Latest": {
+			pcs: &[]graphrbac.PasswordCredential{
+				{EndDate: &date.Time{Time: now}},
+				{EndDate: &date.Time{Time: now.AddDate(1, 0, 0)}},
+				{},
+			},
+			want: now.AddDate(1, 0, 0),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := latestExpiry(tc.pcs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("latestExpiry(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResumeAsyncOperation(t *testing.T) {
+	pollingURL := "https://example.org/stale"
+	cluster := func(state string) containerservice.ManagedCluster {
+		return containerservice.ManagedCluster{
+			ManagedClusterProperties: &containerservice.ManagedClusterProperties{ProvisioningState: to.StringPtr(state)},
+		}
+	}
+
+	cases := map[string]struct {
+		op   azurev1alpha3.AsyncOperation
+		mc   containerservice.ManagedCluster
+		want azurev1alpha3.AsyncOperation
+	}{
+		"NoOperation": {
+			mc: cluster("Upgrading"),
+		},
+		"ClusterProvisioning": {
+			op:   azurev1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: pollingURL, ErrorMessage: "boom"},
+			mc:   cluster("Upgrading"),
+			want: azurev1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: pollingURL, Status: azure.AsyncOperationStatusInProgress, ErrorMessage: "boom"},
+		},
+		"NoClusterProperties": {
+			op:   azurev1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: pollingURL},
+			want: azurev1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: pollingURL, Status: azure.AsyncOperationStatusInProgress},
+		},
+		"ClusterSucceeded": {
+			op:   azurev1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: pollingURL, Status: azure.AsyncOperationStatusInProgress},
+			mc:   cluster("Succeeded"),
+			want: azurev1alpha3.AsyncOperation{Method: http.MethodPut, Status: "Succeeded"},
+		},
+		"ClusterFailed": {
+			op:   azurev1alpha3.AsyncOperation{Method: http.MethodPost, PollingURL: pollingURL},
+			mc:   cluster("Failed"),
+			want: azurev1alpha3.AsyncOperation{Method: http.MethodPost, Status: "Failed"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ResumeAsyncOperation(&tc.op, tc.mc)
+			if diff := cmp.Diff(tc.want, tc.op); diff != "" {
+				t.Errorf("ResumeAsyncOperation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// NewDisk returns a managed disk that matches the supplied parameters. The
+// disk is a copy of the source snapshot if one is specified, and empty
+// otherwise.
+func NewDisk(p v1alpha3.DiskParameters) compute.Disk {
+	cd := &compute.CreationData{CreateOption: compute.DiskCreateOptionEmpty}
+	if p.SourceSnapshotID != nil {
+		cd = &compute.CreationData{CreateOption: compute.DiskCreateOptionCopy, SourceResourceID: p.SourceSnapshotID}
+	}
+	d := compute.Disk{
+		Location: azure.ToStringPtr(p.Location),
+		Zones:    azure.ToStringArrayPtr(p.Zones),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+		DiskProperties: &compute.DiskProperties{
+			CreationData: cd,
+			DiskSizeGB:   p.DiskSizeGB,
+		},
+	}
+	if p.SKU != nil {
+		d.Sku = &compute.DiskSku{Name: compute.DiskStorageAccountTypes(*p.SKU)}
+	}
+	return d
+}
+
+// NewDiskUpdate returns an update of a managed disk to the SKU, size and
+// tags of the supplied parameters.
+func NewDiskUpdate(p v1alpha3.DiskParameters) compute.DiskUpdate {
+	u := compute.DiskUpdate{
+		Tags:                 azure.ToStringPtrMap(p.Tags),
+		DiskUpdateProperties: &compute.DiskUpdateProperties{DiskSizeGB: p.DiskSizeGB},
+	}
+	if p.SKU != nil {
+		u.Sku = &compute.DiskSku{Name: compute.DiskStorageAccountTypes(*p.SKU)}
+	}
+	return u
+}
+
+// GenerateDiskObservation returns the observed state of the supplied managed
+// disk.
+func GenerateDiskObservation(az compute.Disk) v1alpha3.DiskObservation {
+	o := v1alpha3.DiskObservation{ID: azure.ToString(az.ID)}
+	if az.DiskProperties != nil {
+		o.UniqueID = azure.ToString(az.UniqueID)
+		o.DiskState = string(az.DiskState)
+		o.ProvisioningState = azure.ToString(az.DiskProperties.ProvisioningState)
+	}
+	return o
+}
+
+// LateInitializeDisk fills the empty fields of the supplied parameters with
+// the values of the supplied managed disk.
+func LateInitializeDisk(p *v1alpha3.DiskParameters, az compute.Disk) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, az.Tags)
+	p.Zones = azure.LateInitializeStringValArrFromArrPtr(p.Zones, az.Zones)
+	if az.Sku != nil && az.Sku.Name != "" {
+		p.SKU = azure.LateInitializeStringPtrFromVal(p.SKU, string(az.Sku.Name))
+	}
+	if az.DiskProperties != nil {
+		p.DiskSizeGB = azure.LateInitializeInt32PtrFromInt32Ptr(p.DiskSizeGB, az.DiskSizeGB)
+	}
+}
+
+// IsDiskUpToDate returns true if the SKU, size and tags of the supplied
+// managed disk match the supplied parameters.
+func IsDiskUpToDate(p v1alpha3.DiskParameters, az compute.Disk) bool {
+	if az.DiskProperties == nil {
+		return false
+	}
+	if p.SKU != nil && (az.Sku == nil || *p.SKU != string(az.Sku.Name)) {
+		return false
+	}
+	if p.DiskSizeGB != nil && (az.DiskSizeGB == nil || *p.DiskSizeGB != *az.DiskSizeGB) {
+		return false
+	}
+	return cmp.Equal(p.Tags, azure.ToStringMap(az.Tags), cmpopts.EquateEmpty())
+}
+
+// NewSnapshot returns a snapshot that matches the supplied parameters.
+func NewSnapshot(p v1alpha3.SnapshotParameters) compute.Snapshot {
+	s := compute.Snapshot{
+		Location: azure.ToStringPtr(p.Location),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+		SnapshotProperties: &compute.SnapshotProperties{
+			CreationData: &compute.CreationData{CreateOption: compute.DiskCreateOptionCopy, SourceResourceID: p.SourceDiskID},
+			Incremental:  p.Incremental,
+		},
+	}
+	if p.SKU != nil {
+		s.Sku = &compute.SnapshotSku{Name: compute.SnapshotStorageAccountTypes(*p.SKU)}
+	}
+	return s
+}
+
+// NewSnapshotUpdate returns an update of a snapshot to the tags of the
+// supplied parameters. No other parameters can be updated.
+func NewSnapshotUpdate(p v1alpha3.SnapshotParameters) compute.SnapshotUpdate {
+	return compute.SnapshotUpdate{Tags: azure.ToStringPtrMap(p.Tags)}
+}
+
+// GenerateSnapshotObservation returns the observed state of the supplied
+// snapshot.
+func GenerateSnapshotObservation(az compute.Snapshot) v1alpha3.SnapshotObservation {
+	o := v1alpha3.SnapshotObservation{ID: azure.ToString(az.ID)}
+	if az.SnapshotProperties != nil {
+		o.UniqueID = azure.ToString(az.UniqueID)
+		o.DiskSizeGB = az.DiskSizeGB
+		o.ProvisioningState = azure.ToString(az.SnapshotProperties.ProvisioningState)
+	}
+	return o
+}
+
+// LateInitializeSnapshot fills the empty fields of the supplied parameters
+// with the values of the supplied snapshot.
+func LateInitializeSnapshot(p *v1alpha3.SnapshotParameters, az compute.Snapshot) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, az.Tags)
+	if az.Sku != nil && az.Sku.Name != "" {
+		p.SKU = azure.LateInitializeStringPtrFromVal(p.SKU, string(az.Sku.Name))
+	}
+	if az.SnapshotProperties != nil && p.Incremental == nil {
+		p.Incremental = az.Incremental
+	}
+}
+
+// IsSnapshotUpToDate returns true if the tags of the supplied snapshot match
+// the supplied parameters.
+func IsSnapshotUpToDate(p v1alpha3.SnapshotParameters, az compute.Snapshot) bool {
+	return cmp.Equal(p.Tags, azure.ToStringMap(az.Tags), cmpopts.EquateEmpty())
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+)
+
+const snapshotID = "/subscriptions/sub/resourceGroups/cool-rg/providers/Microsoft.Compute/snapshots/cool-snapshot"
+
+func TestNewDisk(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.DiskParameters
+		want compute.Disk
+	}{
+		"Empty": {
+			p: v1alpha3.DiskParameters{
+				Location:   location,
+				Zones:      []string{"1"},
+				SKU:        to.StringPtr("Premium_LRS"),
+				DiskSizeGB: to.Int32Ptr(64),
+				Tags:       map[string]string{"cool": "disk"},
+			},
+			want: compute.Disk{
+				Location: to.StringPtr(location),
+				Zones:    &[]string{"1"},
+				Tags:     map[string]*string{"cool": to.StringPtr("disk")},
+				Sku:      &compute.DiskSku{Name: compute.DiskStorageAccountTypesPremiumLRS},
+				DiskProperties: &compute.DiskProperties{
+					CreationData: &compute.CreationData{CreateOption: compute.DiskCreateOptionEmpty},
+					DiskSizeGB:   to.Int32Ptr(64),
+				},
+			},
+		},
+		"FromSnapshot": {
+			p: v1alpha3.DiskParameters{
+				Location:         location,
+				SourceSnapshotID: to.StringPtr(snapshotID),
+			},
+			want: compute.Disk{
+				Location: to.StringPtr(location),
+				DiskProperties: &compute.DiskProperties{
+					CreationData: &compute.CreationData{CreateOption: compute.DiskCreateOptionCopy, SourceResourceID: to.StringPtr(snapshotID)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewDisk(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewDisk(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeDisk(t *testing.T) {
+	az := compute.Disk{
+		Zones:          &[]string{"2"},
+		Tags:           map[string]*string{"cool": to.StringPtr("disk")},
+		Sku:            &compute.DiskSku{Name: compute.DiskStorageAccountTypesStandardLRS},
+		DiskProperties: &compute.DiskProperties{DiskSizeGB: to.Int32Ptr(30)},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha3.DiskParameters
+		want v1alpha3.DiskParameters
+	}{
+		"AllEmpty": {
+			p: v1alpha3.DiskParameters{},
+			want: v1alpha3.DiskParameters{
+				Zones:      []string{"2"},
+				Tags:       map[string]string{"cool": "disk"},
+				SKU:        to.StringPtr("Standard_LRS"),
+				DiskSizeGB: to.Int32Ptr(30),
+			},
+		},
+		"AllSet": {
+			p: v1alpha3.DiskParameters{
+				Zones:      []string{"1"},
+				Tags:       map[string]string{"other": "disk"},
+				SKU:        to.StringPtr("Premium_LRS"),
+				DiskSizeGB: to.Int32Ptr(64),
+			},
+			want: v1alpha3.DiskParameters{
+				Zones:      []string{"1"},
+				Tags:       map[string]string{"other": "disk"},
+				SKU:        to.StringPtr("Premium_LRS"),
+				DiskSizeGB: to.Int32Ptr(64),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeDisk(&tc.p, az)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeDisk(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDiskUpToDate(t *testing.T) {
+	az := compute.Disk{
+		Tags:           map[string]*string{"cool": to.StringPtr("disk")},
+		Sku:            &compute.DiskSku{Name: compute.DiskStorageAccountTypesStandardLRS},
+		DiskProperties: &compute.DiskProperties{DiskSizeGB: to.Int32Ptr(30)},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha3.DiskParameters
+		az   compute.Disk
+		want bool
+	}{
+		"NoProperties": {
+			p:    v1alpha3.DiskParameters{},
+			az:   compute.Disk{},
+			want: false,
+		},
+		"UpToDate": {
+			p: v1alpha3.DiskParameters{
+				Tags:       map[string]string{"cool": "disk"},
+				SKU:        to.StringPtr("Standard_LRS"),
+				DiskSizeGB: to.Int32Ptr(30),
+			},
+			az:   az,
+			want: true,
+		},
+		"SKUChanged": {
+			p: v1alpha3.DiskParameters{
+				Tags: map[string]string{"cool": "disk"},
+				SKU:  to.StringPtr("Premium_LRS"),
+			},
+			az:   az,
+			want: false,
+		},
+		"SizeChanged": {
+			p: v1alpha3.DiskParameters{
+				Tags:       map[string]string{"cool": "disk"},
+				DiskSizeGB: to.Int32Ptr(64),
+			},
+			az:   az,
+			want: false,
+		},
+		"TagsChanged": {
+			p:    v1alpha3.DiskParameters{},
+			az:   az,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDiskUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsDiskUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewSnapshot(t *testing.T) {
+	p := v1alpha3.SnapshotParameters{
+		Location:     location,
+		SKU:          to.StringPtr("Standard_ZRS"),
+		SourceDiskID: to.StringPtr(diskID),
+		Incremental:  to.BoolPtr(true),
+		Tags:         map[string]string{"cool": "snapshot"},
+	}
+	want := compute.Snapshot{
+		Location: to.StringPtr(location),
+		Tags:     map[string]*string{"cool": to.StringPtr("snapshot")},
+		Sku:      &compute.SnapshotSku{Name: compute.SnapshotStorageAccountTypesStandardZRS},
+		SnapshotProperties: &compute.SnapshotProperties{
+			CreationData: &compute.CreationData{CreateOption: compute.DiskCreateOptionCopy, SourceResourceID: to.StringPtr(diskID)},
+			Incremental:  to.BoolPtr(true),
+		},
+	}
+	if diff := cmp.Diff(want, NewSnapshot(p)); diff != "" {
+		t.Errorf("NewSnapshot(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSnapshot(t *testing.T) {
+	az := compute.Snapshot{
+		Tags:               map[string]*string{"cool": to.StringPtr("snapshot")},
+		Sku:                &compute.SnapshotSku{Name: compute.SnapshotStorageAccountTypesStandardLRS},
+		SnapshotProperties: &compute.SnapshotProperties{Incremental: to.BoolPtr(false)},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha3.SnapshotParameters
+		want v1alpha3.SnapshotParameters
+	}{
+		"AllEmpty": {
+			p: v1alpha3.SnapshotParameters{},
+			want: v1alpha3.SnapshotParameters{
+				Tags:        map[string]string{"cool": "snapshot"},
+				SKU:         to.StringPtr("Standard_LRS"),
+				Incremental: to.BoolPtr(false),
+			},
+		},
+		"AllSet": {
+			p: v1alpha3.SnapshotParameters{
+				Tags:        map[string]string{"other": "snapshot"},
+				SKU:         to.StringPtr("Premium_LRS"),
+				Incremental: to.BoolPtr(true),
+			},
+			want: v1alpha3.SnapshotParameters{
+				Tags:        map[string]string{"other": "snapshot"},
+				SKU:         to.StringPtr("Premium_LRS"),
+				Incremental: to.BoolPtr(true),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSnapshot(&tc.p, az)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeSnapshot(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
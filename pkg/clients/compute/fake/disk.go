@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute/computeapi"
+)
+
+var _ computeapi.DisksClientAPI = &MockDisksClient{}
+
+// MockDisksClient is a fake implementation of compute.DisksClient.
+type MockDisksClient struct {
+	computeapi.DisksClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, diskName string, disk compute.Disk) (result compute.DisksCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, diskName string) (result compute.DisksDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, diskName string) (result compute.Disk, err error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, diskName string, disk compute.DiskUpdate) (result compute.DisksUpdateFuture, err error)
+}
+
+// CreateOrUpdate calls the MockDisksClient's MockCreateOrUpdate method.
+func (c *MockDisksClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, diskName string, disk compute.Disk) (result compute.DisksCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, diskName, disk)
+}
+
+// Delete calls the MockDisksClient's MockDelete method.
+func (c *MockDisksClient) Delete(ctx context.Context, resourceGroupName string, diskName string) (result compute.DisksDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, diskName)
+}
+
+// Get calls the MockDisksClient's MockGet method.
+func (c *MockDisksClient) Get(ctx context.Context, resourceGroupName string, diskName string) (result compute.Disk, err error) {
+	return c.MockGet(ctx, resourceGroupName, diskName)
+}
+
+// Update calls the MockDisksClient's MockUpdate method.
+func (c *MockDisksClient) Update(ctx context.Context, resourceGroupName string, diskName string, disk compute.DiskUpdate) (result compute.DisksUpdateFuture, err error) {
+	return c.MockUpdate(ctx, resourceGroupName, diskName, disk)
+}
+
+var _ computeapi.SnapshotsClientAPI = &MockSnapshotsClient{}
+
+// MockSnapshotsClient is a fake implementation of compute.SnapshotsClient.
+type MockSnapshotsClient struct {
+	computeapi.SnapshotsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, snapshotName string, snapshot compute.Snapshot) (result compute.SnapshotsCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, snapshotName string) (result compute.SnapshotsDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, snapshotName string) (result compute.Snapshot, err error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, snapshotName string, snapshot compute.SnapshotUpdate) (result compute.SnapshotsUpdateFuture, err error)
+}
+
+// CreateOrUpdate calls the MockSnapshotsClient's MockCreateOrUpdate method.
+func (c *MockSnapshotsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, snapshotName string, snapshot compute.Snapshot) (result compute.SnapshotsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, snapshotName, snapshot)
+}
+
+// Delete calls the MockSnapshotsClient's MockDelete method.
+func (c *MockSnapshotsClient) Delete(ctx context.Context, resourceGroupName string, snapshotName string) (result compute.SnapshotsDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, snapshotName)
+}
+
+// Get calls the MockSnapshotsClient's MockGet method.
+func (c *MockSnapshotsClient) Get(ctx context.Context, resourceGroupName string, snapshotName string) (result compute.Snapshot, err error) {
+	return c.MockGet(ctx, resourceGroupName, snapshotName)
+}
+
+// Update calls the MockSnapshotsClient's MockUpdate method.
+func (c *MockSnapshotsClient) Update(ctx context.Context, resourceGroupName string, snapshotName string, snapshot compute.SnapshotUpdate) (result compute.SnapshotsUpdateFuture, err error) {
+	return c.MockUpdate(ctx, resourceGroupName, snapshotName, snapshot)
+}
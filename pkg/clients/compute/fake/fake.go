@@ -18,18 +18,27 @@ package fake
 
 import (
 	"context"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
+	computesdk "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
+	"github.com/Azure/go-autorest/autorest"
 
 	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute"
 )
 
 // AKSClient is a fake AKS client.
 type AKSClient struct {
 	MockGetManagedCluster    func(ctx context.Context, ac *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error)
-	MockEnsureManagedCluster func(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error
+	MockEnsureManagedCluster func(ctx context.Context, ac *v1alpha3.AKSCluster, s compute.ManagedClusterSecrets) error
+	MockUpdateManagedCluster func(ctx context.Context, ac *v1alpha3.AKSCluster) error
 	MockDeleteManagedCluster func(ctx context.Context, ac *v1alpha3.AKSCluster) error
 	MockGetKubeConfig        func(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error)
+	MockGetUserKubeConfig    func(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error)
+	MockGetSecretExpiry      func(ctx context.Context, ac *v1alpha3.AKSCluster) (time.Time, error)
+	MockRotateSecret         func(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) (time.Time, error)
+	MockGetRESTClient        func() autorest.Sender
 }
 
 // GetManagedCluster calls MockGetManagedCluster.
@@ -38,8 +47,13 @@ func (c AKSClient) GetManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluste
 }
 
 // EnsureManagedCluster calls MockEnsureManagedCluster.
-func (c AKSClient) EnsureManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) error {
-	return c.MockEnsureManagedCluster(ctx, ac, secret)
+func (c AKSClient) EnsureManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster, s compute.ManagedClusterSecrets) error {
+	return c.MockEnsureManagedCluster(ctx, ac, s)
+}
+
+// UpdateManagedCluster calls MockUpdateManagedCluster.
+func (c AKSClient) UpdateManagedCluster(ctx context.Context, ac *v1alpha3.AKSCluster) error {
+	return c.MockUpdateManagedCluster(ctx, ac)
 }
 
 // DeleteManagedCluster calls DeleteManagedCluster.
@@ -51,3 +65,89 @@ func (c AKSClient) DeleteManagedCluster(ctx context.Context, ac *v1alpha3.AKSClu
 func (c AKSClient) GetKubeConfig(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error) {
 	return c.MockGetKubeConfig(ctx, ac)
 }
+
+// GetUserKubeConfig calls MockGetUserKubeConfig.
+func (c AKSClient) GetUserKubeConfig(ctx context.Context, ac *v1alpha3.AKSCluster) ([]byte, error) {
+	return c.MockGetUserKubeConfig(ctx, ac)
+}
+
+// GetServicePrincipalSecretExpiry calls MockGetSecretExpiry.
+func (c AKSClient) GetServicePrincipalSecretExpiry(ctx context.Context, ac *v1alpha3.AKSCluster) (time.Time, error) {
+	return c.MockGetSecretExpiry(ctx, ac)
+}
+
+// RotateServicePrincipalSecret calls MockRotateSecret.
+func (c AKSClient) RotateServicePrincipalSecret(ctx context.Context, ac *v1alpha3.AKSCluster, secret string) (time.Time, error) {
+	return c.MockRotateSecret(ctx, ac, secret)
+}
+
+// GetRESTClient calls MockGetRESTClient.
+func (c AKSClient) GetRESTClient() autorest.Sender {
+	return c.MockGetRESTClient()
+}
+
+var _ compute.AKSNodePoolAPI = &MockAKSNodePoolAPI{}
+
+// MockAKSNodePoolAPI is a fake implementation of compute.AKSNodePoolAPI.
+type MockAKSNodePoolAPI struct {
+	MockGet            func(ctx context.Context, np *v1alpha3.AKSNodePool) (containerservice.AgentPool, error)
+	MockCreateOrUpdate func(ctx context.Context, np *v1alpha3.AKSNodePool) error
+	MockDelete         func(ctx context.Context, np *v1alpha3.AKSNodePool) error
+	MockGetRESTClient  func() autorest.Sender
+}
+
+// Get calls the MockAKSNodePoolAPI's MockGet method.
+func (m *MockAKSNodePoolAPI) Get(ctx context.Context, np *v1alpha3.AKSNodePool) (containerservice.AgentPool, error) {
+	return m.MockGet(ctx, np)
+}
+
+// CreateOrUpdate calls the MockAKSNodePoolAPI's MockCreateOrUpdate method.
+func (m *MockAKSNodePoolAPI) CreateOrUpdate(ctx context.Context, np *v1alpha3.AKSNodePool) error {
+	return m.MockCreateOrUpdate(ctx, np)
+}
+
+// Delete calls the MockAKSNodePoolAPI's MockDelete method.
+func (m *MockAKSNodePoolAPI) Delete(ctx context.Context, np *v1alpha3.AKSNodePool) error {
+	return m.MockDelete(ctx, np)
+}
+
+// GetRESTClient calls the MockAKSNodePoolAPI's MockGetRESTClient method.
+func (m *MockAKSNodePoolAPI) GetRESTClient() autorest.Sender {
+	return m.MockGetRESTClient()
+}
+
+var _ compute.VirtualMachineAPI = &MockVirtualMachineAPI{}
+
+// MockVirtualMachineAPI is a fake implementation of compute.VirtualMachineAPI.
+type MockVirtualMachineAPI struct {
+	MockGet           func(ctx context.Context, vm *v1alpha3.VirtualMachine) (computesdk.VirtualMachine, error)
+	MockCreate        func(ctx context.Context, vm *v1alpha3.VirtualMachine, password string) error
+	MockUpdate        func(ctx context.Context, vm *v1alpha3.VirtualMachine) error
+	MockDelete        func(ctx context.Context, vm *v1alpha3.VirtualMachine) error
+	MockGetRESTClient func() autorest.Sender
+}
+
+// Get calls the MockVirtualMachineAPI's MockGet method.
+func (m *MockVirtualMachineAPI) Get(ctx context.Context, vm *v1alpha3.VirtualMachine) (computesdk.VirtualMachine, error) {
+	return m.MockGet(ctx, vm)
+}
+
+// Create calls the MockVirtualMachineAPI's MockCreate method.
+func (m *MockVirtualMachineAPI) Create(ctx context.Context, vm *v1alpha3.VirtualMachine, password string) error {
+	return m.MockCreate(ctx, vm, password)
+}
+
+// Update calls the MockVirtualMachineAPI's MockUpdate method.
+func (m *MockVirtualMachineAPI) Update(ctx context.Context, vm *v1alpha3.VirtualMachine) error {
+	return m.MockUpdate(ctx, vm)
+}
+
+// Delete calls the MockVirtualMachineAPI's MockDelete method.
+func (m *MockVirtualMachineAPI) Delete(ctx context.Context, vm *v1alpha3.VirtualMachine) error {
+	return m.MockDelete(ctx, vm)
+}
+
+// GetRESTClient calls the MockVirtualMachineAPI's MockGetRESTClient method.
+func (m *MockVirtualMachineAPI) GetRESTClient() autorest.Sender {
+	return m.MockGetRESTClient()
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the c.Specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// AKSNodePoolAPI represents the API interface for an AKS node pool client.
+type AKSNodePoolAPI interface {
+	Get(ctx context.Context, np *v1alpha3.AKSNodePool) (containerservice.AgentPool, error)
+	CreateOrUpdate(ctx context.Context, np *v1alpha3.AKSNodePool) error
+	Delete(ctx context.Context, np *v1alpha3.AKSNodePool) error
+	GetRESTClient() autorest.Sender
+}
+
+// AKSNodePoolClient is the concrete implementation of the AKSNodePoolAPI
+// interface that calls Azure API.
+type AKSNodePoolClient struct {
+	containerservice.AgentPoolsClient
+}
+
+// NewAKSNodePoolClient creates and initializes an AKSNodePoolClient instance.
+func NewAKSNodePoolClient(cl containerservice.AgentPoolsClient) *AKSNodePoolClient {
+	return &AKSNodePoolClient{
+		AgentPoolsClient: cl,
+	}
+}
+
+// GetRESTClient returns the underlying REST client that the client object uses.
+func (c *AKSNodePoolClient) GetRESTClient() autorest.Sender {
+	return c.AgentPoolsClient.Client
+}
+
+// Get retrieves the requested node pool.
+func (c *AKSNodePoolClient) Get(ctx context.Context, np *v1alpha3.AKSNodePool) (containerservice.AgentPool, error) {
+	return c.AgentPoolsClient.Get(ctx, np.Spec.ForProvider.ResourceGroupName, np.Spec.ForProvider.ClusterName, meta.GetExternalName(np))
+}
+
+// CreateOrUpdate creates or updates the supplied node pool, and records the
+// started operation in its status.
+func (c *AKSNodePoolClient) CreateOrUpdate(ctx context.Context, np *v1alpha3.AKSNodePool) error {
+	p := np.Spec.ForProvider
+	op, err := c.AgentPoolsClient.CreateOrUpdate(ctx, p.ResourceGroupName, p.ClusterName, meta.GetExternalName(np), NewAgentPoolParameters(p))
+	if err != nil {
+		return err
+	}
+	np.Status.AtProvider.LastOperation = azure.NewAsyncOperation(http.MethodPut, op.FutureAPI)
+	return nil
+}
+
+// Delete deletes the supplied node pool, and records the started operation
+// in its status.
+func (c *AKSNodePoolClient) Delete(ctx context.Context, np *v1alpha3.AKSNodePool) error {
+	op, err := c.AgentPoolsClient.Delete(ctx, np.Spec.ForProvider.ResourceGroupName, np.Spec.ForProvider.ClusterName, meta.GetExternalName(np))
+	if err != nil {
+		return err
+	}
+	np.Status.AtProvider.LastOperation = azure.NewAsyncOperation(http.MethodDelete, op.FutureAPI)
+	return nil
+}
+
+// NewAgentPoolParameters returns the agent pool described by the supplied
+// AKSNodePoolParameters.
+func NewAgentPoolParameters(p v1alpha3.AKSNodePoolParameters) containerservice.AgentPool {
+	return containerservice.AgentPool{
+		ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+			Count:               to.Int32Ptr(nodePoolCount(p.Count)),
+			VMSize:              to.StringPtr(p.VMSize),
+			OsType:              osType(p.OSType),
+			OrchestratorVersion: azure.ToStringPtr(p.Version),
+			VnetSubnetID:        azure.ToStringPtr(p.VnetSubnetID),
+			AvailabilityZones:   azure.ToStringArrayPtr(p.Zones),
+			NodeTaints:          azure.ToStringArrayPtr(p.Taints),
+			NodeLabels:          azure.ToStringPtrMap(p.Labels),
+			Tags:                azure.ToStringPtrMap(p.Tags),
+			Mode:                containerservice.AgentPoolModeUser,
+			Type:                containerservice.AgentPoolTypeVirtualMachineScaleSets,
+		},
+	}
+}
+
+// UpdateAKSNodePoolObservation produces AKSNodePoolObservation from
+// containerservice.AgentPool.
+func UpdateAKSNodePoolObservation(o *v1alpha3.AKSNodePoolObservation, in containerservice.AgentPool) {
+	o.ID = azure.ToString(in.ID)
+	if in.ManagedClusterAgentPoolProfileProperties == nil {
+		return
+	}
+	o.ProvisioningState = azure.ToString(in.ProvisioningState)
+	o.Version = azure.ToString(in.OrchestratorVersion)
+}
+
+// LateInitializeAKSNodePool fills the empty fields of the supplied parameters
+// with the values Azure chose for the supplied node pool. The Kubernetes
+// version is not late initialized, so that node pools without one keep
+// following the version of their cluster.
+func LateInitializeAKSNodePool(p *v1alpha3.AKSNodePoolParameters, in containerservice.AgentPool) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+	if in.ManagedClusterAgentPoolProfileProperties == nil {
+		return
+	}
+	p.Count = azure.LateInitializeIntPtrFromInt32Ptr(p.Count, in.Count)
+	if p.OSType == "" {
+		p.OSType = string(in.OsType)
+	}
+	if p.VnetSubnetID == "" {
+		p.VnetSubnetID = azure.ToString(in.VnetSubnetID)
+	}
+	p.Zones = azure.LateInitializeStringValArrFromArrPtr(p.Zones, in.AvailabilityZones)
+}
+
+// IsAKSNodePoolUpToDate is used to report whether the supplied agent pool is
+// in sync with the AKSNodePoolParameters that the user desires. The version is
+// only compared when the user specified one.
+func IsAKSNodePoolUpToDate(p v1alpha3.AKSNodePoolParameters, in containerservice.AgentPool) bool {
+	if in.ManagedClusterAgentPoolProfileProperties == nil {
+		return false
+	}
+	if p.Version != "" && p.Version != azure.ToString(in.OrchestratorVersion) {
+		return false
+	}
+	return to.Int32(in.Count) == nodePoolCount(p.Count) &&
+		cmp.Equal(p.Taints, azure.ToStringArray(in.NodeTaints), cmpopts.EquateEmpty()) &&
+		cmp.Equal(p.Labels, azure.ToStringMap(in.NodeLabels), cmpopts.EquateEmpty()) &&
+		cmp.Equal(p.Tags, azure.ToStringMap(in.Tags), cmpopts.EquateEmpty())
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the c.Specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+)
+
+func nodePoolParameters(m ...func(*v1alpha3.AKSNodePoolParameters)) v1alpha3.AKSNodePoolParameters {
+	p := v1alpha3.AKSNodePoolParameters{
+		ResourceGroupName: "cool-rg",
+		ClusterName:       name,
+		VMSize:            vmSize,
+		Labels:            map[string]string{"gpu": "true"},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func TestNewAgentPoolParameters(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.AKSNodePoolParameters
+		want containerservice.AgentPool
+	}{
+		"Defaults": {
+			p: nodePoolParameters(),
+			want: containerservice.AgentPool{
+				ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+					Count:      to.Int32Ptr(v1alpha3.DefaultNodeCount),
+					VMSize:     to.StringPtr(vmSize),
+					OsType:     containerservice.OSTypeLinux,
+					NodeLabels: map[string]*string{"gpu": to.StringPtr("true")},
+					Mode:       containerservice.AgentPoolModeUser,
+					Type:       containerservice.AgentPoolTypeVirtualMachineScaleSets,
+				},
+			},
+		},
+		"Windows": {
+			p: nodePoolParameters(func(p *v1alpha3.AKSNodePoolParameters) {
+				p.Count = to.IntPtr(nodeCount)
+				p.OSType = string(containerservice.OSTypeWindows)
+				p.Version = version
+				p.Taints = []string{"os=windows:NoSchedule"}
+			}),
+			want: containerservice.AgentPool{
+				ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+					Count:               to.Int32Ptr(int32(nodeCount)),
+					VMSize:              to.StringPtr(vmSize),
+					OsType:              containerservice.OSTypeWindows,
+					OrchestratorVersion: to.StringPtr(version),
+					NodeTaints:          &[]string{"os=windows:NoSchedule"},
+					NodeLabels:          map[string]*string{"gpu": to.StringPtr("true")},
+					Mode:                containerservice.AgentPoolModeUser,
+					Type:                containerservice.AgentPoolTypeVirtualMachineScaleSets,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewAgentPoolParameters(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewAgentPoolParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAKSNodePoolUpToDate(t *testing.T) {
+	observed := func(count int32, v string) containerservice.AgentPool {
+		return containerservice.AgentPool{
+			ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+				Count:               to.Int32Ptr(count),
+				OrchestratorVersion: to.StringPtr(v),
+				NodeLabels:          map[string]*string{"gpu": to.StringPtr("true")},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		p    v1alpha3.AKSNodePoolParameters
+		ap   containerservice.AgentPool
+		want bool
+	}{
+		"UpToDate": {
+			p:    nodePoolParameters(),
+			ap:   observed(v1alpha3.DefaultNodeCount, version),
+			want: true,
+		},
+		"CountChanged": {
+			p:    nodePoolParameters(func(p *v1alpha3.AKSNodePoolParameters) { p.Count = to.IntPtr(nodeCount) }),
+			ap:   observed(v1alpha3.DefaultNodeCount, version),
+			want: false,
+		},
+		"VersionChanged": {
+			p:    nodePoolParameters(func(p *v1alpha3.AKSNodePoolParameters) { p.Version = "1.22.4" }),
+			ap:   observed(v1alpha3.DefaultNodeCount, version),
+			want: false,
+		},
+		"LabelsChanged": {
+			p:    nodePoolParameters(func(p *v1alpha3.AKSNodePoolParameters) { p.Labels = nil }),
+			ap:   observed(v1alpha3.DefaultNodeCount, version),
+			want: false,
+		},
+		"TagsChanged": {
+			p:    nodePoolParameters(func(p *v1alpha3.AKSNodePoolParameters) { p.Tags = map[string]string{"team": "cool"} }),
+			ap:   observed(v1alpha3.DefaultNodeCount, version),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAKSNodePoolUpToDate(tc.p, tc.ap)
+			if got != tc.want {
+				t.Errorf("IsAKSNodePoolUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
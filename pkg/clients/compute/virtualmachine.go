@@ -0,0 +1,286 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// VirtualMachineAPI represents the API interface for a virtual machine
+// client.
+type VirtualMachineAPI interface {
+	Get(ctx context.Context, vm *v1alpha3.VirtualMachine) (compute.VirtualMachine, error)
+	Create(ctx context.Context, vm *v1alpha3.VirtualMachine, password string) error
+	Update(ctx context.Context, vm *v1alpha3.VirtualMachine) error
+	Delete(ctx context.Context, vm *v1alpha3.VirtualMachine) error
+	GetRESTClient() autorest.Sender
+}
+
+// VirtualMachineClient is the concrete implementation of the
+// VirtualMachineAPI interface that calls Azure API.
+type VirtualMachineClient struct {
+	compute.VirtualMachinesClient
+}
+
+// NewVirtualMachineClient creates and initializes a VirtualMachineClient
+// instance.
+func NewVirtualMachineClient(cl compute.VirtualMachinesClient) *VirtualMachineClient {
+	return &VirtualMachineClient{
+		VirtualMachinesClient: cl,
+	}
+}
+
+// GetRESTClient returns the underlying REST client that the client object uses.
+func (c *VirtualMachineClient) GetRESTClient() autorest.Sender {
+	return c.VirtualMachinesClient.Client
+}
+
+// Get retrieves the requested virtual machine.
+func (c *VirtualMachineClient) Get(ctx context.Context, vm *v1alpha3.VirtualMachine) (compute.VirtualMachine, error) {
+	return c.VirtualMachinesClient.Get(ctx, vm.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(vm), "")
+}
+
+// Create creates the supplied virtual machine with the supplied
+// administrator password, and records the started operation in its status.
+func (c *VirtualMachineClient) Create(ctx context.Context, vm *v1alpha3.VirtualMachine, password string) error {
+	op, err := c.VirtualMachinesClient.CreateOrUpdate(ctx, vm.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(vm), NewVirtualMachineParameters(meta.GetExternalName(vm), vm.Spec.ForProvider, password))
+	if err != nil {
+		return err
+	}
+	vm.Status.AtProvider.LastOperation = azure.NewAsyncOperation(http.MethodPut, op.FutureAPI)
+	return nil
+}
+
+// Update updates the size and tags of the supplied virtual machine, and
+// records the started operation in its status.
+func (c *VirtualMachineClient) Update(ctx context.Context, vm *v1alpha3.VirtualMachine) error {
+	op, err := c.VirtualMachinesClient.Update(ctx, vm.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(vm), NewVirtualMachineUpdate(vm.Spec.ForProvider))
+	if err != nil {
+		return err
+	}
+	vm.Status.AtProvider.LastOperation = azure.NewAsyncOperation(http.MethodPatch, op.FutureAPI)
+	return nil
+}
+
+// Delete deletes the supplied virtual machine, and records the started
+// operation in its status.
+func (c *VirtualMachineClient) Delete(ctx context.Context, vm *v1alpha3.VirtualMachine) error {
+	op, err := c.VirtualMachinesClient.Delete(ctx, vm.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(vm), nil)
+	if err != nil {
+		return err
+	}
+	vm.Status.AtProvider.LastOperation = azure.NewAsyncOperation(http.MethodDelete, op.FutureAPI)
+	return nil
+}
+
+// NewVirtualMachineParameters returns the virtual machine described by the
+// supplied VirtualMachineParameters. The administrator password is omitted
+// if it is empty. The OS disk is deleted along with the virtual machine.
+func NewVirtualMachineParameters(name string, p v1alpha3.VirtualMachineParameters, password string) compute.VirtualMachine {
+	return compute.VirtualMachine{
+		Location: azure.ToStringPtr(p.Location),
+		Zones:    azure.ToStringArrayPtr(p.Zones),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			HardwareProfile: &compute.HardwareProfile{
+				VMSize: compute.VirtualMachineSizeTypes(p.VMSize),
+			},
+			StorageProfile: &compute.StorageProfile{
+				ImageReference: &compute.ImageReference{
+					ID:        p.ImageReference.ID,
+					Publisher: p.ImageReference.Publisher,
+					Offer:     p.ImageReference.Offer,
+					Sku:       p.ImageReference.SKU,
+					Version:   p.ImageReference.Version,
+				},
+				OsDisk:    newOSDisk(p.OSDisk),
+				DataDisks: newDataDisks(p.DataDisks),
+			},
+			OsProfile:      newOSProfile(name, p.OSProfile, password),
+			NetworkProfile: &compute.NetworkProfile{NetworkInterfaces: newNetworkInterfaceReferences(p.NetworkInterfaces)},
+		},
+	}
+}
+
+func newOSDisk(d *v1alpha3.VirtualMachineOSDisk) *compute.OSDisk {
+	disk := &compute.OSDisk{
+		CreateOption: compute.DiskCreateOptionTypesFromImage,
+		DeleteOption: compute.DiskDeleteOptionTypesDelete,
+	}
+	if d == nil {
+		return disk
+	}
+	disk.Caching = compute.CachingTypes(azure.ToString(d.Caching))
+	disk.DiskSizeGB = d.DiskSizeGB
+	if d.StorageAccountType != nil {
+		disk.ManagedDisk = &compute.ManagedDiskParameters{StorageAccountType: compute.StorageAccountTypes(*d.StorageAccountType)}
+	}
+	return disk
+}
+
+// newDataDisks returns the supplied data disks. The result is never nil, so
+// that the last data disk is detached when it is removed.
+func newDataDisks(disks []v1alpha3.VirtualMachineDataDisk) *[]compute.DataDisk {
+	dd := make([]compute.DataDisk, len(disks))
+	for i, d := range disks {
+		dd[i] = compute.DataDisk{
+			Lun:          to.Int32Ptr(d.LUN),
+			CreateOption: compute.DiskCreateOptionTypesAttach,
+			Caching:      compute.CachingTypes(azure.ToString(d.Caching)),
+			ManagedDisk:  &compute.ManagedDiskParameters{ID: azure.ToStringPtr(d.DiskID)},
+		}
+	}
+	return &dd
+}
+
+func newOSProfile(name string, p v1alpha3.VirtualMachineOSProfile, password string) *compute.OSProfile {
+	o := &compute.OSProfile{
+		ComputerName:  azure.ToStringPtr(name),
+		AdminUsername: azure.ToStringPtr(p.AdminUsername),
+		AdminPassword: azure.ToStringPtr(password),
+	}
+	if p.ComputerName != nil {
+		o.ComputerName = p.ComputerName
+	}
+	if p.CustomData != nil {
+		o.CustomData = azure.ToStringPtr(base64.StdEncoding.EncodeToString([]byte(*p.CustomData)))
+	}
+	if len(p.SSHPublicKeys) == 0 && p.DisablePasswordAuthentication == nil {
+		return o
+	}
+	o.LinuxConfiguration = &compute.LinuxConfiguration{DisablePasswordAuthentication: p.DisablePasswordAuthentication}
+	if len(p.SSHPublicKeys) > 0 {
+		keys := make([]compute.SSHPublicKey, len(p.SSHPublicKeys))
+		for i, k := range p.SSHPublicKeys {
+			keys[i] = compute.SSHPublicKey{
+				Path:    azure.ToStringPtr(fmt.Sprintf("/home/%s/.ssh/authorized_keys", p.AdminUsername)),
+				KeyData: azure.ToStringPtr(k),
+			}
+		}
+		o.LinuxConfiguration.SSH = &compute.SSHConfiguration{PublicKeys: &keys}
+	}
+	return o
+}
+
+func newNetworkInterfaceReferences(nics []v1alpha3.VirtualMachineNetworkInterface) *[]compute.NetworkInterfaceReference {
+	refs := make([]compute.NetworkInterfaceReference, len(nics))
+	for i, n := range nics {
+		refs[i] = compute.NetworkInterfaceReference{
+			ID:                                  azure.ToStringPtr(n.ID),
+			NetworkInterfaceReferenceProperties: &compute.NetworkInterfaceReferenceProperties{Primary: n.Primary},
+		}
+	}
+	return &refs
+}
+
+// NewVirtualMachineUpdate returns an update of a virtual machine to the
+// size, data disks and tags of the supplied VirtualMachineParameters. All
+// other parameters can only be set when a virtual machine is created.
+func NewVirtualMachineUpdate(p v1alpha3.VirtualMachineParameters) compute.VirtualMachineUpdate {
+	return compute.VirtualMachineUpdate{
+		Tags: azure.ToStringPtrMap(p.Tags),
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			HardwareProfile: &compute.HardwareProfile{VMSize: compute.VirtualMachineSizeTypes(p.VMSize)},
+			StorageProfile:  &compute.StorageProfile{DataDisks: newDataDisks(p.DataDisks)},
+		},
+	}
+}
+
+// UpdateVirtualMachineObservation produces VirtualMachineObservation from
+// compute.VirtualMachine.
+func UpdateVirtualMachineObservation(o *v1alpha3.VirtualMachineObservation, in compute.VirtualMachine) {
+	o.ID = azure.ToString(in.ID)
+	if in.VirtualMachineProperties == nil {
+		return
+	}
+	o.VMID = azure.ToString(in.VMID)
+	o.ProvisioningState = azure.ToString(in.ProvisioningState)
+}
+
+// LateInitializeVirtualMachine fills the empty fields of the supplied
+// parameters with the values Azure chose for the supplied virtual machine.
+func LateInitializeVirtualMachine(p *v1alpha3.VirtualMachineParameters, in compute.VirtualMachine) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+	p.Zones = azure.LateInitializeStringValArrFromArrPtr(p.Zones, in.Zones)
+	if in.VirtualMachineProperties == nil || in.StorageProfile == nil || in.StorageProfile.OsDisk == nil {
+		return
+	}
+	d := in.StorageProfile.OsDisk
+	if p.OSDisk == nil {
+		p.OSDisk = &v1alpha3.VirtualMachineOSDisk{}
+	}
+	if d.Caching != "" {
+		p.OSDisk.Caching = azure.LateInitializeStringPtrFromVal(p.OSDisk.Caching, string(d.Caching))
+	}
+	if d.ManagedDisk != nil && d.ManagedDisk.StorageAccountType != "" {
+		p.OSDisk.StorageAccountType = azure.LateInitializeStringPtrFromVal(p.OSDisk.StorageAccountType, string(d.ManagedDisk.StorageAccountType))
+	}
+	p.OSDisk.DiskSizeGB = azure.LateInitializeInt32PtrFromInt32Ptr(p.OSDisk.DiskSizeGB, d.DiskSizeGB)
+}
+
+// IsVirtualMachineUpToDate is used to report whether the supplied virtual
+// machine is in sync with the VirtualMachineParameters that the user
+// desires. Only the size, data disks and tags are compared, since no other
+// parameters can be updated.
+func IsVirtualMachineUpToDate(p v1alpha3.VirtualMachineParameters, in compute.VirtualMachine) bool {
+	if in.VirtualMachineProperties == nil || in.HardwareProfile == nil {
+		return false
+	}
+	return p.VMSize == string(in.HardwareProfile.VMSize) &&
+		areDataDisksUpToDate(p.DataDisks, in.StorageProfile) &&
+		cmp.Equal(p.Tags, azure.ToStringMap(in.Tags), cmpopts.EquateEmpty())
+}
+
+// areDataDisksUpToDate returns true if the same managed disks are attached
+// to the same LUNs. Azure may return resource IDs in a different case than
+// they were specified in, so they are compared case insensitively.
+func areDataDisksUpToDate(disks []v1alpha3.VirtualMachineDataDisk, in *compute.StorageProfile) bool {
+	attached := map[int32]compute.DataDisk{}
+	if in != nil && in.DataDisks != nil {
+		for _, d := range *in.DataDisks {
+			attached[to.Int32(d.Lun)] = d
+		}
+	}
+	if len(disks) != len(attached) {
+		return false
+	}
+	for _, d := range disks {
+		a, ok := attached[d.LUN]
+		if !ok || a.ManagedDisk == nil || !strings.EqualFold(d.DiskID, azure.ToString(a.ManagedDisk.ID)) {
+			return false
+		}
+		if d.Caching != nil && *d.Caching != string(a.Caching) {
+			return false
+		}
+	}
+	return true
+}
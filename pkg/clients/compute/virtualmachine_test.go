@@ -0,0 +1,295 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+)
+
+const (
+	nicID  = "/subscriptions/sub/resourceGroups/cool-rg/providers/Microsoft.Network/networkInterfaces/cool-nic"
+	diskID = "/subscriptions/sub/resourceGroups/cool-rg/providers/Microsoft.Compute/disks/cool-disk"
+)
+
+func virtualMachineParameters(m ...func(*v1alpha3.VirtualMachineParameters)) v1alpha3.VirtualMachineParameters {
+	p := v1alpha3.VirtualMachineParameters{
+		ResourceGroupName: "cool-rg",
+		Location:          location,
+		VMSize:            vmSize,
+		ImageReference: v1alpha3.VirtualMachineImageReference{
+			Publisher: to.StringPtr("Canonical"),
+			Offer:     to.StringPtr("UbuntuServer"),
+			SKU:       to.StringPtr("18.04-LTS"),
+			Version:   to.StringPtr("latest"),
+		},
+		NetworkInterfaces: []v1alpha3.VirtualMachineNetworkInterface{{ID: nicID}},
+		OSProfile:         v1alpha3.VirtualMachineOSProfile{AdminUsername: "cool-admin"},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func virtualMachine(m ...func(*compute.VirtualMachine)) compute.VirtualMachine {
+	vm := compute.VirtualMachine{
+		Location: to.StringPtr(location),
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			HardwareProfile: &compute.HardwareProfile{VMSize: compute.VirtualMachineSizeTypes(vmSize)},
+			StorageProfile: &compute.StorageProfile{
+				ImageReference: &compute.ImageReference{
+					Publisher: to.StringPtr("Canonical"),
+					Offer:     to.StringPtr("UbuntuServer"),
+					Sku:       to.StringPtr("18.04-LTS"),
+					Version:   to.StringPtr("latest"),
+				},
+				OsDisk: &compute.OSDisk{
+					CreateOption: compute.DiskCreateOptionTypesFromImage,
+					DeleteOption: compute.DiskDeleteOptionTypesDelete,
+				},
+				DataDisks: &[]compute.DataDisk{},
+			},
+			OsProfile: &compute.OSProfile{
+				ComputerName:  to.StringPtr(name),
+				AdminUsername: to.StringPtr("cool-admin"),
+			},
+			NetworkProfile: &compute.NetworkProfile{NetworkInterfaces: &[]compute.NetworkInterfaceReference{{
+				ID:                                  to.StringPtr(nicID),
+				NetworkInterfaceReferenceProperties: &compute.NetworkInterfaceReferenceProperties{},
+			}}},
+		},
+	}
+	for _, f := range m {
+		f(&vm)
+	}
+	return vm
+}
+
+func TestNewVirtualMachineParameters(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha3.VirtualMachineParameters
+		password string
+		want     compute.VirtualMachine
+	}{
+		"Defaults": {
+			p:    virtualMachineParameters(),
+			want: virtualMachine(),
+		},
+		"Password": {
+			p:        virtualMachineParameters(),
+			password: "cool-password",
+			want: virtualMachine(func(vm *compute.VirtualMachine) {
+				vm.OsProfile.AdminPassword = to.StringPtr("cool-password")
+			}),
+		},
+		"Full": {
+			p: virtualMachineParameters(func(p *v1alpha3.VirtualMachineParameters) {
+				p.Zones = []string{"1"}
+				p.Tags = map[string]string{"cool": "vm"}
+				p.OSDisk = &v1alpha3.VirtualMachineOSDisk{
+					Caching:            to.StringPtr("ReadOnly"),
+					StorageAccountType: to.StringPtr("Premium_LRS"),
+					DiskSizeGB:         to.Int32Ptr(64),
+				}
+				p.DataDisks = []v1alpha3.VirtualMachineDataDisk{{LUN: 0, DiskID: diskID, Caching: to.StringPtr("ReadOnly")}}
+				p.NetworkInterfaces[0].Primary = to.BoolPtr(true)
+				p.OSProfile.ComputerName = to.StringPtr("cool-host")
+				p.OSProfile.CustomData = to.StringPtr("#cloud-config")
+				p.OSProfile.SSHPublicKeys = []string{"ssh-ed25519 cool"}
+				p.OSProfile.DisablePasswordAuthentication = to.BoolPtr(true)
+			}),
+			want: virtualMachine(func(vm *compute.VirtualMachine) {
+				vm.Zones = &[]string{"1"}
+				vm.Tags = map[string]*string{"cool": to.StringPtr("vm")}
+				vm.StorageProfile.OsDisk.Caching = compute.CachingTypesReadOnly
+				vm.StorageProfile.OsDisk.DiskSizeGB = to.Int32Ptr(64)
+				vm.StorageProfile.OsDisk.ManagedDisk = &compute.ManagedDiskParameters{StorageAccountType: compute.StorageAccountTypesPremiumLRS}
+				vm.StorageProfile.DataDisks = &[]compute.DataDisk{{
+					Lun:          to.Int32Ptr(0),
+					CreateOption: compute.DiskCreateOptionTypesAttach,
+					Caching:      compute.CachingTypesReadOnly,
+					ManagedDisk:  &compute.ManagedDiskParameters{ID: to.StringPtr(diskID)},
+				}}
+				(*vm.NetworkProfile.NetworkInterfaces)[0].Primary = to.BoolPtr(true)
+				vm.OsProfile.ComputerName = to.StringPtr("cool-host")
+				vm.OsProfile.CustomData = to.StringPtr("I2Nsb3VkLWNvbmZpZw==")
+				vm.OsProfile.LinuxConfiguration = &compute.LinuxConfiguration{
+					DisablePasswordAuthentication: to.BoolPtr(true),
+					SSH: &compute.SSHConfiguration{PublicKeys: &[]compute.SSHPublicKey{{
+						Path:    to.StringPtr("/home/cool-admin/.ssh/authorized_keys"),
+						KeyData: to.StringPtr("ssh-ed25519 cool"),
+					}}},
+				}
+			}),
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := NewVirtualMachineParameters(name, tc.p, tc.password)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewVirtualMachineParameters(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeVirtualMachine(t *testing.T) {
+	in := virtualMachine(func(vm *compute.VirtualMachine) {
+		vm.Zones = &[]string{"2"}
+		vm.Tags = map[string]*string{"cool": to.StringPtr("vm")}
+		vm.StorageProfile.OsDisk.Caching = compute.CachingTypesReadWrite
+		vm.StorageProfile.OsDisk.DiskSizeGB = to.Int32Ptr(30)
+		vm.StorageProfile.OsDisk.ManagedDisk = &compute.ManagedDiskParameters{StorageAccountType: compute.StorageAccountTypesStandardLRS}
+	})
+
+	cases := map[string]struct {
+		p    v1alpha3.VirtualMachineParameters
+		in   compute.VirtualMachine
+		want v1alpha3.VirtualMachineParameters
+	}{
+		"NoProperties": {
+			p:    virtualMachineParameters(),
+			in:   compute.VirtualMachine{},
+			want: virtualMachineParameters(),
+		},
+		"AllEmpty": {
+			p:  virtualMachineParameters(),
+			in: in,
+			want: virtualMachineParameters(func(p *v1alpha3.VirtualMachineParameters) {
+				p.Zones = []string{"2"}
+				p.Tags = map[string]string{"cool": "vm"}
+				p.OSDisk = &v1alpha3.VirtualMachineOSDisk{
+					Caching:            to.StringPtr("ReadWrite"),
+					StorageAccountType: to.StringPtr("Standard_LRS"),
+					DiskSizeGB:         to.Int32Ptr(30),
+				}
+			}),
+		},
+		"AllSet": {
+			p: virtualMachineParameters(func(p *v1alpha3.VirtualMachineParameters) {
+				p.Zones = []string{"1"}
+				p.Tags = map[string]string{"other": "vm"}
+				p.OSDisk = &v1alpha3.VirtualMachineOSDisk{
+					Caching:            to.StringPtr("None"),
+					StorageAccountType: to.StringPtr("Premium_LRS"),
+					DiskSizeGB:         to.Int32Ptr(64),
+				}
+			}),
+			in: in,
+			want: virtualMachineParameters(func(p *v1alpha3.VirtualMachineParameters) {
+				p.Zones = []string{"1"}
+				p.Tags = map[string]string{"other": "vm"}
+				p.OSDisk = &v1alpha3.VirtualMachineOSDisk{
+					Caching:            to.StringPtr("None"),
+					StorageAccountType: to.StringPtr("Premium_LRS"),
+					DiskSizeGB:         to.Int32Ptr(64),
+				}
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeVirtualMachine(&tc.p, tc.in)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeVirtualMachine(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsVirtualMachineUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.VirtualMachineParameters
+		in   compute.VirtualMachine
+		want bool
+	}{
+		"NoProperties": {
+			p:    virtualMachineParameters(),
+			in:   compute.VirtualMachine{},
+			want: false,
+		},
+		"UpToDate": {
+			p:    virtualMachineParameters(),
+			in:   virtualMachine(),
+			want: true,
+		},
+		"SizeChanged": {
+			p: virtualMachineParameters(func(p *v1alpha3.VirtualMachineParameters) {
+				p.VMSize = "Standard_D4s_v3"
+			}),
+			in:   virtualMachine(),
+			want: false,
+		},
+		"DataDiskAttached": {
+			p: virtualMachineParameters(func(p *v1alpha3.VirtualMachineParameters) {
+				p.DataDisks = []v1alpha3.VirtualMachineDataDisk{{LUN: 0, DiskID: diskID}}
+			}),
+			in:   virtualMachine(),
+			want: false,
+		},
+		"DataDiskDetached": {
+			p: virtualMachineParameters(),
+			in: virtualMachine(func(vm *compute.VirtualMachine) {
+				vm.StorageProfile.DataDisks = &[]compute.DataDisk{{Lun: to.Int32Ptr(0), ManagedDisk: &compute.ManagedDiskParameters{ID: to.StringPtr(diskID)}}}
+			}),
+			want: false,
+		},
+		"DataDiskIDCaseDiffers": {
+			p: virtualMachineParameters(func(p *v1alpha3.VirtualMachineParameters) {
+				p.DataDisks = []v1alpha3.VirtualMachineDataDisk{{LUN: 0, DiskID: diskID}}
+			}),
+			in: virtualMachine(func(vm *compute.VirtualMachine) {
+				vm.StorageProfile.DataDisks = &[]compute.DataDisk{{Lun: to.Int32Ptr(0), ManagedDisk: &compute.ManagedDiskParameters{ID: to.StringPtr(strings.ToUpper(diskID))}}}
+			}),
+			want: true,
+		},
+		"DataDiskCachingChanged": {
+			p: virtualMachineParameters(func(p *v1alpha3.VirtualMachineParameters) {
+				p.DataDisks = []v1alpha3.VirtualMachineDataDisk{{LUN: 0, DiskID: diskID, Caching: to.StringPtr("ReadWrite")}}
+			}),
+			in: virtualMachine(func(vm *compute.VirtualMachine) {
+				vm.StorageProfile.DataDisks = &[]compute.DataDisk{{Lun: to.Int32Ptr(0), Caching: compute.CachingTypesNone, ManagedDisk: &compute.ManagedDiskParameters{ID: to.StringPtr(diskID)}}}
+			}),
+			want: false,
+		},
+		"TagsChanged": {
+			p: virtualMachineParameters(func(p *v1alpha3.VirtualMachineParameters) {
+				p.Tags = map[string]string{"cool": "vm"}
+			}),
+			in:   virtualMachine(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVirtualMachineUpToDate(tc.p, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsVirtualMachineUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+)
+
+const errFmtMaxConcurrentReconciles = "invalid maximum concurrent reconciles %q for %q: must be a positive integer"
+
+var (
+	concurrencyMu sync.RWMutex
+	concurrency   = map[string]int{}
+)
+
+// ParseMaxConcurrentReconciles parses the supplied map of kinds to the maximum
+// number of resources of that kind that may be reconciled concurrently. Kinds
+// may be specified either by kind, e.g. SQLServer, or by group kind, e.g.
+// SQLServer.sql.azure.crossplane.io, and are matched case insensitively.
+func ParseMaxConcurrentReconciles(in map[string]string) (map[string]int, error) {
+	out := make(map[string]int, len(in))
+	for k, v := range in {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return nil, errors.Errorf(errFmtMaxConcurrentReconciles, v, k)
+		}
+		out[strings.ToLower(k)] = n
+	}
+	return out, nil
+}
+
+// SetMaxConcurrentReconciles sets the maximum number of resources of each kind
+// that may be reconciled concurrently, overriding the MaxConcurrentReconciles
+// of the options passed to ControllerOptions. The supplied map should be
+// produced by ParseMaxConcurrentReconciles.
+func SetMaxConcurrentReconciles(m map[string]int) {
+	concurrencyMu.Lock()
+	defer concurrencyMu.Unlock()
+	concurrency = m
+}
+
+// ControllerOptions returns the supplied options for the controller of the
+// supplied group kind, with MaxConcurrentReconciles overridden if a maximum
+// was set for the kind using SetMaxConcurrentReconciles. Settings made for a
+// group kind take precedence over settings made for a kind.
+func ControllerOptions(groupKind string, o controller.Options) controller.Options {
+	concurrencyMu.RLock()
+	defer concurrencyMu.RUnlock()
+	gk := strings.ToLower(groupKind)
+	if n, ok := concurrency[gk]; ok {
+		o.MaxConcurrentReconciles = n
+		return o
+	}
+	if n, ok := concurrency[strings.SplitN(gk, ".", 2)[0]]; ok {
+		o.MaxConcurrentReconciles = n
+	}
+	return o
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestParseMaxConcurrentReconciles(t *testing.T) {
+	type want struct {
+		m   map[string]int
+		err error
+	}
+	cases := map[string]struct {
+		in   map[string]string
+		want want
+	}{
+		"Empty": {
+			want: want{m: map[string]int{}},
+		},
+		"Valid": {
+			in:   map[string]string{"SQLServer": "20", "AKSCluster.compute.azure.crossplane.io": "2"},
+			want: want{m: map[string]int{"sqlserver": 20, "akscluster.compute.azure.crossplane.io": 2}},
+		},
+		"NotANumber": {
+			in:   map[string]string{"SQLServer": "many"},
+			want: want{err: errors.Errorf(errFmtMaxConcurrentReconciles, "many", "SQLServer")},
+		},
+		"NotPositive": {
+			in:   map[string]string{"SQLServer": "0"},
+			want: want{err: errors.Errorf(errFmtMaxConcurrentReconciles, "0", "SQLServer")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m, err := ParseMaxConcurrentReconciles(tc.in)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ParseMaxConcurrentReconciles(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.m, m); diff != "" {
+				t.Errorf("ParseMaxConcurrentReconciles(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestControllerOptions(t *testing.T) {
+	SetMaxConcurrentReconciles(map[string]int{
+		"sqlserver":                              20,
+		"akscluster":                             4,
+		"akscluster.compute.azure.crossplane.io": 2,
+	})
+	defer SetMaxConcurrentReconciles(map[string]int{})
+
+	cases := map[string]struct {
+		groupKind string
+		want      int
+	}{
+		"Kind": {
+			groupKind: "SQLServer.sql.azure.crossplane.io",
+			want:      20,
+		},
+		"GroupKindTakesPrecedence": {
+			groupKind: "AKSCluster.compute.azure.crossplane.io",
+			want:      2,
+		},
+		"NotSet": {
+			groupKind: "VirtualNetwork.network.azure.crossplane.io",
+			want:      10,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := ControllerOptions(tc.groupKind, controller.Options{MaxConcurrentReconciles: 10})
+			if diff := cmp.Diff(tc.want, o.MaxConcurrentReconciles); diff != "" {
+				t.Errorf("ControllerOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+)
+
+// NewConnecter wraps the supplied managed.ExternalConnecter of the supplied
+// kind of managed resource in the connecters every controller of this
+// provider applies. From the outside in, they record API call metrics, back
+// off on throttling and other errors using the supplied Backoff, report
+// terminal errors as conditions, emit events to the supplied recorder,
+// annotate errors with request IDs, and enforce management policies and
+// deletion protection. The controller must be built with the options of the
+// same Backoff, see Backoff.ForControllerRuntime.
+func NewConnecter(kind string, b *Backoff, r event.Recorder, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return NewMetricsConnecter(kind,
+		NewBackoffConnecter(b,
+			NewErrorConditionConnecter(
+				NewEventConnecter(r,
+					NewRequestIDConnecter(
+						NewManagementPolicyConnecter(
+							NewDeletionProtectionConnecter(c)))))))
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func TestNewConnecter(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		err    error
+		events int
+	}
+
+	cases := map[string]struct {
+		annotations map[string]string
+		want        want
+	}{
+		"FullyManaged": {
+			want: want{err: errBoom, events: 1},
+		},
+		"ObserveOnly": {
+			annotations: map[string]string{AnnotationKeyManagementPolicy: AnnotationValueManagementPolicyObserveOnly},
+			want:        want{err: ErrObserveOnly, events: 1},
+		},
+		"DeletionProtected": {
+			annotations: map[string]string{AnnotationKeyDeletionProtection: AnnotationValueDeletionProtectionEnabled},
+			want:        want{err: ErrDeletionProtected, events: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &recorder{}
+			c := NewConnecter("Cool.example.org", NewBackoff(), r, managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &fakeExternal{err: errBoom}, nil
+			}))
+			mg := &fake.Managed{}
+			mg.SetAnnotations(tc.annotations)
+
+			e, err := c.Connect(context.Background(), mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %v", err)
+			}
+			if err := e.Delete(context.Background(), mg); !errors.Is(err, tc.want.err) {
+				t.Errorf("Delete(...): want error %v, got %v", tc.want.err, err)
+			}
+			if len(r.events) != tc.want.events {
+				t.Errorf("Delete(...): want %d events, got %d", tc.want.events, len(r.events))
+			}
+		})
+	}
+}
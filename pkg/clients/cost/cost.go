@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/costmanagement/mgmt/2019-11-01/costmanagement"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+
+	azureclients "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// Annotations that record the month-to-date cost of a managed resource.
+const (
+	AnnotationKeyMonthToDateCost = "azure.crossplane.io/month-to-date-cost"
+	AnnotationKeyCostCurrency    = "azure.crossplane.io/cost-currency"
+)
+
+// Names of the columns of a Cost Management query result that we read.
+// Depending on the billing account type the aggregated cost column is named
+// after the alias of the aggregation, or after the aggregated column.
+const (
+	columnTotalCost  = "totalCost"
+	columnPreTaxCost = "PreTaxCost"
+	columnCurrency   = "Currency"
+)
+
+const (
+	errParseResourceID = "cannot parse resource ID"
+	errQueryCost       = "cannot query Cost Management"
+)
+
+// A Cost is an amount of money in a currency.
+type Cost struct {
+	Amount   float64
+	Currency string
+}
+
+// String returns the amount of the Cost, rounded to cents.
+func (c Cost) String() string {
+	return fmt.Sprintf("%.2f", c.Amount)
+}
+
+// A CostAPI reports the cost of Azure resources.
+type CostAPI interface {
+	MonthToDateCost(ctx context.Context, resourceID string) (Cost, error)
+}
+
+// A QueryClient reports the cost of Azure resources using Cost Management
+// queries.
+type QueryClient struct {
+	client costmanagement.QueryClient
+}
+
+// NewQueryClient returns a QueryClient for the subscription of the supplied
+// credentials.
+func NewQueryClient(creds map[string]string, auth autorest.Authorizer) CostAPI {
+	c := costmanagement.NewQueryClient(creds[azureclients.CredentialsKeySubscriptionID])
+	c.Authorizer = auth
+	_ = c.AddToUserAgent(azureclients.UserAgent)
+	return &QueryClient{client: c}
+}
+
+// MonthToDateCost returns the actual cost of the Azure resource with the
+// supplied ID in the current calendar month. The query is scoped to the
+// resource group of the resource, which requires fewer permissions and is
+// faster than querying the whole subscription.
+func (c *QueryClient) MonthToDateCost(ctx context.Context, resourceID string) (Cost, error) {
+	r, err := azure.ParseResourceID(resourceID)
+	if err != nil {
+		return Cost{}, errors.Wrap(err, errParseResourceID)
+	}
+	scope := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", r.SubscriptionID, r.ResourceGroup)
+	q := costmanagement.QueryDefinition{
+		Type:      costmanagement.ExportTypeActualCost,
+		Timeframe: costmanagement.TimeframeTypeMonthToDate,
+		Dataset: &costmanagement.QueryDataset{
+			Aggregation: map[string]*costmanagement.QueryAggregation{
+				columnTotalCost: {Name: to.StringPtr(columnPreTaxCost), Function: costmanagement.FunctionTypeSum},
+			},
+			Filter: &costmanagement.QueryFilter{
+				Dimensions: &costmanagement.QueryComparisonExpression{
+					Name:     to.StringPtr("ResourceId"),
+					Operator: to.StringPtr("In"),
+					// Cost Management reports resource IDs in lower case.
+					Values: &[]string{strings.ToLower(resourceID)},
+				},
+			},
+		},
+	}
+	res, err := c.client.Usage(ctx, scope, q)
+	if err != nil {
+		return Cost{}, errors.Wrap(err, errQueryCost)
+	}
+	return ParseCost(res.QueryProperties), nil
+}
+
+// ParseCost returns the total cost in the supplied query result. Resources
+// that have not incurred any cost yet have no rows, and a zero cost.
+func ParseCost(p *costmanagement.QueryProperties) Cost {
+	c := Cost{}
+	if p == nil || p.Columns == nil || p.Rows == nil {
+		return c
+	}
+	amount, currency := -1, -1
+	for i, col := range *p.Columns {
+		switch to.String(col.Name) {
+		case columnTotalCost, columnPreTaxCost:
+			amount = i
+		case columnCurrency:
+			currency = i
+		}
+	}
+	for _, row := range *p.Rows {
+		if amount >= 0 && amount < len(row) {
+			if v, ok := row[amount].(float64); ok {
+				c.Amount += v
+			}
+		}
+		if currency >= 0 && currency < len(row) {
+			if v, ok := row[currency].(string); ok {
+				c.Currency = v
+			}
+		}
+	}
+	return c
+}
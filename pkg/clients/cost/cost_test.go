@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/costmanagement/mgmt/2019-11-01/costmanagement"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseCost(t *testing.T) {
+	columns := func(names ...string) *[]costmanagement.QueryColumn {
+		c := make([]costmanagement.QueryColumn, len(names))
+		for i, n := range names {
+			c[i] = costmanagement.QueryColumn{Name: to.StringPtr(n)}
+		}
+		return &c
+	}
+
+	cases := map[string]struct {
+		p    *costmanagement.QueryProperties
+		want Cost
+	}{
+		"NoProperties": {
+			want: Cost{},
+		},
+		"NoRows": {
+			p:    &costmanagement.QueryProperties{Columns: columns(columnPreTaxCost, columnCurrency), Rows: &[][]interface{}{}},
+			want: Cost{},
+		},
+		"AggregatedColumnName": {
+			p: &costmanagement.QueryProperties{
+				Columns: columns(columnPreTaxCost, columnCurrency),
+				Rows:    &[][]interface{}{{12.3456, "USD"}},
+			},
+			want: Cost{Amount: 12.3456, Currency: "USD"},
+		},
+		"AliasColumnName": {
+			p: &costmanagement.QueryProperties{
+				Columns: columns(columnCurrency, columnTotalCost),
+				Rows:    &[][]interface{}{{"EUR", 1.5}, {"EUR", 2.5}},
+			},
+			want: Cost{Amount: 4, Currency: "EUR"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ParseCost(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ParseCost(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCostString(t *testing.T) {
+	if diff := cmp.Diff("12.35", Cost{Amount: 12.3456}.String()); diff != "" {
+		t.Errorf("String(): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/cost"
+)
+
+var _ cost.CostAPI = &MockCostAPI{}
+
+// MockCostAPI is a fake implementation of cost.CostAPI.
+type MockCostAPI struct {
+	MockMonthToDateCost func(ctx context.Context, resourceID string) (cost.Cost, error)
+}
+
+// MonthToDateCost calls the MockCostAPI's MockMonthToDateCost method.
+func (m *MockCostAPI) MonthToDateCost(ctx context.Context, resourceID string) (cost.Cost, error) {
+	return m.MockMonthToDateCost(ctx, resourceID)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
+	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/database/configuration"
+)
+
+var _ configuration.MySQLConfigurationAPI = &MockMySQLConfigurationAPI{}
+
+// MockMySQLConfigurationAPI is a fake implementation of
+// configuration.MySQLConfigurationAPI.
+type MockMySQLConfigurationAPI struct {
+	MockGet            func(ctx context.Context, s *v1beta1.MySQLServerConfiguration) (mysql.Configuration, error)
+	MockCreateOrUpdate func(ctx context.Context, s *v1beta1.MySQLServerConfiguration) error
+	MockDelete         func(ctx context.Context, s *v1beta1.MySQLServerConfiguration) error
+	MockGetRESTClient  func() autorest.Sender
+}
+
+// Get calls the MockMySQLConfigurationAPI's MockGet method.
+func (m *MockMySQLConfigurationAPI) Get(ctx context.Context, s *v1beta1.MySQLServerConfiguration) (mysql.Configuration, error) {
+	return m.MockGet(ctx, s)
+}
+
+// CreateOrUpdate calls the MockMySQLConfigurationAPI's MockCreateOrUpdate
+// method.
+func (m *MockMySQLConfigurationAPI) CreateOrUpdate(ctx context.Context, s *v1beta1.MySQLServerConfiguration) error {
+	return m.MockCreateOrUpdate(ctx, s)
+}
+
+// Delete calls the MockMySQLConfigurationAPI's MockDelete method.
+func (m *MockMySQLConfigurationAPI) Delete(ctx context.Context, s *v1beta1.MySQLServerConfiguration) error {
+	return m.MockDelete(ctx, s)
+}
+
+// GetRESTClient calls the MockMySQLConfigurationAPI's MockGetRESTClient
+// method.
+func (m *MockMySQLConfigurationAPI) GetRESTClient() autorest.Sender {
+	return m.MockGetRESTClient()
+}
+
+var _ configuration.PostgreSQLConfigurationAPI = &MockPostgreSQLConfigurationAPI{}
+
+// MockPostgreSQLConfigurationAPI is a fake implementation of
+// configuration.PostgreSQLConfigurationAPI.
+type MockPostgreSQLConfigurationAPI struct {
+	MockGet            func(ctx context.Context, s *v1beta1.PostgreSQLServerConfiguration) (postgresql.Configuration, error)
+	MockCreateOrUpdate func(ctx context.Context, s *v1beta1.PostgreSQLServerConfiguration) error
+	MockDelete         func(ctx context.Context, s *v1beta1.PostgreSQLServerConfiguration) error
+	MockGetRESTClient  func() autorest.Sender
+}
+
+// Get calls the MockPostgreSQLConfigurationAPI's MockGet method.
+func (m *MockPostgreSQLConfigurationAPI) Get(ctx context.Context, s *v1beta1.PostgreSQLServerConfiguration) (postgresql.Configuration, error) {
+	return m.MockGet(ctx, s)
+}
+
+// CreateOrUpdate calls the MockPostgreSQLConfigurationAPI's
+// MockCreateOrUpdate method.
+func (m *MockPostgreSQLConfigurationAPI) CreateOrUpdate(ctx context.Context, s *v1beta1.PostgreSQLServerConfiguration) error {
+	return m.MockCreateOrUpdate(ctx, s)
+}
+
+// Delete calls the MockPostgreSQLConfigurationAPI's MockDelete method.
+func (m *MockPostgreSQLConfigurationAPI) Delete(ctx context.Context, s *v1beta1.PostgreSQLServerConfiguration) error {
+	return m.MockDelete(ctx, s)
+}
+
+// GetRESTClient calls the MockPostgreSQLConfigurationAPI's MockGetRESTClient
+// method.
+func (m *MockPostgreSQLConfigurationAPI) GetRESTClient() autorest.Sender {
+	return m.MockGetRESTClient()
+}
@@ -24,7 +24,6 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 
 	azuredbv1beta1 "github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
-	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 )
 
@@ -92,10 +91,7 @@ func (c *MySQLConfigurationClient) update(ctx context.Context, cr *azuredbv1beta
 	if err != nil {
 		return err
 	}
-	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
-		PollingURL: op.PollingURL(),
-		Method:     http.MethodPut,
-	}
+	cr.Status.AtProvider.LastOperation = azure.NewAsyncOperation(http.MethodPut, op.FutureAPI)
 	return nil
 }
 
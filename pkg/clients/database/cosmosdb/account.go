@@ -24,7 +24,6 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb/documentdbapi"
-	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/pkg/errors"
 
 	"github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
@@ -37,21 +36,17 @@ type AccountClient documentdbapi.DatabaseAccountsClientAPI
 // NewDatabaseAccountClient create Azure DatabaseAccountsClient using provided
 // credentials data
 func NewDatabaseAccountClient(credentials []byte) (AccountClient, error) {
-	creds := &azure.Credentials{}
-	if err := json.Unmarshal(credentials, creds); err != nil {
+	creds := map[string]string{}
+	if err := json.Unmarshal(credentials, &creds); err != nil {
 		return nil, errors.Wrap(err, "cannot unmarshal Azure client secret data")
 	}
 
-	config := auth.NewClientCredentialsConfig(creds.ClientID, creds.ClientSecret, creds.TenantID)
-	config.AADEndpoint = creds.ActiveDirectoryEndpointURL
-	config.Resource = creds.ResourceManagerEndpointURL
-
-	authorizer, err := config.Authorizer()
+	authorizer, err := azure.NewAuthorizer(creds)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get authorizer from config")
 	}
 
-	client := documentdb.NewDatabaseAccountsClient(creds.SubscriptionID)
+	client := documentdb.NewDatabaseAccountsClient(creds[azure.CredentialsKeySubscriptionID])
 	client.Authorizer = authorizer
 
 	if err := client.AddToUserAgent(azure.UserAgent); err != nil {
@@ -79,8 +74,9 @@ func ToDatabaseAccountCreateOrUpdate(s *v1alpha3.CosmosDBAccountSpec) documentdb
 // documentdb.CosmosDBAccountStatus.
 func UpdateCosmosDBAccountObservation(o *v1alpha3.CosmosDBAccountStatus, in documentdb.DatabaseAccount) {
 	o.AtProvider = &v1alpha3.CosmosDBAccountObservation{
-		ID:    azure.ToString(in.ID),
-		State: azure.ToString(in.DatabaseAccountProperties.ProvisioningState),
+		ID:       azure.ToString(in.ID),
+		State:    azure.ToString(in.DatabaseAccountProperties.ProvisioningState),
+		Endpoint: azure.ToString(in.DatabaseAccountProperties.DocumentEndpoint),
 	}
 }
 
@@ -96,6 +92,7 @@ func toDatabaseProperties(a *v1alpha3.CosmosDBAccountProperties) *documentdb.Dat
 		EnableAutomaticFailover:      a.EnableAutomaticFailover,
 		EnableCassandraConnector:     a.EnableCassandraConnector,
 		EnableMultipleWriteLocations: a.EnableAutomaticFailover,
+		Capabilities:                 toDatabaseCapabilities(a.Capabilities),
 	}
 }
 
@@ -128,6 +125,11 @@ func CheckEqualDatabaseProperties(p v1alpha3.CosmosDBAccountProperties, a docume
 		equalBoolIfNotNull(p.EnableMultipleWriteLocations, o.EnableMultipleWriteLocations))
 }
 
+// CheckEqualTags compares the observed tags with the desired spec.
+func CheckEqualTags(t map[string]string, a documentdb.DatabaseAccount) bool {
+	return cmp.Equal(t, azure.ToStringMap(a.Tags), cmpopts.EquateEmpty())
+}
+
 func equalConsistencyPolicyIfNotNull(spec, current *v1alpha3.CosmosDBAccountConsistencyPolicy) bool {
 	if spec != nil {
 		return (spec == current) || (*spec == *current)
@@ -164,6 +166,19 @@ func fromDatabaseConsistencyPolicy(a *documentdb.ConsistencyPolicy) *v1alpha3.Co
 	}
 }
 
+func toDatabaseCapabilities(a []string) *[]documentdb.Capability {
+	if len(a) == 0 {
+		return nil
+	}
+
+	s := make([]documentdb.Capability, len(a))
+	for i := range a {
+		s[i] = documentdb.Capability{Name: azure.ToStringPtr(a[i])}
+	}
+
+	return &s
+}
+
 func toDatabaseLocations(a []v1alpha3.CosmosDBAccountLocation) *[]documentdb.Location {
 	if a == nil {
 		return &[]documentdb.Location{}
@@ -127,6 +127,28 @@ func TestToDatabaseAccountCreateOrUpdate(t *testing.T) {
 			t.Errorf("ToDatabaseAccountCreateOrUpdate() diff:\n%s", diff)
 		}
 	})
+	t.Run("Capabilities", func(t *testing.T) {
+		diff := cmp.Diff(documentdb.DatabaseAccountCreateUpdateParameters{
+			Kind:     documentdb.GlobalDocumentDB,
+			Location: &location,
+			DatabaseAccountCreateUpdateProperties: &documentdb.DatabaseAccountCreateUpdateProperties{
+				Locations:    &[]documentdb.Location{},
+				Capabilities: &[]documentdb.Capability{{Name: azure.ToStringPtr("EnableCassandra")}},
+			},
+		}, ToDatabaseAccountCreateOrUpdate(&v1alpha3.CosmosDBAccountSpec{
+			ForProvider: v1alpha3.CosmosDBAccountParameters{
+				ResourceGroupName: resourceGroupName,
+				Kind:              documentdb.GlobalDocumentDB,
+				Location:          location,
+				Properties: v1alpha3.CosmosDBAccountProperties{
+					Capabilities: []string{"EnableCassandra"},
+				},
+			},
+		}))
+		if diff != "" {
+			t.Errorf("ToDatabaseAccountCreateOrUpdate() diff:\n%s", diff)
+		}
+	})
 }
 
 func TestCheckEqualDatabaseProperties(t *testing.T) {
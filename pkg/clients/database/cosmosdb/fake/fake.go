@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/database/cosmosdb"
+)
+
+var _ cosmosdb.AccountClient = &MockClient{}
+
+// MockClient is a fake implementation of cosmosdb.AccountClient.
+type MockClient struct {
+	cosmosdb.AccountClient
+
+	MockCreateOrUpdate  func(ctx context.Context, resourceGroupName string, accountName string, createUpdateParameters documentdb.DatabaseAccountCreateUpdateParameters) (result documentdb.DatabaseAccountsCreateOrUpdateFuture, err error)
+	MockCheckNameExists func(ctx context.Context, accountName string) (result autorest.Response, err error)
+	MockGet             func(ctx context.Context, resourceGroupName string, accountName string) (result documentdb.DatabaseAccount, err error)
+	MockDelete          func(ctx context.Context, resourceGroupName string, accountName string) (result documentdb.DatabaseAccountsDeleteFuture, err error)
+	MockListKeys        func(ctx context.Context, resourceGroupName string, accountName string) (result documentdb.DatabaseAccountListKeysResult, err error)
+
+	MockGetSQLDatabase               func(ctx context.Context, resourceGroupName string, accountName string, databaseName string) (result documentdb.SQLDatabase, err error)
+	MockCreateUpdateSQLDatabase      func(ctx context.Context, resourceGroupName string, accountName string, databaseName string, createUpdateSQLDatabaseParameters documentdb.SQLDatabaseCreateUpdateParameters) (result documentdb.DatabaseAccountsCreateUpdateSQLDatabaseFuture, err error)
+	MockDeleteSQLDatabase            func(ctx context.Context, resourceGroupName string, accountName string, databaseName string) (result documentdb.DatabaseAccountsDeleteSQLDatabaseFuture, err error)
+	MockGetSQLDatabaseThroughput     func(ctx context.Context, resourceGroupName string, accountName string, databaseName string) (result documentdb.Throughput, err error)
+	MockUpdateSQLDatabaseThroughput  func(ctx context.Context, resourceGroupName string, accountName string, databaseName string, updateThroughputParameters documentdb.ThroughputUpdateParameters) (result documentdb.DatabaseAccountsUpdateSQLDatabaseThroughputFuture, err error)
+	MockGetSQLContainer              func(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string) (result documentdb.SQLContainer, err error)
+	MockCreateUpdateSQLContainer     func(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string, createUpdateSQLContainerParameters documentdb.SQLContainerCreateUpdateParameters) (result documentdb.DatabaseAccountsCreateUpdateSQLContainerFuture, err error)
+	MockDeleteSQLContainer           func(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string) (result documentdb.DatabaseAccountsDeleteSQLContainerFuture, err error)
+	MockGetSQLContainerThroughput    func(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string) (result documentdb.Throughput, err error)
+	MockUpdateSQLContainerThroughput func(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string, updateThroughputParameters documentdb.ThroughputUpdateParameters) (result documentdb.DatabaseAccountsUpdateSQLContainerThroughputFuture, err error)
+}
+
+// CreateOrUpdate calls the MockClient's MockCreateOrUpdate method.
+func (m *MockClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, accountName string, createUpdateParameters documentdb.DatabaseAccountCreateUpdateParameters) (result documentdb.DatabaseAccountsCreateOrUpdateFuture, err error) {
+	return m.MockCreateOrUpdate(ctx, resourceGroupName, accountName, createUpdateParameters)
+}
+
+// CheckNameExists calls the MockClient's MockCheckNameExists method.
+func (m *MockClient) CheckNameExists(ctx context.Context, accountName string) (result autorest.Response, err error) {
+	return m.MockCheckNameExists(ctx, accountName)
+}
+
+// Get calls the MockClient's MockGet method.
+func (m *MockClient) Get(ctx context.Context, resourceGroupName string, accountName string) (result documentdb.DatabaseAccount, err error) {
+	return m.MockGet(ctx, resourceGroupName, accountName)
+}
+
+// Delete calls the MockClient's MockDelete method.
+func (m *MockClient) Delete(ctx context.Context, resourceGroupName string, accountName string) (result documentdb.DatabaseAccountsDeleteFuture, err error) {
+	return m.MockDelete(ctx, resourceGroupName, accountName)
+}
+
+// ListKeys calls the MockClient's MockListKeys method.
+func (m *MockClient) ListKeys(ctx context.Context, resourceGroupName string, accountName string) (result documentdb.DatabaseAccountListKeysResult, err error) {
+	return m.MockListKeys(ctx, resourceGroupName, accountName)
+}
+
+// GetSQLDatabase calls the MockClient's MockGetSQLDatabase method.
+func (m *MockClient) GetSQLDatabase(ctx context.Context, resourceGroupName string, accountName string, databaseName string) (result documentdb.SQLDatabase, err error) {
+	return m.MockGetSQLDatabase(ctx, resourceGroupName, accountName, databaseName)
+}
+
+// CreateUpdateSQLDatabase calls the MockClient's MockCreateUpdateSQLDatabase
+// method.
+func (m *MockClient) CreateUpdateSQLDatabase(ctx context.Context, resourceGroupName string, accountName string, databaseName string, createUpdateSQLDatabaseParameters documentdb.SQLDatabaseCreateUpdateParameters) (result documentdb.DatabaseAccountsCreateUpdateSQLDatabaseFuture, err error) {
+	return m.MockCreateUpdateSQLDatabase(ctx, resourceGroupName, accountName, databaseName, createUpdateSQLDatabaseParameters)
+}
+
+// DeleteSQLDatabase calls the MockClient's MockDeleteSQLDatabase method.
+func (m *MockClient) DeleteSQLDatabase(ctx context.Context, resourceGroupName string, accountName string, databaseName string) (result documentdb.DatabaseAccountsDeleteSQLDatabaseFuture, err error) {
+	return m.MockDeleteSQLDatabase(ctx, resourceGroupName, accountName, databaseName)
+}
+
+// GetSQLDatabaseThroughput calls the MockClient's
+// MockGetSQLDatabaseThroughput method.
+func (m *MockClient) GetSQLDatabaseThroughput(ctx context.Context, resourceGroupName string, accountName string, databaseName string) (result documentdb.Throughput, err error) {
+	return m.MockGetSQLDatabaseThroughput(ctx, resourceGroupName, accountName, databaseName)
+}
+
+// UpdateSQLDatabaseThroughput calls the MockClient's
+// MockUpdateSQLDatabaseThroughput method.
+func (m *MockClient) UpdateSQLDatabaseThroughput(ctx context.Context, resourceGroupName string, accountName string, databaseName string, updateThroughputParameters documentdb.ThroughputUpdateParameters) (result documentdb.DatabaseAccountsUpdateSQLDatabaseThroughputFuture, err error) {
+	return m.MockUpdateSQLDatabaseThroughput(ctx, resourceGroupName, accountName, databaseName, updateThroughputParameters)
+}
+
+// GetSQLContainer calls the MockClient's MockGetSQLContainer method.
+func (m *MockClient) GetSQLContainer(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string) (result documentdb.SQLContainer, err error) {
+	return m.MockGetSQLContainer(ctx, resourceGroupName, accountName, databaseName, containerName)
+}
+
+// CreateUpdateSQLContainer calls the MockClient's
+// MockCreateUpdateSQLContainer method.
+func (m *MockClient) CreateUpdateSQLContainer(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string, createUpdateSQLContainerParameters documentdb.SQLContainerCreateUpdateParameters) (result documentdb.DatabaseAccountsCreateUpdateSQLContainerFuture, err error) {
+	return m.MockCreateUpdateSQLContainer(ctx, resourceGroupName, accountName, databaseName, containerName, createUpdateSQLContainerParameters)
+}
+
+// DeleteSQLContainer calls the MockClient's MockDeleteSQLContainer method.
+func (m *MockClient) DeleteSQLContainer(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string) (result documentdb.DatabaseAccountsDeleteSQLContainerFuture, err error) {
+	return m.MockDeleteSQLContainer(ctx, resourceGroupName, accountName, databaseName, containerName)
+}
+
+// GetSQLContainerThroughput calls the MockClient's
+// MockGetSQLContainerThroughput method.
+func (m *MockClient) GetSQLContainerThroughput(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string) (result documentdb.Throughput, err error) {
+	return m.MockGetSQLContainerThroughput(ctx, resourceGroupName, accountName, databaseName, containerName)
+}
+
+// UpdateSQLContainerThroughput calls the MockClient's
+// MockUpdateSQLContainerThroughput method.
+func (m *MockClient) UpdateSQLContainerThroughput(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string, updateThroughputParameters documentdb.ThroughputUpdateParameters) (result documentdb.DatabaseAccountsUpdateSQLContainerThroughputFuture, err error) {
+	return m.MockUpdateSQLContainerThroughput(ctx, resourceGroupName, accountName, databaseName, containerName, updateThroughputParameters)
+}
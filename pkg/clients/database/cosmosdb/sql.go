@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosmosdb
+
+import (
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// optionThroughput is the create option that provisions the throughput of a
+// database or container.
+const optionThroughput = "throughput"
+
+// NewSQLDatabaseParameters returns the parameters used to create the supplied
+// SQL API database.
+func NewSQLDatabaseParameters(name string, p v1alpha3.CosmosDBSQLDatabaseParameters) documentdb.SQLDatabaseCreateUpdateParameters {
+	return documentdb.SQLDatabaseCreateUpdateParameters{
+		SQLDatabaseCreateUpdateProperties: &documentdb.SQLDatabaseCreateUpdateProperties{
+			Resource: &documentdb.SQLDatabaseResource{ID: azure.ToStringPtr(name)},
+			Options:  throughputOptions(p.Throughput),
+		},
+	}
+}
+
+// NewSQLContainerParameters returns the parameters used to create or update
+// the supplied SQL API container. Its throughput is only used on creation;
+// it is updated separately afterwards.
+func NewSQLContainerParameters(name string, p v1alpha3.CosmosDBSQLContainerParameters) documentdb.SQLContainerCreateUpdateParameters {
+	paths := make([]string, len(p.PartitionKey.Paths))
+	copy(paths, p.PartitionKey.Paths)
+	return documentdb.SQLContainerCreateUpdateParameters{
+		SQLContainerCreateUpdateProperties: &documentdb.SQLContainerCreateUpdateProperties{
+			Resource: &documentdb.SQLContainerResource{
+				ID: azure.ToStringPtr(name),
+				PartitionKey: &documentdb.ContainerPartitionKey{
+					Paths: &paths,
+					Kind:  documentdb.PartitionKind(azure.ToString(p.PartitionKey.Kind)),
+				},
+				DefaultTTL: p.DefaultTTL,
+			},
+			Options: throughputOptions(p.Throughput),
+		},
+	}
+}
+
+// NewThroughputUpdateParameters returns the parameters used to update the
+// throughput of a database or container.
+func NewThroughputUpdateParameters(throughput int32) documentdb.ThroughputUpdateParameters {
+	return documentdb.ThroughputUpdateParameters{
+		ThroughputUpdateProperties: &documentdb.ThroughputUpdateProperties{
+			Resource: &documentdb.ThroughputResource{Throughput: &throughput},
+		},
+	}
+}
+
+// Throughput returns the provisioned throughput of the supplied Azure
+// throughput settings, or nil if it has none.
+func Throughput(t documentdb.Throughput) *int32 {
+	if t.ThroughputProperties == nil {
+		return nil
+	}
+	return t.ThroughputProperties.Throughput
+}
+
+// LateInitializeSQLContainer fills the empty fields of the supplied
+// parameters with the values of the supplied Azure container.
+func LateInitializeSQLContainer(p *v1alpha3.CosmosDBSQLContainerParameters, c documentdb.SQLContainer, throughput *int32) {
+	if c.SQLContainerProperties != nil {
+		if p.DefaultTTL == nil {
+			p.DefaultTTL = c.DefaultTTL
+		}
+		if pk := c.PartitionKey; pk != nil && p.PartitionKey.Kind == nil && pk.Kind != "" {
+			p.PartitionKey.Kind = azure.ToStringPtr(string(pk.Kind))
+		}
+	}
+	if p.Throughput == nil {
+		p.Throughput = throughput
+	}
+}
+
+// IsSQLContainerUpToDate returns true if the time to live and throughput of
+// the supplied Azure container match the supplied parameters.
+func IsSQLContainerUpToDate(p v1alpha3.CosmosDBSQLContainerParameters, c documentdb.SQLContainer, throughput *int32) bool {
+	var ttl *int32
+	if c.SQLContainerProperties != nil {
+		ttl = c.DefaultTTL
+	}
+	return IsTTLUpToDate(p.DefaultTTL, ttl) && IsThroughputUpToDate(p.Throughput, throughput)
+}
+
+// IsTTLUpToDate returns true if the desired time to live is either unset, or
+// equal to the observed time to live.
+func IsTTLUpToDate(desired, observed *int32) bool {
+	return desired == nil || (observed != nil && *desired == *observed)
+}
+
+// IsThroughputUpToDate returns true if the desired throughput is either
+// unset, or equal to the observed throughput.
+func IsThroughputUpToDate(desired, observed *int32) bool {
+	return desired == nil || (observed != nil && *desired == *observed)
+}
+
+func throughputOptions(throughput *int32) map[string]*string {
+	if throughput == nil {
+		return map[string]*string{}
+	}
+	return map[string]*string{optionThroughput: azure.ToStringPtr(strconv.Itoa(int(*throughput)))}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosmosdb
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+func int32Ptr(i int32) *int32 { return &i }
+
+func TestNewSQLDatabaseParameters(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha3.CosmosDBSQLDatabaseParameters
+		want documentdb.SQLDatabaseCreateUpdateParameters
+	}{
+		"NoThroughput": {
+			p: v1alpha3.CosmosDBSQLDatabaseParameters{},
+			want: documentdb.SQLDatabaseCreateUpdateParameters{
+				SQLDatabaseCreateUpdateProperties: &documentdb.SQLDatabaseCreateUpdateProperties{
+					Resource: &documentdb.SQLDatabaseResource{ID: azure.ToStringPtr("cool-db")},
+					Options:  map[string]*string{},
+				},
+			},
+		},
+		"Throughput": {
+			p: v1alpha3.CosmosDBSQLDatabaseParameters{Throughput: int32Ptr(400)},
+			want: documentdb.SQLDatabaseCreateUpdateParameters{
+				SQLDatabaseCreateUpdateProperties: &documentdb.SQLDatabaseCreateUpdateProperties{
+					Resource: &documentdb.SQLDatabaseResource{ID: azure.ToStringPtr("cool-db")},
+					Options:  map[string]*string{"throughput": azure.ToStringPtr("400")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewSQLDatabaseParameters("cool-db", tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewSQLDatabaseParameters(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewSQLContainerParameters(t *testing.T) {
+	p := v1alpha3.CosmosDBSQLContainerParameters{
+		PartitionKey: v1alpha3.CosmosDBSQLContainerPartitionKey{
+			Paths: []string{"/tenantId"},
+			Kind:  azure.ToStringPtr("Hash"),
+		},
+		DefaultTTL: int32Ptr(-1),
+		Throughput: int32Ptr(1000),
+	}
+	want := documentdb.SQLContainerCreateUpdateParameters{
+		SQLContainerCreateUpdateProperties: &documentdb.SQLContainerCreateUpdateProperties{
+			Resource: &documentdb.SQLContainerResource{
+				ID: azure.ToStringPtr("cool-container"),
+				PartitionKey: &documentdb.ContainerPartitionKey{
+					Paths: &[]string{"/tenantId"},
+					Kind:  documentdb.PartitionKindHash,
+				},
+				DefaultTTL: int32Ptr(-1),
+			},
+			Options: map[string]*string{"throughput": azure.ToStringPtr("1000")},
+		},
+	}
+
+	got := NewSQLContainerParameters("cool-container", p)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NewSQLContainerParameters(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSQLContainer(t *testing.T) {
+	az := documentdb.SQLContainer{
+		SQLContainerProperties: &documentdb.SQLContainerProperties{
+			PartitionKey: &documentdb.ContainerPartitionKey{Kind: documentdb.PartitionKindHash},
+			DefaultTTL:   int32Ptr(3600),
+		},
+	}
+
+	cases := map[string]struct {
+		p          v1alpha3.CosmosDBSQLContainerParameters
+		throughput *int32
+		want       v1alpha3.CosmosDBSQLContainerParameters
+	}{
+		"AllEmpty": {
+			p:          v1alpha3.CosmosDBSQLContainerParameters{},
+			throughput: int32Ptr(400),
+			want: v1alpha3.CosmosDBSQLContainerParameters{
+				PartitionKey: v1alpha3.CosmosDBSQLContainerPartitionKey{Kind: azure.ToStringPtr("Hash")},
+				DefaultTTL:   int32Ptr(3600),
+				Throughput:   int32Ptr(400),
+			},
+		},
+		"AllSet": {
+			p: v1alpha3.CosmosDBSQLContainerParameters{
+				PartitionKey: v1alpha3.CosmosDBSQLContainerPartitionKey{Kind: azure.ToStringPtr("Range")},
+				DefaultTTL:   int32Ptr(60),
+				Throughput:   int32Ptr(800),
+			},
+			throughput: int32Ptr(400),
+			want: v1alpha3.CosmosDBSQLContainerParameters{
+				PartitionKey: v1alpha3.CosmosDBSQLContainerPartitionKey{Kind: azure.ToStringPtr("Range")},
+				DefaultTTL:   int32Ptr(60),
+				Throughput:   int32Ptr(800),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSQLContainer(&tc.p, az, tc.throughput)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeSQLContainer(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSQLContainerUpToDate(t *testing.T) {
+	az := documentdb.SQLContainer{
+		SQLContainerProperties: &documentdb.SQLContainerProperties{DefaultTTL: int32Ptr(60)},
+	}
+
+	cases := map[string]struct {
+		p          v1alpha3.CosmosDBSQLContainerParameters
+		throughput *int32
+		want       bool
+	}{
+		"Unset": {
+			p:    v1alpha3.CosmosDBSQLContainerParameters{},
+			want: true,
+		},
+		"UpToDate": {
+			p:          v1alpha3.CosmosDBSQLContainerParameters{DefaultTTL: int32Ptr(60), Throughput: int32Ptr(400)},
+			throughput: int32Ptr(400),
+			want:       true,
+		},
+		"TTLChanged": {
+			p:          v1alpha3.CosmosDBSQLContainerParameters{DefaultTTL: int32Ptr(120), Throughput: int32Ptr(400)},
+			throughput: int32Ptr(400),
+			want:       false,
+		},
+		"ThroughputChanged": {
+			p:          v1alpha3.CosmosDBSQLContainerParameters{DefaultTTL: int32Ptr(60), Throughput: int32Ptr(800)},
+			throughput: int32Ptr(400),
+			want:       false,
+		},
+		"ThroughputNotProvisioned": {
+			p:    v1alpha3.CosmosDBSQLContainerParameters{Throughput: int32Ptr(400)},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSQLContainerUpToDate(tc.p, az, tc.throughput)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsSQLContainerUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
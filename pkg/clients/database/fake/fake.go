@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
+	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/database"
+)
+
+var _ database.MySQLServerAPI = &MockMySQLServerAPI{}
+
+// MockMySQLServerAPI is a fake implementation of database.MySQLServerAPI.
+type MockMySQLServerAPI struct {
+	MockGetServer     func(ctx context.Context, s *v1beta1.MySQLServer) (mysql.Server, error)
+	MockCreateServer  func(ctx context.Context, s *v1beta1.MySQLServer, adminPassword string) error
+	MockUpdateServer  func(ctx context.Context, s *v1beta1.MySQLServer, adminPassword string) error
+	MockDeleteServer  func(ctx context.Context, s *v1beta1.MySQLServer) error
+	MockGetRESTClient func() autorest.Sender
+}
+
+// GetRESTClient calls the MockMySQLServerAPI's MockGetRESTClient method.
+func (m *MockMySQLServerAPI) GetRESTClient() autorest.Sender {
+	return m.MockGetRESTClient()
+}
+
+// GetServer calls the MockMySQLServerAPI's MockGetServer method.
+func (m *MockMySQLServerAPI) GetServer(ctx context.Context, s *v1beta1.MySQLServer) (mysql.Server, error) {
+	return m.MockGetServer(ctx, s)
+}
+
+// CreateServer calls the MockMySQLServerAPI's MockCreateServer method.
+func (m *MockMySQLServerAPI) CreateServer(ctx context.Context, s *v1beta1.MySQLServer, adminPassword string) error {
+	return m.MockCreateServer(ctx, s, adminPassword)
+}
+
+// UpdateServer calls the MockMySQLServerAPI's MockUpdateServer method.
+func (m *MockMySQLServerAPI) UpdateServer(ctx context.Context, s *v1beta1.MySQLServer, adminPassword string) error {
+	return m.MockUpdateServer(ctx, s, adminPassword)
+}
+
+// DeleteServer calls the MockMySQLServerAPI's MockDeleteServer method.
+func (m *MockMySQLServerAPI) DeleteServer(ctx context.Context, s *v1beta1.MySQLServer) error {
+	return m.MockDeleteServer(ctx, s)
+}
+
+var _ database.PostgreSQLServerAPI = &MockPostgreSQLServerAPI{}
+
+// MockPostgreSQLServerAPI is a fake implementation of
+// database.PostgreSQLServerAPI.
+type MockPostgreSQLServerAPI struct {
+	MockGetServer     func(ctx context.Context, s *v1beta1.PostgreSQLServer) (postgresql.Server, error)
+	MockCreateServer  func(ctx context.Context, s *v1beta1.PostgreSQLServer, adminPassword string) error
+	MockDeleteServer  func(ctx context.Context, s *v1beta1.PostgreSQLServer) error
+	MockUpdateServer  func(ctx context.Context, s *v1beta1.PostgreSQLServer, adminPassword string) error
+	MockGetRESTClient func() autorest.Sender
+}
+
+// GetRESTClient calls the MockPostgreSQLServerAPI's MockGetRESTClient method.
+func (m *MockPostgreSQLServerAPI) GetRESTClient() autorest.Sender {
+	return m.MockGetRESTClient()
+}
+
+// GetServer calls the MockPostgreSQLServerAPI's MockGetServer method.
+func (m *MockPostgreSQLServerAPI) GetServer(ctx context.Context, s *v1beta1.PostgreSQLServer) (postgresql.Server, error) {
+	return m.MockGetServer(ctx, s)
+}
+
+// CreateServer calls the MockPostgreSQLServerAPI's MockCreateServer method.
+func (m *MockPostgreSQLServerAPI) CreateServer(ctx context.Context, s *v1beta1.PostgreSQLServer, adminPassword string) error {
+	return m.MockCreateServer(ctx, s, adminPassword)
+}
+
+// UpdateServer calls the MockPostgreSQLServerAPI's MockUpdateServer method.
+func (m *MockPostgreSQLServerAPI) UpdateServer(ctx context.Context, s *v1beta1.PostgreSQLServer, adminPassword string) error {
+	return m.MockUpdateServer(ctx, s, adminPassword)
+}
+
+// DeleteServer calls the MockPostgreSQLServerAPI's MockDeleteServer method.
+func (m *MockPostgreSQLServerAPI) DeleteServer(ctx context.Context, s *v1beta1.PostgreSQLServer) error {
+	return m.MockDeleteServer(ctx, s)
+}
@@ -32,7 +32,6 @@ import (
 	azuredbv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
 	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
 	azuredbv1beta1 "github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
-	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 )
 
@@ -55,7 +54,7 @@ var (
 type MySQLServerAPI interface {
 	GetServer(ctx context.Context, s *azuredbv1beta1.MySQLServer) (mysql.Server, error)
 	CreateServer(ctx context.Context, s *azuredbv1beta1.MySQLServer, adminPassword string) error
-	UpdateServer(ctx context.Context, s *azuredbv1beta1.MySQLServer) error
+	UpdateServer(ctx context.Context, s *azuredbv1beta1.MySQLServer, adminPassword string) error
 	DeleteServer(ctx context.Context, s *azuredbv1beta1.MySQLServer) error
 	GetRESTClient() autorest.Sender
 }
@@ -157,6 +156,9 @@ func toMySQLProperties(s v1beta1.SQLServerParameters, adminPassword string) mysq
 // CreateServer creates a MySQL Server.
 func (c *MySQLServerClient) CreateServer(ctx context.Context, cr *azuredbv1beta1.MySQLServer, adminPassword string) error {
 	s := cr.Spec.ForProvider
+	if err := validateCreateMode(s); err != nil {
+		return err
+	}
 	sku, err := ToMySQLSKU(s.SKU)
 	if err != nil {
 		return err
@@ -171,17 +173,14 @@ func (c *MySQLServerClient) CreateServer(ctx context.Context, cr *azuredbv1beta1
 	if err != nil {
 		return err
 	}
-	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
-		PollingURL: op.PollingURL(),
-		Method:     http.MethodPut,
-	}
+	cr.Status.AtProvider.LastOperation = azure.NewAsyncOperation(http.MethodPut, op.FutureAPI)
 	return nil
 }
 
-// UpdateServer updates a MySQL Server.
-func (c *MySQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1beta1.MySQLServer) error {
-	// TODO(muvaf): password update via Update call is supported by Azure but
-	// we don't support that.
+// UpdateServer updates a MySQL Server. The administrator password is changed
+// to the supplied one unless it is empty. Its storage is never shrunk. A
+// replica is promoted to a standalone server if requested.
+func (c *MySQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1beta1.MySQLServer, adminPassword string) error {
 	s := cr.Spec.ForProvider
 	properties := &mysql.ServerUpdateParametersProperties{
 		Version:             mysql.ServerVersion(s.Version),
@@ -191,10 +190,16 @@ func (c *MySQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1beta1
 		StorageProfile: &mysql.StorageProfile{
 			BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
 			GeoRedundantBackup:  mysql.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
-			StorageMB:           azure.ToInt32Ptr(s.StorageProfile.StorageMB),
+			StorageMB:           azure.ToInt32Ptr(StorageMB(s.StorageProfile, cr.Status.AtProvider)),
 			StorageAutogrow:     mysql.StorageAutogrow(azure.ToString(s.StorageProfile.StorageAutogrow)),
 		},
 	}
+	if adminPassword != "" {
+		properties.AdministratorLoginPassword = &adminPassword
+	}
+	if promotionPending(s, cr.Status.AtProvider.ReplicationRole) {
+		properties.ReplicationRole = azure.ToStringPtr(replicationRoleNone)
+	}
 	sku, err := ToMySQLSKU(s.SKU)
 	if err != nil {
 		return err
@@ -208,10 +213,7 @@ func (c *MySQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1beta1
 	if err != nil {
 		return err
 	}
-	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
-		PollingURL: op.PollingURL(),
-		Method:     http.MethodPatch,
-	}
+	cr.Status.AtProvider.LastOperation = azure.NewAsyncOperation(http.MethodPatch, op.FutureAPI)
 	return nil
 }
 
@@ -221,10 +223,7 @@ func (c *MySQLServerClient) DeleteServer(ctx context.Context, cr *azuredbv1beta1
 	if err != nil {
 		return err
 	}
-	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
-		PollingURL: op.PollingURL(),
-		Method:     http.MethodDelete,
-	}
+	cr.Status.AtProvider.LastOperation = azure.NewAsyncOperation(http.MethodDelete, op.FutureAPI)
 	return nil
 }
 
@@ -280,6 +279,18 @@ func MySQLServerFirewallRuleIsUpToDate(kube *azuredbv1alpha3.MySQLServerFirewall
 	return cmp.Equal(up.FirewallRuleProperties, az.FirewallRuleProperties)
 }
 
+// NewMySQLDatabaseParameters returns an Azure Database object from a
+// database spec.
+func NewMySQLDatabaseParameters(r *azuredbv1alpha3.MySQLDatabase) mysql.Database {
+	return mysql.Database{
+		Name: azure.ToStringPtr(meta.GetExternalName(r)),
+		DatabaseProperties: &mysql.DatabaseProperties{
+			Charset:   r.Spec.ForProvider.Charset,
+			Collation: r.Spec.ForProvider.Collation,
+		},
+	}
+}
+
 // The name must match the specification of the SKU, so, we don't allow user
 // to specify an arbitrary name. The format is tier + family + cores, e.g. B_Gen4_1, GP_Gen5_8.
 
@@ -306,6 +317,10 @@ func UpdateMySQLObservation(o *azuredbv1beta1.SQLServerObservation, in mysql.Ser
 	o.UserVisibleState = string(in.UserVisibleState)
 	o.FullyQualifiedDomainName = azure.ToString(in.FullyQualifiedDomainName)
 	o.MasterServerID = azure.ToString(in.MasterServerID)
+	o.ReplicationRole = azure.ToString(in.ReplicationRole)
+	if in.StorageProfile != nil {
+		o.StorageMB = azure.ToInt(in.StorageProfile.StorageMB)
+	}
 }
 
 // LateInitializeMySQL fills the empty values of SQLServerParameters with the
@@ -356,12 +371,14 @@ func IsMySQLUpToDate(p azuredbv1beta1.SQLServerParameters, in mysql.Server) bool
 		return false
 	case azure.ToString(p.StorageProfile.GeoRedundantBackup) != string(in.StorageProfile.GeoRedundantBackup):
 		return false
-	case p.StorageProfile.StorageMB != azure.ToInt(in.StorageProfile.StorageMB):
+	case p.StorageProfile.StorageMB > azure.ToInt(in.StorageProfile.StorageMB):
 		return false
 	case azure.ToString(p.StorageProfile.StorageAutogrow) != string(in.StorageProfile.StorageAutogrow):
 		return false
 	case azure.ToString(p.PublicNetworkAccess) != string(in.PublicNetworkAccess):
 		return false
+	case promotionPending(p, azure.ToString(in.ReplicationRole)):
+		return false
 	}
 	return true
 }
@@ -527,6 +527,60 @@ func TestIsMysqlUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"IsUpToDateWithGrownStorage": {
+			args: args{
+				p: v1beta1.SQLServerParameters{
+					StorageProfile: v1beta1.StorageProfile{StorageMB: 20480},
+				},
+				in: mysql.Server{
+					Sku: &mysql.Sku{},
+					ServerProperties: &mysql.ServerProperties{
+						StorageProfile: &mysql.StorageProfile{StorageMB: azure.ToInt32Ptr(25600)},
+					},
+				},
+			},
+			want: true,
+		},
+		"IsNotUpToDateWithIncreasedStorage": {
+			args: args{
+				p: v1beta1.SQLServerParameters{
+					StorageProfile: v1beta1.StorageProfile{StorageMB: 25600},
+				},
+				in: mysql.Server{
+					Sku: &mysql.Sku{},
+					ServerProperties: &mysql.ServerProperties{
+						StorageProfile: &mysql.StorageProfile{StorageMB: azure.ToInt32Ptr(20480)},
+					},
+				},
+			},
+			want: false,
+		},
+		"IsNotUpToDateWithPromotionPending": {
+			args: args{
+				p: v1beta1.SQLServerParameters{PromoteReplica: true},
+				in: mysql.Server{
+					Sku: &mysql.Sku{},
+					ServerProperties: &mysql.ServerProperties{
+						StorageProfile:  &mysql.StorageProfile{},
+						ReplicationRole: azure.ToStringPtr("Replica"),
+					},
+				},
+			},
+			want: false,
+		},
+		"IsUpToDateWithReplicaPromoted": {
+			args: args{
+				p: v1beta1.SQLServerParameters{PromoteReplica: true},
+				in: mysql.Server{
+					Sku: &mysql.Sku{},
+					ServerProperties: &mysql.ServerProperties{
+						StorageProfile:  &mysql.StorageProfile{},
+						ReplicationRole: azure.ToStringPtr("None"),
+					},
+				},
+			},
+			want: true,
+		},
 		"IsNotUpToDateWithServerWithoutSku": {
 			args: args{
 				p: v1beta1.SQLServerParameters{},
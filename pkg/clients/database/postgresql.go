@@ -33,7 +33,6 @@ import (
 	azuredbv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
 	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
 	azuredbv1beta1 "github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
-	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 )
 
@@ -49,7 +48,7 @@ type PostgreSQLServerAPI interface {
 	GetServer(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer) (postgresql.Server, error)
 	CreateServer(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer, adminPassword string) error
 	DeleteServer(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer) error
-	UpdateServer(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer) error
+	UpdateServer(ctx context.Context, s *azuredbv1beta1.PostgreSQLServer, adminPassword string) error
 	GetRESTClient() autorest.Sender
 }
 
@@ -149,6 +148,9 @@ func toPGSQLProperties(s v1beta1.SQLServerParameters, adminPassword string) post
 // CreateServer creates a PostgreSQL Server
 func (c *PostgreSQLServerClient) CreateServer(ctx context.Context, cr *azuredbv1beta1.PostgreSQLServer, adminPassword string) error {
 	s := cr.Spec.ForProvider
+	if err := validateCreateMode(s); err != nil {
+		return err
+	}
 	sku, err := ToPostgreSQLSKU(s.SKU)
 	if err != nil {
 		return err
@@ -163,17 +165,14 @@ func (c *PostgreSQLServerClient) CreateServer(ctx context.Context, cr *azuredbv1
 	if err != nil {
 		return err
 	}
-	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
-		PollingURL: op.PollingURL(),
-		Method:     http.MethodPut,
-	}
+	cr.Status.AtProvider.LastOperation = azure.NewAsyncOperation(http.MethodPut, op.FutureAPI)
 	return nil
 }
 
-// UpdateServer updates a PostgreSQL Server.
-func (c *PostgreSQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1beta1.PostgreSQLServer) error {
-	// TODO(muvaf): password update via Update call is supported by Azure but
-	// we don't support that.
+// UpdateServer updates a PostgreSQL Server. The administrator password is changed
+// to the supplied one unless it is empty. Its storage is never shrunk. A
+// replica is promoted to a standalone server if requested.
+func (c *PostgreSQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1beta1.PostgreSQLServer, adminPassword string) error {
 	s := cr.Spec.ForProvider
 	properties := &postgresql.ServerUpdateParametersProperties{
 		Version:             postgresql.ServerVersion(s.Version),
@@ -183,10 +182,16 @@ func (c *PostgreSQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1
 		StorageProfile: &postgresql.StorageProfile{
 			BackupRetentionDays: azure.ToInt32PtrFromIntPtr(s.StorageProfile.BackupRetentionDays),
 			GeoRedundantBackup:  postgresql.GeoRedundantBackup(azure.ToString(s.StorageProfile.GeoRedundantBackup)),
-			StorageMB:           azure.ToInt32Ptr(s.StorageProfile.StorageMB),
+			StorageMB:           azure.ToInt32Ptr(StorageMB(s.StorageProfile, cr.Status.AtProvider)),
 			StorageAutogrow:     postgresql.StorageAutogrow(azure.ToString(s.StorageProfile.StorageAutogrow)),
 		},
 	}
+	if adminPassword != "" {
+		properties.AdministratorLoginPassword = &adminPassword
+	}
+	if promotionPending(s, cr.Status.AtProvider.ReplicationRole) {
+		properties.ReplicationRole = azure.ToStringPtr(replicationRoleNone)
+	}
 	sku, err := ToPostgreSQLSKU(s.SKU)
 	if err != nil {
 		return err
@@ -200,10 +205,7 @@ func (c *PostgreSQLServerClient) UpdateServer(ctx context.Context, cr *azuredbv1
 	if err != nil {
 		return err
 	}
-	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
-		PollingURL: op.PollingURL(),
-		Method:     http.MethodPatch,
-	}
+	cr.Status.AtProvider.LastOperation = azure.NewAsyncOperation(http.MethodPatch, op.FutureAPI)
 	return nil
 }
 
@@ -213,10 +215,7 @@ func (c *PostgreSQLServerClient) DeleteServer(ctx context.Context, cr *azuredbv1
 	if err != nil {
 		return err
 	}
-	cr.Status.AtProvider.LastOperation = v1alpha3.AsyncOperation{
-		PollingURL: op.PollingURL(),
-		Method:     http.MethodDelete,
-	}
+	cr.Status.AtProvider.LastOperation = azure.NewAsyncOperation(http.MethodDelete, op.FutureAPI)
 	return nil
 }
 
@@ -272,6 +271,18 @@ func PostgreSQLServerFirewallRuleIsUpToDate(kube *azuredbv1alpha3.PostgreSQLServ
 	return cmp.Equal(up.FirewallRuleProperties, az.FirewallRuleProperties)
 }
 
+// NewPostgreSQLDatabaseParameters returns an Azure Database object from a
+// database spec.
+func NewPostgreSQLDatabaseParameters(r *azuredbv1alpha3.PostgreSQLDatabase) postgresql.Database {
+	return postgresql.Database{
+		Name: azure.ToStringPtr(meta.GetExternalName(r)),
+		DatabaseProperties: &postgresql.DatabaseProperties{
+			Charset:   r.Spec.ForProvider.Charset,
+			Collation: r.Spec.ForProvider.Collation,
+		},
+	}
+}
+
 // The name must match the specification of the SKU, so, we don't allow user
 // to specify an arbitrary name. The format is tier + family + cores, e.g. B_Gen4_1, GP_Gen5_8.
 
@@ -298,6 +309,10 @@ func UpdatePostgreSQLObservation(o *azuredbv1beta1.SQLServerObservation, in post
 	o.UserVisibleState = string(in.UserVisibleState)
 	o.FullyQualifiedDomainName = azure.ToString(in.FullyQualifiedDomainName)
 	o.MasterServerID = azure.ToString(in.MasterServerID)
+	o.ReplicationRole = azure.ToString(in.ReplicationRole)
+	if in.StorageProfile != nil {
+		o.StorageMB = azure.ToInt(in.StorageProfile.StorageMB)
+	}
 }
 
 // LateInitializePostgreSQL fills the empty values of SQLServerParameters with the
@@ -349,12 +364,14 @@ func IsPostgreSQLUpToDate(p azuredbv1beta1.SQLServerParameters, in postgresql.Se
 		return false
 	case azure.ToString(p.StorageProfile.GeoRedundantBackup) != string(in.StorageProfile.GeoRedundantBackup):
 		return false
-	case p.StorageProfile.StorageMB != azure.ToInt(in.StorageProfile.StorageMB):
+	case p.StorageProfile.StorageMB > azure.ToInt(in.StorageProfile.StorageMB):
 		return false
 	case azure.ToString(p.StorageProfile.StorageAutogrow) != string(in.StorageProfile.StorageAutogrow):
 		return false
 	case azure.ToString(p.PublicNetworkAccess) != string(in.PublicNetworkAccess):
 		return false
+	case promotionPending(p, azure.ToString(in.ReplicationRole)):
+		return false
 	}
 	return true
 }
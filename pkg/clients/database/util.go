@@ -18,9 +18,30 @@ package database
 
 import (
 	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
 	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+// storageAutogrowEnabled is the StorageAutogrow value of servers with storage
+// auto-grow enabled.
+const storageAutogrowEnabled = "Enabled"
+
+// Error strings.
+const (
+	errFmtNoSourceServerID  = "sourceServerID is required when createMode is %s"
+	errNoRestorePointInTime = "restorePointInTime is required when createMode is PointInTimeRestore"
+)
+
+// Replication roles of a server.
+const (
+	replicationRoleNone    = "None"
+	replicationRoleReplica = "Replica"
 )
 
 // Get a pointer to a CreateMode
@@ -44,3 +65,52 @@ func safeDate(time *metav1.Time) *date.Time {
 	}
 	return &date.Time{Time: time.Time}
 }
+
+// StorageMB returns the storage a server should be updated to have. Azure
+// cannot shrink the storage of a server, so the observed storage is kept if it
+// exceeds the desired storage, e.g. because it was auto-grown.
+func StorageMB(p v1beta1.StorageProfile, o v1beta1.SQLServerObservation) int {
+	if o.StorageMB > p.StorageMB {
+		return o.StorageMB
+	}
+	return p.StorageMB
+}
+
+// SetStorageShrinkCondition sets a condition on the supplied managed resource
+// if its desired storage is less than its observed storage. Servers with
+// storage auto-grow enabled are expected to outgrow their desired storage, so
+// they never have the condition set.
+func SetStorageShrinkCondition(mg resource.Managed, p v1beta1.StorageProfile, o v1beta1.SQLServerObservation) {
+	autogrow := p.StorageAutogrow != nil && *p.StorageAutogrow == storageAutogrowEnabled
+	if !autogrow && p.StorageMB < o.StorageMB {
+		mg.SetConditions(v1alpha3.CannotShrinkStorage(p.StorageMB, o.StorageMB))
+		return
+	}
+	if mg.GetCondition(v1alpha3.TypeCannotShrinkStorage).Status == corev1.ConditionTrue {
+		mg.SetConditions(v1alpha3.NoStorageShrinkRequested())
+	}
+}
+
+// promotionPending returns true if the supplied parameters request that a
+// server with the supplied replication role be promoted from a replica to a
+// standalone server.
+func promotionPending(p v1beta1.SQLServerParameters, role string) bool {
+	return p.PromoteReplica && role == replicationRoleReplica
+}
+
+// validateCreateMode returns an error if the supplied parameters lack a field
+// that their create mode requires. Servers that are restored from a backup or
+// replicate another server require a source server.
+func validateCreateMode(p v1beta1.SQLServerParameters) error {
+	mode := pointerToCreateMode(p.CreateMode)
+	if mode == v1beta1.CreateModeDefault {
+		return nil
+	}
+	if p.SourceServerID == nil || *p.SourceServerID == "" {
+		return errors.Errorf(errFmtNoSourceServerID, mode)
+	}
+	if mode == v1beta1.CreateModePointInTimeRestore && p.RestorePointInTime == nil {
+		return errors.New(errNoRestorePointInTime)
+	}
+	return nil
+}
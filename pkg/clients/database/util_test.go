@@ -0,0 +1,177 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+func TestStorageMB(t *testing.T) {
+	cases := map[string]struct {
+		p    v1beta1.StorageProfile
+		o    v1beta1.SQLServerObservation
+		want int
+	}{
+		"Increased": {
+			p:    v1beta1.StorageProfile{StorageMB: 25600},
+			o:    v1beta1.SQLServerObservation{StorageMB: 20480},
+			want: 25600,
+		},
+		"Decreased": {
+			p:    v1beta1.StorageProfile{StorageMB: 20480},
+			o:    v1beta1.SQLServerObservation{StorageMB: 25600},
+			want: 25600,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := StorageMB(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("StorageMB(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetStorageShrinkCondition(t *testing.T) {
+	cases := map[string]struct {
+		existing []xpv1.Condition
+		p        v1beta1.StorageProfile
+		o        v1beta1.SQLServerObservation
+		want     xpv1.Condition
+	}{
+		"Shrunk": {
+			p:    v1beta1.StorageProfile{StorageMB: 20480},
+			o:    v1beta1.SQLServerObservation{StorageMB: 25600},
+			want: v1alpha3.CannotShrinkStorage(20480, 25600),
+		},
+		"AutoGrown": {
+			p:    v1beta1.StorageProfile{StorageMB: 20480, StorageAutogrow: azure.ToStringPtr("Enabled")},
+			o:    v1beta1.SQLServerObservation{StorageMB: 25600},
+			want: xpv1.Condition{Type: v1alpha3.TypeCannotShrinkStorage, Status: corev1.ConditionUnknown},
+		},
+		"NeverShrunk": {
+			p:    v1beta1.StorageProfile{StorageMB: 20480},
+			o:    v1beta1.SQLServerObservation{StorageMB: 20480},
+			want: xpv1.Condition{Type: v1alpha3.TypeCannotShrinkStorage, Status: corev1.ConditionUnknown},
+		},
+		"Reverted": {
+			existing: []xpv1.Condition{v1alpha3.CannotShrinkStorage(20480, 25600)},
+			p:        v1beta1.StorageProfile{StorageMB: 25600},
+			o:        v1beta1.SQLServerObservation{StorageMB: 25600},
+			want:     v1alpha3.NoStorageShrinkRequested(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &v1beta1.MySQLServer{}
+			mg.SetConditions(tc.existing...)
+			SetStorageShrinkCondition(mg, tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, mg.GetCondition(v1alpha3.TypeCannotShrinkStorage), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("GetCondition(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPromotionPending(t *testing.T) {
+	cases := map[string]struct {
+		p    v1beta1.SQLServerParameters
+		role string
+		want bool
+	}{
+		"Requested": {
+			p:    v1beta1.SQLServerParameters{PromoteReplica: true},
+			role: replicationRoleReplica,
+			want: true,
+		},
+		"AlreadyPromoted": {
+			p:    v1beta1.SQLServerParameters{PromoteReplica: true},
+			role: replicationRoleNone,
+		},
+		"NotRequested": {
+			role: replicationRoleReplica,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := promotionPending(tc.p, tc.role)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("promotionPending(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateCreateMode(t *testing.T) {
+	source := azure.ToStringPtr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.DBforMySQL/servers/source")
+	mode := func(m v1beta1.CreateMode) *v1beta1.CreateMode { return &m }
+
+	cases := map[string]struct {
+		p    v1beta1.SQLServerParameters
+		want error
+	}{
+		"Default": {},
+		"GeoRestore": {
+			p: v1beta1.SQLServerParameters{CreateMode: mode(v1beta1.CreateModeGeoRestore), SourceServerID: source},
+		},
+		"GeoRestoreNoSource": {
+			p:    v1beta1.SQLServerParameters{CreateMode: mode(v1beta1.CreateModeGeoRestore)},
+			want: errors.Errorf(errFmtNoSourceServerID, v1beta1.CreateModeGeoRestore),
+		},
+		"PointInTimeRestore": {
+			p: v1beta1.SQLServerParameters{
+				CreateMode:         mode(v1beta1.CreateModePointInTimeRestore),
+				SourceServerID:     source,
+				RestorePointInTime: &metav1.Time{},
+			},
+		},
+		"PointInTimeRestoreNoTime": {
+			p:    v1beta1.SQLServerParameters{CreateMode: mode(v1beta1.CreateModePointInTimeRestore), SourceServerID: source},
+			want: errors.New(errNoRestorePointInTime),
+		},
+		"ReplicaNoSource": {
+			p:    v1beta1.SQLServerParameters{CreateMode: mode(v1beta1.CreateModeReplica), SourceServerID: azure.ToStringPtr("")},
+			want: errors.Errorf(errFmtNoSourceServerID, v1beta1.CreateModeReplica),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateCreateMode(tc.p)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("validateCreateMode(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+const (
+	// AnnotationKeyDeletionProtection protects the external resource of a
+	// managed resource from deletion when set to
+	// AnnotationValueDeletionProtectionEnabled. A protected managed resource
+	// that is deleted stays in deletion until the annotation is removed.
+	AnnotationKeyDeletionProtection = "azure.crossplane.io/deletion-protection"
+
+	// AnnotationValueDeletionProtectionEnabled enables deletion protection.
+	AnnotationValueDeletionProtectionEnabled = "enabled"
+)
+
+// ErrDeletionProtected is returned instead of deleting an external resource
+// that is protected from deletion.
+var ErrDeletionProtected = errors.New("refusing to delete the external resource because deletion protection is enabled; remove the " + AnnotationKeyDeletionProtection + " annotation to delete it")
+
+// DeletionProtected returns true if the supplied object protects its external
+// resource from deletion.
+func DeletionProtected(o metav1.Object) bool {
+	return o.GetAnnotations()[AnnotationKeyDeletionProtection] == AnnotationValueDeletionProtectionEnabled
+}
+
+// NewDeletionProtectionConnecter returns a managed.ExternalConnecter whose
+// external clients return ErrDeletionProtected rather than delete the external
+// resource of a managed resource that is protected from deletion. They also
+// set the DeletionBlocked condition, so that the blocked deletion is visible
+// at a glance rather than only as a reconcile error.
+func NewDeletionProtectionConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &deletionProtectionConnecter{ExternalConnecter: c}
+}
+
+type deletionProtectionConnecter struct {
+	managed.ExternalConnecter
+}
+
+func (c *deletionProtectionConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &deletionProtectionExternal{ExternalClient: e}, nil
+}
+
+type deletionProtectionExternal struct {
+	managed.ExternalClient
+}
+
+func (e *deletionProtectionExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	if DeletionProtected(mg) {
+		mg.SetConditions(v1alpha3.DeletionBlocked(ErrDeletionProtected.Error()))
+		return ErrDeletionProtected
+	}
+	return e.ExternalClient.Delete(ctx, mg)
+}
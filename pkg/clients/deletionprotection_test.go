@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+func TestDeletionProtectionConnecter(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		annotations map[string]string
+		want        error
+		wantCond    xpv1.Condition
+	}{
+		"NotProtected": {
+			want:     errBoom,
+			wantCond: xpv1.Condition{Type: v1alpha3.TypeDeletionBlocked, Status: corev1.ConditionUnknown},
+		},
+		"ProtectionDisabled": {
+			annotations: map[string]string{AnnotationKeyDeletionProtection: "disabled"},
+			want:        errBoom,
+			wantCond:    xpv1.Condition{Type: v1alpha3.TypeDeletionBlocked, Status: corev1.ConditionUnknown},
+		},
+		"Protected": {
+			annotations: map[string]string{AnnotationKeyDeletionProtection: AnnotationValueDeletionProtectionEnabled},
+			want:        ErrDeletionProtected,
+			wantCond:    v1alpha3.DeletionBlocked(ErrDeletionProtected.Error()),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewDeletionProtectionConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &fakeExternal{err: errBoom}, nil
+			}))
+			mg := &fake.Managed{}
+			mg.SetAnnotations(tc.annotations)
+
+			e, err := c.Connect(context.Background(), mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %v", err)
+			}
+			err = e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantCond, mg.GetCondition(v1alpha3.TypeDeletionBlocked), test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+
+	"github.com/crossplane-contrib/provider-azure/apis/dns/v1alpha1"
+	azuredns "github.com/crossplane-contrib/provider-azure/pkg/clients/dns"
+)
+
+var _ azuredns.ZoneAPI = &MockZoneAPI{}
+
+// MockZoneAPI is a fake implementation of dns.ZoneAPI.
+type MockZoneAPI struct {
+	MockGet            func(ctx context.Context, z *v1alpha1.Zone) (dns.Zone, error)
+	MockCreateOrUpdate func(ctx context.Context, z *v1alpha1.Zone) error
+	MockDelete         func(ctx context.Context, z *v1alpha1.Zone) error
+}
+
+// Get calls the MockZoneAPI's MockGet method.
+func (m *MockZoneAPI) Get(ctx context.Context, z *v1alpha1.Zone) (dns.Zone, error) {
+	return m.MockGet(ctx, z)
+}
+
+// CreateOrUpdate calls the MockZoneAPI's MockCreateOrUpdate method.
+func (m *MockZoneAPI) CreateOrUpdate(ctx context.Context, z *v1alpha1.Zone) error {
+	return m.MockCreateOrUpdate(ctx, z)
+}
+
+// Delete calls the MockZoneAPI's MockDelete method.
+func (m *MockZoneAPI) Delete(ctx context.Context, z *v1alpha1.Zone) error {
+	return m.MockDelete(ctx, z)
+}
+
+var _ azuredns.RecordSetAPI = &MockRecordSetAPI{}
+
+// MockRecordSetAPI is a fake implementation of dns.RecordSetAPI.
+type MockRecordSetAPI struct {
+	MockGet            func(ctx context.Context, r *v1alpha1.RecordSet) (dns.RecordSet, error)
+	MockCreateOrUpdate func(ctx context.Context, r *v1alpha1.RecordSet) error
+	MockDelete         func(ctx context.Context, r *v1alpha1.RecordSet) error
+}
+
+// Get calls the MockRecordSetAPI's MockGet method.
+func (m *MockRecordSetAPI) Get(ctx context.Context, r *v1alpha1.RecordSet) (dns.RecordSet, error) {
+	return m.MockGet(ctx, r)
+}
+
+// CreateOrUpdate calls the MockRecordSetAPI's MockCreateOrUpdate method.
+func (m *MockRecordSetAPI) CreateOrUpdate(ctx context.Context, r *v1alpha1.RecordSet) error {
+	return m.MockCreateOrUpdate(ctx, r)
+}
+
+// Delete calls the MockRecordSetAPI's MockDelete method.
+func (m *MockRecordSetAPI) Delete(ctx context.Context, r *v1alpha1.RecordSet) error {
+	return m.MockDelete(ctx, r)
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+// Azure error codes that indicate a request will keep failing until it is
+// changed, or until the subscription is, e.g. by raising its quota.
+var terminalErrorCodes = map[string]bool{
+	"InvalidParameter":                    true,
+	"InvalidParameterValue":               true,
+	"InvalidRequestContent":               true,
+	"InvalidRequestFormat":                true,
+	"InvalidResourceName":                 true,
+	"InvalidResourceLocation":             true,
+	"LocationNotAvailableForResourceType": true,
+	"MissingRequiredParameter":            true,
+	"PropertyChangeNotAllowed":            true,
+	"QuotaExceeded":                       true,
+	"OperationNotAllowed":                 true,
+	"SkuNotAvailable":                     true,
+	"NoRegisteredProviderFound":           true,
+	"AuthorizationFailed":                 true,
+	"LinkedAuthorizationFailed":           true,
+	"RequestDisallowedByPolicy":           true,
+	"SubscriptionNotRegistered":           true,
+}
+
+// Azure error codes that indicate a request may succeed if it is retried,
+// even though Azure returned 400 Bad Request.
+var retryableErrorCodes = map[string]bool{
+	"AnotherOperationInProgress": true,
+	"RetryableError":             true,
+	"OperationPreempted":         true,
+}
+
+func serviceError(err error) *azure.ServiceError {
+	var re *azure.RequestError
+	if errors.As(err, &re) && re.ServiceError != nil {
+		return re.ServiceError
+	}
+	var se *azure.ServiceError
+	if errors.As(err, &se) {
+		return se
+	}
+	return nil
+}
+
+// ErrorCode returns the error code, e.g. QuotaExceeded, that Azure returned
+// for the failed API call the supplied error stems from. It returns an empty
+// string if the error does not stem from an Azure API call, or if Azure did
+// not return a code.
+func ErrorCode(err error) string {
+	if se := serviceError(err); se != nil {
+		return se.Code
+	}
+	return ""
+}
+
+// ErrorMessage returns the message that Azure returned for the failed API
+// call the supplied error stems from, or the error itself if Azure did not
+// return a message.
+func ErrorMessage(err error) string {
+	if se := serviceError(err); se != nil && se.Message != "" {
+		return se.Message
+	}
+	return err.Error()
+}
+
+// IsTerminal returns true if the supplied error indicates that retrying the
+// call that returned it will keep failing the same way until the managed
+// resource or the Azure subscription is changed, e.g. because a parameter is
+// invalid or a quota is exceeded. Errors may declare themselves terminal by
+// implementing a Terminal method that returns true.
+func IsTerminal(err error) bool {
+	if err == nil {
+		return false
+	}
+	var t interface{ Terminal() bool }
+	if errors.As(err, &t) && t.Terminal() {
+		return true
+	}
+	code := ErrorCode(err)
+	if terminalErrorCodes[code] {
+		return true
+	}
+	if retryableErrorCodes[code] {
+		return false
+	}
+	de := autorest.DetailedError{}
+	return errors.As(err, &de) && de.Response != nil && de.Response.StatusCode == http.StatusBadRequest
+}
+
+// NewErrorConditionConnecter returns a managed.ExternalConnecter whose
+// external clients set the Ready condition of a managed resource they fail to
+// create because of an Azure error to one whose reason is the Azure error
+// code, e.g. InvalidParameter, and whose message is the Azure error message.
+func NewErrorConditionConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &errorConditionConnecter{ExternalConnecter: c}
+}
+
+type errorConditionConnecter struct {
+	managed.ExternalConnecter
+}
+
+func (c *errorConditionConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &errorConditionExternal{ExternalClient: e}, nil
+}
+
+type errorConditionExternal struct {
+	managed.ExternalClient
+}
+
+func (e *errorConditionExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.ExternalClient.Create(ctx, mg)
+	if code := ErrorCode(err); code != "" {
+		mg.SetConditions(v1alpha3.AzureError(code, ErrorMessage(err)))
+	}
+	return c, err
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+func TestErrorCode(t *testing.T) {
+	requestError := &azure.RequestError{
+		DetailedError: autorest.DetailedError{StatusCode: http.StatusConflict},
+		ServiceError:  &azure.ServiceError{Code: "QuotaExceeded"},
+	}
+
+	cases := map[string]struct {
+		err  error
+		want string
+	}{
+		"NoError": {},
+		"NotAnAzureError": {
+			err: errors.New("boom"),
+		},
+		"RequestError": {
+			err:  errors.Wrap(autorest.NewErrorWithError(requestError, "cool.Client", "CreateOrUpdate", nil, "Failure"), "cannot create"),
+			want: "QuotaExceeded",
+		},
+		"RequestErrorWithoutServiceError": {
+			err: &azure.RequestError{DetailedError: autorest.DetailedError{StatusCode: http.StatusConflict}},
+		},
+		"ServiceError": {
+			err:  errors.Wrap(&azure.ServiceError{Code: "ZonalAllocationFailed"}, "cannot create"),
+			want: "ZonalAllocationFailed",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ErrorCode(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ErrorCode(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+type terminalError struct{ error }
+
+func (terminalError) Terminal() bool { return true }
+
+func TestIsTerminal(t *testing.T) {
+	badRequest := func(code string) error {
+		return autorest.NewErrorWithError(&azure.RequestError{
+			DetailedError: autorest.DetailedError{StatusCode: http.StatusBadRequest},
+			ServiceError:  &azure.ServiceError{Code: code},
+		}, "cool.Client", "CreateOrUpdate", &http.Response{StatusCode: http.StatusBadRequest}, "Failure")
+	}
+
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"NoError": {},
+		"NotAnAzureError": {
+			err: errors.New("boom"),
+		},
+		"DeclaredTerminal": {
+			err:  errors.Wrap(terminalError{errors.New("boom")}, "cannot create"),
+			want: true,
+		},
+		"TerminalCode": {
+			err:  &azure.ServiceError{Code: "QuotaExceeded"},
+			want: true,
+		},
+		"BadRequest": {
+			err:  badRequest("SomethingIsWrong"),
+			want: true,
+		},
+		"RetryableBadRequest": {
+			err:  badRequest("AnotherOperationInProgress"),
+			want: false,
+		},
+		"Throttled": {
+			err: autorest.DetailedError{Response: &http.Response{StatusCode: http.StatusTooManyRequests}},
+		},
+		"ServerError": {
+			err: autorest.DetailedError{Response: &http.Response{StatusCode: http.StatusInternalServerError}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTerminal(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsTerminal(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestErrorConditionConnecter(t *testing.T) {
+	errInvalid := errors.Wrap(&azure.ServiceError{Code: "InvalidParameter", Message: "The value of parameter sku is invalid."}, "cannot create")
+	errBoom := errors.New("boom")
+
+	type want struct {
+		c   xpv1.Condition
+		err error
+	}
+
+	cases := map[string]struct {
+		err  error
+		want want
+	}{
+		"AzureError": {
+			err: errInvalid,
+			want: want{
+				c:   v1alpha3.AzureError("InvalidParameter", "The value of parameter sku is invalid."),
+				err: errInvalid,
+			},
+		},
+		"OtherError": {
+			err: errBoom,
+			want: want{
+				c:   xpv1.Creating(),
+				err: errBoom,
+			},
+		},
+		"Success": {
+			want: want{
+				c: xpv1.Creating(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetConditions(xpv1.Creating())
+
+			c := NewErrorConditionConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &fakeExternal{err: tc.err}, nil
+			}))
+			e, err := c.Connect(context.Background(), mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %v", err)
+			}
+			_, err = e.Create(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.c, mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
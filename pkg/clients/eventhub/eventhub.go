@@ -0,0 +1,334 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventhub
+
+import (
+	"sort"
+
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-azure/apis/messaging/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// Resource states
+const (
+	ProvisioningStateSucceeded = "Succeeded"
+)
+
+// Authorization rules whose keys are published to connection secrets.
+const (
+	// NamespaceAuthorizationRuleName is the name of the authorization rule
+	// Azure creates for every Event Hubs namespace.
+	NamespaceAuthorizationRuleName = "RootManageSharedAccessKey"
+
+	// EventHubAuthorizationRuleName is the name of the authorization rule the
+	// provider manages for Event Hubs.
+	EventHubAuthorizationRuleName = "crossplane"
+)
+
+// DefaultConsumerGroupName is the name of the consumer group Azure creates for
+// every Event Hub. It cannot be deleted.
+const DefaultConsumerGroupName = "$Default"
+
+// captureDestinationName is the only capture destination Event Hubs supports.
+const captureDestinationName = "EventHubArchive.AzureBlockBlob"
+
+// Connection secret keys of Event Hubs resources.
+const (
+	ConnectionSecretKeyPrimaryConnectionString   = "primaryConnectionString"
+	ConnectionSecretKeySecondaryConnectionString = "secondaryConnectionString"
+	ConnectionSecretKeyPrimaryKey                = "primaryKey"
+	ConnectionSecretKeySecondaryKey              = "secondaryKey"
+	ConnectionSecretKeySharedAccessKeyName       = "sharedAccessKeyName"
+)
+
+// DefaultAuthorizationRights are the rights of an Event Hub authorization rule
+// when none are specified.
+var DefaultAuthorizationRights = []string{string(eventhub.Listen), string(eventhub.SendEnumValue)}
+
+// NewNamespaceParameters returns the parameters used to create or update an
+// Azure Event Hubs namespace from the supplied EventHubNamespaceParameters.
+func NewNamespaceParameters(p v1alpha1.EventHubNamespaceParameters) eventhub.EHNamespace {
+	return eventhub.EHNamespace{
+		Location: azure.ToStringPtr(p.Location),
+		Sku: &eventhub.Sku{
+			Name:     eventhub.SkuName(p.SKU.Name),
+			Tier:     eventhub.SkuTier(p.SKU.Name),
+			Capacity: p.SKU.Capacity,
+		},
+		EHNamespaceProperties: &eventhub.EHNamespaceProperties{
+			IsAutoInflateEnabled:   p.AutoInflateEnabled,
+			MaximumThroughputUnits: p.MaximumThroughputUnits,
+			KafkaEnabled:           p.KafkaEnabled,
+		},
+		Tags: azure.ToStringPtrMap(p.Tags),
+	}
+}
+
+// GenerateNamespaceObservation produces an EventHubNamespaceObservation from
+// the supplied Azure Event Hubs namespace.
+func GenerateNamespaceObservation(az eventhub.EHNamespace) v1alpha1.EventHubNamespaceObservation {
+	o := v1alpha1.EventHubNamespaceObservation{
+		ID: azure.ToString(az.ID),
+	}
+	if az.EHNamespaceProperties != nil {
+		o.ProvisioningState = azure.ToString(az.ProvisioningState)
+		o.ServiceBusEndpoint = azure.ToString(az.ServiceBusEndpoint)
+	}
+	return o
+}
+
+// LateInitializeNamespace fills the spec values that user did not fill with
+// their corresponding value in the Azure, if there is any.
+func LateInitializeNamespace(p *v1alpha1.EventHubNamespaceParameters, az eventhub.EHNamespace) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, az.Tags)
+	if az.Sku != nil {
+		p.SKU.Capacity = azure.LateInitializeInt32PtrFromInt32Ptr(p.SKU.Capacity, az.Sku.Capacity)
+	}
+	if az.EHNamespaceProperties == nil {
+		return
+	}
+	props := az.EHNamespaceProperties
+	p.AutoInflateEnabled = azure.LateInitializeBoolPtrFromPtr(p.AutoInflateEnabled, props.IsAutoInflateEnabled)
+	p.MaximumThroughputUnits = azure.LateInitializeInt32PtrFromInt32Ptr(p.MaximumThroughputUnits, props.MaximumThroughputUnits)
+	p.KafkaEnabled = azure.LateInitializeBoolPtrFromPtr(p.KafkaEnabled, props.KafkaEnabled)
+}
+
+// IsNamespaceUpToDate returns true if the supplied Azure Event Hubs namespace
+// matches the supplied EventHubNamespaceParameters.
+func IsNamespaceUpToDate(p v1alpha1.EventHubNamespaceParameters, az eventhub.EHNamespace) bool {
+	observed := v1alpha1.EventHubNamespaceParameters{
+		Tags: azure.ToStringMap(az.Tags),
+	}
+	if az.Sku != nil {
+		observed.SKU = v1alpha1.EventHubSKU{
+			Name:     string(az.Sku.Name),
+			Capacity: az.Sku.Capacity,
+		}
+	}
+	if az.EHNamespaceProperties != nil {
+		observed.AutoInflateEnabled = az.IsAutoInflateEnabled
+		observed.MaximumThroughputUnits = az.MaximumThroughputUnits
+		observed.KafkaEnabled = az.KafkaEnabled
+	}
+	return cmp.Equal(p, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.EventHubNamespaceParameters{}, "SubscriptionID", "ResourceGroupName", "ResourceGroupNameRef", "ResourceGroupNameSelector", "Location"),
+	)
+}
+
+// NewEventHubParameters returns the parameters used to create or update an
+// Azure Event Hub from the supplied EventHubParameters.
+func NewEventHubParameters(p v1alpha1.EventHubParameters) eventhub.Model {
+	return eventhub.Model{
+		Properties: &eventhub.Properties{
+			MessageRetentionInDays: p.MessageRetentionInDays,
+			PartitionCount:         p.PartitionCount,
+			CaptureDescription:     newCaptureDescription(p.Capture),
+		},
+	}
+}
+
+// GenerateEventHubObservation produces an EventHubObservation from the
+// supplied Azure Event Hub.
+func GenerateEventHubObservation(az eventhub.Model) v1alpha1.EventHubObservation {
+	o := v1alpha1.EventHubObservation{
+		ID: azure.ToString(az.ID),
+	}
+	if az.Properties != nil {
+		o.Status = string(az.Status)
+		if az.PartitionIds != nil {
+			o.PartitionIDs = *az.PartitionIds
+		}
+	}
+	return o
+}
+
+// LateInitializeEventHub fills the spec values that user did not fill with
+// their corresponding value in the Azure, if there is any.
+func LateInitializeEventHub(p *v1alpha1.EventHubParameters, az eventhub.Model) {
+	if az.Properties == nil {
+		return
+	}
+	if p.MessageRetentionInDays == nil {
+		p.MessageRetentionInDays = az.MessageRetentionInDays
+	}
+	if p.PartitionCount == nil {
+		p.PartitionCount = az.PartitionCount
+	}
+	if p.Capture != nil && az.CaptureDescription != nil {
+		c := az.CaptureDescription
+		if p.Capture.Encoding == nil && c.Encoding != "" {
+			p.Capture.Encoding = azure.ToStringPtr(string(c.Encoding))
+		}
+		p.Capture.IntervalInSeconds = azure.LateInitializeInt32PtrFromInt32Ptr(p.Capture.IntervalInSeconds, c.IntervalInSeconds)
+		p.Capture.SizeLimitInBytes = azure.LateInitializeInt32PtrFromInt32Ptr(p.Capture.SizeLimitInBytes, c.SizeLimitInBytes)
+		p.Capture.SkipEmptyArchives = azure.LateInitializeBoolPtrFromPtr(p.Capture.SkipEmptyArchives, c.SkipEmptyArchives)
+		if c.Destination != nil && c.Destination.DestinationProperties != nil {
+			p.Capture.Destination.ArchiveNameFormat = azure.LateInitializeStringPtrFromPtr(p.Capture.Destination.ArchiveNameFormat, c.Destination.ArchiveNameFormat)
+		}
+	}
+}
+
+// IsEventHubUpToDate returns true if the supplied Azure Event Hub matches the
+// supplied EventHubParameters.
+func IsEventHubUpToDate(p v1alpha1.EventHubParameters, az eventhub.Model) bool {
+	if az.Properties == nil {
+		return false
+	}
+	observed := v1alpha1.EventHubParameters{
+		MessageRetentionInDays: az.MessageRetentionInDays,
+		PartitionCount:         az.PartitionCount,
+		Capture:                generateCapture(az.CaptureDescription),
+	}
+	// A disabled capture is equivalent to no capture at all.
+	desired := p
+	if desired.Capture != nil && !desired.Capture.Enabled {
+		desired.Capture = nil
+	}
+	if observed.Capture != nil && !observed.Capture.Enabled {
+		observed.Capture = nil
+	}
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.EventHubParameters{}, "SubscriptionID", "ResourceGroupName", "ResourceGroupNameRef", "ResourceGroupNameSelector",
+			"NamespaceName", "NamespaceNameRef", "NamespaceNameSelector", "ConsumerGroups", "AuthorizationRights"),
+	)
+}
+
+// ConsumerGroupChanges returns the consumer groups that must be created and
+// the ones that must be deleted for the supplied observed consumer groups to
+// match the desired ones. The $Default consumer group is never deleted.
+func ConsumerGroupChanges(desired, observed []string) (create []string, remove []string) {
+	want := map[string]bool{}
+	for _, n := range desired {
+		want[n] = true
+	}
+	have := map[string]bool{}
+	for _, n := range observed {
+		have[n] = true
+		if !want[n] && n != DefaultConsumerGroupName {
+			remove = append(remove, n)
+		}
+	}
+	for _, n := range desired {
+		if !have[n] && n != DefaultConsumerGroupName {
+			create = append(create, n)
+		}
+	}
+	sort.Strings(create)
+	sort.Strings(remove)
+	return create, remove
+}
+
+// NewAuthorizationRule returns an authorization rule that grants the supplied
+// rights, or DefaultAuthorizationRights if none are supplied.
+func NewAuthorizationRule(rights []string) eventhub.AuthorizationRule {
+	if len(rights) == 0 {
+		rights = DefaultAuthorizationRights
+	}
+	r := make([]eventhub.AccessRights, len(rights))
+	for i, v := range rights {
+		r[i] = eventhub.AccessRights(v)
+	}
+	return eventhub.AuthorizationRule{
+		AuthorizationRuleProperties: &eventhub.AuthorizationRuleProperties{Rights: &r},
+	}
+}
+
+// IsAuthorizationRuleUpToDate returns true if the supplied authorization rule
+// grants exactly the supplied rights, or DefaultAuthorizationRights if none
+// are supplied.
+func IsAuthorizationRuleUpToDate(rights []string, az eventhub.AuthorizationRule) bool {
+	if len(rights) == 0 {
+		rights = DefaultAuthorizationRights
+	}
+	var observed []string
+	if az.AuthorizationRuleProperties != nil && az.Rights != nil {
+		for _, r := range *az.Rights {
+			observed = append(observed, string(r))
+		}
+	}
+	return cmp.Equal(rights, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+	)
+}
+
+// ConnectionDetails returns the connection details that publish the supplied
+// authorization rule keys.
+func ConnectionDetails(k eventhub.AccessKeys) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		ConnectionSecretKeyPrimaryConnectionString:   []byte(azure.ToString(k.PrimaryConnectionString)),
+		ConnectionSecretKeySecondaryConnectionString: []byte(azure.ToString(k.SecondaryConnectionString)),
+		ConnectionSecretKeyPrimaryKey:                []byte(azure.ToString(k.PrimaryKey)),
+		ConnectionSecretKeySecondaryKey:              []byte(azure.ToString(k.SecondaryKey)),
+		ConnectionSecretKeySharedAccessKeyName:       []byte(azure.ToString(k.KeyName)),
+	}
+}
+
+func newCaptureDescription(c *v1alpha1.EventHubCapture) *eventhub.CaptureDescription {
+	if c == nil {
+		return nil
+	}
+	cd := &eventhub.CaptureDescription{
+		Enabled:           azure.ToBoolPtr(c.Enabled),
+		IntervalInSeconds: c.IntervalInSeconds,
+		SizeLimitInBytes:  c.SizeLimitInBytes,
+		SkipEmptyArchives: c.SkipEmptyArchives,
+		Destination: &eventhub.Destination{
+			Name: azure.ToStringPtr(captureDestinationName),
+			DestinationProperties: &eventhub.DestinationProperties{
+				StorageAccountResourceID: azure.ToStringPtr(c.Destination.StorageAccountID),
+				BlobContainer:            azure.ToStringPtr(c.Destination.BlobContainer),
+				ArchiveNameFormat:        c.Destination.ArchiveNameFormat,
+			},
+		},
+	}
+	if c.Encoding != nil {
+		cd.Encoding = eventhub.EncodingCaptureDescription(*c.Encoding)
+	}
+	return cd
+}
+
+func generateCapture(cd *eventhub.CaptureDescription) *v1alpha1.EventHubCapture {
+	if cd == nil {
+		return nil
+	}
+	c := &v1alpha1.EventHubCapture{
+		Enabled:           azure.ToBool(cd.Enabled),
+		IntervalInSeconds: cd.IntervalInSeconds,
+		SizeLimitInBytes:  cd.SizeLimitInBytes,
+		SkipEmptyArchives: cd.SkipEmptyArchives,
+	}
+	if cd.Encoding != "" {
+		c.Encoding = azure.ToStringPtr(string(cd.Encoding))
+	}
+	if cd.Destination != nil && cd.Destination.DestinationProperties != nil {
+		c.Destination = v1alpha1.EventHubCaptureDestination{
+			StorageAccountID:  azure.ToString(cd.Destination.StorageAccountResourceID),
+			BlobContainer:     azure.ToString(cd.Destination.BlobContainer),
+			ArchiveNameFormat: cd.Destination.ArchiveNameFormat,
+		}
+	}
+	return c
+}
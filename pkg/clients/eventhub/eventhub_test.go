@@ -0,0 +1,197 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventhub
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/messaging/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+func TestIsNamespaceUpToDate(t *testing.T) {
+	az := eventhub.EHNamespace{
+		Sku: &eventhub.Sku{Name: eventhub.Standard, Tier: eventhub.SkuTierStandard, Capacity: azure.ToInt32Ptr(1)},
+		EHNamespaceProperties: &eventhub.EHNamespaceProperties{
+			IsAutoInflateEnabled:   azure.ToBoolPtr(true),
+			MaximumThroughputUnits: azure.ToInt32Ptr(10),
+		},
+		Tags: map[string]*string{"created_by": azure.ToStringPtr("crossplane")},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.EventHubNamespaceParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.EventHubNamespaceParameters{
+				Location:               "westeurope",
+				SKU:                    v1alpha1.EventHubSKU{Name: "Standard", Capacity: azure.ToInt32Ptr(1)},
+				AutoInflateEnabled:     azure.ToBoolPtr(true),
+				MaximumThroughputUnits: azure.ToInt32Ptr(10),
+				Tags:                   map[string]string{"created_by": "crossplane"},
+			},
+			want: true,
+		},
+		"ThroughputUnitsChanged": {
+			p: v1alpha1.EventHubNamespaceParameters{
+				SKU:                    v1alpha1.EventHubSKU{Name: "Standard", Capacity: azure.ToInt32Ptr(2)},
+				AutoInflateEnabled:     azure.ToBoolPtr(true),
+				MaximumThroughputUnits: azure.ToInt32Ptr(10),
+				Tags:                   map[string]string{"created_by": "crossplane"},
+			},
+			want: false,
+		},
+		"AutoInflateChanged": {
+			p: v1alpha1.EventHubNamespaceParameters{
+				SKU:                    v1alpha1.EventHubSKU{Name: "Standard", Capacity: azure.ToInt32Ptr(1)},
+				AutoInflateEnabled:     azure.ToBoolPtr(true),
+				MaximumThroughputUnits: azure.ToInt32Ptr(20),
+				Tags:                   map[string]string{"created_by": "crossplane"},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsNamespaceUpToDate(tc.p, az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsNamespaceUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsEventHubUpToDate(t *testing.T) {
+	capture := func(enabled bool, interval int32) *v1alpha1.EventHubCapture {
+		return &v1alpha1.EventHubCapture{
+			Enabled:           enabled,
+			Encoding:          azure.ToStringPtr("Avro"),
+			IntervalInSeconds: azure.ToInt32Ptr(int(interval)),
+			Destination: v1alpha1.EventHubCaptureDestination{
+				StorageAccountID: "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Storage/storageAccounts/coolstorage",
+				BlobContainer:    "capture",
+			},
+		}
+	}
+	azureHub := func(c *v1alpha1.EventHubCapture) eventhub.Model {
+		return eventhub.Model{Properties: &eventhub.Properties{
+			MessageRetentionInDays: to.Int64Ptr(1),
+			PartitionCount:         to.Int64Ptr(2),
+			CaptureDescription:     newCaptureDescription(c),
+		}}
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.EventHubParameters
+		az   eventhub.Model
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.EventHubParameters{
+				NamespaceName:          "coolNamespace",
+				MessageRetentionInDays: to.Int64Ptr(1),
+				PartitionCount:         to.Int64Ptr(2),
+				Capture:                capture(true, 300),
+				ConsumerGroups:         []string{"analytics"},
+			},
+			az:   azureHub(capture(true, 300)),
+			want: true,
+		},
+		"DisabledCaptureMatchesNone": {
+			p: v1alpha1.EventHubParameters{
+				MessageRetentionInDays: to.Int64Ptr(1),
+				PartitionCount:         to.Int64Ptr(2),
+				Capture:                capture(false, 300),
+			},
+			az:   azureHub(nil),
+			want: true,
+		},
+		"CaptureChanged": {
+			p: v1alpha1.EventHubParameters{
+				MessageRetentionInDays: to.Int64Ptr(1),
+				PartitionCount:         to.Int64Ptr(2),
+				Capture:                capture(true, 600),
+			},
+			az:   azureHub(capture(true, 300)),
+			want: false,
+		},
+		"RetentionChanged": {
+			p: v1alpha1.EventHubParameters{
+				MessageRetentionInDays: to.Int64Ptr(7),
+				PartitionCount:         to.Int64Ptr(2),
+			},
+			az:   azureHub(nil),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsEventHubUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsEventHubUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConsumerGroupChanges(t *testing.T) {
+	type want struct {
+		create []string
+		remove []string
+	}
+
+	cases := map[string]struct {
+		desired  []string
+		observed []string
+		want     want
+	}{
+		"NoChanges": {
+			desired:  []string{"analytics"},
+			observed: []string{DefaultConsumerGroupName, "analytics"},
+		},
+		"DefaultIsKept": {
+			observed: []string{DefaultConsumerGroupName},
+		},
+		"CreateAndRemove": {
+			desired:  []string{"reporting", DefaultConsumerGroupName, "analytics"},
+			observed: []string{DefaultConsumerGroupName, "stale", "legacy"},
+			want: want{
+				create: []string{"analytics", "reporting"},
+				remove: []string{"legacy", "stale"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, remove := ConsumerGroupChanges(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.create, create); diff != "" {
+				t.Errorf("ConsumerGroupChanges(...): -want create, +got create:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("ConsumerGroupChanges(...): -want remove, +got remove:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub/eventhubapi"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+var _ eventhubapi.NamespacesClientAPI = &MockNamespacesClient{}
+
+// MockNamespacesClient is a fake implementation of eventhub.NamespacesClient.
+type MockNamespacesClient struct {
+	eventhubapi.NamespacesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, namespaceName string, parameters eventhub.EHNamespace) (result eventhub.NamespacesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, namespaceName string) (result eventhub.NamespacesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, namespaceName string) (result eventhub.EHNamespace, err error)
+	MockListKeys       func(ctx context.Context, resourceGroupName string, namespaceName string, authorizationRuleName string) (result eventhub.AccessKeys, err error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, namespaceName string, parameters eventhub.EHNamespace) (result eventhub.EHNamespace, err error)
+}
+
+// CreateOrUpdate calls the MockNamespacesClient's MockCreateOrUpdate method.
+func (c *MockNamespacesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, namespaceName string, parameters eventhub.EHNamespace) (result eventhub.NamespacesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, namespaceName, parameters)
+}
+
+// Delete calls the MockNamespacesClient's MockDelete method.
+func (c *MockNamespacesClient) Delete(ctx context.Context, resourceGroupName string, namespaceName string) (result eventhub.NamespacesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, namespaceName)
+}
+
+// Get calls the MockNamespacesClient's MockGet method.
+func (c *MockNamespacesClient) Get(ctx context.Context, resourceGroupName string, namespaceName string) (result eventhub.EHNamespace, err error) {
+	return c.MockGet(ctx, resourceGroupName, namespaceName)
+}
+
+// ListKeys calls the MockNamespacesClient's MockListKeys method.
+func (c *MockNamespacesClient) ListKeys(ctx context.Context, resourceGroupName string, namespaceName string, authorizationRuleName string) (result eventhub.AccessKeys, err error) {
+	return c.MockListKeys(ctx, resourceGroupName, namespaceName, authorizationRuleName)
+}
+
+// Update calls the MockNamespacesClient's MockUpdate method.
+func (c *MockNamespacesClient) Update(ctx context.Context, resourceGroupName string, namespaceName string, parameters eventhub.EHNamespace) (result eventhub.EHNamespace, err error) {
+	return c.MockUpdate(ctx, resourceGroupName, namespaceName, parameters)
+}
+
+var _ eventhubapi.EventHubsClientAPI = &MockEventHubsClient{}
+
+// MockEventHubsClient is a fake implementation of eventhub.EventHubsClient.
+type MockEventHubsClient struct {
+	eventhubapi.EventHubsClientAPI
+
+	MockCreateOrUpdate                  func(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string, parameters eventhub.Model) (result eventhub.Model, err error)
+	MockCreateOrUpdateAuthorizationRule func(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string, authorizationRuleName string, parameters eventhub.AuthorizationRule) (result eventhub.AuthorizationRule, err error)
+	MockDelete                          func(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string) (result autorest.Response, err error)
+	MockGet                             func(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string) (result eventhub.Model, err error)
+	MockGetAuthorizationRule            func(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string, authorizationRuleName string) (result eventhub.AuthorizationRule, err error)
+	MockListKeys                        func(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string, authorizationRuleName string) (result eventhub.AccessKeys, err error)
+}
+
+// CreateOrUpdate calls the MockEventHubsClient's MockCreateOrUpdate method.
+func (c *MockEventHubsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string, parameters eventhub.Model) (result eventhub.Model, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, namespaceName, eventHubName, parameters)
+}
+
+// CreateOrUpdateAuthorizationRule calls the MockEventHubsClient's
+// MockCreateOrUpdateAuthorizationRule method.
+func (c *MockEventHubsClient) CreateOrUpdateAuthorizationRule(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string, authorizationRuleName string, parameters eventhub.AuthorizationRule) (result eventhub.AuthorizationRule, err error) {
+	return c.MockCreateOrUpdateAuthorizationRule(ctx, resourceGroupName, namespaceName, eventHubName, authorizationRuleName, parameters)
+}
+
+// Delete calls the MockEventHubsClient's MockDelete method.
+func (c *MockEventHubsClient) Delete(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string) (result autorest.Response, err error) {
+	return c.MockDelete(ctx, resourceGroupName, namespaceName, eventHubName)
+}
+
+// Get calls the MockEventHubsClient's MockGet method.
+func (c *MockEventHubsClient) Get(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string) (result eventhub.Model, err error) {
+	return c.MockGet(ctx, resourceGroupName, namespaceName, eventHubName)
+}
+
+// GetAuthorizationRule calls the MockEventHubsClient's
+// MockGetAuthorizationRule method.
+func (c *MockEventHubsClient) GetAuthorizationRule(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string, authorizationRuleName string) (result eventhub.AuthorizationRule, err error) {
+	return c.MockGetAuthorizationRule(ctx, resourceGroupName, namespaceName, eventHubName, authorizationRuleName)
+}
+
+// ListKeys calls the MockEventHubsClient's MockListKeys method.
+func (c *MockEventHubsClient) ListKeys(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string, authorizationRuleName string) (result eventhub.AccessKeys, err error) {
+	return c.MockListKeys(ctx, resourceGroupName, namespaceName, eventHubName, authorizationRuleName)
+}
+
+var _ eventhubapi.ConsumerGroupsClientAPI = &MockConsumerGroupsClient{}
+
+// MockConsumerGroupsClient is a fake implementation of
+// eventhub.ConsumerGroupsClient.
+type MockConsumerGroupsClient struct {
+	eventhubapi.ConsumerGroupsClientAPI
+
+	MockCreateOrUpdate         func(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string, consumerGroupName string, parameters eventhub.ConsumerGroup) (result eventhub.ConsumerGroup, err error)
+	MockDelete                 func(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string, consumerGroupName string) (result autorest.Response, err error)
+	MockListByEventHubComplete func(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string, skip *int32, top *int32) (result eventhub.ConsumerGroupListResultIterator, err error)
+}
+
+// CreateOrUpdate calls the MockConsumerGroupsClient's MockCreateOrUpdate
+// method.
+func (c *MockConsumerGroupsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string, consumerGroupName string, parameters eventhub.ConsumerGroup) (result eventhub.ConsumerGroup, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, namespaceName, eventHubName, consumerGroupName, parameters)
+}
+
+// Delete calls the MockConsumerGroupsClient's MockDelete method.
+func (c *MockConsumerGroupsClient) Delete(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string, consumerGroupName string) (result autorest.Response, err error) {
+	return c.MockDelete(ctx, resourceGroupName, namespaceName, eventHubName, consumerGroupName)
+}
+
+// ListByEventHubComplete calls the MockConsumerGroupsClient's
+// MockListByEventHubComplete method.
+func (c *MockConsumerGroupsClient) ListByEventHubComplete(ctx context.Context, resourceGroupName string, namespaceName string, eventHubName string, skip *int32, top *int32) (result eventhub.ConsumerGroupListResultIterator, err error) {
+	return c.MockListByEventHubComplete(ctx, resourceGroupName, namespaceName, eventHubName, skip, top)
+}
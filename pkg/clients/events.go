@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Reasons of the events emitted over the lifecycle of the external resource
+// of a managed resource.
+const (
+	ReasonCreating     event.Reason = "Creating"
+	ReasonCreateFailed event.Reason = "CreateFailed"
+	ReasonReady        event.Reason = "Ready"
+	ReasonDeleting     event.Reason = "Deleting"
+	ReasonDeleteFailed event.Reason = "DeleteFailed"
+)
+
+// NewEventConnecter returns a managed.ExternalConnecter whose external clients
+// record events when they start creating or deleting the external resource of
+// a managed resource, when they fail to, and when the external resource
+// becomes ready. These let users follow the progress of a managed resource
+// using kubectl describe.
+func NewEventConnecter(r event.Recorder, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &eventConnecter{ExternalConnecter: c, record: r}
+}
+
+type eventConnecter struct {
+	managed.ExternalConnecter
+	record event.Recorder
+}
+
+func (c *eventConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &eventExternal{ExternalClient: e, record: c.record}, nil
+}
+
+type eventExternal struct {
+	managed.ExternalClient
+	record event.Recorder
+}
+
+func (e *eventExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	wasReady := mg.GetCondition(xpv1.TypeReady).Reason == xpv1.ReasonAvailable
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if err == nil && !wasReady && mg.GetCondition(xpv1.TypeReady).Reason == xpv1.ReasonAvailable {
+		e.record.Event(mg, event.Normal(ReasonReady, "Azure resource is ready"))
+	}
+	return o, err
+}
+
+func (e *eventExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.ExternalClient.Create(ctx, mg)
+	if err != nil {
+		e.record.Event(mg, event.Warning(ReasonCreateFailed, withErrorCode(err)))
+		return c, err
+	}
+	e.record.Event(mg, event.Normal(ReasonCreating, "Started creating Azure resource"))
+	return c, nil
+}
+
+func (e *eventExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	err := e.ExternalClient.Delete(ctx, mg)
+	if err != nil {
+		e.record.Event(mg, event.Warning(ReasonDeleteFailed, withErrorCode(err)))
+		return err
+	}
+	e.record.Event(mg, event.Normal(ReasonDeleting, "Started deleting Azure resource"))
+	return nil
+}
+
+// withErrorCode returns the supplied error, prefixed with the Azure error code
+// if there is one. Event messages may be truncated, so the code goes first.
+func withErrorCode(err error) error {
+	if c := ErrorCode(err); c != "" {
+		return errors.Errorf("%s: %s", c, err)
+	}
+	return err
+}
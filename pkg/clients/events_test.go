@@ -0,0 +1,155 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *recorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+type conditionExternal struct {
+	fakeExternal
+	c xpv1.Condition
+}
+
+func (e *conditionExternal) Observe(_ context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	mg.SetConditions(e.c)
+	return managed.ExternalObservation{ResourceExists: true}, e.err
+}
+
+func TestEventConnecter(t *testing.T) {
+	errQuota := &azure.ServiceError{Code: "QuotaExceeded", Message: "boom"}
+
+	type want struct {
+		reasons []event.Reason
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		e    *conditionExternal
+		op   func(context.Context, managed.ExternalClient, resource.Managed)
+		want want
+	}{
+		"BecameReady": {
+			mg: &fake.Managed{},
+			e:  &conditionExternal{c: xpv1.Available()},
+			op: func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) {
+				_, _ = e.Observe(ctx, mg)
+			},
+			want: want{reasons: []event.Reason{ReasonReady}},
+		},
+		"StillReady": {
+			mg: &fake.Managed{ConditionedStatus: xpv1.ConditionedStatus{Conditions: []xpv1.Condition{xpv1.Available()}}},
+			e:  &conditionExternal{c: xpv1.Available()},
+			op: func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) {
+				_, _ = e.Observe(ctx, mg)
+			},
+		},
+		"NotReady": {
+			mg: &fake.Managed{},
+			e:  &conditionExternal{c: xpv1.Creating()},
+			op: func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) {
+				_, _ = e.Observe(ctx, mg)
+			},
+		},
+		"Creating": {
+			mg: &fake.Managed{},
+			e:  &conditionExternal{},
+			op: func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) {
+				_, _ = e.Create(ctx, mg)
+			},
+			want: want{reasons: []event.Reason{ReasonCreating}},
+		},
+		"CreateFailed": {
+			mg: &fake.Managed{},
+			e:  &conditionExternal{fakeExternal: fakeExternal{err: errQuota}},
+			op: func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) {
+				_, _ = e.Create(ctx, mg)
+			},
+			want: want{reasons: []event.Reason{ReasonCreateFailed}},
+		},
+		"Deleting": {
+			mg: &fake.Managed{},
+			e:  &conditionExternal{},
+			op: func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) {
+				_ = e.Delete(ctx, mg)
+			},
+			want: want{reasons: []event.Reason{ReasonDeleting}},
+		},
+		"DeleteFailed": {
+			mg: &fake.Managed{},
+			e:  &conditionExternal{fakeExternal: fakeExternal{err: errQuota}},
+			op: func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) {
+				_ = e.Delete(ctx, mg)
+			},
+			want: want{reasons: []event.Reason{ReasonDeleteFailed}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &recorder{}
+			c := NewEventConnecter(r, managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return tc.e, nil
+			}))
+			e, err := c.Connect(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %v", err)
+			}
+			tc.op(context.Background(), e, tc.mg)
+
+			var got []event.Reason
+			for _, ev := range r.events {
+				got = append(got, ev.Reason)
+			}
+			if diff := cmp.Diff(tc.want.reasons, got); diff != "" {
+				t.Errorf("-want reasons, +got reasons:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithErrorCode(t *testing.T) {
+	err := errors.Wrap(&azure.ServiceError{Code: "QuotaExceeded", Message: "boom"}, "cannot create")
+	want := "QuotaExceeded: " + err.Error()
+	if diff := cmp.Diff(want, withErrorCode(err).Error()); diff != "" {
+		t.Errorf("withErrorCode(...): -want, +got:\n%s", diff)
+	}
+}
@@ -128,3 +128,55 @@ func (c *MockPostgreSQLFirewallRulesClient) Delete(ctx context.Context, resource
 func (c *MockPostgreSQLFirewallRulesClient) Get(ctx context.Context, resourceGroupName string, serverName string, firewallRuleName string) (result postgresql.FirewallRule, err error) {
 	return c.MockGet(ctx, resourceGroupName, serverName, firewallRuleName)
 }
+
+var _ mysqlapi.DatabasesClientAPI = &MockMySQLDatabasesClient{}
+
+// MockMySQLDatabasesClient is a fake implementation of mysql.DatabasesClient.
+type MockMySQLDatabasesClient struct {
+	mysqlapi.DatabasesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters mysql.Database) (result mysql.DatabasesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result mysql.DatabasesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result mysql.Database, err error)
+}
+
+// CreateOrUpdate calls the MockMySQLDatabasesClient's MockCreateOrUpdate method.
+func (c *MockMySQLDatabasesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters mysql.Database) (result mysql.DatabasesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, databaseName, parameters)
+}
+
+// Delete calls the MockMySQLDatabasesClient's MockDelete method.
+func (c *MockMySQLDatabasesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result mysql.DatabasesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, databaseName)
+}
+
+// Get calls the MockMySQLDatabasesClient's MockGet method.
+func (c *MockMySQLDatabasesClient) Get(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result mysql.Database, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, databaseName)
+}
+
+var _ postgresqlapi.DatabasesClientAPI = &MockPostgreSQLDatabasesClient{}
+
+// MockPostgreSQLDatabasesClient is a fake implementation of postgresql.DatabasesClient.
+type MockPostgreSQLDatabasesClient struct {
+	postgresqlapi.DatabasesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters postgresql.Database) (result postgresql.DatabasesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result postgresql.DatabasesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result postgresql.Database, err error)
+}
+
+// CreateOrUpdate calls the MockPostgreSQLDatabasesClient's MockCreateOrUpdate method.
+func (c *MockPostgreSQLDatabasesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters postgresql.Database) (result postgresql.DatabasesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, databaseName, parameters)
+}
+
+// Delete calls the MockPostgreSQLDatabasesClient's MockDelete method.
+func (c *MockPostgreSQLDatabasesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result postgresql.DatabasesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, databaseName)
+}
+
+// Get calls the MockPostgreSQLDatabasesClient's MockGet method.
+func (c *MockPostgreSQLDatabasesClient) Get(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result postgresql.Database, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, databaseName)
+}
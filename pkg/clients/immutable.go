@@ -0,0 +1,78 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+// Paths of the fields that most managed resources cannot change after the
+// external resource is created.
+const (
+	FieldPathExternalName  = "metadata.annotations[crossplane.io/external-name]"
+	FieldPathResourceGroup = "spec.forProvider.resourceGroupName"
+	FieldPathLocation      = "spec.forProvider.location"
+)
+
+// ImmutableFieldChanges returns the paths of the fields that identify the
+// external resource with the supplied ID, i.e. its resource group and name,
+// whose desired values differ from the ID. Nothing is returned if the ID
+// cannot be parsed, e.g. because the external resource was never observed.
+func ImmutableFieldChanges(id, resourceGroup, name string) []string {
+	r, err := azure.ParseResourceID(id)
+	if err != nil {
+		return nil
+	}
+	var fields []string
+	if !strings.EqualFold(r.ResourceName, name) {
+		fields = append(fields, FieldPathExternalName)
+	}
+	if !strings.EqualFold(r.ResourceGroup, resourceGroup) {
+		fields = append(fields, FieldPathResourceGroup)
+	}
+	return fields
+}
+
+// LocationChanged returns true if the desired location differs from the
+// observed one. Azure reports locations by name, e.g. westus2, even if they
+// were requested by display name, e.g. West US 2.
+func LocationChanged(desired, observed string) bool {
+	normalize := func(l string) string { return strings.ToLower(strings.ReplaceAll(l, " ", "")) }
+	return observed != "" && normalize(desired) != normalize(observed)
+}
+
+// SetImmutableFieldCondition sets the CannotUpdateImmutableField condition of
+// the supplied managed resource if any immutable fields were changed, and
+// returns true. Otherwise it resets the condition, if it was ever set, and
+// returns false.
+func SetImmutableFieldCondition(mg resource.Managed, fields []string) bool {
+	if len(fields) > 0 {
+		mg.SetConditions(v1alpha3.CannotUpdateImmutableField(fields...))
+		return true
+	}
+	if mg.GetCondition(v1alpha3.TypeCannotUpdateImmutableField).Status == corev1.ConditionTrue {
+		mg.SetConditions(v1alpha3.ImmutableFieldsUnchanged())
+	}
+	return false
+}
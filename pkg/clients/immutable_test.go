@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+func TestImmutableFieldChanges(t *testing.T) {
+	id := "/subscriptions/coolsub/resourceGroups/coolgroup/providers/Microsoft.DBforMySQL/servers/coolserver"
+
+	cases := map[string]struct {
+		id            string
+		resourceGroup string
+		name          string
+		want          []string
+	}{
+		"NotObserved": {
+			resourceGroup: "coolgroup",
+			name:          "coolserver",
+		},
+		"Unchanged": {
+			id:            id,
+			resourceGroup: "CoolGroup",
+			name:          "coolserver",
+		},
+		"NameChanged": {
+			id:            id,
+			resourceGroup: "coolgroup",
+			name:          "newserver",
+			want:          []string{FieldPathExternalName},
+		},
+		"BothChanged": {
+			id:            id,
+			resourceGroup: "newgroup",
+			name:          "newserver",
+			want:          []string{FieldPathExternalName, FieldPathResourceGroup},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ImmutableFieldChanges(tc.id, tc.resourceGroup, tc.name)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ImmutableFieldChanges(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLocationChanged(t *testing.T) {
+	cases := map[string]struct {
+		desired  string
+		observed string
+		want     bool
+	}{
+		"NotObserved":   {desired: "westus2"},
+		"Same":          {desired: "westus2", observed: "westus2"},
+		"DisplayName":   {desired: "West US 2", observed: "westus2"},
+		"LocationMoved": {desired: "eastus", observed: "westus2", want: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := LocationChanged(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("LocationChanged(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetImmutableFieldCondition(t *testing.T) {
+	type want struct {
+		changed bool
+		cond    xpv1.Condition
+	}
+	cases := map[string]struct {
+		existing []xpv1.Condition
+		fields   []string
+		want     want
+	}{
+		"Changed": {
+			fields: []string{FieldPathLocation},
+			want: want{
+				changed: true,
+				cond:    v1alpha3.CannotUpdateImmutableField(FieldPathLocation),
+			},
+		},
+		"NeverChanged": {
+			want: want{
+				cond: xpv1.Condition{Type: v1alpha3.TypeCannotUpdateImmutableField, Status: corev1.ConditionUnknown},
+			},
+		},
+		"Reverted": {
+			existing: []xpv1.Condition{v1alpha3.CannotUpdateImmutableField(FieldPathLocation)},
+			want: want{
+				cond: v1alpha3.ImmutableFieldsUnchanged(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &v1beta1.MySQLServer{}
+			mg.SetConditions(tc.existing...)
+			got := SetImmutableFieldCondition(mg, tc.fields)
+			if diff := cmp.Diff(tc.want.changed, got); diff != "" {
+				t.Errorf("SetImmutableFieldCondition(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cond, mg.GetCondition(v1alpha3.TypeCannotUpdateImmutableField), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("GetCondition(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package insights
+
+import (
+	"strings"
+
+	monitor "github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	"github.com/crossplane-contrib/provider-azure/apis/insights/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// NewDiagnosticSettingParameters returns the parameters used to create or
+// update an Azure diagnostic setting from the supplied
+// DiagnosticSettingParameters.
+func NewDiagnosticSettingParameters(p v1alpha1.DiagnosticSettingParameters) monitor.DiagnosticSettingsResource {
+	props := &monitor.DiagnosticSettings{
+		WorkspaceID:                 p.WorkspaceID,
+		LogAnalyticsDestinationType: p.LogAnalyticsDestinationType,
+		StorageAccountID:            p.StorageAccountID,
+		EventHubAuthorizationRuleID: p.EventHubAuthorizationRuleID,
+		EventHubName:                p.EventHubName,
+	}
+	if len(p.Logs) > 0 {
+		logs := make([]monitor.LogSettings, len(p.Logs))
+		for i, l := range p.Logs {
+			logs[i] = monitor.LogSettings{
+				Category:        azure.ToStringPtr(l.Category),
+				Enabled:         to.BoolPtr(isCategoryEnabled(l.Enabled)),
+				RetentionPolicy: newRetentionPolicy(l.RetentionDays),
+			}
+		}
+		props.Logs = &logs
+	}
+	if len(p.Metrics) > 0 {
+		metrics := make([]monitor.MetricSettings, len(p.Metrics))
+		for i, m := range p.Metrics {
+			metrics[i] = monitor.MetricSettings{
+				Category:        azure.ToStringPtr(m.Category),
+				Enabled:         to.BoolPtr(isCategoryEnabled(m.Enabled)),
+				RetentionPolicy: newRetentionPolicy(m.RetentionDays),
+			}
+		}
+		props.Metrics = &metrics
+	}
+	return monitor.DiagnosticSettingsResource{DiagnosticSettings: props}
+}
+
+// GenerateDiagnosticSettingObservation produces a
+// DiagnosticSettingObservation from the supplied Azure diagnostic setting.
+func GenerateDiagnosticSettingObservation(az monitor.DiagnosticSettingsResource) v1alpha1.DiagnosticSettingObservation {
+	return v1alpha1.DiagnosticSettingObservation{
+		ID: azure.ToString(az.ID),
+	}
+}
+
+// IsDiagnosticSettingUpToDate returns true if the supplied Azure diagnostic
+// setting matches the supplied DiagnosticSettingParameters.
+func IsDiagnosticSettingUpToDate(p v1alpha1.DiagnosticSettingParameters, az monitor.DiagnosticSettingsResource) bool {
+	props := az.DiagnosticSettings
+	if props == nil {
+		return false
+	}
+	// Azure does not preserve the case of resource IDs.
+	switch {
+	case !strings.EqualFold(azure.ToString(p.WorkspaceID), azure.ToString(props.WorkspaceID)),
+		!strings.EqualFold(azure.ToString(p.StorageAccountID), azure.ToString(props.StorageAccountID)),
+		!strings.EqualFold(azure.ToString(p.EventHubAuthorizationRuleID), azure.ToString(props.EventHubAuthorizationRuleID)),
+		azure.ToString(p.EventHubName) != azure.ToString(props.EventHubName):
+		return false
+	}
+	if p.LogAnalyticsDestinationType != nil && !strings.EqualFold(*p.LogAnalyticsDestinationType, azure.ToString(props.LogAnalyticsDestinationType)) {
+		return false
+	}
+
+	desired := map[string]categorySettings{}
+	for _, l := range p.Logs {
+		desired["log/"+strings.ToLower(l.Category)] = categorySettings{enabled: isCategoryEnabled(l.Enabled), days: to.Int32(l.RetentionDays)}
+	}
+	for _, m := range p.Metrics {
+		desired["metric/"+strings.ToLower(m.Category)] = categorySettings{enabled: isCategoryEnabled(m.Enabled), days: to.Int32(m.RetentionDays)}
+	}
+	observed := map[string]categorySettings{}
+	if props.Logs != nil {
+		for _, l := range *props.Logs {
+			observed["log/"+strings.ToLower(azure.ToString(l.Category))] = categorySettings{enabled: azure.ToBool(l.Enabled), days: retentionDays(l.RetentionPolicy)}
+		}
+	}
+	if props.Metrics != nil {
+		for _, m := range *props.Metrics {
+			observed["metric/"+strings.ToLower(azure.ToString(m.Category))] = categorySettings{enabled: azure.ToBool(m.Enabled), days: retentionDays(m.RetentionPolicy)}
+		}
+	}
+
+	for k, d := range desired {
+		if observed[k] != d {
+			return false
+		}
+	}
+	// Azure may report every category the resource supports, but those that
+	// were not asked for must not be routed.
+	for k, o := range observed {
+		if _, ok := desired[k]; !ok && o.enabled {
+			return false
+		}
+	}
+	return true
+}
+
+type categorySettings struct {
+	enabled bool
+	days    int32
+}
+
+// isCategoryEnabled returns whether a log or metric category is enabled.
+// Categories are enabled unless explicitly disabled.
+func isCategoryEnabled(enabled *bool) bool {
+	return enabled == nil || *enabled
+}
+
+func newRetentionPolicy(days *int32) *monitor.RetentionPolicy {
+	if to.Int32(days) == 0 {
+		return &monitor.RetentionPolicy{Enabled: to.BoolPtr(false), Days: to.Int32Ptr(0)}
+	}
+	return &monitor.RetentionPolicy{Enabled: to.BoolPtr(true), Days: days}
+}
+
+func retentionDays(p *monitor.RetentionPolicy) int32 {
+	if p == nil || !azure.ToBool(p.Enabled) {
+		return 0
+	}
+	return to.Int32(p.Days)
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package insights
+
+import (
+	"testing"
+
+	monitor "github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/insights/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+func TestNewDiagnosticSettingParameters(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DiagnosticSettingParameters
+		want monitor.DiagnosticSettingsResource
+	}{
+		"Workspace": {
+			p: v1alpha1.DiagnosticSettingParameters{
+				WorkspaceID: azure.ToStringPtr(workspaceID),
+				Logs:        []v1alpha1.DiagnosticLogSettings{{Category: "AuditEvent"}},
+				Metrics:     []v1alpha1.DiagnosticMetricSettings{{Category: "AllMetrics", Enabled: to.BoolPtr(false)}},
+			},
+			want: monitor.DiagnosticSettingsResource{
+				DiagnosticSettings: &monitor.DiagnosticSettings{
+					WorkspaceID: azure.ToStringPtr(workspaceID),
+					Logs: &[]monitor.LogSettings{{
+						Category:        azure.ToStringPtr("AuditEvent"),
+						Enabled:         to.BoolPtr(true),
+						RetentionPolicy: &monitor.RetentionPolicy{Enabled: to.BoolPtr(false), Days: to.Int32Ptr(0)},
+					}},
+					Metrics: &[]monitor.MetricSettings{{
+						Category:        azure.ToStringPtr("AllMetrics"),
+						Enabled:         to.BoolPtr(false),
+						RetentionPolicy: &monitor.RetentionPolicy{Enabled: to.BoolPtr(false), Days: to.Int32Ptr(0)},
+					}},
+				},
+			},
+		},
+		"StorageRetention": {
+			p: v1alpha1.DiagnosticSettingParameters{
+				StorageAccountID: azure.ToStringPtr("storage"),
+				Logs:             []v1alpha1.DiagnosticLogSettings{{Category: "AuditEvent", RetentionDays: to.Int32Ptr(30)}},
+			},
+			want: monitor.DiagnosticSettingsResource{
+				DiagnosticSettings: &monitor.DiagnosticSettings{
+					StorageAccountID: azure.ToStringPtr("storage"),
+					Logs: &[]monitor.LogSettings{{
+						Category:        azure.ToStringPtr("AuditEvent"),
+						Enabled:         to.BoolPtr(true),
+						RetentionPolicy: &monitor.RetentionPolicy{Enabled: to.BoolPtr(true), Days: to.Int32Ptr(30)},
+					}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewDiagnosticSettingParameters(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewDiagnosticSettingParameters(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDiagnosticSettingUpToDate(t *testing.T) {
+	az := monitor.DiagnosticSettingsResource{
+		DiagnosticSettings: &monitor.DiagnosticSettings{
+			WorkspaceID: azure.ToStringPtr(workspaceID),
+			Logs: &[]monitor.LogSettings{
+				{Category: azure.ToStringPtr("AuditEvent"), Enabled: to.BoolPtr(true), RetentionPolicy: &monitor.RetentionPolicy{Enabled: to.BoolPtr(false), Days: to.Int32Ptr(0)}},
+				{Category: azure.ToStringPtr("AzurePolicyEvaluationDetails"), Enabled: to.BoolPtr(false)},
+			},
+			Metrics: &[]monitor.MetricSettings{
+				{Category: azure.ToStringPtr("AllMetrics"), Enabled: to.BoolPtr(true)},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.DiagnosticSettingParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.DiagnosticSettingParameters{
+				WorkspaceID: azure.ToStringPtr(workspaceID),
+				Logs:        []v1alpha1.DiagnosticLogSettings{{Category: "AuditEvent"}},
+				Metrics:     []v1alpha1.DiagnosticMetricSettings{{Category: "AllMetrics", Enabled: to.BoolPtr(true)}},
+			},
+			want: true,
+		},
+		"SubscriptionOverridden": {
+			p: v1alpha1.DiagnosticSettingParameters{
+				SubscriptionID: azure.ToStringPtr("other"),
+				WorkspaceID:    azure.ToStringPtr(workspaceID),
+				Logs:           []v1alpha1.DiagnosticLogSettings{{Category: "AuditEvent"}},
+				Metrics:        []v1alpha1.DiagnosticMetricSettings{{Category: "AllMetrics", Enabled: to.BoolPtr(true)}},
+			},
+			want: true,
+		},
+		"WorkspaceIDCaseDiffers": {
+			p: v1alpha1.DiagnosticSettingParameters{
+				WorkspaceID: azure.ToStringPtr("/subscriptions/sub/resourcegroups/coolrg/providers/microsoft.operationalinsights/workspaces/coolworkspace"),
+				Logs:        []v1alpha1.DiagnosticLogSettings{{Category: "auditevent"}},
+				Metrics:     []v1alpha1.DiagnosticMetricSettings{{Category: "AllMetrics"}},
+			},
+			want: true,
+		},
+		"DestinationChanged": {
+			p: v1alpha1.DiagnosticSettingParameters{
+				StorageAccountID: azure.ToStringPtr("storage"),
+				Logs:             []v1alpha1.DiagnosticLogSettings{{Category: "AuditEvent"}},
+				Metrics:          []v1alpha1.DiagnosticMetricSettings{{Category: "AllMetrics"}},
+			},
+			want: false,
+		},
+		"CategoryAdded": {
+			p: v1alpha1.DiagnosticSettingParameters{
+				WorkspaceID: azure.ToStringPtr(workspaceID),
+				Logs:        []v1alpha1.DiagnosticLogSettings{{Category: "AuditEvent"}, {Category: "AzurePolicyEvaluationDetails"}},
+				Metrics:     []v1alpha1.DiagnosticMetricSettings{{Category: "AllMetrics"}},
+			},
+			want: false,
+		},
+		"CategoryRemoved": {
+			p: v1alpha1.DiagnosticSettingParameters{
+				WorkspaceID: azure.ToStringPtr(workspaceID),
+				Logs:        []v1alpha1.DiagnosticLogSettings{{Category: "AuditEvent"}},
+			},
+			want: false,
+		},
+		"RetentionChanged": {
+			p: v1alpha1.DiagnosticSettingParameters{
+				WorkspaceID: azure.ToStringPtr(workspaceID),
+				Logs:        []v1alpha1.DiagnosticLogSettings{{Category: "AuditEvent", RetentionDays: to.Int32Ptr(7)}},
+				Metrics:     []v1alpha1.DiagnosticMetricSettings{{Category: "AllMetrics"}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDiagnosticSettingUpToDate(tc.p, az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsDiagnosticSettingUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2020-02-02/insights"
+	appinsightsapi "github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2020-02-02/insights/insightsapi"
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights/operationalinsightsapi"
+	monitor "github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights/insightsapi"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+var _ operationalinsightsapi.WorkspacesClientAPI = &MockWorkspacesClient{}
+
+// MockWorkspacesClient is a fake implementation of
+// operationalinsights.WorkspacesClient.
+type MockWorkspacesClient struct {
+	operationalinsightsapi.WorkspacesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, workspaceName string, parameters operationalinsights.Workspace) (result operationalinsights.WorkspacesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, workspaceName string, force *bool) (result operationalinsights.WorkspacesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, workspaceName string) (result operationalinsights.Workspace, err error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, workspaceName string, parameters operationalinsights.WorkspacePatch) (result operationalinsights.Workspace, err error)
+}
+
+// CreateOrUpdate calls the MockWorkspacesClient's MockCreateOrUpdate method.
+func (c *MockWorkspacesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, workspaceName string, parameters operationalinsights.Workspace) (result operationalinsights.WorkspacesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, workspaceName, parameters)
+}
+
+// Delete calls the MockWorkspacesClient's MockDelete method.
+func (c *MockWorkspacesClient) Delete(ctx context.Context, resourceGroupName string, workspaceName string, force *bool) (result operationalinsights.WorkspacesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, workspaceName, force)
+}
+
+// Get calls the MockWorkspacesClient's MockGet method.
+func (c *MockWorkspacesClient) Get(ctx context.Context, resourceGroupName string, workspaceName string) (result operationalinsights.Workspace, err error) {
+	return c.MockGet(ctx, resourceGroupName, workspaceName)
+}
+
+// Update calls the MockWorkspacesClient's MockUpdate method.
+func (c *MockWorkspacesClient) Update(ctx context.Context, resourceGroupName string, workspaceName string, parameters operationalinsights.WorkspacePatch) (result operationalinsights.Workspace, err error) {
+	return c.MockUpdate(ctx, resourceGroupName, workspaceName, parameters)
+}
+
+var _ operationalinsightsapi.SharedKeysClientAPI = &MockSharedKeysClient{}
+
+// MockSharedKeysClient is a fake implementation of
+// operationalinsights.SharedKeysClient.
+type MockSharedKeysClient struct {
+	operationalinsightsapi.SharedKeysClientAPI
+
+	MockGetSharedKeys func(ctx context.Context, resourceGroupName string, workspaceName string) (result operationalinsights.SharedKeys, err error)
+}
+
+// GetSharedKeys calls the MockSharedKeysClient's MockGetSharedKeys method.
+func (c *MockSharedKeysClient) GetSharedKeys(ctx context.Context, resourceGroupName string, workspaceName string) (result operationalinsights.SharedKeys, err error) {
+	return c.MockGetSharedKeys(ctx, resourceGroupName, workspaceName)
+}
+
+var _ appinsightsapi.ComponentsClientAPI = &MockComponentsClient{}
+
+// MockComponentsClient is a fake implementation of insights.ComponentsClient.
+type MockComponentsClient struct {
+	appinsightsapi.ComponentsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, resourceName string, insightProperties insights.ApplicationInsightsComponent) (result insights.ApplicationInsightsComponent, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, resourceName string) (result autorest.Response, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, resourceName string) (result insights.ApplicationInsightsComponent, err error)
+}
+
+// CreateOrUpdate calls the MockComponentsClient's MockCreateOrUpdate method.
+func (c *MockComponentsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, resourceName string, insightProperties insights.ApplicationInsightsComponent) (result insights.ApplicationInsightsComponent, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, resourceName, insightProperties)
+}
+
+// Delete calls the MockComponentsClient's MockDelete method.
+func (c *MockComponentsClient) Delete(ctx context.Context, resourceGroupName string, resourceName string) (result autorest.Response, err error) {
+	return c.MockDelete(ctx, resourceGroupName, resourceName)
+}
+
+// Get calls the MockComponentsClient's MockGet method.
+func (c *MockComponentsClient) Get(ctx context.Context, resourceGroupName string, resourceName string) (result insights.ApplicationInsightsComponent, err error) {
+	return c.MockGet(ctx, resourceGroupName, resourceName)
+}
+
+var _ insightsapi.DiagnosticSettingsClientAPI = &MockDiagnosticSettingsClient{}
+
+// MockDiagnosticSettingsClient is a fake implementation of
+// insights.DiagnosticSettingsClient.
+type MockDiagnosticSettingsClient struct {
+	insightsapi.DiagnosticSettingsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceURI string, parameters monitor.DiagnosticSettingsResource, name string) (result monitor.DiagnosticSettingsResource, err error)
+	MockDelete         func(ctx context.Context, resourceURI string, name string) (result autorest.Response, err error)
+	MockGet            func(ctx context.Context, resourceURI string, name string) (result monitor.DiagnosticSettingsResource, err error)
+}
+
+// CreateOrUpdate calls the MockDiagnosticSettingsClient's MockCreateOrUpdate
+// method.
+func (c *MockDiagnosticSettingsClient) CreateOrUpdate(ctx context.Context, resourceURI string, parameters monitor.DiagnosticSettingsResource, name string) (result monitor.DiagnosticSettingsResource, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceURI, parameters, name)
+}
+
+// Delete calls the MockDiagnosticSettingsClient's MockDelete method.
+func (c *MockDiagnosticSettingsClient) Delete(ctx context.Context, resourceURI string, name string) (result autorest.Response, err error) {
+	return c.MockDelete(ctx, resourceURI, name)
+}
+
+// Get calls the MockDiagnosticSettingsClient's MockGet method.
+func (c *MockDiagnosticSettingsClient) Get(ctx context.Context, resourceURI string, name string) (result monitor.DiagnosticSettingsResource, err error) {
+	return c.MockGet(ctx, resourceURI, name)
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package insights
+
+import (
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2020-02-02/insights"
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-azure/apis/insights/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// Provisioning states of Log Analytics workspaces and Application Insights
+// components.
+const (
+	ProvisioningStateSucceeded = "Succeeded"
+)
+
+// DefaultWorkspaceSKUName is the SKU of a Log Analytics workspace when none
+// is specified.
+const DefaultWorkspaceSKUName = string(operationalinsights.WorkspaceSkuNameEnumPerGB2018)
+
+// Connection secret keys of a Log Analytics workspace.
+const (
+	ConnectionSecretKeyWorkspaceID        = "workspaceId"
+	ConnectionSecretKeyPrimarySharedKey   = "primarySharedKey"
+	ConnectionSecretKeySecondarySharedKey = "secondarySharedKey"
+)
+
+// Connection secret keys of an Application Insights component.
+const (
+	ConnectionSecretKeyAppID              = "appId"
+	ConnectionSecretKeyInstrumentationKey = "instrumentationKey"
+	ConnectionSecretKeyConnectionString   = "connectionString"
+)
+
+// NewWorkspaceParameters returns the parameters used to create an Azure Log
+// Analytics workspace from the supplied LogAnalyticsWorkspaceParameters.
+func NewWorkspaceParameters(p v1alpha1.LogAnalyticsWorkspaceParameters) operationalinsights.Workspace {
+	return operationalinsights.Workspace{
+		Location:            azure.ToStringPtr(p.Location),
+		Tags:                azure.ToStringPtrMap(p.Tags),
+		WorkspaceProperties: newWorkspaceProperties(p),
+	}
+}
+
+// NewWorkspacePatch returns the parameters used to update an Azure Log
+// Analytics workspace from the supplied LogAnalyticsWorkspaceParameters.
+func NewWorkspacePatch(p v1alpha1.LogAnalyticsWorkspaceParameters) operationalinsights.WorkspacePatch {
+	return operationalinsights.WorkspacePatch{
+		Tags:                azure.ToStringPtrMap(p.Tags),
+		WorkspaceProperties: newWorkspaceProperties(p),
+	}
+}
+
+// GenerateWorkspaceObservation produces a LogAnalyticsWorkspaceObservation
+// from the supplied Azure Log Analytics workspace.
+func GenerateWorkspaceObservation(az operationalinsights.Workspace) v1alpha1.LogAnalyticsWorkspaceObservation {
+	o := v1alpha1.LogAnalyticsWorkspaceObservation{
+		ID: azure.ToString(az.ID),
+	}
+	if az.WorkspaceProperties != nil {
+		o.CustomerID = azure.ToString(az.CustomerID)
+		o.ProvisioningState = string(az.ProvisioningState)
+	}
+	return o
+}
+
+// LateInitializeWorkspace fills the spec values that user did not fill with
+// their corresponding value in the Azure, if there is any.
+func LateInitializeWorkspace(p *v1alpha1.LogAnalyticsWorkspaceParameters, az operationalinsights.Workspace) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, az.Tags)
+	if az.WorkspaceProperties == nil {
+		return
+	}
+	props := az.WorkspaceProperties
+	if props.Sku != nil {
+		if p.SKUName == nil && props.Sku.Name != "" {
+			p.SKUName = azure.ToStringPtr(string(props.Sku.Name))
+		}
+		p.CapacityReservationLevel = azure.LateInitializeInt32PtrFromInt32Ptr(p.CapacityReservationLevel, props.Sku.CapacityReservationLevel)
+	}
+	p.RetentionInDays = azure.LateInitializeInt32PtrFromInt32Ptr(p.RetentionInDays, props.RetentionInDays)
+	if p.PublicNetworkAccessForIngestion == nil && props.PublicNetworkAccessForIngestion != "" {
+		p.PublicNetworkAccessForIngestion = azure.ToStringPtr(string(props.PublicNetworkAccessForIngestion))
+	}
+	if p.PublicNetworkAccessForQuery == nil && props.PublicNetworkAccessForQuery != "" {
+		p.PublicNetworkAccessForQuery = azure.ToStringPtr(string(props.PublicNetworkAccessForQuery))
+	}
+}
+
+// IsWorkspaceUpToDate returns true if the supplied Azure Log Analytics
+// workspace matches the supplied LogAnalyticsWorkspaceParameters.
+func IsWorkspaceUpToDate(p v1alpha1.LogAnalyticsWorkspaceParameters, az operationalinsights.Workspace) bool {
+	if az.WorkspaceProperties == nil {
+		return false
+	}
+	props := az.WorkspaceProperties
+	observed := v1alpha1.LogAnalyticsWorkspaceParameters{
+		RetentionInDays:                 props.RetentionInDays,
+		PublicNetworkAccessForIngestion: azure.ToStringPtr(string(props.PublicNetworkAccessForIngestion)),
+		PublicNetworkAccessForQuery:     azure.ToStringPtr(string(props.PublicNetworkAccessForQuery)),
+		Tags:                            azure.ToStringMap(az.Tags),
+	}
+	if props.Sku != nil {
+		observed.SKUName = azure.ToStringPtr(string(props.Sku.Name))
+		observed.CapacityReservationLevel = props.Sku.CapacityReservationLevel
+	}
+	return cmp.Equal(p, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.LogAnalyticsWorkspaceParameters{}, "SubscriptionID", "ResourceGroupName", "ResourceGroupNameRef", "ResourceGroupNameSelector", "Location"),
+	)
+}
+
+// WorkspaceConnectionDetails returns the connection details that publish the
+// ID and the supplied shared keys of a Log Analytics workspace.
+func WorkspaceConnectionDetails(o v1alpha1.LogAnalyticsWorkspaceObservation, k operationalinsights.SharedKeys) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		ConnectionSecretKeyWorkspaceID:        []byte(o.CustomerID),
+		ConnectionSecretKeyPrimarySharedKey:   []byte(azure.ToString(k.PrimarySharedKey)),
+		ConnectionSecretKeySecondarySharedKey: []byte(azure.ToString(k.SecondarySharedKey)),
+	}
+}
+
+// NewComponentParameters returns the parameters used to create or update an
+// Azure Application Insights component from the supplied
+// ApplicationInsightsParameters.
+func NewComponentParameters(p v1alpha1.ApplicationInsightsParameters) insights.ApplicationInsightsComponent {
+	return insights.ApplicationInsightsComponent{
+		Kind:     azure.ToStringPtr(p.Kind),
+		Location: azure.ToStringPtr(p.Location),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+		ApplicationInsightsComponentProperties: &insights.ApplicationInsightsComponentProperties{
+			ApplicationType:     insights.ApplicationType(p.ApplicationType),
+			FlowType:            insights.FlowTypeBluefield,
+			RequestSource:       insights.RequestSourceRest,
+			WorkspaceResourceID: azure.ToStringPtr(p.WorkspaceResourceID),
+			RetentionInDays:     p.RetentionInDays,
+			DisableIPMasking:    p.DisableIPMasking,
+			DisableLocalAuth:    p.DisableLocalAuth,
+		},
+	}
+}
+
+// GenerateComponentObservation produces an ApplicationInsightsObservation
+// from the supplied Azure Application Insights component.
+func GenerateComponentObservation(az insights.ApplicationInsightsComponent) v1alpha1.ApplicationInsightsObservation {
+	o := v1alpha1.ApplicationInsightsObservation{
+		ID: azure.ToString(az.ID),
+	}
+	if az.ApplicationInsightsComponentProperties != nil {
+		o.AppID = azure.ToString(az.AppID)
+		o.ProvisioningState = azure.ToString(az.ProvisioningState)
+	}
+	return o
+}
+
+// LateInitializeComponent fills the spec values that user did not fill with
+// their corresponding value in the Azure, if there is any.
+func LateInitializeComponent(p *v1alpha1.ApplicationInsightsParameters, az insights.ApplicationInsightsComponent) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, az.Tags)
+	if az.ApplicationInsightsComponentProperties == nil {
+		return
+	}
+	props := az.ApplicationInsightsComponentProperties
+	p.RetentionInDays = azure.LateInitializeInt32PtrFromInt32Ptr(p.RetentionInDays, props.RetentionInDays)
+	p.DisableIPMasking = azure.LateInitializeBoolPtrFromPtr(p.DisableIPMasking, props.DisableIPMasking)
+	p.DisableLocalAuth = azure.LateInitializeBoolPtrFromPtr(p.DisableLocalAuth, props.DisableLocalAuth)
+}
+
+// IsComponentUpToDate returns true if the supplied Azure Application Insights
+// component matches the supplied ApplicationInsightsParameters.
+func IsComponentUpToDate(p v1alpha1.ApplicationInsightsParameters, az insights.ApplicationInsightsComponent) bool {
+	if az.ApplicationInsightsComponentProperties == nil {
+		return false
+	}
+	props := az.ApplicationInsightsComponentProperties
+	// Azure does not preserve the case of resource IDs.
+	if !strings.EqualFold(p.WorkspaceResourceID, azure.ToString(props.WorkspaceResourceID)) {
+		return false
+	}
+	observed := v1alpha1.ApplicationInsightsParameters{
+		RetentionInDays:  props.RetentionInDays,
+		DisableIPMasking: props.DisableIPMasking,
+		DisableLocalAuth: props.DisableLocalAuth,
+		Tags:             azure.ToStringMap(az.Tags),
+	}
+	return cmp.Equal(p, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.ApplicationInsightsParameters{}, "SubscriptionID", "ResourceGroupName", "ResourceGroupNameRef", "ResourceGroupNameSelector",
+			"Location", "Kind", "ApplicationType", "WorkspaceResourceID", "WorkspaceResourceIDRef", "WorkspaceResourceIDSelector"),
+	)
+}
+
+// ComponentConnectionDetails returns the connection details that publish the
+// instrumentation key and connection string of the supplied Application
+// Insights component.
+func ComponentConnectionDetails(az insights.ApplicationInsightsComponent) managed.ConnectionDetails {
+	if az.ApplicationInsightsComponentProperties == nil {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		ConnectionSecretKeyAppID:              []byte(azure.ToString(az.AppID)),
+		ConnectionSecretKeyInstrumentationKey: []byte(azure.ToString(az.InstrumentationKey)),
+		ConnectionSecretKeyConnectionString:   []byte(azure.ToString(az.ConnectionString)),
+	}
+}
+
+func newWorkspaceProperties(p v1alpha1.LogAnalyticsWorkspaceParameters) *operationalinsights.WorkspaceProperties {
+	sku := DefaultWorkspaceSKUName
+	if p.SKUName != nil {
+		sku = *p.SKUName
+	}
+	props := &operationalinsights.WorkspaceProperties{
+		Sku: &operationalinsights.WorkspaceSku{
+			Name:                     operationalinsights.WorkspaceSkuNameEnum(sku),
+			CapacityReservationLevel: p.CapacityReservationLevel,
+		},
+		RetentionInDays: p.RetentionInDays,
+	}
+	if p.PublicNetworkAccessForIngestion != nil {
+		props.PublicNetworkAccessForIngestion = operationalinsights.PublicNetworkAccessType(*p.PublicNetworkAccessForIngestion)
+	}
+	if p.PublicNetworkAccessForQuery != nil {
+		props.PublicNetworkAccessForQuery = operationalinsights.PublicNetworkAccessType(*p.PublicNetworkAccessForQuery)
+	}
+	return props
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package insights
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2020-02-02/insights"
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/insights/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const workspaceID = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.OperationalInsights/workspaces/coolWorkspace"
+
+func TestNewWorkspaceParameters(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.LogAnalyticsWorkspaceParameters
+		want operationalinsights.WorkspaceSkuNameEnum
+	}{
+		"DefaultSKU": {
+			p:    v1alpha1.LogAnalyticsWorkspaceParameters{},
+			want: operationalinsights.WorkspaceSkuNameEnumPerGB2018,
+		},
+		"SKU": {
+			p:    v1alpha1.LogAnalyticsWorkspaceParameters{SKUName: azure.ToStringPtr("Standalone")},
+			want: operationalinsights.WorkspaceSkuNameEnumStandalone,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewWorkspaceParameters(tc.p)
+			if diff := cmp.Diff(tc.want, got.Sku.Name); diff != "" {
+				t.Errorf("NewWorkspaceParameters(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsWorkspaceUpToDate(t *testing.T) {
+	az := operationalinsights.Workspace{
+		WorkspaceProperties: &operationalinsights.WorkspaceProperties{
+			Sku:             &operationalinsights.WorkspaceSku{Name: operationalinsights.WorkspaceSkuNameEnumPerGB2018},
+			RetentionInDays: azure.ToInt32Ptr(30),
+		},
+		Tags: map[string]*string{"created_by": azure.ToStringPtr("crossplane")},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.LogAnalyticsWorkspaceParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.LogAnalyticsWorkspaceParameters{
+				Location:        "westeurope",
+				SKUName:         azure.ToStringPtr("PerGB2018"),
+				RetentionInDays: azure.ToInt32Ptr(30),
+				Tags:            map[string]string{"created_by": "crossplane"},
+			},
+			want: true,
+		},
+		"RetentionChanged": {
+			p: v1alpha1.LogAnalyticsWorkspaceParameters{
+				SKUName:         azure.ToStringPtr("PerGB2018"),
+				RetentionInDays: azure.ToInt32Ptr(90),
+				Tags:            map[string]string{"created_by": "crossplane"},
+			},
+			want: false,
+		},
+		"PublicNetworkAccessChanged": {
+			p: v1alpha1.LogAnalyticsWorkspaceParameters{
+				SKUName:                     azure.ToStringPtr("PerGB2018"),
+				RetentionInDays:             azure.ToInt32Ptr(30),
+				PublicNetworkAccessForQuery: azure.ToStringPtr("Disabled"),
+				Tags:                        map[string]string{"created_by": "crossplane"},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsWorkspaceUpToDate(tc.p, az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsWorkspaceUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsComponentUpToDate(t *testing.T) {
+	az := insights.ApplicationInsightsComponent{
+		ApplicationInsightsComponentProperties: &insights.ApplicationInsightsComponentProperties{
+			RetentionInDays:     azure.ToInt32Ptr(90),
+			WorkspaceResourceID: azure.ToStringPtr(workspaceID),
+		},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ApplicationInsightsParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.ApplicationInsightsParameters{
+				Kind:                "web",
+				ApplicationType:     "web",
+				WorkspaceResourceID: workspaceID,
+				RetentionInDays:     azure.ToInt32Ptr(90),
+			},
+			want: true,
+		},
+		"WorkspaceIDCaseDiffers": {
+			p: v1alpha1.ApplicationInsightsParameters{
+				WorkspaceResourceID: "/subscriptions/sub/resourcegroups/coolrg/providers/microsoft.operationalinsights/workspaces/coolworkspace",
+				RetentionInDays:     azure.ToInt32Ptr(90),
+			},
+			want: true,
+		},
+		"WorkspaceRemoved": {
+			p: v1alpha1.ApplicationInsightsParameters{
+				RetentionInDays: azure.ToInt32Ptr(90),
+			},
+			want: false,
+		},
+		"RetentionChanged": {
+			p: v1alpha1.ApplicationInsightsParameters{
+				WorkspaceResourceID: workspaceID,
+				RetentionInDays:     azure.ToInt32Ptr(30),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsComponentUpToDate(tc.p, az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsComponentUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
+)
+
+// Error strings.
+const (
+	errGetStoreConfig  = "cannot get store config"
+	errConnectStore    = "cannot connect to secret store"
+	errWriteStore      = "cannot write to secret store"
+	errDeleteFromStore = "cannot delete from secret store"
+)
+
+// A StoreBuilderFn builds and returns an Azure Key Vault Store from the
+// supplied StoreConfig spec.
+type StoreBuilderFn func(ctx context.Context, kube client.Client, cfg v1alpha1.StoreConfigSpec) (connection.Store, error)
+
+// A DetailsManagerOption configures a DetailsManager.
+type DetailsManagerOption func(*DetailsManager)
+
+// WithStoreBuilder configures the StoreBuilderFn used to build Azure Key
+// Vault stores.
+func WithStoreBuilder(sb StoreBuilderFn) DetailsManagerOption {
+	return func(m *DetailsManager) {
+		m.storeBuilder = sb
+	}
+}
+
+// WithRuntimePublisher configures the ConnectionPublisher used for
+// StoreConfigs that do not configure an Azure Key Vault.
+func WithRuntimePublisher(p managed.ConnectionPublisher) DetailsManagerOption {
+	return func(m *DetailsManager) {
+		m.runtime = p
+	}
+}
+
+// A DetailsManager publishes connection details to the secret store
+// configured by a StoreConfig. Azure Key Vault stores are handled here, while
+// all other store types are delegated to the crossplane-runtime
+// connection.DetailsManager.
+type DetailsManager struct {
+	kube         client.Client
+	runtime      managed.ConnectionPublisher
+	storeBuilder StoreBuilderFn
+}
+
+// NewDetailsManager returns a new DetailsManager.
+func NewDetailsManager(c client.Client, o ...DetailsManagerOption) *DetailsManager {
+	m := &DetailsManager{
+		kube: c,
+		storeBuilder: func(ctx context.Context, kube client.Client, cfg v1alpha1.StoreConfigSpec) (connection.Store, error) {
+			return NewSecretStore(ctx, kube, cfg)
+		},
+	}
+	for _, mo := range o {
+		mo(m)
+	}
+	if m.runtime == nil {
+		m.runtime = connection.NewDetailsManager(c, v1alpha1.StoreConfigGroupVersionKind)
+	}
+	return m
+}
+
+// PublishConnection publishes the supplied ConnectionDetails to the
+// configured secret store.
+func (m *DetailsManager) PublishConnection(ctx context.Context, so resource.ConnectionSecretOwner, conn managed.ConnectionDetails) (bool, error) {
+	// This resource does not want to expose a connection secret.
+	p := so.GetPublishConnectionDetailsTo()
+	if p == nil {
+		return false, nil
+	}
+
+	ss, err := m.connectStore(ctx, p.SecretStoreConfigRef.Name)
+	if err != nil {
+		return false, errors.Wrap(err, errConnectStore)
+	}
+	if ss == nil {
+		return m.runtime.PublishConnection(ctx, so, conn)
+	}
+
+	changed, err := ss.WriteKeyValues(ctx, store.NewSecret(so, store.KeyValues(conn)), connection.SecretToWriteMustBeOwnedBy(so))
+	return changed, errors.Wrap(err, errWriteStore)
+}
+
+// UnpublishConnection deletes the supplied ConnectionDetails from the
+// configured secret store.
+func (m *DetailsManager) UnpublishConnection(ctx context.Context, so resource.ConnectionSecretOwner, conn managed.ConnectionDetails) error {
+	// This resource didn't expose a connection secret.
+	p := so.GetPublishConnectionDetailsTo()
+	if p == nil {
+		return nil
+	}
+
+	ss, err := m.connectStore(ctx, p.SecretStoreConfigRef.Name)
+	if err != nil {
+		return errors.Wrap(err, errConnectStore)
+	}
+	if ss == nil {
+		return m.runtime.UnpublishConnection(ctx, so, conn)
+	}
+
+	return errors.Wrap(ss.DeleteKeyValues(ctx, store.NewSecret(so, store.KeyValues(conn)), connection.SecretToDeleteMustBeOwnedBy(so)), errDeleteFromStore)
+}
+
+// connectStore returns an Azure Key Vault store if the named StoreConfig
+// configures one, or nil otherwise.
+func (m *DetailsManager) connectStore(ctx context.Context, name string) (connection.Store, error) {
+	sc := &v1alpha1.StoreConfig{}
+	if err := m.kube.Get(ctx, types.NamespacedName{Name: name}, sc); err != nil {
+		return nil, errors.Wrap(err, errGetStoreConfig)
+	}
+	if sc.Spec.AzureKeyVault == nil {
+		return nil, nil
+	}
+	return m.storeBuilder(ctx, m.kube, sc.Spec)
+}
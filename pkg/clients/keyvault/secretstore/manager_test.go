@@ -0,0 +1,148 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
+)
+
+type mockStore struct {
+	connection.Store
+
+	write func(s *store.Secret) (bool, error)
+}
+
+func (m *mockStore) WriteKeyValues(_ context.Context, s *store.Secret, _ ...store.WriteOption) (bool, error) {
+	return m.write(s)
+}
+
+func TestPublishConnection(t *testing.T) {
+	type args struct {
+		kube    client.Client
+		runtime managed.ConnectionPublisher
+		sb      StoreBuilderFn
+		so      resource.ConnectionSecretOwner
+	}
+	type want struct {
+		published bool
+		err       error
+	}
+
+	owner := func() *fake.Managed {
+		mg := &fake.Managed{}
+		mg.SetPublishConnectionDetailsTo(&xpv1.PublishConnectionDetailsTo{
+			Name:                 secretName,
+			SecretStoreConfigRef: &xpv1.Reference{Name: "cool-store"},
+		})
+		return mg
+	}
+	getStoreConfig := func(spec v1alpha1.StoreConfigSpec) test.MockGetFn {
+		return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*v1alpha1.StoreConfig).Spec = spec
+			return nil
+		}
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoPublishConnectionDetailsTo": {
+			args: args{
+				runtime: managed.ConnectionPublisherFns{},
+				so:      &fake.Managed{},
+			},
+		},
+		"GetStoreConfigFailed": {
+			args: args{
+				kube:    &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				runtime: managed.ConnectionPublisherFns{},
+				so:      owner(),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, errGetStoreConfig), errConnectStore),
+			},
+		},
+		"DelegatedToRuntime": {
+			args: args{
+				kube: &test.MockClient{MockGet: getStoreConfig(v1alpha1.StoreConfigSpec{})},
+				runtime: managed.ConnectionPublisherFns{
+					PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, _ managed.ConnectionDetails) (bool, error) {
+						return true, nil
+					},
+				},
+				so: owner(),
+			},
+			want: want{
+				published: true,
+			},
+		},
+		"PublishedToKeyVault": {
+			args: args{
+				kube: &test.MockClient{MockGet: getStoreConfig(v1alpha1.StoreConfigSpec{
+					AzureKeyVault: &v1alpha1.AzureKeyVaultSecretStoreConfig{VaultBaseURL: vaultBaseURL},
+				})},
+				runtime: managed.ConnectionPublisherFns{
+					PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, _ managed.ConnectionDetails) (bool, error) {
+						return false, errors.New("should not be called")
+					},
+				},
+				sb: func(_ context.Context, _ client.Client, _ v1alpha1.StoreConfigSpec) (connection.Store, error) {
+					return &mockStore{write: func(s *store.Secret) (bool, error) {
+						return s.Name == secretName, nil
+					}}, nil
+				},
+				so: owner(),
+			},
+			want: want{
+				published: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := []DetailsManagerOption{WithRuntimePublisher(tc.args.runtime)}
+			if tc.args.sb != nil {
+				o = append(o, WithStoreBuilder(tc.args.sb))
+			}
+			m := NewDetailsManager(tc.args.kube, o...)
+			published, err := m.PublishConnection(context.Background(), tc.args.so, managed.ConnectionDetails{"password": []byte("pass")})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("PublishConnection(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.published, published); diff != "" {
+				t.Errorf("PublishConnection(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
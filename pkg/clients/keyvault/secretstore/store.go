@@ -0,0 +1,244 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.0/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.0/keyvault/keyvaultapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// keyVaultResource is the resource tokens for the Key Vault data plane are
+// requested for.
+const keyVaultResource = "https://vault.azure.net"
+
+// contentTypeJSON is the content type of Key Vault secrets written by the
+// SecretStore.
+const contentTypeJSON = "application/json"
+
+// Error strings.
+const (
+	errNoConfig         = "no Azure Key Vault config provided"
+	errGetCredentials   = "cannot get Azure Key Vault credentials"
+	errGetSecret        = "cannot get Key Vault secret"
+	errSetSecret        = "cannot set Key Vault secret"
+	errDeleteSecret     = "cannot delete Key Vault secret"
+	errUnmarshalSecret  = "cannot unmarshal Key Vault secret value"
+	errMarshalSecretVal = "cannot marshal Key Vault secret value"
+)
+
+// invalidSecretNameChars matches the characters that are not allowed in Key
+// Vault secret names.
+var invalidSecretNameChars = regexp.MustCompile(`[^0-9a-zA-Z-]`)
+
+// SecretStore is an Azure Key Vault Secret Store.
+type SecretStore struct {
+	client       keyvaultapi.BaseClientAPI
+	vaultBaseURL string
+	defaultScope string
+}
+
+// NewSecretStore returns a new Azure Key Vault SecretStore.
+func NewSecretStore(ctx context.Context, kube client.Client, cfg v1alpha1.StoreConfigSpec) (*SecretStore, error) {
+	if cfg.AzureKeyVault == nil {
+		return nil, errors.New(errNoConfig)
+	}
+	creds, err := azure.ProviderConfigCredentials(ctx, kube, cfg.AzureKeyVault.ProviderConfigReference.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCredentials)
+	}
+	cred, err := azure.NewTokenCredential(creds)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCredentials)
+	}
+	cl := keyvault.New()
+	cl.Authorizer = azure.NewBearerAuthorizer(cred, keyVaultResource)
+	return &SecretStore{
+		client:       cl,
+		vaultBaseURL: cfg.AzureKeyVault.VaultBaseURL,
+		defaultScope: cfg.DefaultScope,
+	}, nil
+}
+
+// ReadKeyValues reads and returns key value pairs for a given Key Vault
+// secret.
+func (ss *SecretStore) ReadKeyValues(ctx context.Context, n store.ScopedName, s *store.Secret) error {
+	_, err := ss.read(ctx, n, s)
+	return err
+}
+
+// WriteKeyValues writes key value pairs to a given Key Vault secret. Existing
+// keys that are not supplied are preserved.
+func (ss *SecretStore) WriteKeyValues(ctx context.Context, s *store.Secret, wo ...store.WriteOption) (changed bool, err error) {
+	current := &store.Secret{}
+	exists, err := ss.read(ctx, s.ScopedName, current)
+	if err != nil {
+		return false, err
+	}
+
+	desired := &store.Secret{
+		ScopedName: s.ScopedName,
+		Metadata:   s.Metadata,
+		Data:       store.KeyValues{},
+	}
+	for k, v := range current.Data {
+		desired.Data[k] = v
+	}
+	for k, v := range s.Data {
+		desired.Data[k] = v
+	}
+
+	if exists {
+		for _, o := range wo {
+			if err := o(ctx, current, desired); err != nil {
+				return false, err
+			}
+		}
+		if cmp.Equal(current.Data, desired.Data, cmpopts.EquateEmpty()) &&
+			cmp.Equal(current.GetLabels(), desired.GetLabels(), cmpopts.EquateEmpty()) {
+			return false, nil
+		}
+	}
+
+	if err := ss.write(ctx, desired); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteKeyValues deletes key value pairs from a given Key Vault secret. If
+// no keys are supplied, or no keys are left, the Key Vault secret is deleted.
+// Key Vaults with soft delete enabled retain the deleted secret until it is
+// purged or its retention period expires.
+func (ss *SecretStore) DeleteKeyValues(ctx context.Context, s *store.Secret, do ...store.DeleteOption) error {
+	current := &store.Secret{}
+	exists, err := ss.read(ctx, s.ScopedName, current)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		// Secret already deleted, nothing to do.
+		return nil
+	}
+
+	for _, o := range do {
+		if err := o(ctx, s); err != nil {
+			return err
+		}
+	}
+
+	for k := range s.Data {
+		delete(current.Data, k)
+	}
+	if len(s.Data) == 0 || len(current.Data) == 0 {
+		_, err := ss.client.DeleteSecret(ctx, ss.vaultBaseURL, ss.secretName(s.ScopedName))
+		return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteSecret)
+	}
+	return ss.write(ctx, current)
+}
+
+func (ss *SecretStore) read(ctx context.Context, n store.ScopedName, s *store.Secret) (bool, error) {
+	b, err := ss.client.GetSecret(ctx, ss.vaultBaseURL, ss.secretName(n), "" /* latest */)
+	if azure.IsNotFound(err) {
+		s.ScopedName = n
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, errGetSecret)
+	}
+
+	data := map[string]string{}
+	if v := azure.ToString(b.Value); v != "" {
+		if err := json.Unmarshal([]byte(v), &data); err != nil {
+			return false, errors.Wrap(err, errUnmarshalSecret)
+		}
+	}
+
+	s.ScopedName = n
+	s.Data = keyValuesFromData(data)
+	if len(b.Tags) > 0 {
+		s.Metadata = &xpv1.ConnectionSecretMetadata{
+			Labels: azure.ToStringMap(b.Tags),
+		}
+	}
+	return true, nil
+}
+
+func (ss *SecretStore) write(ctx context.Context, s *store.Secret) error {
+	// NOTE: Key Vault secrets hold a single string value, so the key value
+	// pairs are stored as a JSON object of strings, similar to how the Vault
+	// store keeps them.
+	v, err := json.Marshal(dataFromKeyValues(s.Data))
+	if err != nil {
+		return errors.Wrap(err, errMarshalSecretVal)
+	}
+	_, err = ss.client.SetSecret(ctx, ss.vaultBaseURL, ss.secretName(s.ScopedName), keyvault.SecretSetParameters{
+		Value:       azure.ToStringPtr(string(v)),
+		Tags:        azure.ToStringPtrMap(s.GetLabels()),
+		ContentType: azure.ToStringPtr(contentTypeJSON),
+	})
+	return errors.Wrap(err, errSetSecret)
+}
+
+// secretName returns the Key Vault secret name for the supplied scoped name.
+// Key Vault secret names may only contain alphanumeric characters and
+// dashes, and are not namespaced, so the scope is prefixed to the name.
+func (ss *SecretStore) secretName(n store.ScopedName) string {
+	scope := n.Scope
+	if scope == "" {
+		scope = ss.defaultScope
+	}
+	name := n.Name
+	if scope != "" {
+		name = strings.Join([]string{scope, n.Name}, "-")
+	}
+	return invalidSecretNameChars.ReplaceAllString(name, "-")
+}
+
+func keyValuesFromData(data map[string]string) store.KeyValues {
+	if len(data) == 0 {
+		return nil
+	}
+	kv := make(store.KeyValues, len(data))
+	for k, v := range data {
+		kv[k] = []byte(v)
+	}
+	return kv
+}
+
+func dataFromKeyValues(kv store.KeyValues) map[string]string {
+	data := make(map[string]string, len(kv))
+	for k, v := range kv {
+		data[k] = string(v)
+	}
+	return data
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.0/keyvault"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secret/fake"
+)
+
+const (
+	vaultBaseURL = "https://coolvault.vault.azure.net"
+	defaultScope = "crossplane-system"
+	secretName   = "cool-secret"
+	ownerUID     = "cool-uid"
+)
+
+var errBoom = errors.New("boom")
+
+func notFound() error {
+	return autorest.DetailedError{StatusCode: http.StatusNotFound}
+}
+
+func bundle(value string, tags map[string]string) keyvault.SecretBundle {
+	return keyvault.SecretBundle{
+		Value: azure.ToStringPtr(value),
+		Tags:  azure.ToStringPtrMap(tags),
+	}
+}
+
+func secret(data store.KeyValues) *store.Secret {
+	return &store.Secret{
+		ScopedName: store.ScopedName{Name: secretName},
+		Metadata: &xpv1.ConnectionSecretMetadata{
+			Labels: map[string]string{xpv1.LabelKeyOwnerUID: ownerUID},
+		},
+		Data: data,
+	}
+}
+
+func TestSecretName(t *testing.T) {
+	cases := map[string]struct {
+		n    store.ScopedName
+		want string
+	}{
+		"DefaultScope": {
+			n:    store.ScopedName{Name: secretName},
+			want: "crossplane-system-cool-secret",
+		},
+		"Scoped": {
+			n:    store.ScopedName{Name: secretName, Scope: "cool-namespace"},
+			want: "cool-namespace-cool-secret",
+		},
+		"InvalidCharacters": {
+			n:    store.ScopedName{Name: "cool.secret_name"},
+			want: "crossplane-system-cool-secret-name",
+		},
+	}
+
+	ss := &SecretStore{defaultScope: defaultScope}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ss.secretName(tc.n)); diff != "" {
+				t.Errorf("secretName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteKeyValues(t *testing.T) {
+	type want struct {
+		changed bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		client keyvaultClient
+		s      *store.Secret
+		wo     []store.WriteOption
+		want   want
+	}{
+		"GetFailed": {
+			client: keyvaultClient{get: func() (keyvault.SecretBundle, error) {
+				return keyvault.SecretBundle{}, errBoom
+			}},
+			s: secret(store.KeyValues{"password": []byte("pass")}),
+			want: want{
+				err: errors.Wrap(errBoom, errGetSecret),
+			},
+		},
+		"CreatedSecret": {
+			client: keyvaultClient{
+				get: func() (keyvault.SecretBundle, error) {
+					return keyvault.SecretBundle{}, notFound()
+				},
+				set: func(p keyvault.SecretSetParameters) error {
+					if diff := cmp.Diff(`{"password":"pass"}`, azure.ToString(p.Value)); diff != "" {
+						return errors.Errorf("unexpected value: %s", diff)
+					}
+					if azure.ToString(p.Tags[xpv1.LabelKeyOwnerUID]) != ownerUID {
+						return errors.New("owner not recorded")
+					}
+					return nil
+				},
+			},
+			s: secret(store.KeyValues{"password": []byte("pass")}),
+			want: want{
+				changed: true,
+			},
+		},
+		"MergedKeys": {
+			client: keyvaultClient{
+				get: func() (keyvault.SecretBundle, error) {
+					return bundle(`{"username":"admin"}`, map[string]string{xpv1.LabelKeyOwnerUID: ownerUID}), nil
+				},
+				set: func(p keyvault.SecretSetParameters) error {
+					if diff := cmp.Diff(`{"password":"pass","username":"admin"}`, azure.ToString(p.Value)); diff != "" {
+						return errors.Errorf("unexpected value: %s", diff)
+					}
+					return nil
+				},
+			},
+			s: secret(store.KeyValues{"password": []byte("pass")}),
+			want: want{
+				changed: true,
+			},
+		},
+		"NoChange": {
+			client: keyvaultClient{
+				get: func() (keyvault.SecretBundle, error) {
+					return bundle(`{"password":"pass"}`, map[string]string{xpv1.LabelKeyOwnerUID: ownerUID}), nil
+				},
+			},
+			s: secret(store.KeyValues{"password": []byte("pass")}),
+			want: want{
+				changed: false,
+			},
+		},
+		"WriteOptionFailed": {
+			client: keyvaultClient{
+				get: func() (keyvault.SecretBundle, error) {
+					return bundle(`{"password":"pass"}`, nil), nil
+				},
+			},
+			s: secret(store.KeyValues{"password": []byte("new")}),
+			wo: []store.WriteOption{func(_ context.Context, _, _ *store.Secret) error {
+				return errBoom
+			}},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"SetFailed": {
+			client: keyvaultClient{
+				get: func() (keyvault.SecretBundle, error) {
+					return keyvault.SecretBundle{}, notFound()
+				},
+				set: func(_ keyvault.SecretSetParameters) error {
+					return errBoom
+				},
+			},
+			s: secret(store.KeyValues{"password": []byte("pass")}),
+			want: want{
+				err: errors.Wrap(errBoom, errSetSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ss := &SecretStore{client: tc.client.mock(), vaultBaseURL: vaultBaseURL, defaultScope: defaultScope}
+			changed, err := ss.WriteKeyValues(context.Background(), tc.s, tc.wo...)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("WriteKeyValues(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("WriteKeyValues(...): -want changed, +got changed:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteKeyValues(t *testing.T) {
+	cases := map[string]struct {
+		client keyvaultClient
+		s      *store.Secret
+		want   error
+	}{
+		"AlreadyDeleted": {
+			client: keyvaultClient{get: func() (keyvault.SecretBundle, error) {
+				return keyvault.SecretBundle{}, notFound()
+			}},
+			s: secret(nil),
+		},
+		"DeletedSecret": {
+			client: keyvaultClient{
+				get: func() (keyvault.SecretBundle, error) {
+					return bundle(`{"password":"pass"}`, nil), nil
+				},
+				del: func() error { return nil },
+			},
+			s: secret(store.KeyValues{"password": []byte("pass")}),
+		},
+		"DeleteFailed": {
+			client: keyvaultClient{
+				get: func() (keyvault.SecretBundle, error) {
+					return bundle(`{"password":"pass"}`, nil), nil
+				},
+				del: func() error { return errBoom },
+			},
+			s:    secret(nil),
+			want: errors.Wrap(errBoom, errDeleteSecret),
+		},
+		"RemovedKeys": {
+			client: keyvaultClient{
+				get: func() (keyvault.SecretBundle, error) {
+					return bundle(`{"password":"pass","username":"admin"}`, nil), nil
+				},
+				set: func(p keyvault.SecretSetParameters) error {
+					if diff := cmp.Diff(`{"username":"admin"}`, azure.ToString(p.Value)); diff != "" {
+						return errors.Errorf("unexpected value: %s", diff)
+					}
+					return nil
+				},
+			},
+			s: secret(store.KeyValues{"password": []byte("pass")}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ss := &SecretStore{client: tc.client.mock(), vaultBaseURL: vaultBaseURL, defaultScope: defaultScope}
+			err := ss.DeleteKeyValues(context.Background(), tc.s)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("DeleteKeyValues(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+// keyvaultClient builds a fake.MockClient that only cares about the secret
+// it is asked about.
+type keyvaultClient struct {
+	get func() (keyvault.SecretBundle, error)
+	set func(p keyvault.SecretSetParameters) error
+	del func() error
+}
+
+func (c keyvaultClient) mock() *fake.MockClient {
+	return &fake.MockClient{
+		MockGetSecret: func(_ context.Context, _ string, _ string, _ string) (keyvault.SecretBundle, error) {
+			return c.get()
+		},
+		MockSetSecret: func(_ context.Context, _ string, _ string, p keyvault.SecretSetParameters) (keyvault.SecretBundle, error) {
+			return keyvault.SecretBundle{}, c.set(p)
+		},
+		MockDeleteSecret: func(_ context.Context, _ string, _ string) (keyvault.DeletedSecretBundle, error) {
+			return keyvault.DeletedSecretBundle{}, c.del()
+		},
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2019-09-01/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2019-09-01/keyvault/keyvaultapi"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+var _ keyvaultapi.VaultsClientAPI = &MockVaultsClient{}
+
+// MockVaultsClient is a fake implementation of keyvault.VaultsClient.
+type MockVaultsClient struct {
+	keyvaultapi.VaultsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, vaultName string, parameters keyvault.VaultCreateOrUpdateParameters) (result keyvault.VaultsCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, vaultName string) (result autorest.Response, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, vaultName string) (result keyvault.Vault, err error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, vaultName string, parameters keyvault.VaultPatchParameters) (result keyvault.Vault, err error)
+}
+
+// CreateOrUpdate calls the MockVaultsClient's MockCreateOrUpdate method.
+func (c *MockVaultsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, vaultName string, parameters keyvault.VaultCreateOrUpdateParameters) (result keyvault.VaultsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, vaultName, parameters)
+}
+
+// Delete calls the MockVaultsClient's MockDelete method.
+func (c *MockVaultsClient) Delete(ctx context.Context, resourceGroupName string, vaultName string) (result autorest.Response, err error) {
+	return c.MockDelete(ctx, resourceGroupName, vaultName)
+}
+
+// Get calls the MockVaultsClient's MockGet method.
+func (c *MockVaultsClient) Get(ctx context.Context, resourceGroupName string, vaultName string) (result keyvault.Vault, err error) {
+	return c.MockGet(ctx, resourceGroupName, vaultName)
+}
+
+// Update calls the MockVaultsClient's MockUpdate method.
+func (c *MockVaultsClient) Update(ctx context.Context, resourceGroupName string, vaultName string, parameters keyvault.VaultPatchParameters) (result keyvault.Vault, err error) {
+	return c.MockUpdate(ctx, resourceGroupName, vaultName, parameters)
+}
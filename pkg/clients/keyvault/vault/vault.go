@@ -0,0 +1,330 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2019-09-01/keyvault"
+	"github.com/gofrs/uuid"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-azure/apis/keyvault/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// ConnectionSecretKeyVaultURI is the connection secret key under which the
+// URI of a Key Vault is published.
+const ConnectionSecretKeyVaultURI = "vaultUri"
+
+// skuFamily is the only SKU family Key Vault supports.
+const skuFamily = "A"
+
+const (
+	errFmtInvalidTenantID      = "invalid tenant ID %q"
+	errFmtInvalidApplicationID = "invalid application ID %q"
+)
+
+// NewVaultParameters returns the parameters used to create an Azure Key Vault
+// from the supplied KeyVaultParameters.
+func NewVaultParameters(p v1alpha1.KeyVaultParameters) (keyvault.VaultCreateOrUpdateParameters, error) {
+	tenantID, err := uuid.FromString(p.TenantID)
+	if err != nil {
+		return keyvault.VaultCreateOrUpdateParameters{}, errors.Wrapf(err, errFmtInvalidTenantID, p.TenantID)
+	}
+	policies, err := newAccessPolicies(p.AccessPolicies)
+	if err != nil {
+		return keyvault.VaultCreateOrUpdateParameters{}, err
+	}
+	return keyvault.VaultCreateOrUpdateParameters{
+		Location: azure.ToStringPtr(p.Location),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+		Properties: &keyvault.VaultProperties{
+			TenantID:                     &tenantID,
+			Sku:                          newSku(p.SKUName),
+			AccessPolicies:               policies,
+			EnabledForDeployment:         p.EnabledForDeployment,
+			EnabledForDiskEncryption:     p.EnabledForDiskEncryption,
+			EnabledForTemplateDeployment: p.EnabledForTemplateDeployment,
+			EnableRbacAuthorization:      p.EnableRBACAuthorization,
+			EnableSoftDelete:             p.EnableSoftDelete,
+			SoftDeleteRetentionInDays:    p.SoftDeleteRetentionInDays,
+			EnablePurgeProtection:        p.EnablePurgeProtection,
+			NetworkAcls:                  newNetworkRuleSet(p.NetworkACLs),
+		},
+	}, nil
+}
+
+// NewVaultPatchParameters returns the parameters used to update an Azure Key
+// Vault from the supplied KeyVaultParameters.
+func NewVaultPatchParameters(p v1alpha1.KeyVaultParameters) (keyvault.VaultPatchParameters, error) {
+	tenantID, err := uuid.FromString(p.TenantID)
+	if err != nil {
+		return keyvault.VaultPatchParameters{}, errors.Wrapf(err, errFmtInvalidTenantID, p.TenantID)
+	}
+	policies, err := newAccessPolicies(p.AccessPolicies)
+	if err != nil {
+		return keyvault.VaultPatchParameters{}, err
+	}
+	return keyvault.VaultPatchParameters{
+		Tags: azure.ToStringPtrMap(p.Tags),
+		Properties: &keyvault.VaultPatchProperties{
+			TenantID:                     &tenantID,
+			Sku:                          newSku(p.SKUName),
+			AccessPolicies:               policies,
+			EnabledForDeployment:         p.EnabledForDeployment,
+			EnabledForDiskEncryption:     p.EnabledForDiskEncryption,
+			EnabledForTemplateDeployment: p.EnabledForTemplateDeployment,
+			EnableRbacAuthorization:      p.EnableRBACAuthorization,
+			EnableSoftDelete:             p.EnableSoftDelete,
+			SoftDeleteRetentionInDays:    p.SoftDeleteRetentionInDays,
+			EnablePurgeProtection:        p.EnablePurgeProtection,
+			NetworkAcls:                  newNetworkRuleSet(p.NetworkACLs),
+		},
+	}, nil
+}
+
+// GenerateObservation produces a KeyVaultObservation from the supplied Azure
+// Key Vault.
+func GenerateObservation(az keyvault.Vault) v1alpha1.KeyVaultObservation {
+	o := v1alpha1.KeyVaultObservation{
+		ID: azure.ToString(az.ID),
+	}
+	if az.Properties != nil {
+		o.VaultURI = azure.ToString(az.Properties.VaultURI)
+	}
+	return o
+}
+
+// LateInitialize fills the spec values that user did not fill with their
+// corresponding value in the Azure, if there is any.
+func LateInitialize(p *v1alpha1.KeyVaultParameters, az keyvault.Vault) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, az.Tags)
+	if az.Properties == nil {
+		return
+	}
+	props := az.Properties
+	if p.AccessPolicies == nil {
+		p.AccessPolicies = generateAccessPolicies(props.AccessPolicies)
+	}
+	p.EnabledForDeployment = azure.LateInitializeBoolPtrFromPtr(p.EnabledForDeployment, props.EnabledForDeployment)
+	p.EnabledForDiskEncryption = azure.LateInitializeBoolPtrFromPtr(p.EnabledForDiskEncryption, props.EnabledForDiskEncryption)
+	p.EnabledForTemplateDeployment = azure.LateInitializeBoolPtrFromPtr(p.EnabledForTemplateDeployment, props.EnabledForTemplateDeployment)
+	p.EnableRBACAuthorization = azure.LateInitializeBoolPtrFromPtr(p.EnableRBACAuthorization, props.EnableRbacAuthorization)
+	p.EnableSoftDelete = azure.LateInitializeBoolPtrFromPtr(p.EnableSoftDelete, props.EnableSoftDelete)
+	p.SoftDeleteRetentionInDays = azure.LateInitializeInt32PtrFromInt32Ptr(p.SoftDeleteRetentionInDays, props.SoftDeleteRetentionInDays)
+	p.EnablePurgeProtection = azure.LateInitializeBoolPtrFromPtr(p.EnablePurgeProtection, props.EnablePurgeProtection)
+	if p.NetworkACLs == nil {
+		p.NetworkACLs = generateNetworkACLs(props.NetworkAcls)
+	}
+}
+
+// IsUpToDate returns true if the supplied Azure Key Vault matches the
+// supplied KeyVaultParameters.
+func IsUpToDate(p v1alpha1.KeyVaultParameters, az keyvault.Vault) bool {
+	if az.Properties == nil {
+		return false
+	}
+	props := az.Properties
+	observed := v1alpha1.KeyVaultParameters{
+		TenantID:                     tenantIDString(props.TenantID),
+		AccessPolicies:               generateAccessPolicies(props.AccessPolicies),
+		EnabledForDeployment:         props.EnabledForDeployment,
+		EnabledForDiskEncryption:     props.EnabledForDiskEncryption,
+		EnabledForTemplateDeployment: props.EnabledForTemplateDeployment,
+		EnableRBACAuthorization:      props.EnableRbacAuthorization,
+		EnableSoftDelete:             props.EnableSoftDelete,
+		SoftDeleteRetentionInDays:    props.SoftDeleteRetentionInDays,
+		EnablePurgeProtection:        props.EnablePurgeProtection,
+		NetworkACLs:                  normalizeNetworkACLs(generateNetworkACLs(props.NetworkAcls)),
+		Tags:                         azure.ToStringMap(az.Tags),
+	}
+	if props.Sku != nil {
+		observed.SKUName = string(props.Sku.Name)
+	}
+	p.NetworkACLs = normalizeNetworkACLs(p.NetworkACLs)
+	return cmp.Equal(p, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.KeyVaultParameters{}, "SubscriptionID", "ResourceGroupName", "ResourceGroupNameRef", "ResourceGroupNameSelector", "Location"),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.SortSlices(func(a, b v1alpha1.KeyVaultAccessPolicy) bool { return a.ObjectID < b.ObjectID }),
+	)
+}
+
+func newNetworkRuleSet(in *v1alpha1.KeyVaultNetworkACLs) *keyvault.NetworkRuleSet {
+	if in == nil {
+		return nil
+	}
+	ips := make([]keyvault.IPRule, len(in.IPRules))
+	for i, ip := range in.IPRules {
+		ips[i] = keyvault.IPRule{Value: azure.ToStringPtr(ip)}
+	}
+	vnets := make([]keyvault.VirtualNetworkRule, len(in.VirtualNetworkSubnetIDs))
+	for i, id := range in.VirtualNetworkSubnetIDs {
+		vnets[i] = keyvault.VirtualNetworkRule{ID: azure.ToStringPtr(id)}
+	}
+	return &keyvault.NetworkRuleSet{
+		DefaultAction:       keyvault.NetworkRuleAction(in.DefaultAction),
+		Bypass:              keyvault.NetworkRuleBypassOptions(azure.ToString(in.Bypass)),
+		IPRules:             &ips,
+		VirtualNetworkRules: &vnets,
+	}
+}
+
+func generateNetworkACLs(in *keyvault.NetworkRuleSet) *v1alpha1.KeyVaultNetworkACLs {
+	if in == nil {
+		return nil
+	}
+	out := &v1alpha1.KeyVaultNetworkACLs{DefaultAction: string(in.DefaultAction)}
+	if in.Bypass != "" {
+		out.Bypass = azure.ToStringPtr(string(in.Bypass))
+	}
+	if in.IPRules != nil {
+		for _, ip := range *in.IPRules {
+			out.IPRules = append(out.IPRules, azure.ToString(ip.Value))
+		}
+	}
+	if in.VirtualNetworkRules != nil {
+		for _, v := range *in.VirtualNetworkRules {
+			out.VirtualNetworkSubnetIDs = append(out.VirtualNetworkSubnetIDs, azure.ToString(v.ID))
+		}
+	}
+	return out
+}
+
+// normalizeNetworkACLs returns a copy of the supplied network ACLs with
+// their subnet IDs in lower case, as returned by Azure.
+func normalizeNetworkACLs(in *v1alpha1.KeyVaultNetworkACLs) *v1alpha1.KeyVaultNetworkACLs {
+	if in == nil {
+		return nil
+	}
+	out := in.DeepCopy()
+	for i := range out.VirtualNetworkSubnetIDs {
+		out.VirtualNetworkSubnetIDs[i] = strings.ToLower(out.VirtualNetworkSubnetIDs[i])
+	}
+	return out
+}
+
+func newSku(name string) *keyvault.Sku {
+	return &keyvault.Sku{
+		Family: azure.ToStringPtr(skuFamily),
+		Name:   keyvault.SkuName(name),
+	}
+}
+
+func newAccessPolicies(in []v1alpha1.KeyVaultAccessPolicy) (*[]keyvault.AccessPolicyEntry, error) {
+	out := make([]keyvault.AccessPolicyEntry, len(in))
+	for i, ap := range in {
+		tenantID, err := uuid.FromString(ap.TenantID)
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtInvalidTenantID, ap.TenantID)
+		}
+		out[i] = keyvault.AccessPolicyEntry{
+			TenantID:    &tenantID,
+			ObjectID:    azure.ToStringPtr(ap.ObjectID),
+			Permissions: newPermissions(ap.Permissions),
+		}
+		if ap.ApplicationID != nil {
+			appID, err := uuid.FromString(*ap.ApplicationID)
+			if err != nil {
+				return nil, errors.Wrapf(err, errFmtInvalidApplicationID, *ap.ApplicationID)
+			}
+			out[i].ApplicationID = &appID
+		}
+	}
+	return &out, nil
+}
+
+func newPermissions(p v1alpha1.KeyVaultPermissions) *keyvault.Permissions {
+	keys := make([]keyvault.KeyPermissions, len(p.Keys))
+	for i, k := range p.Keys {
+		keys[i] = keyvault.KeyPermissions(k)
+	}
+	secrets := make([]keyvault.SecretPermissions, len(p.Secrets))
+	for i, s := range p.Secrets {
+		secrets[i] = keyvault.SecretPermissions(s)
+	}
+	certificates := make([]keyvault.CertificatePermissions, len(p.Certificates))
+	for i, c := range p.Certificates {
+		certificates[i] = keyvault.CertificatePermissions(c)
+	}
+	storage := make([]keyvault.StoragePermissions, len(p.Storage))
+	for i, s := range p.Storage {
+		storage[i] = keyvault.StoragePermissions(s)
+	}
+	return &keyvault.Permissions{
+		Keys:         &keys,
+		Secrets:      &secrets,
+		Certificates: &certificates,
+		Storage:      &storage,
+	}
+}
+
+func generateAccessPolicies(in *[]keyvault.AccessPolicyEntry) []v1alpha1.KeyVaultAccessPolicy {
+	if in == nil {
+		return nil
+	}
+	out := make([]v1alpha1.KeyVaultAccessPolicy, len(*in))
+	for i, ap := range *in {
+		out[i] = v1alpha1.KeyVaultAccessPolicy{
+			TenantID:    tenantIDString(ap.TenantID),
+			ObjectID:    azure.ToString(ap.ObjectID),
+			Permissions: generatePermissions(ap.Permissions),
+		}
+		if ap.ApplicationID != nil {
+			out[i].ApplicationID = azure.ToStringPtr(ap.ApplicationID.String())
+		}
+	}
+	return out
+}
+
+func generatePermissions(p *keyvault.Permissions) v1alpha1.KeyVaultPermissions {
+	out := v1alpha1.KeyVaultPermissions{}
+	if p == nil {
+		return out
+	}
+	if p.Keys != nil {
+		for _, k := range *p.Keys {
+			out.Keys = append(out.Keys, string(k))
+		}
+	}
+	if p.Secrets != nil {
+		for _, s := range *p.Secrets {
+			out.Secrets = append(out.Secrets, string(s))
+		}
+	}
+	if p.Certificates != nil {
+		for _, c := range *p.Certificates {
+			out.Certificates = append(out.Certificates, string(c))
+		}
+	}
+	if p.Storage != nil {
+		for _, s := range *p.Storage {
+			out.Storage = append(out.Storage, string(s))
+		}
+	}
+	return out
+}
+
+func tenantIDString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
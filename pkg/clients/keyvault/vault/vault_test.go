@@ -0,0 +1,185 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2019-09-01/keyvault"
+	"github.com/gofrs/uuid"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/keyvault/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const (
+	tenantID = "7ec52e29-9f8c-4c2c-a9bc-2d2c8b1a5e0f"
+	objectID = "cool-object"
+	subnetID = "/subscriptions/sub/resourceGroups/cool-rg/providers/Microsoft.Network/virtualNetworks/cool-vnet/subnets/Cool-Subnet"
+)
+
+func params() v1alpha1.KeyVaultParameters {
+	return v1alpha1.KeyVaultParameters{
+		Location: "westeurope",
+		TenantID: tenantID,
+		SKUName:  "standard",
+		AccessPolicies: []v1alpha1.KeyVaultAccessPolicy{{
+			TenantID: tenantID,
+			ObjectID: objectID,
+			Permissions: v1alpha1.KeyVaultPermissions{
+				Secrets: []string{"set", "get"},
+			},
+		}},
+		EnablePurgeProtection: azure.ToBoolPtr(true),
+		Tags:                  map[string]string{"created_by": "crossplane"},
+	}
+}
+
+func vault() keyvault.Vault {
+	tid := uuid.FromStringOrNil(tenantID)
+	return keyvault.Vault{
+		Tags: map[string]*string{"created_by": azure.ToStringPtr("crossplane")},
+		Properties: &keyvault.VaultProperties{
+			TenantID: &tid,
+			Sku:      &keyvault.Sku{Family: azure.ToStringPtr(skuFamily), Name: keyvault.Standard},
+			AccessPolicies: &[]keyvault.AccessPolicyEntry{{
+				TenantID: &tid,
+				ObjectID: azure.ToStringPtr(objectID),
+				Permissions: &keyvault.Permissions{
+					Secrets: &[]keyvault.SecretPermissions{keyvault.SecretPermissionsGet, keyvault.SecretPermissionsSet},
+				},
+			}},
+			EnablePurgeProtection: azure.ToBoolPtr(true),
+			EnableSoftDelete:      azure.ToBoolPtr(true),
+		},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.KeyVaultParameters
+		az   keyvault.Vault
+		want bool
+	}{
+		"UpToDate": {
+			p: func() v1alpha1.KeyVaultParameters {
+				p := params()
+				p.EnableSoftDelete = azure.ToBoolPtr(true)
+				return p
+			}(),
+			az:   vault(),
+			want: true,
+		},
+		"SKUChanged": {
+			p: func() v1alpha1.KeyVaultParameters {
+				p := params()
+				p.EnableSoftDelete = azure.ToBoolPtr(true)
+				p.SKUName = "premium"
+				return p
+			}(),
+			az:   vault(),
+			want: false,
+		},
+		"AccessPolicyChanged": {
+			p: func() v1alpha1.KeyVaultParameters {
+				p := params()
+				p.EnableSoftDelete = azure.ToBoolPtr(true)
+				p.AccessPolicies[0].Permissions.Keys = []string{"get"}
+				return p
+			}(),
+			az:   vault(),
+			want: false,
+		},
+		"NetworkACLsUpToDate": {
+			p: func() v1alpha1.KeyVaultParameters {
+				p := params()
+				p.EnableSoftDelete = azure.ToBoolPtr(true)
+				p.NetworkACLs = &v1alpha1.KeyVaultNetworkACLs{DefaultAction: "Deny", Bypass: azure.ToStringPtr("AzureServices"), VirtualNetworkSubnetIDs: []string{subnetID}}
+				return p
+			}(),
+			az: func() keyvault.Vault {
+				v := vault()
+				v.Properties.NetworkAcls = &keyvault.NetworkRuleSet{
+					DefaultAction:       keyvault.Deny,
+					Bypass:              keyvault.AzureServices,
+					VirtualNetworkRules: &[]keyvault.VirtualNetworkRule{{ID: azure.ToStringPtr(strings.ToLower(subnetID))}},
+				}
+				return v
+			}(),
+			want: true,
+		},
+		"NetworkACLsChanged": {
+			p: func() v1alpha1.KeyVaultParameters {
+				p := params()
+				p.EnableSoftDelete = azure.ToBoolPtr(true)
+				p.NetworkACLs = &v1alpha1.KeyVaultNetworkACLs{DefaultAction: "Deny"}
+				return p
+			}(),
+			az: func() keyvault.Vault {
+				v := vault()
+				v.Properties.NetworkAcls = &keyvault.NetworkRuleSet{DefaultAction: keyvault.Allow}
+				return v
+			}(),
+			want: false,
+		},
+		"NoProperties": {
+			p:    params(),
+			az:   keyvault.Vault{},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	p := params()
+	p.AccessPolicies = nil
+	LateInitialize(&p, vault())
+
+	want := params()
+	want.AccessPolicies[0].Permissions.Secrets = []string{"get", "set"}
+	want.EnableSoftDelete = azure.ToBoolPtr(true)
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestNewVaultParameters(t *testing.T) {
+	got, err := NewVaultParameters(params())
+	if err != nil {
+		t.Fatalf("NewVaultParameters(...): unexpected error: %s", err)
+	}
+	if !IsUpToDate(params(), keyvault.Vault{Tags: got.Tags, Properties: got.Properties}) {
+		t.Errorf("NewVaultParameters(...): generated parameters do not match the spec")
+	}
+
+	p := params()
+	p.AccessPolicies[0].TenantID = "cool-tenant"
+	if _, err := NewVaultParameters(p); err == nil {
+		t.Errorf("NewVaultParameters(...): expected an error for an invalid access policy tenant ID")
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+const (
+	// AnnotationKeyManagementPolicy determines what the provider may do to
+	// the external resource of a managed resource. By default it creates,
+	// updates and deletes the external resource. When set to
+	// AnnotationValueManagementPolicyObserveOnly it only observes it.
+	AnnotationKeyManagementPolicy = "azure.crossplane.io/management-policy"
+
+	// AnnotationValueManagementPolicyObserveOnly makes the provider report
+	// the state of an existing external resource, and whether it drifted from
+	// the desired state, without ever creating, updating or deleting it. The
+	// external resource is orphaned when the managed resource is deleted.
+	AnnotationValueManagementPolicyObserveOnly = "ObserveOnly"
+)
+
+// ErrObserveOnlyNotFound is returned instead of creating an external resource
+// that is only observed.
+var ErrObserveOnlyNotFound = errors.New("the external resource does not exist and is not created because the management policy is ObserveOnly")
+
+// ErrObserveOnly is returned instead of changing an external resource that is
+// only observed.
+var ErrObserveOnly = errors.New("refusing to change the external resource because the management policy is ObserveOnly")
+
+// ObserveOnly returns true if the external resource of the supplied object is
+// only observed.
+func ObserveOnly(o metav1.Object) bool {
+	return o.GetAnnotations()[AnnotationKeyManagementPolicy] == AnnotationValueManagementPolicyObserveOnly
+}
+
+// NewManagementPolicyConnecter returns a managed.ExternalConnecter whose
+// external clients only observe the external resource of a managed resource
+// whose management policy is ObserveOnly. Drift from the desired state is
+// reported by the Drifted condition rather than corrected.
+func NewManagementPolicyConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &managementPolicyConnecter{ExternalConnecter: c}
+}
+
+type managementPolicyConnecter struct {
+	managed.ExternalConnecter
+}
+
+func (c *managementPolicyConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &managementPolicyExternal{ExternalClient: e}, nil
+}
+
+type managementPolicyExternal struct {
+	managed.ExternalClient
+}
+
+func (e *managementPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil || !ObserveOnly(mg) {
+		return o, err
+	}
+	if meta.WasDeleted(mg) {
+		// Reporting that the external resource does not exist lets the
+		// managed resource be deleted without deleting it.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if !o.ResourceExists {
+		return o, ErrObserveOnlyNotFound
+	}
+	if !o.ResourceUpToDate {
+		mg.SetConditions(v1alpha3.Drifted())
+		o.ResourceUpToDate = true
+		return o, nil
+	}
+	mg.SetConditions(v1alpha3.NotDrifted())
+	return o, nil
+}
+
+func (e *managementPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if ObserveOnly(mg) {
+		return managed.ExternalCreation{}, ErrObserveOnly
+	}
+	return e.ExternalClient.Create(ctx, mg)
+}
+
+func (e *managementPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if ObserveOnly(mg) {
+		return managed.ExternalUpdate{}, ErrObserveOnly
+	}
+	return e.ExternalClient.Update(ctx, mg)
+}
+
+func (e *managementPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	if ObserveOnly(mg) {
+		return ErrObserveOnly
+	}
+	return e.ExternalClient.Delete(ctx, mg)
+}
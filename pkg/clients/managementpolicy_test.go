@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+func TestManagementPolicyObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	observeOnly := map[string]string{AnnotationKeyManagementPolicy: AnnotationValueManagementPolicyObserveOnly}
+	now := metav1.Now()
+
+	type want struct {
+		o    managed.ExternalObservation
+		err  error
+		cond *xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		annotations map[string]string
+		deleted     bool
+		o           managed.ExternalObservation
+		err         error
+		want        want
+	}{
+		"FullyManaged": {
+			o:    managed.ExternalObservation{ResourceExists: true},
+			want: want{o: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"ObserveError": {
+			annotations: observeOnly,
+			err:         errBoom,
+			want:        want{err: errBoom},
+		},
+		"NotFound": {
+			annotations: observeOnly,
+			want:        want{err: ErrObserveOnlyNotFound},
+		},
+		"Drifted": {
+			annotations: observeOnly,
+			o:           managed.ExternalObservation{ResourceExists: true},
+			want: want{
+				o:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: func() *xpv1.Condition { c := v1alpha3.Drifted(); return &c }(),
+			},
+		},
+		"NotDrifted": {
+			annotations: observeOnly,
+			o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want: want{
+				o:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: func() *xpv1.Condition { c := v1alpha3.NotDrifted(); return &c }(),
+			},
+		},
+		"Deleted": {
+			annotations: observeOnly,
+			deleted:     true,
+			o:           managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want:        want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewManagementPolicyConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return tc.o, tc.err
+					},
+				}, nil
+			}))
+			mg := &fake.Managed{}
+			mg.SetAnnotations(tc.annotations)
+			if tc.deleted {
+				mg.SetDeletionTimestamp(&now)
+			}
+
+			e, err := c.Connect(context.Background(), mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %v", err)
+			}
+			o, err := e.Observe(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.cond == nil {
+				return
+			}
+			if diff := cmp.Diff(*tc.want.cond, mg.GetCondition(v1alpha3.TypeDrifted), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestManagementPolicyChanges(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		annotations map[string]string
+		want        error
+	}{
+		"FullyManaged": {
+			want: errBoom,
+		},
+		"ObserveOnly": {
+			annotations: map[string]string{AnnotationKeyManagementPolicy: AnnotationValueManagementPolicyObserveOnly},
+			want:        ErrObserveOnly,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewManagementPolicyConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &fakeExternal{err: errBoom}, nil
+			}))
+			mg := &fake.Managed{}
+			mg.SetAnnotations(tc.annotations)
+
+			e, err := c.Connect(context.Background(), mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %v", err)
+			}
+			if _, err := e.Create(context.Background(), mg); !errors.Is(err, tc.want) {
+				t.Errorf("Create(...): want error %v, got %v", tc.want, err)
+			}
+			if _, err := e.Update(context.Background(), mg); !errors.Is(err, tc.want) {
+				t.Errorf("Update(...): want error %v, got %v", tc.want, err)
+			}
+			if err := e.Delete(context.Background(), mg); !errors.Is(err, tc.want) {
+				t.Errorf("Delete(...): want error %v, got %v", tc.want, err)
+			}
+		})
+	}
+}
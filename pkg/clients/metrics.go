@@ -0,0 +1,206 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Labels of the metrics exposed by Azure controllers.
+const (
+	labelKind      = "kind"
+	labelResult    = "result"
+	labelOperation = "operation"
+	labelCode      = "code"
+	labelSource    = "source"
+	labelMethod    = "method"
+	labelStatus    = "status"
+)
+
+// Values of the result label.
+const (
+	resultSuccess = "success"
+	resultError   = "error"
+)
+
+// Values of the source label of throttling events.
+const (
+	throttleSourceClient = "client"
+	throttleSourceServer = "server"
+)
+
+var (
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "crossplane_azure_reconcile_duration_seconds",
+		Help:    "Duration of reconciles of managed resources, by kind and result. Reconciles that ask to be requeued immediately failed.",
+		Buckets: prometheus.ExponentialBuckets(0.05, 2, 12),
+	}, []string{labelKind, labelResult})
+
+	apiCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "crossplane_azure_api_call_duration_seconds",
+		Help:    "Duration of the Azure API calls made to connect to, observe, create, update, or delete the external resource of a managed resource.",
+		Buckets: prometheus.ExponentialBuckets(0.05, 2, 12),
+	}, []string{labelKind, labelOperation, labelResult})
+
+	apiCallErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "crossplane_azure_api_call_errors_total",
+		Help: "Failed Azure API calls, by the HTTP status code of the response. The code is empty if the call failed without a response.",
+	}, []string{labelKind, labelOperation, labelCode})
+
+	throttledRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "crossplane_azure_throttled_requests_total",
+		Help: "Azure API requests that were delayed by the provider's rate limiter (client) or rejected by Azure with HTTP 429 (server).",
+	}, []string{labelSource})
+
+	asyncOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "crossplane_azure_async_operation_duration_seconds",
+		Help:    "Approximate duration of Azure long running operations, from when they were first polled until they completed.",
+		Buckets: prometheus.ExponentialBuckets(5, 2, 10),
+	}, []string{labelMethod, labelStatus})
+)
+
+func init() {
+	metrics.Registry.MustRegister(reconcileDuration, apiCallDuration, apiCallErrors, throttledRequests, asyncOperationDuration)
+}
+
+// NewInstrumentedReconciler returns a reconcile.Reconciler that records the
+// duration and result of each reconcile of the supplied kind of managed
+// resource.
+func NewInstrumentedReconciler(kind string, r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		start := time.Now()
+		res, err := r.Reconcile(ctx, req)
+		result := resultSuccess
+		if err != nil || res.Requeue {
+			result = resultError
+		}
+		reconcileDuration.WithLabelValues(kind, result).Observe(time.Since(start).Seconds())
+		return res, err
+	})
+}
+
+// NewMetricsConnecter returns a managed.ExternalConnecter whose external
+// clients record the duration and errors of the Azure API calls they make
+// for the supplied kind of managed resource.
+func NewMetricsConnecter(kind string, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &metricsConnecter{ExternalConnecter: c, kind: kind}
+}
+
+type metricsConnecter struct {
+	managed.ExternalConnecter
+	kind string
+}
+
+func (c *metricsConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	start := time.Now()
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	observeAPICall(c.kind, "connect", start, err)
+	if err != nil {
+		return nil, err
+	}
+	return &metricsExternal{ExternalClient: e, kind: c.kind}, nil
+}
+
+type metricsExternal struct {
+	managed.ExternalClient
+	kind string
+}
+
+func (e *metricsExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	start := time.Now()
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	observeAPICall(e.kind, "observe", start, err)
+	return o, err
+}
+
+func (e *metricsExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	start := time.Now()
+	c, err := e.ExternalClient.Create(ctx, mg)
+	observeAPICall(e.kind, "create", start, err)
+	return c, err
+}
+
+func (e *metricsExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	start := time.Now()
+	u, err := e.ExternalClient.Update(ctx, mg)
+	observeAPICall(e.kind, "update", start, err)
+	return u, err
+}
+
+func (e *metricsExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	start := time.Now()
+	err := e.ExternalClient.Delete(ctx, mg)
+	observeAPICall(e.kind, "delete", start, err)
+	return err
+}
+
+func observeAPICall(kind, operation string, start time.Time, err error) {
+	result := resultSuccess
+	if err != nil {
+		result = resultError
+	}
+	apiCallDuration.WithLabelValues(kind, operation, result).Observe(time.Since(start).Seconds())
+	if err == nil {
+		return
+	}
+	code := ""
+	de := autorest.DetailedError{}
+	if errors.As(err, &de) && de.Response != nil {
+		code = strconv.Itoa(de.Response.StatusCode)
+		if de.Response.StatusCode == http.StatusTooManyRequests {
+			throttledRequests.WithLabelValues(throttleSourceServer).Inc()
+		}
+	}
+	apiCallErrors.WithLabelValues(kind, operation, code).Inc()
+}
+
+// asyncOperations tracks when long running operations were first polled, by
+// their polling URL, in order to record their duration once they complete.
+var asyncOperations = &asyncOperationTracker{started: map[string]time.Time{}}
+
+type asyncOperationTracker struct {
+	mu      sync.Mutex
+	started map[string]time.Time
+}
+
+func (t *asyncOperationTracker) observe(pollingURL, method, status string, done bool, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	start, ok := t.started[pollingURL]
+	if !ok {
+		start = now
+		t.started[pollingURL] = start
+	}
+	if !done {
+		return
+	}
+	delete(t.started, pollingURL)
+	asyncOperationDuration.WithLabelValues(method, status).Observe(now.Sub(start).Seconds())
+}
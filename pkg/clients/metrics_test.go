@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestInstrumentedReconciler(t *testing.T) {
+	cases := map[string]struct {
+		res    reconcile.Result
+		err    error
+		result string
+	}{
+		"Success": {
+			res:    reconcile.Result{RequeueAfter: time.Minute},
+			result: resultSuccess,
+		},
+		"Requeue": {
+			res:    reconcile.Result{Requeue: true},
+			result: resultError,
+		},
+		"Error": {
+			err:    errors.New("boom"),
+			result: resultError,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kind := "Instrumented" + name
+			r := NewInstrumentedReconciler(kind, reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				return tc.res, tc.err
+			}))
+			res, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if diff := cmp.Diff(tc.res, res); diff != "" {
+				t.Errorf("Reconcile(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Reconcile(...): -want error, +got error:\n%s", diff)
+			}
+			if !reconcileDuration.DeleteLabelValues(kind, tc.result) {
+				t.Errorf("Reconcile(...): want duration observed with result %q", tc.result)
+			}
+		})
+	}
+}
+
+func TestMetricsConnecter(t *testing.T) {
+	throttled := autorest.DetailedError{
+		Original: errors.New("boom"),
+		Response: &http.Response{StatusCode: http.StatusTooManyRequests},
+	}
+	kind := "MetricsConnecterTest"
+	before := testutil.ToFloat64(throttledRequests.WithLabelValues(throttleSourceServer))
+
+	c := NewMetricsConnecter(kind, managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &fakeExternal{err: errors.Wrap(throttled, "cannot get thing")}, nil
+	}))
+	e, err := c.Connect(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Connect(...): %v", err)
+	}
+	_, _ = e.Observe(context.Background(), nil)
+	_, _ = e.Create(context.Background(), nil)
+
+	if diff := cmp.Diff(float64(1), testutil.ToFloat64(apiCallErrors.WithLabelValues(kind, "observe", "429"))); diff != "" {
+		t.Errorf("Observe(...): -want errors, +got errors:\n%s", diff)
+	}
+	if diff := cmp.Diff(float64(1), testutil.ToFloat64(apiCallErrors.WithLabelValues(kind, "create", "429"))); diff != "" {
+		t.Errorf("Create(...): -want errors, +got errors:\n%s", diff)
+	}
+	if diff := cmp.Diff(before+2, testutil.ToFloat64(throttledRequests.WithLabelValues(throttleSourceServer))); diff != "" {
+		t.Errorf("-want throttled requests, +got throttled requests:\n%s", diff)
+	}
+	for _, op := range []string{"connect", "observe", "create"} {
+		if !apiCallDuration.DeleteLabelValues(kind, op, resultSuccess) && !apiCallDuration.DeleteLabelValues(kind, op, resultError) {
+			t.Errorf("want duration of %s observed", op)
+		}
+	}
+}
+
+func TestAsyncOperationTracker(t *testing.T) {
+	now := time.Now()
+	tr := &asyncOperationTracker{started: map[string]time.Time{}}
+
+	tr.observe("https://cool/operation", http.MethodPut, "InProgress", false, now)
+	tr.observe("https://cool/operation", http.MethodPut, "InProgress", false, now.Add(time.Minute))
+	if diff := cmp.Diff(map[string]time.Time{"https://cool/operation": now}, tr.started); diff != "" {
+		t.Errorf("observe(...): -want started, +got started:\n%s", diff)
+	}
+
+	tr.observe("https://cool/operation", http.MethodPut, "AsyncOperationTrackerTest", true, now.Add(2*time.Minute))
+	if diff := cmp.Diff(map[string]time.Time{}, tr.started); diff != "" {
+		t.Errorf("observe(...): -want started, +got started:\n%s", diff)
+	}
+	if !asyncOperationDuration.DeleteLabelValues(http.MethodPut, "AsyncOperationTrackerTest") {
+		t.Errorf("observe(...): want duration of completed operation observed")
+	}
+}
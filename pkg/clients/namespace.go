@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// AnnotationKeyDefaultProviderConfig is the namespace annotation that
+	// names the ProviderConfig to use for managed resources composed for
+	// claims in that namespace.
+	AnnotationKeyDefaultProviderConfig = "azure.crossplane.io/default-provider-config"
+
+	// LabelKeyClaimNamespace is the label Crossplane uses to record the
+	// namespace of the claim a managed resource was composed for.
+	LabelKeyClaimNamespace = "crossplane.io/claim-namespace"
+
+	// LabelKeyClaimName is the label Crossplane uses to record the name of
+	// the claim a managed resource was composed for.
+	LabelKeyClaimName = "crossplane.io/claim-name"
+
+	defaultProviderConfigName = "default"
+
+	errGetClaimNamespace = "cannot get claim namespace"
+	errUpdateManaged     = "cannot update managed resource"
+)
+
+// A NamespaceProviderConfig initializes the ProviderConfig reference of a
+// managed resource from the default-provider-config annotation of the
+// namespace of its claim.
+type NamespaceProviderConfig struct{ client client.Client }
+
+// NewNamespaceProviderConfig returns a new NamespaceProviderConfig.
+func NewNamespaceProviderConfig(c client.Client) *NamespaceProviderConfig {
+	return &NamespaceProviderConfig{client: c}
+}
+
+// Initialize the ProviderConfig reference of the supplied managed resource.
+// Resources that reference a ProviderConfig other than the one named
+// 'default', which the API server sets when none is specified, are left
+// untouched.
+func (a *NamespaceProviderConfig) Initialize(ctx context.Context, mg resource.Managed) error {
+	ns := mg.GetLabels()[LabelKeyClaimNamespace]
+	if ns == "" {
+		return nil
+	}
+	if ref := mg.GetProviderConfigReference(); ref != nil && ref.Name != defaultProviderConfigName {
+		return nil
+	}
+	n := &corev1.Namespace{}
+	if err := a.client.Get(ctx, types.NamespacedName{Name: ns}, n); err != nil {
+		return errors.Wrap(resource.IgnoreNotFound(err), errGetClaimNamespace)
+	}
+	name := n.GetAnnotations()[AnnotationKeyDefaultProviderConfig]
+	if name == "" || name == defaultProviderConfigName {
+		return nil
+	}
+	mg.SetProviderConfigReference(&xpv1.Reference{Name: name})
+	return errors.Wrap(a.client.Update(ctx, mg), errUpdateManaged)
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestNamespaceProviderConfig(t *testing.T) {
+	errBoom := errors.New("boom")
+	claimed := func(ref *xpv1.Reference) *fake.Managed {
+		mg := &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: ref}}
+		mg.SetLabels(map[string]string{LabelKeyClaimNamespace: "cool-team"})
+		return mg
+	}
+	annotated := func(name string) test.MockGetFn {
+		return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Namespace).SetAnnotations(map[string]string{AnnotationKeyDefaultProviderConfig: name})
+			return nil
+		}
+	}
+
+	type want struct {
+		ref *xpv1.Reference
+		err error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		mg   *fake.Managed
+		want want
+	}{
+		"NotClaimed": {
+			mg: &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "default"}}},
+			want: want{
+				ref: &xpv1.Reference{Name: "default"},
+			},
+		},
+		"ExplicitProviderConfig": {
+			mg: claimed(&xpv1.Reference{Name: "cool-config"}),
+			want: want{
+				ref: &xpv1.Reference{Name: "cool-config"},
+			},
+		},
+		"NamespaceNotFound": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "cool-team"))},
+			mg:   claimed(&xpv1.Reference{Name: "default"}),
+			want: want{
+				ref: &xpv1.Reference{Name: "default"},
+			},
+		},
+		"GetNamespaceError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg:   claimed(&xpv1.Reference{Name: "default"}),
+			want: want{
+				ref: &xpv1.Reference{Name: "default"},
+				err: errors.Wrap(errBoom, errGetClaimNamespace),
+			},
+		},
+		"NotAnnotated": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			mg:   claimed(&xpv1.Reference{Name: "default"}),
+			want: want{
+				ref: &xpv1.Reference{Name: "default"},
+			},
+		},
+		"UpdateError": {
+			kube: &test.MockClient{MockGet: annotated("team-config"), MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:   claimed(&xpv1.Reference{Name: "default"}),
+			want: want{
+				ref: &xpv1.Reference{Name: "team-config"},
+				err: errors.Wrap(errBoom, errUpdateManaged),
+			},
+		},
+		"Defaulted": {
+			kube: &test.MockClient{MockGet: annotated("team-config"), MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:   claimed(nil),
+			want: want{
+				ref: &xpv1.Reference{Name: "team-config"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewNamespaceProviderConfig(tc.kube).Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Initialize(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ref, tc.mg.GetProviderConfigReference()); diff != "" {
+				t.Errorf("Initialize(...): -want providerConfigRef, +got providerConfigRef:\n%s", diff)
+			}
+		})
+	}
+}
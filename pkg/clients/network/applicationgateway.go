@@ -0,0 +1,520 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"strings"
+
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// ApplicationGatewayIPConfigurationName is the name of the gateway IP
+// configuration that places an Application Gateway in its subnet. An
+// Application Gateway has exactly one.
+const ApplicationGatewayIPConfigurationName = "gatewayIPConfiguration"
+
+// The types of the child resources of an Application Gateway, as they appear
+// in their IDs.
+const (
+	agFrontendIPConfigurations = "frontendIPConfigurations"
+	agFrontendPorts            = "frontendPorts"
+	agBackendAddressPools      = "backendAddressPools"
+	agBackendHTTPSettings      = "backendHttpSettingsCollection"
+	agHTTPListeners            = "httpListeners"
+)
+
+// ApplicationGatewayID returns the ID of the Application Gateway with the
+// supplied name.
+func ApplicationGatewayID(subscriptionID, resourceGroupName, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s", subscriptionID, resourceGroupName, name)
+}
+
+// NewApplicationGatewayParameters returns an Azure ApplicationGateway object
+// from an application gateway spec. The child resources of the Application
+// Gateway refer to each other by ID, which is derived from the supplied ID of
+// the Application Gateway.
+func NewApplicationGatewayParameters(p v1alpha3.ApplicationGatewayParameters, id string) networkmgmt.ApplicationGateway {
+	az := networkmgmt.ApplicationGateway{
+		Location: azure.ToStringPtr(p.Location),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+		ApplicationGatewayPropertiesFormat: &networkmgmt.ApplicationGatewayPropertiesFormat{
+			GatewayIPConfigurations: &[]networkmgmt.ApplicationGatewayIPConfiguration{{
+				Name: azure.ToStringPtr(ApplicationGatewayIPConfigurationName),
+				ApplicationGatewayIPConfigurationPropertiesFormat: &networkmgmt.ApplicationGatewayIPConfigurationPropertiesFormat{
+					Subnet: &networkmgmt.SubResource{ID: azure.ToStringPtr(p.SubnetID)},
+				},
+			}},
+			FrontendIPConfigurations:      newFrontendIPConfigurations(p.FrontendIPConfigurations, p.SubnetID),
+			FrontendPorts:                 newFrontendPorts(p.FrontendPorts),
+			BackendAddressPools:           newBackendAddressPools(p.BackendAddressPools),
+			BackendHTTPSettingsCollection: newBackendHTTPSettings(p.BackendHTTPSettings),
+			HTTPListeners:                 newHTTPListeners(p.HTTPListeners, id),
+			RequestRoutingRules:           newRequestRoutingRules(p.RequestRoutingRules, id),
+		},
+	}
+	SetApplicationGatewaySettings(&az, p)
+	return az
+}
+
+// SetApplicationGatewaySettings sets the desired settings of the supplied
+// Azure ApplicationGateway, i.e. everything but its child resources. It is
+// used to update Application Gateways whose child resources are managed by
+// the Application Gateway Ingress Controller. The web application firewall
+// configuration is left as is unless one is desired.
+func SetApplicationGatewaySettings(az *networkmgmt.ApplicationGateway, p v1alpha3.ApplicationGatewayParameters) {
+	az.Tags = azure.ToStringPtrMap(p.Tags)
+	if az.ApplicationGatewayPropertiesFormat == nil {
+		az.ApplicationGatewayPropertiesFormat = &networkmgmt.ApplicationGatewayPropertiesFormat{}
+	}
+	az.Sku = &networkmgmt.ApplicationGatewaySku{
+		Name:     networkmgmt.ApplicationGatewaySkuName(p.SKU.Name),
+		Tier:     networkmgmt.ApplicationGatewayTier(p.SKU.Tier),
+		Capacity: p.SKU.Capacity,
+	}
+	az.EnableHTTP2 = p.EnableHTTP2
+	if p.WAFConfiguration != nil {
+		az.WebApplicationFirewallConfiguration = newWAFConfiguration(p.WAFConfiguration)
+	}
+}
+
+func childID(id, kind, name string) *networkmgmt.SubResource {
+	return &networkmgmt.SubResource{ID: azure.ToStringPtr(id + "/" + kind + "/" + name)}
+}
+
+// childName returns the name of the child resource of an Application Gateway
+// the supplied SubResource refers to.
+func childName(r *networkmgmt.SubResource) string {
+	if r == nil {
+		return ""
+	}
+	id := azure.ToString(r.ID)
+	return id[strings.LastIndex(id, "/")+1:]
+}
+
+func subResourceID(r *networkmgmt.SubResource) *string {
+	if r == nil {
+		return nil
+	}
+	return r.ID
+}
+
+func newFrontendIPConfigurations(in []v1alpha3.ApplicationGatewayFrontendIPConfiguration, subnetID string) *[]networkmgmt.ApplicationGatewayFrontendIPConfiguration {
+	out := make([]networkmgmt.ApplicationGatewayFrontendIPConfiguration, len(in))
+	for i, fe := range in {
+		props := &networkmgmt.ApplicationGatewayFrontendIPConfigurationPropertiesFormat{}
+		switch {
+		case fe.PublicIPAddressID != nil:
+			props.PublicIPAddress = &networkmgmt.SubResource{ID: fe.PublicIPAddressID}
+		case fe.PrivateIPAddress != nil:
+			props.Subnet = &networkmgmt.SubResource{ID: azure.ToStringPtr(subnetID)}
+			props.PrivateIPAddress = fe.PrivateIPAddress
+			props.PrivateIPAllocationMethod = networkmgmt.Static
+		default:
+			props.Subnet = &networkmgmt.SubResource{ID: azure.ToStringPtr(subnetID)}
+			props.PrivateIPAllocationMethod = networkmgmt.Dynamic
+		}
+		out[i] = networkmgmt.ApplicationGatewayFrontendIPConfiguration{
+			Name: azure.ToStringPtr(fe.Name),
+			ApplicationGatewayFrontendIPConfigurationPropertiesFormat: props,
+		}
+	}
+	return &out
+}
+
+func newFrontendPorts(in []v1alpha3.ApplicationGatewayFrontendPort) *[]networkmgmt.ApplicationGatewayFrontendPort {
+	out := make([]networkmgmt.ApplicationGatewayFrontendPort, len(in))
+	for i, fp := range in {
+		fp := fp
+		out[i] = networkmgmt.ApplicationGatewayFrontendPort{
+			Name: azure.ToStringPtr(fp.Name),
+			ApplicationGatewayFrontendPortPropertiesFormat: &networkmgmt.ApplicationGatewayFrontendPortPropertiesFormat{
+				Port: &fp.Port,
+			},
+		}
+	}
+	return &out
+}
+
+func newBackendAddressPools(in []v1alpha3.ApplicationGatewayBackendAddressPool) *[]networkmgmt.ApplicationGatewayBackendAddressPool {
+	out := make([]networkmgmt.ApplicationGatewayBackendAddressPool, len(in))
+	for i, bp := range in {
+		addrs := make([]networkmgmt.ApplicationGatewayBackendAddress, 0, len(bp.IPAddresses)+len(bp.FQDNs))
+		for _, ip := range bp.IPAddresses {
+			addrs = append(addrs, networkmgmt.ApplicationGatewayBackendAddress{IPAddress: azure.ToStringPtr(ip)})
+		}
+		for _, fqdn := range bp.FQDNs {
+			addrs = append(addrs, networkmgmt.ApplicationGatewayBackendAddress{Fqdn: azure.ToStringPtr(fqdn)})
+		}
+		out[i] = networkmgmt.ApplicationGatewayBackendAddressPool{
+			Name: azure.ToStringPtr(bp.Name),
+			ApplicationGatewayBackendAddressPoolPropertiesFormat: &networkmgmt.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+				BackendAddresses: &addrs,
+			},
+		}
+	}
+	return &out
+}
+
+func newBackendHTTPSettings(in []v1alpha3.ApplicationGatewayBackendHTTPSettings) *[]networkmgmt.ApplicationGatewayBackendHTTPSettings {
+	out := make([]networkmgmt.ApplicationGatewayBackendHTTPSettings, len(in))
+	for i, s := range in {
+		s := s
+		affinity := networkmgmt.Disabled
+		if s.CookieBasedAffinity != nil {
+			affinity = networkmgmt.ApplicationGatewayCookieBasedAffinity(*s.CookieBasedAffinity)
+		}
+		out[i] = networkmgmt.ApplicationGatewayBackendHTTPSettings{
+			Name: azure.ToStringPtr(s.Name),
+			ApplicationGatewayBackendHTTPSettingsPropertiesFormat: &networkmgmt.ApplicationGatewayBackendHTTPSettingsPropertiesFormat{
+				Port:                           &s.Port,
+				Protocol:                       networkmgmt.ApplicationGatewayProtocol(s.Protocol),
+				CookieBasedAffinity:            affinity,
+				RequestTimeout:                 s.RequestTimeout,
+				HostName:                       s.HostName,
+				PickHostNameFromBackendAddress: s.PickHostNameFromBackendAddress,
+				Path:                           s.Path,
+			},
+		}
+	}
+	return &out
+}
+
+func newHTTPListeners(in []v1alpha3.ApplicationGatewayHTTPListener, id string) *[]networkmgmt.ApplicationGatewayHTTPListener {
+	out := make([]networkmgmt.ApplicationGatewayHTTPListener, len(in))
+	for i, l := range in {
+		out[i] = networkmgmt.ApplicationGatewayHTTPListener{
+			Name: azure.ToStringPtr(l.Name),
+			ApplicationGatewayHTTPListenerPropertiesFormat: &networkmgmt.ApplicationGatewayHTTPListenerPropertiesFormat{
+				FrontendIPConfiguration: childID(id, agFrontendIPConfigurations, l.FrontendIPConfigurationName),
+				FrontendPort:            childID(id, agFrontendPorts, l.FrontendPortName),
+				Protocol:                networkmgmt.ApplicationGatewayProtocol(l.Protocol),
+				HostName:                l.HostName,
+			},
+		}
+	}
+	return &out
+}
+
+func newRequestRoutingRules(in []v1alpha3.ApplicationGatewayRequestRoutingRule, id string) *[]networkmgmt.ApplicationGatewayRequestRoutingRule {
+	out := make([]networkmgmt.ApplicationGatewayRequestRoutingRule, len(in))
+	for i, r := range in {
+		out[i] = networkmgmt.ApplicationGatewayRequestRoutingRule{
+			Name: azure.ToStringPtr(r.Name),
+			ApplicationGatewayRequestRoutingRulePropertiesFormat: &networkmgmt.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+				RuleType:            networkmgmt.ApplicationGatewayRequestRoutingRuleType(r.RuleType),
+				HTTPListener:        childID(id, agHTTPListeners, r.HTTPListenerName),
+				BackendAddressPool:  childID(id, agBackendAddressPools, r.BackendAddressPoolName),
+				BackendHTTPSettings: childID(id, agBackendHTTPSettings, r.BackendHTTPSettingsName),
+			},
+		}
+	}
+	return &out
+}
+
+func newWAFConfiguration(in *v1alpha3.ApplicationGatewayWAFConfiguration) *networkmgmt.ApplicationGatewayWebApplicationFirewallConfiguration {
+	if in == nil {
+		return nil
+	}
+	return &networkmgmt.ApplicationGatewayWebApplicationFirewallConfiguration{
+		Enabled:                azure.ToBoolPtr(in.Enabled, azure.FieldRequired),
+		FirewallMode:           networkmgmt.ApplicationGatewayFirewallMode(in.FirewallMode),
+		RuleSetType:            azure.ToStringPtr(in.RuleSetType),
+		RuleSetVersion:         azure.ToStringPtr(in.RuleSetVersion),
+		RequestBodyCheck:       in.RequestBodyCheck,
+		MaxRequestBodySizeInKb: in.MaxRequestBodySizeInKB,
+		FileUploadLimitInMb:    in.FileUploadLimitInMB,
+	}
+}
+
+// GenerateApplicationGatewayObservation returns the observation object
+// related to the external Azure application gateway in the
+// ApplicationGatewayStatus
+func GenerateApplicationGatewayObservation(az networkmgmt.ApplicationGateway) v1alpha3.ApplicationGatewayObservation {
+	o := v1alpha3.ApplicationGatewayObservation{
+		Etag: azure.ToString(az.Etag),
+		ID:   azure.ToString(az.ID),
+	}
+	if az.ApplicationGatewayPropertiesFormat == nil {
+		return o
+	}
+	o.State = azure.ToString(az.ProvisioningState)
+	o.OperationalState = string(az.OperationalState)
+	o.ResourceGUID = azure.ToString(az.ResourceGUID)
+	return o
+}
+
+// LateInitializeApplicationGateway late-initializes an ApplicationGateway
+// resource.
+func LateInitializeApplicationGateway(p *v1alpha3.ApplicationGatewayParameters, in networkmgmt.ApplicationGateway) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+	if in.ApplicationGatewayPropertiesFormat == nil {
+		return
+	}
+	if in.Sku != nil {
+		p.SKU.Capacity = azure.LateInitializeInt32PtrFromInt32Ptr(p.SKU.Capacity, in.Sku.Capacity)
+	}
+	p.EnableHTTP2 = azure.LateInitializeBoolPtrFromPtr(p.EnableHTTP2, in.EnableHTTP2)
+	if p.WAFConfiguration != nil && in.WebApplicationFirewallConfiguration != nil {
+		waf := in.WebApplicationFirewallConfiguration
+		p.WAFConfiguration.RequestBodyCheck = azure.LateInitializeBoolPtrFromPtr(p.WAFConfiguration.RequestBodyCheck, waf.RequestBodyCheck)
+		p.WAFConfiguration.MaxRequestBodySizeInKB = azure.LateInitializeInt32PtrFromInt32Ptr(p.WAFConfiguration.MaxRequestBodySizeInKB, waf.MaxRequestBodySizeInKb)
+		p.WAFConfiguration.FileUploadLimitInMB = azure.LateInitializeInt32PtrFromInt32Ptr(p.WAFConfiguration.FileUploadLimitInMB, waf.FileUploadLimitInMb)
+	}
+	lateInitializeBackendHTTPSettings(p.BackendHTTPSettings, generateBackendHTTPSettings(in.BackendHTTPSettingsCollection))
+}
+
+// lateInitializeBackendHTTPSettings late-initializes the optional fields of
+// the supplied backend HTTP settings from the observed settings of the same
+// name, which Azure defaults.
+func lateInitializeBackendHTTPSettings(settings, from []v1alpha3.ApplicationGatewayBackendHTTPSettings) {
+	observed := make(map[string]v1alpha3.ApplicationGatewayBackendHTTPSettings, len(from))
+	for _, s := range from {
+		observed[s.Name] = s
+	}
+	for i := range settings {
+		o, ok := observed[settings[i].Name]
+		if !ok {
+			continue
+		}
+		s := &settings[i]
+		s.CookieBasedAffinity = azure.LateInitializeStringPtrFromPtr(s.CookieBasedAffinity, o.CookieBasedAffinity)
+		s.RequestTimeout = azure.LateInitializeInt32PtrFromInt32Ptr(s.RequestTimeout, o.RequestTimeout)
+		s.PickHostNameFromBackendAddress = azure.LateInitializeBoolPtrFromPtr(s.PickHostNameFromBackendAddress, o.PickHostNameFromBackendAddress)
+	}
+}
+
+// IsApplicationGatewayUpToDate is used to report whether the supplied
+// network.ApplicationGateway is in sync with the ApplicationGatewayParameters
+// that the user desires. The child resources of Application Gateways that are
+// managed by the Application Gateway Ingress Controller are ignored.
+func IsApplicationGatewayUpToDate(p v1alpha3.ApplicationGatewayParameters, in networkmgmt.ApplicationGateway) bool {
+	if in.ApplicationGatewayPropertiesFormat == nil {
+		return false
+	}
+	observed := generateApplicationGatewayParameters(in)
+	if p.WAFConfiguration == nil {
+		observed.WAFConfiguration = nil
+	}
+	ignored := []string{"SubscriptionID", "ResourceGroupName", "ResourceGroupNameRef", "ResourceGroupNameSelector", "Location",
+		"SubnetID", "SubnetIDRef", "SubnetIDSelector", "FrontendIPConfigurations", "ManagedByIngressController"}
+	if p.ManagedByIngressController {
+		ignored = append(ignored, "FrontendPorts", "BackendAddressPools", "BackendHTTPSettings", "HTTPListeners", "RequestRoutingRules")
+	}
+	if !cmp.Equal(p, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha3.ApplicationGatewayParameters{}, ignored...),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.SortSlices(func(a, b v1alpha3.ApplicationGatewayFrontendPort) bool { return a.Name < b.Name }),
+		cmpopts.SortSlices(func(a, b v1alpha3.ApplicationGatewayBackendAddressPool) bool { return a.Name < b.Name }),
+		cmpopts.SortSlices(func(a, b v1alpha3.ApplicationGatewayBackendHTTPSettings) bool { return a.Name < b.Name }),
+		cmpopts.SortSlices(func(a, b v1alpha3.ApplicationGatewayHTTPListener) bool { return a.Name < b.Name }),
+		cmpopts.SortSlices(func(a, b v1alpha3.ApplicationGatewayRequestRoutingRule) bool { return a.Name < b.Name }),
+	) {
+		return false
+	}
+	// Azure does not preserve the case of resource IDs.
+	if !strings.EqualFold(p.SubnetID, observed.SubnetID) {
+		return false
+	}
+	return p.ManagedByIngressController || areFrontendIPConfigurationsUpToDate(p.FrontendIPConfigurations, observed.FrontendIPConfigurations)
+}
+
+func areFrontendIPConfigurationsUpToDate(want, got []v1alpha3.ApplicationGatewayFrontendIPConfiguration) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	observed := make(map[string]v1alpha3.ApplicationGatewayFrontendIPConfiguration, len(got))
+	for _, fe := range got {
+		observed[fe.Name] = fe
+	}
+	for _, fe := range want {
+		o, ok := observed[fe.Name]
+		if !ok {
+			return false
+		}
+		if !strings.EqualFold(azure.ToString(fe.PublicIPAddressID), azure.ToString(o.PublicIPAddressID)) {
+			return false
+		}
+		// Azure allocates a private IP address to frontends that do not
+		// specify one.
+		if fe.PrivateIPAddress != nil && azure.ToString(fe.PrivateIPAddress) != azure.ToString(o.PrivateIPAddress) {
+			return false
+		}
+	}
+	return true
+}
+
+// generateApplicationGatewayParameters returns the ApplicationGatewayParameters
+// that describe the supplied Azure ApplicationGateway.
+func generateApplicationGatewayParameters(in networkmgmt.ApplicationGateway) v1alpha3.ApplicationGatewayParameters {
+	p := v1alpha3.ApplicationGatewayParameters{
+		Tags:                     azure.ToStringMap(in.Tags),
+		EnableHTTP2:              in.EnableHTTP2,
+		SubnetID:                 generateGatewaySubnetID(in.GatewayIPConfigurations),
+		FrontendIPConfigurations: generateFrontendIPConfigurations(in.FrontendIPConfigurations),
+		FrontendPorts:            generateFrontendPorts(in.FrontendPorts),
+		BackendAddressPools:      generateBackendAddressPools(in.BackendAddressPools),
+		BackendHTTPSettings:      generateBackendHTTPSettings(in.BackendHTTPSettingsCollection),
+		HTTPListeners:            generateHTTPListeners(in.HTTPListeners),
+		RequestRoutingRules:      generateRequestRoutingRules(in.RequestRoutingRules),
+		WAFConfiguration:         generateWAFConfiguration(in.WebApplicationFirewallConfiguration),
+	}
+	if in.Sku != nil {
+		p.SKU = v1alpha3.ApplicationGatewaySKU{Name: string(in.Sku.Name), Tier: string(in.Sku.Tier), Capacity: in.Sku.Capacity}
+	}
+	return p
+}
+
+func generateGatewaySubnetID(in *[]networkmgmt.ApplicationGatewayIPConfiguration) string {
+	if in == nil {
+		return ""
+	}
+	for _, c := range *in {
+		if c.ApplicationGatewayIPConfigurationPropertiesFormat != nil {
+			return azure.ToString(subResourceID(c.Subnet))
+		}
+	}
+	return ""
+}
+
+func generateFrontendIPConfigurations(in *[]networkmgmt.ApplicationGatewayFrontendIPConfiguration) []v1alpha3.ApplicationGatewayFrontendIPConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := make([]v1alpha3.ApplicationGatewayFrontendIPConfiguration, len(*in))
+	for i, fe := range *in {
+		out[i] = v1alpha3.ApplicationGatewayFrontendIPConfiguration{Name: azure.ToString(fe.Name)}
+		if props := fe.ApplicationGatewayFrontendIPConfigurationPropertiesFormat; props != nil {
+			out[i].PublicIPAddressID = subResourceID(props.PublicIPAddress)
+			out[i].PrivateIPAddress = props.PrivateIPAddress
+		}
+	}
+	return out
+}
+
+func generateFrontendPorts(in *[]networkmgmt.ApplicationGatewayFrontendPort) []v1alpha3.ApplicationGatewayFrontendPort {
+	if in == nil {
+		return nil
+	}
+	out := make([]v1alpha3.ApplicationGatewayFrontendPort, len(*in))
+	for i, fp := range *in {
+		out[i] = v1alpha3.ApplicationGatewayFrontendPort{Name: azure.ToString(fp.Name)}
+		if props := fp.ApplicationGatewayFrontendPortPropertiesFormat; props != nil {
+			out[i].Port = to.Int32(props.Port)
+		}
+	}
+	return out
+}
+
+func generateBackendAddressPools(in *[]networkmgmt.ApplicationGatewayBackendAddressPool) []v1alpha3.ApplicationGatewayBackendAddressPool {
+	if in == nil {
+		return nil
+	}
+	out := make([]v1alpha3.ApplicationGatewayBackendAddressPool, len(*in))
+	for i, bp := range *in {
+		out[i] = v1alpha3.ApplicationGatewayBackendAddressPool{Name: azure.ToString(bp.Name)}
+		props := bp.ApplicationGatewayBackendAddressPoolPropertiesFormat
+		if props == nil || props.BackendAddresses == nil {
+			continue
+		}
+		for _, a := range *props.BackendAddresses {
+			if a.IPAddress != nil {
+				out[i].IPAddresses = append(out[i].IPAddresses, *a.IPAddress)
+			}
+			if a.Fqdn != nil {
+				out[i].FQDNs = append(out[i].FQDNs, *a.Fqdn)
+			}
+		}
+	}
+	return out
+}
+
+func generateBackendHTTPSettings(in *[]networkmgmt.ApplicationGatewayBackendHTTPSettings) []v1alpha3.ApplicationGatewayBackendHTTPSettings {
+	if in == nil {
+		return nil
+	}
+	out := make([]v1alpha3.ApplicationGatewayBackendHTTPSettings, len(*in))
+	for i, s := range *in {
+		out[i] = v1alpha3.ApplicationGatewayBackendHTTPSettings{Name: azure.ToString(s.Name)}
+		if props := s.ApplicationGatewayBackendHTTPSettingsPropertiesFormat; props != nil {
+			out[i].Port = to.Int32(props.Port)
+			out[i].Protocol = string(props.Protocol)
+			out[i].CookieBasedAffinity = azure.ToStringPtr(string(props.CookieBasedAffinity))
+			out[i].RequestTimeout = props.RequestTimeout
+			out[i].HostName = props.HostName
+			out[i].PickHostNameFromBackendAddress = props.PickHostNameFromBackendAddress
+			out[i].Path = props.Path
+		}
+	}
+	return out
+}
+
+func generateHTTPListeners(in *[]networkmgmt.ApplicationGatewayHTTPListener) []v1alpha3.ApplicationGatewayHTTPListener {
+	if in == nil {
+		return nil
+	}
+	out := make([]v1alpha3.ApplicationGatewayHTTPListener, len(*in))
+	for i, l := range *in {
+		out[i] = v1alpha3.ApplicationGatewayHTTPListener{Name: azure.ToString(l.Name)}
+		if props := l.ApplicationGatewayHTTPListenerPropertiesFormat; props != nil {
+			out[i].FrontendIPConfigurationName = childName(props.FrontendIPConfiguration)
+			out[i].FrontendPortName = childName(props.FrontendPort)
+			out[i].Protocol = string(props.Protocol)
+			out[i].HostName = props.HostName
+		}
+	}
+	return out
+}
+
+func generateRequestRoutingRules(in *[]networkmgmt.ApplicationGatewayRequestRoutingRule) []v1alpha3.ApplicationGatewayRequestRoutingRule {
+	if in == nil {
+		return nil
+	}
+	out := make([]v1alpha3.ApplicationGatewayRequestRoutingRule, len(*in))
+	for i, r := range *in {
+		out[i] = v1alpha3.ApplicationGatewayRequestRoutingRule{Name: azure.ToString(r.Name)}
+		if props := r.ApplicationGatewayRequestRoutingRulePropertiesFormat; props != nil {
+			out[i].RuleType = string(props.RuleType)
+			out[i].HTTPListenerName = childName(props.HTTPListener)
+			out[i].BackendAddressPoolName = childName(props.BackendAddressPool)
+			out[i].BackendHTTPSettingsName = childName(props.BackendHTTPSettings)
+		}
+	}
+	return out
+}
+
+func generateWAFConfiguration(in *networkmgmt.ApplicationGatewayWebApplicationFirewallConfiguration) *v1alpha3.ApplicationGatewayWAFConfiguration {
+	if in == nil {
+		return nil
+	}
+	return &v1alpha3.ApplicationGatewayWAFConfiguration{
+		Enabled:                to.Bool(in.Enabled),
+		FirewallMode:           string(in.FirewallMode),
+		RuleSetType:            azure.ToString(in.RuleSetType),
+		RuleSetVersion:         azure.ToString(in.RuleSetVersion),
+		RequestBodyCheck:       in.RequestBodyCheck,
+		MaxRequestBodySizeInKB: in.MaxRequestBodySizeInKb,
+		FileUploadLimitInMB:    in.FileUploadLimitInMb,
+	}
+}
@@ -0,0 +1,354 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"strings"
+	"testing"
+
+	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const (
+	agID       = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/applicationGateways/ag"
+	agSubnetID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/gateway"
+	agPublicIP = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/ip"
+)
+
+func applicationGatewayParameters() v1alpha3.ApplicationGatewayParameters {
+	return v1alpha3.ApplicationGatewayParameters{
+		Location: location,
+		SKU: v1alpha3.ApplicationGatewaySKU{
+			Name:     string(networkmgmt.StandardV2),
+			Tier:     string(networkmgmt.ApplicationGatewayTierStandardV2),
+			Capacity: to.Int32Ptr(2),
+		},
+		SubnetID: agSubnetID,
+		FrontendIPConfigurations: []v1alpha3.ApplicationGatewayFrontendIPConfiguration{
+			{Name: "public", PublicIPAddressID: azure.ToStringPtr(agPublicIP)},
+		},
+		FrontendPorts: []v1alpha3.ApplicationGatewayFrontendPort{
+			{Name: "http", Port: 80},
+		},
+		BackendAddressPools: []v1alpha3.ApplicationGatewayBackendAddressPool{
+			{Name: "pool", IPAddresses: []string{"10.0.1.4", "10.0.1.5"}},
+		},
+		BackendHTTPSettings: []v1alpha3.ApplicationGatewayBackendHTTPSettings{
+			{Name: "settings", Port: 80, Protocol: string(networkmgmt.HTTP), CookieBasedAffinity: azure.ToStringPtr(string(networkmgmt.Disabled))},
+		},
+		HTTPListeners: []v1alpha3.ApplicationGatewayHTTPListener{
+			{Name: "listener", FrontendIPConfigurationName: "public", FrontendPortName: "http", Protocol: string(networkmgmt.HTTP)},
+		},
+		RequestRoutingRules: []v1alpha3.ApplicationGatewayRequestRoutingRule{
+			{Name: "rule", RuleType: string(networkmgmt.Basic), HTTPListenerName: "listener", BackendAddressPoolName: "pool", BackendHTTPSettingsName: "settings"},
+		},
+		Tags: map[string]string{"cool": "tag"},
+	}
+}
+
+func TestNewApplicationGatewayParameters(t *testing.T) {
+	cases := []struct {
+		name string
+		p    v1alpha3.ApplicationGatewayParameters
+		want networkmgmt.ApplicationGateway
+	}{
+		{
+			name: "Successful",
+			p:    applicationGatewayParameters(),
+			want: networkmgmt.ApplicationGateway{
+				Location: azure.ToStringPtr(location),
+				Tags:     map[string]*string{"cool": azure.ToStringPtr("tag")},
+				ApplicationGatewayPropertiesFormat: &networkmgmt.ApplicationGatewayPropertiesFormat{
+					Sku: &networkmgmt.ApplicationGatewaySku{
+						Name:     networkmgmt.StandardV2,
+						Tier:     networkmgmt.ApplicationGatewayTierStandardV2,
+						Capacity: to.Int32Ptr(2),
+					},
+					GatewayIPConfigurations: &[]networkmgmt.ApplicationGatewayIPConfiguration{{
+						Name: azure.ToStringPtr(ApplicationGatewayIPConfigurationName),
+						ApplicationGatewayIPConfigurationPropertiesFormat: &networkmgmt.ApplicationGatewayIPConfigurationPropertiesFormat{
+							Subnet: &networkmgmt.SubResource{ID: azure.ToStringPtr(agSubnetID)},
+						},
+					}},
+					FrontendIPConfigurations: &[]networkmgmt.ApplicationGatewayFrontendIPConfiguration{{
+						Name: azure.ToStringPtr("public"),
+						ApplicationGatewayFrontendIPConfigurationPropertiesFormat: &networkmgmt.ApplicationGatewayFrontendIPConfigurationPropertiesFormat{
+							PublicIPAddress: &networkmgmt.SubResource{ID: azure.ToStringPtr(agPublicIP)},
+						},
+					}},
+					FrontendPorts: &[]networkmgmt.ApplicationGatewayFrontendPort{{
+						Name: azure.ToStringPtr("http"),
+						ApplicationGatewayFrontendPortPropertiesFormat: &networkmgmt.ApplicationGatewayFrontendPortPropertiesFormat{
+							Port: to.Int32Ptr(80),
+						},
+					}},
+					BackendAddressPools: &[]networkmgmt.ApplicationGatewayBackendAddressPool{{
+						Name: azure.ToStringPtr("pool"),
+						ApplicationGatewayBackendAddressPoolPropertiesFormat: &networkmgmt.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+							BackendAddresses: &[]networkmgmt.ApplicationGatewayBackendAddress{
+								{IPAddress: azure.ToStringPtr("10.0.1.4")},
+								{IPAddress: azure.ToStringPtr("10.0.1.5")},
+							},
+						},
+					}},
+					BackendHTTPSettingsCollection: &[]networkmgmt.ApplicationGatewayBackendHTTPSettings{{
+						Name: azure.ToStringPtr("settings"),
+						ApplicationGatewayBackendHTTPSettingsPropertiesFormat: &networkmgmt.ApplicationGatewayBackendHTTPSettingsPropertiesFormat{
+							Port:                to.Int32Ptr(80),
+							Protocol:            networkmgmt.HTTP,
+							CookieBasedAffinity: networkmgmt.Disabled,
+						},
+					}},
+					HTTPListeners: &[]networkmgmt.ApplicationGatewayHTTPListener{{
+						Name: azure.ToStringPtr("listener"),
+						ApplicationGatewayHTTPListenerPropertiesFormat: &networkmgmt.ApplicationGatewayHTTPListenerPropertiesFormat{
+							FrontendIPConfiguration: &networkmgmt.SubResource{ID: azure.ToStringPtr(agID + "/frontendIPConfigurations/public")},
+							FrontendPort:            &networkmgmt.SubResource{ID: azure.ToStringPtr(agID + "/frontendPorts/http")},
+							Protocol:                networkmgmt.HTTP,
+						},
+					}},
+					RequestRoutingRules: &[]networkmgmt.ApplicationGatewayRequestRoutingRule{{
+						Name: azure.ToStringPtr("rule"),
+						ApplicationGatewayRequestRoutingRulePropertiesFormat: &networkmgmt.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+							RuleType:            networkmgmt.Basic,
+							HTTPListener:        &networkmgmt.SubResource{ID: azure.ToStringPtr(agID + "/httpListeners/listener")},
+							BackendAddressPool:  &networkmgmt.SubResource{ID: azure.ToStringPtr(agID + "/backendAddressPools/pool")},
+							BackendHTTPSettings: &networkmgmt.SubResource{ID: azure.ToStringPtr(agID + "/backendHttpSettingsCollection/settings")},
+						},
+					}},
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewApplicationGatewayParameters(tc.p, agID)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewApplicationGatewayParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewFrontendIPConfigurations(t *testing.T) {
+	cases := []struct {
+		name string
+		in   v1alpha3.ApplicationGatewayFrontendIPConfiguration
+		want networkmgmt.ApplicationGatewayFrontendIPConfigurationPropertiesFormat
+	}{
+		{
+			name: "Public",
+			in:   v1alpha3.ApplicationGatewayFrontendIPConfiguration{PublicIPAddressID: azure.ToStringPtr(agPublicIP)},
+			want: networkmgmt.ApplicationGatewayFrontendIPConfigurationPropertiesFormat{
+				PublicIPAddress: &networkmgmt.SubResource{ID: azure.ToStringPtr(agPublicIP)},
+			},
+		},
+		{
+			name: "PrivateStatic",
+			in:   v1alpha3.ApplicationGatewayFrontendIPConfiguration{PrivateIPAddress: azure.ToStringPtr("10.0.0.10")},
+			want: networkmgmt.ApplicationGatewayFrontendIPConfigurationPropertiesFormat{
+				Subnet:                    &networkmgmt.SubResource{ID: azure.ToStringPtr(agSubnetID)},
+				PrivateIPAddress:          azure.ToStringPtr("10.0.0.10"),
+				PrivateIPAllocationMethod: networkmgmt.Static,
+			},
+		},
+		{
+			name: "PrivateDynamic",
+			in:   v1alpha3.ApplicationGatewayFrontendIPConfiguration{},
+			want: networkmgmt.ApplicationGatewayFrontendIPConfigurationPropertiesFormat{
+				Subnet:                    &networkmgmt.SubResource{ID: azure.ToStringPtr(agSubnetID)},
+				PrivateIPAllocationMethod: networkmgmt.Dynamic,
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := newFrontendIPConfigurations([]v1alpha3.ApplicationGatewayFrontendIPConfiguration{tc.in}, agSubnetID)
+			if diff := cmp.Diff(tc.want, *(*got)[0].ApplicationGatewayFrontendIPConfigurationPropertiesFormat); diff != "" {
+				t.Errorf("newFrontendIPConfigurations(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsApplicationGatewayUpToDate(t *testing.T) {
+	// observed returns the Azure Application Gateway that results from the
+	// supplied parameters, with the IDs upper-cased as Azure may report them.
+	observed := func(p v1alpha3.ApplicationGatewayParameters, m ...func(*networkmgmt.ApplicationGateway)) networkmgmt.ApplicationGateway {
+		az := NewApplicationGatewayParameters(p, agID)
+		(*az.GatewayIPConfigurations)[0].Subnet.ID = azure.ToStringPtr(strings.ToUpper(agSubnetID))
+		(*az.FrontendIPConfigurations)[0].PublicIPAddress.ID = azure.ToStringPtr(strings.ToUpper(agPublicIP))
+		for _, f := range m {
+			f(&az)
+		}
+		return az
+	}
+	agic := applicationGatewayParameters()
+	agic.ManagedByIngressController = true
+	withWAF := applicationGatewayParameters()
+	withWAF.WAFConfiguration = &v1alpha3.ApplicationGatewayWAFConfiguration{
+		Enabled:        true,
+		FirewallMode:   string(networkmgmt.Prevention),
+		RuleSetType:    "OWASP",
+		RuleSetVersion: "3.0",
+	}
+
+	cases := []struct {
+		name string
+		p    v1alpha3.ApplicationGatewayParameters
+		az   networkmgmt.ApplicationGateway
+		want bool
+	}{
+		{
+			name: "UpToDate",
+			p:    applicationGatewayParameters(),
+			az:   observed(applicationGatewayParameters()),
+			want: true,
+		},
+		{
+			name: "CapacityChanged",
+			p:    applicationGatewayParameters(),
+			az: observed(applicationGatewayParameters(), func(az *networkmgmt.ApplicationGateway) {
+				az.Sku.Capacity = to.Int32Ptr(3)
+			}),
+			want: false,
+		},
+		{
+			name: "BackendAddressesReordered",
+			p:    applicationGatewayParameters(),
+			az: observed(applicationGatewayParameters(), func(az *networkmgmt.ApplicationGateway) {
+				a := *(*az.BackendAddressPools)[0].BackendAddresses
+				a[0], a[1] = a[1], a[0]
+			}),
+			want: true,
+		},
+		{
+			name: "RoutingRuleChanged",
+			p:    applicationGatewayParameters(),
+			az: observed(applicationGatewayParameters(), func(az *networkmgmt.ApplicationGateway) {
+				(*az.RequestRoutingRules)[0].HTTPListener = childID(agID, agHTTPListeners, "other")
+			}),
+			want: false,
+		},
+		{
+			name: "PublicIPAddressChanged",
+			p:    applicationGatewayParameters(),
+			az: observed(applicationGatewayParameters(), func(az *networkmgmt.ApplicationGateway) {
+				(*az.FrontendIPConfigurations)[0].PublicIPAddress.ID = azure.ToStringPtr(agPublicIP + "-other")
+			}),
+			want: false,
+		},
+		{
+			name: "ManagedByIngressControllerIgnoresChildren",
+			p:    agic,
+			az: observed(applicationGatewayParameters(), func(az *networkmgmt.ApplicationGateway) {
+				(*az.RequestRoutingRules)[0].HTTPListener = childID(agID, agHTTPListeners, "agic")
+				az.FrontendIPConfigurations = &[]networkmgmt.ApplicationGatewayFrontendIPConfiguration{}
+			}),
+			want: true,
+		},
+		{
+			name: "ManagedByIngressControllerCapacityChanged",
+			p:    agic,
+			az: observed(applicationGatewayParameters(), func(az *networkmgmt.ApplicationGateway) {
+				az.Sku.Capacity = to.Int32Ptr(3)
+			}),
+			want: false,
+		},
+		{
+			name: "UndesiredWAFConfigurationIgnored",
+			p:    applicationGatewayParameters(),
+			az:   observed(withWAF),
+			want: true,
+		},
+		{
+			name: "WAFConfigurationChanged",
+			p:    withWAF,
+			az: observed(withWAF, func(az *networkmgmt.ApplicationGateway) {
+				az.WebApplicationFirewallConfiguration.FirewallMode = networkmgmt.Detection
+			}),
+			want: false,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := IsApplicationGatewayUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsApplicationGatewayUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeApplicationGateway(t *testing.T) {
+	cases := []struct {
+		name string
+		p    v1alpha3.ApplicationGatewayParameters
+		az   networkmgmt.ApplicationGateway
+		want v1alpha3.ApplicationGatewayParameters
+	}{
+		{
+			name: "NoProperties",
+			p:    v1alpha3.ApplicationGatewayParameters{},
+			az:   networkmgmt.ApplicationGateway{Tags: map[string]*string{"cool": azure.ToStringPtr("tag")}},
+			want: v1alpha3.ApplicationGatewayParameters{Tags: map[string]string{"cool": "tag"}},
+		},
+		{
+			name: "Defaults",
+			p: v1alpha3.ApplicationGatewayParameters{
+				BackendHTTPSettings: []v1alpha3.ApplicationGatewayBackendHTTPSettings{
+					{Name: "settings", RequestTimeout: to.Int32Ptr(60)},
+				},
+			},
+			az: networkmgmt.ApplicationGateway{
+				ApplicationGatewayPropertiesFormat: &networkmgmt.ApplicationGatewayPropertiesFormat{
+					Sku:         &networkmgmt.ApplicationGatewaySku{Capacity: to.Int32Ptr(2)},
+					EnableHTTP2: azure.ToBoolPtr(true),
+					BackendHTTPSettingsCollection: &[]networkmgmt.ApplicationGatewayBackendHTTPSettings{{
+						Name: azure.ToStringPtr("settings"),
+						ApplicationGatewayBackendHTTPSettingsPropertiesFormat: &networkmgmt.ApplicationGatewayBackendHTTPSettingsPropertiesFormat{
+							CookieBasedAffinity: networkmgmt.Disabled,
+							RequestTimeout:      to.Int32Ptr(30),
+						},
+					}},
+				},
+			},
+			want: v1alpha3.ApplicationGatewayParameters{
+				SKU:         v1alpha3.ApplicationGatewaySKU{Capacity: to.Int32Ptr(2)},
+				EnableHTTP2: azure.ToBoolPtr(true),
+				BackendHTTPSettings: []v1alpha3.ApplicationGatewayBackendHTTPSettings{{
+					Name:                "settings",
+					CookieBasedAffinity: azure.ToStringPtr(string(networkmgmt.Disabled)),
+					RequestTimeout:      to.Int32Ptr(60),
+				}},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			LateInitializeApplicationGateway(&tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeApplicationGateway(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
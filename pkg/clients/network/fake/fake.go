@@ -118,3 +118,55 @@ func (c *MockPublicIPAddressClient) Get(ctx context.Context, resourceGroupName s
 func (c *MockPublicIPAddressClient) List(ctx context.Context, resourceGroupName string) (result network.PublicIPAddressListResultPage, err error) {
 	return c.MockList(ctx, resourceGroupName)
 }
+
+var _ networkapi.SecurityGroupsClientAPI = &MockSecurityGroupsClient{}
+
+// MockSecurityGroupsClient is a fake implementation of network.SecurityGroupsClient.
+type MockSecurityGroupsClient struct {
+	networkapi.SecurityGroupsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, parameters network.SecurityGroup) (result network.SecurityGroupsCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, networkSecurityGroupName string) (result network.SecurityGroupsDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, expand string) (result network.SecurityGroup, err error)
+}
+
+// CreateOrUpdate calls the MockSecurityGroupsClient's MockCreateOrUpdate method.
+func (c *MockSecurityGroupsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, parameters network.SecurityGroup) (result network.SecurityGroupsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, networkSecurityGroupName, parameters)
+}
+
+// Delete calls the MockSecurityGroupsClient's MockDelete method.
+func (c *MockSecurityGroupsClient) Delete(ctx context.Context, resourceGroupName string, networkSecurityGroupName string) (result network.SecurityGroupsDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, networkSecurityGroupName)
+}
+
+// Get calls the MockSecurityGroupsClient's MockGet method.
+func (c *MockSecurityGroupsClient) Get(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, expand string) (result network.SecurityGroup, err error) {
+	return c.MockGet(ctx, resourceGroupName, networkSecurityGroupName, expand)
+}
+
+var _ networkapi.ApplicationGatewaysClientAPI = &MockApplicationGatewaysClient{}
+
+// MockApplicationGatewaysClient is a fake implementation of network.ApplicationGatewaysClient.
+type MockApplicationGatewaysClient struct {
+	networkapi.ApplicationGatewaysClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, applicationGatewayName string, parameters network.ApplicationGateway) (result network.ApplicationGatewaysCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, applicationGatewayName string) (result network.ApplicationGatewaysDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, applicationGatewayName string) (result network.ApplicationGateway, err error)
+}
+
+// CreateOrUpdate calls the MockApplicationGatewaysClient's MockCreateOrUpdate method.
+func (c *MockApplicationGatewaysClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, applicationGatewayName string, parameters network.ApplicationGateway) (result network.ApplicationGatewaysCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, applicationGatewayName, parameters)
+}
+
+// Delete calls the MockApplicationGatewaysClient's MockDelete method.
+func (c *MockApplicationGatewaysClient) Delete(ctx context.Context, resourceGroupName string, applicationGatewayName string) (result network.ApplicationGatewaysDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, applicationGatewayName)
+}
+
+// Get calls the MockApplicationGatewaysClient's MockGet method.
+func (c *MockApplicationGatewaysClient) Get(ctx context.Context, resourceGroupName string, applicationGatewayName string) (result network.ApplicationGateway, err error) {
+	return c.MockGet(ctx, resourceGroupName, applicationGatewayName)
+}
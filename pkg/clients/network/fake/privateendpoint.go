@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network/networkapi"
+)
+
+var _ networkapi.PrivateEndpointsClientAPI = &MockPrivateEndpointsClient{}
+
+// MockPrivateEndpointsClient is a fake implementation of
+// network.PrivateEndpointsClient.
+type MockPrivateEndpointsClient struct {
+	networkapi.PrivateEndpointsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, privateEndpointName string, parameters network.PrivateEndpoint) (result network.PrivateEndpointsCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, privateEndpointName string) (result network.PrivateEndpointsDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, privateEndpointName string, expand string) (result network.PrivateEndpoint, err error)
+}
+
+// CreateOrUpdate calls the MockPrivateEndpointsClient's MockCreateOrUpdate
+// method.
+func (c *MockPrivateEndpointsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, privateEndpointName string, parameters network.PrivateEndpoint) (result network.PrivateEndpointsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, privateEndpointName, parameters)
+}
+
+// Delete calls the MockPrivateEndpointsClient's MockDelete method.
+func (c *MockPrivateEndpointsClient) Delete(ctx context.Context, resourceGroupName string, privateEndpointName string) (result network.PrivateEndpointsDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, privateEndpointName)
+}
+
+// Get calls the MockPrivateEndpointsClient's MockGet method.
+func (c *MockPrivateEndpointsClient) Get(ctx context.Context, resourceGroupName string, privateEndpointName string, expand string) (result network.PrivateEndpoint, err error) {
+	return c.MockGet(ctx, resourceGroupName, privateEndpointName, expand)
+}
+
+var _ networkapi.PrivateDNSZoneGroupsClientAPI = &MockPrivateDNSZoneGroupsClient{}
+
+// MockPrivateDNSZoneGroupsClient is a fake implementation of
+// network.PrivateDNSZoneGroupsClient.
+type MockPrivateDNSZoneGroupsClient struct {
+	networkapi.PrivateDNSZoneGroupsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, privateEndpointName string, privateDNSZoneGroupName string, parameters network.PrivateDNSZoneGroup) (result network.PrivateDNSZoneGroupsCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, privateEndpointName string, privateDNSZoneGroupName string) (result network.PrivateDNSZoneGroupsDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, privateEndpointName string, privateDNSZoneGroupName string) (result network.PrivateDNSZoneGroup, err error)
+}
+
+// CreateOrUpdate calls the MockPrivateDNSZoneGroupsClient's
+// MockCreateOrUpdate method.
+func (c *MockPrivateDNSZoneGroupsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, privateEndpointName string, privateDNSZoneGroupName string, parameters network.PrivateDNSZoneGroup) (result network.PrivateDNSZoneGroupsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, privateEndpointName, privateDNSZoneGroupName, parameters)
+}
+
+// Delete calls the MockPrivateDNSZoneGroupsClient's MockDelete method.
+func (c *MockPrivateDNSZoneGroupsClient) Delete(ctx context.Context, resourceGroupName string, privateEndpointName string, privateDNSZoneGroupName string) (result network.PrivateDNSZoneGroupsDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, privateEndpointName, privateDNSZoneGroupName)
+}
+
+// Get calls the MockPrivateDNSZoneGroupsClient's MockGet method.
+func (c *MockPrivateDNSZoneGroupsClient) Get(ctx context.Context, resourceGroupName string, privateEndpointName string, privateDNSZoneGroupName string) (result network.PrivateDNSZoneGroup, err error) {
+	return c.MockGet(ctx, resourceGroupName, privateEndpointName, privateDNSZoneGroupName)
+}
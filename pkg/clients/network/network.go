@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
@@ -76,12 +77,20 @@ func UpdateVirtualNetworkStatusFromAzure(v *v1alpha3.VirtualNetwork, az networkm
 func NewSubnetParameters(s *v1alpha3.Subnet) networkmgmt.Subnet {
 	return networkmgmt.Subnet{
 		SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
-			AddressPrefix:    azure.ToStringPtr(s.Spec.SubnetPropertiesFormat.AddressPrefix),
-			ServiceEndpoints: NewServiceEndpoints(s.Spec.SubnetPropertiesFormat.ServiceEndpoints),
+			AddressPrefix:        azure.ToStringPtr(s.Spec.SubnetPropertiesFormat.AddressPrefix),
+			ServiceEndpoints:     NewServiceEndpoints(s.Spec.SubnetPropertiesFormat.ServiceEndpoints),
+			NetworkSecurityGroup: newSecurityGroupRef(s.Spec.SubnetPropertiesFormat.NetworkSecurityGroupID),
 		},
 	}
 }
 
+func newSecurityGroupRef(id string) *networkmgmt.SecurityGroup {
+	if id == "" {
+		return nil
+	}
+	return &networkmgmt.SecurityGroup{ID: azure.ToStringPtr(id)}
+}
+
 // NewPublicIPAddressParameters returns an Azure PublicIPAddress object from a public ip address spec
 func NewPublicIPAddressParameters(s *v1alpha3.PublicIPAddress) networkmgmt.PublicIPAddress {
 	p := s.Spec.ForProvider
@@ -96,6 +105,7 @@ func NewPublicIPAddressParameters(s *v1alpha3.PublicIPAddress) networkmgmt.Publi
 			IPTags:                   newIPTags(p.IPTags),
 		},
 		Location: &p.Location,
+		Zones:    azure.ToStringArrayPtr(p.Zones),
 		Tags:     azure.ToStringPtrMap(p.Tags),
 	}
 }
@@ -161,7 +171,20 @@ func NewServiceEndpoints(e []v1alpha3.ServiceEndpointPropertiesFormat) *[]networ
 func SubnetNeedsUpdate(kube *v1alpha3.Subnet, az networkmgmt.Subnet) bool {
 	up := NewSubnetParameters(kube)
 
-	return !reflect.DeepEqual(up.SubnetPropertiesFormat.AddressPrefix, az.SubnetPropertiesFormat.AddressPrefix)
+	nsg := ""
+	if az.SubnetPropertiesFormat.NetworkSecurityGroup != nil {
+		nsg = azure.ToString(az.SubnetPropertiesFormat.NetworkSecurityGroup.ID)
+	}
+
+	switch {
+	case !reflect.DeepEqual(up.SubnetPropertiesFormat.AddressPrefix, az.SubnetPropertiesFormat.AddressPrefix):
+		return true
+	// Azure does not preserve the case of resource IDs.
+	case !strings.EqualFold(kube.Spec.SubnetPropertiesFormat.NetworkSecurityGroupID, nsg):
+		return true
+	}
+
+	return false
 }
 
 // UpdateSubnetStatusFromAzure updates the status related to the external
@@ -213,6 +236,7 @@ func LateInitializePublicIPAddress(p *v1alpha3.PublicIPAddressProperties, in *ne
 	}
 	p.TCPIdleTimeoutInMinutes = azure.LateInitializeInt32PtrFromInt32Ptr(p.TCPIdleTimeoutInMinutes, in.IdleTimeoutInMinutes)
 	p.IPTags = lateInitializeIPTags(p.IPTags, in.IPTags)
+	p.Zones = azure.LateInitializeStringValArrFromArrPtr(p.Zones, in.Zones)
 }
 
 func lateInitializeIPTags(t []v1alpha3.IPTag, from *[]networkmgmt.IPTag) []v1alpha3.IPTag {
@@ -330,3 +354,117 @@ func isSKUUpToDate(s *v1alpha3.SKU, in *networkmgmt.PublicIPAddressSku) bool {
 	}
 	return s.Name == string(in.Name)
 }
+
+// NewSecurityGroupParameters returns an Azure SecurityGroup object from a
+// security group spec. The returned object always contains the complete set
+// of desired security rules, so that creating or updating the security group
+// with it removes any other rules.
+func NewSecurityGroupParameters(s *v1alpha3.SecurityGroup) networkmgmt.SecurityGroup {
+	p := s.Spec.ForProvider
+	return networkmgmt.SecurityGroup{
+		Location: azure.ToStringPtr(p.Location),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+		SecurityGroupPropertiesFormat: &networkmgmt.SecurityGroupPropertiesFormat{
+			SecurityRules: newSecurityRules(p.SecurityRules),
+		},
+	}
+}
+
+func newSecurityRules(rules []v1alpha3.SecurityRule) *[]networkmgmt.SecurityRule {
+	result := make([]networkmgmt.SecurityRule, len(rules))
+	for i, r := range rules {
+		r := r
+		result[i] = networkmgmt.SecurityRule{
+			Name: azure.ToStringPtr(r.Name),
+			SecurityRulePropertiesFormat: &networkmgmt.SecurityRulePropertiesFormat{
+				Description:                r.Description,
+				Protocol:                   networkmgmt.SecurityRuleProtocol(r.Protocol),
+				SourcePortRange:            r.SourcePortRange,
+				SourcePortRanges:           azure.ToStringArrayPtr(r.SourcePortRanges),
+				DestinationPortRange:       r.DestinationPortRange,
+				DestinationPortRanges:      azure.ToStringArrayPtr(r.DestinationPortRanges),
+				SourceAddressPrefix:        r.SourceAddressPrefix,
+				SourceAddressPrefixes:      azure.ToStringArrayPtr(r.SourceAddressPrefixes),
+				DestinationAddressPrefix:   r.DestinationAddressPrefix,
+				DestinationAddressPrefixes: azure.ToStringArrayPtr(r.DestinationAddressPrefixes),
+				Access:                     networkmgmt.SecurityRuleAccess(r.Access),
+				Priority:                   &r.Priority,
+				Direction:                  networkmgmt.SecurityRuleDirection(r.Direction),
+			},
+		}
+	}
+	return &result
+}
+
+func generateSecurityRules(rules *[]networkmgmt.SecurityRule) []v1alpha3.SecurityRule {
+	if rules == nil {
+		return nil
+	}
+	result := make([]v1alpha3.SecurityRule, 0, len(*rules))
+	for _, r := range *rules {
+		rule := v1alpha3.SecurityRule{Name: azure.ToString(r.Name)}
+		if props := r.SecurityRulePropertiesFormat; props != nil {
+			rule.Description = props.Description
+			rule.Protocol = string(props.Protocol)
+			rule.SourcePortRange = props.SourcePortRange
+			rule.SourcePortRanges = azure.ToStringArray(props.SourcePortRanges)
+			rule.DestinationPortRange = props.DestinationPortRange
+			rule.DestinationPortRanges = azure.ToStringArray(props.DestinationPortRanges)
+			rule.SourceAddressPrefix = props.SourceAddressPrefix
+			rule.SourceAddressPrefixes = azure.ToStringArray(props.SourceAddressPrefixes)
+			rule.DestinationAddressPrefix = props.DestinationAddressPrefix
+			rule.DestinationAddressPrefixes = azure.ToStringArray(props.DestinationAddressPrefixes)
+			rule.Access = string(props.Access)
+			rule.Priority = to.Int32(props.Priority)
+			rule.Direction = string(props.Direction)
+		}
+		result = append(result, rule)
+	}
+	return result
+}
+
+// GenerateSecurityGroupObservation returns the observation object related to
+// the external Azure security group in the SecurityGroupStatus
+func GenerateSecurityGroupObservation(az networkmgmt.SecurityGroup) v1alpha3.SecurityGroupObservation {
+	o := v1alpha3.SecurityGroupObservation{
+		Etag: azure.ToString(az.Etag),
+		ID:   azure.ToString(az.ID),
+	}
+	if az.SecurityGroupPropertiesFormat == nil {
+		return o
+	}
+	o.State = azure.ToString(az.ProvisioningState)
+	o.ResourceGUID = azure.ToString(az.ResourceGUID)
+	if az.Subnets != nil {
+		for _, sn := range *az.Subnets {
+			o.Subnets = append(o.Subnets, azure.ToString(sn.ID))
+		}
+	}
+	return o
+}
+
+// LateInitializeSecurityGroup late-initializes a SecurityGroup resource. The
+// security rules are not late-initialized, since the desired rules are the
+// complete set of rules of the security group.
+func LateInitializeSecurityGroup(p *v1alpha3.SecurityGroupParameters, in networkmgmt.SecurityGroup) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+}
+
+// IsSecurityGroupUpToDate is used to report whether the supplied
+// network.SecurityGroup is in sync with the SecurityGroupParameters that the
+// user desires. Rules that were added, changed or removed in Azure make the
+// security group out of date.
+func IsSecurityGroupUpToDate(p v1alpha3.SecurityGroupParameters, in networkmgmt.SecurityGroup) bool {
+	if !cmp.Equal(p.Tags, azure.ToStringMap(in.Tags), cmpopts.EquateEmpty()) {
+		return false
+	}
+	var observed []v1alpha3.SecurityRule
+	if in.SecurityGroupPropertiesFormat != nil {
+		observed = generateSecurityRules(in.SecurityRules)
+	}
+	return cmp.Equal(p.SecurityRules, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b v1alpha3.SecurityRule) bool { return a.Name < b.Name }),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+	)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package network
 
 import (
+	"strings"
 	"testing"
 
 	networkmgmt "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
@@ -61,6 +62,9 @@ var (
 	ipTag2              = "SQL2"
 	tagKey              = "tagKey"
 	tagVal              = "tagValue"
+
+	nsgID    = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/networkSecurityGroups/cool-nsg"
+	ruleName = "allow-https"
 )
 
 func TestNewVirtualNetworkParameters(t *testing.T) {
@@ -394,6 +398,25 @@ func TestNewSubnetParameters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "SecurityGroup",
+			r: &v1alpha3.Subnet{
+				ObjectMeta: metav1.ObjectMeta{UID: uid},
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix:          addressPrefix,
+						NetworkSecurityGroupID: nsgID,
+					},
+				},
+			},
+			want: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix:        azure.ToStringPtr(addressPrefix),
+					ServiceEndpoints:     NewServiceEndpoints(nil),
+					NetworkSecurityGroup: &networkmgmt.SecurityGroup{ID: azure.ToStringPtr(nsgID)},
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -553,6 +576,58 @@ func TestSubnetNeedsUpdate(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "SecurityGroupAttached",
+			kube: &v1alpha3.Subnet{
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix:          addressPrefix,
+						NetworkSecurityGroupID: nsgID,
+					},
+				},
+			},
+			az: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix: &addressPrefix,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "SecurityGroupDetached",
+			kube: &v1alpha3.Subnet{
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix: addressPrefix,
+					},
+				},
+			},
+			az: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix:        &addressPrefix,
+					NetworkSecurityGroup: &networkmgmt.SecurityGroup{ID: azure.ToStringPtr(nsgID)},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "SecurityGroupUpToDate",
+			kube: &v1alpha3.Subnet{
+				Spec: v1alpha3.SubnetSpec{
+					SubnetPropertiesFormat: v1alpha3.SubnetPropertiesFormat{
+						AddressPrefix:          addressPrefix,
+						NetworkSecurityGroupID: nsgID,
+					},
+				},
+			},
+			az: networkmgmt.Subnet{
+				SubnetPropertiesFormat: &networkmgmt.SubnetPropertiesFormat{
+					AddressPrefix:        &addressPrefix,
+					NetworkSecurityGroup: &networkmgmt.SecurityGroup{ID: azure.ToStringPtr(strings.ToLower(nsgID))},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -1018,6 +1093,12 @@ func withSKU(name string) publicIPAddressOption {
 	}
 }
 
+func withZones(zones ...string) publicIPAddressOption {
+	return func(in *networkmgmt.PublicIPAddress) {
+		in.Zones = &zones
+	}
+}
+
 func TestLateInitializePublicIPAddress(t *testing.T) {
 	tagVal2 := "tagValue2"
 	type args struct {
@@ -1042,7 +1123,8 @@ func TestLateInitializePublicIPAddress(t *testing.T) {
 					withIdleTimeout(timeout),
 					withIPPrefix(prefixID),
 					withDNSSettings(dnsLabel, fqdn, reverseFQDN),
-					withSKU(skuName)),
+					withSKU(skuName),
+					withZones("1", "2", "3")),
 			},
 			want: v1alpha3.PublicIPAddressProperties{
 				PublicIPPrefixID: &prefixID,
@@ -1063,6 +1145,7 @@ func TestLateInitializePublicIPAddress(t *testing.T) {
 				SKU: &v1alpha3.SKU{
 					Name: skuName,
 				},
+				Zones: []string{"1", "2", "3"},
 			},
 		},
 		"LateInitializeNonEmptySpec": {
@@ -1133,3 +1216,202 @@ func TestLateInitializePublicIPAddress(t *testing.T) {
 		})
 	}
 }
+
+func securityRule() v1alpha3.SecurityRule {
+	return v1alpha3.SecurityRule{
+		Name:                     ruleName,
+		Description:              azure.ToStringPtr("HTTPS from anywhere"),
+		Protocol:                 string(networkmgmt.SecurityRuleProtocolTCP),
+		SourcePortRange:          azure.ToStringPtr("*"),
+		DestinationPortRanges:    []string{"443", "8443"},
+		SourceAddressPrefixes:    []string{"10.0.0.0/8", "192.168.0.0/16"},
+		DestinationAddressPrefix: azure.ToStringPtr("VirtualNetwork"),
+		Access:                   string(networkmgmt.SecurityRuleAccessAllow),
+		Priority:                 100,
+		Direction:                string(networkmgmt.SecurityRuleDirectionInbound),
+	}
+}
+
+func azureSecurityRule() networkmgmt.SecurityRule {
+	return networkmgmt.SecurityRule{
+		Name: azure.ToStringPtr(ruleName),
+		SecurityRulePropertiesFormat: &networkmgmt.SecurityRulePropertiesFormat{
+			Description:              azure.ToStringPtr("HTTPS from anywhere"),
+			Protocol:                 networkmgmt.SecurityRuleProtocolTCP,
+			SourcePortRange:          azure.ToStringPtr("*"),
+			DestinationPortRanges:    &[]string{"443", "8443"},
+			SourceAddressPrefixes:    &[]string{"10.0.0.0/8", "192.168.0.0/16"},
+			DestinationAddressPrefix: azure.ToStringPtr("VirtualNetwork"),
+			Access:                   networkmgmt.SecurityRuleAccessAllow,
+			Priority:                 to.Int32Ptr(100),
+			Direction:                networkmgmt.SecurityRuleDirectionInbound,
+		},
+	}
+}
+
+func TestNewSecurityGroupParameters(t *testing.T) {
+	cases := []struct {
+		name string
+		r    *v1alpha3.SecurityGroup
+		want networkmgmt.SecurityGroup
+	}{
+		{
+			name: "NoRules",
+			r: &v1alpha3.SecurityGroup{
+				Spec: v1alpha3.SecurityGroupSpec{
+					ForProvider: v1alpha3.SecurityGroupParameters{Location: location},
+				},
+			},
+			want: networkmgmt.SecurityGroup{
+				Location: azure.ToStringPtr(location),
+				SecurityGroupPropertiesFormat: &networkmgmt.SecurityGroupPropertiesFormat{
+					SecurityRules: &[]networkmgmt.SecurityRule{},
+				},
+			},
+		},
+		{
+			name: "Rules",
+			r: &v1alpha3.SecurityGroup{
+				Spec: v1alpha3.SecurityGroupSpec{
+					ForProvider: v1alpha3.SecurityGroupParameters{
+						Location:      location,
+						SecurityRules: []v1alpha3.SecurityRule{securityRule()},
+						Tags:          tags,
+					},
+				},
+			},
+			want: networkmgmt.SecurityGroup{
+				Location: azure.ToStringPtr(location),
+				Tags:     azure.ToStringPtrMap(tags),
+				SecurityGroupPropertiesFormat: &networkmgmt.SecurityGroupPropertiesFormat{
+					SecurityRules: &[]networkmgmt.SecurityRule{azureSecurityRule()},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewSecurityGroupParameters(tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewSecurityGroupParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateSecurityGroupObservation(t *testing.T) {
+	cases := []struct {
+		name string
+		az   networkmgmt.SecurityGroup
+		want v1alpha3.SecurityGroupObservation
+	}{
+		{
+			name: "NoProperties",
+			az:   networkmgmt.SecurityGroup{ID: azure.ToStringPtr(id), Etag: azure.ToStringPtr(etag)},
+			want: v1alpha3.SecurityGroupObservation{ID: id, Etag: etag},
+		},
+		{
+			name: "Successful",
+			az: networkmgmt.SecurityGroup{
+				ID:   azure.ToStringPtr(id),
+				Etag: azure.ToStringPtr(etag),
+				SecurityGroupPropertiesFormat: &networkmgmt.SecurityGroupPropertiesFormat{
+					ProvisioningState: azure.ToStringPtr("Succeeded"),
+					ResourceGUID:      azure.ToStringPtr(string(uid)),
+					Subnets:           &[]networkmgmt.Subnet{{ID: azure.ToStringPtr("a-subnet-id")}},
+				},
+			},
+			want: v1alpha3.SecurityGroupObservation{
+				ID:           id,
+				Etag:         etag,
+				State:        "Succeeded",
+				ResourceGUID: string(uid),
+				Subnets:      []string{"a-subnet-id"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := GenerateSecurityGroupObservation(tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateSecurityGroupObservation(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSecurityGroupUpToDate(t *testing.T) {
+	withRules := func(rules ...networkmgmt.SecurityRule) networkmgmt.SecurityGroup {
+		return networkmgmt.SecurityGroup{
+			SecurityGroupPropertiesFormat: &networkmgmt.SecurityGroupPropertiesFormat{SecurityRules: &rules},
+		}
+	}
+	other := azureSecurityRule()
+	other.Name = azure.ToStringPtr("deny-all")
+	other.Priority = to.Int32Ptr(4096)
+	drifted := azureSecurityRule()
+	drifted.Access = networkmgmt.SecurityRuleAccessDeny
+	reordered := azureSecurityRule()
+	reordered.DestinationPortRanges = &[]string{"8443", "443"}
+
+	cases := []struct {
+		name string
+		p    v1alpha3.SecurityGroupParameters
+		az   networkmgmt.SecurityGroup
+		want bool
+	}{
+		{
+			name: "UpToDate",
+			p:    v1alpha3.SecurityGroupParameters{SecurityRules: []v1alpha3.SecurityRule{securityRule()}},
+			az:   withRules(azureSecurityRule()),
+			want: true,
+		},
+		{
+			name: "NoRules",
+			p:    v1alpha3.SecurityGroupParameters{},
+			az:   withRules(),
+			want: true,
+		},
+		{
+			name: "ReorderedPortRanges",
+			p:    v1alpha3.SecurityGroupParameters{SecurityRules: []v1alpha3.SecurityRule{securityRule()}},
+			az:   withRules(reordered),
+			want: true,
+		},
+		{
+			name: "RuleMissing",
+			p:    v1alpha3.SecurityGroupParameters{SecurityRules: []v1alpha3.SecurityRule{securityRule()}},
+			az:   withRules(),
+			want: false,
+		},
+		{
+			name: "RuleDrifted",
+			p:    v1alpha3.SecurityGroupParameters{SecurityRules: []v1alpha3.SecurityRule{securityRule()}},
+			az:   withRules(drifted),
+			want: false,
+		},
+		{
+			name: "UnknownRule",
+			p:    v1alpha3.SecurityGroupParameters{SecurityRules: []v1alpha3.SecurityRule{securityRule()}},
+			az:   withRules(other, azureSecurityRule()),
+			want: false,
+		},
+		{
+			name: "TagsChanged",
+			p:    v1alpha3.SecurityGroupParameters{Tags: tags},
+			az:   withRules(),
+			want: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := IsSecurityGroupUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsSecurityGroupUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
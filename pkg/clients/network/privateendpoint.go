@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"sort"
+	"strings"
+
+	privatenetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// PrivateDNSZoneGroupName is the name of the private DNS zone group that
+// registers a Private Endpoint in its private DNS zones. A Private Endpoint
+// has at most one.
+const PrivateDNSZoneGroupName = "default"
+
+// NewPrivateEndpointParameters returns an Azure PrivateEndpoint object from a
+// private endpoint spec. The connection to the connected resource is named
+// after the Private Endpoint.
+func NewPrivateEndpointParameters(name string, p v1alpha3.PrivateEndpointParameters) privatenetwork.PrivateEndpoint {
+	conns := &[]privatenetwork.PrivateLinkServiceConnection{{
+		Name: azure.ToStringPtr(name),
+		PrivateLinkServiceConnectionProperties: &privatenetwork.PrivateLinkServiceConnectionProperties{
+			PrivateLinkServiceID: azure.ToStringPtr(p.PrivateLinkServiceID),
+			GroupIds:             &p.GroupIDs,
+			RequestMessage:       p.RequestMessage,
+		},
+	}}
+	props := &privatenetwork.PrivateEndpointProperties{
+		Subnet: &privatenetwork.Subnet{ID: azure.ToStringPtr(p.SubnetID)},
+	}
+	if p.ManualApproval {
+		props.ManualPrivateLinkServiceConnections = conns
+	} else {
+		props.PrivateLinkServiceConnections = conns
+	}
+	return privatenetwork.PrivateEndpoint{
+		Location:                  azure.ToStringPtr(p.Location),
+		Tags:                      azure.ToStringPtrMap(p.Tags),
+		PrivateEndpointProperties: props,
+	}
+}
+
+// GeneratePrivateEndpointObservation produces a PrivateEndpointObservation
+// object from the supplied network.PrivateEndpoint.
+func GeneratePrivateEndpointObservation(az privatenetwork.PrivateEndpoint) v1alpha3.PrivateEndpointObservation {
+	o := v1alpha3.PrivateEndpointObservation{
+		ID: azure.ToString(az.ID),
+	}
+	if az.PrivateEndpointProperties == nil {
+		return o
+	}
+	o.State = string(az.ProvisioningState)
+	if s := privateLinkServiceConnectionState(az.PrivateEndpointProperties); s != nil {
+		o.ConnectionStatus = azure.ToString(s.Status)
+		o.ConnectionDescription = azure.ToString(s.Description)
+	}
+	if az.NetworkInterfaces != nil {
+		for _, nic := range *az.NetworkInterfaces {
+			o.NetworkInterfaceIDs = append(o.NetworkInterfaceIDs, azure.ToString(nic.ID))
+		}
+	}
+	if az.CustomDNSConfigs != nil {
+		for _, c := range *az.CustomDNSConfigs {
+			dc := v1alpha3.PrivateEndpointDNSConfig{FQDN: azure.ToString(c.Fqdn)}
+			if c.IPAddresses != nil {
+				dc.IPAddresses = *c.IPAddresses
+			}
+			o.CustomDNSConfigs = append(o.CustomDNSConfigs, dc)
+		}
+	}
+	return o
+}
+
+// privateLinkServiceConnectionState returns the state of the connection of a
+// Private Endpoint, whether or not it required manual approval.
+func privateLinkServiceConnectionState(p *privatenetwork.PrivateEndpointProperties) *privatenetwork.PrivateLinkServiceConnectionState {
+	for _, conns := range []*[]privatenetwork.PrivateLinkServiceConnection{p.PrivateLinkServiceConnections, p.ManualPrivateLinkServiceConnections} {
+		if conns == nil {
+			continue
+		}
+		for _, c := range *conns {
+			if c.PrivateLinkServiceConnectionProperties != nil && c.PrivateLinkServiceConnectionState != nil {
+				return c.PrivateLinkServiceConnectionState
+			}
+		}
+	}
+	return nil
+}
+
+// LateInitializePrivateEndpoint late-initializes a PrivateEndpoint resource.
+func LateInitializePrivateEndpoint(p *v1alpha3.PrivateEndpointParameters, in privatenetwork.PrivateEndpoint) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, in.Tags)
+}
+
+// IsPrivateEndpointUpToDate is used to report whether the supplied
+// network.PrivateEndpoint is in sync with the PrivateEndpointParameters that
+// the user desires. All other properties of a Private Endpoint are immutable.
+func IsPrivateEndpointUpToDate(p v1alpha3.PrivateEndpointParameters, in privatenetwork.PrivateEndpoint) bool {
+	return cmp.Equal(p.Tags, azure.ToStringMap(in.Tags), cmpopts.EquateEmpty())
+}
+
+// NewPrivateDNSZoneGroup returns an Azure PrivateDNSZoneGroup object that
+// registers a Private Endpoint in the supplied private DNS zones. Each zone
+// configuration is named after its zone, with dots replaced by dashes.
+func NewPrivateDNSZoneGroup(zoneIDs []string) privatenetwork.PrivateDNSZoneGroup {
+	configs := make([]privatenetwork.PrivateDNSZoneConfig, len(zoneIDs))
+	for i, id := range zoneIDs {
+		configs[i] = privatenetwork.PrivateDNSZoneConfig{
+			Name: azure.ToStringPtr(strings.ReplaceAll(id[strings.LastIndex(id, "/")+1:], ".", "-")),
+			PrivateDNSZonePropertiesFormat: &privatenetwork.PrivateDNSZonePropertiesFormat{
+				PrivateDNSZoneID: azure.ToStringPtr(id),
+			},
+		}
+	}
+	return privatenetwork.PrivateDNSZoneGroup{
+		PrivateDNSZoneGroupPropertiesFormat: &privatenetwork.PrivateDNSZoneGroupPropertiesFormat{
+			PrivateDNSZoneConfigs: &configs,
+		},
+	}
+}
+
+// IsPrivateDNSZoneGroupUpToDate is used to report whether the supplied
+// network.PrivateDNSZoneGroup registers a Private Endpoint in exactly the
+// desired private DNS zones. Azure does not preserve the case of the zone
+// IDs, so they are compared case-insensitively.
+func IsPrivateDNSZoneGroupUpToDate(zoneIDs []string, in privatenetwork.PrivateDNSZoneGroup) bool {
+	var observed []string
+	if in.PrivateDNSZoneGroupPropertiesFormat != nil && in.PrivateDNSZoneConfigs != nil {
+		for _, c := range *in.PrivateDNSZoneConfigs {
+			if c.PrivateDNSZonePropertiesFormat != nil {
+				observed = append(observed, azure.ToString(c.PrivateDNSZoneID))
+			}
+		}
+	}
+	return cmp.Equal(normalizeIDs(zoneIDs), normalizeIDs(observed), cmpopts.EquateEmpty())
+}
+
+func normalizeIDs(ids []string) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = strings.ToLower(id)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	privatenetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const (
+	peSubnetID  = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/endpoints"
+	peAccountID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/coolaccount"
+	peZoneID    = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/privateDnsZones/privatelink.blob.core.windows.net"
+)
+
+func TestNewPrivateEndpointParameters(t *testing.T) {
+	p := v1alpha3.PrivateEndpointParameters{
+		Location:             location,
+		SubnetID:             peSubnetID,
+		PrivateLinkServiceID: peAccountID,
+		GroupIDs:             []string{"blob"},
+		Tags:                 map[string]string{"cool": "tag"},
+	}
+	conns := &[]privatenetwork.PrivateLinkServiceConnection{{
+		Name: azure.ToStringPtr("endpoint"),
+		PrivateLinkServiceConnectionProperties: &privatenetwork.PrivateLinkServiceConnectionProperties{
+			PrivateLinkServiceID: azure.ToStringPtr(peAccountID),
+			GroupIds:             &[]string{"blob"},
+		},
+	}}
+
+	cases := []struct {
+		name   string
+		manual bool
+		want   privatenetwork.PrivateEndpoint
+	}{
+		{
+			name: "Automatic",
+			want: privatenetwork.PrivateEndpoint{
+				Location: azure.ToStringPtr(location),
+				Tags:     map[string]*string{"cool": azure.ToStringPtr("tag")},
+				PrivateEndpointProperties: &privatenetwork.PrivateEndpointProperties{
+					Subnet:                        &privatenetwork.Subnet{ID: azure.ToStringPtr(peSubnetID)},
+					PrivateLinkServiceConnections: conns,
+				},
+			},
+		},
+		{
+			name:   "ManualApproval",
+			manual: true,
+			want: privatenetwork.PrivateEndpoint{
+				Location: azure.ToStringPtr(location),
+				Tags:     map[string]*string{"cool": azure.ToStringPtr("tag")},
+				PrivateEndpointProperties: &privatenetwork.PrivateEndpointProperties{
+					Subnet:                              &privatenetwork.Subnet{ID: azure.ToStringPtr(peSubnetID)},
+					ManualPrivateLinkServiceConnections: conns,
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := p
+			p.ManualApproval = tc.manual
+			got := NewPrivateEndpointParameters("endpoint", p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewPrivateEndpointParameters(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePrivateEndpointObservation(t *testing.T) {
+	az := privatenetwork.PrivateEndpoint{
+		ID: azure.ToStringPtr("id"),
+		PrivateEndpointProperties: &privatenetwork.PrivateEndpointProperties{
+			ProvisioningState: privatenetwork.ProvisioningStateSucceeded,
+			NetworkInterfaces: &[]privatenetwork.Interface{{ID: azure.ToStringPtr("nic")}},
+			ManualPrivateLinkServiceConnections: &[]privatenetwork.PrivateLinkServiceConnection{{
+				PrivateLinkServiceConnectionProperties: &privatenetwork.PrivateLinkServiceConnectionProperties{
+					PrivateLinkServiceConnectionState: &privatenetwork.PrivateLinkServiceConnectionState{
+						Status:      azure.ToStringPtr("Pending"),
+						Description: azure.ToStringPtr("please"),
+					},
+				},
+			}},
+			CustomDNSConfigs: &[]privatenetwork.CustomDNSConfigPropertiesFormat{{
+				Fqdn:        azure.ToStringPtr("coolaccount.blob.core.windows.net"),
+				IPAddresses: &[]string{"10.0.0.4"},
+			}},
+		},
+	}
+	want := v1alpha3.PrivateEndpointObservation{
+		ID:                    "id",
+		State:                 "Succeeded",
+		ConnectionStatus:      "Pending",
+		ConnectionDescription: "please",
+		NetworkInterfaceIDs:   []string{"nic"},
+		CustomDNSConfigs: []v1alpha3.PrivateEndpointDNSConfig{{
+			FQDN:        "coolaccount.blob.core.windows.net",
+			IPAddresses: []string{"10.0.0.4"},
+		}},
+	}
+	if diff := cmp.Diff(want, GeneratePrivateEndpointObservation(az)); diff != "" {
+		t.Errorf("GeneratePrivateEndpointObservation(...): -want, +got\n%s", diff)
+	}
+}
+
+func TestIsPrivateDNSZoneGroupUpToDate(t *testing.T) {
+	cases := []struct {
+		name    string
+		zoneIDs []string
+		in      privatenetwork.PrivateDNSZoneGroup
+		want    bool
+	}{
+		{
+			name:    "UpToDate",
+			zoneIDs: []string{peZoneID},
+			in:      NewPrivateDNSZoneGroup([]string{peZoneID}),
+			want:    true,
+		},
+		{
+			name:    "DifferentCase",
+			zoneIDs: []string{peZoneID},
+			in:      NewPrivateDNSZoneGroup([]string{"/subscriptions/sub/resourcegroups/rg/providers/Microsoft.Network/privateDnsZones/privatelink.blob.core.windows.net"}),
+			want:    true,
+		},
+		{
+			name:    "ZoneRemoved",
+			zoneIDs: nil,
+			in:      NewPrivateDNSZoneGroup([]string{peZoneID}),
+			want:    false,
+		},
+		{
+			name:    "ZoneAdded",
+			zoneIDs: []string{peZoneID},
+			in:      privatenetwork.PrivateDNSZoneGroup{},
+			want:    false,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := IsPrivateDNSZoneGroupUpToDate(tc.zoneIDs, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsPrivateDNSZoneGroupUpToDate(...): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -34,6 +34,8 @@ type MockClient struct {
 	MockGet      func(ctx context.Context, resourceGroupName string, name string) (result redis.ResourceType, err error)
 	MockListKeys func(ctx context.Context, resourceGroupName string, name string) (result redis.AccessKeys, err error)
 	MockUpdate   func(ctx context.Context, resourceGroupName string, name string, parameters redis.UpdateParameters) (result redis.ResourceType, err error)
+
+	MockRegenerateKey func(ctx context.Context, resourceGroupName string, name string, parameters redis.RegenerateKeyParameters) (result redis.AccessKeys, err error)
 }
 
 // Create calls the MockClient's MockCreate method.
@@ -60,3 +62,8 @@ func (c *MockClient) ListKeys(ctx context.Context, resourceGroupName string, nam
 func (c *MockClient) Update(ctx context.Context, resourceGroupName string, name string, parameters redis.UpdateParameters) (result redis.ResourceType, err error) {
 	return c.MockUpdate(ctx, resourceGroupName, name, parameters)
 }
+
+// RegenerateKey calls the MockClient's MockRegenerateKey method.
+func (c *MockClient) RegenerateKey(ctx context.Context, resourceGroupName string, name string, parameters redis.RegenerateKeyParameters) (result redis.AccessKeys, err error) {
+	return c.MockRegenerateKey(ctx, resourceGroupName, name, parameters)
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Azure Resource Manager response headers that identify a request. Azure
+// support asks for them when investigating a failed operation.
+const (
+	HeaderCorrelationRequestID = "x-ms-correlation-request-id"
+	HeaderRequestID            = "x-ms-request-id"
+)
+
+type requestIDError struct {
+	error
+	correlationRequestID string
+	requestID            string
+}
+
+func (e *requestIDError) Error() string {
+	return fmt.Sprintf("%s (correlation ID: %s, request ID: %s)", e.error.Error(), e.correlationRequestID, e.requestID)
+}
+
+func (e *requestIDError) Unwrap() error {
+	return e.error
+}
+
+// WithRequestIDs returns the supplied error annotated with the correlation and
+// request IDs of the failed Azure API call it stems from. Errors that do not
+// stem from an Azure API call, or that were already annotated, are returned
+// unchanged.
+func WithRequestIDs(err error) error {
+	if err == nil {
+		return nil
+	}
+	var ae *requestIDError
+	if errors.As(err, &ae) {
+		return err
+	}
+	de := autorest.DetailedError{}
+	if !errors.As(err, &de) {
+		return err
+	}
+	return withResponseRequestIDs(err, de.Response)
+}
+
+// withResponseRequestIDs returns the supplied error annotated with the
+// correlation and request IDs of the supplied response, if it has any. Errors
+// of long-running operations do not carry the response they stem from, so
+// the IDs of the response that reported them must be supplied explicitly.
+func withResponseRequestIDs(err error, r *http.Response) error {
+	cid, rid := requestIDs(r)
+	if cid == "" && rid == "" {
+		return err
+	}
+	return &requestIDError{error: err, correlationRequestID: cid, requestID: rid}
+}
+
+// requestIDs returns the correlation and request IDs of the supplied Azure
+// API response, if any.
+func requestIDs(r *http.Response) (correlationRequestID, requestID string) {
+	if r == nil {
+		return "", ""
+	}
+	return r.Header.Get(HeaderCorrelationRequestID), r.Header.Get(HeaderRequestID)
+}
+
+// NewRequestIDConnecter returns a managed.ExternalConnecter whose external
+// clients annotate the errors they return with the correlation and request IDs
+// of the failed Azure API call, if any. These errors surface in the
+// ReconcileError condition of the managed resource, where users can find the
+// IDs of the most recent failed call when opening an Azure support case.
+func NewRequestIDConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &requestIDConnecter{ExternalConnecter: c}
+}
+
+type requestIDConnecter struct {
+	managed.ExternalConnecter
+}
+
+func (c *requestIDConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, WithRequestIDs(err)
+	}
+	return &requestIDExternal{ExternalClient: e}, nil
+}
+
+type requestIDExternal struct {
+	managed.ExternalClient
+}
+
+func (e *requestIDExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	return o, WithRequestIDs(err)
+}
+
+func (e *requestIDExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.ExternalClient.Create(ctx, mg)
+	return c, WithRequestIDs(err)
+}
+
+func (e *requestIDExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.ExternalClient.Update(ctx, mg)
+	return u, WithRequestIDs(err)
+}
+
+func (e *requestIDExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	return WithRequestIDs(e.ExternalClient.Delete(ctx, mg))
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+func TestWithRequestIDs(t *testing.T) {
+	errBoom := errors.New("boom")
+	detailed := autorest.DetailedError{
+		Original: errBoom,
+		Response: &http.Response{
+			StatusCode: http.StatusConflict,
+			Header: http.Header{
+				"X-Ms-Correlation-Request-Id": []string{"cool-correlation"},
+				"X-Ms-Request-Id":             []string{"cool-request"},
+			},
+		},
+	}
+	suffix := " (correlation ID: cool-correlation, request ID: cool-request)"
+
+	cases := map[string]struct {
+		err  error
+		want string
+	}{
+		"NoError": {},
+		"NotAnAzureError": {
+			err:  errBoom,
+			want: "boom",
+		},
+		"NoResponse": {
+			err:  autorest.DetailedError{Original: errBoom},
+			want: autorest.DetailedError{Original: errBoom}.Error(),
+		},
+		"NoIDs": {
+			err:  autorest.DetailedError{Original: errBoom, Response: &http.Response{Header: http.Header{}}},
+			want: autorest.DetailedError{Original: errBoom}.Error(),
+		},
+		"AzureError": {
+			err:  detailed,
+			want: detailed.Error() + suffix,
+		},
+		"WrappedAzureError": {
+			err:  errors.Wrap(detailed, "cannot do the thing"),
+			want: "cannot do the thing: " + detailed.Error() + suffix,
+		},
+		"AlreadyAnnotated": {
+			err:  errors.Wrap(WithRequestIDs(detailed), "cannot do the thing"),
+			want: "cannot do the thing: " + detailed.Error() + suffix,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := WithRequestIDs(tc.err)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("WithRequestIDs(...): -want, +got:\n%s", diff)
+			}
+			if !errors.Is(err, errBoom) && tc.err != nil {
+				t.Errorf("WithRequestIDs(...): want error that wraps %v", errBoom)
+			}
+		})
+	}
+}
+
+type fakeExternal struct {
+	err error
+}
+
+func (e *fakeExternal) Observe(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+	return managed.ExternalObservation{}, e.err
+}
+
+func (e *fakeExternal) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, e.err
+}
+
+func (e *fakeExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, e.err
+}
+
+func (e *fakeExternal) Delete(_ context.Context, _ resource.Managed) error {
+	return e.err
+}
+
+func TestRequestIDConnecter(t *testing.T) {
+	detailed := autorest.DetailedError{
+		Original: errors.New("boom"),
+		Response: &http.Response{Header: http.Header{"X-Ms-Request-Id": []string{"cool-request"}}},
+	}
+	want := detailed.Error() + " (correlation ID: , request ID: cool-request)"
+
+	c := NewRequestIDConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &fakeExternal{err: detailed}, nil
+	}))
+	e, err := c.Connect(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Connect(...): %v", err)
+	}
+
+	_, err = e.Observe(context.Background(), nil)
+	if diff := cmp.Diff(want, err.Error()); diff != "" {
+		t.Errorf("Observe(...): -want, +got:\n%s", diff)
+	}
+	_, err = e.Create(context.Background(), nil)
+	if diff := cmp.Diff(want, err.Error()); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	_, err = e.Update(context.Background(), nil)
+	if diff := cmp.Diff(want, err.Error()); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+	err = e.Delete(context.Background(), nil)
+	if diff := cmp.Diff(want, err.Error()); diff != "" {
+		t.Errorf("Delete(...): -want, +got:\n%s", diff)
+	}
+}
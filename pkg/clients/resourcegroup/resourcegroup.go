@@ -21,7 +21,6 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources/resourcesapi"
-	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/pkg/errors"
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -36,20 +35,13 @@ type GroupsClient resourcesapi.GroupsClientAPI
 // NewClient returns a new Azure Resource Groups client. Credentials must be
 // passed as JSON encoded data.
 func NewClient(credentials []byte) (GroupsClient, error) {
-	c := azure.Credentials{}
+	c := map[string]string{}
 	if err := json.Unmarshal(credentials, &c); err != nil {
 		return nil, errors.Wrap(err, "cannot unmarshal Azure client secret data")
 	}
-	client := resources.NewGroupsClient(c.SubscriptionID)
+	client := resources.NewGroupsClient(c[azure.CredentialsKeySubscriptionID])
 
-	cfg := auth.ClientCredentialsConfig{
-		ClientID:     c.ClientID,
-		ClientSecret: c.ClientSecret,
-		TenantID:     c.TenantID,
-		AADEndpoint:  c.ActiveDirectoryEndpointURL,
-		Resource:     c.ResourceManagerEndpointURL,
-	}
-	a, err := cfg.Authorizer()
+	a, err := azure.NewAuthorizer(c)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot create Azure authorizer from credentials config")
 	}
@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+// ReasonRotatedCredentials is the reason of the events emitted when the
+// credentials of a resource are rotated.
+const ReasonRotatedCredentials event.Reason = "RotatedCredentials"
+
+// AnnotationKeyRotateCredentials requests the rotation of the credentials of
+// a resource that were last rotated before the RFC3339 time it is set to,
+// e.g. 2022-06-01T00:00:00Z. Setting it to the current time thus requests a
+// single rotation, regardless of any rotation policy.
+const AnnotationKeyRotateCredentials = "azure.crossplane.io/rotate-credentials"
+
+// RotationDue returns true if the credentials of a resource created at the
+// supplied time are due for rotation under the supplied policy, given the
+// supplied rotation status. Credentials are never due if there is no policy.
+func RotationDue(p *v1alpha3.CredentialRotationPolicy, s v1alpha3.CredentialRotationStatus, created metav1.Time) bool {
+	if p == nil || p.Period.Duration <= 0 {
+		return false
+	}
+	last := created
+	if s.LastRotationTime != nil {
+		last = *s.LastRotationTime
+	}
+	return time.Since(last.Time) >= p.Period.Duration
+}
+
+// RotationRequested returns true if the credentials of the supplied resource
+// were last rotated, or created if they were never rotated, before the time
+// requested by its AnnotationKeyRotateCredentials annotation. Times that are
+// not valid RFC3339 times, or that lie in the future, are ignored.
+func RotationRequested(o metav1.Object, s v1alpha3.CredentialRotationStatus) bool {
+	v, ok := o.GetAnnotations()[AnnotationKeyRotateCredentials]
+	if !ok {
+		return false
+	}
+	req, err := time.Parse(time.RFC3339, v)
+	if err != nil || req.After(time.Now()) {
+		return false
+	}
+	last := o.GetCreationTimestamp()
+	if s.LastRotationTime != nil {
+		last = *s.LastRotationTime
+	}
+	return last.Time.Before(req)
+}
+
+// StandbyKey returns the key of a resource with a primary and a secondary key
+// that is not published in its connection secret, and can therefore be
+// regenerated without disrupting clients that use the published one.
+func StandbyKey(s v1alpha3.CredentialRotationStatus) string {
+	if s.ActiveKey == v1alpha3.CredentialKeySecondary {
+		return v1alpha3.CredentialKeyPrimary
+	}
+	return v1alpha3.CredentialKeySecondary
+}
+
+// SetRotated records in the supplied status that credentials were rotated,
+// and that the supplied key is the one now published. The key is empty for
+// resources that have a single credential, e.g. a password.
+func SetRotated(s *v1alpha3.CredentialRotationStatus, active string) {
+	t := metav1.Now()
+	s.LastRotationTime = &t
+	s.ActiveKey = active
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+func TestRotationDue(t *testing.T) {
+	day := &v1alpha3.CredentialRotationPolicy{Period: metav1.Duration{Duration: 24 * time.Hour}}
+	ago := func(d time.Duration) metav1.Time { return metav1.NewTime(time.Now().Add(-d)) }
+	rotated := func(d time.Duration) v1alpha3.CredentialRotationStatus {
+		t := ago(d)
+		return v1alpha3.CredentialRotationStatus{LastRotationTime: &t}
+	}
+
+	cases := map[string]struct {
+		p       *v1alpha3.CredentialRotationPolicy
+		s       v1alpha3.CredentialRotationStatus
+		created metav1.Time
+		want    bool
+	}{
+		"NoPolicy": {
+			created: ago(48 * time.Hour),
+			want:    false,
+		},
+		"ZeroPeriod": {
+			p:       &v1alpha3.CredentialRotationPolicy{},
+			created: ago(48 * time.Hour),
+			want:    false,
+		},
+		"NeverRotatedRecentlyCreated": {
+			p:       day,
+			created: ago(time.Hour),
+			want:    false,
+		},
+		"NeverRotatedCreatedLongAgo": {
+			p:       day,
+			created: ago(48 * time.Hour),
+			want:    true,
+		},
+		"RecentlyRotated": {
+			p:       day,
+			s:       rotated(time.Hour),
+			created: ago(48 * time.Hour),
+			want:    false,
+		},
+		"RotatedLongAgo": {
+			p:       day,
+			s:       rotated(25 * time.Hour),
+			created: ago(48 * time.Hour),
+			want:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := RotationDue(tc.p, tc.s, tc.created)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("RotationDue(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRotationRequested(t *testing.T) {
+	ago := func(d time.Duration) metav1.Time { return metav1.NewTime(time.Now().Add(-d)) }
+	requested := func(d time.Duration) map[string]string {
+		return map[string]string{AnnotationKeyRotateCredentials: time.Now().Add(-d).Format(time.RFC3339)}
+	}
+	rotated := func(d time.Duration) v1alpha3.CredentialRotationStatus {
+		t := ago(d)
+		return v1alpha3.CredentialRotationStatus{LastRotationTime: &t}
+	}
+
+	cases := map[string]struct {
+		annotations map[string]string
+		s           v1alpha3.CredentialRotationStatus
+		want        bool
+	}{
+		"NotRequested": {
+			want: false,
+		},
+		"InvalidTime": {
+			annotations: map[string]string{AnnotationKeyRotateCredentials: "now"},
+			want:        false,
+		},
+		"FutureTime": {
+			annotations: requested(-time.Hour),
+			want:        false,
+		},
+		"NeverRotated": {
+			annotations: requested(time.Hour),
+			want:        true,
+		},
+		"RotatedBeforeRequest": {
+			annotations: requested(time.Hour),
+			s:           rotated(2 * time.Hour),
+			want:        true,
+		},
+		"RotatedAfterRequest": {
+			annotations: requested(2 * time.Hour),
+			s:           rotated(time.Hour),
+			want:        false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &metav1.ObjectMeta{Annotations: tc.annotations, CreationTimestamp: ago(48 * time.Hour)}
+			got := RotationRequested(o, tc.s)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("RotationRequested(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStandbyKey(t *testing.T) {
+	cases := map[string]struct {
+		active string
+		want   string
+	}{
+		"NeverRotated": {
+			want: v1alpha3.CredentialKeySecondary,
+		},
+		"PrimaryActive": {
+			active: v1alpha3.CredentialKeyPrimary,
+			want:   v1alpha3.CredentialKeySecondary,
+		},
+		"SecondaryActive": {
+			active: v1alpha3.CredentialKeySecondary,
+			want:   v1alpha3.CredentialKeyPrimary,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := StandbyKey(v1alpha3.CredentialRotationStatus{ActiveKey: tc.active})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("StandbyKey(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
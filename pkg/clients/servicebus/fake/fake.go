@@ -0,0 +1,182 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus/servicebusapi"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+var _ servicebusapi.NamespacesClientAPI = &MockNamespacesClient{}
+
+// MockNamespacesClient is a fake implementation of servicebus.NamespacesClient.
+type MockNamespacesClient struct {
+	servicebusapi.NamespacesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, namespaceName string, parameters servicebus.SBNamespace) (result servicebus.NamespacesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, namespaceName string) (result servicebus.NamespacesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, namespaceName string) (result servicebus.SBNamespace, err error)
+	MockListKeys       func(ctx context.Context, resourceGroupName string, namespaceName string, authorizationRuleName string) (result servicebus.AccessKeys, err error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, namespaceName string, parameters servicebus.SBNamespaceUpdateParameters) (result servicebus.SBNamespace, err error)
+}
+
+// CreateOrUpdate calls the MockNamespacesClient's MockCreateOrUpdate method.
+func (c *MockNamespacesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, namespaceName string, parameters servicebus.SBNamespace) (result servicebus.NamespacesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, namespaceName, parameters)
+}
+
+// Delete calls the MockNamespacesClient's MockDelete method.
+func (c *MockNamespacesClient) Delete(ctx context.Context, resourceGroupName string, namespaceName string) (result servicebus.NamespacesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, namespaceName)
+}
+
+// Get calls the MockNamespacesClient's MockGet method.
+func (c *MockNamespacesClient) Get(ctx context.Context, resourceGroupName string, namespaceName string) (result servicebus.SBNamespace, err error) {
+	return c.MockGet(ctx, resourceGroupName, namespaceName)
+}
+
+// ListKeys calls the MockNamespacesClient's MockListKeys method.
+func (c *MockNamespacesClient) ListKeys(ctx context.Context, resourceGroupName string, namespaceName string, authorizationRuleName string) (result servicebus.AccessKeys, err error) {
+	return c.MockListKeys(ctx, resourceGroupName, namespaceName, authorizationRuleName)
+}
+
+// Update calls the MockNamespacesClient's MockUpdate method.
+func (c *MockNamespacesClient) Update(ctx context.Context, resourceGroupName string, namespaceName string, parameters servicebus.SBNamespaceUpdateParameters) (result servicebus.SBNamespace, err error) {
+	return c.MockUpdate(ctx, resourceGroupName, namespaceName, parameters)
+}
+
+var _ servicebusapi.QueuesClientAPI = &MockQueuesClient{}
+
+// MockQueuesClient is a fake implementation of servicebus.QueuesClient.
+type MockQueuesClient struct {
+	servicebusapi.QueuesClientAPI
+
+	MockCreateOrUpdate                  func(ctx context.Context, resourceGroupName string, namespaceName string, queueName string, parameters servicebus.SBQueue) (result servicebus.SBQueue, err error)
+	MockCreateOrUpdateAuthorizationRule func(ctx context.Context, resourceGroupName string, namespaceName string, queueName string, authorizationRuleName string, parameters servicebus.SBAuthorizationRule) (result servicebus.SBAuthorizationRule, err error)
+	MockDelete                          func(ctx context.Context, resourceGroupName string, namespaceName string, queueName string) (result autorest.Response, err error)
+	MockGet                             func(ctx context.Context, resourceGroupName string, namespaceName string, queueName string) (result servicebus.SBQueue, err error)
+	MockGetAuthorizationRule            func(ctx context.Context, resourceGroupName string, namespaceName string, queueName string, authorizationRuleName string) (result servicebus.SBAuthorizationRule, err error)
+	MockListKeys                        func(ctx context.Context, resourceGroupName string, namespaceName string, queueName string, authorizationRuleName string) (result servicebus.AccessKeys, err error)
+}
+
+// CreateOrUpdate calls the MockQueuesClient's MockCreateOrUpdate method.
+func (c *MockQueuesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, namespaceName string, queueName string, parameters servicebus.SBQueue) (result servicebus.SBQueue, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, namespaceName, queueName, parameters)
+}
+
+// CreateOrUpdateAuthorizationRule calls the MockQueuesClient's
+// MockCreateOrUpdateAuthorizationRule method.
+func (c *MockQueuesClient) CreateOrUpdateAuthorizationRule(ctx context.Context, resourceGroupName string, namespaceName string, queueName string, authorizationRuleName string, parameters servicebus.SBAuthorizationRule) (result servicebus.SBAuthorizationRule, err error) {
+	return c.MockCreateOrUpdateAuthorizationRule(ctx, resourceGroupName, namespaceName, queueName, authorizationRuleName, parameters)
+}
+
+// Delete calls the MockQueuesClient's MockDelete method.
+func (c *MockQueuesClient) Delete(ctx context.Context, resourceGroupName string, namespaceName string, queueName string) (result autorest.Response, err error) {
+	return c.MockDelete(ctx, resourceGroupName, namespaceName, queueName)
+}
+
+// Get calls the MockQueuesClient's MockGet method.
+func (c *MockQueuesClient) Get(ctx context.Context, resourceGroupName string, namespaceName string, queueName string) (result servicebus.SBQueue, err error) {
+	return c.MockGet(ctx, resourceGroupName, namespaceName, queueName)
+}
+
+// GetAuthorizationRule calls the MockQueuesClient's MockGetAuthorizationRule
+// method.
+func (c *MockQueuesClient) GetAuthorizationRule(ctx context.Context, resourceGroupName string, namespaceName string, queueName string, authorizationRuleName string) (result servicebus.SBAuthorizationRule, err error) {
+	return c.MockGetAuthorizationRule(ctx, resourceGroupName, namespaceName, queueName, authorizationRuleName)
+}
+
+// ListKeys calls the MockQueuesClient's MockListKeys method.
+func (c *MockQueuesClient) ListKeys(ctx context.Context, resourceGroupName string, namespaceName string, queueName string, authorizationRuleName string) (result servicebus.AccessKeys, err error) {
+	return c.MockListKeys(ctx, resourceGroupName, namespaceName, queueName, authorizationRuleName)
+}
+
+var _ servicebusapi.TopicsClientAPI = &MockTopicsClient{}
+
+// MockTopicsClient is a fake implementation of servicebus.TopicsClient.
+type MockTopicsClient struct {
+	servicebusapi.TopicsClientAPI
+
+	MockCreateOrUpdate                  func(ctx context.Context, resourceGroupName string, namespaceName string, topicName string, parameters servicebus.SBTopic) (result servicebus.SBTopic, err error)
+	MockCreateOrUpdateAuthorizationRule func(ctx context.Context, resourceGroupName string, namespaceName string, topicName string, authorizationRuleName string, parameters servicebus.SBAuthorizationRule) (result servicebus.SBAuthorizationRule, err error)
+	MockDelete                          func(ctx context.Context, resourceGroupName string, namespaceName string, topicName string) (result autorest.Response, err error)
+	MockGet                             func(ctx context.Context, resourceGroupName string, namespaceName string, topicName string) (result servicebus.SBTopic, err error)
+	MockGetAuthorizationRule            func(ctx context.Context, resourceGroupName string, namespaceName string, topicName string, authorizationRuleName string) (result servicebus.SBAuthorizationRule, err error)
+	MockListKeys                        func(ctx context.Context, resourceGroupName string, namespaceName string, topicName string, authorizationRuleName string) (result servicebus.AccessKeys, err error)
+}
+
+// CreateOrUpdate calls the MockTopicsClient's MockCreateOrUpdate method.
+func (c *MockTopicsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, namespaceName string, topicName string, parameters servicebus.SBTopic) (result servicebus.SBTopic, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, namespaceName, topicName, parameters)
+}
+
+// CreateOrUpdateAuthorizationRule calls the MockTopicsClient's
+// MockCreateOrUpdateAuthorizationRule method.
+func (c *MockTopicsClient) CreateOrUpdateAuthorizationRule(ctx context.Context, resourceGroupName string, namespaceName string, topicName string, authorizationRuleName string, parameters servicebus.SBAuthorizationRule) (result servicebus.SBAuthorizationRule, err error) {
+	return c.MockCreateOrUpdateAuthorizationRule(ctx, resourceGroupName, namespaceName, topicName, authorizationRuleName, parameters)
+}
+
+// Delete calls the MockTopicsClient's MockDelete method.
+func (c *MockTopicsClient) Delete(ctx context.Context, resourceGroupName string, namespaceName string, topicName string) (result autorest.Response, err error) {
+	return c.MockDelete(ctx, resourceGroupName, namespaceName, topicName)
+}
+
+// Get calls the MockTopicsClient's MockGet method.
+func (c *MockTopicsClient) Get(ctx context.Context, resourceGroupName string, namespaceName string, topicName string) (result servicebus.SBTopic, err error) {
+	return c.MockGet(ctx, resourceGroupName, namespaceName, topicName)
+}
+
+// GetAuthorizationRule calls the MockTopicsClient's MockGetAuthorizationRule
+// method.
+func (c *MockTopicsClient) GetAuthorizationRule(ctx context.Context, resourceGroupName string, namespaceName string, topicName string, authorizationRuleName string) (result servicebus.SBAuthorizationRule, err error) {
+	return c.MockGetAuthorizationRule(ctx, resourceGroupName, namespaceName, topicName, authorizationRuleName)
+}
+
+// ListKeys calls the MockTopicsClient's MockListKeys method.
+func (c *MockTopicsClient) ListKeys(ctx context.Context, resourceGroupName string, namespaceName string, topicName string, authorizationRuleName string) (result servicebus.AccessKeys, err error) {
+	return c.MockListKeys(ctx, resourceGroupName, namespaceName, topicName, authorizationRuleName)
+}
+
+var _ servicebusapi.SubscriptionsClientAPI = &MockSubscriptionsClient{}
+
+// MockSubscriptionsClient is a fake implementation of
+// servicebus.SubscriptionsClient.
+type MockSubscriptionsClient struct {
+	servicebusapi.SubscriptionsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, namespaceName string, topicName string, subscriptionName string, parameters servicebus.SBSubscription) (result servicebus.SBSubscription, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, namespaceName string, topicName string, subscriptionName string) (result autorest.Response, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, namespaceName string, topicName string, subscriptionName string) (result servicebus.SBSubscription, err error)
+}
+
+// CreateOrUpdate calls the MockSubscriptionsClient's MockCreateOrUpdate method.
+func (c *MockSubscriptionsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, namespaceName string, topicName string, subscriptionName string, parameters servicebus.SBSubscription) (result servicebus.SBSubscription, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, namespaceName, topicName, subscriptionName, parameters)
+}
+
+// Delete calls the MockSubscriptionsClient's MockDelete method.
+func (c *MockSubscriptionsClient) Delete(ctx context.Context, resourceGroupName string, namespaceName string, topicName string, subscriptionName string) (result autorest.Response, err error) {
+	return c.MockDelete(ctx, resourceGroupName, namespaceName, topicName, subscriptionName)
+}
+
+// Get calls the MockSubscriptionsClient's MockGet method.
+func (c *MockSubscriptionsClient) Get(ctx context.Context, resourceGroupName string, namespaceName string, topicName string, subscriptionName string) (result servicebus.SBSubscription, err error) {
+	return c.MockGet(ctx, resourceGroupName, namespaceName, topicName, subscriptionName)
+}
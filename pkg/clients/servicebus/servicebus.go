@@ -0,0 +1,398 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicebus
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-azure/apis/messaging/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// Resource states
+const (
+	ProvisioningStateSucceeded = "Succeeded"
+)
+
+// Authorization rules whose keys are published to connection secrets.
+const (
+	// NamespaceAuthorizationRuleName is the name of the authorization rule
+	// Azure creates for every Service Bus namespace.
+	NamespaceAuthorizationRuleName = "RootManageSharedAccessKey"
+
+	// EntityAuthorizationRuleName is the name of the authorization rule the
+	// provider manages for queues and topics.
+	EntityAuthorizationRuleName = "crossplane"
+)
+
+// Connection secret keys of Service Bus resources.
+const (
+	ConnectionSecretKeyPrimaryConnectionString   = "primaryConnectionString"
+	ConnectionSecretKeySecondaryConnectionString = "secondaryConnectionString"
+	ConnectionSecretKeyPrimaryKey                = "primaryKey"
+	ConnectionSecretKeySecondaryKey              = "secondaryKey"
+	ConnectionSecretKeySharedAccessKeyName       = "sharedAccessKeyName"
+)
+
+// DefaultAuthorizationRights are the rights of an entity authorization rule
+// when none are specified.
+var DefaultAuthorizationRights = []string{string(servicebus.Listen), string(servicebus.SendEnumValue)}
+
+// NewNamespaceParameters returns the parameters used to create an Azure
+// Service Bus namespace from the supplied ServiceBusNamespaceParameters.
+func NewNamespaceParameters(p v1alpha1.ServiceBusNamespaceParameters) servicebus.SBNamespace {
+	return servicebus.SBNamespace{
+		Location: azure.ToStringPtr(p.Location),
+		Sku:      newSku(p.SKU),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+	}
+}
+
+// NewNamespaceUpdateParameters returns the parameters used to update an
+// Azure Service Bus namespace from the supplied ServiceBusNamespaceParameters.
+func NewNamespaceUpdateParameters(p v1alpha1.ServiceBusNamespaceParameters) servicebus.SBNamespaceUpdateParameters {
+	return servicebus.SBNamespaceUpdateParameters{
+		Sku:  newSku(p.SKU),
+		Tags: azure.ToStringPtrMap(p.Tags),
+	}
+}
+
+// GenerateNamespaceObservation produces a ServiceBusNamespaceObservation from
+// the supplied Azure Service Bus namespace.
+func GenerateNamespaceObservation(az servicebus.SBNamespace) v1alpha1.ServiceBusNamespaceObservation {
+	o := v1alpha1.ServiceBusNamespaceObservation{
+		ID: azure.ToString(az.ID),
+	}
+	if az.SBNamespaceProperties != nil {
+		o.ProvisioningState = azure.ToString(az.ProvisioningState)
+		o.ServiceBusEndpoint = azure.ToString(az.ServiceBusEndpoint)
+	}
+	return o
+}
+
+// LateInitializeNamespace fills the spec values that user did not fill with
+// their corresponding value in the Azure, if there is any.
+func LateInitializeNamespace(p *v1alpha1.ServiceBusNamespaceParameters, az servicebus.SBNamespace) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, az.Tags)
+	if az.Sku != nil {
+		p.SKU.Capacity = azure.LateInitializeInt32PtrFromInt32Ptr(p.SKU.Capacity, az.Sku.Capacity)
+	}
+}
+
+// IsNamespaceUpToDate returns true if the supplied Azure Service Bus namespace
+// matches the supplied ServiceBusNamespaceParameters.
+func IsNamespaceUpToDate(p v1alpha1.ServiceBusNamespaceParameters, az servicebus.SBNamespace) bool {
+	observed := v1alpha1.ServiceBusNamespaceParameters{
+		Tags: azure.ToStringMap(az.Tags),
+	}
+	if az.Sku != nil {
+		observed.SKU = v1alpha1.ServiceBusSKU{
+			Name:     string(az.Sku.Name),
+			Capacity: az.Sku.Capacity,
+		}
+	}
+	return cmp.Equal(p, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.ServiceBusNamespaceParameters{}, "SubscriptionID", "ResourceGroupName", "ResourceGroupNameRef", "ResourceGroupNameSelector", "Location"),
+	)
+}
+
+// NewQueueParameters returns the parameters used to create or update an Azure
+// Service Bus queue from the supplied ServiceBusQueueParameters.
+func NewQueueParameters(p v1alpha1.ServiceBusQueueParameters) servicebus.SBQueue {
+	return servicebus.SBQueue{
+		SBQueueProperties: &servicebus.SBQueueProperties{
+			LockDuration:                        p.LockDuration,
+			MaxSizeInMegabytes:                  p.MaxSizeInMegabytes,
+			RequiresDuplicateDetection:          p.RequiresDuplicateDetection,
+			DuplicateDetectionHistoryTimeWindow: p.DuplicateDetectionHistoryTimeWindow,
+			RequiresSession:                     p.RequiresSession,
+			DefaultMessageTimeToLive:            p.DefaultMessageTimeToLive,
+			DeadLetteringOnMessageExpiration:    p.DeadLetteringOnMessageExpiration,
+			MaxDeliveryCount:                    p.MaxDeliveryCount,
+			EnableBatchedOperations:             p.EnableBatchedOperations,
+			EnablePartitioning:                  p.EnablePartitioning,
+			AutoDeleteOnIdle:                    p.AutoDeleteOnIdle,
+			ForwardTo:                           p.ForwardTo,
+			ForwardDeadLetteredMessagesTo:       p.ForwardDeadLetteredMessagesTo,
+		},
+	}
+}
+
+// GenerateQueueObservation produces a ServiceBusEntityObservation from the
+// supplied Azure Service Bus queue.
+func GenerateQueueObservation(az servicebus.SBQueue) v1alpha1.ServiceBusEntityObservation {
+	o := v1alpha1.ServiceBusEntityObservation{
+		ID: azure.ToString(az.ID),
+	}
+	if az.SBQueueProperties != nil {
+		o.Status = string(az.Status)
+	}
+	return o
+}
+
+// LateInitializeQueue fills the spec values that user did not fill with their
+// corresponding value in the Azure, if there is any.
+func LateInitializeQueue(p *v1alpha1.ServiceBusQueueParameters, az servicebus.SBQueue) {
+	if az.SBQueueProperties == nil {
+		return
+	}
+	props := az.SBQueueProperties
+	p.LockDuration = azure.LateInitializeStringPtrFromPtr(p.LockDuration, props.LockDuration)
+	p.MaxSizeInMegabytes = azure.LateInitializeInt32PtrFromInt32Ptr(p.MaxSizeInMegabytes, props.MaxSizeInMegabytes)
+	p.RequiresDuplicateDetection = azure.LateInitializeBoolPtrFromPtr(p.RequiresDuplicateDetection, props.RequiresDuplicateDetection)
+	p.DuplicateDetectionHistoryTimeWindow = azure.LateInitializeStringPtrFromPtr(p.DuplicateDetectionHistoryTimeWindow, props.DuplicateDetectionHistoryTimeWindow)
+	p.RequiresSession = azure.LateInitializeBoolPtrFromPtr(p.RequiresSession, props.RequiresSession)
+	p.DefaultMessageTimeToLive = azure.LateInitializeStringPtrFromPtr(p.DefaultMessageTimeToLive, props.DefaultMessageTimeToLive)
+	p.DeadLetteringOnMessageExpiration = azure.LateInitializeBoolPtrFromPtr(p.DeadLetteringOnMessageExpiration, props.DeadLetteringOnMessageExpiration)
+	p.MaxDeliveryCount = azure.LateInitializeInt32PtrFromInt32Ptr(p.MaxDeliveryCount, props.MaxDeliveryCount)
+	p.EnableBatchedOperations = azure.LateInitializeBoolPtrFromPtr(p.EnableBatchedOperations, props.EnableBatchedOperations)
+	p.EnablePartitioning = azure.LateInitializeBoolPtrFromPtr(p.EnablePartitioning, props.EnablePartitioning)
+	p.AutoDeleteOnIdle = azure.LateInitializeStringPtrFromPtr(p.AutoDeleteOnIdle, props.AutoDeleteOnIdle)
+}
+
+// IsQueueUpToDate returns true if the supplied Azure Service Bus queue matches
+// the supplied ServiceBusQueueParameters.
+func IsQueueUpToDate(p v1alpha1.ServiceBusQueueParameters, az servicebus.SBQueue) bool {
+	if az.SBQueueProperties == nil {
+		return false
+	}
+	props := az.SBQueueProperties
+	observed := v1alpha1.ServiceBusQueueParameters{
+		LockDuration:                        props.LockDuration,
+		MaxSizeInMegabytes:                  props.MaxSizeInMegabytes,
+		RequiresDuplicateDetection:          props.RequiresDuplicateDetection,
+		DuplicateDetectionHistoryTimeWindow: props.DuplicateDetectionHistoryTimeWindow,
+		RequiresSession:                     props.RequiresSession,
+		DefaultMessageTimeToLive:            props.DefaultMessageTimeToLive,
+		DeadLetteringOnMessageExpiration:    props.DeadLetteringOnMessageExpiration,
+		MaxDeliveryCount:                    props.MaxDeliveryCount,
+		EnableBatchedOperations:             props.EnableBatchedOperations,
+		EnablePartitioning:                  props.EnablePartitioning,
+		AutoDeleteOnIdle:                    props.AutoDeleteOnIdle,
+		ForwardTo:                           props.ForwardTo,
+		ForwardDeadLetteredMessagesTo:       props.ForwardDeadLetteredMessagesTo,
+	}
+	return cmp.Equal(p, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.ServiceBusQueueParameters{}, "SubscriptionID", "ResourceGroupName", "ResourceGroupNameRef", "ResourceGroupNameSelector",
+			"NamespaceName", "NamespaceNameRef", "NamespaceNameSelector", "AuthorizationRights"),
+	)
+}
+
+// NewTopicParameters returns the parameters used to create or update an Azure
+// Service Bus topic from the supplied ServiceBusTopicParameters.
+func NewTopicParameters(p v1alpha1.ServiceBusTopicParameters) servicebus.SBTopic {
+	return servicebus.SBTopic{
+		SBTopicProperties: &servicebus.SBTopicProperties{
+			MaxSizeInMegabytes:                  p.MaxSizeInMegabytes,
+			RequiresDuplicateDetection:          p.RequiresDuplicateDetection,
+			DuplicateDetectionHistoryTimeWindow: p.DuplicateDetectionHistoryTimeWindow,
+			DefaultMessageTimeToLive:            p.DefaultMessageTimeToLive,
+			EnableBatchedOperations:             p.EnableBatchedOperations,
+			SupportOrdering:                     p.SupportOrdering,
+			EnablePartitioning:                  p.EnablePartitioning,
+			AutoDeleteOnIdle:                    p.AutoDeleteOnIdle,
+		},
+	}
+}
+
+// GenerateTopicObservation produces a ServiceBusEntityObservation from the
+// supplied Azure Service Bus topic.
+func GenerateTopicObservation(az servicebus.SBTopic) v1alpha1.ServiceBusEntityObservation {
+	o := v1alpha1.ServiceBusEntityObservation{
+		ID: azure.ToString(az.ID),
+	}
+	if az.SBTopicProperties != nil {
+		o.Status = string(az.Status)
+	}
+	return o
+}
+
+// LateInitializeTopic fills the spec values that user did not fill with their
+// corresponding value in the Azure, if there is any.
+func LateInitializeTopic(p *v1alpha1.ServiceBusTopicParameters, az servicebus.SBTopic) {
+	if az.SBTopicProperties == nil {
+		return
+	}
+	props := az.SBTopicProperties
+	p.MaxSizeInMegabytes = azure.LateInitializeInt32PtrFromInt32Ptr(p.MaxSizeInMegabytes, props.MaxSizeInMegabytes)
+	p.RequiresDuplicateDetection = azure.LateInitializeBoolPtrFromPtr(p.RequiresDuplicateDetection, props.RequiresDuplicateDetection)
+	p.DuplicateDetectionHistoryTimeWindow = azure.LateInitializeStringPtrFromPtr(p.DuplicateDetectionHistoryTimeWindow, props.DuplicateDetectionHistoryTimeWindow)
+	p.DefaultMessageTimeToLive = azure.LateInitializeStringPtrFromPtr(p.DefaultMessageTimeToLive, props.DefaultMessageTimeToLive)
+	p.EnableBatchedOperations = azure.LateInitializeBoolPtrFromPtr(p.EnableBatchedOperations, props.EnableBatchedOperations)
+	p.SupportOrdering = azure.LateInitializeBoolPtrFromPtr(p.SupportOrdering, props.SupportOrdering)
+	p.EnablePartitioning = azure.LateInitializeBoolPtrFromPtr(p.EnablePartitioning, props.EnablePartitioning)
+	p.AutoDeleteOnIdle = azure.LateInitializeStringPtrFromPtr(p.AutoDeleteOnIdle, props.AutoDeleteOnIdle)
+}
+
+// IsTopicUpToDate returns true if the supplied Azure Service Bus topic matches
+// the supplied ServiceBusTopicParameters.
+func IsTopicUpToDate(p v1alpha1.ServiceBusTopicParameters, az servicebus.SBTopic) bool {
+	if az.SBTopicProperties == nil {
+		return false
+	}
+	props := az.SBTopicProperties
+	observed := v1alpha1.ServiceBusTopicParameters{
+		MaxSizeInMegabytes:                  props.MaxSizeInMegabytes,
+		RequiresDuplicateDetection:          props.RequiresDuplicateDetection,
+		DuplicateDetectionHistoryTimeWindow: props.DuplicateDetectionHistoryTimeWindow,
+		DefaultMessageTimeToLive:            props.DefaultMessageTimeToLive,
+		EnableBatchedOperations:             props.EnableBatchedOperations,
+		SupportOrdering:                     props.SupportOrdering,
+		EnablePartitioning:                  props.EnablePartitioning,
+		AutoDeleteOnIdle:                    props.AutoDeleteOnIdle,
+	}
+	return cmp.Equal(p, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.ServiceBusTopicParameters{}, "SubscriptionID", "ResourceGroupName", "ResourceGroupNameRef", "ResourceGroupNameSelector",
+			"NamespaceName", "NamespaceNameRef", "NamespaceNameSelector", "AuthorizationRights"),
+	)
+}
+
+// NewSubscriptionParameters returns the parameters used to create or update an
+// Azure Service Bus subscription from the supplied
+// ServiceBusSubscriptionParameters.
+func NewSubscriptionParameters(p v1alpha1.ServiceBusSubscriptionParameters) servicebus.SBSubscription {
+	return servicebus.SBSubscription{
+		SBSubscriptionProperties: &servicebus.SBSubscriptionProperties{
+			LockDuration:                              p.LockDuration,
+			RequiresSession:                           p.RequiresSession,
+			DefaultMessageTimeToLive:                  p.DefaultMessageTimeToLive,
+			DeadLetteringOnMessageExpiration:          p.DeadLetteringOnMessageExpiration,
+			DeadLetteringOnFilterEvaluationExceptions: p.DeadLetteringOnFilterEvaluationExceptions,
+			MaxDeliveryCount:                          p.MaxDeliveryCount,
+			EnableBatchedOperations:                   p.EnableBatchedOperations,
+			AutoDeleteOnIdle:                          p.AutoDeleteOnIdle,
+			ForwardTo:                                 p.ForwardTo,
+			ForwardDeadLetteredMessagesTo:             p.ForwardDeadLetteredMessagesTo,
+		},
+	}
+}
+
+// GenerateSubscriptionObservation produces a ServiceBusEntityObservation from
+// the supplied Azure Service Bus subscription.
+func GenerateSubscriptionObservation(az servicebus.SBSubscription) v1alpha1.ServiceBusEntityObservation {
+	o := v1alpha1.ServiceBusEntityObservation{
+		ID: azure.ToString(az.ID),
+	}
+	if az.SBSubscriptionProperties != nil {
+		o.Status = string(az.Status)
+	}
+	return o
+}
+
+// LateInitializeSubscription fills the spec values that user did not fill
+// with their corresponding value in the Azure, if there is any.
+func LateInitializeSubscription(p *v1alpha1.ServiceBusSubscriptionParameters, az servicebus.SBSubscription) {
+	if az.SBSubscriptionProperties == nil {
+		return
+	}
+	props := az.SBSubscriptionProperties
+	p.LockDuration = azure.LateInitializeStringPtrFromPtr(p.LockDuration, props.LockDuration)
+	p.RequiresSession = azure.LateInitializeBoolPtrFromPtr(p.RequiresSession, props.RequiresSession)
+	p.DefaultMessageTimeToLive = azure.LateInitializeStringPtrFromPtr(p.DefaultMessageTimeToLive, props.DefaultMessageTimeToLive)
+	p.DeadLetteringOnMessageExpiration = azure.LateInitializeBoolPtrFromPtr(p.DeadLetteringOnMessageExpiration, props.DeadLetteringOnMessageExpiration)
+	p.DeadLetteringOnFilterEvaluationExceptions = azure.LateInitializeBoolPtrFromPtr(p.DeadLetteringOnFilterEvaluationExceptions, props.DeadLetteringOnFilterEvaluationExceptions)
+	p.MaxDeliveryCount = azure.LateInitializeInt32PtrFromInt32Ptr(p.MaxDeliveryCount, props.MaxDeliveryCount)
+	p.EnableBatchedOperations = azure.LateInitializeBoolPtrFromPtr(p.EnableBatchedOperations, props.EnableBatchedOperations)
+	p.AutoDeleteOnIdle = azure.LateInitializeStringPtrFromPtr(p.AutoDeleteOnIdle, props.AutoDeleteOnIdle)
+}
+
+// IsSubscriptionUpToDate returns true if the supplied Azure Service Bus
+// subscription matches the supplied ServiceBusSubscriptionParameters.
+func IsSubscriptionUpToDate(p v1alpha1.ServiceBusSubscriptionParameters, az servicebus.SBSubscription) bool {
+	if az.SBSubscriptionProperties == nil {
+		return false
+	}
+	props := az.SBSubscriptionProperties
+	observed := v1alpha1.ServiceBusSubscriptionParameters{
+		LockDuration:                              props.LockDuration,
+		RequiresSession:                           props.RequiresSession,
+		DefaultMessageTimeToLive:                  props.DefaultMessageTimeToLive,
+		DeadLetteringOnMessageExpiration:          props.DeadLetteringOnMessageExpiration,
+		DeadLetteringOnFilterEvaluationExceptions: props.DeadLetteringOnFilterEvaluationExceptions,
+		MaxDeliveryCount:                          props.MaxDeliveryCount,
+		EnableBatchedOperations:                   props.EnableBatchedOperations,
+		AutoDeleteOnIdle:                          props.AutoDeleteOnIdle,
+		ForwardTo:                                 props.ForwardTo,
+		ForwardDeadLetteredMessagesTo:             props.ForwardDeadLetteredMessagesTo,
+	}
+	return cmp.Equal(p, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.ServiceBusSubscriptionParameters{}, "SubscriptionID", "ResourceGroupName", "ResourceGroupNameRef", "ResourceGroupNameSelector",
+			"NamespaceName", "NamespaceNameRef", "NamespaceNameSelector", "TopicName", "TopicNameRef", "TopicNameSelector"),
+	)
+}
+
+// NewAuthorizationRule returns an authorization rule that grants the supplied
+// rights, or DefaultAuthorizationRights if none are supplied.
+func NewAuthorizationRule(rights []string) servicebus.SBAuthorizationRule {
+	if len(rights) == 0 {
+		rights = DefaultAuthorizationRights
+	}
+	r := make([]servicebus.AccessRights, len(rights))
+	for i, v := range rights {
+		r[i] = servicebus.AccessRights(v)
+	}
+	return servicebus.SBAuthorizationRule{
+		SBAuthorizationRuleProperties: &servicebus.SBAuthorizationRuleProperties{Rights: &r},
+	}
+}
+
+// IsAuthorizationRuleUpToDate returns true if the supplied authorization rule
+// grants exactly the supplied rights, or DefaultAuthorizationRights if none
+// are supplied.
+func IsAuthorizationRuleUpToDate(rights []string, az servicebus.SBAuthorizationRule) bool {
+	if len(rights) == 0 {
+		rights = DefaultAuthorizationRights
+	}
+	var observed []string
+	if az.SBAuthorizationRuleProperties != nil && az.Rights != nil {
+		for _, r := range *az.Rights {
+			observed = append(observed, string(r))
+		}
+	}
+	return cmp.Equal(rights, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+	)
+}
+
+// ConnectionDetails returns the connection details that publish the supplied
+// authorization rule keys.
+func ConnectionDetails(k servicebus.AccessKeys) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		ConnectionSecretKeyPrimaryConnectionString:   []byte(azure.ToString(k.PrimaryConnectionString)),
+		ConnectionSecretKeySecondaryConnectionString: []byte(azure.ToString(k.SecondaryConnectionString)),
+		ConnectionSecretKeyPrimaryKey:                []byte(azure.ToString(k.PrimaryKey)),
+		ConnectionSecretKeySecondaryKey:              []byte(azure.ToString(k.SecondaryKey)),
+		ConnectionSecretKeySharedAccessKeyName:       []byte(azure.ToString(k.KeyName)),
+	}
+}
+
+func newSku(s v1alpha1.ServiceBusSKU) *servicebus.SBSku {
+	return &servicebus.SBSku{
+		Name:     servicebus.SkuName(s.Name),
+		Tier:     servicebus.SkuTier(s.Name),
+		Capacity: s.Capacity,
+	}
+}
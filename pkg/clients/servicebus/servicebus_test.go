@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicebus
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-azure/apis/messaging/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+func TestIsNamespaceUpToDate(t *testing.T) {
+	az := servicebus.SBNamespace{
+		Sku:  &servicebus.SBSku{Name: servicebus.Standard, Tier: servicebus.SkuTierStandard, Capacity: azure.ToInt32Ptr(1)},
+		Tags: map[string]*string{"created_by": azure.ToStringPtr("crossplane")},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ServiceBusNamespaceParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.ServiceBusNamespaceParameters{
+				Location: "westeurope",
+				SKU:      v1alpha1.ServiceBusSKU{Name: "Standard", Capacity: azure.ToInt32Ptr(1)},
+				Tags:     map[string]string{"created_by": "crossplane"},
+			},
+			want: true,
+		},
+		"SKUChanged": {
+			p: v1alpha1.ServiceBusNamespaceParameters{
+				SKU:  v1alpha1.ServiceBusSKU{Name: "Premium", Capacity: azure.ToInt32Ptr(1)},
+				Tags: map[string]string{"created_by": "crossplane"},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			p: v1alpha1.ServiceBusNamespaceParameters{
+				SKU: v1alpha1.ServiceBusSKU{Name: "Standard", Capacity: azure.ToInt32Ptr(1)},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsNamespaceUpToDate(tc.p, az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsNamespaceUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestQueueParameters(t *testing.T) {
+	p := v1alpha1.ServiceBusQueueParameters{
+		ResourceGroupName:   "coolRG",
+		NamespaceName:       "coolNamespace",
+		LockDuration:        azure.ToStringPtr("PT1M"),
+		MaxDeliveryCount:    azure.ToInt32Ptr(10),
+		RequiresSession:     azure.ToBoolPtr(true),
+		ForwardTo:           azure.ToStringPtr("coolTopic"),
+		AuthorizationRights: []string{"Listen"},
+	}
+	if !IsQueueUpToDate(p, NewQueueParameters(p)) {
+		t.Errorf("NewQueueParameters(...): generated parameters do not match the spec")
+	}
+
+	changed := p
+	changed.MaxDeliveryCount = azure.ToInt32Ptr(5)
+	if IsQueueUpToDate(changed, NewQueueParameters(p)) {
+		t.Errorf("IsQueueUpToDate(...): expected a changed MaxDeliveryCount to need an update")
+	}
+
+	if IsQueueUpToDate(p, servicebus.SBQueue{}) {
+		t.Errorf("IsQueueUpToDate(...): expected a queue without properties to need an update")
+	}
+}
+
+func TestLateInitializeQueue(t *testing.T) {
+	p := v1alpha1.ServiceBusQueueParameters{MaxDeliveryCount: azure.ToInt32Ptr(5)}
+	LateInitializeQueue(&p, servicebus.SBQueue{SBQueueProperties: &servicebus.SBQueueProperties{
+		LockDuration:     azure.ToStringPtr("PT1M"),
+		MaxDeliveryCount: azure.ToInt32Ptr(10),
+	}})
+
+	want := v1alpha1.ServiceBusQueueParameters{
+		LockDuration:     azure.ToStringPtr("PT1M"),
+		MaxDeliveryCount: azure.ToInt32Ptr(5),
+	}
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeQueue(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsAuthorizationRuleUpToDate(t *testing.T) {
+	rule := func(r ...servicebus.AccessRights) servicebus.SBAuthorizationRule {
+		return servicebus.SBAuthorizationRule{SBAuthorizationRuleProperties: &servicebus.SBAuthorizationRuleProperties{Rights: &r}}
+	}
+
+	cases := map[string]struct {
+		rights []string
+		az     servicebus.SBAuthorizationRule
+		want   bool
+	}{
+		"DefaultRights": {
+			az:   rule(servicebus.SendEnumValue, servicebus.Listen),
+			want: true,
+		},
+		"SameRights": {
+			rights: []string{"Manage", "Listen", "Send"},
+			az:     rule(servicebus.Listen, servicebus.Manage, servicebus.SendEnumValue),
+			want:   true,
+		},
+		"RightsChanged": {
+			rights: []string{"Listen"},
+			az:     rule(servicebus.Listen, servicebus.SendEnumValue),
+			want:   false,
+		},
+		"NoProperties": {
+			az:   servicebus.SBAuthorizationRule{},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAuthorizationRuleUpToDate(tc.rights, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsAuthorizationRuleUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
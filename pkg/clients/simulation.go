@@ -0,0 +1,56 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Subscriptions served by a simulator are not verified to be accessible.
+var simulatedSubscriptionOverrider = NewSubscriptionOverrider(func(context.Context, map[string]string, autorest.Authorizer, string) error { return nil })
+
+// GetSimulatedAuthInfo is the simulation mode counterpart of GetAuthInfo. It
+// returns the credentials content of the supplied managed resource, targeting
+// the subscription it overrides, if any, and an authorizer that does not
+// authorize requests. It never authenticates with Azure AD or contacts Azure
+// Resource Manager.
+func GetSimulatedAuthInfo(ctx context.Context, c client.Client, mg resource.Managed) (map[string]string, autorest.Authorizer, error) {
+	content, err := GetCredentials(ctx, c, mg)
+	if err != nil {
+		return nil, nil, err
+	}
+	content, err = simulatedSubscriptionOverrider.Override(ctx, mg, content, autorest.NullAuthorizer{})
+	return content, autorest.NullAuthorizer{}, err
+}
+
+// SimulatedEndpoint returns the base URI that track 1 clients should use when
+// the provider runs in simulation mode. This is the Azure Resource Manager
+// endpoint found in the supplied credentials content, which is expected to
+// point at a local API simulator or a replay of recorded API responses.
+func SimulatedEndpoint(creds map[string]string) string {
+	ep := creds[CredentialsKeyResourceManagerEndpointURL]
+	if ep == "" {
+		ep = DefaultResourceManagerEndpoint
+	}
+	return strings.TrimSuffix(ep, "/")
+}
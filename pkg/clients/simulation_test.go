@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+)
+
+func TestGetSimulatedAuthInfo(t *testing.T) {
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			switch o := obj.(type) {
+			case *v1alpha3.Provider:
+				o.Spec.CredentialsSecretRef = xpv1.SecretKeySelector{Key: "creds"}
+			case *corev1.Secret:
+				o.Data = map[string][]byte{"creds": []byte(`{"subscriptionId":"default","resourceManagerEndpointUrl":"http://127.0.0.1:8080"}`)}
+			}
+			return nil
+		},
+	}
+	withSubscription := func(id *string) resource.Managed {
+		rg := &v1alpha3.ResourceGroup{Spec: v1alpha3.ResourceGroupSpec{SubscriptionID: id}}
+		rg.SetProviderReference(&xpv1.Reference{Name: "provider"})
+		return rg
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want map[string]string
+	}{
+		"NotOverridden": {
+			mg: withSubscription(nil),
+			want: map[string]string{
+				CredentialsKeySubscriptionID:             "default",
+				CredentialsKeyResourceManagerEndpointURL: "http://127.0.0.1:8080",
+			},
+		},
+		// The overriding subscription is not verified, which would require
+		// access to Azure Resource Manager.
+		"Overridden": {
+			mg: withSubscription(to.StringPtr("other")),
+			want: map[string]string{
+				CredentialsKeySubscriptionID:             "other",
+				CredentialsKeyResourceManagerEndpointURL: "http://127.0.0.1:8080",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, a, err := GetSimulatedAuthInfo(context.Background(), kube, tc.mg)
+			if err != nil {
+				t.Fatalf("GetSimulatedAuthInfo(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetSimulatedAuthInfo(...): -want credentials, +got credentials:\n%s", diff)
+			}
+			if _, ok := a.(autorest.NullAuthorizer); !ok {
+				t.Errorf("GetSimulatedAuthInfo(...): want autorest.NullAuthorizer, got %T", a)
+			}
+		})
+	}
+}
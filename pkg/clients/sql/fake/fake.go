@@ -0,0 +1,120 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v4.0/sql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v4.0/sql/sqlapi"
+)
+
+var _ sqlapi.ServersClientAPI = &MockServersClient{}
+
+// MockServersClient is a fake implementation of sql.ServersClient.
+type MockServersClient struct {
+	sqlapi.ServersClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, parameters sql.Server) (result sql.ServersCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string) (result sql.ServersDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string) (result sql.Server, err error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, serverName string, parameters sql.ServerUpdate) (result sql.ServersUpdateFuture, err error)
+}
+
+// CreateOrUpdate calls the MockServersClient's MockCreateOrUpdate method.
+func (c *MockServersClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, parameters sql.Server) (result sql.ServersCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, parameters)
+}
+
+// Delete calls the MockServersClient's MockDelete method.
+func (c *MockServersClient) Delete(ctx context.Context, resourceGroupName string, serverName string) (result sql.ServersDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName)
+}
+
+// Get calls the MockServersClient's MockGet method.
+func (c *MockServersClient) Get(ctx context.Context, resourceGroupName string, serverName string) (result sql.Server, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName)
+}
+
+// Update calls the MockServersClient's MockUpdate method.
+func (c *MockServersClient) Update(ctx context.Context, resourceGroupName string, serverName string, parameters sql.ServerUpdate) (result sql.ServersUpdateFuture, err error) {
+	return c.MockUpdate(ctx, resourceGroupName, serverName, parameters)
+}
+
+var _ sqlapi.ElasticPoolsClientAPI = &MockElasticPoolsClient{}
+
+// MockElasticPoolsClient is a fake implementation of sql.ElasticPoolsClient.
+type MockElasticPoolsClient struct {
+	sqlapi.ElasticPoolsClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string, parameters sql.ElasticPool) (result sql.ElasticPoolsCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string) (result sql.ElasticPoolsDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string) (result sql.ElasticPool, err error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string, parameters sql.ElasticPoolUpdate) (result sql.ElasticPoolsUpdateFuture, err error)
+}
+
+// CreateOrUpdate calls the MockElasticPoolsClient's MockCreateOrUpdate method.
+func (c *MockElasticPoolsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string, parameters sql.ElasticPool) (result sql.ElasticPoolsCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, elasticPoolName, parameters)
+}
+
+// Delete calls the MockElasticPoolsClient's MockDelete method.
+func (c *MockElasticPoolsClient) Delete(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string) (result sql.ElasticPoolsDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, elasticPoolName)
+}
+
+// Get calls the MockElasticPoolsClient's MockGet method.
+func (c *MockElasticPoolsClient) Get(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string) (result sql.ElasticPool, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, elasticPoolName)
+}
+
+// Update calls the MockElasticPoolsClient's MockUpdate method.
+func (c *MockElasticPoolsClient) Update(ctx context.Context, resourceGroupName string, serverName string, elasticPoolName string, parameters sql.ElasticPoolUpdate) (result sql.ElasticPoolsUpdateFuture, err error) {
+	return c.MockUpdate(ctx, resourceGroupName, serverName, elasticPoolName, parameters)
+}
+
+var _ sqlapi.DatabasesClientAPI = &MockDatabasesClient{}
+
+// MockDatabasesClient is a fake implementation of sql.DatabasesClient.
+type MockDatabasesClient struct {
+	sqlapi.DatabasesClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters sql.Database) (result sql.DatabasesCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result sql.DatabasesDeleteFuture, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result sql.Database, err error)
+	MockUpdate         func(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters sql.DatabaseUpdate) (result sql.DatabasesUpdateFuture, err error)
+}
+
+// CreateOrUpdate calls the MockDatabasesClient's MockCreateOrUpdate method.
+func (c *MockDatabasesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters sql.Database) (result sql.DatabasesCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, serverName, databaseName, parameters)
+}
+
+// Delete calls the MockDatabasesClient's MockDelete method.
+func (c *MockDatabasesClient) Delete(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result sql.DatabasesDeleteFuture, err error) {
+	return c.MockDelete(ctx, resourceGroupName, serverName, databaseName)
+}
+
+// Get calls the MockDatabasesClient's MockGet method.
+func (c *MockDatabasesClient) Get(ctx context.Context, resourceGroupName string, serverName string, databaseName string) (result sql.Database, err error) {
+	return c.MockGet(ctx, resourceGroupName, serverName, databaseName)
+}
+
+// Update calls the MockDatabasesClient's MockUpdate method.
+func (c *MockDatabasesClient) Update(ctx context.Context, resourceGroupName string, serverName string, databaseName string, parameters sql.DatabaseUpdate) (result sql.DatabasesUpdateFuture, err error) {
+	return c.MockUpdate(ctx, resourceGroupName, serverName, databaseName, parameters)
+}
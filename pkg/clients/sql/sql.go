@@ -0,0 +1,393 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v4.0/sql"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-azure/apis/sql/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// States of Azure SQL servers, elastic pools and databases in which they can
+// be used.
+const (
+	ServerStateReady      = "Ready"
+	ElasticPoolStateReady = string(sql.ElasticPoolStateReady)
+	DatabaseStatusOnline  = string(sql.DatabaseStatusOnline)
+)
+
+// NewServerParameters returns the parameters used to create an Azure SQL
+// server with the supplied administrator password from the supplied
+// SQLServerParameters.
+func NewServerParameters(p v1alpha1.SQLServerParameters, adminPassword string) sql.Server {
+	return sql.Server{
+		Location: azure.ToStringPtr(p.Location),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+		ServerProperties: &sql.ServerProperties{
+			AdministratorLogin:         azure.ToStringPtr(p.AdministratorLogin),
+			AdministratorLoginPassword: azure.ToStringPtr(adminPassword),
+			Version:                    p.Version,
+			MinimalTLSVersion:          p.MinimalTLSVersion,
+			PublicNetworkAccess:        sql.ServerPublicNetworkAccess(azure.ToString(p.PublicNetworkAccess)),
+		},
+	}
+}
+
+// NewServerUpdate returns the parameters used to update an Azure SQL server
+// from the supplied SQLServerParameters.
+func NewServerUpdate(p v1alpha1.SQLServerParameters) sql.ServerUpdate {
+	return sql.ServerUpdate{
+		Tags: azure.ToStringPtrMap(p.Tags),
+		ServerProperties: &sql.ServerProperties{
+			MinimalTLSVersion:   p.MinimalTLSVersion,
+			PublicNetworkAccess: sql.ServerPublicNetworkAccess(azure.ToString(p.PublicNetworkAccess)),
+		},
+	}
+}
+
+// GenerateServerObservation produces a SQLServerObservation from the supplied
+// Azure SQL server.
+func GenerateServerObservation(az sql.Server) v1alpha1.SQLServerObservation {
+	o := v1alpha1.SQLServerObservation{
+		ID: azure.ToString(az.ID),
+	}
+	if az.ServerProperties != nil {
+		o.State = azure.ToString(az.State)
+		o.FullyQualifiedDomainName = azure.ToString(az.FullyQualifiedDomainName)
+	}
+	return o
+}
+
+// ServerConnectionDetails returns the connection details of the Azure SQL
+// server with the supplied parameters and observation, except for its
+// administrator password.
+func ServerConnectionDetails(p v1alpha1.SQLServerParameters, o v1alpha1.SQLServerObservation) managed.ConnectionDetails {
+	cd := azure.ResourceConnectionDetails(o.ID, p.Location)
+	cd[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(o.FullyQualifiedDomainName)
+	cd[xpv1.ResourceCredentialsSecretPortKey] = []byte(v1alpha1.SQLServerPort)
+	cd[xpv1.ResourceCredentialsSecretUserKey] = []byte(p.AdministratorLogin)
+	return cd
+}
+
+// LateInitializeServer fills the spec values that user did not fill with
+// their corresponding value in the Azure, if there is any.
+func LateInitializeServer(p *v1alpha1.SQLServerParameters, az sql.Server) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, az.Tags)
+	if az.ServerProperties == nil {
+		return
+	}
+	p.Version = azure.LateInitializeStringPtrFromPtr(p.Version, az.Version)
+	p.MinimalTLSVersion = azure.LateInitializeStringPtrFromPtr(p.MinimalTLSVersion, az.MinimalTLSVersion)
+	if p.PublicNetworkAccess == nil && az.PublicNetworkAccess != "" {
+		p.PublicNetworkAccess = azure.ToStringPtr(string(az.PublicNetworkAccess))
+	}
+}
+
+// IsServerUpToDate returns true if the supplied Azure SQL server matches the
+// supplied SQLServerParameters.
+func IsServerUpToDate(p v1alpha1.SQLServerParameters, az sql.Server) bool {
+	if az.ServerProperties == nil {
+		return false
+	}
+	observed := v1alpha1.SQLServerParameters{
+		Tags:              azure.ToStringMap(az.Tags),
+		MinimalTLSVersion: az.MinimalTLSVersion,
+	}
+	if az.PublicNetworkAccess != "" {
+		observed.PublicNetworkAccess = azure.ToStringPtr(string(az.PublicNetworkAccess))
+	}
+	return cmp.Equal(observed, p,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.SQLServerParameters{}, "SubscriptionID", "ResourceGroupName", "ResourceGroupNameRef", "ResourceGroupNameSelector",
+			"Location", "AdministratorLogin", "Version", "ConnectionSecretFormat"))
+}
+
+// NewElasticPoolParameters returns the parameters used to create an Azure SQL
+// elastic pool from the supplied ElasticPoolParameters.
+func NewElasticPoolParameters(p v1alpha1.ElasticPoolParameters) sql.ElasticPool {
+	return sql.ElasticPool{
+		Location: azure.ToStringPtr(p.Location),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+		Sku:      newSKU(&p.SKU),
+		ElasticPoolProperties: &sql.ElasticPoolProperties{
+			MaxSizeBytes:        p.MaxSizeBytes,
+			PerDatabaseSettings: newPerDatabaseSettings(p.PerDatabaseSettings),
+			ZoneRedundant:       p.ZoneRedundant,
+			LicenseType:         sql.ElasticPoolLicenseType(azure.ToString(p.LicenseType)),
+		},
+	}
+}
+
+// NewElasticPoolUpdate returns the parameters used to update an Azure SQL
+// elastic pool from the supplied ElasticPoolParameters.
+func NewElasticPoolUpdate(p v1alpha1.ElasticPoolParameters) sql.ElasticPoolUpdate {
+	return sql.ElasticPoolUpdate{
+		Tags: azure.ToStringPtrMap(p.Tags),
+		Sku:  newSKU(&p.SKU),
+		ElasticPoolUpdateProperties: &sql.ElasticPoolUpdateProperties{
+			MaxSizeBytes:        p.MaxSizeBytes,
+			PerDatabaseSettings: newPerDatabaseSettings(p.PerDatabaseSettings),
+			ZoneRedundant:       p.ZoneRedundant,
+			LicenseType:         sql.ElasticPoolLicenseType(azure.ToString(p.LicenseType)),
+		},
+	}
+}
+
+// GenerateElasticPoolObservation produces an ElasticPoolObservation from the
+// supplied Azure SQL elastic pool.
+func GenerateElasticPoolObservation(az sql.ElasticPool) v1alpha1.ElasticPoolObservation {
+	o := v1alpha1.ElasticPoolObservation{
+		ID: azure.ToString(az.ID),
+	}
+	if az.ElasticPoolProperties != nil {
+		o.State = string(az.State)
+	}
+	return o
+}
+
+// LateInitializeElasticPool fills the spec values that user did not fill with
+// their corresponding value in the Azure, if there is any.
+func LateInitializeElasticPool(p *v1alpha1.ElasticPoolParameters, az sql.ElasticPool) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, az.Tags)
+	if az.Sku != nil {
+		lateInitializeSKU(&p.SKU, *az.Sku)
+	}
+	if az.ElasticPoolProperties == nil {
+		return
+	}
+	p.MaxSizeBytes = azure.LateInitializeInt64PtrFromInt64Ptr(p.MaxSizeBytes, az.MaxSizeBytes)
+	p.ZoneRedundant = azure.LateInitializeBoolPtrFromPtr(p.ZoneRedundant, az.ZoneRedundant)
+	if p.LicenseType == nil && az.LicenseType != "" {
+		p.LicenseType = azure.ToStringPtr(string(az.LicenseType))
+	}
+	if s := az.PerDatabaseSettings; s != nil {
+		if p.PerDatabaseSettings == nil {
+			p.PerDatabaseSettings = &v1alpha1.ElasticPoolPerDatabaseSettings{}
+		}
+		if p.PerDatabaseSettings.MinCapacity == nil {
+			p.PerDatabaseSettings.MinCapacity = generateCapacity(s.MinCapacity)
+		}
+		if p.PerDatabaseSettings.MaxCapacity == nil {
+			p.PerDatabaseSettings.MaxCapacity = generateCapacity(s.MaxCapacity)
+		}
+	}
+}
+
+// IsElasticPoolUpToDate returns true if the supplied Azure SQL elastic pool
+// matches the supplied ElasticPoolParameters.
+func IsElasticPoolUpToDate(p v1alpha1.ElasticPoolParameters, az sql.ElasticPool) bool {
+	if az.ElasticPoolProperties == nil {
+		return false
+	}
+	observed := v1alpha1.ElasticPoolParameters{
+		Tags:          azure.ToStringMap(az.Tags),
+		MaxSizeBytes:  az.MaxSizeBytes,
+		ZoneRedundant: az.ZoneRedundant,
+	}
+	if sku := generateSKU(az.Sku); sku != nil {
+		observed.SKU = *sku
+	}
+	if az.LicenseType != "" {
+		observed.LicenseType = azure.ToStringPtr(string(az.LicenseType))
+	}
+	if s := az.PerDatabaseSettings; s != nil {
+		observed.PerDatabaseSettings = &v1alpha1.ElasticPoolPerDatabaseSettings{
+			MinCapacity: generateCapacity(s.MinCapacity),
+			MaxCapacity: generateCapacity(s.MaxCapacity),
+		}
+	}
+	return cmp.Equal(observed, p,
+		cmpopts.EquateEmpty(),
+		equateQuantities(),
+		cmpopts.IgnoreFields(v1alpha1.ElasticPoolParameters{}, "SubscriptionID", "ResourceGroupName", "ResourceGroupNameRef", "ResourceGroupNameSelector",
+			"ServerName", "ServerNameRef", "ServerNameSelector", "Location"))
+}
+
+// NewDatabaseParameters returns the parameters used to create an Azure SQL
+// database from the supplied SQLDatabaseParameters.
+func NewDatabaseParameters(p v1alpha1.SQLDatabaseParameters) sql.Database {
+	return sql.Database{
+		Location: azure.ToStringPtr(p.Location),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+		Sku:      newSKU(p.SKU),
+		DatabaseProperties: &sql.DatabaseProperties{
+			Collation:     p.Collation,
+			MaxSizeBytes:  p.MaxSizeBytes,
+			ElasticPoolID: p.ElasticPoolID,
+			ZoneRedundant: p.ZoneRedundant,
+			LicenseType:   sql.DatabaseLicenseType(azure.ToString(p.LicenseType)),
+		},
+	}
+}
+
+// NewDatabaseUpdate returns the parameters used to update an Azure SQL
+// database from the supplied SQLDatabaseParameters.
+func NewDatabaseUpdate(p v1alpha1.SQLDatabaseParameters) sql.DatabaseUpdate {
+	return sql.DatabaseUpdate{
+		Tags: azure.ToStringPtrMap(p.Tags),
+		Sku:  newSKU(p.SKU),
+		DatabaseProperties: &sql.DatabaseProperties{
+			MaxSizeBytes:  p.MaxSizeBytes,
+			ElasticPoolID: p.ElasticPoolID,
+			ZoneRedundant: p.ZoneRedundant,
+			LicenseType:   sql.DatabaseLicenseType(azure.ToString(p.LicenseType)),
+		},
+	}
+}
+
+// GenerateDatabaseObservation produces a SQLDatabaseObservation from the
+// supplied Azure SQL database.
+func GenerateDatabaseObservation(az sql.Database) v1alpha1.SQLDatabaseObservation {
+	o := v1alpha1.SQLDatabaseObservation{
+		ID: azure.ToString(az.ID),
+	}
+	if az.DatabaseProperties != nil {
+		o.Status = string(az.Status)
+		o.CurrentServiceObjectiveName = azure.ToString(az.CurrentServiceObjectiveName)
+		if az.DatabaseID != nil {
+			o.DatabaseID = az.DatabaseID.String()
+		}
+	}
+	return o
+}
+
+// LateInitializeDatabase fills the spec values that user did not fill with
+// their corresponding value in the Azure, if there is any.
+func LateInitializeDatabase(p *v1alpha1.SQLDatabaseParameters, az sql.Database) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, az.Tags)
+	if az.Sku != nil {
+		if p.SKU == nil {
+			p.SKU = &v1alpha1.SKU{}
+		}
+		lateInitializeSKU(p.SKU, *az.Sku)
+	}
+	if az.DatabaseProperties == nil {
+		return
+	}
+	p.Collation = azure.LateInitializeStringPtrFromPtr(p.Collation, az.Collation)
+	p.MaxSizeBytes = azure.LateInitializeInt64PtrFromInt64Ptr(p.MaxSizeBytes, az.MaxSizeBytes)
+	p.ZoneRedundant = azure.LateInitializeBoolPtrFromPtr(p.ZoneRedundant, az.ZoneRedundant)
+	if p.LicenseType == nil && az.LicenseType != "" {
+		p.LicenseType = azure.ToStringPtr(string(az.LicenseType))
+	}
+}
+
+// IsDatabaseUpToDate returns true if the supplied Azure SQL database matches
+// the supplied SQLDatabaseParameters.
+func IsDatabaseUpToDate(p v1alpha1.SQLDatabaseParameters, az sql.Database) bool {
+	if az.DatabaseProperties == nil {
+		return false
+	}
+	observed := v1alpha1.SQLDatabaseParameters{
+		Tags:          azure.ToStringMap(az.Tags),
+		SKU:           generateSKU(az.Sku),
+		MaxSizeBytes:  az.MaxSizeBytes,
+		ZoneRedundant: az.ZoneRedundant,
+	}
+	if az.LicenseType != "" {
+		observed.LicenseType = azure.ToStringPtr(string(az.LicenseType))
+	}
+	// Azure does not preserve the case of resource IDs.
+	if !strings.EqualFold(azure.ToString(p.ElasticPoolID), azure.ToString(az.ElasticPoolID)) {
+		return false
+	}
+	return cmp.Equal(observed, p,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.SQLDatabaseParameters{}, "SubscriptionID", "ResourceGroupName", "ResourceGroupNameRef", "ResourceGroupNameSelector",
+			"ServerName", "ServerNameRef", "ServerNameSelector", "Location",
+			"ElasticPoolID", "ElasticPoolIDRef", "ElasticPoolIDSelector", "Collation"))
+}
+
+func newSKU(s *v1alpha1.SKU) *sql.Sku {
+	if s == nil {
+		return nil
+	}
+	return &sql.Sku{
+		Name:     azure.ToStringPtr(s.Name),
+		Tier:     s.Tier,
+		Family:   s.Family,
+		Capacity: s.Capacity,
+	}
+}
+
+func generateSKU(s *sql.Sku) *v1alpha1.SKU {
+	if s == nil {
+		return nil
+	}
+	return &v1alpha1.SKU{
+		Name:     azure.ToString(s.Name),
+		Tier:     s.Tier,
+		Family:   s.Family,
+		Capacity: s.Capacity,
+	}
+}
+
+// lateInitializeSKU late-initializes the supplied SKU. Its name is only
+// late-initialized when it is empty, i.e. when no SKU was specified.
+func lateInitializeSKU(s *v1alpha1.SKU, from sql.Sku) {
+	if s.Name == "" {
+		s.Name = azure.ToString(from.Name)
+	}
+	s.Tier = azure.LateInitializeStringPtrFromPtr(s.Tier, from.Tier)
+	s.Family = azure.LateInitializeStringPtrFromPtr(s.Family, from.Family)
+	s.Capacity = azure.LateInitializeInt32PtrFromInt32Ptr(s.Capacity, from.Capacity)
+}
+
+func newPerDatabaseSettings(s *v1alpha1.ElasticPoolPerDatabaseSettings) *sql.ElasticPoolPerDatabaseSettings {
+	if s == nil {
+		return nil
+	}
+	return &sql.ElasticPoolPerDatabaseSettings{
+		MinCapacity: newCapacity(s.MinCapacity),
+		MaxCapacity: newCapacity(s.MaxCapacity),
+	}
+}
+
+func newCapacity(q *resource.Quantity) *float64 {
+	if q == nil {
+		return nil
+	}
+	f := q.AsApproximateFloat64()
+	return &f
+}
+
+func generateCapacity(f *float64) *resource.Quantity {
+	if f == nil {
+		return nil
+	}
+	q, err := resource.ParseQuantity(strconv.FormatFloat(*f, 'f', -1, 64))
+	if err != nil {
+		return nil
+	}
+	return &q
+}
+
+// equateQuantities returns a cmp option that considers quantities that
+// represent the same value, e.g. 0.5 and 500m, equal.
+func equateQuantities() cmp.Option {
+	return cmp.Comparer(func(a, b resource.Quantity) bool { return a.Cmp(b) == 0 })
+}
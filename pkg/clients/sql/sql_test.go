@@ -0,0 +1,310 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v4.0/sql"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/sql/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const elasticPoolID = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Sql/servers/coolserver/elasticPools/coolpool"
+
+func quantity(s string) *resource.Quantity {
+	q := resource.MustParse(s)
+	return &q
+}
+
+func TestNewServerParameters(t *testing.T) {
+	p := v1alpha1.SQLServerParameters{
+		Location:            "westeurope",
+		AdministratorLogin:  "crossplane",
+		Version:             azure.ToStringPtr("12.0"),
+		MinimalTLSVersion:   azure.ToStringPtr("1.2"),
+		PublicNetworkAccess: azure.ToStringPtr("Disabled"),
+		Tags:                map[string]string{"created_by": "crossplane"},
+	}
+	want := sql.Server{
+		Location: azure.ToStringPtr("westeurope"),
+		Tags:     map[string]*string{"created_by": azure.ToStringPtr("crossplane")},
+		ServerProperties: &sql.ServerProperties{
+			AdministratorLogin:         azure.ToStringPtr("crossplane"),
+			AdministratorLoginPassword: azure.ToStringPtr("verysecret"),
+			Version:                    azure.ToStringPtr("12.0"),
+			MinimalTLSVersion:          azure.ToStringPtr("1.2"),
+			PublicNetworkAccess:        sql.ServerPublicNetworkAccessDisabled,
+		},
+	}
+	if diff := cmp.Diff(want, NewServerParameters(p, "verysecret")); diff != "" {
+		t.Errorf("NewServerParameters(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsServerUpToDate(t *testing.T) {
+	az := sql.Server{
+		ServerProperties: &sql.ServerProperties{
+			Version:             azure.ToStringPtr("12.0"),
+			MinimalTLSVersion:   azure.ToStringPtr("1.2"),
+			PublicNetworkAccess: sql.ServerPublicNetworkAccessEnabled,
+		},
+		Tags: map[string]*string{"created_by": azure.ToStringPtr("crossplane")},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.SQLServerParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.SQLServerParameters{
+				SubscriptionID:      azure.ToStringPtr("other"),
+				Location:            "westeurope",
+				AdministratorLogin:  "crossplane",
+				Version:             azure.ToStringPtr("12.0"),
+				MinimalTLSVersion:   azure.ToStringPtr("1.2"),
+				PublicNetworkAccess: azure.ToStringPtr("Enabled"),
+				Tags:                map[string]string{"created_by": "crossplane"},
+			},
+			want: true,
+		},
+		"MinimalTLSVersionChanged": {
+			p: v1alpha1.SQLServerParameters{
+				MinimalTLSVersion:   azure.ToStringPtr("1.1"),
+				PublicNetworkAccess: azure.ToStringPtr("Enabled"),
+				Tags:                map[string]string{"created_by": "crossplane"},
+			},
+			want: false,
+		},
+		"PublicNetworkAccessChanged": {
+			p: v1alpha1.SQLServerParameters{
+				MinimalTLSVersion:   azure.ToStringPtr("1.2"),
+				PublicNetworkAccess: azure.ToStringPtr("Disabled"),
+				Tags:                map[string]string{"created_by": "crossplane"},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsServerUpToDate(tc.p, az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsServerUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewElasticPoolParameters(t *testing.T) {
+	p := v1alpha1.ElasticPoolParameters{
+		Location: "westeurope",
+		SKU:      v1alpha1.SKU{Name: "GP_Gen5", Tier: azure.ToStringPtr("GeneralPurpose"), Family: azure.ToStringPtr("Gen5"), Capacity: azure.ToInt32Ptr(2)},
+		PerDatabaseSettings: &v1alpha1.ElasticPoolPerDatabaseSettings{
+			MinCapacity: quantity("250m"),
+			MaxCapacity: quantity("2"),
+		},
+		MaxSizeBytes:  to.Int64Ptr(34359738368),
+		ZoneRedundant: azure.ToBoolPtr(true),
+		LicenseType:   azure.ToStringPtr("LicenseIncluded"),
+	}
+	want := sql.ElasticPool{
+		Location: azure.ToStringPtr("westeurope"),
+		Sku:      &sql.Sku{Name: azure.ToStringPtr("GP_Gen5"), Tier: azure.ToStringPtr("GeneralPurpose"), Family: azure.ToStringPtr("Gen5"), Capacity: to.Int32Ptr(2)},
+		ElasticPoolProperties: &sql.ElasticPoolProperties{
+			MaxSizeBytes:        to.Int64Ptr(34359738368),
+			PerDatabaseSettings: &sql.ElasticPoolPerDatabaseSettings{MinCapacity: to.Float64Ptr(0.25), MaxCapacity: to.Float64Ptr(2)},
+			ZoneRedundant:       azure.ToBoolPtr(true),
+			LicenseType:         sql.ElasticPoolLicenseTypeLicenseIncluded,
+		},
+	}
+	if diff := cmp.Diff(want, NewElasticPoolParameters(p)); diff != "" {
+		t.Errorf("NewElasticPoolParameters(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeElasticPool(t *testing.T) {
+	az := sql.ElasticPool{
+		Sku: &sql.Sku{Name: azure.ToStringPtr("StandardPool"), Tier: azure.ToStringPtr("Standard"), Capacity: to.Int32Ptr(50)},
+		ElasticPoolProperties: &sql.ElasticPoolProperties{
+			MaxSizeBytes:        to.Int64Ptr(5368709120),
+			PerDatabaseSettings: &sql.ElasticPoolPerDatabaseSettings{MinCapacity: to.Float64Ptr(0), MaxCapacity: to.Float64Ptr(50)},
+			ZoneRedundant:       azure.ToBoolPtr(false, azure.FieldRequired),
+			LicenseType:         sql.ElasticPoolLicenseTypeLicenseIncluded,
+		},
+	}
+	p := v1alpha1.ElasticPoolParameters{
+		SKU:                 v1alpha1.SKU{Name: "StandardPool", Capacity: azure.ToInt32Ptr(100)},
+		PerDatabaseSettings: &v1alpha1.ElasticPoolPerDatabaseSettings{MaxCapacity: quantity("10")},
+	}
+	want := v1alpha1.ElasticPoolParameters{
+		SKU:                 v1alpha1.SKU{Name: "StandardPool", Tier: azure.ToStringPtr("Standard"), Capacity: azure.ToInt32Ptr(100)},
+		PerDatabaseSettings: &v1alpha1.ElasticPoolPerDatabaseSettings{MinCapacity: quantity("0"), MaxCapacity: quantity("10")},
+		MaxSizeBytes:        to.Int64Ptr(5368709120),
+		ZoneRedundant:       azure.ToBoolPtr(false, azure.FieldRequired),
+		LicenseType:         azure.ToStringPtr("LicenseIncluded"),
+	}
+	LateInitializeElasticPool(&p, az)
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeElasticPool(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsElasticPoolUpToDate(t *testing.T) {
+	az := sql.ElasticPool{
+		Sku: &sql.Sku{Name: azure.ToStringPtr("GP_Gen5"), Tier: azure.ToStringPtr("GeneralPurpose"), Family: azure.ToStringPtr("Gen5"), Capacity: to.Int32Ptr(2)},
+		ElasticPoolProperties: &sql.ElasticPoolProperties{
+			MaxSizeBytes:        to.Int64Ptr(34359738368),
+			PerDatabaseSettings: &sql.ElasticPoolPerDatabaseSettings{MinCapacity: to.Float64Ptr(0.5), MaxCapacity: to.Float64Ptr(2)},
+		},
+	}
+	sku := v1alpha1.SKU{Name: "GP_Gen5", Tier: azure.ToStringPtr("GeneralPurpose"), Family: azure.ToStringPtr("Gen5"), Capacity: azure.ToInt32Ptr(2)}
+
+	cases := map[string]struct {
+		p    v1alpha1.ElasticPoolParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.ElasticPoolParameters{
+				Location:            "westeurope",
+				SKU:                 sku,
+				MaxSizeBytes:        to.Int64Ptr(34359738368),
+				PerDatabaseSettings: &v1alpha1.ElasticPoolPerDatabaseSettings{MinCapacity: quantity("500m"), MaxCapacity: quantity("2")},
+			},
+			want: true,
+		},
+		"CapacityChanged": {
+			p: v1alpha1.ElasticPoolParameters{
+				SKU:                 v1alpha1.SKU{Name: "GP_Gen5", Tier: azure.ToStringPtr("GeneralPurpose"), Family: azure.ToStringPtr("Gen5"), Capacity: azure.ToInt32Ptr(4)},
+				MaxSizeBytes:        to.Int64Ptr(34359738368),
+				PerDatabaseSettings: &v1alpha1.ElasticPoolPerDatabaseSettings{MinCapacity: quantity("0.5"), MaxCapacity: quantity("2")},
+			},
+			want: false,
+		},
+		"PerDatabaseSettingsChanged": {
+			p: v1alpha1.ElasticPoolParameters{
+				SKU:                 sku,
+				MaxSizeBytes:        to.Int64Ptr(34359738368),
+				PerDatabaseSettings: &v1alpha1.ElasticPoolPerDatabaseSettings{MinCapacity: quantity("0.5"), MaxCapacity: quantity("1")},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsElasticPoolUpToDate(tc.p, az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsElasticPoolUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeDatabase(t *testing.T) {
+	az := sql.Database{
+		Sku: &sql.Sku{Name: azure.ToStringPtr("ElasticPool"), Tier: azure.ToStringPtr("GeneralPurpose")},
+		DatabaseProperties: &sql.DatabaseProperties{
+			Collation:     azure.ToStringPtr("SQL_Latin1_General_CP1_CI_AS"),
+			MaxSizeBytes:  to.Int64Ptr(34359738368),
+			ZoneRedundant: azure.ToBoolPtr(false, azure.FieldRequired),
+		},
+	}
+	p := v1alpha1.SQLDatabaseParameters{ElasticPoolID: azure.ToStringPtr(elasticPoolID)}
+	want := v1alpha1.SQLDatabaseParameters{
+		ElasticPoolID: azure.ToStringPtr(elasticPoolID),
+		SKU:           &v1alpha1.SKU{Name: "ElasticPool", Tier: azure.ToStringPtr("GeneralPurpose")},
+		Collation:     azure.ToStringPtr("SQL_Latin1_General_CP1_CI_AS"),
+		MaxSizeBytes:  to.Int64Ptr(34359738368),
+		ZoneRedundant: azure.ToBoolPtr(false, azure.FieldRequired),
+	}
+	LateInitializeDatabase(&p, az)
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeDatabase(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsDatabaseUpToDate(t *testing.T) {
+	single := sql.Database{
+		Sku: &sql.Sku{Name: azure.ToStringPtr("S0"), Tier: azure.ToStringPtr("Standard"), Capacity: to.Int32Ptr(10)},
+		DatabaseProperties: &sql.DatabaseProperties{
+			Collation:    azure.ToStringPtr("SQL_Latin1_General_CP1_CI_AS"),
+			MaxSizeBytes: to.Int64Ptr(268435456000),
+		},
+	}
+	pooled := sql.Database{
+		Sku: &sql.Sku{Name: azure.ToStringPtr("ElasticPool"), Tier: azure.ToStringPtr("Standard")},
+		DatabaseProperties: &sql.DatabaseProperties{
+			ElasticPoolID: azure.ToStringPtr("/subscriptions/sub/resourcegroups/coolrg/providers/microsoft.sql/servers/coolserver/elasticpools/coolpool"),
+			MaxSizeBytes:  to.Int64Ptr(268435456000),
+		},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.SQLDatabaseParameters
+		az   sql.Database
+		want bool
+	}{
+		"SingleUpToDate": {
+			p: v1alpha1.SQLDatabaseParameters{
+				SKU:          &v1alpha1.SKU{Name: "S0", Tier: azure.ToStringPtr("Standard"), Capacity: azure.ToInt32Ptr(10)},
+				MaxSizeBytes: to.Int64Ptr(268435456000),
+			},
+			az:   single,
+			want: true,
+		},
+		"SingleSKUChanged": {
+			p: v1alpha1.SQLDatabaseParameters{
+				SKU:          &v1alpha1.SKU{Name: "S1", Tier: azure.ToStringPtr("Standard"), Capacity: azure.ToInt32Ptr(20)},
+				MaxSizeBytes: to.Int64Ptr(268435456000),
+			},
+			az:   single,
+			want: false,
+		},
+		"PooledIDCaseDiffers": {
+			p: v1alpha1.SQLDatabaseParameters{
+				ElasticPoolID: azure.ToStringPtr(elasticPoolID),
+				SKU:           &v1alpha1.SKU{Name: "ElasticPool", Tier: azure.ToStringPtr("Standard")},
+				MaxSizeBytes:  to.Int64Ptr(268435456000),
+			},
+			az:   pooled,
+			want: true,
+		},
+		"MovedIntoPool": {
+			p: v1alpha1.SQLDatabaseParameters{
+				ElasticPoolID: azure.ToStringPtr(elasticPoolID),
+				SKU:           &v1alpha1.SKU{Name: "S0", Tier: azure.ToStringPtr("Standard"), Capacity: azure.ToInt32Ptr(10)},
+				MaxSizeBytes:  to.Int64Ptr(268435456000),
+			},
+			az:   single,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDatabaseUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsDatabaseUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
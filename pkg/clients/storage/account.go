@@ -18,34 +18,53 @@ package storage
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-06-01/storage"
-	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/Azure/go-autorest/autorest"
+	azureautorest "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/pkg/errors"
 
+	storagev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 )
 
+// Keys of the connection secrets of storage accounts, in addition to the
+// ones common to all resources. The primary and secondary access keys are not
+// published for accounts whose keys are rotated.
+const (
+	ConnectionSecretKeyPrimaryAccessKey   = "primaryAccessKey"
+	ConnectionSecretKeySecondaryAccessKey = "secondaryAccessKey"
+	ConnectionSecretKeySASToken           = "sasToken"
+)
+
+// Defaults of shared access signature tokens.
+const (
+	DefaultSASPermissions = storage.R + storage.L
+	DefaultSASValidity    = 24 * time.Hour
+)
+
 // NewStorageAccountClient create Azure storage.AccountClient using provided credentials data
 func NewStorageAccountClient(data []byte) (*storage.AccountsClient, error) {
-	creds := &azure.Credentials{}
-	if err := json.Unmarshal(data, creds); err != nil {
+	creds := map[string]string{}
+	if err := json.Unmarshal(data, &creds); err != nil {
 		return nil, errors.Wrap(err, "cannot unmarshal Azure client secret data")
 	}
 
-	config := auth.NewClientCredentialsConfig(creds.ClientID, creds.ClientSecret, creds.TenantID)
-	config.AADEndpoint = creds.ActiveDirectoryEndpointURL
-	config.Resource = creds.ResourceManagerEndpointURL
-
-	authorizer, err := config.Authorizer()
+	authorizer, err := azure.NewAuthorizer(creds)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get authorizer from config: %+v", err)
 	}
 
-	client := storage.NewAccountsClient(creds.SubscriptionID)
+	client := storage.NewAccountsClient(creds[azure.CredentialsKeySubscriptionID])
 	client.Authorizer = authorizer
 
 	if err := client.AddToUserAgent(azure.UserAgent); err != nil {
@@ -56,12 +75,17 @@ func NewStorageAccountClient(data []byte) (*storage.AccountsClient, error) {
 
 // AccountOperations Azure storate account interface
 type AccountOperations interface {
-	Create(context.Context, storage.AccountCreateParameters) (*storage.Account, error)
+	Create(context.Context, storage.AccountCreateParameters) (v1alpha3.AsyncOperation, error)
 	Update(context.Context, storage.AccountUpdateParameters) (*storage.Account, error)
 	Get(ctx context.Context) (*storage.Account, error)
 	Delete(ctx context.Context) error
 	IsAccountNameAvailable(context.Context, string) error
 	ListKeys(context.Context) ([]storage.AccountKey, error)
+	ListSAS(context.Context, storagev1alpha3.SharedAccessSignatureParameters, time.Time) (string, error)
+	RegenerateKey(context.Context, string) error
+	GetPublicNetworkAccess(context.Context) (string, error)
+	SetPublicNetworkAccess(context.Context, string) error
+	GetRESTClient() autorest.Sender
 }
 
 // AccountHandle implements AccountOperations interface
@@ -82,27 +106,20 @@ func NewAccountHandle(client *storage.AccountsClient, groupName, accountName str
 	}
 }
 
-// Create create new storage account with given location
-func (a *AccountHandle) Create(ctx context.Context, params storage.AccountCreateParameters) (*storage.Account, error) {
+// Create starts creating a new storage account with given location. It does
+// not wait for the creation to complete, but returns the operation so that
+// its progress can be persisted and polled.
+func (a *AccountHandle) Create(ctx context.Context, params storage.AccountCreateParameters) (v1alpha3.AsyncOperation, error) {
 	if err := a.IsAccountNameAvailable(ctx, a.accountName); err != nil {
-		return nil, errors.Wrapf(err, "failed to check account name availability")
+		return v1alpha3.AsyncOperation{}, errors.Wrapf(err, "failed to check account name availability")
 	}
 
 	future, err := a.client.Create(ctx, a.groupName, a.accountName, params)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to start creating storage account")
+		return v1alpha3.AsyncOperation{}, errors.Wrapf(err, "failed to start creating storage account")
 	}
 
-	err = future.WaitForCompletionRef(ctx, a.client.Client)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to finish creating storage account")
-	}
-
-	acct, err := future.Result(*a.client)
-	if err != nil {
-		return nil, err
-	}
-	return &acct, nil
+	return azure.NewAsyncOperation(http.MethodPut, future.FutureAPI), nil
 }
 
 // Update create new storage account with given location
@@ -157,3 +174,140 @@ func (a *AccountHandle) ListKeys(ctx context.Context) ([]storage.AccountKey, err
 
 	return *rs.Keys, nil
 }
+
+// RegenerateKey regenerates the supplied access key, i.e. either
+// v1alpha3.CredentialKeyPrimary or v1alpha3.CredentialKeySecondary,
+// of this storage account.
+func (a *AccountHandle) RegenerateKey(ctx context.Context, key string) error {
+	name := "key1"
+	if key == v1alpha3.CredentialKeySecondary {
+		name = "key2"
+	}
+	_, err := a.client.RegenerateKey(ctx, a.groupName, a.accountName, storage.AccountRegenerateKeyParameters{KeyName: to.StringPtr(name)})
+	return err
+}
+
+// networkAccessAPIVersion is the storage API version used to get and set the
+// public network access setting of an account. The SDK version this package
+// uses predates the setting.
+const networkAccessAPIVersion = "2021-04-01"
+
+// accountNetworkAccess is the subset of a storage account that holds its
+// public network access setting.
+type accountNetworkAccess struct {
+	Properties struct {
+		PublicNetworkAccess string `json:"publicNetworkAccess,omitempty"`
+	} `json:"properties"`
+}
+
+// networkAccessRequest prepares a request for this storage account, using the
+// API version that supports the public network access setting.
+func (a *AccountHandle) networkAccessRequest(ctx context.Context, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	path := map[string]interface{}{
+		"accountName":       autorest.Encode("path", a.accountName),
+		"resourceGroupName": autorest.Encode("path", a.groupName),
+		"subscriptionId":    autorest.Encode("path", a.client.SubscriptionID),
+	}
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.WithBaseURL(a.client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{accountName}", path),
+		autorest.WithQueryParameters(map[string]interface{}{"api-version": networkAccessAPIVersion}),
+	}, decorators...)
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetPublicNetworkAccess returns whether this storage account can be reached
+// over the public internet, i.e. Enabled or Disabled. Azure omits the setting
+// of accounts it was never set for, which are reachable.
+func (a *AccountHandle) GetPublicNetworkAccess(ctx context.Context) (string, error) {
+	req, err := a.networkAccessRequest(ctx, autorest.AsGet())
+	if err != nil {
+		return "", err
+	}
+	resp, err := a.client.Send(req, azureautorest.DoRetryWithRegistration(a.client.Client))
+	if err != nil {
+		return "", err
+	}
+	acct := accountNetworkAccess{}
+	err = autorest.Respond(resp,
+		azureautorest.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&acct),
+		autorest.ByClosing())
+	return acct.Properties.PublicNetworkAccess, err
+}
+
+// SetPublicNetworkAccess sets whether this storage account can be reached over
+// the public internet, i.e. Enabled or Disabled. Other properties of the
+// account are left unchanged.
+func (a *AccountHandle) SetPublicNetworkAccess(ctx context.Context, access string) error {
+	acct := accountNetworkAccess{}
+	acct.Properties.PublicNetworkAccess = access
+	req, err := a.networkAccessRequest(ctx,
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithJSON(acct))
+	if err != nil {
+		return err
+	}
+	resp, err := a.client.Send(req, azureautorest.DoRetryWithRegistration(a.client.Client))
+	if err != nil {
+		return err
+	}
+	return autorest.Respond(resp,
+		azureautorest.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+}
+
+// ListSAS returns a shared access signature token with the supplied
+// parameters for this storage account, or the supplied container thereof,
+// that expires at the supplied time.
+func (a *AccountHandle) ListSAS(ctx context.Context, p storagev1alpha3.SharedAccessSignatureParameters, expiry time.Time) (string, error) {
+	perms := storage.Permissions(p.Permissions)
+	if perms == "" {
+		perms = DefaultSASPermissions
+	}
+	exp := &date.Time{Time: expiry}
+
+	if p.Container == "" {
+		rs, err := a.client.ListAccountSAS(ctx, a.groupName, a.accountName, storage.AccountSasParameters{
+			Services:               storage.B,
+			ResourceTypes:          storage.SignedResourceTypesS + storage.SignedResourceTypesC + storage.SignedResourceTypesO,
+			Permissions:            perms,
+			Protocols:              storage.HTTPS,
+			SharedAccessExpiryTime: exp,
+		})
+		return to.String(rs.AccountSasToken), err
+	}
+	rs, err := a.client.ListServiceSAS(ctx, a.groupName, a.accountName, storage.ServiceSasParameters{
+		CanonicalizedResource:  to.StringPtr(fmt.Sprintf("/blob/%s/%s", a.accountName, p.Container)),
+		Resource:               storage.SignedResourceC,
+		Permissions:            perms,
+		Protocols:              storage.HTTPS,
+		SharedAccessExpiryTime: exp,
+	})
+	return to.String(rs.ServiceSasToken), err
+}
+
+// SASValidity returns how long shared access signature tokens with the
+// supplied parameters should be valid for.
+func SASValidity(p storagev1alpha3.SharedAccessSignatureParameters) time.Duration {
+	if p.Validity == nil || p.Validity.Duration <= 0 {
+		return DefaultSASValidity
+	}
+	return p.Validity.Duration
+}
+
+// SASParametersHash returns a hash of the supplied shared access signature
+// parameters, which identifies the tokens that were generated with them.
+func SASParametersHash(p storagev1alpha3.SharedAccessSignatureParameters) string {
+	// Marshalling a struct of strings and durations cannot fail.
+	b, _ := json.Marshal(p) // nolint:errchkjson
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
+
+// GetRESTClient returns the underlying REST client that the storage account
+// client uses.
+func (a *AccountHandle) GetRESTClient() autorest.Sender {
+	return a.client.Client
+}
@@ -17,13 +17,21 @@ limitations under the License.
 package storage
 
 import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-06-01/storage"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	storagev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
 )
 
 func TestNewStorageAccountClient(t *testing.T) {
@@ -105,3 +113,75 @@ func TestNewAccountHandle(t *testing.T) {
 		})
 	}
 }
+
+func TestPublicNetworkAccess(t *testing.T) {
+	const path = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/acct"
+
+	var patched string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path || r.URL.Query().Get("api-version") != networkAccessAPIVersion {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"properties":{"publicNetworkAccess":"Enabled"}}`))
+		case http.MethodPatch:
+			b, _ := io.ReadAll(r.Body)
+			patched = string(b)
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer srv.Close()
+
+	cl := storage.NewAccountsClientWithBaseURI(srv.URL, "sub")
+	h := NewAccountHandle(&cl, "rg", "acct")
+
+	got, err := h.GetPublicNetworkAccess(context.Background())
+	if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+		t.Errorf("GetPublicNetworkAccess(...): -want error, +got error:\n%s", diff)
+	}
+	if diff := cmp.Diff("Enabled", got); diff != "" {
+		t.Errorf("GetPublicNetworkAccess(...): -want, +got:\n%s", diff)
+	}
+
+	err = h.SetPublicNetworkAccess(context.Background(), "Disabled")
+	if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+		t.Errorf("SetPublicNetworkAccess(...): -want error, +got error:\n%s", diff)
+	}
+	if diff := cmp.Diff(`{"properties":{"publicNetworkAccess":"Disabled"}}`, patched); diff != "" {
+		t.Errorf("SetPublicNetworkAccess(...): -want body, +got body:\n%s", diff)
+	}
+}
+
+func TestSASParametersHash(t *testing.T) {
+	day := &metav1.Duration{Duration: 24 * time.Hour}
+	base := storagev1alpha3.SharedAccessSignatureParameters{Permissions: "rl", Validity: day}
+
+	cases := map[string]struct {
+		p    storagev1alpha3.SharedAccessSignatureParameters
+		same bool
+	}{
+		"Same": {
+			p:    storagev1alpha3.SharedAccessSignatureParameters{Permissions: "rl", Validity: &metav1.Duration{Duration: 24 * time.Hour}},
+			same: true,
+		},
+		"PermissionsChanged": {
+			p: storagev1alpha3.SharedAccessSignatureParameters{Permissions: "rw", Validity: day},
+		},
+		"ContainerChanged": {
+			p: storagev1alpha3.SharedAccessSignatureParameters{Container: "cool", Permissions: "rl", Validity: day},
+		},
+		"ValidityChanged": {
+			p: storagev1alpha3.SharedAccessSignatureParameters{Permissions: "rl", Validity: &metav1.Duration{Duration: time.Hour}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := SASParametersHash(tc.p) == SASParametersHash(base); got != tc.same {
+				t.Errorf("SASParametersHash(...): want same hash %t, got %t", tc.same, got)
+			}
+		})
+	}
+}
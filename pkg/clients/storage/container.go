@@ -47,6 +47,18 @@ const blobFormatString = `https://%s.blob.core.windows.net`
 
 // NewContainerHandle creates a new instance of ContainerHandle for given storage account and given container name
 func NewContainerHandle(accountName, accountKey, containerName string) (*ContainerHandle, error) {
+	return NewContainerHandleWithEndpoint(fmt.Sprintf(blobFormatString, accountName), accountName, accountKey, containerName)
+}
+
+// NewContainerHandleWithEndpoint creates a new instance of ContainerHandle for
+// given storage account and given container name, using the given blob service
+// endpoint, e.g. http://127.0.0.1:10000/devstoreaccount1 for Azurite.
+func NewContainerHandleWithEndpoint(endpoint, accountName, accountKey, containerName string) (*ContainerHandle, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
 	c, err := azblob.NewSharedKeyCredential(accountName, accountKey)
 	if err != nil {
 		return nil, err
@@ -56,7 +68,6 @@ func NewContainerHandle(accountName, accountKey, containerName string) (*Contain
 		Telemetry: azblob.TelemetryOptions{Value: azure.UserAgent},
 	})
 
-	u, _ := url.Parse(fmt.Sprintf(blobFormatString, accountName))
 	service := azblob.NewServiceURL(*u, p)
 
 	return &ContainerHandle{
@@ -18,20 +18,30 @@ package fake
 
 import (
 	"context"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-06-01/storage"
+	"github.com/Azure/go-autorest/autorest"
+
+	storagev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 
 	azurestorage "github.com/crossplane-contrib/provider-azure/pkg/clients/storage"
 )
 
 // MockAccountOperations mock implementation of AccountOperations
 type MockAccountOperations struct {
-	MockCreate                 func(context.Context, storage.AccountCreateParameters) (*storage.Account, error)
+	MockCreate                 func(context.Context, storage.AccountCreateParameters) (v1alpha3.AsyncOperation, error)
 	MockUpdate                 func(context.Context, storage.AccountUpdateParameters) (*storage.Account, error)
 	MockGet                    func(ctx context.Context) (*storage.Account, error)
 	MockDelete                 func(ctx context.Context) error
 	MockIsAccountNameAvailable func(context.Context, string) error
 	MockListKeys               func(context.Context) ([]storage.AccountKey, error)
+	MockListSAS                func(context.Context, storagev1alpha3.SharedAccessSignatureParameters, time.Time) (string, error)
+	MockRegenerateKey          func(context.Context, string) error
+	MockGetPublicNetworkAccess func(context.Context) (string, error)
+	MockSetPublicNetworkAccess func(context.Context, string) error
+	MockGetRESTClient          func() autorest.Sender
 }
 
 var _ azurestorage.AccountOperations = &MockAccountOperations{}
@@ -39,8 +49,8 @@ var _ azurestorage.AccountOperations = &MockAccountOperations{}
 // NewMockAccountOperations returns new mock instance with default mocks
 func NewMockAccountOperations() *MockAccountOperations {
 	return &MockAccountOperations{
-		MockCreate: func(i context.Context, parameters storage.AccountCreateParameters) (account *storage.Account, e error) {
-			return nil, nil
+		MockCreate: func(i context.Context, parameters storage.AccountCreateParameters) (v1alpha3.AsyncOperation, error) {
+			return v1alpha3.AsyncOperation{}, nil
 		},
 		MockUpdate: func(i context.Context, parameters storage.AccountUpdateParameters) (account *storage.Account, e error) {
 			return nil, nil
@@ -57,11 +67,26 @@ func NewMockAccountOperations() *MockAccountOperations {
 		MockListKeys: func(i context.Context) ([]storage.AccountKey, error) {
 			return nil, nil
 		},
+		MockListSAS: func(context.Context, storagev1alpha3.SharedAccessSignatureParameters, time.Time) (string, error) {
+			return "", nil
+		},
+		MockRegenerateKey: func(context.Context, string) error {
+			return nil
+		},
+		MockGetPublicNetworkAccess: func(context.Context) (string, error) {
+			return "", nil
+		},
+		MockSetPublicNetworkAccess: func(context.Context, string) error {
+			return nil
+		},
+		MockGetRESTClient: func() autorest.Sender {
+			return nil
+		},
 	}
 }
 
 // Create mock create
-func (m *MockAccountOperations) Create(ctx context.Context, params storage.AccountCreateParameters) (*storage.Account, error) {
+func (m *MockAccountOperations) Create(ctx context.Context, params storage.AccountCreateParameters) (v1alpha3.AsyncOperation, error) {
 	return m.MockCreate(ctx, params)
 }
 
@@ -89,3 +114,28 @@ func (m *MockAccountOperations) IsAccountNameAvailable(ctx context.Context, name
 func (m *MockAccountOperations) ListKeys(ctx context.Context) ([]storage.AccountKey, error) {
 	return m.MockListKeys(ctx)
 }
+
+// ListSAS mock list shared access signature
+func (m *MockAccountOperations) ListSAS(ctx context.Context, p storagev1alpha3.SharedAccessSignatureParameters, expiry time.Time) (string, error) {
+	return m.MockListSAS(ctx, p, expiry)
+}
+
+// RegenerateKey mock regenerate key
+func (m *MockAccountOperations) RegenerateKey(ctx context.Context, key string) error {
+	return m.MockRegenerateKey(ctx, key)
+}
+
+// GetPublicNetworkAccess mock get public network access
+func (m *MockAccountOperations) GetPublicNetworkAccess(ctx context.Context) (string, error) {
+	return m.MockGetPublicNetworkAccess(ctx)
+}
+
+// SetPublicNetworkAccess mock set public network access
+func (m *MockAccountOperations) SetPublicNetworkAccess(ctx context.Context, access string) error {
+	return m.MockSetPublicNetworkAccess(ctx, access)
+}
+
+// GetRESTClient mock get REST client
+func (m *MockAccountOperations) GetRESTClient() autorest.Sender {
+	return m.MockGetRESTClient()
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// Keys of the connection secrets of file shares. They match the keys the
+// Azure Files CSI driver expects, so that the connection secret may be used
+// as the node stage secret of a persistent volume.
+const (
+	ConnectionSecretKeyAzureStorageAccountName = "azurestorageaccountname"
+	ConnectionSecretKeyAzureStorageAccountKey  = "azurestorageaccountkey"
+)
+
+// NewFileShare returns an Azure Files share that matches the supplied
+// parameters.
+func NewFileShare(p v1alpha3.FileShareParameters) storage.FileShare {
+	return storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			Metadata:         azure.ToStringPtrMap(p.Metadata),
+			ShareQuota:       p.ShareQuota,
+			EnabledProtocols: storage.EnabledProtocols(azure.ToString(p.EnabledProtocols)),
+			AccessTier:       storage.ShareAccessTier(azure.ToString(p.AccessTier)),
+		},
+	}
+}
+
+// NewFileShareUpdate returns an update of an Azure Files share to the
+// supplied parameters. The protocols that serve a share can only be set
+// when it is created, so they are omitted.
+func NewFileShareUpdate(p v1alpha3.FileShareParameters) storage.FileShare {
+	fs := NewFileShare(p)
+	fs.EnabledProtocols = ""
+	return fs
+}
+
+// GenerateFileShareObservation returns the observed state of the supplied
+// Azure Files share.
+func GenerateFileShareObservation(az storage.FileShare) v1alpha3.FileShareObservation {
+	o := v1alpha3.FileShareObservation{ID: azure.ToString(az.ID)}
+	if az.FileShareProperties != nil {
+		o.ShareUsageBytes = az.ShareUsageBytes
+	}
+	return o
+}
+
+// LateInitializeFileShare fills the empty fields of the supplied parameters
+// with the values of the supplied Azure Files share.
+func LateInitializeFileShare(p *v1alpha3.FileShareParameters, az storage.FileShare) {
+	if az.FileShareProperties == nil {
+		return
+	}
+	p.ShareQuota = azure.LateInitializeInt32PtrFromInt32Ptr(p.ShareQuota, az.ShareQuota)
+	if az.EnabledProtocols != "" {
+		p.EnabledProtocols = azure.LateInitializeStringPtrFromVal(p.EnabledProtocols, string(az.EnabledProtocols))
+	}
+	if az.AccessTier != "" {
+		p.AccessTier = azure.LateInitializeStringPtrFromVal(p.AccessTier, string(az.AccessTier))
+	}
+	p.Metadata = azure.LateInitializeStringMap(p.Metadata, az.Metadata)
+}
+
+// IsFileShareUpToDate returns true if the quota, access tier and metadata of
+// the supplied Azure Files share match the supplied parameters.
+func IsFileShareUpToDate(p v1alpha3.FileShareParameters, az storage.FileShare) bool {
+	if az.FileShareProperties == nil {
+		return false
+	}
+	if p.ShareQuota != nil && (az.ShareQuota == nil || *p.ShareQuota != *az.ShareQuota) {
+		return false
+	}
+	if p.AccessTier != nil && *p.AccessTier != string(az.AccessTier) {
+		return false
+	}
+	return cmp.Equal(p.Metadata, azure.ToStringMap(az.Metadata), cmpopts.EquateEmpty())
+}
+
+// FileShareConnectionDetails returns the connection details of a file share
+// of the named storage account, using the first of the supplied access keys.
+func FileShareConnectionDetails(accountName string, keys storage.AccountListKeysResult) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{
+		ConnectionSecretKeyAzureStorageAccountName: []byte(accountName),
+	}
+	if keys.Keys != nil && len(*keys.Keys) > 0 {
+		cd[ConnectionSecretKeyAzureStorageAccountKey] = []byte(azure.ToString((*keys.Keys)[0].Value))
+	}
+	return cd
+}
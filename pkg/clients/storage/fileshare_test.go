@@ -0,0 +1,209 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+func TestNewFileShare(t *testing.T) {
+	p := v1alpha3.FileShareParameters{
+		ShareQuota:       azure.ToInt32Ptr(100),
+		EnabledProtocols: azure.ToStringPtr("SMB"),
+		AccessTier:       azure.ToStringPtr("Hot"),
+		Metadata:         map[string]string{"cool": "share"},
+	}
+
+	cases := map[string]struct {
+		fn   func(v1alpha3.FileShareParameters) storage.FileShare
+		want storage.FileShare
+	}{
+		"Create": {
+			fn: NewFileShare,
+			want: storage.FileShare{FileShareProperties: &storage.FileShareProperties{
+				ShareQuota:       azure.ToInt32Ptr(100),
+				EnabledProtocols: storage.SMB,
+				AccessTier:       storage.ShareAccessTierHot,
+				Metadata:         map[string]*string{"cool": azure.ToStringPtr("share")},
+			}},
+		},
+		"Update": {
+			fn: NewFileShareUpdate,
+			want: storage.FileShare{FileShareProperties: &storage.FileShareProperties{
+				ShareQuota: azure.ToInt32Ptr(100),
+				AccessTier: storage.ShareAccessTierHot,
+				Metadata:   map[string]*string{"cool": azure.ToStringPtr("share")},
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.fn(p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeFileShare(t *testing.T) {
+	az := storage.FileShare{FileShareProperties: &storage.FileShareProperties{
+		ShareQuota:       azure.ToInt32Ptr(5120),
+		EnabledProtocols: storage.SMB,
+		AccessTier:       storage.ShareAccessTierTransactionOptimized,
+		Metadata:         map[string]*string{"cool": azure.ToStringPtr("share")},
+	}}
+
+	cases := map[string]struct {
+		p    v1alpha3.FileShareParameters
+		want v1alpha3.FileShareParameters
+	}{
+		"AllEmpty": {
+			p: v1alpha3.FileShareParameters{},
+			want: v1alpha3.FileShareParameters{
+				ShareQuota:       azure.ToInt32Ptr(5120),
+				EnabledProtocols: azure.ToStringPtr("SMB"),
+				AccessTier:       azure.ToStringPtr("TransactionOptimized"),
+				Metadata:         map[string]string{"cool": "share"},
+			},
+		},
+		"AllSet": {
+			p: v1alpha3.FileShareParameters{
+				ShareQuota:       azure.ToInt32Ptr(100),
+				EnabledProtocols: azure.ToStringPtr("SMB"),
+				AccessTier:       azure.ToStringPtr("Cool"),
+				Metadata:         map[string]string{"other": "share"},
+			},
+			want: v1alpha3.FileShareParameters{
+				ShareQuota:       azure.ToInt32Ptr(100),
+				EnabledProtocols: azure.ToStringPtr("SMB"),
+				AccessTier:       azure.ToStringPtr("Cool"),
+				Metadata:         map[string]string{"other": "share"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeFileShare(&tc.p, az)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeFileShare(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsFileShareUpToDate(t *testing.T) {
+	az := storage.FileShare{FileShareProperties: &storage.FileShareProperties{
+		ShareQuota: azure.ToInt32Ptr(100),
+		AccessTier: storage.ShareAccessTierHot,
+		Metadata:   map[string]*string{"cool": azure.ToStringPtr("share")},
+	}}
+
+	cases := map[string]struct {
+		p    v1alpha3.FileShareParameters
+		az   storage.FileShare
+		want bool
+	}{
+		"NoProperties": {
+			p:    v1alpha3.FileShareParameters{},
+			az:   storage.FileShare{},
+			want: false,
+		},
+		"UpToDate": {
+			p: v1alpha3.FileShareParameters{
+				ShareQuota: azure.ToInt32Ptr(100),
+				AccessTier: azure.ToStringPtr("Hot"),
+				Metadata:   map[string]string{"cool": "share"},
+			},
+			az:   az,
+			want: true,
+		},
+		"QuotaChanged": {
+			p: v1alpha3.FileShareParameters{
+				ShareQuota: azure.ToInt32Ptr(200),
+				Metadata:   map[string]string{"cool": "share"},
+			},
+			az:   az,
+			want: false,
+		},
+		"AccessTierChanged": {
+			p: v1alpha3.FileShareParameters{
+				AccessTier: azure.ToStringPtr("Cool"),
+				Metadata:   map[string]string{"cool": "share"},
+			},
+			az:   az,
+			want: false,
+		},
+		"MetadataChanged": {
+			p:    v1alpha3.FileShareParameters{},
+			az:   az,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsFileShareUpToDate(tc.p, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsFileShareUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFileShareConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		keys storage.AccountListKeysResult
+		want managed.ConnectionDetails
+	}{
+		"NoKeys": {
+			keys: storage.AccountListKeysResult{},
+			want: managed.ConnectionDetails{
+				ConnectionSecretKeyAzureStorageAccountName: []byte("coolaccount"),
+			},
+		},
+		"Keys": {
+			keys: storage.AccountListKeysResult{Keys: &[]storage.AccountKey{
+				{KeyName: azure.ToStringPtr("key1"), Value: azure.ToStringPtr("primary")},
+				{KeyName: azure.ToStringPtr("key2"), Value: azure.ToStringPtr("secondary")},
+			}},
+			want: managed.ConnectionDetails{
+				ConnectionSecretKeyAzureStorageAccountName: []byte("coolaccount"),
+				ConnectionSecretKeyAzureStorageAccountKey:  []byte("primary"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FileShareConnectionDetails("coolaccount", tc.keys)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FileShareConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-06-01/subscriptions"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Error strings.
+const (
+	errGetSubscriptionID          = "cannot get subscription ID of managed resource"
+	errFmtSubscriptionNotAccessed = "cannot access subscription %q using the credentials of the provider"
+	errNoSubscriptionID           = "credentials do not specify a subscription ID"
+)
+
+// The paths at which managed resources may override the subscription of the
+// provider's credentials. Most resources specify their subscription alongside
+// their other parameters, while older ones specify it directly in their spec.
+var fieldPathsSubscriptionID = []string{"spec.forProvider.subscriptionID", "spec.subscriptionID"}
+
+// SubscriptionID returns the ID of the subscription the supplied managed
+// resource should be managed in, if it overrides the subscription of the
+// provider's credentials. An empty string is returned otherwise.
+func SubscriptionID(mg resource.Managed) (string, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return "", errors.Wrap(err, errGetSubscriptionID)
+	}
+	p := fieldpath.Pave(u)
+	for _, fp := range fieldPathsSubscriptionID {
+		id, err := p.GetString(fp)
+		if fieldpath.IsNotFound(err) {
+			continue
+		}
+		return id, errors.Wrap(err, errGetSubscriptionID)
+	}
+	return "", nil
+}
+
+// A SubscriptionVerifier returns an error unless the supplied authorizer
+// can access the subscription with the supplied ID.
+type SubscriptionVerifier func(ctx context.Context, creds map[string]string, a autorest.Authorizer, id string) error
+
+// VerifySubscription returns an error unless the supplied authorizer can read
+// the subscription with the supplied ID from Azure Resource Manager.
+func VerifySubscription(ctx context.Context, creds map[string]string, a autorest.Authorizer, id string) error {
+	ep := creds[CredentialsKeyResourceManagerEndpointURL]
+	if ep == "" {
+		ep = subscriptions.DefaultBaseURI
+	}
+	c := subscriptions.NewClientWithBaseURI(strings.TrimSuffix(ep, "/"))
+	c.Authorizer = a
+	_, err := c.Get(ctx, id)
+	return err
+}
+
+// VerifyCredentials returns an error unless an Azure AD token can be acquired
+// using the supplied credentials content, and the subscription it specifies
+// can be read using that token.
+func VerifyCredentials(ctx context.Context, creds map[string]string) error {
+	id := creds[CredentialsKeySubscriptionID]
+	if id == "" {
+		return errors.New(errNoSubscriptionID)
+	}
+	cred, err := NewTokenCredential(creds)
+	if err != nil {
+		return err
+	}
+	ep := creds[CredentialsKeyResourceManagerEndpointURL]
+	if ep == "" {
+		ep = DefaultResourceManagerEndpoint
+	}
+	if _, err := cred.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{strings.TrimSuffix(ep, "/") + scopeDefaultSuffix}}); err != nil {
+		return errors.Wrap(err, errGetToken)
+	}
+	a := NewBearerAuthorizer(cred, ep)
+	return errors.Wrapf(VerifySubscription(ctx, creds, a, id), errFmtSubscriptionNotAccessed, id)
+}
+
+// A SubscriptionOverrider replaces the subscription of the provider's
+// credentials with the one a managed resource overrides it with, if any.
+// Subscriptions are verified to be accessible once per set of credentials,
+// and the result is cached for the lifetime of the SubscriptionOverrider.
+type SubscriptionOverrider struct {
+	verify   SubscriptionVerifier
+	verified sync.Map
+}
+
+// NewSubscriptionOverrider returns a SubscriptionOverrider that verifies
+// subscriptions using the supplied SubscriptionVerifier.
+func NewSubscriptionOverrider(v SubscriptionVerifier) *SubscriptionOverrider {
+	return &SubscriptionOverrider{verify: v}
+}
+
+// Override returns credentials content that targets the subscription the
+// supplied managed resource should be managed in. The supplied content is
+// returned unchanged if the resource does not override its subscription.
+func (o *SubscriptionOverrider) Override(ctx context.Context, mg resource.Managed, creds map[string]string, a autorest.Authorizer) (map[string]string, error) {
+	id, err := SubscriptionID(mg)
+	if err != nil {
+		return nil, err
+	}
+	if id == "" || strings.EqualFold(id, creds[CredentialsKeySubscriptionID]) {
+		return creds, nil
+	}
+	key := strings.Join([]string{creds[CredentialsKeyTenantID], creds[CredentialsKeyClientID], creds[CredentialsKeyIdentityClientID], strings.ToLower(id)}, "/")
+	if _, ok := o.verified.Load(key); !ok {
+		if err := o.verify(ctx, creds, a, id); err != nil {
+			return nil, errors.Wrapf(err, errFmtSubscriptionNotAccessed, id)
+		}
+		o.verified.Store(key, true)
+	}
+	out := make(map[string]string, len(creds))
+	for k, v := range creds {
+		out[k] = v
+	}
+	out[CredentialsKeySubscriptionID] = id
+	return out, nil
+}
+
+var subscriptionOverrider = NewSubscriptionOverrider(VerifySubscription)
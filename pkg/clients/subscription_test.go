@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	insightsv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/insights/v1alpha1"
+	networkv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	sqlv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/sql/v1alpha1"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	webv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/web/v1alpha1"
+)
+
+func TestSubscriptionID(t *testing.T) {
+	cases := map[string]struct {
+		mg   resource.Managed
+		want string
+	}{
+		"NotOverridden": {
+			mg: &sqlv1alpha1.SQLServer{},
+		},
+		"ForProvider": {
+			mg: &sqlv1alpha1.SQLServer{Spec: sqlv1alpha1.SQLServerSpec{
+				ForProvider: sqlv1alpha1.SQLServerParameters{SubscriptionID: to.StringPtr("other")},
+			}},
+			want: "other",
+		},
+		"Spec": {
+			mg: &v1alpha3.ResourceGroup{Spec: v1alpha3.ResourceGroupSpec{
+				SubscriptionID: to.StringPtr("other"),
+			}},
+			want: "other",
+		},
+		"PrivateEndpoint": {
+			mg: &networkv1alpha3.PrivateEndpoint{Spec: networkv1alpha3.PrivateEndpointSpec{
+				ForProvider: networkv1alpha3.PrivateEndpointParameters{SubscriptionID: to.StringPtr("other")},
+			}},
+			want: "other",
+		},
+		"DiagnosticSetting": {
+			mg: &insightsv1alpha1.DiagnosticSetting{Spec: insightsv1alpha1.DiagnosticSettingSpec{
+				ForProvider: insightsv1alpha1.DiagnosticSettingParameters{SubscriptionID: to.StringPtr("other")},
+			}},
+			want: "other",
+		},
+		"FunctionApp": {
+			mg: &webv1alpha1.FunctionApp{Spec: webv1alpha1.FunctionAppSpec{
+				ForProvider: webv1alpha1.FunctionAppParameters{WebAppParameters: webv1alpha1.WebAppParameters{SubscriptionID: to.StringPtr("other")}},
+			}},
+			want: "other",
+		},
+		"WebAppSlot": {
+			mg: &webv1alpha1.WebAppSlot{Spec: webv1alpha1.WebAppSlotSpec{
+				ForProvider: webv1alpha1.WebAppSlotParameters{WebAppParameters: webv1alpha1.WebAppParameters{SubscriptionID: to.StringPtr("other")}},
+			}},
+			want: "other",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := SubscriptionID(tc.mg)
+			if err != nil {
+				t.Fatalf("SubscriptionID(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("SubscriptionID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSubscriptionOverriderOverride(t *testing.T) {
+	errBoom := errors.New("boom")
+	creds := map[string]string{CredentialsKeyClientID: "client", CredentialsKeySubscriptionID: "default"}
+	withSubscription := func(id string) resource.Managed {
+		return &sqlv1alpha1.SQLServer{Spec: sqlv1alpha1.SQLServerSpec{
+			ForProvider: sqlv1alpha1.SQLServerParameters{SubscriptionID: to.StringPtr(id)},
+		}}
+	}
+
+	type want struct {
+		creds    map[string]string
+		err      error
+		verified int
+	}
+	cases := map[string]struct {
+		verifyErr error
+		mg        []resource.Managed
+		want      want
+	}{
+		"NotOverridden": {
+			mg:   []resource.Managed{&sqlv1alpha1.SQLServer{}},
+			want: want{creds: creds},
+		},
+		"SameSubscription": {
+			mg:   []resource.Managed{withSubscription("Default")},
+			want: want{creds: creds},
+		},
+		"Overridden": {
+			mg: []resource.Managed{withSubscription("other"), withSubscription("other")},
+			want: want{
+				creds:    map[string]string{CredentialsKeyClientID: "client", CredentialsKeySubscriptionID: "other"},
+				verified: 1,
+			},
+		},
+		"NotAccessible": {
+			verifyErr: errBoom,
+			mg:        []resource.Managed{withSubscription("other")},
+			want: want{
+				err:      errors.Wrapf(errBoom, errFmtSubscriptionNotAccessed, "other"),
+				verified: 1,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			verified := 0
+			o := NewSubscriptionOverrider(func(_ context.Context, _ map[string]string, _ autorest.Authorizer, _ string) error {
+				verified++
+				return tc.verifyErr
+			})
+			var got map[string]string
+			var err error
+			for _, mg := range tc.mg {
+				got, err = o.Override(context.Background(), mg, creds, nil)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Override(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.creds, got); diff != "" {
+				t.Errorf("Override(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.verified, verified); diff != "" {
+				t.Errorf("Override(...): -want verifications, +got verifications:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Tags that identify an external resource as managed by Crossplane.
+const (
+	TagKeyManaged        = "crossplane-managed"
+	TagKeyName           = "crossplane-name"
+	TagKeyClaimName      = "crossplane-claim-name"
+	TagKeyClaimNamespace = "crossplane-claim-namespace"
+)
+
+// DefaultTags returns the tags that identify the external resource of the
+// supplied managed resource as managed by Crossplane, including the claim it
+// was composed for, if any.
+func DefaultTags(mg resource.Managed) map[string]string {
+	t := map[string]string{
+		TagKeyManaged: "true",
+		TagKeyName:    mg.GetName(),
+	}
+	if v := mg.GetLabels()[LabelKeyClaimName]; v != "" {
+		t[TagKeyClaimName] = v
+	}
+	if v := mg.GetLabels()[LabelKeyClaimNamespace]; v != "" {
+		t[TagKeyClaimNamespace] = v
+	}
+	return t
+}
+
+// A TagsFn returns a pointer to the tags of the supplied managed resource, or
+// nil if it has none.
+type TagsFn func(mg resource.Managed) *map[string]string
+
+// A DefaultTagger initializes the tags of a managed resource with the
+// DefaultTags, which are then propagated to its external resource.
+type DefaultTagger struct {
+	client client.Client
+	tags   TagsFn
+}
+
+// NewDefaultTagger returns a new DefaultTagger that sets the default tags in
+// the tags returned by the supplied function.
+func NewDefaultTagger(c client.Client, fn TagsFn) *DefaultTagger {
+	return &DefaultTagger{client: c, tags: fn}
+}
+
+// Initialize the tags of the supplied managed resource. Tags that are already
+// set, including default tags set to a different value, are left untouched.
+func (t *DefaultTagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	tags := t.tags(mg)
+	if tags == nil {
+		return nil
+	}
+	changed := false
+	for k, v := range DefaultTags(mg) {
+		if _, ok := (*tags)[k]; ok {
+			continue
+		}
+		if *tags == nil {
+			*tags = map[string]string{}
+		}
+		(*tags)[k] = v
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return errors.Wrap(t.client.Update(ctx, mg), errUpdateManaged)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestDefaultTagger(t *testing.T) {
+	errBoom := errors.New("boom")
+	claimed := func() *fake.Managed {
+		mg := &fake.Managed{}
+		mg.SetName("cool-resource")
+		mg.SetLabels(map[string]string{LabelKeyClaimName: "cool-claim", LabelKeyClaimNamespace: "cool-team"})
+		return mg
+	}
+	defaults := map[string]string{
+		TagKeyManaged:        "true",
+		TagKeyName:           "cool-resource",
+		TagKeyClaimName:      "cool-claim",
+		TagKeyClaimNamespace: "cool-team",
+	}
+
+	type want struct {
+		tags map[string]string
+		err  error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		tags map[string]string
+		want want
+	}{
+		"Defaulted": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			want: want{
+				tags: defaults,
+			},
+		},
+		"KeepExistingTags": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			tags: map[string]string{"team": "cool", TagKeyManaged: "yes"},
+			want: want{
+				tags: map[string]string{
+					"team":               "cool",
+					TagKeyManaged:        "yes",
+					TagKeyName:           "cool-resource",
+					TagKeyClaimName:      "cool-claim",
+					TagKeyClaimNamespace: "cool-team",
+				},
+			},
+		},
+		"AlreadyTagged": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			tags: defaults,
+			want: want{
+				tags: defaults,
+			},
+		},
+		"UpdateError": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			want: want{
+				tags: defaults,
+				err:  errors.Wrap(errBoom, errUpdateManaged),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tags := tc.tags
+			fn := func(_ resource.Managed) *map[string]string { return &tags }
+			err := NewDefaultTagger(tc.kube, fn).Initialize(context.Background(), claimed())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Initialize(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tags, tags); diff != "" {
+				t.Errorf("Initialize(...): -want tags, +got tags:\n%s", diff)
+			}
+		})
+	}
+}
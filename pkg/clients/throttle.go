@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+// Default rates, in requests per second, at which Azure Resource Manager read
+// and write requests are sent. They are somewhat below the rates at which
+// Azure Resource Manager refills its per-subscription throttling buckets.
+const (
+	DefaultReadsPerSecond  = 20
+	DefaultWritesPerSecond = 8
+)
+
+const errThrottle = "cannot wait for Azure API rate limiter"
+
+// A Throttle limits the rate at which Azure Resource Manager requests are
+// sent, using separate token buckets for reads and writes. The size of each
+// bucket (i.e. the allowed burst) is ten times its rate.
+type Throttle struct {
+	reads  *rate.Limiter
+	writes *rate.Limiter
+}
+
+// NewThrottle returns a Throttle that sends at most the supplied number of
+// read and write requests per second on average. A rate of zero or less
+// disables throttling of the respective requests.
+func NewThrottle(readsPerSecond, writesPerSecond int) *Throttle {
+	return &Throttle{reads: newLimiter(readsPerSecond), writes: newLimiter(writesPerSecond)}
+}
+
+func newLimiter(rps int) *rate.Limiter {
+	if rps <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	return rate.NewLimiter(rate.Limit(rps), rps*10)
+}
+
+// Wait until the supplied request may be sent, or its context is done.
+func (t *Throttle) Wait(r *http.Request) error {
+	l := t.writes
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		l = t.reads
+	}
+	if l.Allow() {
+		return nil
+	}
+	throttledRequests.WithLabelValues(throttleSourceClient).Inc()
+	return l.Wait(r.Context())
+}
+
+var (
+	throttleMu sync.RWMutex
+	throttle   = NewThrottle(DefaultReadsPerSecond, DefaultWritesPerSecond)
+)
+
+// SetThrottle sets the Throttle shared by all Azure Resource Manager clients
+// subsequently created by this package.
+func SetThrottle(t *Throttle) {
+	throttleMu.Lock()
+	defer throttleMu.Unlock()
+	throttle = t
+}
+
+func sharedThrottle() *Throttle {
+	throttleMu.RLock()
+	defer throttleMu.RUnlock()
+	return throttle
+}
+
+// NewThrottledAuthorizer returns an autorest.Authorizer that waits for the
+// supplied Throttle before authorizing each request. Track 1 clients authorize
+// every request they send, including retries and long running operation polls.
+func NewThrottledAuthorizer(a autorest.Authorizer, t *Throttle) autorest.Authorizer {
+	return &throttledAuthorizer{Authorizer: a, throttle: t}
+}
+
+type throttledAuthorizer struct {
+	autorest.Authorizer
+	throttle *Throttle
+}
+
+// WithAuthorization returns a PrepareDecorator that waits for the Throttle
+// before authorizing the request using the underlying Authorizer.
+func (a *throttledAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		inner := a.Authorizer.WithAuthorization()(p)
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			if err := a.throttle.Wait(r); err != nil {
+				return r, errors.Wrap(err, errThrottle)
+			}
+			return inner.Prepare(r)
+		})
+	}
+}
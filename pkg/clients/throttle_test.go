@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/time/rate"
+)
+
+func TestThrottleWait(t *testing.T) {
+	cases := map[string]struct {
+		method string
+		want   bool
+	}{
+		"Read": {
+			method: http.MethodGet,
+			want:   true,
+		},
+		"Write": {
+			method: http.MethodPut,
+			want:   false,
+		},
+		"Delete": {
+			method: http.MethodDelete,
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// Writes have exhausted their bucket, so only reads should be able
+			// to proceed before the deadline.
+			th := &Throttle{reads: rate.NewLimiter(1, 1), writes: rate.NewLimiter(1, 1)}
+			th.writes.Allow()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			r, _ := http.NewRequestWithContext(ctx, tc.method, "https://management.azure.com", nil)
+
+			got := th.Wait(r) == nil
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Wait(...): -want proceeded, +got proceeded:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewThrottleUnlimited(t *testing.T) {
+	th := NewThrottle(0, 0)
+	r, _ := http.NewRequest(http.MethodPut, "https://management.azure.com", nil)
+	for i := 0; i < 1000; i++ {
+		if err := th.Wait(r); err != nil {
+			t.Fatalf("Wait(...): %v", err)
+		}
+	}
+}
+
+type fakeAuthorizer struct {
+	authorized int
+}
+
+func (a *fakeAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			a.authorized++
+			return p.Prepare(r)
+		})
+	}
+}
+
+func TestThrottledAuthorizer(t *testing.T) {
+	th := &Throttle{reads: rate.NewLimiter(1, 1), writes: rate.NewLimiter(1, 1)}
+
+	fa := &fakeAuthorizer{}
+	a := NewThrottledAuthorizer(fa, th)
+
+	r, _ := http.NewRequest(http.MethodGet, "https://management.azure.com", nil)
+	if _, err := autorest.Prepare(r, a.WithAuthorization()); err != nil {
+		t.Fatalf("Prepare(...): %v", err)
+	}
+
+	// The bucket is now empty, so the next request must wait for longer than
+	// its context allows.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := autorest.Prepare(r.WithContext(ctx), a.WithAuthorization()); err == nil {
+		t.Errorf("Prepare(...): want error, got nil")
+	}
+	if diff := cmp.Diff(1, fa.authorized); diff != "" {
+		t.Errorf("WithAuthorization(): -want authorized, +got authorized:\n%s", diff)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web/webapi"
+	"github.com/Azure/go-autorest/autorest"
+
+	azureweb "github.com/crossplane-contrib/provider-azure/pkg/clients/web"
+)
+
+var _ webapi.AppServicePlansClientAPI = &MockAppServicePlansClient{}
+
+// MockAppServicePlansClient is a fake implementation of
+// web.AppServicePlansClient.
+type MockAppServicePlansClient struct {
+	webapi.AppServicePlansClientAPI
+
+	MockCreateOrUpdate func(ctx context.Context, resourceGroupName string, name string, appServicePlan web.AppServicePlan) (result web.AppServicePlansCreateOrUpdateFuture, err error)
+	MockDelete         func(ctx context.Context, resourceGroupName string, name string) (result autorest.Response, err error)
+	MockGet            func(ctx context.Context, resourceGroupName string, name string) (result web.AppServicePlan, err error)
+}
+
+// CreateOrUpdate calls the MockAppServicePlansClient's MockCreateOrUpdate
+// method.
+func (c *MockAppServicePlansClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, name string, appServicePlan web.AppServicePlan) (result web.AppServicePlansCreateOrUpdateFuture, err error) {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, name, appServicePlan)
+}
+
+// Delete calls the MockAppServicePlansClient's MockDelete method.
+func (c *MockAppServicePlansClient) Delete(ctx context.Context, resourceGroupName string, name string) (result autorest.Response, err error) {
+	return c.MockDelete(ctx, resourceGroupName, name)
+}
+
+// Get calls the MockAppServicePlansClient's MockGet method.
+func (c *MockAppServicePlansClient) Get(ctx context.Context, resourceGroupName string, name string) (result web.AppServicePlan, err error) {
+	return c.MockGet(ctx, resourceGroupName, name)
+}
+
+var _ azureweb.AppsAPI = &MockAppsClient{}
+
+// MockAppsClient is a fake implementation of the AppsAPI interface.
+type MockAppsClient struct {
+	MockGet                     func(ctx context.Context, resourceGroupName, name, slot string) (web.Site, error)
+	MockGetConfiguration        func(ctx context.Context, resourceGroupName, name, slot string) (web.SiteConfigResource, error)
+	MockListApplicationSettings func(ctx context.Context, resourceGroupName, name, slot string) (web.StringDictionary, error)
+	MockListConnectionStrings   func(ctx context.Context, resourceGroupName, name, slot string) (web.ConnectionStringDictionary, error)
+	MockListHostKeys            func(ctx context.Context, resourceGroupName, name, slot string) (web.HostKeys, error)
+	MockCreateOrUpdate          func(ctx context.Context, resourceGroupName, name, slot string, site web.Site) error
+	MockDelete                  func(ctx context.Context, resourceGroupName, name, slot string) error
+}
+
+// Get calls the MockAppsClient's MockGet method.
+func (c *MockAppsClient) Get(ctx context.Context, resourceGroupName, name, slot string) (web.Site, error) {
+	return c.MockGet(ctx, resourceGroupName, name, slot)
+}
+
+// GetConfiguration calls the MockAppsClient's MockGetConfiguration method.
+func (c *MockAppsClient) GetConfiguration(ctx context.Context, resourceGroupName, name, slot string) (web.SiteConfigResource, error) {
+	return c.MockGetConfiguration(ctx, resourceGroupName, name, slot)
+}
+
+// ListApplicationSettings calls the MockAppsClient's
+// MockListApplicationSettings method.
+func (c *MockAppsClient) ListApplicationSettings(ctx context.Context, resourceGroupName, name, slot string) (web.StringDictionary, error) {
+	return c.MockListApplicationSettings(ctx, resourceGroupName, name, slot)
+}
+
+// ListConnectionStrings calls the MockAppsClient's MockListConnectionStrings
+// method.
+func (c *MockAppsClient) ListConnectionStrings(ctx context.Context, resourceGroupName, name, slot string) (web.ConnectionStringDictionary, error) {
+	return c.MockListConnectionStrings(ctx, resourceGroupName, name, slot)
+}
+
+// ListHostKeys calls the MockAppsClient's MockListHostKeys method.
+func (c *MockAppsClient) ListHostKeys(ctx context.Context, resourceGroupName, name, slot string) (web.HostKeys, error) {
+	return c.MockListHostKeys(ctx, resourceGroupName, name, slot)
+}
+
+// CreateOrUpdate calls the MockAppsClient's MockCreateOrUpdate method.
+func (c *MockAppsClient) CreateOrUpdate(ctx context.Context, resourceGroupName, name, slot string, site web.Site) error {
+	return c.MockCreateOrUpdate(ctx, resourceGroupName, name, slot, site)
+}
+
+// Delete calls the MockAppsClient's MockDelete method.
+func (c *MockAppsClient) Delete(ctx context.Context, resourceGroupName, name, slot string) error {
+	return c.MockDelete(ctx, resourceGroupName, name, slot)
+}
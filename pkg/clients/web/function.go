@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package web
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-azure/apis/web/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// Application settings read by the Azure Functions host.
+const (
+	AppSettingWorkerRuntime            = "FUNCTIONS_WORKER_RUNTIME"
+	AppSettingExtensionVersion         = "FUNCTIONS_EXTENSION_VERSION"
+	AppSettingWebJobsStorage           = "AzureWebJobsStorage"
+	AppSettingContentConnectionString  = "WEBSITE_CONTENTAZUREFILECONNECTIONSTRING"
+	AppSettingContentShare             = "WEBSITE_CONTENTSHARE"
+	AppSettingInsightsConnectionString = "APPLICATIONINSIGHTS_CONNECTION_STRING"
+)
+
+const (
+	// DefaultFunctionsExtensionVersion is the version of the Functions
+	// runtime apps run on unless they specify one.
+	DefaultFunctionsExtensionVersion = "~4"
+
+	// ConnectionSecretKeyFunctionHostKey is the connection secret key the
+	// default host key of a function app is written to.
+	ConnectionSecretKeyFunctionHostKey = "hostKey"
+
+	functionHostKeyName        = "default"
+	storageConnectionStringFmt = "DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=core.windows.net"
+
+	skuTierDynamic        = "Dynamic"
+	skuTierElasticPremium = "ElasticPremium"
+)
+
+// StorageConnectionString returns a connection string for the named storage
+// account, using the first of the supplied access keys.
+func StorageConnectionString(accountName string, keys storage.AccountListKeysResult) string {
+	key := ""
+	if keys.Keys != nil && len(*keys.Keys) > 0 {
+		key = azure.ToString((*keys.Keys)[0].Value)
+	}
+	return fmt.Sprintf(storageConnectionStringFmt, accountName, key)
+}
+
+// isLinuxPlan returns true if the supplied App Service plan hosts apps on
+// Linux.
+func isLinuxPlan(plan web.AppServicePlan) bool {
+	return plan.AppServicePlanProperties != nil && to.Bool(plan.Reserved)
+}
+
+// usesContentShare returns true if function apps on the supplied App Service
+// plan must store their content on an Azure Files share, which is the case
+// for Windows consumption plans and all premium plans.
+func usesContentShare(plan web.AppServicePlan) bool {
+	if plan.Sku == nil {
+		return false
+	}
+	switch azure.ToString(plan.Sku.Tier) {
+	case skuTierElasticPremium:
+		return true
+	case skuTierDynamic:
+		return !isLinuxPlan(plan)
+	}
+	return false
+}
+
+// FunctionAppSettings returns the application settings of the named function
+// app on the supplied App Service plan. The settings that configure the
+// Functions host are generated from the supplied parameters and connection
+// strings, and are overridden by the supplied resolved settings of the
+// same name. The Application Insights setting is omitted if its connection
+// string is empty.
+func FunctionAppSettings(name string, p v1alpha1.FunctionAppParameters, plan web.AppServicePlan, storageConn, insightsConn string, resolved map[string]string) map[string]string {
+	settings := map[string]string{
+		AppSettingWorkerRuntime:    p.WorkerRuntime,
+		AppSettingExtensionVersion: DefaultFunctionsExtensionVersion,
+		AppSettingWebJobsStorage:   storageConn,
+	}
+	if p.ExtensionVersion != nil {
+		settings[AppSettingExtensionVersion] = *p.ExtensionVersion
+	}
+	if usesContentShare(plan) {
+		settings[AppSettingContentConnectionString] = storageConn
+		settings[AppSettingContentShare] = contentShareName(name)
+	}
+	if insightsConn != "" {
+		settings[AppSettingInsightsConnectionString] = insightsConn
+	}
+	for k, v := range resolved {
+		settings[k] = v
+	}
+	return settings
+}
+
+// contentShareName returns the name of the file share that stores the
+// content of the named function app. Share names are lower case and at most
+// 63 characters long.
+func contentShareName(name string) string {
+	n := strings.ToLower(name)
+	if len(n) > 63 {
+		n = n[:63]
+	}
+	return n
+}
+
+// NewFunctionSite returns a function app on the supplied App Service plan
+// that matches the supplied parameters, with the supplied application
+// settings and resolved connection strings.
+func NewFunctionSite(p v1alpha1.FunctionAppParameters, plan web.AppServicePlan, settings map[string]string, conns map[string]web.ConnStringValueTypePair) web.Site {
+	site := NewSite(p.WebAppParameters, settings, conns)
+	site.Kind = to.StringPtr("functionapp")
+	if isLinuxPlan(plan) {
+		site.Kind = to.StringPtr("functionapp,linux")
+	}
+	site.Reserved = to.BoolPtr(isLinuxPlan(plan))
+	return site
+}
+
+// FunctionAppConnectionDetails returns the connection details of the
+// supplied function app, which are its default host name and default host
+// key.
+func FunctionAppConnectionDetails(az web.Site, keys web.HostKeys) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if az.SiteProperties != nil {
+		cd[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(azure.ToString(az.DefaultHostName))
+	}
+	if k, ok := keys.FunctionKeys[functionHostKeyName]; ok {
+		cd[ConnectionSecretKeyFunctionHostKey] = []byte(azure.ToString(k))
+	}
+	return cd
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package web
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-azure/apis/web/v1alpha1"
+)
+
+const (
+	functionName = "Cool-Functions"
+	storageConn  = "DefaultEndpointsProtocol=https;AccountName=coolstorage;AccountKey=key;EndpointSuffix=core.windows.net"
+	insightsConn = "InstrumentationKey=cool"
+)
+
+func plan(tier string, linux bool) web.AppServicePlan {
+	return web.AppServicePlan{
+		Sku:                      &web.SkuDescription{Tier: to.StringPtr(tier)},
+		AppServicePlanProperties: &web.AppServicePlanProperties{Reserved: to.BoolPtr(linux)},
+	}
+}
+
+func TestStorageConnectionString(t *testing.T) {
+	keys := storage.AccountListKeysResult{Keys: &[]storage.AccountKey{{Value: to.StringPtr("key")}, {Value: to.StringPtr("other")}}}
+	if diff := cmp.Diff(storageConn, StorageConnectionString("coolstorage", keys)); diff != "" {
+		t.Errorf("StorageConnectionString(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestFunctionAppSettings(t *testing.T) {
+	type args struct {
+		p            v1alpha1.FunctionAppParameters
+		plan         web.AppServicePlan
+		insightsConn string
+		resolved     map[string]string
+	}
+
+	cases := map[string]struct {
+		args args
+		want map[string]string
+	}{
+		"LinuxConsumption": {
+			args: args{
+				p:    v1alpha1.FunctionAppParameters{WorkerRuntime: "python"},
+				plan: plan(skuTierDynamic, true),
+			},
+			want: map[string]string{
+				AppSettingWorkerRuntime:    "python",
+				AppSettingExtensionVersion: DefaultFunctionsExtensionVersion,
+				AppSettingWebJobsStorage:   storageConn,
+			},
+		},
+		"WindowsConsumption": {
+			args: args{
+				p:            v1alpha1.FunctionAppParameters{WorkerRuntime: "dotnet", ExtensionVersion: to.StringPtr("~3")},
+				plan:         plan(skuTierDynamic, false),
+				insightsConn: insightsConn,
+			},
+			want: map[string]string{
+				AppSettingWorkerRuntime:            "dotnet",
+				AppSettingExtensionVersion:         "~3",
+				AppSettingWebJobsStorage:           storageConn,
+				AppSettingContentConnectionString:  storageConn,
+				AppSettingContentShare:             "cool-functions",
+				AppSettingInsightsConnectionString: insightsConn,
+			},
+		},
+		"PremiumWithOverrides": {
+			args: args{
+				p:        v1alpha1.FunctionAppParameters{WorkerRuntime: "node"},
+				plan:     plan(skuTierElasticPremium, true),
+				resolved: map[string]string{AppSettingExtensionVersion: "~4.1", "MODE": "release"},
+			},
+			want: map[string]string{
+				AppSettingWorkerRuntime:           "node",
+				AppSettingExtensionVersion:        "~4.1",
+				AppSettingWebJobsStorage:          storageConn,
+				AppSettingContentConnectionString: storageConn,
+				AppSettingContentShare:            "cool-functions",
+				"MODE":                            "release",
+			},
+		},
+		"Dedicated": {
+			args: args{
+				p:    v1alpha1.FunctionAppParameters{WorkerRuntime: "java"},
+				plan: plan("PremiumV2", false),
+			},
+			want: map[string]string{
+				AppSettingWorkerRuntime:    "java",
+				AppSettingExtensionVersion: DefaultFunctionsExtensionVersion,
+				AppSettingWebJobsStorage:   storageConn,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FunctionAppSettings(functionName, tc.args.p, tc.args.plan, storageConn, tc.args.insightsConn, tc.args.resolved)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FunctionAppSettings(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewFunctionSite(t *testing.T) {
+	p := v1alpha1.FunctionAppParameters{
+		WorkerRuntime:    "python",
+		WebAppParameters: v1alpha1.WebAppParameters{Location: location, ServerFarmID: planID},
+	}
+	settings := map[string]string{AppSettingWorkerRuntime: "python", AppSettingWebJobsStorage: storageConn}
+
+	want := web.Site{
+		Kind:     to.StringPtr("functionapp,linux"),
+		Location: to.StringPtr(location),
+		SiteProperties: &web.SiteProperties{
+			ServerFarmID: to.StringPtr(planID),
+			Reserved:     to.BoolPtr(true),
+			SiteConfig: &web.SiteConfig{
+				AppSettings: &[]web.NameValuePair{
+					{Name: to.StringPtr(AppSettingWebJobsStorage), Value: to.StringPtr(storageConn)},
+					{Name: to.StringPtr(AppSettingWorkerRuntime), Value: to.StringPtr("python")},
+				},
+				ConnectionStrings: &[]web.ConnStringInfo{},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, NewFunctionSite(p, plan(skuTierDynamic, true), settings, nil)); diff != "" {
+		t.Errorf("NewFunctionSite(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestFunctionAppConnectionDetails(t *testing.T) {
+	az := web.Site{SiteProperties: &web.SiteProperties{DefaultHostName: to.StringPtr("cool.azurewebsites.net")}}
+	keys := web.HostKeys{
+		MasterKey:    to.StringPtr("master"),
+		FunctionKeys: map[string]*string{functionHostKeyName: to.StringPtr("host")},
+	}
+
+	want := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte("cool.azurewebsites.net"),
+		ConnectionSecretKeyFunctionHostKey:        []byte("host"),
+	}
+	if diff := cmp.Diff(want, FunctionAppConnectionDetails(az, keys)); diff != "" {
+		t.Errorf("FunctionAppConnectionDetails(...): -want, +got:\n%s", diff)
+	}
+}
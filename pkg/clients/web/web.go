@@ -0,0 +1,333 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package web
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-azure/apis/web/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+// AppsAPI represents the API interface for a client of web apps and their
+// deployment slots. Methods operate on the named deployment slot of the app
+// if slot is not empty, and on the app itself otherwise.
+type AppsAPI interface {
+	Get(ctx context.Context, resourceGroupName, name, slot string) (web.Site, error)
+	GetConfiguration(ctx context.Context, resourceGroupName, name, slot string) (web.SiteConfigResource, error)
+	ListApplicationSettings(ctx context.Context, resourceGroupName, name, slot string) (web.StringDictionary, error)
+	ListConnectionStrings(ctx context.Context, resourceGroupName, name, slot string) (web.ConnectionStringDictionary, error)
+	ListHostKeys(ctx context.Context, resourceGroupName, name, slot string) (web.HostKeys, error)
+	CreateOrUpdate(ctx context.Context, resourceGroupName, name, slot string, site web.Site) error
+	Delete(ctx context.Context, resourceGroupName, name, slot string) error
+}
+
+// AppsClient is the concrete implementation of the AppsAPI interface that
+// calls Azure API.
+type AppsClient struct {
+	web.AppsClient
+}
+
+// NewAppsClient creates and initializes an AppsClient instance.
+func NewAppsClient(cl web.AppsClient) *AppsClient {
+	return &AppsClient{AppsClient: cl}
+}
+
+// Get retrieves the requested app or slot.
+func (c *AppsClient) Get(ctx context.Context, resourceGroupName, name, slot string) (web.Site, error) {
+	if slot != "" {
+		return c.AppsClient.GetSlot(ctx, resourceGroupName, name, slot)
+	}
+	return c.AppsClient.Get(ctx, resourceGroupName, name)
+}
+
+// GetConfiguration retrieves the configuration of the requested app or slot.
+func (c *AppsClient) GetConfiguration(ctx context.Context, resourceGroupName, name, slot string) (web.SiteConfigResource, error) {
+	if slot != "" {
+		return c.AppsClient.GetConfigurationSlot(ctx, resourceGroupName, name, slot)
+	}
+	return c.AppsClient.GetConfiguration(ctx, resourceGroupName, name)
+}
+
+// ListApplicationSettings retrieves the application settings of the
+// requested app or slot.
+func (c *AppsClient) ListApplicationSettings(ctx context.Context, resourceGroupName, name, slot string) (web.StringDictionary, error) {
+	if slot != "" {
+		return c.AppsClient.ListApplicationSettingsSlot(ctx, resourceGroupName, name, slot)
+	}
+	return c.AppsClient.ListApplicationSettings(ctx, resourceGroupName, name)
+}
+
+// ListConnectionStrings retrieves the connection strings of the requested
+// app or slot.
+func (c *AppsClient) ListConnectionStrings(ctx context.Context, resourceGroupName, name, slot string) (web.ConnectionStringDictionary, error) {
+	if slot != "" {
+		return c.AppsClient.ListConnectionStringsSlot(ctx, resourceGroupName, name, slot)
+	}
+	return c.AppsClient.ListConnectionStrings(ctx, resourceGroupName, name)
+}
+
+// ListHostKeys retrieves the Functions host keys of the requested app or
+// slot.
+func (c *AppsClient) ListHostKeys(ctx context.Context, resourceGroupName, name, slot string) (web.HostKeys, error) {
+	if slot != "" {
+		return c.AppsClient.ListHostKeysSlot(ctx, resourceGroupName, name, slot)
+	}
+	return c.AppsClient.ListHostKeys(ctx, resourceGroupName, name)
+}
+
+// CreateOrUpdate creates or updates the supplied app or slot.
+func (c *AppsClient) CreateOrUpdate(ctx context.Context, resourceGroupName, name, slot string, site web.Site) error {
+	if slot != "" {
+		_, err := c.AppsClient.CreateOrUpdateSlot(ctx, resourceGroupName, name, site, slot)
+		return err
+	}
+	_, err := c.AppsClient.CreateOrUpdate(ctx, resourceGroupName, name, site)
+	return err
+}
+
+// Delete deletes the requested app or slot. The App Service plan of the app
+// is never deleted along with it.
+func (c *AppsClient) Delete(ctx context.Context, resourceGroupName, name, slot string) error {
+	if slot != "" {
+		_, err := c.AppsClient.DeleteSlot(ctx, resourceGroupName, name, slot, nil, to.BoolPtr(false))
+		return err
+	}
+	_, err := c.AppsClient.Delete(ctx, resourceGroupName, name, nil, to.BoolPtr(false))
+	return err
+}
+
+// NewAppServicePlan returns an App Service plan that matches the supplied
+// parameters.
+func NewAppServicePlan(p v1alpha1.AppServicePlanParameters) web.AppServicePlan {
+	linux := azure.ToString(p.OSType) == v1alpha1.OSTypeLinux
+	asp := web.AppServicePlan{
+		Location: azure.ToStringPtr(p.Location),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+		Sku: &web.SkuDescription{
+			Name:     azure.ToStringPtr(p.SKU.Name),
+			Capacity: p.SKU.Capacity,
+		},
+		AppServicePlanProperties: &web.AppServicePlanProperties{
+			Reserved: to.BoolPtr(linux),
+		},
+	}
+	if linux {
+		asp.Kind = to.StringPtr("linux")
+	}
+	return asp
+}
+
+// GenerateAppServicePlanObservation returns the observed state of the
+// supplied App Service plan.
+func GenerateAppServicePlanObservation(az web.AppServicePlan) v1alpha1.AppServicePlanObservation {
+	o := v1alpha1.AppServicePlanObservation{ID: azure.ToString(az.ID)}
+	if az.AppServicePlanProperties != nil {
+		o.Status = string(az.Status)
+		o.ProvisioningState = string(az.ProvisioningState)
+		o.NumberOfSites = az.NumberOfSites
+	}
+	return o
+}
+
+// LateInitializeAppServicePlan fills the empty fields of the supplied
+// parameters with the values of the supplied App Service plan.
+func LateInitializeAppServicePlan(p *v1alpha1.AppServicePlanParameters, az web.AppServicePlan) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, az.Tags)
+	if az.Sku != nil {
+		p.SKU.Capacity = azure.LateInitializeInt32PtrFromInt32Ptr(p.SKU.Capacity, az.Sku.Capacity)
+	}
+	if p.OSType == nil && az.AppServicePlanProperties != nil {
+		p.OSType = to.StringPtr(v1alpha1.OSTypeWindows)
+		if to.Bool(az.Reserved) {
+			p.OSType = to.StringPtr(v1alpha1.OSTypeLinux)
+		}
+	}
+}
+
+// IsAppServicePlanUpToDate returns true if the SKU and tags of the supplied
+// App Service plan match the supplied parameters.
+func IsAppServicePlanUpToDate(p v1alpha1.AppServicePlanParameters, az web.AppServicePlan) bool {
+	if az.Sku == nil {
+		return false
+	}
+	if !strings.EqualFold(p.SKU.Name, azure.ToString(az.Sku.Name)) {
+		return false
+	}
+	if p.SKU.Capacity != nil && (az.Sku.Capacity == nil || *p.SKU.Capacity != *az.Sku.Capacity) {
+		return false
+	}
+	return cmp.Equal(p.Tags, azure.ToStringMap(az.Tags), cmpopts.EquateEmpty())
+}
+
+// ResolveAppSettings returns the application settings of the supplied
+// parameters, reading the values that reference secrets.
+func ResolveAppSettings(ctx context.Context, kube client.Client, p v1alpha1.WebAppParameters) (map[string]string, error) {
+	settings := make(map[string]string, len(p.AppSettings))
+	for _, s := range p.AppSettings {
+		if s.ValueSecretRef == nil {
+			settings[s.Name] = azure.ToString(s.Value)
+			continue
+		}
+		v, err := azure.SecretKeyValue(ctx, kube, *s.ValueSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		settings[s.Name] = v
+	}
+	return settings, nil
+}
+
+// ResolveConnectionStrings returns the connection strings of the supplied
+// parameters, reading their values from the secrets they reference.
+func ResolveConnectionStrings(ctx context.Context, kube client.Client, p v1alpha1.WebAppParameters) (map[string]web.ConnStringValueTypePair, error) {
+	conns := make(map[string]web.ConnStringValueTypePair, len(p.ConnectionStrings))
+	for _, cs := range p.ConnectionStrings {
+		v, err := azure.SecretKeyValue(ctx, kube, cs.ValueSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		conns[cs.Name] = web.ConnStringValueTypePair{Value: to.StringPtr(v), Type: web.ConnectionStringType(cs.Type)}
+	}
+	return conns, nil
+}
+
+// NewSite returns a web app or deployment slot that matches the supplied
+// parameters, with the supplied resolved application settings and
+// connection strings.
+func NewSite(p v1alpha1.WebAppParameters, settings map[string]string, conns map[string]web.ConnStringValueTypePair) web.Site {
+	names := make([]string, 0, len(settings))
+	for n := range settings {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	nvs := make([]web.NameValuePair, 0, len(settings))
+	for _, n := range names {
+		nvs = append(nvs, web.NameValuePair{Name: to.StringPtr(n), Value: to.StringPtr(settings[n])})
+	}
+	css := make([]web.ConnStringInfo, 0, len(conns))
+	for _, cs := range p.ConnectionStrings {
+		css = append(css, web.ConnStringInfo{Name: to.StringPtr(cs.Name), ConnectionString: conns[cs.Name].Value, Type: conns[cs.Name].Type})
+	}
+	cfg := &web.SiteConfig{AppSettings: &nvs, ConnectionStrings: &css}
+	if c := p.SiteConfig; c != nil {
+		cfg.LinuxFxVersion = c.LinuxFXVersion
+		cfg.WindowsFxVersion = c.WindowsFXVersion
+		cfg.NetFrameworkVersion = c.NetFrameworkVersion
+		cfg.AlwaysOn = c.AlwaysOn
+		cfg.MinTLSVersion = web.SupportedTLSVersions(azure.ToString(c.MinTLSVersion))
+	}
+	return web.Site{
+		Location: azure.ToStringPtr(p.Location),
+		Tags:     azure.ToStringPtrMap(p.Tags),
+		SiteProperties: &web.SiteProperties{
+			ServerFarmID: azure.ToStringPtr(p.ServerFarmID),
+			HTTPSOnly:    p.HTTPSOnly,
+			SiteConfig:   cfg,
+		},
+	}
+}
+
+// GenerateWebAppObservation returns the observed state of the supplied web
+// app or deployment slot.
+func GenerateWebAppObservation(az web.Site) v1alpha1.WebAppObservation {
+	o := v1alpha1.WebAppObservation{ID: azure.ToString(az.ID)}
+	if az.SiteProperties != nil {
+		o.State = azure.ToString(az.State)
+		o.DefaultHostName = azure.ToString(az.DefaultHostName)
+	}
+	return o
+}
+
+// LateInitializeWebApp fills the empty fields of the supplied parameters
+// with the values of the supplied web app or deployment slot and its
+// configuration.
+func LateInitializeWebApp(p *v1alpha1.WebAppParameters, az web.Site, cfg web.SiteConfigResource) {
+	p.Tags = azure.LateInitializeStringMap(p.Tags, az.Tags)
+	if az.SiteProperties != nil {
+		p.HTTPSOnly = azure.LateInitializeBoolPtrFromPtr(p.HTTPSOnly, az.HTTPSOnly)
+	}
+	if cfg.SiteConfig == nil {
+		return
+	}
+	c := p.SiteConfig
+	if c == nil {
+		c = &v1alpha1.WebAppSiteConfig{}
+	}
+	c.AlwaysOn = azure.LateInitializeBoolPtrFromPtr(c.AlwaysOn, cfg.AlwaysOn)
+	if cfg.MinTLSVersion != "" {
+		c.MinTLSVersion = azure.LateInitializeStringPtrFromVal(c.MinTLSVersion, string(cfg.MinTLSVersion))
+	}
+	if c.AlwaysOn != nil || c.MinTLSVersion != nil {
+		p.SiteConfig = c
+	}
+}
+
+// IsWebAppUpToDate returns true if the supplied web app or deployment slot
+// and its configuration match the supplied parameters. The runtime stack is
+// only compared when it is specified.
+func IsWebAppUpToDate(p v1alpha1.WebAppParameters, az web.Site, cfg web.SiteConfigResource) bool {
+	if az.SiteProperties == nil || cfg.SiteConfig == nil {
+		return false
+	}
+	if p.ServerFarmID != "" && !strings.EqualFold(p.ServerFarmID, azure.ToString(az.ServerFarmID)) {
+		return false
+	}
+	if p.HTTPSOnly != nil && *p.HTTPSOnly != to.Bool(az.HTTPSOnly) {
+		return false
+	}
+	if c := p.SiteConfig; c != nil {
+		switch {
+		case c.LinuxFXVersion != nil && !strings.EqualFold(*c.LinuxFXVersion, azure.ToString(cfg.LinuxFxVersion)),
+			c.WindowsFXVersion != nil && !strings.EqualFold(*c.WindowsFXVersion, azure.ToString(cfg.WindowsFxVersion)),
+			c.NetFrameworkVersion != nil && *c.NetFrameworkVersion != azure.ToString(cfg.NetFrameworkVersion),
+			c.AlwaysOn != nil && *c.AlwaysOn != to.Bool(cfg.AlwaysOn),
+			c.MinTLSVersion != nil && *c.MinTLSVersion != string(cfg.MinTLSVersion):
+			return false
+		}
+	}
+	return cmp.Equal(p.Tags, azure.ToStringMap(az.Tags), cmpopts.EquateEmpty())
+}
+
+// AreAppSettingsUpToDate returns true if the supplied observed application
+// settings are exactly the supplied resolved ones.
+func AreAppSettingsUpToDate(settings map[string]string, az web.StringDictionary) bool {
+	return cmp.Equal(settings, azure.ToStringMap(az.Properties), cmpopts.EquateEmpty())
+}
+
+// AreConnectionStringsUpToDate returns true if the supplied observed
+// connection strings are exactly the supplied resolved ones.
+func AreConnectionStringsUpToDate(conns map[string]web.ConnStringValueTypePair, az web.ConnectionStringDictionary) bool {
+	if len(conns) != len(az.Properties) {
+		return false
+	}
+	for name, want := range conns {
+		got, ok := az.Properties[name]
+		if !ok || got == nil || got.Type != want.Type || to.String(got.Value) != to.String(want.Value) {
+			return false
+		}
+	}
+	return true
+}
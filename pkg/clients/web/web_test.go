@@ -0,0 +1,292 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package web
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/web/v1alpha1"
+)
+
+const (
+	location   = "westus2"
+	planID     = "/subscriptions/sub/resourceGroups/cool-rg/providers/Microsoft.Web/serverfarms/cool-plan"
+	secretName = "cool-secret"
+)
+
+var errBoom = errors.New("boom")
+
+func TestNewAppServicePlan(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.AppServicePlanParameters
+		want web.AppServicePlan
+	}{
+		"Linux": {
+			p: v1alpha1.AppServicePlanParameters{
+				Location: location,
+				OSType:   to.StringPtr(v1alpha1.OSTypeLinux),
+				SKU:      v1alpha1.AppServicePlanSKU{Name: "P1v2", Capacity: to.Int32Ptr(2)},
+				Tags:     map[string]string{"cool": "plan"},
+			},
+			want: web.AppServicePlan{
+				Location:                 to.StringPtr(location),
+				Kind:                     to.StringPtr("linux"),
+				Tags:                     map[string]*string{"cool": to.StringPtr("plan")},
+				Sku:                      &web.SkuDescription{Name: to.StringPtr("P1v2"), Capacity: to.Int32Ptr(2)},
+				AppServicePlanProperties: &web.AppServicePlanProperties{Reserved: to.BoolPtr(true)},
+			},
+		},
+		"Windows": {
+			p: v1alpha1.AppServicePlanParameters{
+				Location: location,
+				SKU:      v1alpha1.AppServicePlanSKU{Name: "S1"},
+			},
+			want: web.AppServicePlan{
+				Location:                 to.StringPtr(location),
+				Sku:                      &web.SkuDescription{Name: to.StringPtr("S1")},
+				AppServicePlanProperties: &web.AppServicePlanProperties{Reserved: to.BoolPtr(false)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewAppServicePlan(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewAppServicePlan(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAppServicePlanUpToDate(t *testing.T) {
+	az := web.AppServicePlan{
+		Sku:  &web.SkuDescription{Name: to.StringPtr("P1v2"), Capacity: to.Int32Ptr(1)},
+		Tags: map[string]*string{"cool": to.StringPtr("plan")},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.AppServicePlanParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.AppServicePlanParameters{
+				SKU:  v1alpha1.AppServicePlanSKU{Name: "p1v2", Capacity: to.Int32Ptr(1)},
+				Tags: map[string]string{"cool": "plan"},
+			},
+			want: true,
+		},
+		"SKUChanged": {
+			p: v1alpha1.AppServicePlanParameters{
+				SKU:  v1alpha1.AppServicePlanSKU{Name: "P2v2"},
+				Tags: map[string]string{"cool": "plan"},
+			},
+			want: false,
+		},
+		"CapacityChanged": {
+			p: v1alpha1.AppServicePlanParameters{
+				SKU:  v1alpha1.AppServicePlanSKU{Name: "P1v2", Capacity: to.Int32Ptr(3)},
+				Tags: map[string]string{"cool": "plan"},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			p: v1alpha1.AppServicePlanParameters{
+				SKU: v1alpha1.AppServicePlanSKU{Name: "P1v2"},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAppServicePlanUpToDate(tc.p, az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsAppServicePlanUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResolveAppSettings(t *testing.T) {
+	ref := &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: secretName}, Key: "key"}
+
+	type want struct {
+		settings map[string]string
+		err      error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		p    v1alpha1.WebAppParameters
+		want want
+	}{
+		"GetSecretError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p:    v1alpha1.WebAppParameters{AppSettings: []v1alpha1.WebAppSetting{{Name: "KEY", ValueSecretRef: ref}}},
+			want: want{err: errors.Wrap(errBoom, "cannot get secret")},
+		},
+		"Success": {
+			kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+				obj.(*corev1.Secret).Data = map[string][]byte{"key": []byte("secret")}
+				return nil
+			}},
+			p: v1alpha1.WebAppParameters{AppSettings: []v1alpha1.WebAppSetting{
+				{Name: "MODE", Value: to.StringPtr("release")},
+				{Name: "KEY", Value: to.StringPtr("ignored"), ValueSecretRef: ref},
+			}},
+			want: want{settings: map[string]string{"MODE": "release", "KEY": "secret"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ResolveAppSettings(context.Background(), tc.kube, tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ResolveAppSettings(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.settings, got); diff != "" {
+				t.Errorf("ResolveAppSettings(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewSite(t *testing.T) {
+	p := v1alpha1.WebAppParameters{
+		Location:     location,
+		ServerFarmID: planID,
+		HTTPSOnly:    to.BoolPtr(true),
+		SiteConfig: &v1alpha1.WebAppSiteConfig{
+			LinuxFXVersion: to.StringPtr("NODE|18-lts"),
+			MinTLSVersion:  to.StringPtr("1.2"),
+		},
+		AppSettings:       []v1alpha1.WebAppSetting{{Name: "MODE"}},
+		ConnectionStrings: []v1alpha1.WebAppConnectionString{{Name: "db", Type: "SQLAzure"}},
+		Tags:              map[string]string{"cool": "app"},
+	}
+	settings := map[string]string{"MODE": "release"}
+	conns := map[string]web.ConnStringValueTypePair{"db": {Value: to.StringPtr("conn"), Type: web.ConnectionStringTypeSQLAzure}}
+
+	want := web.Site{
+		Location: to.StringPtr(location),
+		Tags:     map[string]*string{"cool": to.StringPtr("app")},
+		SiteProperties: &web.SiteProperties{
+			ServerFarmID: to.StringPtr(planID),
+			HTTPSOnly:    to.BoolPtr(true),
+			SiteConfig: &web.SiteConfig{
+				LinuxFxVersion:    to.StringPtr("NODE|18-lts"),
+				MinTLSVersion:     web.SupportedTLSVersionsOneFullStopTwo,
+				AppSettings:       &[]web.NameValuePair{{Name: to.StringPtr("MODE"), Value: to.StringPtr("release")}},
+				ConnectionStrings: &[]web.ConnStringInfo{{Name: to.StringPtr("db"), ConnectionString: to.StringPtr("conn"), Type: web.ConnectionStringTypeSQLAzure}},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, NewSite(p, settings, conns)); diff != "" {
+		t.Errorf("NewSite(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsWebAppUpToDate(t *testing.T) {
+	az := web.Site{SiteProperties: &web.SiteProperties{ServerFarmID: to.StringPtr(planID), HTTPSOnly: to.BoolPtr(true)}}
+	cfg := web.SiteConfigResource{SiteConfig: &web.SiteConfig{LinuxFxVersion: to.StringPtr("NODE|18-lts"), AlwaysOn: to.BoolPtr(true)}}
+
+	cases := map[string]struct {
+		p    v1alpha1.WebAppParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.WebAppParameters{
+				ServerFarmID: planID,
+				HTTPSOnly:    to.BoolPtr(true),
+				SiteConfig:   &v1alpha1.WebAppSiteConfig{LinuxFXVersion: to.StringPtr("node|18-lts")},
+			},
+			want: true,
+		},
+		"RuntimeChanged": {
+			p: v1alpha1.WebAppParameters{
+				ServerFarmID: planID,
+				SiteConfig:   &v1alpha1.WebAppSiteConfig{LinuxFXVersion: to.StringPtr("PYTHON|3.11")},
+			},
+			want: false,
+		},
+		"AlwaysOnChanged": {
+			p: v1alpha1.WebAppParameters{
+				SiteConfig: &v1alpha1.WebAppSiteConfig{AlwaysOn: to.BoolPtr(false)},
+			},
+			want: false,
+		},
+		"HTTPSOnlyChanged": {
+			p:    v1alpha1.WebAppParameters{HTTPSOnly: to.BoolPtr(false)},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsWebAppUpToDate(tc.p, az, cfg)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsWebAppUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAreConnectionStringsUpToDate(t *testing.T) {
+	conns := map[string]web.ConnStringValueTypePair{"db": {Value: to.StringPtr("conn"), Type: web.ConnectionStringTypeSQLAzure}}
+
+	cases := map[string]struct {
+		az   web.ConnectionStringDictionary
+		want bool
+	}{
+		"UpToDate": {
+			az:   web.ConnectionStringDictionary{Properties: map[string]*web.ConnStringValueTypePair{"db": {Value: to.StringPtr("conn"), Type: web.ConnectionStringTypeSQLAzure}}},
+			want: true,
+		},
+		"ValueChanged": {
+			az:   web.ConnectionStringDictionary{Properties: map[string]*web.ConnStringValueTypePair{"db": {Value: to.StringPtr("old"), Type: web.ConnectionStringTypeSQLAzure}}},
+			want: false,
+		},
+		"Extra": {
+			az: web.ConnectionStringDictionary{Properties: map[string]*web.ConnStringValueTypePair{
+				"db":    {Value: to.StringPtr("conn"), Type: web.ConnectionStringTypeSQLAzure},
+				"cache": {Value: to.StringPtr("redis"), Type: web.ConnectionStringTypeRedisCache},
+			}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := AreConnectionStringsUpToDate(conns, tc.az)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("AreConnectionStringsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/pkg/errors"
+)
+
+// Environment variables set by the Azure AD workload identity webhook on pods
+// whose service account is federated with an Azure AD application.
+const (
+	EnvClientID           = "AZURE_CLIENT_ID"
+	EnvTenantID           = "AZURE_TENANT_ID"
+	EnvFederatedTokenFile = "AZURE_FEDERATED_TOKEN_FILE"
+	EnvAuthorityHost      = "AZURE_AUTHORITY_HOST"
+)
+
+const (
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+	// Tokens are refreshed a while before they expire, so that requests
+	// authorized just before they expire don't fail in flight.
+	tokenRefreshBefore = 5 * time.Minute
+
+	errNoFederatedTokenFile   = "workload identity requires a federated token file"
+	errReadFederatedToken     = "cannot read federated service account token"
+	errExchangeFederatedToken = "cannot exchange federated service account token for an Azure AD token"
+	errFmtTokenEndpoint       = "Azure AD token endpoint returned %s: %s"
+)
+
+// UseWorkloadIdentity returns true if the supplied credentials content enables
+// Azure AD workload identity authentication.
+func UseWorkloadIdentity(creds map[string]string) bool {
+	return strings.EqualFold(creds[CredentialsKeyUseWorkloadIdentity], "true")
+}
+
+// WithWorkloadIdentity returns the supplied credentials content with Azure AD
+// workload identity authentication enabled if useWorkloadIdentity is true.
+func WithWorkloadIdentity(creds map[string]string, useWorkloadIdentity bool) map[string]string {
+	if !useWorkloadIdentity {
+		return creds
+	}
+	if creds == nil {
+		creds = map[string]string{}
+	}
+	creds[CredentialsKeyUseWorkloadIdentity] = "true"
+	return creds
+}
+
+// federatedCredentials caches a federatedCredential per identity, so that the
+// Azure AD tokens they acquire are shared by all clients rather than being
+// acquired again each time a client is built.
+var federatedCredentials sync.Map
+
+// NewFederatedCredential returns a credential that exchanges the Kubernetes
+// service account token found in a federated token file for an Azure AD
+// token, using the tenant ID, client ID and token file found in the supplied
+// credentials content. Values the content does not specify are read from the
+// environment variables set by the Azure AD workload identity webhook.
+// Credentials are shared by all callers that supply the same identity.
+func NewFederatedCredential(creds map[string]string) (azcore.TokenCredential, error) {
+	c := &federatedCredential{
+		tenantID:  valueOrEnv(creds, CredentialsKeyTenantID, EnvTenantID),
+		clientID:  valueOrEnv(creds, CredentialsKeyClientID, EnvClientID),
+		tokenFile: valueOrEnv(creds, CredentialsKeyFederatedTokenFile, EnvFederatedTokenFile),
+		authority: valueOrEnv(creds, CredentialsKeyActiveDirectoryEndpointURL, EnvAuthorityHost),
+		client:    http.DefaultClient,
+		now:       time.Now,
+		tokens:    map[string]azcore.AccessToken{},
+	}
+	if c.tokenFile == "" {
+		return nil, errors.New(errNoFederatedTokenFile)
+	}
+	if c.authority == "" {
+		c.authority = cloud.AzurePublic.ActiveDirectoryAuthorityHost
+	}
+	key := strings.Join([]string{c.authority, c.tenantID, c.clientID, c.tokenFile}, "/")
+	shared, _ := federatedCredentials.LoadOrStore(key, c)
+	return shared.(*federatedCredential), nil
+}
+
+func valueOrEnv(creds map[string]string, key, env string) string {
+	if v := creds[key]; v != "" {
+		return v
+	}
+	return os.Getenv(env)
+}
+
+type federatedCredential struct {
+	tenantID  string
+	clientID  string
+	tokenFile string
+	authority string
+	client    *http.Client
+	now       func() time.Time
+
+	mu     sync.Mutex
+	tokens map[string]azcore.AccessToken
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// GetToken returns a cached Azure AD token for the supplied scopes, unless it
+// is about to expire. Otherwise the service account token is read again,
+// since the kubelet rotates it, and exchanged for a new Azure AD token.
+func (c *federatedCredential) GetToken(ctx context.Context, o policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	scope := strings.Join(o.Scopes, " ")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if tk, ok := c.tokens[scope]; ok && c.now().Add(tokenRefreshBefore).Before(tk.ExpiresOn) {
+		return tk, nil
+	}
+
+	assertion, err := os.ReadFile(c.tokenFile)
+	if err != nil {
+		return azcore.AccessToken{}, errors.Wrap(err, errReadFederatedToken)
+	}
+	tk, err := c.exchange(ctx, scope, strings.TrimSpace(string(assertion)))
+	if err != nil {
+		return azcore.AccessToken{}, errors.Wrap(err, errExchangeFederatedToken)
+	}
+	c.tokens[scope] = tk
+	return tk, nil
+}
+
+func (c *federatedCredential) exchange(ctx context.Context, scope, assertion string) (azcore.AccessToken, error) {
+	form := url.Values{
+		"grant_type":            {"client_credentials"},
+		"client_id":             {c.clientID},
+		"client_assertion_type": {clientAssertionType},
+		"client_assertion":      {assertion},
+		"scope":                 {scope},
+	}
+	ep := strings.TrimSuffix(c.authority, "/") + "/" + c.tenantID + "/oauth2/v2.0/token"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ep, strings.NewReader(form.Encode()))
+	if err != nil {
+		return azcore.AccessToken{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	now := c.now()
+	rsp, err := c.client.Do(req)
+	if err != nil {
+		return azcore.AccessToken{}, err
+	}
+	defer rsp.Body.Close() // nolint:errcheck
+
+	if rsp.StatusCode != http.StatusOK {
+		body := map[string]interface{}{}
+		_ = json.NewDecoder(rsp.Body).Decode(&body)
+		return azcore.AccessToken{}, errors.Errorf(errFmtTokenEndpoint, rsp.Status, fmt.Sprint(body["error_description"]))
+	}
+	tr := tokenResponse{}
+	if err := json.NewDecoder(rsp.Body).Decode(&tr); err != nil {
+		return azcore.AccessToken{}, err
+	}
+	return azcore.AccessToken{Token: tr.AccessToken, ExpiresOn: now.Add(time.Duration(tr.ExpiresIn) * time.Second)}, nil
+}
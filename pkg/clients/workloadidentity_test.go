@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFederatedCredentialGetToken(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(file, []byte("sa-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var assertions []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if diff := cmp.Diff("/tenant/oauth2/v2.0/token", r.URL.Path); diff != "" {
+			t.Errorf("POST: -want path, +got path:\n%s", diff)
+		}
+		_ = r.ParseForm()
+		assertions = append(assertions, r.PostForm.Get("client_assertion"))
+		if r.PostForm.Get("client_id") != "client" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error_description": "AADSTS700016: application not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"access_token": "aad-token-%d", "expires_in": 3600}`, len(assertions))
+	}))
+	defer srv.Close()
+
+	now := time.Now()
+	c := &federatedCredential{
+		tenantID:  "tenant",
+		clientID:  "client",
+		tokenFile: file,
+		authority: srv.URL + "/",
+		client:    srv.Client(),
+		now:       func() time.Time { return now },
+		tokens:    map[string]azcore.AccessToken{},
+	}
+	get := func() string {
+		tk, err := c.GetToken(context.Background(), policy.TokenRequestOptions{Scopes: []string{"https://management.azure.com//.default"}})
+		if err != nil {
+			t.Fatalf("GetToken(...): %s", err)
+		}
+		return tk.Token
+	}
+
+	if diff := cmp.Diff("aad-token-1", get()); diff != "" {
+		t.Errorf("GetToken(...): -want, +got:\n%s", diff)
+	}
+
+	// The cached token is returned until it is about to expire.
+	now = now.Add(50 * time.Minute)
+	if diff := cmp.Diff("aad-token-1", get()); diff != "" {
+		t.Errorf("GetToken(...): -want cached, +got:\n%s", diff)
+	}
+
+	// The rotated service account token is exchanged for a new token.
+	if err := os.WriteFile(file, []byte("rotated-sa-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(6 * time.Minute)
+	if diff := cmp.Diff("aad-token-2", get()); diff != "" {
+		t.Errorf("GetToken(...): -want refreshed, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"sa-token", "rotated-sa-token"}, assertions); diff != "" {
+		t.Errorf("GetToken(...): -want assertions, +got assertions:\n%s", diff)
+	}
+
+	c.clientID = "unknown"
+	c.tokens = map[string]azcore.AccessToken{}
+	if _, err := c.GetToken(context.Background(), policy.TokenRequestOptions{Scopes: []string{"scope"}}); err == nil {
+		t.Errorf("GetToken(...): want error for unknown client")
+	}
+}
+
+func TestNewFederatedCredential(t *testing.T) {
+	t.Setenv(EnvTenantID, "tenant")
+	t.Setenv(EnvFederatedTokenFile, "/var/run/secrets/azure/tokens/azure-identity-token")
+
+	creds := map[string]string{CredentialsKeyUseWorkloadIdentity: "true", CredentialsKeyClientID: "client"}
+	a, err := NewFederatedCredential(creds)
+	if err != nil {
+		t.Fatalf("NewFederatedCredential(...): %s", err)
+	}
+	b, err := NewTokenCredential(creds)
+	if err != nil {
+		t.Fatalf("NewTokenCredential(...): %s", err)
+	}
+	if a != b {
+		t.Errorf("NewTokenCredential(...): want the credential of the same identity to be shared")
+	}
+	fc := a.(*federatedCredential)
+	if diff := cmp.Diff("tenant", fc.tenantID); diff != "" {
+		t.Errorf("NewFederatedCredential(...): -want tenant, +got tenant:\n%s", diff)
+	}
+
+	t.Setenv(EnvFederatedTokenFile, "")
+	if _, err := NewFederatedCredential(map[string]string{}); err == nil {
+		t.Errorf("NewFederatedCredential(...): want error without a federated token file")
+	}
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package advisor
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	cachev1beta1 "github.com/crossplane-contrib/provider-azure/apis/cache/v1beta1"
+	computev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	databasev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
+	databasev1beta1 "github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
+	azurev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	advisorclients "github.com/crossplane-contrib/provider-azure/pkg/clients/advisor"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+const (
+	// Advisor refreshes most recommendations about once a day, so there is
+	// little point in asking for them more often.
+	pollInterval = 1 * time.Hour
+
+	reasonRecommendation event.Reason = "AdvisorRecommendation"
+
+	errGetManaged   = "cannot get managed resource"
+	errUpdateStatus = "cannot update managed resource status"
+)
+
+// A kind of managed resource that Azure Advisor makes recommendations for.
+type kind struct {
+	groupKind  string
+	newManaged func() resource.Managed
+	resourceID func(resource.Managed) string
+}
+
+var kinds = []kind{
+	{
+		groupKind:  computev1alpha3.AKSClusterGroupKind,
+		newManaged: func() resource.Managed { return &computev1alpha3.AKSCluster{} },
+		resourceID: func(mg resource.Managed) string { return mg.(*computev1alpha3.AKSCluster).Status.ProviderID },
+	},
+	{
+		groupKind:  cachev1beta1.RedisGroupKind,
+		newManaged: func() resource.Managed { return &cachev1beta1.Redis{} },
+		resourceID: func(mg resource.Managed) string { return mg.(*cachev1beta1.Redis).Status.AtProvider.ID },
+	},
+	{
+		groupKind:  databasev1beta1.MySQLServerGroupKind,
+		newManaged: func() resource.Managed { return &databasev1beta1.MySQLServer{} },
+		resourceID: func(mg resource.Managed) string { return mg.(*databasev1beta1.MySQLServer).Status.AtProvider.ID },
+	},
+	{
+		groupKind:  databasev1beta1.PostgreSQLServerGroupKind,
+		newManaged: func() resource.Managed { return &databasev1beta1.PostgreSQLServer{} },
+		resourceID: func(mg resource.Managed) string { return mg.(*databasev1beta1.PostgreSQLServer).Status.AtProvider.ID },
+	},
+	{
+		groupKind:  databasev1alpha3.CosmosDBAccountGroupKind,
+		newManaged: func() resource.Managed { return &databasev1alpha3.CosmosDBAccount{} },
+		resourceID: func(mg resource.Managed) string {
+			if o := mg.(*databasev1alpha3.CosmosDBAccount).Status.AtProvider; o != nil {
+				return o.ID
+			}
+			return ""
+		},
+	},
+}
+
+// Setup adds controllers that surface the Azure Advisor recommendations of
+// managed resources as conditions and events, if the feature is enabled.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	if !o.Features.Enabled(features.EnableAlphaAdvisorRecommendations) {
+		return nil
+	}
+	for _, k := range kinds {
+		name := "advisor/" + strings.ToLower(k.groupKind)
+		r := &Reconciler{
+			kube:       mgr.GetClient(),
+			newManaged: k.newManaged,
+			resourceID: k.resourceID,
+			connect:    connect(mgr.GetClient()),
+			poll:       pollInterval,
+			log:        o.Logger.WithValues("controller", name),
+			record:     event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+		}
+		if err := ctrl.NewControllerManagedBy(mgr).
+			Named(name).
+			WithOptions(o.ForControllerRuntime()).
+			For(k.newManaged()).
+			Complete(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func connect(kube client.Client) func(context.Context, resource.Managed) (advisorclients.RecommendationsAPI, error) {
+	return func(ctx context.Context, mg resource.Managed) (advisorclients.RecommendationsAPI, error) {
+		creds, auth, err := azure.GetAuthInfo(ctx, kube, mg)
+		if err != nil {
+			return nil, err
+		}
+		return advisorclients.NewRecommendationsClient(creds, auth), nil
+	}
+}
+
+// A Reconciler surfaces the Azure Advisor recommendations of a kind of
+// managed resource.
+type Reconciler struct {
+	kube       client.Client
+	newManaged func() resource.Managed
+	resourceID func(resource.Managed) string
+	connect    func(context.Context, resource.Managed) (advisorclients.RecommendationsAPI, error)
+	poll       time.Duration
+	log        logging.Logger
+	record     event.Recorder
+}
+
+// Reconcile the Azure Advisor recommendations of a managed resource.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	r.log.Debug("Reconciling", "request", req)
+
+	mg := r.newManaged()
+	if err := r.kube.Get(ctx, req.NamespacedName, mg); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetManaged)
+	}
+
+	// Resources are reconciled again when their ID is first observed, since
+	// that updates their status.
+	id := r.resourceID(mg)
+	if meta.WasDeleted(mg) || id == "" {
+		return reconcile.Result{}, nil
+	}
+
+	api, err := r.connect(ctx, mg)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	recs, err := api.ListRecommendations(ctx, id)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	summary := advisorclients.Summarize(recs)
+	c := azurev1alpha3.NoAdvisorRecommendations()
+	if len(summary) > 0 {
+		c = azurev1alpha3.AdvisorRecommendations(summary...)
+	}
+	previous := mg.GetCondition(azurev1alpha3.TypeAdvisorRecommendations)
+	if previous.Equal(c) {
+		return reconcile.Result{RequeueAfter: r.poll}, nil
+	}
+
+	// Only emit events for recommendations we haven't seen before.
+	seen := map[string]bool{}
+	if previous.Reason == azurev1alpha3.ReasonRecommendationsFound {
+		for _, s := range strings.Split(previous.Message, "; ") {
+			seen[s] = true
+		}
+	}
+	for _, s := range summary {
+		if !seen[s] {
+			r.record.Event(mg, event.Normal(reasonRecommendation, s))
+		}
+	}
+
+	mg.SetConditions(c)
+	return reconcile.Result{RequeueAfter: r.poll}, errors.Wrap(r.kube.Status().Update(ctx, mg), errUpdateStatus)
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package advisor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/advisor/mgmt/2020-01-01/advisor"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
+	azurev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	advisorclients "github.com/crossplane-contrib/provider-azure/pkg/clients/advisor"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/advisor/fake"
+)
+
+const (
+	id      = "/subscriptions/coolsub/resourceGroups/coolgroup/providers/Microsoft.DBforMySQL/servers/coolserver"
+	problem = "Enable Azure Defender"
+	summary = "Security (High impact): " + problem
+)
+
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) { r.events = append(r.events, e) }
+
+func (r *recorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func server(id string, c ...xpv1.Condition) test.ObjectFn {
+	return func(obj client.Object) error {
+		s := obj.(*v1beta1.MySQLServer)
+		s.Status.AtProvider.ID = id
+		s.SetConditions(c...)
+		return nil
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+	recs := []advisor.ResourceRecommendationBase{{
+		RecommendationProperties: &advisor.RecommendationProperties{
+			Category:         advisor.Security,
+			Impact:           advisor.High,
+			ShortDescription: &advisor.ShortDescription{Problem: to.StringPtr(problem)},
+		},
+	}}
+	api := func(recs []advisor.ResourceRecommendationBase, err error) func(context.Context, resource.Managed) (advisorclients.RecommendationsAPI, error) {
+		return func(context.Context, resource.Managed) (advisorclients.RecommendationsAPI, error) {
+			return &fake.MockRecommendationsAPI{
+				MockListRecommendations: func(_ context.Context, _ string) ([]advisor.ResourceRecommendationBase, error) {
+					return recs, err
+				},
+			}, nil
+		}
+	}
+
+	type args struct {
+		kube    client.Client
+		connect func(context.Context, resource.Managed) (advisorclients.RecommendationsAPI, error)
+	}
+	type want struct {
+		result reconcile.Result
+		err    error
+		events int
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"GetError": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetManaged),
+			},
+		},
+		"NotObserved": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			},
+		},
+		"ConnectError": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, server(id))},
+				connect: func(context.Context, resource.Managed) (advisorclients.RecommendationsAPI, error) {
+					return nil, errBoom
+				},
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"ListError": {
+			args: args{
+				kube:    &test.MockClient{MockGet: test.NewMockGetFn(nil, server(id))},
+				connect: api(nil, errBoom),
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"Unchanged": {
+			args: args{
+				kube:    &test.MockClient{MockGet: test.NewMockGetFn(nil, server(id, azurev1alpha3.AdvisorRecommendations(summary)))},
+				connect: api(recs, nil),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: pollInterval},
+			},
+		},
+		"NewRecommendation": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, server(id, azurev1alpha3.NoAdvisorRecommendations())),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(nil, func(obj client.Object) error {
+						c := obj.(*v1beta1.MySQLServer).GetCondition(azurev1alpha3.TypeAdvisorRecommendations)
+						if diff := cmp.Diff(azurev1alpha3.AdvisorRecommendations(summary), c, test.EquateConditions()); diff != "" {
+							t.Errorf("Status().Update(...): -want condition, +got condition:\n%s", diff)
+						}
+						return nil
+					}),
+				},
+				connect: api(recs, nil),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: pollInterval},
+				events: 1,
+			},
+		},
+		"RecommendationResolved": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:          test.NewMockGetFn(nil, server(id, azurev1alpha3.AdvisorRecommendations(summary))),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(errBoom),
+				},
+				connect: api(nil, nil),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: pollInterval},
+				err:    errors.Wrap(errBoom, errUpdateStatus),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rec := &recorder{}
+			r := &Reconciler{
+				kube:       tc.args.kube,
+				newManaged: func() resource.Managed { return &v1beta1.MySQLServer{} },
+				resourceID: func(mg resource.Managed) string { return mg.(*v1beta1.MySQLServer).Status.AtProvider.ID },
+				connect:    tc.args.connect,
+				poll:       pollInterval,
+				log:        logging.NewNopLogger(),
+				record:     rec,
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r.Reconcile(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r.Reconcile(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, len(rec.events)); diff != "" {
+				t.Errorf("r.Reconcile(...): -want events, +got events:\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,27 +21,58 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/advisor"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/cache"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/compute"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/compute/aksnodepool"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/compute/disk"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/compute/snapshot"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/compute/virtualmachine"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/config"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/cost"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/cosmosdb"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/cosmosdbsqlcontainer"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/cosmosdbsqldatabase"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/mysqldatabase"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/mysqlserver"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/mysqlserverconfiguration"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/mysqlserverfirewallrule"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/mysqlservervirtualnetworkrule"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/postgresqldatabase"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/postgresqlserver"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/postgresqlserverconfiguration"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/postgresqlserverfirewallrule"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/database/postgresqlservervirtualnetworkrule"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/dns/recordset"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/dns/zone"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/insights/applicationinsights"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/insights/diagnosticsetting"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/insights/loganalyticsworkspace"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/keyvault/secret"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/keyvault/vault"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/messaging/eventhub"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/messaging/eventhubnamespace"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/messaging/servicebusnamespace"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/messaging/servicebusqueue"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/messaging/servicebussubscription"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/messaging/servicebustopic"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/applicationgateway"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/privateendpoint"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/publicipaddress"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/securitygroup"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/subnet"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/network/virtualnetwork"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/resourcegroup"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/sql/elasticpool"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/sql/sqldatabase"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/sql/sqlserver"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/storage/account"
 	"github.com/crossplane-contrib/provider-azure/pkg/controller/storage/container"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/storage/fileshare"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/web/appserviceplan"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/web/functionapp"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/web/webapp"
+	"github.com/crossplane-contrib/provider-azure/pkg/controller/web/webappslot"
 )
 
 // Setup Azure controllers.
@@ -49,24 +80,55 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		cache.SetupRedis,
 		compute.SetupAKSCluster,
+		aksnodepool.Setup,
+		virtualmachine.Setup,
+		disk.Setup,
+		snapshot.Setup,
 		mysqlserver.Setup,
 		mysqlserverfirewallrule.Setup,
 		mysqlservervirtualnetworkrule.Setup,
 		mysqlserverconfiguration.Setup,
+		mysqldatabase.Setup,
 		postgresqlserver.Setup,
 		postgresqlserverfirewallrule.Setup,
 		postgresqlservervirtualnetworkrule.Setup,
 		postgresqlserverconfiguration.Setup,
+		postgresqldatabase.Setup,
 		cosmosdb.Setup,
+		cosmosdbsqldatabase.Setup,
+		cosmosdbsqlcontainer.Setup,
+		sqlserver.Setup,
+		elasticpool.Setup,
+		sqldatabase.Setup,
 		publicipaddress.Setup,
+		securitygroup.Setup,
+		applicationgateway.Setup,
+		privateendpoint.Setup,
 		virtualnetwork.Setup,
 		subnet.Setup,
 		resourcegroup.Setup,
 		account.Setup,
 		container.Setup,
+		fileshare.Setup,
+		appserviceplan.Setup,
+		webapp.Setup,
+		webappslot.Setup,
+		functionapp.Setup,
+		vault.Setup,
 		secret.SetupSecret,
+		servicebusnamespace.Setup,
+		servicebusqueue.Setup,
+		servicebustopic.Setup,
+		servicebussubscription.Setup,
+		eventhubnamespace.Setup,
+		eventhub.Setup,
+		loganalyticsworkspace.Setup,
+		applicationinsights.Setup,
+		diagnosticsetting.Setup,
 		zone.Setup,
 		recordset.Setup,
+		advisor.Setup,
+		cost.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -18,7 +18,9 @@ package cache
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis/redisapi"
@@ -27,7 +29,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -35,8 +36,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-azure/apis/cache/v1beta1"
-	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
+	apisv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
 	redisclients "github.com/crossplane-contrib/provider-azure/pkg/clients/redis"
 	"github.com/crossplane-contrib/provider-azure/pkg/features"
 )
@@ -51,33 +53,53 @@ const (
 	errCreateFailed         = "cannot create the Redis instance"
 	errUpdateFailed         = "cannot update the Redis instance"
 	errDeleteFailed         = "cannot delete the Redis instance"
+	errRotateKeysFailed     = "cannot regenerate the access key of the Redis instance"
 )
 
 // SetupRedis adds a controller that reconciles Redis resources.
 func SetupRedis(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1beta1.RedisGroupKind)
+	o = azure.ControllerOptions(v1beta1.RedisGroupKind, o)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
-		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
 	}
 
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient()), azure.NewDefaultTagger(mgr.GetClient(), resourceTags)}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(bo.ForControllerRuntime(o)).
 		For(&v1beta1.Redis{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(azure.NewInstrumentedReconciler(v1beta1.RedisGroupKind, managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.RedisGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1beta1.RedisGroupKind, bo, r, &connector{kube: mgr.GetClient(), record: r})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-			managed.WithConnectionPublishers(cps...)))
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+func resourceTags(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*v1beta1.Redis)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Tags
 }
 
 type connector struct {
-	kube client.Client
+	kube   client.Client
+	record event.Recorder
 }
 
 func (c connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -87,12 +109,13 @@ func (c connector) Connect(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 	cl := redis.NewClient(creds[azure.CredentialsKeySubscriptionID])
 	cl.Authorizer = auth
-	return &external{kube: c.kube, client: cl}, nil
+	return &external{kube: c.kube, client: cl, record: c.record}, nil
 }
 
 type external struct {
 	kube   client.Client
 	client redisapi.ClientAPI
+	record event.Recorder
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -111,18 +134,16 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 	cr.Status.AtProvider = redisclients.GenerateObservation(cache)
 
-	var conn managed.ConnectionDetails
+	conn := azure.ResourceConnectionDetails(cr.Status.AtProvider.ID, cr.Spec.ForProvider.Location)
 	switch cr.Status.AtProvider.ProvisioningState {
 	case redisclients.ProvisioningStateSucceeded:
 		k, err := c.client.ListKeys(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
 		if err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errListAccessKeysFailed)
 		}
-		conn = managed.ConnectionDetails{
-			xpv1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.HostName),
-			xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(cr.Status.AtProvider.Port)),
-			xpv1.ResourceCredentialsSecretPasswordKey: []byte(azure.ToString(k.PrimaryKey)),
-		}
+		conn[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(cr.Status.AtProvider.HostName)
+		conn[xpv1.ResourceCredentialsSecretPortKey] = []byte(strconv.Itoa(cr.Status.AtProvider.Port))
+		conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(activeKey(cr.Status.CredentialRotation, k))
 		cr.Status.SetConditions(xpv1.Available())
 	case redisclients.ProvisioningStateCreating:
 		cr.Status.SetConditions(xpv1.Creating())
@@ -133,7 +154,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  !redisclients.NeedsUpdate(cr.Spec.ForProvider, cache),
+		ResourceUpToDate:  !redisclients.NeedsUpdate(cr.Spec.ForProvider, cache) && !rotationDue(cr),
 		ConnectionDetails: conn,
 	}, nil
 }
@@ -158,6 +179,10 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.ProvisioningState != redisclients.ProvisioningStateSucceeded {
 		return managed.ExternalUpdate{}, nil
 	}
+	if rotationDue(cr) {
+		cd, err := c.rotateKeys(ctx, cr)
+		return managed.ExternalUpdate{ConnectionDetails: cd}, errors.Wrap(err, errRotateKeysFailed)
+	}
 	cache, err := c.client.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetFailed)
@@ -182,3 +207,32 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	_, err := c.client.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
 	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteFailed)
 }
+
+// rotateKeys regenerates the access key of the supplied Redis that is not
+// published, and returns connection details that publish it instead. Clients
+// that use the previously published key keep working until the next rotation.
+func (c *external) rotateKeys(ctx context.Context, cr *v1beta1.Redis) (managed.ConnectionDetails, error) {
+	standby := azure.StandbyKey(cr.Status.CredentialRotation)
+	k, err := c.client.RegenerateKey(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), redis.RegenerateKeyParameters{KeyType: redis.KeyType(standby)})
+	if err != nil {
+		return nil, err
+	}
+	azure.SetRotated(&cr.Status.CredentialRotation, standby)
+	c.record.Event(cr, event.Normal(azure.ReasonRotatedCredentials, fmt.Sprintf("Regenerated and published the %s access key", strings.ToLower(standby))))
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(activeKey(cr.Status.CredentialRotation, k)),
+	}, nil
+}
+
+func rotationDue(cr *v1beta1.Redis) bool {
+	return azure.RotationDue(cr.Spec.ForProvider.CredentialRotation, cr.Status.CredentialRotation, cr.GetCreationTimestamp())
+}
+
+// activeKey returns the access key that should be published, which is the
+// primary one unless credential rotation has switched to the secondary one.
+func activeKey(s apisv1alpha3.CredentialRotationStatus, k redis.AccessKeys) string {
+	if s.ActiveKey == apisv1alpha3.CredentialKeySecondary {
+		return azure.ToString(k.SecondaryKey)
+	}
+	return azure.ToString(k.PrimaryKey)
+}
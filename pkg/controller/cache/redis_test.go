@@ -21,20 +21,25 @@ import (
 	"net/http"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis/redisapi"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-azure/apis/cache/v1beta1"
+	apisv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 	redisclient "github.com/crossplane-contrib/provider-azure/pkg/clients/redis"
 	"github.com/crossplane-contrib/provider-azure/pkg/clients/redis/fake"
@@ -86,6 +91,17 @@ func withPort(p int) redisResourceModifier {
 	return func(r *v1beta1.Redis) { r.Status.AtProvider.Port = p }
 }
 
+func withCredentialRotation(period, age time.Duration) redisResourceModifier {
+	return func(r *v1beta1.Redis) {
+		r.Spec.ForProvider.CredentialRotation = &apisv1alpha3.CredentialRotationPolicy{Period: metav1.Duration{Duration: period}}
+		r.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-age)))
+	}
+}
+
+func withActiveKey(k string) redisResourceModifier {
+	return func(r *v1beta1.Redis) { r.Status.CredentialRotation.ActiveKey = k }
+}
+
 func instance(rm ...redisResourceModifier) *v1beta1.Redis {
 	r := &v1beta1.Redis{
 		Spec: v1beta1.RedisSpec{
@@ -181,6 +197,7 @@ func TestObserve(t *testing.T) {
 						xpv1.ResourceCredentialsSecretEndpointKey: []byte(hostName),
 						xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
 						xpv1.ResourceCredentialsSecretPasswordKey: []byte(primaryKey),
+						azure.ConnectionSecretKeyLocation:         []byte(location),
 					},
 				},
 			},
@@ -261,6 +278,9 @@ func TestObserve(t *testing.T) {
 				o: managed.ExternalObservation{
 					ResourceUpToDate: false,
 					ResourceExists:   true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyLocation: []byte(location),
+					},
 				},
 			},
 		},
@@ -287,6 +307,9 @@ func TestObserve(t *testing.T) {
 				o: managed.ExternalObservation{
 					ResourceUpToDate: false,
 					ResourceExists:   true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyLocation: []byte(location),
+					},
 				},
 			},
 		},
@@ -313,6 +336,9 @@ func TestObserve(t *testing.T) {
 				o: managed.ExternalObservation{
 					ResourceUpToDate: false,
 					ResourceExists:   true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyLocation: []byte(location),
+					},
 				},
 			},
 		},
@@ -455,6 +481,39 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(errorBoom, errGetFailed),
 			},
 		},
+		"RotateKeys": {
+			args: args{
+				cr: instance(withProvisioningState(redisclient.ProvisioningStateSucceeded), withCredentialRotation(time.Hour, 2*time.Hour), withActiveKey(apisv1alpha3.CredentialKeySecondary)),
+				r: &fake.MockClient{
+					MockRegenerateKey: func(_ context.Context, _ string, _ string, p redis.RegenerateKeyParameters) (result redis.AccessKeys, err error) {
+						if p.KeyType != redis.Primary {
+							return redis.AccessKeys{}, errors.Errorf("regenerated the %s key", p.KeyType)
+						}
+						return redis.AccessKeys{PrimaryKey: azure.ToStringPtr(primaryKey), SecondaryKey: azure.ToStringPtr("old")}, nil
+					},
+				},
+			},
+			want: want{
+				cr: instance(withProvisioningState(redisclient.ProvisioningStateSucceeded), withCredentialRotation(time.Hour, 2*time.Hour), withActiveKey(apisv1alpha3.CredentialKeyPrimary)),
+				o: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(primaryKey),
+				}},
+			},
+		},
+		"RotateKeysFailed": {
+			args: args{
+				cr: instance(withProvisioningState(redisclient.ProvisioningStateSucceeded), withCredentialRotation(time.Hour, 2*time.Hour)),
+				r: &fake.MockClient{
+					MockRegenerateKey: func(_ context.Context, _ string, _ string, _ redis.RegenerateKeyParameters) (result redis.AccessKeys, err error) {
+						return redis.AccessKeys{}, errorBoom
+					},
+				},
+			},
+			want: want{
+				cr:  instance(withProvisioningState(redisclient.ProvisioningStateSucceeded), withCredentialRotation(time.Hour, 2*time.Hour)),
+				err: errors.Wrap(errorBoom, errRotateKeysFailed),
+			},
+		},
 		"UpdateFailed": {
 			args: args{
 				cr: instance(withProvisioningState(redisclient.ProvisioningStateSucceeded)),
@@ -476,10 +535,10 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := external{client: tc.r}
+			e := external{client: tc.r, record: event.NewNopRecorder()}
 
 			c, err := e.Update(context.Background(), tc.args.cr)
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, cmpopts.IgnoreTypes(&metav1.Time{}), cmpopts.IgnoreFields(metav1.ObjectMeta{}, "CreationTimestamp")); diff != "" {
 				t.Errorf("Update(...): -want, +got\n%s", diff)
 			}
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -0,0 +1,182 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aksnodepool
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotAKSNodePool     = "managed resource is not an AKSNodePool"
+	errCreateAKSNodePool  = "cannot create AKSNodePool"
+	errUpdateAKSNodePool  = "cannot update AKSNodePool"
+	errGetAKSNodePool     = "cannot get AKSNodePool"
+	errDeleteAKSNodePool  = "cannot delete AKSNodePool"
+	errFetchLastOperation = "cannot fetch last operation"
+)
+
+// Setup adds a controller that reconciles AKSNodePools.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.AKSNodePoolGroupKind)
+	o = azure.ControllerOptions(v1alpha3.AKSNodePoolGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient()), azure.NewDefaultTagger(mgr.GetClient(), resourceTags)}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&v1alpha3.AKSNodePool{}).
+		Complete(azure.NewInstrumentedReconciler(v1alpha3.AKSNodePoolGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.AKSNodePoolGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1alpha3.AKSNodePoolGroupKind, bo, r, &connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+func resourceTags(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*v1alpha3.AKSNodePool)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Tags
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := containerservice.NewAgentPoolsClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{client: compute.NewAKSNodePoolClient(cl)}, nil
+}
+
+type external struct {
+	client compute.AKSNodePoolAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.AKSNodePool)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAKSNodePool)
+	}
+
+	np, err := e.client.Get(ctx, cr)
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetAKSNodePool)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	compute.LateInitializeAKSNodePool(&cr.Spec.ForProvider, np)
+	li := !cmp.Equal(current, &cr.Spec.ForProvider)
+
+	compute.UpdateAKSNodePoolObservation(&cr.Status.AtProvider, np)
+	if err := azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+	}
+
+	if cr.Status.AtProvider.ProvisioningState != "Succeeded" {
+		// Node pools that are being created, scaled or upgraded can't be
+		// updated until the operation completes.
+		cr.SetConditions(xpv1.Unavailable())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: li}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        compute.IsAKSNodePoolUpToDate(cr.Spec.ForProvider, np),
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.AKSNodePool)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAKSNodePool)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	if err := e.client.CreateOrUpdate(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAKSNodePool)
+	}
+	return managed.ExternalCreation{}, errors.Wrap(azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation), errFetchLastOperation)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.AKSNodePool)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAKSNodePool)
+	}
+	if cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress {
+		return managed.ExternalUpdate{}, nil
+	}
+	if err := e.client.CreateOrUpdate(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAKSNodePool)
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation), errFetchLastOperation)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.AKSNodePool)
+	if !ok {
+		return errors.New(errNotAKSNodePool)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, e.client.Delete(ctx, cr)), errDeleteAKSNodePool)
+}
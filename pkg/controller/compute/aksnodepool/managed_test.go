@@ -0,0 +1,348 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aksnodepool
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azurev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute/fake"
+)
+
+const (
+	id     = "cool-id"
+	vmSize = "Standard_B2s"
+)
+
+var errBoom = errors.New("boom")
+
+type modifier func(*v1alpha3.AKSNodePool)
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(np *v1alpha3.AKSNodePool) { np.Status.SetConditions(c...) }
+}
+
+func withCount(count int) modifier {
+	return func(np *v1alpha3.AKSNodePool) { np.Spec.ForProvider.Count = &count }
+}
+
+func withObservation(o v1alpha3.AKSNodePoolObservation) modifier {
+	return func(np *v1alpha3.AKSNodePool) { np.Status.AtProvider = o }
+}
+
+func withLastOperation(op azurev1alpha3.AsyncOperation) modifier {
+	return func(np *v1alpha3.AKSNodePool) { np.Status.AtProvider.LastOperation = op }
+}
+
+func nodePool(m ...modifier) *v1alpha3.AKSNodePool {
+	np := &v1alpha3.AKSNodePool{
+		Spec: v1alpha3.AKSNodePoolSpec{
+			ForProvider: v1alpha3.AKSNodePoolParameters{
+				ResourceGroupName: "cool-rg",
+				ClusterName:       "cool-cluster",
+				VMSize:            vmSize,
+			},
+		},
+	}
+	for _, mod := range m {
+		mod(np)
+	}
+	return np
+}
+
+func agentPool(state string, count int32) containerservice.AgentPool {
+	return containerservice.AgentPool{
+		ID: to.StringPtr(id),
+		ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+			Count:             to.Int32Ptr(count),
+			VMSize:            to.StringPtr(vmSize),
+			ProvisioningState: to.StringPtr(state),
+		},
+	}
+}
+
+func sender() autorest.Sender {
+	return autorest.SenderFunc(func(*http.Request) (*http.Response, error) { return nil, nil })
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotAKSNodePool": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotAKSNodePool),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockAKSNodePoolAPI{
+				MockGet: func(_ context.Context, _ *v1alpha3.AKSNodePool) (containerservice.AgentPool, error) {
+					return containerservice.AgentPool{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: nodePool(),
+			want: want{
+				mg: nodePool(),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			e: &external{client: &fake.MockAKSNodePoolAPI{
+				MockGet: func(_ context.Context, _ *v1alpha3.AKSNodePool) (containerservice.AgentPool, error) {
+					return containerservice.AgentPool{}, errBoom
+				},
+			}},
+			mg: nodePool(),
+			want: want{
+				mg:  nodePool(),
+				err: errors.Wrap(errBoom, errGetAKSNodePool),
+			},
+		},
+		"Scaling": {
+			e: &external{client: &fake.MockAKSNodePoolAPI{
+				MockGet: func(_ context.Context, _ *v1alpha3.AKSNodePool) (containerservice.AgentPool, error) {
+					return agentPool("Scaling", 1), nil
+				},
+				MockGetRESTClient: sender,
+			}},
+			mg: nodePool(withCount(3)),
+			want: want{
+				mg: nodePool(withCount(3),
+					withObservation(v1alpha3.AKSNodePoolObservation{ID: id, ProvisioningState: "Scaling"}),
+					withConditions(xpv1.Unavailable())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			e: &external{client: &fake.MockAKSNodePoolAPI{
+				MockGet: func(_ context.Context, _ *v1alpha3.AKSNodePool) (containerservice.AgentPool, error) {
+					return agentPool("Succeeded", 1), nil
+				},
+				MockGetRESTClient: sender,
+			}},
+			mg: nodePool(withCount(3)),
+			want: want{
+				mg: nodePool(withCount(3),
+					withObservation(v1alpha3.AKSNodePoolObservation{ID: id, ProvisioningState: "Succeeded"}),
+					withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"UpToDate": {
+			e: &external{client: &fake.MockAKSNodePoolAPI{
+				MockGet: func(_ context.Context, _ *v1alpha3.AKSNodePool) (containerservice.AgentPool, error) {
+					return agentPool("Succeeded", 1), nil
+				},
+				MockGetRESTClient: sender,
+			}},
+			mg: nodePool(withCount(1)),
+			want: want{
+				mg: nodePool(
+					withCount(1),
+					withObservation(v1alpha3.AKSNodePoolObservation{ID: id, ProvisioningState: "Succeeded"}),
+					withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialized": {
+			e: &external{client: &fake.MockAKSNodePoolAPI{
+				MockGet: func(_ context.Context, _ *v1alpha3.AKSNodePool) (containerservice.AgentPool, error) {
+					return agentPool("Succeeded", 3), nil
+				},
+				MockGetRESTClient: sender,
+			}},
+			mg: nodePool(),
+			want: want{
+				mg: nodePool(
+					withCount(3),
+					withObservation(v1alpha3.AKSNodePoolObservation{ID: id, ProvisioningState: "Succeeded"}),
+					withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotAKSNodePool": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotAKSNodePool),
+			},
+		},
+		"CreateError": {
+			e: &external{client: &fake.MockAKSNodePoolAPI{
+				MockCreateOrUpdate: func(_ context.Context, _ *v1alpha3.AKSNodePool) error { return errBoom },
+			}},
+			mg: nodePool(),
+			want: want{
+				mg:  nodePool(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateAKSNodePool),
+			},
+		},
+		"Success": {
+			e: &external{client: &fake.MockAKSNodePoolAPI{
+				MockCreateOrUpdate: func(_ context.Context, _ *v1alpha3.AKSNodePool) error { return nil },
+				MockGetRESTClient:  sender,
+			}},
+			mg: nodePool(),
+			want: want{
+				mg: nodePool(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	inProgress := azurev1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: "crossplane.io", Status: azure.AsyncOperationStatusInProgress}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotAKSNodePool": {
+			e:    &external{},
+			want: errors.New(errNotAKSNodePool),
+		},
+		"OperationInProgress": {
+			e:  &external{client: &fake.MockAKSNodePoolAPI{}},
+			mg: nodePool(withLastOperation(inProgress)),
+		},
+		"UpdateError": {
+			e: &external{client: &fake.MockAKSNodePoolAPI{
+				MockCreateOrUpdate: func(_ context.Context, _ *v1alpha3.AKSNodePool) error { return errBoom },
+			}},
+			mg:   nodePool(),
+			want: errors.Wrap(errBoom, errUpdateAKSNodePool),
+		},
+		"Success": {
+			e: &external{client: &fake.MockAKSNodePoolAPI{
+				MockCreateOrUpdate: func(_ context.Context, _ *v1alpha3.AKSNodePool) error { return nil },
+				MockGetRESTClient:  sender,
+			}},
+			mg: nodePool(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotAKSNodePool": {
+			e:    &external{},
+			want: errors.New(errNotAKSNodePool),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockAKSNodePoolAPI{
+				MockDelete: func(_ context.Context, _ *v1alpha3.AKSNodePool) error {
+					return autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: nodePool(),
+		},
+		"DeleteError": {
+			e: &external{client: &fake.MockAKSNodePoolAPI{
+				MockDelete: func(_ context.Context, _ *v1alpha3.AKSNodePool) error { return errBoom },
+			}},
+			mg:   nodePool(),
+			want: errors.Wrap(errBoom, errDeleteAKSNodePool),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	computesdk "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute/fake"
+)
+
+const (
+	name              = "cool-disk"
+	resourceGroupName = "cool-rg"
+	id                = "cool-id"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+)
+
+type modifier func(*v1alpha3.Disk)
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(d *v1alpha3.Disk) { d.Status.SetConditions(c...) }
+}
+
+func withSize(s int32) modifier {
+	return func(d *v1alpha3.Disk) { d.Spec.ForProvider.DiskSizeGB = to.Int32Ptr(s) }
+}
+
+func withObservation(o v1alpha3.DiskObservation) modifier {
+	return func(d *v1alpha3.Disk) { d.Status.AtProvider = o }
+}
+
+func disk(m ...modifier) *v1alpha3.Disk {
+	d := &v1alpha3.Disk{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.DiskSpec{
+			ForProvider: v1alpha3.DiskParameters{ResourceGroupName: resourceGroupName},
+		},
+	}
+	meta.SetExternalName(d, name)
+	for _, f := range m {
+		f(d)
+	}
+	return d
+}
+
+func azureDisk(state string, size int32) computesdk.Disk {
+	return computesdk.Disk{
+		ID: to.StringPtr(id),
+		DiskProperties: &computesdk.DiskProperties{
+			DiskSizeGB:        to.Int32Ptr(size),
+			DiskState:         computesdk.DiskStateUnattached,
+			ProvisioningState: to.StringPtr(state),
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotDisk": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotDisk),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockDisksClient{
+				MockGet: func(_ context.Context, _, _ string) (computesdk.Disk, error) {
+					return computesdk.Disk{}, errNotFound
+				},
+			}},
+			mg: disk(),
+			want: want{
+				mg: disk(),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			e: &external{client: &fake.MockDisksClient{
+				MockGet: func(_ context.Context, _, _ string) (computesdk.Disk, error) {
+					return computesdk.Disk{}, errBoom
+				},
+			}},
+			mg: disk(),
+			want: want{
+				mg:  disk(),
+				err: errors.Wrap(errBoom, errGetDisk),
+			},
+		},
+		"Updating": {
+			e: &external{client: &fake.MockDisksClient{
+				MockGet: func(_ context.Context, _, _ string) (computesdk.Disk, error) {
+					return azureDisk("Updating", 32), nil
+				},
+			}},
+			mg: disk(withSize(64)),
+			want: want{
+				mg: disk(withSize(64),
+					withObservation(v1alpha3.DiskObservation{ID: id, DiskState: "Unattached", ProvisioningState: "Updating"}),
+					withConditions(xpv1.Unavailable())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			e: &external{client: &fake.MockDisksClient{
+				MockGet: func(_ context.Context, _, _ string) (computesdk.Disk, error) {
+					return azureDisk("Succeeded", 32), nil
+				},
+			}},
+			mg: disk(withSize(64)),
+			want: want{
+				mg: disk(withSize(64),
+					withObservation(v1alpha3.DiskObservation{ID: id, DiskState: "Unattached", ProvisioningState: "Succeeded"}),
+					withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LateInitialized": {
+			e: &external{client: &fake.MockDisksClient{
+				MockGet: func(_ context.Context, _, _ string) (computesdk.Disk, error) {
+					return azureDisk("Succeeded", 32), nil
+				},
+			}},
+			mg: disk(),
+			want: want{
+				mg: disk(withSize(32),
+					withObservation(v1alpha3.DiskObservation{ID: id, DiskState: "Unattached", ProvisioningState: "Succeeded"}),
+					withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotDisk": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotDisk),
+			},
+		},
+		"CreateError": {
+			e: &external{client: &fake.MockDisksClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ computesdk.Disk) (computesdk.DisksCreateOrUpdateFuture, error) {
+					return computesdk.DisksCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			mg: disk(),
+			want: want{
+				mg:  disk(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateDisk),
+			},
+		},
+		"Success": {
+			e: &external{client: &fake.MockDisksClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ computesdk.Disk) (computesdk.DisksCreateOrUpdateFuture, error) {
+					return computesdk.DisksCreateOrUpdateFuture{}, nil
+				},
+			}},
+			mg: disk(),
+			want: want{
+				mg: disk(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotDisk": {
+			e:    &external{},
+			want: errors.New(errNotDisk),
+		},
+		"UpdateError": {
+			e: &external{client: &fake.MockDisksClient{
+				MockUpdate: func(_ context.Context, _, _ string, _ computesdk.DiskUpdate) (computesdk.DisksUpdateFuture, error) {
+					return computesdk.DisksUpdateFuture{}, errBoom
+				},
+			}},
+			mg:   disk(),
+			want: errors.Wrap(errBoom, errUpdateDisk),
+		},
+		"Success": {
+			e: &external{client: &fake.MockDisksClient{
+				MockUpdate: func(_ context.Context, _, _ string, _ computesdk.DiskUpdate) (computesdk.DisksUpdateFuture, error) {
+					return computesdk.DisksUpdateFuture{}, nil
+				},
+			}},
+			mg: disk(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotDisk": {
+			e:    &external{},
+			want: errors.New(errNotDisk),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockDisksClient{
+				MockDelete: func(_ context.Context, _, _ string) (computesdk.DisksDeleteFuture, error) {
+					return computesdk.DisksDeleteFuture{}, errNotFound
+				},
+			}},
+			mg: disk(),
+		},
+		"DeleteError": {
+			e: &external{client: &fake.MockDisksClient{
+				MockDelete: func(_ context.Context, _, _ string) (computesdk.DisksDeleteFuture, error) {
+					return computesdk.DisksDeleteFuture{}, errBoom
+				},
+			}},
+			mg:   disk(),
+			want: errors.Wrap(errBoom, errDeleteDisk),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,17 +18,21 @@ package compute
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
 	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/clientcmd"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -37,44 +41,85 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
-	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
+	azurev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
 	"github.com/crossplane-contrib/provider-azure/pkg/features"
 )
 
 // Error strings.
 const (
-	errGenPassword      = "cannot generate service principal secret"
-	errNotAKSCluster    = "managed resource is not a AKSCluster"
-	errCreateAKSCluster = "cannot create AKSCluster"
-	errGetAKSCluster    = "cannot get AKSCluster"
-	errGetKubeConfig    = "cannot get AKSCluster kubeconfig"
-	errDeleteAKSCluster = "cannot delete AKSCluster"
-	errGetConnSecret    = "cannot get connection secret"
+	errGenPassword        = "cannot generate service principal secret"
+	errNotAKSCluster      = "managed resource is not a AKSCluster"
+	errCreateAKSCluster   = "cannot create AKSCluster"
+	errGetAKSCluster      = "cannot get AKSCluster"
+	errGetKubeConfig      = "cannot get AKSCluster kubeconfig"
+	errGetUserKubeConfig  = "cannot get AKSCluster user kubeconfig"
+	errGetAADSecret       = "cannot get Azure AD server application secret"
+	errGetSSHKey          = "cannot get SSH public key"
+	errGetWindowsPassword = "cannot get Windows administrator password"
+	errUpdateAKSCluster   = "cannot update AKSCluster"
+	errDeleteAKSCluster   = "cannot delete AKSCluster"
+	errFetchLastOperation = "cannot fetch last operation"
+	errGetConnSecret      = "cannot get connection secret"
+	errGetSecretExpiry    = "cannot get service principal secret expiry"
+	errRotateSecret       = "cannot rotate service principal secret"
+
+	fmtPrivateEndpoint = "https://%s:443"
+
+	// keyUserKubeconfig is the connection secret key of the kubeconfig that
+	// authenticates as an Azure AD user.
+	keyUserKubeconfig = "userKubeconfig"
+
+	// keyToken is the connection secret key of the bearer token of the
+	// cluster's admin user, if the cluster issues one.
+	keyToken = "token"
+
+	// secretRotationWindow is how long before it expires the service
+	// principal secret of a cluster is rotated.
+	secretRotationWindow = 30 * 24 * time.Hour
 )
 
 // SetupAKSCluster adds a controller that reconciles AKSClusters.
 func SetupAKSCluster(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha3.AKSClusterGroupKind)
+	o = azure.ControllerOptions(v1alpha3.AKSClusterGroupKind, o)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
-		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
 	}
 
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient()), azure.NewDefaultTagger(mgr.GetClient(), resourceTags)}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(bo.ForControllerRuntime(o)).
 		For(&v1alpha3.AKSCluster{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(azure.NewInstrumentedReconciler(v1alpha3.AKSClusterGroupKind, managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha3.AKSClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1alpha3.AKSClusterGroupKind, bo, r, &connecter{client: mgr.GetClient()})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-			managed.WithConnectionPublishers(cps...)))
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+func resourceTags(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*v1alpha3.AKSCluster)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.Tags
 }
 
 type connecter struct {
@@ -113,32 +158,88 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetAKSCluster)
 	}
 
+	current := cr.Spec.AKSClusterParameters.DeepCopy()
+	compute.LateInitialize(&cr.Spec.AKSClusterParameters, c)
+	li := !cmp.Equal(current, &cr.Spec.AKSClusterParameters)
+
 	cr.Status.ProviderID = to.String(c.ID)
 	cr.Status.State = to.String(c.ProvisioningState)
 	cr.Status.Endpoint = to.String(c.Fqdn)
+	if cr.Spec.PrivateCluster {
+		// Private clusters are only reachable through their private FQDN.
+		cr.Status.Endpoint = to.String(c.PrivateFQDN)
+	}
+	if err := azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.LastOperation); err != nil || cr.Status.LastOperation.Status == "" {
+		// The last operation could not be polled, e.g. because it was
+		// started before the controller restarted and its polling URL is
+		// stale. Rather than failing until another operation replaces it,
+		// its status is re-derived from the cluster.
+		compute.ResumeAsyncOperation(&cr.Status.LastOperation, c)
+	}
+	setUpgradeConditions(cr, c)
 
 	if cr.Status.State != "Succeeded" {
-		// AKS clusters are always up to date because we can't yet update them.
-		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		// Clusters that are being created, scaled or upgraded can't be
+		// updated until the operation completes.
+		return managed.ExternalObservation{
+			ResourceExists:          true,
+			ResourceUpToDate:        true,
+			ResourceLateInitialized: li,
+			ConnectionDetails:       azure.ResourceConnectionDetails(cr.Status.ProviderID, cr.Spec.Location),
+		}, nil
 	}
 
-	kubeconfig, err := e.client.GetKubeConfig(ctx, cr)
-	if err != nil {
-		return managed.ExternalObservation{}, errors.Wrap(err, errGetKubeConfig)
+	cd := managed.ConnectionDetails{}
+	admin, user := kubeconfigCredentials(cr)
+	if admin {
+		kubeconfig, err := e.client.GetKubeConfig(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetKubeConfig)
+		}
+		if cd, err = connectionDetails(kubeconfig, meta.GetExternalName(cr)); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetAKSCluster)
+		}
+	}
+	if user {
+		ukc, err := e.client.GetUserKubeConfig(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetUserKubeConfig)
+		}
+		ucd, err := connectionDetails(ukc, meta.GetExternalName(cr))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetAKSCluster)
+		}
+		// The user kubeconfig shares the endpoint and CA of the admin one,
+		// but none of its credentials.
+		for _, k := range []string{xpv1.ResourceCredentialsSecretEndpointKey, xpv1.ResourceCredentialsSecretCAKey} {
+			cd[k] = ucd[k]
+		}
+		cd[keyUserKubeconfig] = ukc
+	}
+	if cr.Spec.PrivateCluster {
+		cd[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(fmt.Sprintf(fmtPrivateEndpoint, cr.Status.Endpoint))
+	}
+	for k, v := range azure.ResourceConnectionDetails(cr.Status.ProviderID, cr.Spec.Location) {
+		cd[k] = v
 	}
 
-	cd, err := connectionDetails(kubeconfig, meta.GetExternalName(cr))
-	if err != nil {
-		return managed.ExternalObservation{}, errors.Wrap(err, errGetAKSCluster)
+	if cr.Spec.Identity == nil && cr.Status.ServicePrincipalSecretExpiry == nil {
+		exp, err := e.client.GetServicePrincipalSecretExpiry(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetSecretExpiry)
+		}
+		if !exp.IsZero() {
+			cr.Status.ServicePrincipalSecretExpiry = &metav1.Time{Time: exp}
+		}
 	}
 
 	cr.SetConditions(xpv1.Available())
 
-	// AKS clusters are always up to date because we can't yet update them.
 	o := managed.ExternalObservation{
-		ResourceExists:    true,
-		ResourceUpToDate:  true,
-		ConnectionDetails: cd,
+		ResourceExists:          true,
+		ResourceUpToDate:        compute.IsUpToDate(cr.Spec.AKSClusterParameters, c) && !needsSecretRotation(cr, time.Now()),
+		ResourceLateInitialized: li,
+		ConnectionDetails:       azure.FormatConnectionDetails(cr.Spec.ConnectionSecretFormat, "", cd),
 	}
 	return o, nil
 }
@@ -150,6 +251,19 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 	cr.SetConditions(xpv1.Creating())
 
+	secrets, err := e.getClusterSecrets(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	// Clusters that use a managed identity have no service principal, and
+	// thus no service principal secret.
+	if cr.Spec.Identity != nil {
+		err := e.client.EnsureManagedCluster(ctx, cr, secrets)
+		setValidationConditions(cr, err)
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAKSCluster)
+	}
+
 	pw, err := e.getPassword(ctx, cr)
 	if err != nil {
 		return managed.ExternalCreation{}, err
@@ -160,11 +274,38 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 			return managed.ExternalCreation{}, errors.Wrap(err, errGenPassword)
 		}
 	}
+	secrets.ServicePrincipal = pw
+	err = e.client.EnsureManagedCluster(ctx, cr, secrets)
+	setValidationConditions(cr, err)
 	return managed.ExternalCreation{
-		ConnectionDetails: managed.ConnectionDetails{
+		ConnectionDetails: azure.FormatConnectionDetails(cr.Spec.ConnectionSecretFormat, "", managed.ConnectionDetails{
 			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
-		},
-	}, errors.Wrap(e.client.EnsureManagedCluster(ctx, cr, pw), errCreateAKSCluster)
+		}),
+	}, errors.Wrap(err, errCreateAKSCluster)
+}
+
+// setUpgradeConditions reports the phase of the Kubernetes version upgrade of
+// the supplied cluster, if any.
+func setUpgradeConditions(cr *v1alpha3.AKSCluster, mc containerservice.ManagedCluster) {
+	switch {
+	case !compute.IsControlPlaneUpgraded(cr.Spec.AKSClusterParameters, mc):
+		cr.SetConditions(v1alpha3.UpgradingControlPlane(cr.Spec.Version))
+	case !compute.AreNodesUpgraded(cr.Spec.AKSClusterParameters, mc):
+		cr.SetConditions(v1alpha3.UpgradingNodes(cr.Spec.Version))
+	case cr.GetCondition(v1alpha3.TypeUpgrading).Status == v1.ConditionTrue:
+		cr.SetConditions(v1alpha3.Upgraded())
+	}
+}
+
+// setValidationConditions reports why the supplied cluster could never be
+// created, if the supplied error explains it.
+func setValidationConditions(cr *v1alpha3.AKSCluster, err error) {
+	switch {
+	case compute.IsQuotaExceeded(err):
+		cr.SetConditions(azurev1alpha3.QuotaExceeded(err.Error()))
+	case compute.IsZoneUnavailable(err):
+		cr.SetConditions(azurev1alpha3.ZoneUnavailable(err.Error()))
+	}
 }
 
 func (e *external) getPassword(ctx context.Context, cr *v1alpha3.AKSCluster) (string, error) {
@@ -184,9 +325,85 @@ func (e *external) getPassword(ctx context.Context, cr *v1alpha3.AKSCluster) (st
 	return string(s.Data[xpv1.ResourceCredentialsSecretPasswordKey]), nil
 }
 
-func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
-	// TODO(negz): Support updates.
-	return managed.ExternalUpdate{}, nil
+// getClusterSecrets reads the secrets the supplied cluster is created with
+// from the Kubernetes secrets its spec references. Its service principal
+// secret is read separately.
+func (e *external) getClusterSecrets(ctx context.Context, cr *v1alpha3.AKSCluster) (compute.ManagedClusterSecrets, error) {
+	s := compute.ManagedClusterSecrets{}
+	var err error
+	if p := cr.Spec.AADProfile; p != nil {
+		if s.AADServerApp, err = e.getSecretValue(ctx, p.ServerAppSecretSecretRef); err != nil {
+			return s, errors.Wrap(err, errGetAADSecret)
+		}
+	}
+	if p := cr.Spec.LinuxProfile; p != nil {
+		if s.SSHPublicKey, err = e.getSecretValue(ctx, p.SSHPublicKeySecretRef); err != nil {
+			return s, errors.Wrap(err, errGetSSHKey)
+		}
+	}
+	if p := cr.Spec.WindowsProfile; p != nil {
+		if s.WindowsAdminPassword, err = e.getSecretValue(ctx, &p.AdminPasswordSecretRef); err != nil {
+			return s, errors.Wrap(err, errGetWindowsPassword)
+		}
+	}
+	return s, nil
+}
+
+func (e *external) getSecretValue(ctx context.Context, ref *xpv1.SecretKeySelector) (string, error) {
+	if ref == nil {
+		return "", nil
+	}
+	s := &v1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", err
+	}
+	return string(s.Data[ref.Key]), nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.AKSCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAKSCluster)
+	}
+	if cr.Status.LastOperation.Status == azure.AsyncOperationStatusInProgress {
+		return managed.ExternalUpdate{}, nil
+	}
+	if needsSecretRotation(cr, time.Now()) {
+		return e.rotateSecret(ctx, cr)
+	}
+	if err := e.client.UpdateManagedCluster(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAKSCluster)
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.LastOperation), errFetchLastOperation)
+}
+
+// rotateSecret replaces the service principal secret of the supplied cluster
+// with a new one, which is published along with the cluster's other connection
+// details.
+func (e *external) rotateSecret(ctx context.Context, cr *v1alpha3.AKSCluster) (managed.ExternalUpdate, error) {
+	pw, err := e.newPasswordFn()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGenPassword)
+	}
+	exp, err := e.client.RotateServicePrincipalSecret(ctx, cr, pw)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRotateSecret)
+	}
+	cr.Status.ServicePrincipalSecretExpiry = &metav1.Time{Time: exp}
+	return managed.ExternalUpdate{
+		ConnectionDetails: azure.FormatConnectionDetails(cr.Spec.ConnectionSecretFormat, "", managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		}),
+	}, errors.Wrap(azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.LastOperation), errFetchLastOperation)
+}
+
+// needsSecretRotation returns true if the service principal secret of the
+// supplied cluster expires within the rotation window of the supplied time.
+func needsSecretRotation(cr *v1alpha3.AKSCluster, now time.Time) bool {
+	if cr.Spec.Identity != nil || cr.Status.ServicePrincipalSecretExpiry == nil {
+		return false
+	}
+	return now.Add(secretRotationWindow).After(cr.Status.ServicePrincipalSecretExpiry.Time)
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -198,6 +415,22 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	return errors.Wrap(e.client.DeleteManagedCluster(ctx, cr), errDeleteAKSCluster)
 }
 
+// kubeconfigCredentials returns whether the admin and the user kubeconfig of
+// the supplied cluster should be written to its connection secret. Clusters
+// that authenticate their users with Azure AD only hand out user kubeconfigs
+// unless told otherwise.
+func kubeconfigCredentials(cr *v1alpha3.AKSCluster) (admin, user bool) {
+	switch cr.Spec.KubeconfigCredentials {
+	case v1alpha3.KubeconfigCredentialsAdmin:
+		return true, false
+	case v1alpha3.KubeconfigCredentialsUser:
+		return false, true
+	case v1alpha3.KubeconfigCredentialsAdminAndUser:
+		return true, true
+	}
+	return cr.Spec.AADProfile == nil, cr.Spec.AADProfile != nil
+}
+
 func connectionDetails(kubeconfig []byte, name string) (managed.ConnectionDetails, error) {
 	kcfg, err := clientcmd.Load(kubeconfig)
 	if err != nil {
@@ -217,11 +450,15 @@ func connectionDetails(kubeconfig []byte, name string) (managed.ConnectionDetail
 		return nil, errors.Errorf("auth-info configuration is not found: %s", kctx.AuthInfo)
 	}
 
-	return managed.ConnectionDetails{
+	cd := managed.ConnectionDetails{
 		xpv1.ResourceCredentialsSecretEndpointKey:   []byte(cluster.Server),
 		xpv1.ResourceCredentialsSecretCAKey:         cluster.CertificateAuthorityData,
 		xpv1.ResourceCredentialsSecretClientCertKey: auth.ClientCertificateData,
 		xpv1.ResourceCredentialsSecretClientKeyKey:  auth.ClientKeyData,
 		xpv1.ResourceCredentialsSecretKubeconfigKey: kubeconfig,
-	}, nil
+	}
+	if auth.Token != "" {
+		cd[keyToken] = []byte(auth.Token)
+	}
+	return cd, nil
 }
@@ -18,31 +18,64 @@ package compute
 
 import (
 	"context"
+	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-10-01/containerservice"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azurev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute"
 	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute/fake"
 )
 
 const (
 	testPasswd         = "pass123"
 	testExistingSecret = "existingSecret"
+	testClusterName    = "coolcluster"
+	testEndpoint       = "https://coolcluster.hcp.westus2.azmk8s.io:443"
 )
 
+// testKubeconfig returns a kubeconfig of the test cluster that authenticates
+// using the supplied token.
+func testKubeconfig(token string) []byte {
+	return []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: ` + testClusterName + `
+  cluster:
+    server: ` + testEndpoint + `
+    certificate-authority-data: Y2E=
+contexts:
+- name: ` + testClusterName + `
+  context:
+    cluster: ` + testClusterName + `
+    user: ` + token + `
+current-context: ` + testClusterName + `
+users:
+- name: ` + token + `
+  user:
+    token: ` + token + `
+`)
+}
+
 type modifier func(*v1alpha3.AKSCluster)
 
 func withState(state string) modifier {
@@ -69,6 +102,100 @@ func withConnectionSecretRef(ref *xpv1.SecretReference) modifier {
 	}
 }
 
+func withConnectionSecretFormat(f *azurev1alpha3.ConnectionSecretFormat) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.ConnectionSecretFormat = f
+	}
+}
+
+func withLastOperation(op azurev1alpha3.AsyncOperation) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Status.LastOperation = op
+	}
+}
+
+func withApplicationIDs(appObjectID, appID, spObjectID string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Status.ApplicationObjectID = appObjectID
+		c.Status.ApplicationID = appID
+		c.Status.ServicePrincipalObjectID = spObjectID
+	}
+}
+
+func withIdentity(id *v1alpha3.AKSClusterIdentity) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.Identity = id
+	}
+}
+
+func withVersion(v string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.Version = v
+	}
+}
+
+func withConditions(cs ...xpv1.Condition) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Status.SetConditions(cs...)
+	}
+}
+
+func withPrivateCluster() modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.PrivateCluster = true
+	}
+}
+
+func withSecretExpiry(t time.Time) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Status.ServicePrincipalSecretExpiry = &metav1.Time{Time: t}
+	}
+}
+
+func withAADProfile() modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.AADProfile = &v1alpha3.AKSClusterAADProfile{
+			ServerAppID: "server-app",
+			ServerAppSecretSecretRef: &xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "aad", Namespace: "test-ns"},
+				Key:             "secret",
+			},
+			ClientAppID: "client-app",
+		}
+	}
+}
+
+func withProfiles() modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.LinuxProfile = &v1alpha3.AKSClusterLinuxProfile{
+			AdminUsername: "azureuser",
+			SSHPublicKeySecretRef: &xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "keys", Namespace: "test-ns"},
+				Key:             "ssh",
+			},
+		}
+		c.Spec.WindowsProfile = &v1alpha3.AKSClusterWindowsProfile{
+			AdminUsername: "azureuser",
+			AdminPasswordSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "keys", Namespace: "test-ns"},
+				Key:             "win",
+			},
+		}
+	}
+}
+
+func withKubeconfigCredentials(k string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		c.Spec.KubeconfigCredentials = k
+	}
+}
+
+func withExternalName(n string) modifier {
+	return func(c *v1alpha3.AKSCluster) {
+		meta.SetExternalName(c, n)
+	}
+}
+
 func aksCluster(m ...modifier) *v1alpha3.AKSCluster {
 	ac := &v1alpha3.AKSCluster{}
 
@@ -85,6 +212,28 @@ func TestObserve(t *testing.T) {
 	stateSucceeded := "Succeeded"
 	stateWat := "Wat"
 	endpoint := "http://wat.example.org"
+	privateEndpoint := "wat.privatelink.example.org"
+	stateUpgrading := "Upgrading"
+	errThrottled := autorest.DetailedError{StatusCode: http.StatusTooManyRequests}
+	// An operation started before the controller restarted.
+	inProgress := azurev1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: "https://example.org", Status: azure.AsyncOperationStatusInProgress}
+	succeeded := azurev1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: "https://example.org", Status: "Succeeded"}
+	// An operation that can no longer be polled, whose status is derived
+	// from the cluster instead.
+	stale := inProgress
+	stale.ErrorMessage = "pollingTrackerBase#pollForStatus: failed to send HTTP request: StatusCode=0 -- Original Error: boom"
+	oldVersion, newVersion := "1.21.2", "1.22.4"
+	upgrading := func(controlPlane, nodes string) containerservice.ManagedCluster {
+		return containerservice.ManagedCluster{
+			ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+				ProvisioningState: to.StringPtr(stateUpgrading),
+				KubernetesVersion: to.StringPtr(controlPlane),
+				AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{
+					{Name: to.StringPtr(compute.AgentPoolProfileName), OrchestratorVersion: to.StringPtr(nodes)},
+				},
+			},
+		}
+	}
 
 	type args struct {
 		ctx context.Context
@@ -144,6 +293,23 @@ func TestObserve(t *testing.T) {
 				mg:  aksCluster(),
 			},
 		},
+		"ErrThrottled": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{}, errThrottled
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(),
+			},
+			want: want{
+				mg:  aksCluster(),
+				err: errors.Wrap(errThrottled, errGetAKSCluster),
+			},
+		},
 		"NotReady": {
 			e: &external{
 				client: fake.AKSClient{
@@ -156,22 +322,331 @@ func TestObserve(t *testing.T) {
 							},
 						}, nil
 					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyResourceID: []byte(id),
+					},
+				},
+				mg: aksCluster(
+					withProviderID(id),
+					withState(stateWat),
+					withEndpoint(endpoint),
+				),
+			},
+		},
+		"ResumeOperation": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{
+							ID: to.StringPtr(id),
+							ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+								ProvisioningState: to.StringPtr(stateWat),
+								Fqdn:              to.StringPtr(endpoint),
+							},
+						}, nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+							body := `{"status": "Succeeded"}`
+							return &http.Response{
+								Request:       req,
+								StatusCode:    http.StatusOK,
+								Body:          ioutil.NopCloser(strings.NewReader(body)),
+								ContentLength: int64(len(body)),
+							}, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withLastOperation(inProgress)),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyResourceID: []byte(id),
+					},
+				},
+				mg: aksCluster(
+					withProviderID(id),
+					withState(stateWat),
+					withEndpoint(endpoint),
+					withLastOperation(succeeded),
+				),
+			},
+		},
+		"ResumeStaleOperation": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{
+							ID: to.StringPtr(id),
+							ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+								ProvisioningState: to.StringPtr(stateWat),
+								Fqdn:              to.StringPtr(endpoint),
+							},
+						}, nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(_ *http.Request) (*http.Response, error) {
+							return nil, errBoom
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withLastOperation(inProgress)),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyResourceID: []byte(id),
+					},
+				},
+				mg: aksCluster(
+					withProviderID(id),
+					withState(stateWat),
+					withEndpoint(endpoint),
+					withLastOperation(stale),
+				),
+			},
+		},
+		"UpgradingControlPlane": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return upgrading(oldVersion, oldVersion), nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withVersion(newVersion)),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				mg: aksCluster(
+					withVersion(newVersion),
+					withState(stateUpgrading),
+					withConditions(v1alpha3.UpgradingControlPlane(newVersion)),
+				),
+			},
+		},
+		"UpgradingNodes": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return upgrading(newVersion, oldVersion), nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withVersion(newVersion), withConditions(v1alpha3.UpgradingControlPlane(newVersion))),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				mg: aksCluster(
+					withVersion(newVersion),
+					withState(stateUpgrading),
+					withConditions(v1alpha3.UpgradingNodes(newVersion)),
+				),
+			},
+		},
+		"Upgraded": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return upgrading(newVersion, newVersion), nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withVersion(newVersion), withConditions(v1alpha3.UpgradingNodes(newVersion))),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				mg: aksCluster(
+					withVersion(newVersion),
+					withState(stateUpgrading),
+					withConditions(v1alpha3.Upgraded()),
+				),
+			},
+		},
+		"PrivateNotReady": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{
+							ID: to.StringPtr(id),
+							ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+								ProvisioningState: to.StringPtr(stateWat),
+								Fqdn:              to.StringPtr(endpoint),
+								PrivateFQDN:       to.StringPtr(privateEndpoint),
+							},
+						}, nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withPrivateCluster()),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyResourceID: []byte(id),
+					},
+				},
+				mg: aksCluster(
+					withPrivateCluster(),
+					withProviderID(id),
+					withState(stateWat),
+					withEndpoint(privateEndpoint),
+				),
+			},
+		},
+		"ErrGetKubeConfig": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+							ProvisioningState: to.StringPtr(stateSucceeded),
+						}}, nil
+					},
+					MockGetKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster) ([]byte, error) {
+						return nil, errBoom
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(),
+			},
+			want: want{
+				mg: aksCluster(
+					withState(stateSucceeded),
+				),
+				err: errors.Wrap(errBoom, errGetKubeConfig),
+			},
+		},
+		"ErrGetUserKubeConfig": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+							ProvisioningState: to.StringPtr(stateSucceeded),
+						}}, nil
+					},
+					MockGetUserKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster) ([]byte, error) {
+						return nil, errBoom
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withAADProfile()),
+			},
+			want: want{
+				mg: aksCluster(
+					withAADProfile(),
+					withState(stateSucceeded),
+				),
+				err: errors.Wrap(errBoom, errGetUserKubeConfig),
+			},
+		},
+		"AADUserKubeconfigOnly": {
+			e: &external{
+				client: fake.AKSClient{
+					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
+						return containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+							ProvisioningState: to.StringPtr(stateSucceeded),
+						}}, nil
+					},
+					MockGetUserKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster) ([]byte, error) {
+						return testKubeconfig("user"), nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
 				},
 			},
 			args: args{
 				ctx: context.Background(),
-				mg:  aksCluster(),
+				mg:  aksCluster(withExternalName(testClusterName), withAADProfile(), withIdentity(&v1alpha3.AKSClusterIdentity{})),
 			},
 			want: want{
-				eo: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(testEndpoint),
+						xpv1.ResourceCredentialsSecretCAKey:       []byte("ca"),
+						keyUserKubeconfig:                         testKubeconfig("user"),
+					},
+				},
 				mg: aksCluster(
-					withProviderID(id),
-					withState(stateWat),
-					withEndpoint(endpoint),
+					withExternalName(testClusterName),
+					withAADProfile(),
+					withIdentity(&v1alpha3.AKSClusterIdentity{}),
+					withState(stateSucceeded),
+					withConditions(xpv1.Available()),
 				),
 			},
 		},
-		"ErrGetKubeConfig": {
+		"AdminAndUserKubeconfig": {
 			e: &external{
 				client: fake.AKSClient{
 					MockGetManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) (containerservice.ManagedCluster, error) {
@@ -180,19 +655,47 @@ func TestObserve(t *testing.T) {
 						}}, nil
 					},
 					MockGetKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster) ([]byte, error) {
-						return nil, errBoom
+						return testKubeconfig("admin"), nil
+					},
+					MockGetUserKubeConfig: func(_ context.Context, _ *v1alpha3.AKSCluster) ([]byte, error) {
+						return testKubeconfig("user"), nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
 					},
 				},
 			},
 			args: args{
 				ctx: context.Background(),
-				mg:  aksCluster(),
+				mg: aksCluster(
+					withExternalName(testClusterName),
+					withAADProfile(),
+					withIdentity(&v1alpha3.AKSClusterIdentity{}),
+					withKubeconfigCredentials(v1alpha3.KubeconfigCredentialsAdminAndUser),
+				),
 			},
 			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey:   []byte(testEndpoint),
+						xpv1.ResourceCredentialsSecretCAKey:         []byte("ca"),
+						xpv1.ResourceCredentialsSecretClientCertKey: nil,
+						xpv1.ResourceCredentialsSecretClientKeyKey:  nil,
+						xpv1.ResourceCredentialsSecretKubeconfigKey: testKubeconfig("admin"),
+						keyToken:          []byte("admin"),
+						keyUserKubeconfig: testKubeconfig("user"),
+					},
+				},
 				mg: aksCluster(
+					withExternalName(testClusterName),
+					withAADProfile(),
+					withIdentity(&v1alpha3.AKSClusterIdentity{}),
+					withKubeconfigCredentials(v1alpha3.KubeconfigCredentialsAdminAndUser),
 					withState(stateSucceeded),
+					withConditions(xpv1.Available()),
 				),
-				err: errors.Wrap(errBoom, errGetKubeConfig),
 			},
 		},
 	}
@@ -204,7 +707,7 @@ func TestObserve(t *testing.T) {
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
 				t.Errorf("tc.e.Observe(...): -want managed, +got managed:\n%s", diff)
 			}
 			if diff := cmp.Diff(tc.want.eo, eo); diff != "" {
@@ -216,6 +719,7 @@ func TestObserve(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	errBoom := errors.New("boom")
+	errThrottled := autorest.DetailedError{StatusCode: http.StatusTooManyRequests}
 	// password := "verysecure"
 
 	type args struct {
@@ -224,6 +728,7 @@ func TestCreate(t *testing.T) {
 	}
 	type want struct {
 		ec  managed.ExternalCreation
+		mg  resource.Managed
 		err error
 	}
 
@@ -250,6 +755,7 @@ func TestCreate(t *testing.T) {
 				mg:  aksCluster(),
 			},
 			want: want{
+				mg:  aksCluster(withConditions(xpv1.Creating())),
 				err: errors.Wrap(errBoom, errGenPassword),
 			},
 		},
@@ -257,7 +763,7 @@ func TestCreate(t *testing.T) {
 			e: &external{
 				newPasswordFn: func() (string, error) { return "", nil },
 				client: fake.AKSClient{
-					MockEnsureManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, _ string) error {
+					MockEnsureManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, _ compute.ManagedClusterSecrets) error {
 						return errBoom
 					},
 				},
@@ -267,6 +773,7 @@ func TestCreate(t *testing.T) {
 				mg:  aksCluster(),
 			},
 			want: want{
+				mg:  aksCluster(withConditions(xpv1.Creating())),
 				err: errors.Wrap(errBoom, errCreateAKSCluster),
 				ec: managed.ExternalCreation{
 					ConnectionDetails: map[string][]byte{
@@ -275,11 +782,37 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		"ErrEnsureClusterAfterServicePrincipal": {
+			e: &external{
+				newPasswordFn: func() (string, error) { return testPasswd, nil },
+				client: fake.AKSClient{
+					MockEnsureManagedCluster: func(_ context.Context, ac *v1alpha3.AKSCluster, _ compute.ManagedClusterSecrets) error {
+						// The service principal was created, but Azure
+						// throttled the creation of the cluster.
+						withApplicationIDs("app-object", "app", "sp-object")(ac)
+						return errThrottled
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(),
+			},
+			want: want{
+				mg:  aksCluster(withApplicationIDs("app-object", "app", "sp-object"), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errThrottled, errCreateAKSCluster),
+				ec: managed.ExternalCreation{
+					ConnectionDetails: map[string][]byte{
+						"password": []byte(testPasswd),
+					},
+				},
+			},
+		},
 		"SuccessEnsureCluster": {
 			e: &external{
 				newPasswordFn: func() (string, error) { return testPasswd, nil },
 				client: fake.AKSClient{
-					MockEnsureManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, _ string) error {
+					MockEnsureManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, _ compute.ManagedClusterSecrets) error {
 						return nil
 					},
 				},
@@ -289,6 +822,7 @@ func TestCreate(t *testing.T) {
 				mg:  aksCluster(),
 			},
 			want: want{
+				mg: aksCluster(withConditions(xpv1.Creating())),
 				ec: managed.ExternalCreation{
 					ConnectionDetails: map[string][]byte{
 						"password": []byte(testPasswd),
@@ -296,11 +830,32 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		"SuccessManagedIdentity": {
+			e: &external{
+				newPasswordFn: func() (string, error) { return "", errBoom },
+				client: fake.AKSClient{
+					MockEnsureManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, s compute.ManagedClusterSecrets) error {
+						if s.ServicePrincipal != "" {
+							return errors.New("unexpected service principal secret")
+						}
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withIdentity(&v1alpha3.AKSClusterIdentity{Type: v1alpha3.AKSClusterIdentityTypeSystemAssigned})),
+			},
+			want: want{
+				mg: aksCluster(withIdentity(&v1alpha3.AKSClusterIdentity{Type: v1alpha3.AKSClusterIdentityTypeSystemAssigned}), withConditions(xpv1.Creating())),
+				ec: managed.ExternalCreation{},
+			},
+		},
 		"SuccessExistingEmptyAppSecret": {
 			e: &external{
 				newPasswordFn: func() (string, error) { return testPasswd, nil },
 				client: fake.AKSClient{
-					MockEnsureManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, _ string) error {
+					MockEnsureManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, _ compute.ManagedClusterSecrets) error {
 						return nil
 					},
 				},
@@ -323,6 +878,10 @@ func TestCreate(t *testing.T) {
 				})),
 			},
 			want: want{
+				mg: aksCluster(withConnectionSecretRef(&xpv1.SecretReference{
+					Name:      "test-secret",
+					Namespace: "test-ns",
+				}), withConditions(xpv1.Creating())),
 				ec: managed.ExternalCreation{
 					ConnectionDetails: map[string][]byte{
 						"password": []byte(testPasswd),
@@ -334,7 +893,7 @@ func TestCreate(t *testing.T) {
 			e: &external{
 				newPasswordFn: func() (string, error) { return testPasswd, nil },
 				client: fake.AKSClient{
-					MockEnsureManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, _ string) error {
+					MockEnsureManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, _ compute.ManagedClusterSecrets) error {
 						return nil
 					},
 				},
@@ -357,6 +916,10 @@ func TestCreate(t *testing.T) {
 				})),
 			},
 			want: want{
+				mg: aksCluster(withConnectionSecretRef(&xpv1.SecretReference{
+					Name:      "test-secret",
+					Namespace: "test-ns",
+				}), withConditions(xpv1.Creating())),
 				ec: managed.ExternalCreation{
 					ConnectionDetails: map[string][]byte{
 						"password": []byte(testExistingSecret),
@@ -364,11 +927,100 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		"SuccessAADServerAppSecret": {
+			e: &external{
+				client: fake.AKSClient{
+					MockEnsureManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, s compute.ManagedClusterSecrets) error {
+						if s.AADServerApp != testExistingSecret {
+							return errors.Errorf("want AAD secret %q, got %q", testExistingSecret, s.AADServerApp)
+						}
+						return nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, o client.Object) error {
+						s, ok := o.(*v1.Secret)
+						if !ok {
+							t.Fatalf("not a *v1.Secret")
+						}
+						s.Data = map[string][]byte{"secret": []byte(testExistingSecret)}
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withIdentity(&v1alpha3.AKSClusterIdentity{Type: v1alpha3.AKSClusterIdentityTypeSystemAssigned}), withAADProfile()),
+			},
+			want: want{
+				mg: aksCluster(withIdentity(&v1alpha3.AKSClusterIdentity{Type: v1alpha3.AKSClusterIdentityTypeSystemAssigned}), withAADProfile(), withConditions(xpv1.Creating())),
+			},
+		},
+		"SuccessProfileSecrets": {
+			e: &external{
+				client: fake.AKSClient{
+					MockEnsureManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, s compute.ManagedClusterSecrets) error {
+						want := compute.ManagedClusterSecrets{SSHPublicKey: "ssh-rsa AAAA", WindowsAdminPassword: testExistingSecret}
+						if diff := cmp.Diff(want, s); diff != "" {
+							return errors.Errorf("EnsureManagedCluster(...): -want, +got:\n%s", diff)
+						}
+						return nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, o client.Object) error {
+						s, ok := o.(*v1.Secret)
+						if !ok {
+							t.Fatalf("not a *v1.Secret")
+						}
+						s.Data = map[string][]byte{"ssh": []byte("ssh-rsa AAAA"), "win": []byte(testExistingSecret)}
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withIdentity(&v1alpha3.AKSClusterIdentity{Type: v1alpha3.AKSClusterIdentityTypeSystemAssigned}), withProfiles()),
+			},
+			want: want{
+				mg: aksCluster(withIdentity(&v1alpha3.AKSClusterIdentity{Type: v1alpha3.AKSClusterIdentityTypeSystemAssigned}), withProfiles(), withConditions(xpv1.Creating())),
+			},
+		},
+		"ErrSSHPublicKey": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withProfiles()),
+			},
+			want: want{
+				mg:  aksCluster(withProfiles(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errGetSSHKey),
+			},
+		},
+		"ErrAADServerAppSecret": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  aksCluster(withAADProfile()),
+			},
+			want: want{
+				mg:  aksCluster(withAADProfile(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errGetAADSecret),
+			},
+		},
 		"ErrExistingAppSecret": {
 			e: &external{
 				newPasswordFn: func() (string, error) { return testPasswd, nil },
 				client: fake.AKSClient{
-					MockEnsureManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, _ string) error {
+					MockEnsureManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster, _ compute.ManagedClusterSecrets) error {
 						return nil
 					},
 				},
@@ -384,6 +1036,10 @@ func TestCreate(t *testing.T) {
 				})),
 			},
 			want: want{
+				mg: aksCluster(withConnectionSecretRef(&xpv1.SecretReference{
+					Name:      "test-secret",
+					Namespace: "test-ns",
+				}), withConditions(xpv1.Creating())),
 				err: errors.Wrap(errBoom, errGetConnSecret),
 			},
 		},
@@ -399,6 +1055,9 @@ func TestCreate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.ec, ec); diff != "" {
 				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
 			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want managed resource, +got managed resource:\n%s", diff)
+			}
 		})
 	}
 }
@@ -450,3 +1109,195 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+	inProgress := azurev1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: "https://example.org", Status: azure.AsyncOperationStatusInProgress}
+	expiring := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	rotated := expiring.AddDate(5, 0, 0)
+
+	type want struct {
+		eu  managed.ExternalUpdate
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"ErrNotAKSCluster": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotAKSCluster),
+			},
+		},
+		"OperationInProgress": {
+			e: &external{
+				client: fake.AKSClient{
+					MockUpdateManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) error {
+						return errors.New("unexpected update")
+					},
+				},
+			},
+			mg: aksCluster(withLastOperation(inProgress)),
+			want: want{
+				mg: aksCluster(withLastOperation(inProgress)),
+			},
+		},
+		"ErrUpdateCluster": {
+			e: &external{
+				client: fake.AKSClient{
+					MockUpdateManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) error {
+						return errBoom
+					},
+				},
+			},
+			mg: aksCluster(),
+			want: want{
+				mg:  aksCluster(),
+				err: errors.Wrap(errBoom, errUpdateAKSCluster),
+			},
+		},
+		"Success": {
+			e: &external{
+				client: fake.AKSClient{
+					MockUpdateManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) error {
+						return nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
+				},
+			},
+			mg: aksCluster(),
+			want: want{
+				mg: aksCluster(),
+			},
+		},
+		"SecretNotExpiring": {
+			e: &external{
+				client: fake.AKSClient{
+					MockUpdateManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) error {
+						return nil
+					},
+					MockRotateSecret: func(_ context.Context, _ *v1alpha3.AKSCluster, _ string) (time.Time, error) {
+						return time.Time{}, errors.New("unexpected rotation")
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
+				},
+			},
+			mg: aksCluster(withSecretExpiry(rotated)),
+			want: want{
+				mg: aksCluster(withSecretExpiry(rotated)),
+			},
+		},
+		"ErrGeneratePassword": {
+			e: &external{
+				newPasswordFn: func() (string, error) { return "", errBoom },
+			},
+			mg: aksCluster(withSecretExpiry(expiring)),
+			want: want{
+				mg:  aksCluster(withSecretExpiry(expiring)),
+				err: errors.Wrap(errBoom, errGenPassword),
+			},
+		},
+		"ErrRotateSecret": {
+			e: &external{
+				client: fake.AKSClient{
+					MockRotateSecret: func(_ context.Context, _ *v1alpha3.AKSCluster, _ string) (time.Time, error) {
+						return time.Time{}, errBoom
+					},
+				},
+				newPasswordFn: func() (string, error) { return testPasswd, nil },
+			},
+			mg: aksCluster(withSecretExpiry(expiring)),
+			want: want{
+				mg:  aksCluster(withSecretExpiry(expiring)),
+				err: errors.Wrap(errBoom, errRotateSecret),
+			},
+		},
+		"SuccessRotateSecret": {
+			e: &external{
+				client: fake.AKSClient{
+					MockUpdateManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) error {
+						return errors.New("unexpected update")
+					},
+					MockRotateSecret: func(_ context.Context, _ *v1alpha3.AKSCluster, secret string) (time.Time, error) {
+						if secret != testPasswd {
+							return time.Time{}, errors.Errorf("unexpected secret %q", secret)
+						}
+						return rotated, nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
+				},
+				newPasswordFn: func() (string, error) { return testPasswd, nil },
+			},
+			mg: aksCluster(withSecretExpiry(expiring)),
+			want: want{
+				eu: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(testPasswd),
+					},
+				},
+				mg: aksCluster(withSecretExpiry(rotated)),
+			},
+		},
+		"SuccessRotateSecretWithConnectionSecretFormat": {
+			e: &external{
+				client: fake.AKSClient{
+					MockRotateSecret: func(_ context.Context, _ *v1alpha3.AKSCluster, _ string) (time.Time, error) {
+						return rotated, nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
+				},
+				newPasswordFn: func() (string, error) { return testPasswd, nil },
+			},
+			mg: aksCluster(withSecretExpiry(expiring), withConnectionSecretFormat(&azurev1alpha3.ConnectionSecretFormat{Keys: []string{"kubeconfig"}})),
+			want: want{
+				eu: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				mg: aksCluster(withSecretExpiry(rotated), withConnectionSecretFormat(&azurev1alpha3.ConnectionSecretFormat{Keys: []string{"kubeconfig"}})),
+			},
+		},
+		"ManagedIdentityNoRotation": {
+			e: &external{
+				client: fake.AKSClient{
+					MockUpdateManagedCluster: func(_ context.Context, _ *v1alpha3.AKSCluster) error {
+						return nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return nil
+					},
+				},
+			},
+			mg: aksCluster(withIdentity(&v1alpha3.AKSClusterIdentity{}), withSecretExpiry(expiring)),
+			want: want{
+				mg: aksCluster(withIdentity(&v1alpha3.AKSClusterIdentity{}), withSecretExpiry(expiring)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eu, err := tc.e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eu, eu); diff != "" {
+				t.Errorf("tc.e.Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("tc.e.Update(...): -want managed, +got managed:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	computesdk "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute/fake"
+)
+
+const (
+	name              = "cool-snapshot"
+	resourceGroupName = "cool-rg"
+	id                = "cool-id"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+)
+
+type modifier func(*v1alpha3.Snapshot)
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(s *v1alpha3.Snapshot) { s.Status.SetConditions(c...) }
+}
+
+func withTags(t map[string]string) modifier {
+	return func(s *v1alpha3.Snapshot) { s.Spec.ForProvider.Tags = t }
+}
+
+func withIncremental(i bool) modifier {
+	return func(s *v1alpha3.Snapshot) { s.Spec.ForProvider.Incremental = to.BoolPtr(i) }
+}
+
+func withObservation(o v1alpha3.SnapshotObservation) modifier {
+	return func(s *v1alpha3.Snapshot) { s.Status.AtProvider = o }
+}
+
+func snapshot(m ...modifier) *v1alpha3.Snapshot {
+	s := &v1alpha3.Snapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.SnapshotSpec{
+			ForProvider: v1alpha3.SnapshotParameters{ResourceGroupName: resourceGroupName},
+		},
+	}
+	meta.SetExternalName(s, name)
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func azureSnapshot(state string) computesdk.Snapshot {
+	return computesdk.Snapshot{
+		ID: to.StringPtr(id),
+		SnapshotProperties: &computesdk.SnapshotProperties{
+			DiskSizeGB:        to.Int32Ptr(32),
+			Incremental:       to.BoolPtr(true),
+			ProvisioningState: to.StringPtr(state),
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotSnapshot": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotSnapshot),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockGet: func(_ context.Context, _, _ string) (computesdk.Snapshot, error) {
+					return computesdk.Snapshot{}, errNotFound
+				},
+			}},
+			mg: snapshot(),
+			want: want{
+				mg: snapshot(),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockGet: func(_ context.Context, _, _ string) (computesdk.Snapshot, error) {
+					return computesdk.Snapshot{}, errBoom
+				},
+			}},
+			mg: snapshot(),
+			want: want{
+				mg:  snapshot(),
+				err: errors.Wrap(errBoom, errGetSnapshot),
+			},
+		},
+		"Creating": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockGet: func(_ context.Context, _, _ string) (computesdk.Snapshot, error) {
+					return azureSnapshot("Creating"), nil
+				},
+			}},
+			mg: snapshot(withIncremental(true), withTags(map[string]string{"cool": "snapshot"})),
+			want: want{
+				mg: snapshot(withIncremental(true), withTags(map[string]string{"cool": "snapshot"}),
+					withObservation(v1alpha3.SnapshotObservation{ID: id, DiskSizeGB: to.Int32Ptr(32), ProvisioningState: "Creating"}),
+					withConditions(xpv1.Unavailable())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockGet: func(_ context.Context, _, _ string) (computesdk.Snapshot, error) {
+					return azureSnapshot("Succeeded"), nil
+				},
+			}},
+			mg: snapshot(withIncremental(true), withTags(map[string]string{"cool": "snapshot"})),
+			want: want{
+				mg: snapshot(withIncremental(true), withTags(map[string]string{"cool": "snapshot"}),
+					withObservation(v1alpha3.SnapshotObservation{ID: id, DiskSizeGB: to.Int32Ptr(32), ProvisioningState: "Succeeded"}),
+					withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LateInitialized": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockGet: func(_ context.Context, _, _ string) (computesdk.Snapshot, error) {
+					return azureSnapshot("Succeeded"), nil
+				},
+			}},
+			mg: snapshot(),
+			want: want{
+				mg: snapshot(withIncremental(true),
+					withObservation(v1alpha3.SnapshotObservation{ID: id, DiskSizeGB: to.Int32Ptr(32), ProvisioningState: "Succeeded"}),
+					withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotSnapshot": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotSnapshot),
+			},
+		},
+		"CreateError": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ computesdk.Snapshot) (computesdk.SnapshotsCreateOrUpdateFuture, error) {
+					return computesdk.SnapshotsCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			mg: snapshot(),
+			want: want{
+				mg:  snapshot(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateSnapshot),
+			},
+		},
+		"Success": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ computesdk.Snapshot) (computesdk.SnapshotsCreateOrUpdateFuture, error) {
+					return computesdk.SnapshotsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			mg: snapshot(),
+			want: want{
+				mg: snapshot(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotSnapshot": {
+			e:    &external{},
+			want: errors.New(errNotSnapshot),
+		},
+		"UpdateError": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockUpdate: func(_ context.Context, _, _ string, _ computesdk.SnapshotUpdate) (computesdk.SnapshotsUpdateFuture, error) {
+					return computesdk.SnapshotsUpdateFuture{}, errBoom
+				},
+			}},
+			mg:   snapshot(),
+			want: errors.Wrap(errBoom, errUpdateSnapshot),
+		},
+		"Success": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockUpdate: func(_ context.Context, _, _ string, _ computesdk.SnapshotUpdate) (computesdk.SnapshotsUpdateFuture, error) {
+					return computesdk.SnapshotsUpdateFuture{}, nil
+				},
+			}},
+			mg: snapshot(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotSnapshot": {
+			e:    &external{},
+			want: errors.New(errNotSnapshot),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockDelete: func(_ context.Context, _, _ string) (computesdk.SnapshotsDeleteFuture, error) {
+					return computesdk.SnapshotsDeleteFuture{}, errNotFound
+				},
+			}},
+			mg: snapshot(),
+		},
+		"DeleteError": {
+			e: &external{client: &fake.MockSnapshotsClient{
+				MockDelete: func(_ context.Context, _, _ string) (computesdk.SnapshotsDeleteFuture, error) {
+					return computesdk.SnapshotsDeleteFuture{}, errBoom
+				},
+			}},
+			mg:   snapshot(),
+			want: errors.Wrap(errBoom, errDeleteSnapshot),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
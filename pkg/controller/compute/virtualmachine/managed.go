@@ -0,0 +1,220 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualmachine
+
+import (
+	"context"
+
+	computesdk "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotVirtualMachine    = "managed resource is not a VirtualMachine"
+	errCreateVirtualMachine = "cannot create VirtualMachine"
+	errUpdateVirtualMachine = "cannot update VirtualMachine"
+	errGetVirtualMachine    = "cannot get VirtualMachine"
+	errDeleteVirtualMachine = "cannot delete VirtualMachine"
+	errGenPassword          = "cannot generate admin password"
+	errGetPasswordSecret    = "cannot get admin password secret"
+	errFetchLastOperation   = "cannot fetch last operation"
+)
+
+// Setup adds a controller that reconciles VirtualMachines.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.VirtualMachineGroupKind)
+	o = azure.ControllerOptions(v1alpha3.VirtualMachineGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient()), azure.NewDefaultTagger(mgr.GetClient(), resourceTags)}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&v1alpha3.VirtualMachine{}).
+		Complete(azure.NewInstrumentedReconciler(v1alpha3.VirtualMachineGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.VirtualMachineGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1alpha3.VirtualMachineGroupKind, bo, r, &connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+func resourceTags(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*v1alpha3.VirtualMachine)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Tags
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := computesdk.NewVirtualMachinesClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{kube: c.client, client: compute.NewVirtualMachineClient(cl), newPasswordFn: password.Generate}, nil
+}
+
+type external struct {
+	kube          client.Client
+	client        compute.VirtualMachineAPI
+	newPasswordFn func() (password string, err error)
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.VirtualMachine)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotVirtualMachine)
+	}
+
+	vm, err := e.client.Get(ctx, cr)
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetVirtualMachine)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	compute.LateInitializeVirtualMachine(&cr.Spec.ForProvider, vm)
+	li := !cmp.Equal(current, &cr.Spec.ForProvider)
+
+	compute.UpdateVirtualMachineObservation(&cr.Status.AtProvider, vm)
+	if err := azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+	}
+
+	// The administrator password is only known when the virtual machine is
+	// created, so only the username is published when it is observed.
+	cd := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey: []byte(cr.Spec.ForProvider.OSProfile.AdminUsername),
+	}
+
+	if cr.Status.AtProvider.ProvisioningState != "Succeeded" {
+		// Virtual machines that are being created or resized can't be
+		// updated until the operation completes.
+		cr.SetConditions(xpv1.Unavailable())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: li, ConnectionDetails: cd}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        compute.IsVirtualMachineUpToDate(cr.Spec.ForProvider, vm),
+		ResourceLateInitialized: li,
+		ConnectionDetails:       cd,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.VirtualMachine)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotVirtualMachine)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	pw, err := e.adminPassword(ctx, cr.Spec.ForProvider.OSProfile)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := e.client.Create(ctx, cr, pw); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateVirtualMachine)
+	}
+
+	cd := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey: []byte(cr.Spec.ForProvider.OSProfile.AdminUsername),
+	}
+	if pw != "" {
+		cd[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+	}
+	return managed.ExternalCreation{ConnectionDetails: cd}, errors.Wrap(azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation), errFetchLastOperation)
+}
+
+// adminPassword returns the referenced administrator password, or generates
+// one if none is referenced. No password is returned if password
+// authentication is disabled.
+func (e *external) adminPassword(ctx context.Context, p v1alpha3.VirtualMachineOSProfile) (string, error) {
+	if azure.ToBool(p.DisablePasswordAuthentication) {
+		return "", nil
+	}
+	if p.AdminPasswordSecretRef != nil {
+		pw, err := azure.SecretKeyValue(ctx, e.kube, *p.AdminPasswordSecretRef)
+		return pw, errors.Wrap(err, errGetPasswordSecret)
+	}
+	pw, err := e.newPasswordFn()
+	return pw, errors.Wrap(err, errGenPassword)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.VirtualMachine)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotVirtualMachine)
+	}
+	if cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress {
+		return managed.ExternalUpdate{}, nil
+	}
+	if err := e.client.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateVirtualMachine)
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation), errFetchLastOperation)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.VirtualMachine)
+	if !ok {
+		return errors.New(errNotVirtualMachine)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, e.client.Delete(ctx, cr)), errDeleteVirtualMachine)
+}
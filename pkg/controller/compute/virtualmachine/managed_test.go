@@ -0,0 +1,440 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualmachine
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	computesdk "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	azurev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/compute/fake"
+)
+
+const (
+	id       = "cool-id"
+	vmSize   = "Standard_B2s"
+	username = "cool-admin"
+	pw       = "cool-password"
+)
+
+var errBoom = errors.New("boom")
+
+type modifier func(*v1alpha3.VirtualMachine)
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(vm *v1alpha3.VirtualMachine) { vm.Status.SetConditions(c...) }
+}
+
+func withVMSize(s string) modifier {
+	return func(vm *v1alpha3.VirtualMachine) { vm.Spec.ForProvider.VMSize = s }
+}
+
+func withObservation(o v1alpha3.VirtualMachineObservation) modifier {
+	return func(vm *v1alpha3.VirtualMachine) { vm.Status.AtProvider = o }
+}
+
+func withLastOperation(op azurev1alpha3.AsyncOperation) modifier {
+	return func(vm *v1alpha3.VirtualMachine) { vm.Status.AtProvider.LastOperation = op }
+}
+
+func withOSProfile(p v1alpha3.VirtualMachineOSProfile) modifier {
+	return func(vm *v1alpha3.VirtualMachine) { vm.Spec.ForProvider.OSProfile = p }
+}
+
+func virtualMachine(m ...modifier) *v1alpha3.VirtualMachine {
+	vm := &v1alpha3.VirtualMachine{
+		Spec: v1alpha3.VirtualMachineSpec{
+			ForProvider: v1alpha3.VirtualMachineParameters{
+				ResourceGroupName: "cool-rg",
+				VMSize:            vmSize,
+				OSProfile:         v1alpha3.VirtualMachineOSProfile{AdminUsername: username},
+			},
+		},
+	}
+	for _, mod := range m {
+		mod(vm)
+	}
+	return vm
+}
+
+func azureVirtualMachine(state string) computesdk.VirtualMachine {
+	return computesdk.VirtualMachine{
+		ID: to.StringPtr(id),
+		VirtualMachineProperties: &computesdk.VirtualMachineProperties{
+			HardwareProfile:   &computesdk.HardwareProfile{VMSize: computesdk.VirtualMachineSizeTypes(vmSize)},
+			ProvisioningState: to.StringPtr(state),
+		},
+	}
+}
+
+func sender() autorest.Sender {
+	return autorest.SenderFunc(func(*http.Request) (*http.Response, error) { return nil, nil })
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cd := managed.ConnectionDetails{xpv1.ResourceCredentialsSecretUserKey: []byte(username)}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotVirtualMachine": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotVirtualMachine),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockVirtualMachineAPI{
+				MockGet: func(_ context.Context, _ *v1alpha3.VirtualMachine) (computesdk.VirtualMachine, error) {
+					return computesdk.VirtualMachine{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: virtualMachine(),
+			want: want{
+				mg: virtualMachine(),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			e: &external{client: &fake.MockVirtualMachineAPI{
+				MockGet: func(_ context.Context, _ *v1alpha3.VirtualMachine) (computesdk.VirtualMachine, error) {
+					return computesdk.VirtualMachine{}, errBoom
+				},
+			}},
+			mg: virtualMachine(),
+			want: want{
+				mg:  virtualMachine(),
+				err: errors.Wrap(errBoom, errGetVirtualMachine),
+			},
+		},
+		"Creating": {
+			e: &external{client: &fake.MockVirtualMachineAPI{
+				MockGet: func(_ context.Context, _ *v1alpha3.VirtualMachine) (computesdk.VirtualMachine, error) {
+					return azureVirtualMachine("Creating"), nil
+				},
+				MockGetRESTClient: sender,
+			}},
+			mg: virtualMachine(withVMSize("Standard_D4s_v3")),
+			want: want{
+				mg: virtualMachine(withVMSize("Standard_D4s_v3"),
+					withObservation(v1alpha3.VirtualMachineObservation{ID: id, ProvisioningState: "Creating"}),
+					withConditions(xpv1.Unavailable())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: cd},
+			},
+		},
+		"NeedsUpdate": {
+			e: &external{client: &fake.MockVirtualMachineAPI{
+				MockGet: func(_ context.Context, _ *v1alpha3.VirtualMachine) (computesdk.VirtualMachine, error) {
+					return azureVirtualMachine("Succeeded"), nil
+				},
+				MockGetRESTClient: sender,
+			}},
+			mg: virtualMachine(withVMSize("Standard_D4s_v3")),
+			want: want{
+				mg: virtualMachine(withVMSize("Standard_D4s_v3"),
+					withObservation(v1alpha3.VirtualMachineObservation{ID: id, ProvisioningState: "Succeeded"}),
+					withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: cd},
+			},
+		},
+		"UpToDate": {
+			e: &external{client: &fake.MockVirtualMachineAPI{
+				MockGet: func(_ context.Context, _ *v1alpha3.VirtualMachine) (computesdk.VirtualMachine, error) {
+					return azureVirtualMachine("Succeeded"), nil
+				},
+				MockGetRESTClient: sender,
+			}},
+			mg: virtualMachine(),
+			want: want{
+				mg: virtualMachine(
+					withObservation(v1alpha3.VirtualMachineObservation{ID: id, ProvisioningState: "Succeeded"}),
+					withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: cd},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		c   managed.ExternalCreation
+		err error
+	}
+
+	ref := &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: "cool-ns", Name: "cool-secret"}, Key: "password"}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotVirtualMachine": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotVirtualMachine),
+			},
+		},
+		"GenPasswordError": {
+			e:  &external{newPasswordFn: func() (string, error) { return "", errBoom }},
+			mg: virtualMachine(),
+			want: want{
+				mg:  virtualMachine(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errGenPassword),
+			},
+		},
+		"GetPasswordSecretError": {
+			e: &external{kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)}},
+			mg: virtualMachine(withOSProfile(v1alpha3.VirtualMachineOSProfile{
+				AdminUsername:          username,
+				AdminPasswordSecretRef: ref,
+			})),
+			want: want{
+				mg: virtualMachine(withOSProfile(v1alpha3.VirtualMachineOSProfile{
+					AdminUsername:          username,
+					AdminPasswordSecretRef: ref,
+				}), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get secret"), errGetPasswordSecret),
+			},
+		},
+		"CreateError": {
+			e: &external{
+				newPasswordFn: func() (string, error) { return pw, nil },
+				client: &fake.MockVirtualMachineAPI{
+					MockCreate: func(_ context.Context, _ *v1alpha3.VirtualMachine, _ string) error { return errBoom },
+				},
+			},
+			mg: virtualMachine(),
+			want: want{
+				mg:  virtualMachine(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateVirtualMachine),
+			},
+		},
+		"GeneratedPassword": {
+			e: &external{
+				newPasswordFn: func() (string, error) { return pw, nil },
+				client: &fake.MockVirtualMachineAPI{
+					MockCreate: func(_ context.Context, _ *v1alpha3.VirtualMachine, password string) error {
+						if password != pw {
+							return errors.New("wrong password")
+						}
+						return nil
+					},
+					MockGetRESTClient: sender,
+				},
+			},
+			mg: virtualMachine(),
+			want: want{
+				mg: virtualMachine(withConditions(xpv1.Creating())),
+				c: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretUserKey:     []byte(username),
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+				}},
+			},
+		},
+		"ReferencedPassword": {
+			e: &external{
+				kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte("referenced")}
+					return nil
+				}},
+				client: &fake.MockVirtualMachineAPI{
+					MockCreate: func(_ context.Context, _ *v1alpha3.VirtualMachine, password string) error {
+						if password != "referenced" {
+							return errors.New("wrong password")
+						}
+						return nil
+					},
+					MockGetRESTClient: sender,
+				},
+			},
+			mg: virtualMachine(withOSProfile(v1alpha3.VirtualMachineOSProfile{
+				AdminUsername:          username,
+				AdminPasswordSecretRef: ref,
+			})),
+			want: want{
+				mg: virtualMachine(withOSProfile(v1alpha3.VirtualMachineOSProfile{
+					AdminUsername:          username,
+					AdminPasswordSecretRef: ref,
+				}), withConditions(xpv1.Creating())),
+				c: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretUserKey:     []byte(username),
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte("referenced"),
+				}},
+			},
+		},
+		"PasswordAuthenticationDisabled": {
+			e: &external{
+				client: &fake.MockVirtualMachineAPI{
+					MockCreate: func(_ context.Context, _ *v1alpha3.VirtualMachine, password string) error {
+						if password != "" {
+							return errors.New("unexpected password")
+						}
+						return nil
+					},
+					MockGetRESTClient: sender,
+				},
+			},
+			mg: virtualMachine(withOSProfile(v1alpha3.VirtualMachineOSProfile{
+				AdminUsername:                 username,
+				DisablePasswordAuthentication: to.BoolPtr(true),
+			})),
+			want: want{
+				mg: virtualMachine(withOSProfile(v1alpha3.VirtualMachineOSProfile{
+					AdminUsername:                 username,
+					DisablePasswordAuthentication: to.BoolPtr(true),
+				}), withConditions(xpv1.Creating())),
+				c: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretUserKey: []byte(username),
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, err := tc.e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.c, c); diff != "" {
+				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	inProgress := azurev1alpha3.AsyncOperation{Method: http.MethodPut, PollingURL: "crossplane.io", Status: azure.AsyncOperationStatusInProgress}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotVirtualMachine": {
+			e:    &external{},
+			want: errors.New(errNotVirtualMachine),
+		},
+		"OperationInProgress": {
+			e:  &external{client: &fake.MockVirtualMachineAPI{}},
+			mg: virtualMachine(withLastOperation(inProgress)),
+		},
+		"UpdateError": {
+			e: &external{client: &fake.MockVirtualMachineAPI{
+				MockUpdate: func(_ context.Context, _ *v1alpha3.VirtualMachine) error { return errBoom },
+			}},
+			mg:   virtualMachine(),
+			want: errors.Wrap(errBoom, errUpdateVirtualMachine),
+		},
+		"Success": {
+			e: &external{client: &fake.MockVirtualMachineAPI{
+				MockUpdate:        func(_ context.Context, _ *v1alpha3.VirtualMachine) error { return nil },
+				MockGetRESTClient: sender,
+			}},
+			mg: virtualMachine(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotVirtualMachine": {
+			e:    &external{},
+			want: errors.New(errNotVirtualMachine),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockVirtualMachineAPI{
+				MockDelete: func(_ context.Context, _ *v1alpha3.VirtualMachine) error {
+					return autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: virtualMachine(),
+		},
+		"DeleteError": {
+			e: &external{client: &fake.MockVirtualMachineAPI{
+				MockDelete: func(_ context.Context, _ *v1alpha3.VirtualMachine) error { return errBoom },
+			}},
+			mg:   virtualMachine(),
+			want: errors.Wrap(errBoom, errDeleteVirtualMachine),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+const (
+	// Credentials are validated again periodically, since the secret they
+	// are read from may change, or the service principal may be deleted or
+	// lose access to its subscription, without the ProviderConfig changing.
+	validationInterval = 10 * time.Minute
+
+	reasonCredentialsValid   event.Reason = "CredentialsValid"
+	reasonCredentialsInvalid event.Reason = "CredentialsInvalid"
+
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errUpdateStatus      = "cannot update ProviderConfig status"
+)
+
+// SetupValidation adds a controller that validates the credentials of
+// ProviderConfigs and reports whether they are usable in their Ready
+// condition. Credentials are not validated in simulation mode, in which
+// Azure API requests are not authorized.
+func SetupValidation(mgr ctrl.Manager, o controller.Options) error {
+	if o.Features.Enabled(features.EnableSimulation) {
+		return nil
+	}
+	name := "validation/" + strings.ToLower(v1beta1.ProviderConfigGroupKind)
+	r := &ValidationReconciler{
+		kube:     mgr.GetClient(),
+		validate: validate(mgr.GetClient()),
+		poll:     validationInterval,
+		log:      o.Logger.WithValues("controller", name),
+		record:   event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		// Status updates, e.g. of the number of users of a ProviderConfig,
+		// do not warrant validating its credentials again.
+		For(&v1beta1.ProviderConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+func validate(kube client.Client) func(context.Context, *v1beta1.ProviderConfig) error {
+	return func(ctx context.Context, pc *v1beta1.ProviderConfig) error {
+		creds, err := azure.ProviderConfigCredentials(ctx, kube, pc.GetName())
+		if err != nil {
+			return err
+		}
+		return azure.VerifyCredentials(ctx, creds)
+	}
+}
+
+// A ValidationReconciler validates the credentials of a ProviderConfig.
+type ValidationReconciler struct {
+	kube     client.Client
+	validate func(context.Context, *v1beta1.ProviderConfig) error
+	poll     time.Duration
+	log      logging.Logger
+	record   event.Recorder
+}
+
+// Reconcile validates the credentials of a ProviderConfig by acquiring a
+// token with them and reading the subscription they specify.
+func (r *ValidationReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	r.log.Debug("Reconciling", "request", req)
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetProviderConfig)
+	}
+	if meta.WasDeleted(pc) {
+		return reconcile.Result{}, nil
+	}
+
+	c := v1beta1.CredentialsValid()
+	e := event.Normal(reasonCredentialsValid, "Successfully validated credentials")
+	if err := r.validate(ctx, pc); err != nil {
+		c = v1beta1.CredentialsInvalid(err.Error())
+		e = event.Warning(reasonCredentialsInvalid, err)
+	}
+	if pc.GetCondition(c.Type).Equal(c) {
+		return reconcile.Result{RequeueAfter: r.poll}, nil
+	}
+
+	r.record.Event(pc, e)
+	pc.SetConditions(c)
+	return reconcile.Result{RequeueAfter: r.poll}, errors.Wrap(r.kube.Status().Update(ctx, pc), errUpdateStatus)
+}
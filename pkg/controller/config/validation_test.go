@@ -0,0 +1,159 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
+)
+
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) { r.events = append(r.events, e) }
+
+func (r *recorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func providerConfig(c ...xpv1.Condition) test.ObjectFn {
+	return func(obj client.Object) error {
+		obj.(*v1beta1.ProviderConfig).SetConditions(c...)
+		return nil
+	}
+}
+
+func wantCondition(t *testing.T, want xpv1.Condition) test.ObjectFn {
+	return func(obj client.Object) error {
+		got := obj.(*v1beta1.ProviderConfig).GetCondition(xpv1.TypeReady)
+		if diff := cmp.Diff(want, got, test.EquateConditions()); diff != "" {
+			t.Errorf("Status().Update(...): -want condition, +got condition:\n%s", diff)
+		}
+		return nil
+	}
+}
+
+func TestValidationReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		kube     client.Client
+		validate func(context.Context, *v1beta1.ProviderConfig) error
+	}
+	type want struct {
+		result reconcile.Result
+		err    error
+		events int
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"GetError": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderConfig),
+			},
+		},
+		"Valid": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:          test.NewMockGetFn(nil),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(nil, wantCondition(t, v1beta1.CredentialsValid())),
+				},
+				validate: func(context.Context, *v1beta1.ProviderConfig) error { return nil },
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: validationInterval},
+				events: 1,
+			},
+		},
+		"Invalid": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:          test.NewMockGetFn(nil, providerConfig(v1beta1.CredentialsValid())),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(nil, wantCondition(t, v1beta1.CredentialsInvalid(errBoom.Error()))),
+				},
+				validate: func(context.Context, *v1beta1.ProviderConfig) error { return errBoom },
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: validationInterval},
+				events: 1,
+			},
+		},
+		"Unchanged": {
+			args: args{
+				kube:     &test.MockClient{MockGet: test.NewMockGetFn(nil, providerConfig(v1beta1.CredentialsInvalid(errBoom.Error())))},
+				validate: func(context.Context, *v1beta1.ProviderConfig) error { return errBoom },
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: validationInterval},
+			},
+		},
+		"UpdateStatusError": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:          test.NewMockGetFn(nil),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(errBoom),
+				},
+				validate: func(context.Context, *v1beta1.ProviderConfig) error { return nil },
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: validationInterval},
+				err:    errors.Wrap(errBoom, errUpdateStatus),
+				events: 1,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rec := &recorder{}
+			r := &ValidationReconciler{
+				kube:     tc.args.kube,
+				validate: tc.args.validate,
+				poll:     validationInterval,
+				log:      logging.NewNopLogger(),
+				record:   rec,
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r.Reconcile(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r.Reconcile(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, len(rec.events)); diff != "" {
+				t.Errorf("r.Reconcile(...): -want events, +got events:\n%s", diff)
+			}
+		})
+	}
+}
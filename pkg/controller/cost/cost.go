@@ -0,0 +1,220 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	cachev1beta1 "github.com/crossplane-contrib/provider-azure/apis/cache/v1beta1"
+	computev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	databasev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
+	databasev1beta1 "github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
+	networkv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	costclients "github.com/crossplane-contrib/provider-azure/pkg/clients/cost"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+const (
+	// Cost Management refreshes cost data a few times a day, and throttles
+	// queries heavily, so there is little point in asking more often.
+	pollInterval = 4 * time.Hour
+
+	errGetManaged    = "cannot get managed resource"
+	errUpdateManaged = "cannot update managed resource"
+)
+
+// Labels of the month-to-date cost metric.
+const (
+	labelGroupKind      = "group_kind"
+	labelName           = "name"
+	labelClaimNamespace = "claim_namespace"
+	labelClaimName      = "claim_name"
+	labelCurrency       = "currency"
+)
+
+// monthToDateCost is the month-to-date cost of each managed resource, labelled
+// with the claim it was composed for, if any, so spend can be attributed.
+var monthToDateCost = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "crossplane_azure_managed_resource_month_to_date_cost",
+	Help: "Actual cost of the Azure resource of a managed resource in the current calendar month.",
+}, []string{labelGroupKind, labelName, labelClaimNamespace, labelClaimName, labelCurrency})
+
+// A kind of managed resource whose cost is reported.
+type kind struct {
+	groupKind  string
+	newManaged func() resource.Managed
+	resourceID func(resource.Managed) string
+}
+
+var kinds = []kind{
+	{
+		groupKind:  computev1alpha3.AKSClusterGroupKind,
+		newManaged: func() resource.Managed { return &computev1alpha3.AKSCluster{} },
+		resourceID: func(mg resource.Managed) string { return mg.(*computev1alpha3.AKSCluster).Status.ProviderID },
+	},
+	{
+		groupKind:  cachev1beta1.RedisGroupKind,
+		newManaged: func() resource.Managed { return &cachev1beta1.Redis{} },
+		resourceID: func(mg resource.Managed) string { return mg.(*cachev1beta1.Redis).Status.AtProvider.ID },
+	},
+	{
+		groupKind:  databasev1beta1.MySQLServerGroupKind,
+		newManaged: func() resource.Managed { return &databasev1beta1.MySQLServer{} },
+		resourceID: func(mg resource.Managed) string { return mg.(*databasev1beta1.MySQLServer).Status.AtProvider.ID },
+	},
+	{
+		groupKind:  databasev1beta1.PostgreSQLServerGroupKind,
+		newManaged: func() resource.Managed { return &databasev1beta1.PostgreSQLServer{} },
+		resourceID: func(mg resource.Managed) string { return mg.(*databasev1beta1.PostgreSQLServer).Status.AtProvider.ID },
+	},
+	{
+		groupKind:  databasev1alpha3.CosmosDBAccountGroupKind,
+		newManaged: func() resource.Managed { return &databasev1alpha3.CosmosDBAccount{} },
+		resourceID: func(mg resource.Managed) string {
+			if o := mg.(*databasev1alpha3.CosmosDBAccount).Status.AtProvider; o != nil {
+				return o.ID
+			}
+			return ""
+		},
+	},
+	{
+		groupKind:  networkv1alpha3.PublicIPAddressGroupKind,
+		newManaged: func() resource.Managed { return &networkv1alpha3.PublicIPAddress{} },
+		resourceID: func(mg resource.Managed) string { return mg.(*networkv1alpha3.PublicIPAddress).Status.AtProvider.ID },
+	},
+}
+
+// Setup adds controllers that report the month-to-date cost of managed
+// resources as annotations and as a metric, if the feature is enabled.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	if !o.Features.Enabled(features.EnableAlphaCostReporting) {
+		return nil
+	}
+	if err := metrics.Registry.Register(monthToDateCost); err != nil {
+		return err
+	}
+	for _, k := range kinds {
+		name := "cost/" + strings.ToLower(k.groupKind)
+		r := &Reconciler{
+			kube:       mgr.GetClient(),
+			groupKind:  k.groupKind,
+			newManaged: k.newManaged,
+			resourceID: k.resourceID,
+			connect:    connect(mgr.GetClient()),
+			metric:     monthToDateCost,
+			poll:       pollInterval,
+			log:        o.Logger.WithValues("controller", name),
+		}
+		if err := ctrl.NewControllerManagedBy(mgr).
+			Named(name).
+			WithOptions(o.ForControllerRuntime()).
+			For(k.newManaged()).
+			Complete(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func connect(kube client.Client) func(context.Context, resource.Managed) (costclients.CostAPI, error) {
+	return func(ctx context.Context, mg resource.Managed) (costclients.CostAPI, error) {
+		creds, auth, err := azure.GetAuthInfo(ctx, kube, mg)
+		if err != nil {
+			return nil, err
+		}
+		return costclients.NewQueryClient(creds, auth), nil
+	}
+}
+
+// A Reconciler reports the month-to-date cost of a kind of managed resource.
+type Reconciler struct {
+	kube       client.Client
+	groupKind  string
+	newManaged func() resource.Managed
+	resourceID func(resource.Managed) string
+	connect    func(context.Context, resource.Managed) (costclients.CostAPI, error)
+	metric     *prometheus.GaugeVec
+	poll       time.Duration
+	log        logging.Logger
+}
+
+// Reconcile the month-to-date cost of a managed resource.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	r.log.Debug("Reconciling", "request", req)
+
+	mg := r.newManaged()
+	if err := r.kube.Get(ctx, req.NamespacedName, mg); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetManaged)
+	}
+
+	if meta.WasDeleted(mg) {
+		r.metric.Delete(r.labels(mg, mg.GetAnnotations()[costclients.AnnotationKeyCostCurrency]))
+		return reconcile.Result{}, nil
+	}
+
+	// Resources are reconciled again when their ID is first observed, since
+	// that updates their status.
+	id := r.resourceID(mg)
+	if id == "" {
+		return reconcile.Result{}, nil
+	}
+
+	api, err := r.connect(ctx, mg)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	c, err := api.MonthToDateCost(ctx, id)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	r.metric.With(r.labels(mg, c.Currency)).Set(c.Amount)
+
+	a := mg.GetAnnotations()
+	if a[costclients.AnnotationKeyMonthToDateCost] == c.String() && a[costclients.AnnotationKeyCostCurrency] == c.Currency {
+		return reconcile.Result{RequeueAfter: r.poll}, nil
+	}
+	meta.AddAnnotations(mg, map[string]string{
+		costclients.AnnotationKeyMonthToDateCost: c.String(),
+		costclients.AnnotationKeyCostCurrency:    c.Currency,
+	})
+	return reconcile.Result{RequeueAfter: r.poll}, errors.Wrap(r.kube.Update(ctx, mg), errUpdateManaged)
+}
+
+func (r *Reconciler) labels(mg resource.Managed, currency string) prometheus.Labels {
+	return prometheus.Labels{
+		labelGroupKind:      r.groupKind,
+		labelName:           mg.GetName(),
+		labelClaimNamespace: mg.GetLabels()[azure.LabelKeyClaimNamespace],
+		labelClaimName:      mg.GetLabels()[azure.LabelKeyClaimName],
+		labelCurrency:       currency,
+	}
+}
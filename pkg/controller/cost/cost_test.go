@@ -0,0 +1,214 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	costclients "github.com/crossplane-contrib/provider-azure/pkg/clients/cost"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/cost/fake"
+)
+
+const (
+	id         = "/subscriptions/coolsub/resourceGroups/coolgroup/providers/Microsoft.DBforMySQL/servers/coolserver"
+	serverName = "coolserver"
+	claimNS    = "coolns"
+	claim      = "coolclaim"
+	currency   = "USD"
+)
+
+func server(id string, annotations map[string]string) test.ObjectFn {
+	return func(obj client.Object) error {
+		s := obj.(*v1beta1.MySQLServer)
+		s.SetName(serverName)
+		s.SetLabels(map[string]string{azure.LabelKeyClaimNamespace: claimNS, azure.LabelKeyClaimName: claim})
+		// Reconcile mutates the annotations, which are shared by cases.
+		a := make(map[string]string, len(annotations))
+		for k, v := range annotations {
+			a[k] = v
+		}
+		s.SetAnnotations(a)
+		s.Status.AtProvider.ID = id
+		return nil
+	}
+}
+
+func deleted() test.ObjectFn {
+	return func(obj client.Object) error {
+		now := metav1.Now()
+		obj.SetDeletionTimestamp(&now)
+		return nil
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+	annotated := map[string]string{
+		costclients.AnnotationKeyMonthToDateCost: "12.35",
+		costclients.AnnotationKeyCostCurrency:    currency,
+	}
+	api := func(c costclients.Cost, err error) func(context.Context, resource.Managed) (costclients.CostAPI, error) {
+		return func(context.Context, resource.Managed) (costclients.CostAPI, error) {
+			return &fake.MockCostAPI{
+				MockMonthToDateCost: func(_ context.Context, _ string) (costclients.Cost, error) {
+					return c, err
+				},
+			}, nil
+		}
+	}
+
+	type args struct {
+		reported bool
+		kube     client.Client
+		connect  func(context.Context, resource.Managed) (costclients.CostAPI, error)
+	}
+	type want struct {
+		result  reconcile.Result
+		err     error
+		metrics int
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"GetError": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetManaged),
+			},
+		},
+		"NotObserved": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			},
+		},
+		"Deleted": {
+			args: args{
+				reported: true,
+				kube:     &test.MockClient{MockGet: test.NewMockGetFn(nil, server(id, annotated), deleted())},
+			},
+		},
+		"ConnectError": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, server(id, nil))},
+				connect: func(context.Context, resource.Managed) (costclients.CostAPI, error) {
+					return nil, errBoom
+				},
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"QueryError": {
+			args: args{
+				kube:    &test.MockClient{MockGet: test.NewMockGetFn(nil, server(id, nil))},
+				connect: api(costclients.Cost{}, errBoom),
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"Unchanged": {
+			args: args{
+				kube:    &test.MockClient{MockGet: test.NewMockGetFn(nil, server(id, annotated))},
+				connect: api(costclients.Cost{Amount: 12.3456, Currency: currency}, nil),
+			},
+			want: want{
+				result:  reconcile.Result{RequeueAfter: pollInterval},
+				metrics: 1,
+			},
+		},
+		"Changed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, server(id, annotated)),
+					MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+						if diff := cmp.Diff("20.00", obj.GetAnnotations()[costclients.AnnotationKeyMonthToDateCost]); diff != "" {
+							t.Errorf("Update(...): -want cost, +got cost:\n%s", diff)
+						}
+						return nil
+					}),
+				},
+				connect: api(costclients.Cost{Amount: 20, Currency: currency}, nil),
+			},
+			want: want{
+				result:  reconcile.Result{RequeueAfter: pollInterval},
+				metrics: 1,
+			},
+		},
+		"UpdateError": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(nil, server(id, nil)),
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				connect: api(costclients.Cost{Amount: 20, Currency: currency}, nil),
+			},
+			want: want{
+				result:  reconcile.Result{RequeueAfter: pollInterval},
+				err:     errors.Wrap(errBoom, errUpdateManaged),
+				metrics: 1,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "test"}, []string{labelGroupKind, labelName, labelClaimNamespace, labelClaimName, labelCurrency})
+			if tc.args.reported {
+				m.With(prometheus.Labels{labelGroupKind: v1beta1.MySQLServerGroupKind, labelName: serverName, labelClaimNamespace: claimNS, labelClaimName: claim, labelCurrency: currency}).Set(1)
+			}
+			r := &Reconciler{
+				kube:       tc.args.kube,
+				groupKind:  v1beta1.MySQLServerGroupKind,
+				newManaged: func() resource.Managed { return &v1beta1.MySQLServer{} },
+				resourceID: func(mg resource.Managed) string { return mg.(*v1beta1.MySQLServer).Status.AtProvider.ID },
+				connect:    tc.args.connect,
+				metric:     m,
+				poll:       pollInterval,
+				log:        logging.NewNopLogger(),
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r.Reconcile(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r.Reconcile(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.metrics, testutil.CollectAndCount(m)); diff != "" {
+				t.Errorf("r.Reconcile(...): -want metrics, +got metrics:\n%s", diff)
+			}
+		})
+	}
+}
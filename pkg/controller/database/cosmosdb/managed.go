@@ -26,7 +26,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -34,42 +33,61 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
-	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 	"github.com/crossplane-contrib/provider-azure/pkg/clients/database/cosmosdb"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
 	"github.com/crossplane-contrib/provider-azure/pkg/features"
 )
 
 // Error strings
 const (
-	errNotNoSQLAccount    = "managed resource is not a Database Account"
-	errCreateNoSQLAccount = "cannot create Database Account"
-	errGetNoSQLAccount    = "cannot get Database Account"
-	errDeleteNoSQLAccount = "cannot delete Database Account"
+	errNotNoSQLAccount      = "managed resource is not a Database Account"
+	errCreateNoSQLAccount   = "cannot create Database Account"
+	errGetNoSQLAccount      = "cannot get Database Account"
+	errDeleteNoSQLAccount   = "cannot delete Database Account"
+	errListNoSQLAccountKeys = "cannot list Database Account keys"
 )
 
 // Setup adds a controller that reconciles NoSQLAccount.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha3.CosmosDBAccountGroupKind)
+	o = azure.ControllerOptions(v1alpha3.CosmosDBAccountGroupKind, o)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
-		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
 	}
 
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient()), azure.NewDefaultTagger(mgr.GetClient(), resourceTags)}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(bo.ForControllerRuntime(o)).
 		For(&v1alpha3.CosmosDBAccount{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(azure.NewInstrumentedReconciler(v1alpha3.CosmosDBAccountGroupKind, managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha3.CosmosDBAccountGroupVersionKind),
+			managed.WithInitializers(is...),
 			managed.WithConnectionPublishers(),
-			managed.WithExternalConnecter(&connecter{kube: mgr.GetClient()}),
+			managed.WithExternalConnecter(azure.NewConnecter(v1alpha3.CosmosDBAccountGroupKind, bo, r, &connecter{kube: mgr.GetClient()})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-			managed.WithConnectionPublishers(cps...)))
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+func resourceTags(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*v1alpha3.CosmosDBAccount)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Tags
 }
 
 type connecter struct {
@@ -112,14 +130,26 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 	cosmosdb.UpdateCosmosDBAccountObservation(&r.Status, account)
 
+	conn := azure.ResourceConnectionDetails(r.Status.AtProvider.ID, r.Spec.ForProvider.Location)
 	switch r.Status.AtProvider.State {
 	case "Succeeded":
+		k, err := e.client.ListKeys(ctx, r.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(r))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errListNoSQLAccountKeys)
+		}
+		conn[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(r.Status.AtProvider.Endpoint)
+		conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(azure.ToString(k.PrimaryMasterKey))
 		r.SetConditions(xpv1.Available())
 	default:
 		r.SetConditions(xpv1.Unavailable())
 	}
-	resourceUpToDate := cosmosdb.CheckEqualDatabaseProperties(r.Spec.ForProvider.Properties, account)
-	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: resourceUpToDate}, nil
+	resourceUpToDate := cosmosdb.CheckEqualDatabaseProperties(r.Spec.ForProvider.Properties, account) &&
+		cosmosdb.CheckEqualTags(r.Spec.ForProvider.Tags, account)
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  resourceUpToDate,
+		ConnectionDetails: conn,
+	}, nil
 }
 
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -133,7 +163,6 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		r.Spec.ForProvider.ResourceGroupName,
 		meta.GetExternalName(r),
 		cosmosdb.ToDatabaseAccountCreateOrUpdate(&r.Spec))
-	// TODO(artursouza): handle secrets.
 	return managed.ExternalCreation{}, errors.Wrap(err, errCreateNoSQLAccount)
 }
 
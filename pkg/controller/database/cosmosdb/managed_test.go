@@ -37,7 +37,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
-	cosmosdbclient "github.com/crossplane-contrib/provider-azure/pkg/clients/database/cosmosdb"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/database/cosmosdb/fake"
 )
 
 const (
@@ -47,46 +47,22 @@ const (
 	resourcegroupname = "cool-rg"
 	location          = "coolplace"
 	kind              = "mongodb"
+	endpoint          = "https://mycosmosaccount.documents.azure.com:443/"
+	primaryKey        = "verysecure"
 
 	stateSucceeded = "Succeeded"
 )
 
 type cosmosDBAccountModifier func(*v1alpha3.CosmosDBAccount)
 
-// MockClient is a fake implementation of the azure cosmosdb client.
-type MockClient struct {
-	cosmosdbclient.AccountClient
-
-	MockCreateOrUpdate  func(ctx context.Context, resourceGroupName string, accountName string, createUpdateParameters documentdb.DatabaseAccountCreateUpdateParameters) (result documentdb.DatabaseAccountsCreateOrUpdateFuture, err error)
-	MockCheckNameExists func(ctx context.Context, accountName string) (result autorest.Response, err error)
-	MockGet             func(ctx context.Context, resourceGroupName string, accountName string) (result documentdb.DatabaseAccount, err error)
-	MockDelete          func(ctx context.Context, resourceGroupName string, accountName string) (result documentdb.DatabaseAccountsDeleteFuture, err error)
-}
-
-// CreateOrUpdate calls the underlying MockCreateOrUpdate method.
-func (m *MockClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, accountName string, createUpdateParameters documentdb.DatabaseAccountCreateUpdateParameters) (result documentdb.DatabaseAccountsCreateOrUpdateFuture, err error) {
-	return m.MockCreateOrUpdate(ctx, resourceGroupName, accountName, createUpdateParameters)
-}
-
-// CheckExistence calls the underlying MockCheckExistence method.
-func (m *MockClient) CheckNameExists(ctx context.Context, accountName string) (result autorest.Response, err error) {
-	return m.MockCheckNameExists(ctx, accountName)
-}
-
-// CheckExistence calls the underlying MockCheckExistence method.
-func (m *MockClient) Get(ctx context.Context, resourceGroupName string, accountName string) (result documentdb.DatabaseAccount, err error) {
-	return m.MockGet(ctx, resourceGroupName, accountName)
-}
-
-// Delete calls the underlying MockDeleteGroup method.
-func (m *MockClient) Delete(ctx context.Context, resourceGroupName string, accountName string) (result documentdb.DatabaseAccountsDeleteFuture, err error) {
-	return m.MockDelete(ctx, resourceGroupName, accountName)
-}
-
 func withConditions(c ...xpv1.Condition) cosmosDBAccountModifier {
 	return func(r *v1alpha3.CosmosDBAccount) { r.Status.ConditionedStatus.Conditions = c }
 }
 
+func withEndpoint(e string) cosmosDBAccountModifier {
+	return func(r *v1alpha3.CosmosDBAccount) { r.Status.AtProvider.Endpoint = e }
+}
+
 func cosmosDBAccount(rm ...cosmosDBAccountModifier) *v1alpha3.CosmosDBAccount {
 	r := &v1alpha3.CosmosDBAccount{
 		ObjectMeta: metav1.ObjectMeta{
@@ -167,7 +143,7 @@ func TestObserve(t *testing.T) {
 		"CheckExistenceError": {
 			e: &external{
 				kube: mockKube,
-				client: &MockClient{
+				client: &fake.MockClient{
 					MockCheckNameExists: func(_ context.Context, _ string) (result autorest.Response, err error) {
 						return autorest.Response{}, errBoom
 					},
@@ -184,7 +160,7 @@ func TestObserve(t *testing.T) {
 		"AccountNotFound": {
 			e: &external{
 				kube: mockKube,
-				client: &MockClient{
+				client: &fake.MockClient{
 					MockCheckNameExists: func(_ context.Context, _ string) (result autorest.Response, err error) {
 						return autorest.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, nil
 					},
@@ -198,10 +174,48 @@ func TestObserve(t *testing.T) {
 				mg: cosmosDBAccount(),
 			},
 		},
+		"ListKeysError": {
+			e: &external{
+				kube: mockKube,
+				client: &fake.MockClient{
+					MockCheckNameExists: func(_ context.Context, _ string) (result autorest.Response, err error) {
+						return autorest.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+					},
+					MockGet: func(_ context.Context, _ string, _ string) (result documentdb.DatabaseAccount, err error) {
+						return documentdb.DatabaseAccount{
+							ID:       azure.ToStringPtr(id),
+							Kind:     kind,
+							Location: azure.ToStringPtr(location),
+							DatabaseAccountProperties: &documentdb.DatabaseAccountProperties{
+								ProvisioningState: azure.ToStringPtr(stateSucceeded),
+								DocumentEndpoint:  azure.ToStringPtr(endpoint),
+								ReadLocations: &[]documentdb.Location{
+									{
+										LocationName:     azure.ToStringPtr(location),
+										FailoverPriority: azure.ToInt32Ptr(0, azure.FieldRequired),
+										IsZoneRedundant:  azure.ToBoolPtr(true),
+									},
+								},
+							},
+						}, nil
+					},
+					MockListKeys: func(_ context.Context, _ string, _ string) (result documentdb.DatabaseAccountListKeysResult, err error) {
+						return documentdb.DatabaseAccountListKeysResult{}, errBoom
+					},
+				},
+			},
+			args: args{
+				mg: cosmosDBAccount(),
+			},
+			want: want{
+				mg:  cosmosDBAccount(withEndpoint(endpoint)),
+				err: errors.Wrap(errBoom, errListNoSQLAccountKeys),
+			},
+		},
 		"Success": {
 			e: &external{
 				kube: mockKube,
-				client: &MockClient{
+				client: &fake.MockClient{
 					MockCheckNameExists: func(_ context.Context, _ string) (result autorest.Response, err error) {
 						return autorest.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
 					},
@@ -212,6 +226,7 @@ func TestObserve(t *testing.T) {
 							Location: azure.ToStringPtr(location),
 							DatabaseAccountProperties: &documentdb.DatabaseAccountProperties{
 								ProvisioningState: azure.ToStringPtr(stateSucceeded),
+								DocumentEndpoint:  azure.ToStringPtr(endpoint),
 								ReadLocations: &[]documentdb.Location{
 									{
 										LocationName:     azure.ToStringPtr(location),
@@ -222,6 +237,9 @@ func TestObserve(t *testing.T) {
 							},
 						}, nil
 					},
+					MockListKeys: func(_ context.Context, _ string, _ string) (result documentdb.DatabaseAccountListKeysResult, err error) {
+						return documentdb.DatabaseAccountListKeysResult{PrimaryMasterKey: azure.ToStringPtr(primaryKey)}, nil
+					},
 				},
 			},
 			args: args{
@@ -231,8 +249,15 @@ func TestObserve(t *testing.T) {
 				o: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyResourceID:       []byte(id),
+						azure.ConnectionSecretKeyLocation:         []byte(location),
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(primaryKey),
+					},
 				},
 				mg: cosmosDBAccount(
+					withEndpoint(endpoint),
 					withConditions(xpv1.Available())),
 			},
 		},
@@ -288,7 +313,7 @@ func TestCreate(t *testing.T) {
 		},
 		"CreateOrUpdateError": {
 			e: &external{
-				client: &MockClient{
+				client: &fake.MockClient{
 					MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ documentdb.DatabaseAccountCreateUpdateParameters) (result documentdb.DatabaseAccountsCreateOrUpdateFuture, err error) {
 						return documentdb.DatabaseAccountsCreateOrUpdateFuture{}, errBoom
 					},
@@ -352,7 +377,7 @@ func TestDelete(t *testing.T) {
 		},
 		"DeleteError": {
 			e: &external{
-				client: &MockClient{
+				client: &fake.MockClient{
 					MockDelete: func(_ context.Context, _ string, _ string) (result documentdb.DatabaseAccountsDeleteFuture, err error) {
 						return documentdb.DatabaseAccountsDeleteFuture{}, errBoom
 					},
@@ -0,0 +1,187 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosmosdbsqlcontainer
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/database/cosmosdb"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotCosmosDBSQLContainer              = "managed resource is not a CosmosDBSQLContainer"
+	errCreateCosmosDBSQLContainer           = "cannot create CosmosDBSQLContainer"
+	errGetCosmosDBSQLContainer              = "cannot get CosmosDBSQLContainer"
+	errGetCosmosDBSQLContainerThroughput    = "cannot get CosmosDBSQLContainer throughput"
+	errUpdateCosmosDBSQLContainer           = "cannot update CosmosDBSQLContainer"
+	errUpdateCosmosDBSQLContainerThroughput = "cannot update CosmosDBSQLContainer throughput"
+	errDeleteCosmosDBSQLContainer           = "cannot delete CosmosDBSQLContainer"
+)
+
+// Setup adds a controller that reconciles CosmosDBSQLContainers.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.CosmosDBSQLContainerGroupKind)
+	o = azure.ControllerOptions(v1alpha3.CosmosDBSQLContainerGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient())}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&v1alpha3.CosmosDBSQLContainer{}).
+		Complete(azure.NewInstrumentedReconciler(v1alpha3.CosmosDBSQLContainerGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.CosmosDBSQLContainerGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1alpha3.CosmosDBSQLContainerGroupKind, bo, r, &connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := documentdb.NewDatabaseAccountsClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{client: cl}, nil
+}
+
+type external struct {
+	client cosmosdb.AccountClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	c, ok := mg.(*v1alpha3.CosmosDBSQLContainer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCosmosDBSQLContainer)
+	}
+
+	p := c.Spec.ForProvider
+	az, err := e.client.GetSQLContainer(ctx, p.ResourceGroupName, p.AccountName, p.DatabaseName, meta.GetExternalName(c))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetCosmosDBSQLContainer)
+	}
+
+	// A container that shares the throughput of its database has no
+	// throughput settings of its own.
+	t, err := e.client.GetSQLContainerThroughput(ctx, p.ResourceGroupName, p.AccountName, p.DatabaseName, meta.GetExternalName(c))
+	if resource.Ignore(azure.IsNotFound, err) != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetCosmosDBSQLContainerThroughput)
+	}
+	throughput := cosmosdb.Throughput(t)
+
+	current := c.Spec.ForProvider.DeepCopy()
+	cosmosdb.LateInitializeSQLContainer(&c.Spec.ForProvider, az, throughput)
+
+	if az.SQLContainerProperties != nil {
+		c.Status.AtProvider.ID = azure.ToString(az.SQLContainerProperties.ID)
+	}
+	c.Status.AtProvider.Throughput = throughput
+	c.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        cosmosdb.IsSQLContainerUpToDate(c.Spec.ForProvider, az, throughput),
+		ResourceLateInitialized: !cmp.Equal(current, &c.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, ok := mg.(*v1alpha3.CosmosDBSQLContainer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCosmosDBSQLContainer)
+	}
+
+	c.SetConditions(xpv1.Creating())
+	p := c.Spec.ForProvider
+	_, err := e.client.CreateUpdateSQLContainer(ctx, p.ResourceGroupName, p.AccountName, p.DatabaseName, meta.GetExternalName(c), cosmosdb.NewSQLContainerParameters(meta.GetExternalName(c), p))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateCosmosDBSQLContainer)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	c, ok := mg.(*v1alpha3.CosmosDBSQLContainer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCosmosDBSQLContainer)
+	}
+
+	// The throughput of an existing container can only be changed through
+	// its throughput settings, so it is omitted when the container is put.
+	p := c.Spec.ForProvider
+	cp := cosmosdb.NewSQLContainerParameters(meta.GetExternalName(c), p)
+	cp.Options = map[string]*string{}
+	if _, err := e.client.CreateUpdateSQLContainer(ctx, p.ResourceGroupName, p.AccountName, p.DatabaseName, meta.GetExternalName(c), cp); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCosmosDBSQLContainer)
+	}
+
+	if p.Throughput == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err := e.client.UpdateSQLContainerThroughput(ctx, p.ResourceGroupName, p.AccountName, p.DatabaseName, meta.GetExternalName(c), cosmosdb.NewThroughputUpdateParameters(*p.Throughput))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCosmosDBSQLContainerThroughput)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	c, ok := mg.(*v1alpha3.CosmosDBSQLContainer)
+	if !ok {
+		return errors.New(errNotCosmosDBSQLContainer)
+	}
+
+	c.SetConditions(xpv1.Deleting())
+	p := c.Spec.ForProvider
+	_, err := e.client.DeleteSQLContainer(ctx, p.ResourceGroupName, p.AccountName, p.DatabaseName, meta.GetExternalName(c))
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteCosmosDBSQLContainer)
+}
@@ -0,0 +1,448 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosmosdbsqlcontainer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/database/cosmosdb/fake"
+)
+
+const (
+	name              = "coolContainer"
+	databaseName      = "coolDB"
+	accountName       = "coolAccount"
+	resourceGroupName = "coolRG"
+	resourceID        = "a-very-cool-id"
+	partitionKeyPath  = "/tenantId"
+)
+
+var errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+
+type containerModifier func(*v1alpha3.CosmosDBSQLContainer)
+
+func withConditions(c ...xpv1.Condition) containerModifier {
+	return func(cr *v1alpha3.CosmosDBSQLContainer) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func withID(s string) containerModifier {
+	return func(cr *v1alpha3.CosmosDBSQLContainer) { cr.Status.AtProvider.ID = s }
+}
+
+func withPartitionKind(k string) containerModifier {
+	return func(cr *v1alpha3.CosmosDBSQLContainer) { cr.Spec.ForProvider.PartitionKey.Kind = azure.ToStringPtr(k) }
+}
+
+func withDefaultTTL(ttl int32) containerModifier {
+	return func(cr *v1alpha3.CosmosDBSQLContainer) { cr.Spec.ForProvider.DefaultTTL = &ttl }
+}
+
+func withThroughput(t int32) containerModifier {
+	return func(cr *v1alpha3.CosmosDBSQLContainer) { cr.Spec.ForProvider.Throughput = &t }
+}
+
+func withObservedThroughput(t int32) containerModifier {
+	return func(cr *v1alpha3.CosmosDBSQLContainer) { cr.Status.AtProvider.Throughput = &t }
+}
+
+func container(m ...containerModifier) *v1alpha3.CosmosDBSQLContainer {
+	cr := &v1alpha3.CosmosDBSQLContainer{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.CosmosDBSQLContainerSpec{
+			ForProvider: v1alpha3.CosmosDBSQLContainerParameters{
+				ResourceGroupName: resourceGroupName,
+				AccountName:       accountName,
+				DatabaseName:      databaseName,
+				PartitionKey: v1alpha3.CosmosDBSQLContainerPartitionKey{
+					Paths: []string{partitionKeyPath},
+				},
+			},
+		},
+	}
+
+	meta.SetExternalName(cr, name)
+
+	for _, f := range m {
+		f(cr)
+	}
+
+	return cr
+}
+
+func sqlContainer(ttl int32) documentdb.SQLContainer {
+	return documentdb.SQLContainer{
+		SQLContainerProperties: &documentdb.SQLContainerProperties{
+			ID: azure.ToStringPtr(resourceID),
+			PartitionKey: &documentdb.ContainerPartitionKey{
+				Paths: &[]string{partitionKeyPath},
+				Kind:  documentdb.PartitionKindHash,
+			},
+			DefaultTTL: &ttl,
+		},
+	}
+}
+
+func throughput(t int32) documentdb.Throughput {
+	return documentdb.Throughput{ThroughputProperties: &documentdb.ThroughputProperties{Throughput: &t}}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotCosmosDBSQLContainer": {
+			ec: &external{client: &fake.MockClient{}},
+			want: want{
+				err: errors.New(errNotCosmosDBSQLContainer),
+			},
+		},
+		"SuccessfulObserveNotExist": {
+			ec: &external{client: &fake.MockClient{
+				MockGetSQLContainer: func(_ context.Context, _, _, _, _ string) (documentdb.SQLContainer, error) {
+					return documentdb.SQLContainer{}, errNotFound
+				},
+			}},
+			mg: container(),
+			want: want{
+				mg: container(),
+			},
+		},
+		"SuccessfulObserveLateInitialized": {
+			ec: &external{client: &fake.MockClient{
+				MockGetSQLContainer: func(_ context.Context, _, _, _, _ string) (documentdb.SQLContainer, error) {
+					return sqlContainer(60), nil
+				},
+				MockGetSQLContainerThroughput: func(_ context.Context, _, _, _, _ string) (documentdb.Throughput, error) {
+					return throughput(400), nil
+				},
+			}},
+			mg: container(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+				mg: container(
+					withPartitionKind("Hash"),
+					withDefaultTTL(60),
+					withThroughput(400),
+					withObservedThroughput(400),
+					withID(resourceID),
+					withConditions(xpv1.Available()),
+				),
+			},
+		},
+		"SuccessfulObserveSharedThroughput": {
+			ec: &external{client: &fake.MockClient{
+				MockGetSQLContainer: func(_ context.Context, _, _, _, _ string) (documentdb.SQLContainer, error) {
+					return sqlContainer(60), nil
+				},
+				MockGetSQLContainerThroughput: func(_ context.Context, _, _, _, _ string) (documentdb.Throughput, error) {
+					return documentdb.Throughput{}, errNotFound
+				},
+			}},
+			mg: container(withPartitionKind("Hash"), withDefaultTTL(60)),
+			want: want{
+				o:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg: container(withPartitionKind("Hash"), withDefaultTTL(60), withID(resourceID), withConditions(xpv1.Available())),
+			},
+		},
+		"SuccessfulObserveNeedsUpdate": {
+			ec: &external{client: &fake.MockClient{
+				MockGetSQLContainer: func(_ context.Context, _, _, _, _ string) (documentdb.SQLContainer, error) {
+					return sqlContainer(60), nil
+				},
+				MockGetSQLContainerThroughput: func(_ context.Context, _, _, _, _ string) (documentdb.Throughput, error) {
+					return throughput(400), nil
+				},
+			}},
+			mg: container(withPartitionKind("Hash"), withDefaultTTL(120), withThroughput(400)),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true},
+				mg: container(
+					withPartitionKind("Hash"),
+					withDefaultTTL(120),
+					withThroughput(400),
+					withObservedThroughput(400),
+					withID(resourceID),
+					withConditions(xpv1.Available()),
+				),
+			},
+		},
+		"FailedObserve": {
+			ec: &external{client: &fake.MockClient{
+				MockGetSQLContainer: func(_ context.Context, _, _, _, _ string) (documentdb.SQLContainer, error) {
+					return documentdb.SQLContainer{}, errBoom
+				},
+			}},
+			mg: container(),
+			want: want{
+				mg:  container(),
+				err: errors.Wrap(errBoom, errGetCosmosDBSQLContainer),
+			},
+		},
+		"FailedObserveThroughput": {
+			ec: &external{client: &fake.MockClient{
+				MockGetSQLContainer: func(_ context.Context, _, _, _, _ string) (documentdb.SQLContainer, error) {
+					return sqlContainer(60), nil
+				},
+				MockGetSQLContainerThroughput: func(_ context.Context, _, _, _, _ string) (documentdb.Throughput, error) {
+					return documentdb.Throughput{}, errBoom
+				},
+			}},
+			mg: container(),
+			want: want{
+				mg:  container(),
+				err: errors.Wrap(errBoom, errGetCosmosDBSQLContainerThroughput),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotCosmosDBSQLContainer": {
+			ec: &external{client: &fake.MockClient{}},
+			want: want{
+				err: errors.New(errNotCosmosDBSQLContainer),
+			},
+		},
+		"ErrorCreate": {
+			ec: &external{client: &fake.MockClient{
+				MockCreateUpdateSQLContainer: func(_ context.Context, _, _, _, _ string, _ documentdb.SQLContainerCreateUpdateParameters) (documentdb.DatabaseAccountsCreateUpdateSQLContainerFuture, error) {
+					return documentdb.DatabaseAccountsCreateUpdateSQLContainerFuture{}, errBoom
+				},
+			}},
+			mg: container(),
+			want: want{
+				mg:  container(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateCosmosDBSQLContainer),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockClient{
+				MockCreateUpdateSQLContainer: func(_ context.Context, _, _, _, _ string, p documentdb.SQLContainerCreateUpdateParameters) (documentdb.DatabaseAccountsCreateUpdateSQLContainerFuture, error) {
+					if azure.ToString(p.Options["throughput"]) != "400" {
+						return documentdb.DatabaseAccountsCreateUpdateSQLContainerFuture{}, errors.New("unexpected throughput")
+					}
+					return documentdb.DatabaseAccountsCreateUpdateSQLContainerFuture{}, nil
+				},
+			}},
+			mg: container(withThroughput(400)),
+			want: want{
+				mg: container(withThroughput(400), withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	putContainer := func(_ context.Context, _, _, _, _ string, p documentdb.SQLContainerCreateUpdateParameters) (documentdb.DatabaseAccountsCreateUpdateSQLContainerFuture, error) {
+		if len(p.Options) != 0 {
+			return documentdb.DatabaseAccountsCreateUpdateSQLContainerFuture{}, errors.New("unexpected options")
+		}
+		return documentdb.DatabaseAccountsCreateUpdateSQLContainerFuture{}, nil
+	}
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotCosmosDBSQLContainer": {
+			ec:   &external{client: &fake.MockClient{}},
+			want: errors.New(errNotCosmosDBSQLContainer),
+		},
+		"ErrorUpdate": {
+			ec: &external{client: &fake.MockClient{
+				MockCreateUpdateSQLContainer: func(_ context.Context, _, _, _, _ string, _ documentdb.SQLContainerCreateUpdateParameters) (documentdb.DatabaseAccountsCreateUpdateSQLContainerFuture, error) {
+					return documentdb.DatabaseAccountsCreateUpdateSQLContainerFuture{}, errBoom
+				},
+			}},
+			mg:   container(withDefaultTTL(120)),
+			want: errors.Wrap(errBoom, errUpdateCosmosDBSQLContainer),
+		},
+		"ErrorUpdateThroughput": {
+			ec: &external{client: &fake.MockClient{
+				MockCreateUpdateSQLContainer: putContainer,
+				MockUpdateSQLContainerThroughput: func(_ context.Context, _, _, _, _ string, _ documentdb.ThroughputUpdateParameters) (documentdb.DatabaseAccountsUpdateSQLContainerThroughputFuture, error) {
+					return documentdb.DatabaseAccountsUpdateSQLContainerThroughputFuture{}, errBoom
+				},
+			}},
+			mg:   container(withThroughput(800)),
+			want: errors.Wrap(errBoom, errUpdateCosmosDBSQLContainerThroughput),
+		},
+		"SuccessfulSharedThroughput": {
+			ec: &external{client: &fake.MockClient{
+				MockCreateUpdateSQLContainer: putContainer,
+			}},
+			mg: container(withDefaultTTL(120)),
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockClient{
+				MockCreateUpdateSQLContainer: putContainer,
+				MockUpdateSQLContainerThroughput: func(_ context.Context, _, _, _, _ string, p documentdb.ThroughputUpdateParameters) (documentdb.DatabaseAccountsUpdateSQLContainerThroughputFuture, error) {
+					if *p.Resource.Throughput != 800 {
+						return documentdb.DatabaseAccountsUpdateSQLContainerThroughputFuture{}, errors.New("unexpected throughput")
+					}
+					return documentdb.DatabaseAccountsUpdateSQLContainerThroughputFuture{}, nil
+				},
+			}},
+			mg: container(withThroughput(800)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotCosmosDBSQLContainer": {
+			ec: &external{client: &fake.MockClient{}},
+			want: want{
+				err: errors.New(errNotCosmosDBSQLContainer),
+			},
+		},
+		"SuccessfulNotFound": {
+			ec: &external{client: &fake.MockClient{
+				MockDeleteSQLContainer: func(_ context.Context, _, _, _, _ string) (documentdb.DatabaseAccountsDeleteSQLContainerFuture, error) {
+					return documentdb.DatabaseAccountsDeleteSQLContainerFuture{}, errNotFound
+				},
+			}},
+			mg: container(),
+			want: want{
+				mg: container(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			ec: &external{client: &fake.MockClient{
+				MockDeleteSQLContainer: func(_ context.Context, _, _, _, _ string) (documentdb.DatabaseAccountsDeleteSQLContainerFuture, error) {
+					return documentdb.DatabaseAccountsDeleteSQLContainerFuture{}, errBoom
+				},
+			}},
+			mg: container(),
+			want: want{
+				mg:  container(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteCosmosDBSQLContainer),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
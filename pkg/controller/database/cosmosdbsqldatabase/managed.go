@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosmosdbsqldatabase
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/database/cosmosdb"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotCosmosDBSQLDatabase              = "managed resource is not a CosmosDBSQLDatabase"
+	errCreateCosmosDBSQLDatabase           = "cannot create CosmosDBSQLDatabase"
+	errGetCosmosDBSQLDatabase              = "cannot get CosmosDBSQLDatabase"
+	errGetCosmosDBSQLDatabaseThroughput    = "cannot get CosmosDBSQLDatabase throughput"
+	errUpdateCosmosDBSQLDatabaseThroughput = "cannot update CosmosDBSQLDatabase throughput"
+	errDeleteCosmosDBSQLDatabase           = "cannot delete CosmosDBSQLDatabase"
+)
+
+// Setup adds a controller that reconciles CosmosDBSQLDatabases.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.CosmosDBSQLDatabaseGroupKind)
+	o = azure.ControllerOptions(v1alpha3.CosmosDBSQLDatabaseGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient())}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&v1alpha3.CosmosDBSQLDatabase{}).
+		Complete(azure.NewInstrumentedReconciler(v1alpha3.CosmosDBSQLDatabaseGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.CosmosDBSQLDatabaseGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1alpha3.CosmosDBSQLDatabaseGroupKind, bo, r, &connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := documentdb.NewDatabaseAccountsClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{client: cl}, nil
+}
+
+type external struct {
+	client cosmosdb.AccountClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	d, ok := mg.(*v1alpha3.CosmosDBSQLDatabase)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCosmosDBSQLDatabase)
+	}
+
+	az, err := e.client.GetSQLDatabase(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.AccountName, meta.GetExternalName(d))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetCosmosDBSQLDatabase)
+	}
+
+	// A database without provisioned throughput has no throughput settings.
+	t, err := e.client.GetSQLDatabaseThroughput(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.AccountName, meta.GetExternalName(d))
+	if resource.Ignore(azure.IsNotFound, err) != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetCosmosDBSQLDatabaseThroughput)
+	}
+	throughput := cosmosdb.Throughput(t)
+
+	li := d.Spec.ForProvider.Throughput == nil && throughput != nil
+	if li {
+		d.Spec.ForProvider.Throughput = throughput
+	}
+
+	d.Status.AtProvider.ID = azure.ToString(az.ID)
+	d.Status.AtProvider.Throughput = throughput
+	d.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        cosmosdb.IsThroughputUpToDate(d.Spec.ForProvider.Throughput, throughput),
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	d, ok := mg.(*v1alpha3.CosmosDBSQLDatabase)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCosmosDBSQLDatabase)
+	}
+
+	d.SetConditions(xpv1.Creating())
+	p := cosmosdb.NewSQLDatabaseParameters(meta.GetExternalName(d), d.Spec.ForProvider)
+	_, err := e.client.CreateUpdateSQLDatabase(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.AccountName, meta.GetExternalName(d), p)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateCosmosDBSQLDatabase)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	d, ok := mg.(*v1alpha3.CosmosDBSQLDatabase)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCosmosDBSQLDatabase)
+	}
+
+	// Throughput is the only property of a database that can be updated.
+	if d.Spec.ForProvider.Throughput == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	p := cosmosdb.NewThroughputUpdateParameters(*d.Spec.ForProvider.Throughput)
+	_, err := e.client.UpdateSQLDatabaseThroughput(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.AccountName, meta.GetExternalName(d), p)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCosmosDBSQLDatabaseThroughput)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	d, ok := mg.(*v1alpha3.CosmosDBSQLDatabase)
+	if !ok {
+		return errors.New(errNotCosmosDBSQLDatabase)
+	}
+
+	d.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteSQLDatabase(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.AccountName, meta.GetExternalName(d))
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteCosmosDBSQLDatabase)
+}
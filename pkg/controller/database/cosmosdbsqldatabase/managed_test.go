@@ -0,0 +1,398 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosmosdbsqldatabase
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/database/cosmosdb/fake"
+)
+
+const (
+	name              = "coolDB"
+	accountName       = "coolAccount"
+	resourceGroupName = "coolRG"
+	resourceID        = "a-very-cool-id"
+)
+
+var errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+
+type databaseModifier func(*v1alpha3.CosmosDBSQLDatabase)
+
+func withConditions(c ...xpv1.Condition) databaseModifier {
+	return func(d *v1alpha3.CosmosDBSQLDatabase) { d.Status.ConditionedStatus.Conditions = c }
+}
+
+func withID(s string) databaseModifier {
+	return func(d *v1alpha3.CosmosDBSQLDatabase) { d.Status.AtProvider.ID = s }
+}
+
+func withThroughput(t int32) databaseModifier {
+	return func(d *v1alpha3.CosmosDBSQLDatabase) { d.Spec.ForProvider.Throughput = &t }
+}
+
+func withObservedThroughput(t int32) databaseModifier {
+	return func(d *v1alpha3.CosmosDBSQLDatabase) { d.Status.AtProvider.Throughput = &t }
+}
+
+func db(sm ...databaseModifier) *v1alpha3.CosmosDBSQLDatabase {
+	d := &v1alpha3.CosmosDBSQLDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.CosmosDBSQLDatabaseSpec{
+			ForProvider: v1alpha3.CosmosDBSQLDatabaseParameters{
+				AccountName:       accountName,
+				ResourceGroupName: resourceGroupName,
+			},
+		},
+	}
+
+	meta.SetExternalName(d, name)
+
+	for _, m := range sm {
+		m(d)
+	}
+
+	return d
+}
+
+func throughput(t int32) documentdb.Throughput {
+	return documentdb.Throughput{ThroughputProperties: &documentdb.ThroughputProperties{Throughput: &t}}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotCosmosDBSQLDatabase": {
+			ec: &external{client: &fake.MockClient{}},
+			want: want{
+				err: errors.New(errNotCosmosDBSQLDatabase),
+			},
+		},
+		"SuccessfulObserveNotExist": {
+			ec: &external{client: &fake.MockClient{
+				MockGetSQLDatabase: func(_ context.Context, _, _, _ string) (documentdb.SQLDatabase, error) {
+					return documentdb.SQLDatabase{}, errNotFound
+				},
+			}},
+			mg: db(),
+			want: want{
+				mg: db(),
+			},
+		},
+		"SuccessfulObserveNoThroughput": {
+			ec: &external{client: &fake.MockClient{
+				MockGetSQLDatabase: func(_ context.Context, _, _, _ string) (documentdb.SQLDatabase, error) {
+					return documentdb.SQLDatabase{ID: azure.ToStringPtr(resourceID)}, nil
+				},
+				MockGetSQLDatabaseThroughput: func(_ context.Context, _, _, _ string) (documentdb.Throughput, error) {
+					return documentdb.Throughput{}, errNotFound
+				},
+			}},
+			mg: db(),
+			want: want{
+				o:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg: db(withID(resourceID), withConditions(xpv1.Available())),
+			},
+		},
+		"SuccessfulObserveLateInitialized": {
+			ec: &external{client: &fake.MockClient{
+				MockGetSQLDatabase: func(_ context.Context, _, _, _ string) (documentdb.SQLDatabase, error) {
+					return documentdb.SQLDatabase{ID: azure.ToStringPtr(resourceID)}, nil
+				},
+				MockGetSQLDatabaseThroughput: func(_ context.Context, _, _, _ string) (documentdb.Throughput, error) {
+					return throughput(400), nil
+				},
+			}},
+			mg: db(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+				mg: db(withThroughput(400), withObservedThroughput(400), withID(resourceID), withConditions(xpv1.Available())),
+			},
+		},
+		"SuccessfulObserveNeedsUpdate": {
+			ec: &external{client: &fake.MockClient{
+				MockGetSQLDatabase: func(_ context.Context, _, _, _ string) (documentdb.SQLDatabase, error) {
+					return documentdb.SQLDatabase{ID: azure.ToStringPtr(resourceID)}, nil
+				},
+				MockGetSQLDatabaseThroughput: func(_ context.Context, _, _, _ string) (documentdb.Throughput, error) {
+					return throughput(400), nil
+				},
+			}},
+			mg: db(withThroughput(800)),
+			want: want{
+				o:  managed.ExternalObservation{ResourceExists: true},
+				mg: db(withThroughput(800), withObservedThroughput(400), withID(resourceID), withConditions(xpv1.Available())),
+			},
+		},
+		"FailedObserve": {
+			ec: &external{client: &fake.MockClient{
+				MockGetSQLDatabase: func(_ context.Context, _, _, _ string) (documentdb.SQLDatabase, error) {
+					return documentdb.SQLDatabase{}, errBoom
+				},
+			}},
+			mg: db(),
+			want: want{
+				mg:  db(),
+				err: errors.Wrap(errBoom, errGetCosmosDBSQLDatabase),
+			},
+		},
+		"FailedObserveThroughput": {
+			ec: &external{client: &fake.MockClient{
+				MockGetSQLDatabase: func(_ context.Context, _, _, _ string) (documentdb.SQLDatabase, error) {
+					return documentdb.SQLDatabase{}, nil
+				},
+				MockGetSQLDatabaseThroughput: func(_ context.Context, _, _, _ string) (documentdb.Throughput, error) {
+					return documentdb.Throughput{}, errBoom
+				},
+			}},
+			mg: db(),
+			want: want{
+				mg:  db(),
+				err: errors.Wrap(errBoom, errGetCosmosDBSQLDatabaseThroughput),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotCosmosDBSQLDatabase": {
+			ec: &external{client: &fake.MockClient{}},
+			want: want{
+				err: errors.New(errNotCosmosDBSQLDatabase),
+			},
+		},
+		"ErrorCreate": {
+			ec: &external{client: &fake.MockClient{
+				MockCreateUpdateSQLDatabase: func(_ context.Context, _, _, _ string, _ documentdb.SQLDatabaseCreateUpdateParameters) (documentdb.DatabaseAccountsCreateUpdateSQLDatabaseFuture, error) {
+					return documentdb.DatabaseAccountsCreateUpdateSQLDatabaseFuture{}, errBoom
+				},
+			}},
+			mg: db(),
+			want: want{
+				mg:  db(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateCosmosDBSQLDatabase),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockClient{
+				MockCreateUpdateSQLDatabase: func(_ context.Context, _, _, _ string, p documentdb.SQLDatabaseCreateUpdateParameters) (documentdb.DatabaseAccountsCreateUpdateSQLDatabaseFuture, error) {
+					if azure.ToString(p.Options["throughput"]) != "400" {
+						return documentdb.DatabaseAccountsCreateUpdateSQLDatabaseFuture{}, errors.New("unexpected throughput")
+					}
+					return documentdb.DatabaseAccountsCreateUpdateSQLDatabaseFuture{}, nil
+				},
+			}},
+			mg: db(withThroughput(400)),
+			want: want{
+				mg: db(withThroughput(400), withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotCosmosDBSQLDatabase": {
+			ec:   &external{client: &fake.MockClient{}},
+			want: errors.New(errNotCosmosDBSQLDatabase),
+		},
+		"NoThroughput": {
+			ec: &external{client: &fake.MockClient{}},
+			mg: db(),
+		},
+		"ErrorUpdate": {
+			ec: &external{client: &fake.MockClient{
+				MockUpdateSQLDatabaseThroughput: func(_ context.Context, _, _, _ string, _ documentdb.ThroughputUpdateParameters) (documentdb.DatabaseAccountsUpdateSQLDatabaseThroughputFuture, error) {
+					return documentdb.DatabaseAccountsUpdateSQLDatabaseThroughputFuture{}, errBoom
+				},
+			}},
+			mg:   db(withThroughput(800)),
+			want: errors.Wrap(errBoom, errUpdateCosmosDBSQLDatabaseThroughput),
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockClient{
+				MockUpdateSQLDatabaseThroughput: func(_ context.Context, _, _, _ string, p documentdb.ThroughputUpdateParameters) (documentdb.DatabaseAccountsUpdateSQLDatabaseThroughputFuture, error) {
+					if *p.Resource.Throughput != 800 {
+						return documentdb.DatabaseAccountsUpdateSQLDatabaseThroughputFuture{}, errors.New("unexpected throughput")
+					}
+					return documentdb.DatabaseAccountsUpdateSQLDatabaseThroughputFuture{}, nil
+				},
+			}},
+			mg: db(withThroughput(800)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotCosmosDBSQLDatabase": {
+			ec: &external{client: &fake.MockClient{}},
+			want: want{
+				err: errors.New(errNotCosmosDBSQLDatabase),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockClient{
+				MockDeleteSQLDatabase: func(_ context.Context, _, _, _ string) (documentdb.DatabaseAccountsDeleteSQLDatabaseFuture, error) {
+					return documentdb.DatabaseAccountsDeleteSQLDatabaseFuture{}, nil
+				},
+			}},
+			mg: db(),
+			want: want{
+				mg: db(withConditions(xpv1.Deleting())),
+			},
+		},
+		"SuccessfulNotFound": {
+			ec: &external{client: &fake.MockClient{
+				MockDeleteSQLDatabase: func(_ context.Context, _, _, _ string) (documentdb.DatabaseAccountsDeleteSQLDatabaseFuture, error) {
+					return documentdb.DatabaseAccountsDeleteSQLDatabaseFuture{}, errNotFound
+				},
+			}},
+			mg: db(),
+			want: want{
+				mg: db(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			ec: &external{client: &fake.MockClient{
+				MockDeleteSQLDatabase: func(_ context.Context, _, _, _ string) (documentdb.DatabaseAccountsDeleteSQLDatabaseFuture, error) {
+					return documentdb.DatabaseAccountsDeleteSQLDatabaseFuture{}, errBoom
+				},
+			}},
+			mg: db(),
+			want: want{
+				mg:  db(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteCosmosDBSQLDatabase),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqldatabase
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql/mysqlapi"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/database"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotMySQLDatabase    = "managed resource is not a MySQLDatabase"
+	errCreateMySQLDatabase = "cannot create MySQLDatabase"
+	errGetMySQLDatabase    = "cannot get MySQLDatabase"
+	errDeleteMySQLDatabase = "cannot delete MySQLDatabase"
+)
+
+// Setup adds a controller that reconciles MySQLDatabases.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.MySQLDatabaseGroupKind)
+	o = azure.ControllerOptions(v1alpha3.MySQLDatabaseGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient())}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&v1alpha3.MySQLDatabase{}).
+		Complete(azure.NewInstrumentedReconciler(v1alpha3.MySQLDatabaseGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.MySQLDatabaseGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1alpha3.MySQLDatabaseGroupKind, bo, r, &connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := mysql.NewDatabasesClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{client: cl}, nil
+}
+
+type external struct {
+	client mysqlapi.DatabasesClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	d, ok := mg.(*v1alpha3.MySQLDatabase)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMySQLDatabase)
+	}
+
+	az, err := e.client.Get(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.ServerName, meta.GetExternalName(d))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMySQLDatabase)
+	}
+
+	l := resource.NewLateInitializer()
+	if az.DatabaseProperties != nil {
+		d.Spec.ForProvider.Charset = l.LateInitializeStringPtr(d.Spec.ForProvider.Charset, az.Charset)
+		d.Spec.ForProvider.Collation = l.LateInitializeStringPtr(d.Spec.ForProvider.Collation, az.Collation)
+	}
+
+	d.Status.AtProvider.ID = azure.ToString(az.ID)
+	d.Status.AtProvider.Type = azure.ToString(az.Type)
+	d.SetConditions(xpv1.Available())
+
+	// The charset and collation of a database cannot be changed after it is
+	// created, so a database is always up to date.
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: l.IsChanged(),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	d, ok := mg.(*v1alpha3.MySQLDatabase)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMySQLDatabase)
+	}
+
+	d.SetConditions(xpv1.Creating())
+	p := database.NewMySQLDatabaseParameters(d)
+	_, err := e.client.CreateOrUpdate(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.ServerName, meta.GetExternalName(d), p)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateMySQLDatabase)
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	d, ok := mg.(*v1alpha3.MySQLDatabase)
+	if !ok {
+		return errors.New(errNotMySQLDatabase)
+	}
+
+	d.SetConditions(xpv1.Deleting())
+	_, err := e.client.Delete(ctx, d.Spec.ForProvider.ResourceGroupName, d.Spec.ForProvider.ServerName, meta.GetExternalName(d))
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteMySQLDatabase)
+}
@@ -19,6 +19,7 @@ package mysqlserver
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
 	"github.com/pkg/errors"
@@ -26,7 +27,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -35,9 +35,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
-	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 	"github.com/crossplane-contrib/provider-azure/pkg/clients/database"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
 	"github.com/crossplane-contrib/provider-azure/pkg/features"
 )
 
@@ -45,39 +45,60 @@ import (
 const (
 	errUpdateCR           = "cannot update MySQLServer custom resource"
 	errGenPassword        = "cannot generate admin password"
+	errGetPasswordSecret  = "cannot get admin password secret"
 	errNotMySQLServer     = "managed resource is not a MySQLServer"
 	errCreateMySQLServer  = "cannot create MySQLServer"
 	errUpdateMySQLServer  = "cannot update MySQLServer"
 	errGetMySQLServer     = "cannot get MySQLServer"
 	errDeleteMySQLServer  = "cannot delete MySQLServer"
 	errFetchLastOperation = "cannot fetch last operation"
+	errFmtChangePassword  = "cannot change administrator password: %s"
 )
 
 // Setup adds a controller that reconciles MySQLServers.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1beta1.MySQLServerGroupKind)
+	o = azure.ControllerOptions(v1beta1.MySQLServerGroupKind, o)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
-		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
 	}
 
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient()), azure.NewDefaultTagger(mgr.GetClient(), resourceTags)}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(bo.ForControllerRuntime(o)).
 		For(&v1beta1.MySQLServer{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(azure.NewInstrumentedReconciler(v1beta1.MySQLServerGroupKind, managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.MySQLServerGroupVersionKind),
-			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1beta1.MySQLServerGroupKind, bo, r, &connecter{client: mgr.GetClient(), record: r})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-			managed.WithConnectionPublishers(cps...)))
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+func resourceTags(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*v1beta1.MySQLServer)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Tags
 }
 
 type connecter struct {
 	client client.Client
+	record event.Recorder
 }
 
 func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -87,13 +108,14 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 	cl := mysql.NewServersClient(creds[azure.CredentialsKeySubscriptionID])
 	cl.Authorizer = auth
-	return &external{kube: c.client, client: database.NewMySQLServerClient(cl), newPasswordFn: password.Generate}, nil
+	return &external{kube: c.client, client: database.NewMySQLServerClient(cl), newPasswordFn: password.Generate, record: c.record}, nil
 }
 
 type external struct {
 	kube          client.Client
 	client        database.MySQLServerAPI
 	newPasswordFn func() (password string, err error)
+	record        event.Recorder
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -102,6 +124,13 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotMySQLServer)
 	}
 
+	// Servers are looked up by resource group and name, so changing either
+	// would orphan the existing server and create a new one.
+	if fields := azure.ImmutableFieldChanges(cr.Status.AtProvider.ID, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr)); len(fields) > 0 {
+		azure.SetImmutableFieldCondition(cr, fields)
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
 	server, err := e.client.GetServer(ctx, cr)
 	if azure.IsNotFound(err) {
 		if err := azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation); err != nil {
@@ -111,8 +140,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		// successfully and we cannot return `ResourceExists: false` during creation
 		// since this will cause `Create` to be called again and it's not idempotent.
 		// So, we check whether a creation operation in fact is in motion.
-		creating := cr.Status.AtProvider.LastOperation.Method == "PUT" &&
-			cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress
+		creating := azure.AsyncOperationInProgress(cr.Status.AtProvider.LastOperation, http.MethodPut)
 		return managed.ExternalObservation{ResourceExists: creating}, nil
 	}
 	if err != nil {
@@ -137,16 +165,69 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		cr.SetConditions(xpv1.Unavailable())
 	}
 
+	cd := connectionDetails(cr)
+	if azure.WantsConnectionStrings(cr.Spec.ForProvider.ConnectionSecretFormat) {
+		// Connection strings are composed from the password, which is only
+		// known when it is generated, so we read it back from the secret.
+		pw, err := azure.ConnectionSecretPassword(ctx, e.kube, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if pw != "" {
+			cd[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+		}
+	}
+
+	ref, pwChanged, err := e.referencedPassword(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// A changed password is only published once Azure has confirmed the
+	// update that changed it.
+	pending, err := azure.ConnectionSecretPendingPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	confirmed := pending != "" && cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusSucceeded
+	if confirmed {
+		cd[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pending)
+		pwChanged = ref != "" && ref != pending
+		e.passwordChanged(cr)
+	}
+
+	var fields []string
+	if azure.LocationChanged(cr.Spec.ForProvider.Location, azure.ToString(server.Location)) {
+		fields = append(fields, azure.FieldPathLocation)
+	}
+
+	// Storage is never shrunk, so a shrink does not require an update.
+	database.SetStorageShrinkCondition(cr, cr.Spec.ForProvider.StorageProfile, cr.Status.AtProvider)
+
+	cd = azure.FormatConnectionDetails(cr.Spec.ForProvider.ConnectionSecretFormat, azure.DatabaseEngineMySQL, cd)
+	if confirmed || (pending != "" && azure.AsyncOperationFailed(cr.Status.AtProvider.LastOperation)) {
+		cd[azure.ConnectionSecretKeyPendingPassword] = []byte{}
+	}
+
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: database.IsMySQLUpToDate(cr.Spec.ForProvider, server),
-		ConnectionDetails: managed.ConnectionDetails{
-			xpv1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.FullyQualifiedDomainName),
-			xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", cr.Spec.ForProvider.AdministratorLogin, meta.GetExternalName(cr))),
-		},
+		ResourceExists: true,
+		// An update could not apply immutable field changes, so we don't
+		// attempt one until they are reverted.
+		ResourceUpToDate:  azure.SetImmutableFieldCondition(cr, fields) || (database.IsMySQLUpToDate(cr.Spec.ForProvider, server) && !rotationDue(cr) && !pwChanged),
+		ConnectionDetails: cd,
 	}, nil
 }
 
+// connectionDetails returns the connection details of the supplied server,
+// except for its administrator password.
+func connectionDetails(cr *v1beta1.MySQLServer) managed.ConnectionDetails {
+	cd := azure.ResourceConnectionDetails(cr.Status.AtProvider.ID, cr.Spec.ForProvider.Location)
+	cd[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(cr.Status.AtProvider.FullyQualifiedDomainName)
+	cd[xpv1.ResourceCredentialsSecretPortKey] = []byte(v1beta1.MySQLServerPort)
+	cd[xpv1.ResourceCredentialsSecretUserKey] = []byte(fmt.Sprintf("%s@%s", cr.Spec.ForProvider.AdministratorLogin, meta.GetExternalName(cr)))
+	return cd
+}
+
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1beta1.MySQLServer)
 	if !ok {
@@ -154,18 +235,23 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	cr.SetConditions(xpv1.Creating())
-	pw, err := e.newPasswordFn()
+	pw, _, err := e.referencedPassword(ctx, cr)
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errGenPassword)
+		return managed.ExternalCreation{}, err
+	}
+	if pw == "" {
+		if pw, err = e.newPasswordFn(); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGenPassword)
+		}
 	}
 	if err := e.client.CreateServer(ctx, cr, pw); err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateMySQLServer)
 	}
 
 	return managed.ExternalCreation{
-			ConnectionDetails: managed.ConnectionDetails{
+			ConnectionDetails: azure.FormatConnectionDetails(cr.Spec.ForProvider.ConnectionSecretFormat, azure.DatabaseEngineMySQL, managed.ConnectionDetails{
 				xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
-			},
+			}),
 		}, errors.Wrap(
 			azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
 			errFetchLastOperation)
@@ -179,13 +265,60 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress {
 		return managed.ExternalUpdate{}, nil
 	}
-	if err := e.client.UpdateServer(ctx, cr); err != nil {
+	pw, pwChanged, err := e.referencedPassword(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	pending, err := azure.ConnectionSecretPendingPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	switch {
+	case pwChanged:
+	case pending != "":
+		// We don't know whether Azure applied the pending password, so we
+		// apply it again rather than generate another one.
+		pw = pending
+	case rotationDue(cr):
+		if pw, err = e.newPasswordFn(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGenPassword)
+		}
+	default:
+		pw = ""
+	}
+	if err := e.client.UpdateServer(ctx, cr, pw); err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMySQLServer)
 	}
 
-	return managed.ExternalUpdate{}, errors.Wrap(
-		azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
-		errFetchLastOperation)
+	err = azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation)
+	if pw == "" {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errFetchLastOperation)
+	}
+
+	op := cr.Status.AtProvider.LastOperation
+	switch {
+	case err == nil && op.Status == azure.AsyncOperationStatusSucceeded:
+		e.passwordChanged(cr)
+		cd := managed.ConnectionDetails{}
+		if azure.WantsConnectionStrings(cr.Spec.ForProvider.ConnectionSecretFormat) {
+			cd = connectionDetails(cr)
+		}
+		cd[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+		cd = azure.FormatConnectionDetails(cr.Spec.ForProvider.ConnectionSecretFormat, azure.DatabaseEngineMySQL, cd)
+		if pending != "" {
+			cd[azure.ConnectionSecretKeyPendingPassword] = []byte{}
+		}
+		return managed.ExternalUpdate{ConnectionDetails: cd}, nil
+	case err == nil && azure.AsyncOperationFailed(op):
+		return managed.ExternalUpdate{}, errors.Errorf(errFmtChangePassword, op.ErrorMessage)
+	}
+
+	// Azure may apply the new password even though the update has not
+	// completed, or its state could not be fetched, so we keep it until we
+	// observe the outcome of the update.
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{azure.ConnectionSecretKeyPendingPassword: []byte(pw)},
+	}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -205,3 +338,43 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		azure.FetchAsyncOperation(ctx, e.client.GetRESTClient(), &cr.Status.AtProvider.LastOperation),
 		errFetchLastOperation)
 }
+
+// referencedPassword returns the administrator password that the supplied
+// server references, if any, and whether it differs from the published one.
+// A referenced password that was never published is not considered changed.
+func (e *external) referencedPassword(ctx context.Context, cr *v1beta1.MySQLServer) (string, bool, error) {
+	ref := cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef
+	if ref == nil {
+		return "", false, nil
+	}
+	pw, err := azure.SecretKeyValue(ctx, e.kube, *ref)
+	if err != nil {
+		return "", false, errors.Wrap(err, errGetPasswordSecret)
+	}
+	published, err := azure.ConnectionSecretPassword(ctx, e.kube, cr)
+	if err != nil {
+		return "", false, err
+	}
+	return pw, published != "" && published != pw, nil
+}
+
+// passwordChanged records that Azure has confirmed the change of the
+// administrator password of the supplied server.
+func (e *external) passwordChanged(cr *v1beta1.MySQLServer) {
+	if cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef != nil {
+		e.record.Event(cr, event.Normal(azure.ReasonRotatedCredentials, "Changed the administrator password to the referenced one"))
+		return
+	}
+	azure.SetRotated(&cr.Status.CredentialRotation, "")
+	e.record.Event(cr, event.Normal(azure.ReasonRotatedCredentials, "Changed and published the administrator password"))
+}
+
+// rotationDue returns true if the generated administrator password of the
+// supplied server is due for rotation. Referenced passwords are never rotated.
+func rotationDue(cr *v1beta1.MySQLServer) bool {
+	if cr.Spec.ForProvider.AdministratorLoginPasswordSecretRef != nil {
+		return false
+	}
+	return azure.RotationDue(cr.Spec.ForProvider.CredentialRotation, cr.Status.CredentialRotation, cr.GetCreationTimestamp()) ||
+		azure.RotationRequested(cr, cr.Status.CredentialRotation)
+}
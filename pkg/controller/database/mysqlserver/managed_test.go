@@ -23,13 +23,18 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -37,60 +42,61 @@ import (
 
 	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
 	azurev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
-	"github.com/crossplane-contrib/provider-azure/pkg/clients/database"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/database/fake"
 )
 
 var (
 	_ managed.ExternalClient    = &external{}
 	_ managed.ExternalConnecter = &connecter{}
-	_ database.MySQLServerAPI   = &MockMySQLServerAPI{}
 )
 
-type MockMySQLServerAPI struct {
-	MockGetServer     func(ctx context.Context, s *v1beta1.MySQLServer) (mysql.Server, error)
-	MockCreateServer  func(ctx context.Context, s *v1beta1.MySQLServer, adminPassword string) error
-	MockUpdateServer  func(ctx context.Context, s *v1beta1.MySQLServer) error
-	MockDeleteServer  func(ctx context.Context, s *v1beta1.MySQLServer) error
-	MockGetRESTClient func() autorest.Sender
-}
-
-func (m *MockMySQLServerAPI) GetRESTClient() autorest.Sender {
-	return m.MockGetRESTClient()
-}
+type modifier func(*v1beta1.MySQLServer)
 
-func (m *MockMySQLServerAPI) GetServer(ctx context.Context, s *v1beta1.MySQLServer) (mysql.Server, error) {
-	return m.MockGetServer(ctx, s)
+func withExternalName(name string) modifier {
+	return func(p *v1beta1.MySQLServer) {
+		meta.SetExternalName(p, name)
+	}
 }
 
-func (m *MockMySQLServerAPI) CreateServer(ctx context.Context, s *v1beta1.MySQLServer, adminPassword string) error {
-	return m.MockCreateServer(ctx, s, adminPassword)
+func withAdminName(name string) modifier {
+	return func(p *v1beta1.MySQLServer) {
+		p.Spec.ForProvider.AdministratorLogin = name
+	}
 }
 
-func (m *MockMySQLServerAPI) UpdateServer(ctx context.Context, s *v1beta1.MySQLServer) error {
-	return m.MockUpdateServer(ctx, s)
+func withLastOperation(op azurev1alpha3.AsyncOperation) modifier {
+	return func(p *v1beta1.MySQLServer) {
+		p.Status.AtProvider.LastOperation = op
+	}
 }
 
-func (m *MockMySQLServerAPI) DeleteServer(ctx context.Context, s *v1beta1.MySQLServer) error {
-	return m.MockDeleteServer(ctx, s)
+func withID(id string) modifier {
+	return func(p *v1beta1.MySQLServer) {
+		p.Status.AtProvider.ID = id
+	}
 }
 
-type modifier func(*v1beta1.MySQLServer)
-
-func withExternalName(name string) modifier {
+func withCredentialRotation(period, age time.Duration) modifier {
 	return func(p *v1beta1.MySQLServer) {
-		meta.SetExternalName(p, name)
+		p.Spec.ForProvider.CredentialRotation = &azurev1alpha3.CredentialRotationPolicy{Period: metav1.Duration{Duration: period}}
+		p.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-age)))
 	}
 }
 
-func withAdminName(name string) modifier {
+func withPasswordSecretRef(key string) modifier {
 	return func(p *v1beta1.MySQLServer) {
-		p.Spec.ForProvider.AdministratorLogin = name
+		p.Spec.ForProvider.AdministratorLoginPasswordSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "ns", Name: "admin"},
+			Key:             key,
+		}
+		p.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: "ns", Name: "conn"})
 	}
 }
 
-func withLastOperation(op azurev1alpha3.AsyncOperation) modifier {
+func withConnectionSecretRef() modifier {
 	return func(p *v1beta1.MySQLServer) {
-		p.Status.AtProvider.LastOperation = op
+		p.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: "ns", Name: "conn"})
 	}
 }
 
@@ -107,11 +113,37 @@ const (
 	inProgressResponse = `{"status": "InProgress"}`
 )
 
+// withSecretData returns a MockGetFn that returns a secret with the supplied
+// data, regardless of the secret that is requested.
+func withSecretData(data map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.Secret).Data = data
+		return nil
+	}
+}
+
 func TestObserve(t *testing.T) {
 	errBoom := errors.New("boom")
 	name := "coolserver"
 	endpoint := "coolazure.example.prg"
 	admin := "cooladmin"
+	password := "verysecure"
+	available := &fake.MockMySQLServerAPI{
+		MockGetServer: func(_ context.Context, _ *v1beta1.MySQLServer) (mysql.Server, error) {
+			return mysql.Server{
+				Sku: &mysql.Sku{},
+				ServerProperties: &mysql.ServerProperties{
+					UserVisibleState:         mysql.ServerStateReady,
+					FullyQualifiedDomainName: &endpoint,
+					StorageProfile:           &mysql.StorageProfile{},
+				}}, nil
+		},
+		MockGetRESTClient: func() autorest.Sender {
+			return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+				return nil, nil
+			})
+		},
+	}
 
 	type args struct {
 		ctx context.Context
@@ -138,7 +170,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ErrGetServer": {
 			e: &external{
-				client: &MockMySQLServerAPI{
+				client: &fake.MockMySQLServerAPI{
 					MockGetServer: func(_ context.Context, _ *v1beta1.MySQLServer) (mysql.Server, error) {
 						return mysql.Server{}, errBoom
 					},
@@ -154,7 +186,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ServerCreating": {
 			e: &external{
-				client: &MockMySQLServerAPI{
+				client: &fake.MockMySQLServerAPI{
 					MockGetServer: func(_ context.Context, _ *v1beta1.MySQLServer) (mysql.Server, error) {
 						return mysql.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
 					},
@@ -182,7 +214,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ServerNotFound": {
 			e: &external{
-				client: &MockMySQLServerAPI{
+				client: &fake.MockMySQLServerAPI{
 					MockGetServer: func(_ context.Context, _ *v1beta1.MySQLServer) (mysql.Server, error) {
 						return mysql.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
 					},
@@ -201,12 +233,28 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ImmutableFieldChanged": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg: mysqlserver(
+					withExternalName(name),
+					withID("/subscriptions/coolsub/resourceGroups/coolgroup/providers/Microsoft.DBforMySQL/servers/oldserver"),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"ServerAvailable": {
 			e: &external{
 				kube: &test.MockClient{
 					MockUpdate: test.NewMockUpdateFn(nil),
 				},
-				client: &MockMySQLServerAPI{
+				client: &fake.MockMySQLServerAPI{
 					MockGetServer: func(_ context.Context, _ *v1beta1.MySQLServer) (mysql.Server, error) {
 						return mysql.Server{
 							Sku: &mysql.Sku{},
@@ -236,11 +284,112 @@ func TestObserve(t *testing.T) {
 					ResourceUpToDate: true,
 					ConnectionDetails: managed.ConnectionDetails{
 						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1beta1.MySQLServerPort),
 						xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", admin, name)),
 					},
 				},
 			},
 		},
+		"PendingPasswordInProgress": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecretData(map[string][]byte{
+						azure.ConnectionSecretKeyPendingPassword: []byte(password),
+					}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: available,
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: mysqlserver(
+					withExternalName(name),
+					withAdminName(admin),
+					withConnectionSecretRef(),
+					withCredentialRotation(time.Hour, 2*time.Hour),
+					withLastOperation(azurev1alpha3.AsyncOperation{Method: http.MethodPatch, Status: azure.AsyncOperationStatusInProgress}),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1beta1.MySQLServerPort),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", admin, name)),
+					},
+				},
+			},
+		},
+		"PendingPasswordConfirmed": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecretData(map[string][]byte{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte("old"),
+						azure.ConnectionSecretKeyPendingPassword:  []byte(password),
+					}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: available,
+				record: event.NewNopRecorder(),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: mysqlserver(
+					withExternalName(name),
+					withAdminName(admin),
+					withConnectionSecretRef(),
+					withCredentialRotation(time.Hour, 2*time.Hour),
+					withLastOperation(azurev1alpha3.AsyncOperation{Method: http.MethodPatch, Status: azure.AsyncOperationStatusSucceeded}),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1beta1.MySQLServerPort),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", admin, name)),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
+						azure.ConnectionSecretKeyPendingPassword:  {},
+					},
+				},
+			},
+		},
+		"PendingPasswordFailed": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecretData(map[string][]byte{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte("old"),
+						azure.ConnectionSecretKeyPendingPassword:  []byte(password),
+					}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: available,
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: mysqlserver(
+					withExternalName(name),
+					withAdminName(admin),
+					withConnectionSecretRef(),
+					withCredentialRotation(time.Hour, 2*time.Hour),
+					withLastOperation(azurev1alpha3.AsyncOperation{Method: http.MethodPatch, Status: azure.AsyncOperationStatusFailed}),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1beta1.MySQLServerPort),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", admin, name)),
+						azure.ConnectionSecretKeyPendingPassword:  {},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -298,7 +447,7 @@ func TestCreate(t *testing.T) {
 		},
 		"ErrCreateServer": {
 			e: &external{
-				client: &MockMySQLServerAPI{
+				client: &fake.MockMySQLServerAPI{
 					MockCreateServer: func(_ context.Context, _ *v1beta1.MySQLServer, _ string) error { return errBoom },
 				},
 				newPasswordFn: func() (string, error) { return password, nil },
@@ -313,7 +462,7 @@ func TestCreate(t *testing.T) {
 		},
 		"Successful": {
 			e: &external{
-				client: &MockMySQLServerAPI{
+				client: &fake.MockMySQLServerAPI{
 					MockCreateServer: func(_ context.Context, _ *v1beta1.MySQLServer, _ string) error { return nil },
 					MockGetRESTClient: func() autorest.Sender {
 						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
@@ -333,6 +482,46 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		"ErrGetPasswordSecret": {
+			e: &external{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mysqlserver(withPasswordSecretRef("pw")),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get secret"), errGetPasswordSecret),
+			},
+		},
+		"ReferencedPassword": {
+			e: &external{
+				kube: &test.MockClient{MockGet: withSecretData(map[string][]byte{"pw": []byte(password)})},
+				client: &fake.MockMySQLServerAPI{
+					MockCreateServer: func(_ context.Context, _ *v1beta1.MySQLServer, pw string) error {
+						if pw != password {
+							return errors.New("did not use the referenced password")
+						}
+						return nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+				newPasswordFn: func() (string, error) { return "", errBoom },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mysqlserver(withPasswordSecretRef("pw")),
+			},
+			want: want{
+				ec: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -349,6 +538,238 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+	password := "verysecure"
+	sender := func() autorest.Sender {
+		return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+			return nil, nil
+		})
+	}
+	// updateServer returns a MockUpdateServer that expects the supplied
+	// password and reports an update with the supplied status.
+	updateServer := func(want, status string) func(context.Context, *v1beta1.MySQLServer, string) error {
+		return func(_ context.Context, cr *v1beta1.MySQLServer, pw string) error {
+			if pw != want {
+				return errors.Errorf("want password %q, got %q", want, pw)
+			}
+			cr.Status.AtProvider.LastOperation = azurev1alpha3.AsyncOperation{Method: http.MethodPatch, Status: status, ErrorMessage: "boom"}
+			return nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		eu      managed.ExternalUpdate
+		rotated bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"ErrNotAMySQLServer": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotMySQLServer),
+			},
+		},
+		"ErrUpdateServer": {
+			e: &external{
+				client: &fake.MockMySQLServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1beta1.MySQLServer, _ string) error { return errBoom },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mysqlserver(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateMySQLServer),
+			},
+		},
+		"RotationNotDue": {
+			e: &external{
+				client: &fake.MockMySQLServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1beta1.MySQLServer, pw string) error {
+						if pw != "" {
+							return errors.New("changed the administrator password")
+						}
+						return nil
+					},
+					MockGetRESTClient: sender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mysqlserver(withCredentialRotation(time.Hour, time.Minute)),
+			},
+		},
+		"ErrGeneratePassword": {
+			e: &external{
+				newPasswordFn: func() (string, error) { return "", errBoom },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mysqlserver(withCredentialRotation(time.Hour, 2*time.Hour)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGenPassword),
+			},
+		},
+		"RotatePassword": {
+			e: &external{
+				client: &fake.MockMySQLServerAPI{
+					MockUpdateServer:  updateServer(password, azure.AsyncOperationStatusSucceeded),
+					MockGetRESTClient: sender,
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+				record:        event.NewNopRecorder(),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mysqlserver(withCredentialRotation(time.Hour, 2*time.Hour)),
+			},
+			want: want{
+				eu: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+				},
+				rotated: true,
+			},
+		},
+		"RotatePasswordInProgress": {
+			e: &external{
+				client: &fake.MockMySQLServerAPI{
+					MockUpdateServer:  updateServer(password, azure.AsyncOperationStatusInProgress),
+					MockGetRESTClient: sender,
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mysqlserver(withCredentialRotation(time.Hour, 2*time.Hour)),
+			},
+			want: want{
+				eu: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{azure.ConnectionSecretKeyPendingPassword: []byte(password)},
+				},
+			},
+		},
+		"RotatePasswordFailed": {
+			e: &external{
+				client: &fake.MockMySQLServerAPI{
+					MockUpdateServer:  updateServer(password, azure.AsyncOperationStatusFailed),
+					MockGetRESTClient: sender,
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mysqlserver(withCredentialRotation(time.Hour, 2*time.Hour)),
+			},
+			want: want{
+				err: errors.Errorf(errFmtChangePassword, "boom"),
+			},
+		},
+		"ApplyPendingPassword": {
+			e: &external{
+				kube: &test.MockClient{MockGet: withSecretData(map[string][]byte{
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte("old"),
+					azure.ConnectionSecretKeyPendingPassword:  []byte(password),
+				})},
+				client: &fake.MockMySQLServerAPI{
+					MockUpdateServer:  updateServer(password, azure.AsyncOperationStatusSucceeded),
+					MockGetRESTClient: sender,
+				},
+				newPasswordFn: func() (string, error) { return "", errors.New("generated another password") },
+				record:        event.NewNopRecorder(),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mysqlserver(withConnectionSecretRef(), withCredentialRotation(time.Hour, 2*time.Hour)),
+			},
+			want: want{
+				eu: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
+						azure.ConnectionSecretKeyPendingPassword:  {},
+					},
+				},
+				rotated: true,
+			},
+		},
+		"ApplyReferencedPassword": {
+			e: &external{
+				kube: &test.MockClient{MockGet: withSecretData(map[string][]byte{
+					"pw": []byte(password),
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte("old"),
+				})},
+				client: &fake.MockMySQLServerAPI{
+					MockUpdateServer:  updateServer(password, azure.AsyncOperationStatusSucceeded),
+					MockGetRESTClient: sender,
+				},
+				record: event.NewNopRecorder(),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mysqlserver(withPasswordSecretRef("pw"), withCredentialRotation(time.Hour, 2*time.Hour)),
+			},
+			want: want{
+				eu: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+				},
+			},
+		},
+		"ReferencedPasswordUnchanged": {
+			e: &external{
+				kube: &test.MockClient{MockGet: withSecretData(map[string][]byte{
+					"pw": []byte(password),
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
+				})},
+				client: &fake.MockMySQLServerAPI{
+					MockUpdateServer: func(_ context.Context, _ *v1beta1.MySQLServer, pw string) error {
+						if pw != "" {
+							return errors.New("changed the administrator password")
+						}
+						return nil
+					},
+					MockGetRESTClient: sender,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mysqlserver(withPasswordSecretRef("pw"), withCredentialRotation(time.Hour, 2*time.Hour)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eu, err := tc.e.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eu, eu); diff != "" {
+				t.Errorf("tc.e.Update(...): -want, +got:\n%s", diff)
+			}
+			if cr, ok := tc.args.mg.(*v1beta1.MySQLServer); ok {
+				if diff := cmp.Diff(tc.want.rotated, cr.Status.CredentialRotation.LastRotationTime != nil); diff != "" {
+					t.Errorf("tc.e.Update(...): -want rotated, +got rotated:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -371,7 +792,7 @@ func TestDelete(t *testing.T) {
 		},
 		"ErrDeleteServer": {
 			e: &external{
-				client: &MockMySQLServerAPI{
+				client: &fake.MockMySQLServerAPI{
 					MockDeleteServer: func(_ context.Context, _ *v1beta1.MySQLServer) error { return errBoom },
 				},
 			},
@@ -383,7 +804,7 @@ func TestDelete(t *testing.T) {
 		},
 		"Successful": {
 			e: &external{
-				client: &MockMySQLServerAPI{
+				client: &fake.MockMySQLServerAPI{
 					MockDeleteServer: func(_ context.Context, _ *v1beta1.MySQLServer) error { return nil },
 					MockGetRESTClient: func() autorest.Sender {
 						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
@@ -26,7 +26,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -34,9 +33,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
-	"github.com/crossplane-contrib/provider-azure/apis/v1alpha1"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 	"github.com/crossplane-contrib/provider-azure/pkg/clients/database/configuration"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
 	"github.com/crossplane-contrib/provider-azure/pkg/features"
 )
 
@@ -56,25 +55,34 @@ const (
 // Setup adds a controller that reconciles MySQLInstances.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1beta1.MySQLServerConfigurationGroupKind)
+	o = azure.ControllerOptions(v1beta1.MySQLServerConfigurationGroupKind, o)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
-		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
 	}
 
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewDefaultProviderConfig(mgr.GetClient())}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(bo.ForControllerRuntime(o)).
 		For(&v1beta1.MySQLServerConfiguration{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(azure.NewInstrumentedReconciler(v1beta1.MySQLServerConfigurationGroupKind, managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.MySQLServerConfigurationGroupVersionKind),
-			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithExternalConnecter(azure.NewConnecter(v1beta1.MySQLServerConfigurationGroupKind, bo, r, &connecter{client: mgr.GetClient()})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithInitializers(is...),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-			managed.WithConnectionPublishers(cps...)))
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
 }
 
 type connecter struct {
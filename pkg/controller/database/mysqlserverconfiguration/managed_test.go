@@ -33,6 +33,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
 	azurev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/database/configuration/fake"
 )
 
 const (
@@ -40,29 +41,6 @@ const (
 	subscriptID = "subscription-id"
 )
 
-type MockMySQLConfigurationAPI struct {
-	MockGet            func(ctx context.Context, s *v1beta1.MySQLServerConfiguration) (mysql.Configuration, error)
-	MockCreateOrUpdate func(ctx context.Context, s *v1beta1.MySQLServerConfiguration) error
-	MockDelete         func(ctx context.Context, s *v1beta1.MySQLServerConfiguration) error
-	MockGetRESTClient  func() autorest.Sender
-}
-
-func (m *MockMySQLConfigurationAPI) Get(ctx context.Context, s *v1beta1.MySQLServerConfiguration) (mysql.Configuration, error) {
-	return m.MockGet(ctx, s)
-}
-
-func (m *MockMySQLConfigurationAPI) CreateOrUpdate(ctx context.Context, s *v1beta1.MySQLServerConfiguration) error {
-	return m.MockCreateOrUpdate(ctx, s)
-}
-
-func (m *MockMySQLConfigurationAPI) Delete(ctx context.Context, s *v1beta1.MySQLServerConfiguration) error {
-	return m.MockDelete(ctx, s)
-}
-
-func (m *MockMySQLConfigurationAPI) GetRESTClient() autorest.Sender {
-	return m.MockGetRESTClient()
-}
-
 type modifier func(configuration *v1beta1.MySQLServerConfiguration)
 
 func withLastOperation(op azurev1alpha3.AsyncOperation) modifier {
@@ -115,7 +93,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ErrGetServer": {
 			e: &external{
-				client: &MockMySQLConfigurationAPI{
+				client: &fake.MockMySQLConfigurationAPI{
 					MockGet: func(_ context.Context, _ *v1beta1.MySQLServerConfiguration) (mysql.Configuration, error) {
 						return mysql.Configuration{}, errBoom
 					},
@@ -131,7 +109,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ServerCreating": {
 			e: &external{
-				client: &MockMySQLConfigurationAPI{
+				client: &fake.MockMySQLConfigurationAPI{
 					MockGet: func(_ context.Context, _ *v1beta1.MySQLServerConfiguration) (mysql.Configuration, error) {
 						return mysql.Configuration{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
 					},
@@ -150,7 +128,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ServerNotFound": {
 			e: &external{
-				client: &MockMySQLConfigurationAPI{
+				client: &fake.MockMySQLConfigurationAPI{
 					MockGet: func(_ context.Context, _ *v1beta1.MySQLServerConfiguration) (mysql.Configuration, error) {
 						return mysql.Configuration{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
 					},
@@ -175,7 +153,7 @@ func TestObserve(t *testing.T) {
 				kube: &test.MockClient{
 					MockUpdate: test.NewMockUpdateFn(nil),
 				},
-				client: &MockMySQLConfigurationAPI{
+				client: &fake.MockMySQLConfigurationAPI{
 					MockGet: func(_ context.Context, _ *v1beta1.MySQLServerConfiguration) (mysql.Configuration, error) {
 						return mysql.Configuration{
 							ConfigurationProperties: &mysql.ConfigurationProperties{},
@@ -245,7 +223,7 @@ func TestCreate(t *testing.T) {
 		},
 		"ErrCreateServer": {
 			e: &external{
-				client: &MockMySQLConfigurationAPI{
+				client: &fake.MockMySQLConfigurationAPI{
 					MockCreateOrUpdate: func(_ context.Context, _ *v1beta1.MySQLServerConfiguration) error { return errBoom },
 				},
 				subscriptionID: subscriptID,
@@ -260,7 +238,7 @@ func TestCreate(t *testing.T) {
 		},
 		"Successful": {
 			e: &external{
-				client: &MockMySQLConfigurationAPI{
+				client: &fake.MockMySQLConfigurationAPI{
 					MockCreateOrUpdate: func(_ context.Context, _ *v1beta1.MySQLServerConfiguration) error { return nil },
 					MockGetRESTClient: func() autorest.Sender {
 						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
@@ -318,7 +296,7 @@ func TestDelete(t *testing.T) {
 		},
 		"ErrDeleteServer": {
 			e: &external{
-				client: &MockMySQLConfigurationAPI{
+				client: &fake.MockMySQLConfigurationAPI{
 					MockDelete: func(_ context.Context, _ *v1beta1.MySQLServerConfiguration) error { return errBoom },
 				},
 			},
@@ -330,7 +308,7 @@ func TestDelete(t *testing.T) {
 		},
 		"Successful": {
 			e: &external{
-				client: &MockMySQLConfigurationAPI{
+				client: &fake.MockMySQLConfigurationAPI{
 					MockDelete: func(_ context.Context, _ *v1beta1.MySQLServerConfiguration) error { return nil },
 					MockGetRESTClient: func() autorest.Sender {
 						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
@@ -398,7 +376,7 @@ func TestUpdate(t *testing.T) {
 		},
 		"ErrUpdateServer": {
 			e: &external{
-				client: &MockMySQLConfigurationAPI{
+				client: &fake.MockMySQLConfigurationAPI{
 					MockCreateOrUpdate: func(_ context.Context, _ *v1beta1.MySQLServerConfiguration) error { return errBoom },
 				},
 				subscriptionID: subscriptID,
@@ -413,7 +391,7 @@ func TestUpdate(t *testing.T) {
 		},
 		"Successful": {
 			e: &external{
-				client: &MockMySQLConfigurationAPI{
+				client: &fake.MockMySQLConfigurationAPI{
 					MockCreateOrUpdate: func(_ context.Context, _ *v1beta1.MySQLServerConfiguration) error { return nil },
 					MockGetRESTClient: func() autorest.Sender {
 						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
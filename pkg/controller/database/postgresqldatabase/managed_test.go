@@ -0,0 +1,315 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresqldatabase
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/fake"
+)
+
+const (
+	name              = "coolDB"
+	serverName        = "coolSrv"
+	resourceGroupName = "coolRG"
+	resourceID        = "a-very-cool-id"
+	resourceType      = "cooltype"
+	charset           = "UTF8"
+	collation         = "English_United States.1252"
+)
+
+type databaseModifier func(*v1alpha3.PostgreSQLDatabase)
+
+func withConditions(c ...xpv1.Condition) databaseModifier {
+	return func(d *v1alpha3.PostgreSQLDatabase) { d.Status.ConditionedStatus.Conditions = c }
+}
+
+func withType(s string) databaseModifier {
+	return func(d *v1alpha3.PostgreSQLDatabase) { d.Status.AtProvider.Type = s }
+}
+
+func withID(s string) databaseModifier {
+	return func(d *v1alpha3.PostgreSQLDatabase) { d.Status.AtProvider.ID = s }
+}
+
+func withCharset(s string) databaseModifier {
+	return func(d *v1alpha3.PostgreSQLDatabase) { d.Spec.ForProvider.Charset = azure.ToStringPtr(s) }
+}
+
+func withCollation(s string) databaseModifier {
+	return func(d *v1alpha3.PostgreSQLDatabase) { d.Spec.ForProvider.Collation = azure.ToStringPtr(s) }
+}
+
+func db(sm ...databaseModifier) *v1alpha3.PostgreSQLDatabase {
+	d := &v1alpha3.PostgreSQLDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.SQLDatabaseSpec{
+			ForProvider: v1alpha3.SQLDatabaseParameters{
+				ServerName:        serverName,
+				ResourceGroupName: resourceGroupName,
+			},
+		},
+	}
+
+	meta.SetExternalName(d, name)
+
+	for _, m := range sm {
+		m(d)
+	}
+
+	return d
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotPostgreSQLDatabase": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotPostgreSQLDatabase),
+			},
+		},
+		"SuccessfulObserveNotExist": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresql.Database, error) {
+					return postgresql.Database{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: db(),
+			want: want{
+				mg: db(),
+			},
+		},
+		"SuccessfulObserveLateInitialized": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresql.Database, error) {
+					return postgresql.Database{
+						ID:   azure.ToStringPtr(resourceID),
+						Type: azure.ToStringPtr(resourceType),
+						DatabaseProperties: &postgresql.DatabaseProperties{
+							Charset:   azure.ToStringPtr(charset),
+							Collation: azure.ToStringPtr(collation),
+						},
+					}, nil
+				},
+			}},
+			mg: db(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+				mg: db(
+					withCharset(charset),
+					withCollation(collation),
+					withConditions(xpv1.Available()),
+					withType(resourceType),
+					withID(resourceID),
+				),
+			},
+		},
+		"FailedObserve": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (postgresql.Database, error) {
+					return postgresql.Database{}, errBoom
+				},
+			}},
+			mg: db(),
+			want: want{
+				mg:  db(),
+				err: errors.Wrap(errBoom, errGetPostgreSQLDatabase),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotPostgreSQLDatabase": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotPostgreSQLDatabase),
+			},
+		},
+		"ErrorCreate": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ postgresql.Database) (postgresql.DatabasesCreateOrUpdateFuture, error) {
+					return postgresql.DatabasesCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			mg: db(),
+			want: want{
+				mg:  db(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreatePostgreSQLDatabase),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, p postgresql.Database) (postgresql.DatabasesCreateOrUpdateFuture, error) {
+					if azure.ToString(p.Charset) != charset || azure.ToString(p.Collation) != collation {
+						return postgresql.DatabasesCreateOrUpdateFuture{}, errors.New("unexpected charset or collation")
+					}
+					return postgresql.DatabasesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			mg: db(withCharset(charset), withCollation(collation)),
+			want: want{
+				mg: db(withCharset(charset), withCollation(collation), withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotPostgreSQLDatabase": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotPostgreSQLDatabase),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (postgresql.DatabasesDeleteFuture, error) {
+					return postgresql.DatabasesDeleteFuture{}, nil
+				},
+			}},
+			mg: db(),
+			want: want{
+				mg: db(withConditions(xpv1.Deleting())),
+			},
+		},
+		"SuccessfulNotFound": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (postgresql.DatabasesDeleteFuture, error) {
+					return postgresql.DatabasesDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: db(),
+			want: want{
+				mg: db(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			ec: &external{client: &fake.MockPostgreSQLDatabasesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (postgresql.DatabasesDeleteFuture, error) {
+					return postgresql.DatabasesDeleteFuture{}, errBoom
+				},
+			}},
+			mg: db(),
+			want: want{
+				mg:  db(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeletePostgreSQLDatabase),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
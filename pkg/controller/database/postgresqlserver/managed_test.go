@@ -23,13 +23,18 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -37,43 +42,15 @@ import (
 
 	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
 	azurev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
-	"github.com/crossplane-contrib/provider-azure/pkg/clients/database"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/database/fake"
 )
 
 var (
-	_ managed.ExternalClient       = &external{}
-	_ managed.ExternalConnecter    = &connecter{}
-	_ database.PostgreSQLServerAPI = &MockPostgreSQLServerAPI{}
+	_ managed.ExternalClient    = &external{}
+	_ managed.ExternalConnecter = &connecter{}
 )
 
-type MockPostgreSQLServerAPI struct {
-	MockGetServer     func(ctx context.Context, s *v1beta1.PostgreSQLServer) (postgresql.Server, error)
-	MockCreateServer  func(ctx context.Context, s *v1beta1.PostgreSQLServer, adminPassword string) error
-	MockDeleteServer  func(ctx context.Context, s *v1beta1.PostgreSQLServer) error
-	MockUpdateServer  func(ctx context.Context, s *v1beta1.PostgreSQLServer) error
-	MockGetRESTClient func() autorest.Sender
-}
-
-func (m *MockPostgreSQLServerAPI) GetRESTClient() autorest.Sender {
-	return m.MockGetRESTClient()
-}
-
-func (m *MockPostgreSQLServerAPI) GetServer(ctx context.Context, s *v1beta1.PostgreSQLServer) (postgresql.Server, error) {
-	return m.MockGetServer(ctx, s)
-}
-
-func (m *MockPostgreSQLServerAPI) CreateServer(ctx context.Context, s *v1beta1.PostgreSQLServer, adminPassword string) error {
-	return m.MockCreateServer(ctx, s, adminPassword)
-}
-
-func (m *MockPostgreSQLServerAPI) UpdateServer(ctx context.Context, s *v1beta1.PostgreSQLServer) error {
-	return m.MockUpdateServer(ctx, s)
-}
-
-func (m *MockPostgreSQLServerAPI) DeleteServer(ctx context.Context, s *v1beta1.PostgreSQLServer) error {
-	return m.MockDeleteServer(ctx, s)
-}
-
 type modifier func(*v1beta1.PostgreSQLServer)
 
 func withExternalName(name string) modifier {
@@ -94,6 +71,19 @@ func withLastOperation(op azurev1alpha3.AsyncOperation) modifier {
 	}
 }
 
+func withCredentialRotation(period, age time.Duration) modifier {
+	return func(p *v1beta1.PostgreSQLServer) {
+		p.Spec.ForProvider.CredentialRotation = &azurev1alpha3.CredentialRotationPolicy{Period: metav1.Duration{Duration: period}}
+		p.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-age)))
+	}
+}
+
+func withConnectionSecretRef() modifier {
+	return func(p *v1beta1.PostgreSQLServer) {
+		p.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: "ns", Name: "conn"})
+	}
+}
+
 func postgresqlserver(m ...modifier) *v1beta1.PostgreSQLServer {
 	p := &v1beta1.PostgreSQLServer{}
 
@@ -107,6 +97,15 @@ const (
 	inProgressResponse = `{"status": "InProgress"}`
 )
 
+// withSecretData returns a MockGetFn that returns a secret with the supplied
+// data, regardless of the secret that is requested.
+func withSecretData(data map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.Secret).Data = data
+		return nil
+	}
+}
+
 func TestObserve(t *testing.T) {
 	errBoom := errors.New("boom")
 	name := "coolserver"
@@ -138,7 +137,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ErrGetServer": {
 			e: &external{
-				client: &MockPostgreSQLServerAPI{
+				client: &fake.MockPostgreSQLServerAPI{
 					MockGetServer: func(_ context.Context, _ *v1beta1.PostgreSQLServer) (postgresql.Server, error) {
 						return postgresql.Server{}, errBoom
 					},
@@ -154,7 +153,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ServerCreating": {
 			e: &external{
-				client: &MockPostgreSQLServerAPI{
+				client: &fake.MockPostgreSQLServerAPI{
 					MockGetServer: func(_ context.Context, _ *v1beta1.PostgreSQLServer) (postgresql.Server, error) {
 						return postgresql.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
 					},
@@ -182,7 +181,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ServerNotFound": {
 			e: &external{
-				client: &MockPostgreSQLServerAPI{
+				client: &fake.MockPostgreSQLServerAPI{
 					MockGetServer: func(_ context.Context, _ *v1beta1.PostgreSQLServer) (postgresql.Server, error) {
 						return postgresql.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
 					},
@@ -206,7 +205,52 @@ func TestObserve(t *testing.T) {
 				kube: &test.MockClient{
 					MockUpdate: test.NewMockUpdateFn(nil),
 				},
-				client: &MockPostgreSQLServerAPI{
+				client: &fake.MockPostgreSQLServerAPI{
+					MockGetServer: func(_ context.Context, _ *v1beta1.PostgreSQLServer) (postgresql.Server, error) {
+						return postgresql.Server{
+							Sku: &postgresql.Sku{},
+							ServerProperties: &postgresql.ServerProperties{
+								UserVisibleState:         postgresql.ServerStateReady,
+								FullyQualifiedDomainName: &endpoint,
+								StorageProfile:           &postgresql.StorageProfile{},
+							}}, nil
+					},
+					MockGetRESTClient: func() autorest.Sender {
+						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+							return nil, nil
+						})
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: postgresqlserver(
+					withExternalName(name),
+					withAdminName(admin),
+				),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", admin, name)),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1beta1.PostgreSQLServerPort),
+					},
+				},
+			},
+		},
+		"PendingPasswordConfirmed": {
+			e: &external{
+				kube: &test.MockClient{
+					MockGet: withSecretData(map[string][]byte{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte("old"),
+						azure.ConnectionSecretKeyPendingPassword:  []byte("new"),
+					}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockPostgreSQLServerAPI{
 					MockGetServer: func(_ context.Context, _ *v1beta1.PostgreSQLServer) (postgresql.Server, error) {
 						return postgresql.Server{
 							Sku: &postgresql.Sku{},
@@ -222,12 +266,16 @@ func TestObserve(t *testing.T) {
 						})
 					},
 				},
+				record: event.NewNopRecorder(),
 			},
 			args: args{
 				ctx: context.Background(),
 				mg: postgresqlserver(
 					withExternalName(name),
 					withAdminName(admin),
+					withConnectionSecretRef(),
+					withCredentialRotation(time.Hour, 2*time.Hour),
+					withLastOperation(azurev1alpha3.AsyncOperation{Method: http.MethodPatch, Status: azure.AsyncOperationStatusSucceeded}),
 				),
 			},
 			want: want{
@@ -238,6 +286,8 @@ func TestObserve(t *testing.T) {
 						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
 						xpv1.ResourceCredentialsSecretUserKey:     []byte(fmt.Sprintf("%s@%s", admin, name)),
 						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1beta1.PostgreSQLServerPort),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte("new"),
+						azure.ConnectionSecretKeyPendingPassword:  {},
 					},
 				},
 			},
@@ -299,7 +349,7 @@ func TestCreate(t *testing.T) {
 		},
 		"ErrCreateServer": {
 			e: &external{
-				client: &MockPostgreSQLServerAPI{
+				client: &fake.MockPostgreSQLServerAPI{
 					MockCreateServer: func(_ context.Context, _ *v1beta1.PostgreSQLServer, _ string) error { return errBoom },
 				},
 				newPasswordFn: func() (string, error) { return password, nil },
@@ -314,7 +364,7 @@ func TestCreate(t *testing.T) {
 		},
 		"Successful": {
 			e: &external{
-				client: &MockPostgreSQLServerAPI{
+				client: &fake.MockPostgreSQLServerAPI{
 					MockCreateServer: func(_ context.Context, _ *v1beta1.PostgreSQLServer, _ string) error { return nil },
 					MockGetRESTClient: func() autorest.Sender {
 						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
@@ -350,6 +400,151 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestUpdate(t *testing.T) {
+	password := "verysecure"
+	sender := func() autorest.Sender {
+		return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
+			return nil, nil
+		})
+	}
+	// updateServer returns a MockUpdateServer that expects the supplied
+	// password and reports an update with the supplied status.
+	updateServer := func(want, status string) func(context.Context, *v1beta1.PostgreSQLServer, string) error {
+		return func(_ context.Context, cr *v1beta1.PostgreSQLServer, pw string) error {
+			if pw != want {
+				return errors.Errorf("want password %q, got %q", want, pw)
+			}
+			cr.Status.AtProvider.LastOperation = azurev1alpha3.AsyncOperation{Method: http.MethodPatch, Status: status, ErrorMessage: "boom"}
+			return nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		eu      managed.ExternalUpdate
+		rotated bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		args args
+		want want
+	}{
+		"ErrNotAPostgreSQLServer": {
+			e: &external{},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotPostgreSQLServer),
+			},
+		},
+		"RotatePassword": {
+			e: &external{
+				client: &fake.MockPostgreSQLServerAPI{
+					MockUpdateServer:  updateServer(password, azure.AsyncOperationStatusSucceeded),
+					MockGetRESTClient: sender,
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+				record:        event.NewNopRecorder(),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  postgresqlserver(withCredentialRotation(time.Hour, 2*time.Hour)),
+			},
+			want: want{
+				eu: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(password)},
+				},
+				rotated: true,
+			},
+		},
+		"RotatePasswordInProgress": {
+			e: &external{
+				client: &fake.MockPostgreSQLServerAPI{
+					MockUpdateServer:  updateServer(password, azure.AsyncOperationStatusInProgress),
+					MockGetRESTClient: sender,
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  postgresqlserver(withCredentialRotation(time.Hour, 2*time.Hour)),
+			},
+			want: want{
+				eu: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{azure.ConnectionSecretKeyPendingPassword: []byte(password)},
+				},
+			},
+		},
+		"RotatePasswordFailed": {
+			e: &external{
+				client: &fake.MockPostgreSQLServerAPI{
+					MockUpdateServer:  updateServer(password, azure.AsyncOperationStatusFailed),
+					MockGetRESTClient: sender,
+				},
+				newPasswordFn: func() (string, error) { return password, nil },
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  postgresqlserver(withCredentialRotation(time.Hour, 2*time.Hour)),
+			},
+			want: want{
+				err: errors.Errorf(errFmtChangePassword, "boom"),
+			},
+		},
+		"ApplyPendingPassword": {
+			e: &external{
+				kube: &test.MockClient{MockGet: withSecretData(map[string][]byte{
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte("old"),
+					azure.ConnectionSecretKeyPendingPassword:  []byte(password),
+				})},
+				client: &fake.MockPostgreSQLServerAPI{
+					MockUpdateServer:  updateServer(password, azure.AsyncOperationStatusSucceeded),
+					MockGetRESTClient: sender,
+				},
+				newPasswordFn: func() (string, error) { return "", errors.New("generated another password") },
+				record:        event.NewNopRecorder(),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  postgresqlserver(withConnectionSecretRef(), withCredentialRotation(time.Hour, 2*time.Hour)),
+			},
+			want: want{
+				eu: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
+						azure.ConnectionSecretKeyPendingPassword:  {},
+					},
+				},
+				rotated: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			eu, err := tc.e.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.eu, eu); diff != "" {
+				t.Errorf("tc.e.Update(...): -want, +got:\n%s", diff)
+			}
+			if cr, ok := tc.args.mg.(*v1beta1.PostgreSQLServer); ok {
+				if diff := cmp.Diff(tc.want.rotated, cr.Status.CredentialRotation.LastRotationTime != nil); diff != "" {
+					t.Errorf("tc.e.Update(...): -want rotated, +got rotated:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -372,7 +567,7 @@ func TestDelete(t *testing.T) {
 		},
 		"ErrDeleteServer": {
 			e: &external{
-				client: &MockPostgreSQLServerAPI{
+				client: &fake.MockPostgreSQLServerAPI{
 					MockDeleteServer: func(_ context.Context, _ *v1beta1.PostgreSQLServer) error { return errBoom },
 				},
 			},
@@ -384,7 +579,7 @@ func TestDelete(t *testing.T) {
 		},
 		"Successful": {
 			e: &external{
-				client: &MockPostgreSQLServerAPI{
+				client: &fake.MockPostgreSQLServerAPI{
 					MockDeleteServer: func(_ context.Context, _ *v1beta1.PostgreSQLServer) error { return nil },
 					MockGetRESTClient: func() autorest.Sender {
 						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
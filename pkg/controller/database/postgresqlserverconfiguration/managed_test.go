@@ -34,6 +34,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
 	azurev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/database/configuration/fake"
 )
 
 const (
@@ -42,29 +43,6 @@ const (
 	subscriptID        = "subscription-id"
 )
 
-type MockPostgreSQLConfigurationAPI struct {
-	MockGet            func(ctx context.Context, s *v1beta1.PostgreSQLServerConfiguration) (postgresql.Configuration, error)
-	MockCreateOrUpdate func(ctx context.Context, s *v1beta1.PostgreSQLServerConfiguration) error
-	MockDelete         func(ctx context.Context, s *v1beta1.PostgreSQLServerConfiguration) error
-	MockGetRESTClient  func() autorest.Sender
-}
-
-func (m *MockPostgreSQLConfigurationAPI) Get(ctx context.Context, s *v1beta1.PostgreSQLServerConfiguration) (postgresql.Configuration, error) {
-	return m.MockGet(ctx, s)
-}
-
-func (m *MockPostgreSQLConfigurationAPI) CreateOrUpdate(ctx context.Context, s *v1beta1.PostgreSQLServerConfiguration) error {
-	return m.MockCreateOrUpdate(ctx, s)
-}
-
-func (m *MockPostgreSQLConfigurationAPI) Delete(ctx context.Context, s *v1beta1.PostgreSQLServerConfiguration) error {
-	return m.MockDelete(ctx, s)
-}
-
-func (m *MockPostgreSQLConfigurationAPI) GetRESTClient() autorest.Sender {
-	return m.MockGetRESTClient()
-}
-
 type modifier func(configuration *v1beta1.PostgreSQLServerConfiguration)
 
 func withLastOperation(op azurev1alpha3.AsyncOperation) modifier {
@@ -117,7 +95,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ErrGetServer": {
 			e: &external{
-				client: &MockPostgreSQLConfigurationAPI{
+				client: &fake.MockPostgreSQLConfigurationAPI{
 					MockGet: func(_ context.Context, _ *v1beta1.PostgreSQLServerConfiguration) (postgresql.Configuration, error) {
 						return postgresql.Configuration{}, errBoom
 					},
@@ -133,7 +111,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ServerCreating": {
 			e: &external{
-				client: &MockPostgreSQLConfigurationAPI{
+				client: &fake.MockPostgreSQLConfigurationAPI{
 					MockGet: func(_ context.Context, _ *v1beta1.PostgreSQLServerConfiguration) (postgresql.Configuration, error) {
 						return postgresql.Configuration{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
 					},
@@ -161,7 +139,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ServerNotFound": {
 			e: &external{
-				client: &MockPostgreSQLConfigurationAPI{
+				client: &fake.MockPostgreSQLConfigurationAPI{
 					MockGet: func(_ context.Context, _ *v1beta1.PostgreSQLServerConfiguration) (postgresql.Configuration, error) {
 						return postgresql.Configuration{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
 					},
@@ -185,7 +163,7 @@ func TestObserve(t *testing.T) {
 				kube: &test.MockClient{
 					MockUpdate: test.NewMockUpdateFn(nil),
 				},
-				client: &MockPostgreSQLConfigurationAPI{
+				client: &fake.MockPostgreSQLConfigurationAPI{
 					MockGet: func(_ context.Context, _ *v1beta1.PostgreSQLServerConfiguration) (postgresql.Configuration, error) {
 						return postgresql.Configuration{
 							ConfigurationProperties: &postgresql.ConfigurationProperties{},
@@ -255,7 +233,7 @@ func TestCreate(t *testing.T) {
 		},
 		"ErrCreateServer": {
 			e: &external{
-				client: &MockPostgreSQLConfigurationAPI{
+				client: &fake.MockPostgreSQLConfigurationAPI{
 					MockCreateOrUpdate: func(_ context.Context, _ *v1beta1.PostgreSQLServerConfiguration) error { return errBoom },
 				},
 				subscriptionID: subscriptID,
@@ -270,7 +248,7 @@ func TestCreate(t *testing.T) {
 		},
 		"Successful": {
 			e: &external{
-				client: &MockPostgreSQLConfigurationAPI{
+				client: &fake.MockPostgreSQLConfigurationAPI{
 					MockCreateOrUpdate: func(_ context.Context, _ *v1beta1.PostgreSQLServerConfiguration) error { return nil },
 					MockGetRESTClient: func() autorest.Sender {
 						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
@@ -328,7 +306,7 @@ func TestDelete(t *testing.T) {
 		},
 		"ErrDeleteServer": {
 			e: &external{
-				client: &MockPostgreSQLConfigurationAPI{
+				client: &fake.MockPostgreSQLConfigurationAPI{
 					MockDelete: func(_ context.Context, _ *v1beta1.PostgreSQLServerConfiguration) error { return errBoom },
 				},
 			},
@@ -340,7 +318,7 @@ func TestDelete(t *testing.T) {
 		},
 		"Successful": {
 			e: &external{
-				client: &MockPostgreSQLConfigurationAPI{
+				client: &fake.MockPostgreSQLConfigurationAPI{
 					MockDelete: func(_ context.Context, _ *v1beta1.PostgreSQLServerConfiguration) error { return nil },
 					MockGetRESTClient: func() autorest.Sender {
 						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
@@ -408,7 +386,7 @@ func TestUpdate(t *testing.T) {
 		},
 		"ErrUpdateServer": {
 			e: &external{
-				client: &MockPostgreSQLConfigurationAPI{
+				client: &fake.MockPostgreSQLConfigurationAPI{
 					MockCreateOrUpdate: func(_ context.Context, _ *v1beta1.PostgreSQLServerConfiguration) error { return errBoom },
 				},
 				subscriptionID: subscriptID,
@@ -423,7 +401,7 @@ func TestUpdate(t *testing.T) {
 		},
 		"Successful": {
 			e: &external{
-				client: &MockPostgreSQLConfigurationAPI{
+				client: &fake.MockPostgreSQLConfigurationAPI{
 					MockCreateOrUpdate: func(_ context.Context, _ *v1beta1.PostgreSQLServerConfiguration) error { return nil },
 					MockGetRESTClient: func() autorest.Sender {
 						return autorest.SenderFunc(func(*http.Request) (*http.Response, error) {
@@ -32,26 +32,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-azure/apis/dns/v1alpha1"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/dns/fake"
 )
 
-type MockRecordSetAPI struct {
-	MockGet            func(ctx context.Context, z *v1alpha1.RecordSet) (dns.RecordSet, error)
-	MockCreateOrUpdate func(ctx context.Context, z *v1alpha1.RecordSet) error
-	MockDelete         func(ctx context.Context, z *v1alpha1.RecordSet) error
-}
-
-func (m *MockRecordSetAPI) Get(ctx context.Context, z *v1alpha1.RecordSet) (dns.RecordSet, error) {
-	return m.MockGet(ctx, z)
-}
-
-func (m *MockRecordSetAPI) CreateOrUpdate(ctx context.Context, z *v1alpha1.RecordSet) error {
-	return m.MockCreateOrUpdate(ctx, z)
-}
-
-func (m *MockRecordSetAPI) Delete(ctx context.Context, z *v1alpha1.RecordSet) error {
-	return m.MockDelete(ctx, z)
-}
-
 type modifier func(configuration *v1alpha1.RecordSet)
 
 func withExternalName(name string) modifier {
@@ -98,7 +81,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ErrGetServer": {
 			e: &external{
-				client: &MockRecordSetAPI{
+				client: &fake.MockRecordSetAPI{
 					MockGet: func(_ context.Context, _ *v1alpha1.RecordSet) (dns.RecordSet, error) {
 						return dns.RecordSet{}, errBoom
 					},
@@ -114,7 +97,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ServerNotFound": {
 			e: &external{
-				client: &MockRecordSetAPI{
+				client: &fake.MockRecordSetAPI{
 					MockGet: func(_ context.Context, _ *v1alpha1.RecordSet) (dns.RecordSet, error) {
 						return dns.RecordSet{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
 					},
@@ -132,7 +115,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ServerAvailable": {
 			e: &external{
-				client: &MockRecordSetAPI{
+				client: &fake.MockRecordSetAPI{
 					MockGet: func(_ context.Context, _ *v1alpha1.RecordSet) (dns.RecordSet, error) {
 						return dns.RecordSet{
 							RecordSetProperties: &dns.RecordSetProperties{},
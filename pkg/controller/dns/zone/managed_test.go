@@ -32,26 +32,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-azure/apis/dns/v1alpha1"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/dns/fake"
 )
 
-type MockZoneAPI struct {
-	MockGet            func(ctx context.Context, z *v1alpha1.Zone) (dns.Zone, error)
-	MockCreateOrUpdate func(ctx context.Context, z *v1alpha1.Zone) error
-	MockDelete         func(ctx context.Context, z *v1alpha1.Zone) error
-}
-
-func (m *MockZoneAPI) Get(ctx context.Context, z *v1alpha1.Zone) (dns.Zone, error) {
-	return m.MockGet(ctx, z)
-}
-
-func (m *MockZoneAPI) CreateOrUpdate(ctx context.Context, z *v1alpha1.Zone) error {
-	return m.MockCreateOrUpdate(ctx, z)
-}
-
-func (m *MockZoneAPI) Delete(ctx context.Context, z *v1alpha1.Zone) error {
-	return m.MockDelete(ctx, z)
-}
-
 type modifier func(configuration *v1alpha1.Zone)
 
 func withExternalName(name string) modifier {
@@ -98,7 +81,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ErrGetServer": {
 			e: &external{
-				client: &MockZoneAPI{
+				client: &fake.MockZoneAPI{
 					MockGet: func(_ context.Context, _ *v1alpha1.Zone) (dns.Zone, error) {
 						return dns.Zone{}, errBoom
 					},
@@ -114,7 +97,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ServerNotFound": {
 			e: &external{
-				client: &MockZoneAPI{
+				client: &fake.MockZoneAPI{
 					MockGet: func(_ context.Context, _ *v1alpha1.Zone) (dns.Zone, error) {
 						return dns.Zone{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
 					},
@@ -132,7 +115,7 @@ func TestObserve(t *testing.T) {
 		},
 		"ServerAvailable": {
 			e: &external{
-				client: &MockZoneAPI{
+				client: &fake.MockZoneAPI{
 					MockGet: func(_ context.Context, _ *v1alpha1.Zone) (dns.Zone, error) {
 						return dns.Zone{
 							ZoneProperties: &dns.ZoneProperties{},
@@ -196,7 +179,7 @@ func TestCreate(t *testing.T) {
 		},
 		"ErrCreateServer": {
 			e: &external{
-				client: &MockZoneAPI{
+				client: &fake.MockZoneAPI{
 					MockCreateOrUpdate: func(_ context.Context, _ *v1alpha1.Zone) error { return errBoom },
 				},
 			},
@@ -210,7 +193,7 @@ func TestCreate(t *testing.T) {
 		},
 		"Successful": {
 			e: &external{
-				client: &MockZoneAPI{
+				client: &fake.MockZoneAPI{
 					MockCreateOrUpdate: func(_ context.Context, _ *v1alpha1.Zone) error { return nil },
 				},
 			},
@@ -260,7 +243,7 @@ func TestDelete(t *testing.T) {
 		},
 		"ErrDeleteServer": {
 			e: &external{
-				client: &MockZoneAPI{
+				client: &fake.MockZoneAPI{
 					MockGet: func(_ context.Context, _ *v1alpha1.Zone) (dns.Zone, error) {
 						return dns.Zone{
 							ZoneProperties: &dns.ZoneProperties{},
@@ -277,7 +260,7 @@ func TestDelete(t *testing.T) {
 		},
 		"Successful": {
 			e: &external{
-				client: &MockZoneAPI{
+				client: &fake.MockZoneAPI{
 					MockGet: func(_ context.Context, _ *v1alpha1.Zone) (dns.Zone, error) {
 						return dns.Zone{
 							ZoneProperties: &dns.ZoneProperties{},
@@ -334,7 +317,7 @@ func TestUpdate(t *testing.T) {
 		},
 		"ErrUpdateServer": {
 			e: &external{
-				client: &MockZoneAPI{
+				client: &fake.MockZoneAPI{
 					MockGet: func(_ context.Context, _ *v1alpha1.Zone) (dns.Zone, error) {
 						return dns.Zone{
 							ZoneProperties: &dns.ZoneProperties{},
@@ -353,7 +336,7 @@ func TestUpdate(t *testing.T) {
 		},
 		"Successful": {
 			e: &external{
-				client: &MockZoneAPI{
+				client: &fake.MockZoneAPI{
 					MockGet: func(_ context.Context, _ *v1alpha1.Zone) (dns.Zone, error) {
 						return dns.Zone{
 							ZoneProperties: &dns.ZoneProperties{},
@@ -0,0 +1,368 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationinsights
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2020-02-02/insights"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/insights/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	insightsclients "github.com/crossplane-contrib/provider-azure/pkg/clients/insights"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/insights/fake"
+)
+
+const (
+	name               = "coolInsights"
+	resourceGroupName  = "coolRG"
+	location           = "westeurope"
+	appID              = "00000000-0000-0000-0000-000000000001"
+	instrumentationKey = "00000000-0000-0000-0000-000000000002"
+	connectionString   = "InstrumentationKey=00000000-0000-0000-0000-000000000002"
+	resourceID         = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Insights/components/coolInsights"
+	workspaceID        = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.OperationalInsights/workspaces/coolWorkspace"
+	retention          = int32(90)
+)
+
+type componentModifier func(*v1alpha1.ApplicationInsights)
+
+func withConditions(c ...xpv1.Condition) componentModifier {
+	return func(ai *v1alpha1.ApplicationInsights) { ai.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.ApplicationInsightsObservation) componentModifier {
+	return func(ai *v1alpha1.ApplicationInsights) { ai.Status.AtProvider = o }
+}
+
+func withWorkspace(id string) componentModifier {
+	return func(ai *v1alpha1.ApplicationInsights) { ai.Spec.ForProvider.WorkspaceResourceID = id }
+}
+
+func withLateInitialized() componentModifier {
+	return func(ai *v1alpha1.ApplicationInsights) {
+		ai.Spec.ForProvider.RetentionInDays = azure.ToInt32Ptr(int(retention))
+		ai.Spec.ForProvider.DisableIPMasking = azure.ToBoolPtr(false, azure.FieldRequired)
+	}
+}
+
+func component(m ...componentModifier) *v1alpha1.ApplicationInsights {
+	ai := &v1alpha1.ApplicationInsights{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.ApplicationInsightsSpec{
+			ForProvider: v1alpha1.ApplicationInsightsParameters{
+				ResourceGroupName: resourceGroupName,
+				Location:          location,
+				Kind:              "web",
+				ApplicationType:   string(insights.ApplicationTypeWeb),
+			},
+		},
+	}
+	meta.SetExternalName(ai, name)
+	for _, f := range m {
+		f(ai)
+	}
+	return ai
+}
+
+func azureComponent(state string) insights.ApplicationInsightsComponent {
+	r := retention
+	return insights.ApplicationInsightsComponent{
+		ID:       azure.ToStringPtr(resourceID),
+		Kind:     azure.ToStringPtr("web"),
+		Location: azure.ToStringPtr(location),
+		ApplicationInsightsComponentProperties: &insights.ApplicationInsightsComponentProperties{
+			AppID:               azure.ToStringPtr(appID),
+			ApplicationType:     insights.ApplicationTypeWeb,
+			InstrumentationKey:  azure.ToStringPtr(instrumentationKey),
+			ConnectionString:    azure.ToStringPtr(connectionString),
+			ProvisioningState:   azure.ToStringPtr(state),
+			RetentionInDays:     &r,
+			DisableIPMasking:    azure.ToBoolPtr(false, azure.FieldRequired),
+			WorkspaceResourceID: azure.ToStringPtr(workspaceID),
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	succeeded := v1alpha1.ApplicationInsightsObservation{ID: resourceID, AppID: appID, ProvisioningState: insightsclients.ProvisioningStateSucceeded}
+	cd := managed.ConnectionDetails{
+		azure.ConnectionSecretKeyResourceID:                   []byte(resourceID),
+		azure.ConnectionSecretKeyLocation:                     []byte(location),
+		azure.ConnectionSecretKeySubscriptionID:               []byte("sub"),
+		azure.ConnectionSecretKeyResourceGroup:                []byte(resourceGroupName),
+		insightsclients.ConnectionSecretKeyAppID:              []byte(appID),
+		insightsclients.ConnectionSecretKeyInstrumentationKey: []byte(instrumentationKey),
+		insightsclients.ConnectionSecretKeyConnectionString:   []byte(connectionString),
+	}
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotApplicationInsights": {
+			ec: &external{client: &fake.MockComponentsClient{}},
+			want: want{
+				err: errors.New(errNotApplicationInsights),
+			},
+		},
+		"NotFound": {
+			ec: &external{client: &fake.MockComponentsClient{
+				MockGet: func(_ context.Context, _ string, _ string) (insights.ApplicationInsightsComponent, error) {
+					return insights.ApplicationInsightsComponent{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: component(),
+			want: want{
+				mg: component(),
+			},
+		},
+		"GetFailed": {
+			ec: &external{client: &fake.MockComponentsClient{
+				MockGet: func(_ context.Context, _ string, _ string) (insights.ApplicationInsightsComponent, error) {
+					return insights.ApplicationInsightsComponent{}, errBoom
+				},
+			}},
+			mg: component(),
+			want: want{
+				mg:  component(),
+				err: errors.Wrap(errBoom, errGetApplicationInsights),
+			},
+		},
+		"WorkspaceChanged": {
+			ec: &external{client: &fake.MockComponentsClient{
+				MockGet: func(_ context.Context, _ string, _ string) (insights.ApplicationInsightsComponent, error) {
+					return azureComponent(insightsclients.ProvisioningStateSucceeded), nil
+				},
+			}},
+			mg: component(withLateInitialized(), withWorkspace("/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.OperationalInsights/workspaces/other")),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: cd,
+				},
+				mg: component(
+					withLateInitialized(),
+					withWorkspace("/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.OperationalInsights/workspaces/other"),
+					withConditions(xpv1.Available()),
+					withObservation(succeeded),
+				),
+			},
+		},
+		"LateInitialized": {
+			ec: &external{client: &fake.MockComponentsClient{
+				MockGet: func(_ context.Context, _ string, _ string) (insights.ApplicationInsightsComponent, error) {
+					return azureComponent(insightsclients.ProvisioningStateSucceeded), nil
+				},
+			}},
+			mg: component(withWorkspace(workspaceID)),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       cd,
+				},
+				mg: component(withWorkspace(workspaceID), withLateInitialized(), withConditions(xpv1.Available()), withObservation(succeeded)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotApplicationInsights": {
+			ec: &external{client: &fake.MockComponentsClient{}},
+			want: want{
+				err: errors.New(errNotApplicationInsights),
+			},
+		},
+		"CreateFailed": {
+			ec: &external{client: &fake.MockComponentsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ insights.ApplicationInsightsComponent) (insights.ApplicationInsightsComponent, error) {
+					return insights.ApplicationInsightsComponent{}, errBoom
+				},
+			}},
+			mg: component(),
+			want: want{
+				mg:  component(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateApplicationInsights),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockComponentsClient{
+				MockCreateOrUpdate: func(_ context.Context, rg string, n string, p insights.ApplicationInsightsComponent) (insights.ApplicationInsightsComponent, error) {
+					if rg != resourceGroupName || n != name || p.ApplicationInsightsComponentProperties == nil {
+						return insights.ApplicationInsightsComponent{}, errBoom
+					}
+					if azure.ToString(p.WorkspaceResourceID) != workspaceID || p.FlowType != insights.FlowTypeBluefield {
+						return insights.ApplicationInsightsComponent{}, errBoom
+					}
+					return insights.ApplicationInsightsComponent{}, nil
+				},
+			}},
+			mg: component(withWorkspace(workspaceID)),
+			want: want{
+				mg: component(withWorkspace(workspaceID), withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotApplicationInsights": {
+			ec:   &external{client: &fake.MockComponentsClient{}},
+			want: errors.New(errNotApplicationInsights),
+		},
+		"UpdateFailed": {
+			ec: &external{client: &fake.MockComponentsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ insights.ApplicationInsightsComponent) (insights.ApplicationInsightsComponent, error) {
+					return insights.ApplicationInsightsComponent{}, errBoom
+				},
+			}},
+			mg:   component(),
+			want: errors.Wrap(errBoom, errUpdateApplicationInsights),
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockComponentsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ insights.ApplicationInsightsComponent) (insights.ApplicationInsightsComponent, error) {
+					return insights.ApplicationInsightsComponent{}, nil
+				},
+			}},
+			mg: component(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotApplicationInsights": {
+			ec:   &external{client: &fake.MockComponentsClient{}},
+			want: errors.New(errNotApplicationInsights),
+		},
+		"AlreadyGone": {
+			ec: &external{client: &fake.MockComponentsClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (autorest.Response, error) {
+					return autorest.Response{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: component(),
+		},
+		"DeleteFailed": {
+			ec: &external{client: &fake.MockComponentsClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (autorest.Response, error) {
+					return autorest.Response{}, errBoom
+				},
+			}},
+			mg:   component(),
+			want: errors.Wrap(errBoom, errDeleteApplicationInsights),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
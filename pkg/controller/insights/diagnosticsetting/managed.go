@@ -0,0 +1,162 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnosticsetting
+
+import (
+	"context"
+
+	monitor "github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights/insightsapi"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/insights/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	insightsclients "github.com/crossplane-contrib/provider-azure/pkg/clients/insights"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotDiagnosticSetting    = "managed resource is not a DiagnosticSetting"
+	errConnectFailed           = "cannot connect to Azure API"
+	errNoResourceID            = "neither a resource ID nor a resource reference is specified"
+	errGetDiagnosticSetting    = "cannot get DiagnosticSetting"
+	errCreateDiagnosticSetting = "cannot create DiagnosticSetting"
+	errUpdateDiagnosticSetting = "cannot update DiagnosticSetting"
+	errDeleteDiagnosticSetting = "cannot delete DiagnosticSetting"
+)
+
+// Setup adds a controller that reconciles DiagnosticSettings.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.DiagnosticSettingGroupKind)
+	o = azure.ControllerOptions(v1alpha1.DiagnosticSettingGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient())}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&v1alpha1.DiagnosticSetting{}).
+		Complete(azure.NewInstrumentedReconciler(v1alpha1.DiagnosticSettingGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DiagnosticSettingGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1alpha1.DiagnosticSettingGroupKind, bo, r, &connecter{kube: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+type connecter struct {
+	kube client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnectFailed)
+	}
+	cl := monitor.NewDiagnosticSettingsClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{client: cl}, nil
+}
+
+type external struct {
+	client insightsapi.DiagnosticSettingsClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DiagnosticSetting)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDiagnosticSetting)
+	}
+	if cr.Spec.ForProvider.ResourceID == "" {
+		return managed.ExternalObservation{}, errors.New(errNoResourceID)
+	}
+
+	az, err := e.client.Get(ctx, cr.Spec.ForProvider.ResourceID, meta.GetExternalName(cr))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetDiagnosticSetting)
+	}
+
+	cr.Status.AtProvider = insightsclients.GenerateDiagnosticSettingObservation(az)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: insightsclients.IsDiagnosticSettingUpToDate(cr.Spec.ForProvider, az),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DiagnosticSetting)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDiagnosticSetting)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceID, insightsclients.NewDiagnosticSettingParameters(cr.Spec.ForProvider), meta.GetExternalName(cr))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateDiagnosticSetting)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DiagnosticSetting)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDiagnosticSetting)
+	}
+
+	_, err := e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceID, insightsclients.NewDiagnosticSettingParameters(cr.Spec.ForProvider), meta.GetExternalName(cr))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDiagnosticSetting)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DiagnosticSetting)
+	if !ok {
+		return errors.New(errNotDiagnosticSetting)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.Delete(ctx, cr.Spec.ForProvider.ResourceID, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteDiagnosticSetting)
+}
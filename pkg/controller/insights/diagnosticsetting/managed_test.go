@@ -0,0 +1,351 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnosticsetting
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	monitor "github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/insights/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/insights/fake"
+)
+
+const (
+	name        = "coolSetting"
+	targetID    = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.KeyVault/vaults/coolVault"
+	settingID   = targetID + "/providers/microsoft.insights/diagnosticSettings/coolSetting"
+	workspaceID = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.OperationalInsights/workspaces/coolWorkspace"
+	category    = "AuditEvent"
+)
+
+type settingModifier func(*v1alpha1.DiagnosticSetting)
+
+func withConditions(c ...xpv1.Condition) settingModifier {
+	return func(ds *v1alpha1.DiagnosticSetting) { ds.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.DiagnosticSettingObservation) settingModifier {
+	return func(ds *v1alpha1.DiagnosticSetting) { ds.Status.AtProvider = o }
+}
+
+func withResourceID(id string) settingModifier {
+	return func(ds *v1alpha1.DiagnosticSetting) { ds.Spec.ForProvider.ResourceID = id }
+}
+
+func withWorkspace(id string) settingModifier {
+	return func(ds *v1alpha1.DiagnosticSetting) { ds.Spec.ForProvider.WorkspaceID = azure.ToStringPtr(id) }
+}
+
+func setting(m ...settingModifier) *v1alpha1.DiagnosticSetting {
+	ds := &v1alpha1.DiagnosticSetting{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.DiagnosticSettingSpec{
+			ForProvider: v1alpha1.DiagnosticSettingParameters{
+				ResourceID:  targetID,
+				WorkspaceID: azure.ToStringPtr(workspaceID),
+				Logs:        []v1alpha1.DiagnosticLogSettings{{Category: category}},
+			},
+		},
+	}
+	meta.SetExternalName(ds, name)
+	for _, f := range m {
+		f(ds)
+	}
+	return ds
+}
+
+func azureSetting() monitor.DiagnosticSettingsResource {
+	return monitor.DiagnosticSettingsResource{
+		ID: azure.ToStringPtr(settingID),
+		DiagnosticSettings: &monitor.DiagnosticSettings{
+			WorkspaceID: azure.ToStringPtr(workspaceID),
+			Logs: &[]monitor.LogSettings{
+				{Category: azure.ToStringPtr(category), Enabled: to.BoolPtr(true)},
+			},
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	observed := v1alpha1.DiagnosticSettingObservation{ID: settingID}
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotDiagnosticSetting": {
+			ec: &external{client: &fake.MockDiagnosticSettingsClient{}},
+			want: want{
+				err: errors.New(errNotDiagnosticSetting),
+			},
+		},
+		"NoResourceID": {
+			ec: &external{client: &fake.MockDiagnosticSettingsClient{}},
+			mg: setting(withResourceID("")),
+			want: want{
+				mg:  setting(withResourceID("")),
+				err: errors.New(errNoResourceID),
+			},
+		},
+		"NotFound": {
+			ec: &external{client: &fake.MockDiagnosticSettingsClient{
+				MockGet: func(_ context.Context, _ string, _ string) (monitor.DiagnosticSettingsResource, error) {
+					return monitor.DiagnosticSettingsResource{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: setting(),
+			want: want{
+				mg: setting(),
+			},
+		},
+		"GetFailed": {
+			ec: &external{client: &fake.MockDiagnosticSettingsClient{
+				MockGet: func(_ context.Context, _ string, _ string) (monitor.DiagnosticSettingsResource, error) {
+					return monitor.DiagnosticSettingsResource{}, errBoom
+				},
+			}},
+			mg: setting(),
+			want: want{
+				mg:  setting(),
+				err: errors.Wrap(errBoom, errGetDiagnosticSetting),
+			},
+		},
+		"UpToDate": {
+			ec: &external{client: &fake.MockDiagnosticSettingsClient{
+				MockGet: func(_ context.Context, uri string, n string) (monitor.DiagnosticSettingsResource, error) {
+					if uri != targetID || n != name {
+						return monitor.DiagnosticSettingsResource{}, errBoom
+					}
+					return azureSetting(), nil
+				},
+			}},
+			mg: setting(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				mg: setting(withConditions(xpv1.Available()), withObservation(observed)),
+			},
+		},
+		"WorkspaceChanged": {
+			ec: &external{client: &fake.MockDiagnosticSettingsClient{
+				MockGet: func(_ context.Context, _ string, _ string) (monitor.DiagnosticSettingsResource, error) {
+					return azureSetting(), nil
+				},
+			}},
+			mg: setting(withWorkspace("/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.OperationalInsights/workspaces/other")),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				mg: setting(
+					withWorkspace("/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.OperationalInsights/workspaces/other"),
+					withConditions(xpv1.Available()),
+					withObservation(observed),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotDiagnosticSetting": {
+			ec: &external{client: &fake.MockDiagnosticSettingsClient{}},
+			want: want{
+				err: errors.New(errNotDiagnosticSetting),
+			},
+		},
+		"CreateFailed": {
+			ec: &external{client: &fake.MockDiagnosticSettingsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ monitor.DiagnosticSettingsResource, _ string) (monitor.DiagnosticSettingsResource, error) {
+					return monitor.DiagnosticSettingsResource{}, errBoom
+				},
+			}},
+			mg: setting(),
+			want: want{
+				mg:  setting(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateDiagnosticSetting),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockDiagnosticSettingsClient{
+				MockCreateOrUpdate: func(_ context.Context, uri string, p monitor.DiagnosticSettingsResource, n string) (monitor.DiagnosticSettingsResource, error) {
+					if uri != targetID || n != name || p.DiagnosticSettings == nil {
+						return monitor.DiagnosticSettingsResource{}, errBoom
+					}
+					if azure.ToString(p.WorkspaceID) != workspaceID || p.Logs == nil || len(*p.Logs) != 1 {
+						return monitor.DiagnosticSettingsResource{}, errBoom
+					}
+					return monitor.DiagnosticSettingsResource{}, nil
+				},
+			}},
+			mg: setting(),
+			want: want{
+				mg: setting(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotDiagnosticSetting": {
+			ec:   &external{client: &fake.MockDiagnosticSettingsClient{}},
+			want: errors.New(errNotDiagnosticSetting),
+		},
+		"UpdateFailed": {
+			ec: &external{client: &fake.MockDiagnosticSettingsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ monitor.DiagnosticSettingsResource, _ string) (monitor.DiagnosticSettingsResource, error) {
+					return monitor.DiagnosticSettingsResource{}, errBoom
+				},
+			}},
+			mg:   setting(),
+			want: errors.Wrap(errBoom, errUpdateDiagnosticSetting),
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockDiagnosticSettingsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ monitor.DiagnosticSettingsResource, _ string) (monitor.DiagnosticSettingsResource, error) {
+					return monitor.DiagnosticSettingsResource{}, nil
+				},
+			}},
+			mg: setting(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotDiagnosticSetting": {
+			ec:   &external{client: &fake.MockDiagnosticSettingsClient{}},
+			want: errors.New(errNotDiagnosticSetting),
+		},
+		"AlreadyGone": {
+			ec: &external{client: &fake.MockDiagnosticSettingsClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (autorest.Response, error) {
+					return autorest.Response{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: setting(),
+		},
+		"DeleteFailed": {
+			ec: &external{client: &fake.MockDiagnosticSettingsClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (autorest.Response, error) {
+					return autorest.Response{}, errBoom
+				},
+			}},
+			mg:   setting(),
+			want: errors.Wrap(errBoom, errDeleteDiagnosticSetting),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loganalyticsworkspace
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights/operationalinsightsapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/insights/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	insightsclients "github.com/crossplane-contrib/provider-azure/pkg/clients/insights"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotLogAnalyticsWorkspace    = "managed resource is not a LogAnalyticsWorkspace"
+	errConnectFailed               = "cannot connect to Azure API"
+	errGetLogAnalyticsWorkspace    = "cannot get LogAnalyticsWorkspace"
+	errGetSharedKeys               = "cannot get LogAnalyticsWorkspace shared keys"
+	errCreateLogAnalyticsWorkspace = "cannot create LogAnalyticsWorkspace"
+	errUpdateLogAnalyticsWorkspace = "cannot update LogAnalyticsWorkspace"
+	errDeleteLogAnalyticsWorkspace = "cannot delete LogAnalyticsWorkspace"
+)
+
+// Setup adds a controller that reconciles LogAnalyticsWorkspaces.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.LogAnalyticsWorkspaceGroupKind)
+	o = azure.ControllerOptions(v1alpha1.LogAnalyticsWorkspaceGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient()), azure.NewDefaultTagger(mgr.GetClient(), resourceTags)}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&v1alpha1.LogAnalyticsWorkspace{}).
+		Complete(azure.NewInstrumentedReconciler(v1alpha1.LogAnalyticsWorkspaceGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LogAnalyticsWorkspaceGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1alpha1.LogAnalyticsWorkspaceGroupKind, bo, r, &connecter{kube: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+func resourceTags(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*v1alpha1.LogAnalyticsWorkspace)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Tags
+}
+
+type connecter struct {
+	kube client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnectFailed)
+	}
+	cl := operationalinsights.NewWorkspacesClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	kcl := operationalinsights.NewSharedKeysClient(creds[azure.CredentialsKeySubscriptionID])
+	kcl.Authorizer = auth
+	return &external{client: cl, keys: kcl}, nil
+}
+
+type external struct {
+	client operationalinsightsapi.WorkspacesClientAPI
+	keys   operationalinsightsapi.SharedKeysClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LogAnalyticsWorkspace)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLogAnalyticsWorkspace)
+	}
+
+	az, err := e.client.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetLogAnalyticsWorkspace)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	insightsclients.LateInitializeWorkspace(&cr.Spec.ForProvider, az)
+
+	cr.Status.AtProvider = insightsclients.GenerateWorkspaceObservation(az)
+
+	cd := azure.ResourceConnectionDetails(cr.Status.AtProvider.ID, cr.Spec.ForProvider.Location)
+	if cr.Status.AtProvider.ProvisioningState != insightsclients.ProvisioningStateSucceeded {
+		cr.SetConditions(xpv1.Unavailable())
+		return managed.ExternalObservation{
+			ResourceExists:          true,
+			ResourceUpToDate:        true,
+			ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+			ConnectionDetails:       cd,
+		}, nil
+	}
+
+	keys, err := e.keys.GetSharedKeys(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSharedKeys)
+	}
+	for k, v := range insightsclients.WorkspaceConnectionDetails(cr.Status.AtProvider, keys) {
+		cd[k] = v
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        insightsclients.IsWorkspaceUpToDate(cr.Spec.ForProvider, az),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails:       cd,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LogAnalyticsWorkspace)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotLogAnalyticsWorkspace)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), insightsclients.NewWorkspaceParameters(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateLogAnalyticsWorkspace)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LogAnalyticsWorkspace)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotLogAnalyticsWorkspace)
+	}
+
+	_, err := e.client.Update(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), insightsclients.NewWorkspacePatch(cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateLogAnalyticsWorkspace)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LogAnalyticsWorkspace)
+	if !ok {
+		return errors.New(errNotLogAnalyticsWorkspace)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), nil)
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteLogAnalyticsWorkspace)
+}
@@ -0,0 +1,457 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loganalyticsworkspace
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/insights/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	insightsclients "github.com/crossplane-contrib/provider-azure/pkg/clients/insights"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/insights/fake"
+)
+
+const (
+	name              = "coolWorkspace"
+	resourceGroupName = "coolRG"
+	location          = "westeurope"
+	customerID        = "00000000-0000-0000-0000-000000000001"
+	resourceID        = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.OperationalInsights/workspaces/coolWorkspace"
+	primaryKey        = "primary"
+	secondaryKey      = "secondary"
+	retention         = int32(30)
+)
+
+type workspaceModifier func(*v1alpha1.LogAnalyticsWorkspace)
+
+func withConditions(c ...xpv1.Condition) workspaceModifier {
+	return func(w *v1alpha1.LogAnalyticsWorkspace) { w.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.LogAnalyticsWorkspaceObservation) workspaceModifier {
+	return func(w *v1alpha1.LogAnalyticsWorkspace) { w.Status.AtProvider = o }
+}
+
+func withRetention(d int32) workspaceModifier {
+	return func(w *v1alpha1.LogAnalyticsWorkspace) { w.Spec.ForProvider.RetentionInDays = &d }
+}
+
+func withLateInitialized() workspaceModifier {
+	return func(w *v1alpha1.LogAnalyticsWorkspace) {
+		w.Spec.ForProvider.SKUName = azure.ToStringPtr(insightsclients.DefaultWorkspaceSKUName)
+		w.Spec.ForProvider.RetentionInDays = azure.ToInt32Ptr(int(retention))
+		w.Spec.ForProvider.PublicNetworkAccessForIngestion = azure.ToStringPtr(string(operationalinsights.Enabled))
+		w.Spec.ForProvider.PublicNetworkAccessForQuery = azure.ToStringPtr(string(operationalinsights.Enabled))
+	}
+}
+
+func workspace(m ...workspaceModifier) *v1alpha1.LogAnalyticsWorkspace {
+	w := &v1alpha1.LogAnalyticsWorkspace{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.LogAnalyticsWorkspaceSpec{
+			ForProvider: v1alpha1.LogAnalyticsWorkspaceParameters{
+				ResourceGroupName: resourceGroupName,
+				Location:          location,
+			},
+		},
+	}
+	meta.SetExternalName(w, name)
+	for _, f := range m {
+		f(w)
+	}
+	return w
+}
+
+func azureWorkspace(state operationalinsights.WorkspaceEntityStatus) operationalinsights.Workspace {
+	r := retention
+	return operationalinsights.Workspace{
+		ID:       azure.ToStringPtr(resourceID),
+		Location: azure.ToStringPtr(location),
+		WorkspaceProperties: &operationalinsights.WorkspaceProperties{
+			ProvisioningState:               state,
+			CustomerID:                      azure.ToStringPtr(customerID),
+			Sku:                             &operationalinsights.WorkspaceSku{Name: operationalinsights.WorkspaceSkuNameEnumPerGB2018},
+			RetentionInDays:                 &r,
+			PublicNetworkAccessForIngestion: operationalinsights.Enabled,
+			PublicNetworkAccessForQuery:     operationalinsights.Enabled,
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	succeeded := v1alpha1.LogAnalyticsWorkspaceObservation{ID: resourceID, CustomerID: customerID, ProvisioningState: insightsclients.ProvisioningStateSucceeded}
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotLogAnalyticsWorkspace": {
+			ec: &external{client: &fake.MockWorkspacesClient{}, keys: &fake.MockSharedKeysClient{}},
+			want: want{
+				err: errors.New(errNotLogAnalyticsWorkspace),
+			},
+		},
+		"NotFound": {
+			ec: &external{
+				client: &fake.MockWorkspacesClient{
+					MockGet: func(_ context.Context, _ string, _ string) (operationalinsights.Workspace, error) {
+						return operationalinsights.Workspace{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				},
+				keys: &fake.MockSharedKeysClient{},
+			},
+			mg: workspace(),
+			want: want{
+				mg: workspace(),
+			},
+		},
+		"GetFailed": {
+			ec: &external{
+				client: &fake.MockWorkspacesClient{
+					MockGet: func(_ context.Context, _ string, _ string) (operationalinsights.Workspace, error) {
+						return operationalinsights.Workspace{}, errBoom
+					},
+				},
+				keys: &fake.MockSharedKeysClient{},
+			},
+			mg: workspace(),
+			want: want{
+				mg:  workspace(),
+				err: errors.Wrap(errBoom, errGetLogAnalyticsWorkspace),
+			},
+		},
+		"Provisioning": {
+			ec: &external{
+				client: &fake.MockWorkspacesClient{
+					MockGet: func(_ context.Context, _ string, _ string) (operationalinsights.Workspace, error) {
+						return azureWorkspace(operationalinsights.WorkspaceEntityStatusCreating), nil
+					},
+				},
+				keys: &fake.MockSharedKeysClient{},
+			},
+			mg: workspace(withLateInitialized()),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyResourceID:     []byte(resourceID),
+						azure.ConnectionSecretKeyLocation:       []byte(location),
+						azure.ConnectionSecretKeySubscriptionID: []byte("sub"),
+						azure.ConnectionSecretKeyResourceGroup:  []byte(resourceGroupName),
+					},
+				},
+				mg: workspace(
+					withLateInitialized(),
+					withConditions(xpv1.Unavailable()),
+					withObservation(v1alpha1.LogAnalyticsWorkspaceObservation{ID: resourceID, CustomerID: customerID, ProvisioningState: string(operationalinsights.WorkspaceEntityStatusCreating)}),
+				),
+			},
+		},
+		"GetSharedKeysFailed": {
+			ec: &external{
+				client: &fake.MockWorkspacesClient{
+					MockGet: func(_ context.Context, _ string, _ string) (operationalinsights.Workspace, error) {
+						return azureWorkspace(operationalinsights.WorkspaceEntityStatusSucceeded), nil
+					},
+				},
+				keys: &fake.MockSharedKeysClient{
+					MockGetSharedKeys: func(_ context.Context, _ string, _ string) (operationalinsights.SharedKeys, error) {
+						return operationalinsights.SharedKeys{}, errBoom
+					},
+				},
+			},
+			mg: workspace(withLateInitialized()),
+			want: want{
+				mg:  workspace(withLateInitialized(), withObservation(succeeded)),
+				err: errors.Wrap(errBoom, errGetSharedKeys),
+			},
+		},
+		"RetentionChanged": {
+			ec: &external{
+				client: &fake.MockWorkspacesClient{
+					MockGet: func(_ context.Context, _ string, _ string) (operationalinsights.Workspace, error) {
+						return azureWorkspace(operationalinsights.WorkspaceEntityStatusSucceeded), nil
+					},
+				},
+				keys: &fake.MockSharedKeysClient{
+					MockGetSharedKeys: func(_ context.Context, _ string, _ string) (operationalinsights.SharedKeys, error) {
+						return operationalinsights.SharedKeys{}, nil
+					},
+				},
+			},
+			mg: workspace(withLateInitialized(), withRetention(90)),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyResourceID:                   []byte(resourceID),
+						azure.ConnectionSecretKeyLocation:                     []byte(location),
+						azure.ConnectionSecretKeySubscriptionID:               []byte("sub"),
+						azure.ConnectionSecretKeyResourceGroup:                []byte(resourceGroupName),
+						insightsclients.ConnectionSecretKeyWorkspaceID:        []byte(customerID),
+						insightsclients.ConnectionSecretKeyPrimarySharedKey:   []byte(""),
+						insightsclients.ConnectionSecretKeySecondarySharedKey: []byte(""),
+					},
+				},
+				mg: workspace(withLateInitialized(), withRetention(90), withConditions(xpv1.Available()), withObservation(succeeded)),
+			},
+		},
+		"LateInitialized": {
+			ec: &external{
+				client: &fake.MockWorkspacesClient{
+					MockGet: func(_ context.Context, _ string, _ string) (operationalinsights.Workspace, error) {
+						return azureWorkspace(operationalinsights.WorkspaceEntityStatusSucceeded), nil
+					},
+				},
+				keys: &fake.MockSharedKeysClient{
+					MockGetSharedKeys: func(_ context.Context, _ string, _ string) (operationalinsights.SharedKeys, error) {
+						return operationalinsights.SharedKeys{
+							PrimarySharedKey:   azure.ToStringPtr(primaryKey),
+							SecondarySharedKey: azure.ToStringPtr(secondaryKey),
+						}, nil
+					},
+				},
+			},
+			mg: workspace(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyResourceID:                   []byte(resourceID),
+						azure.ConnectionSecretKeyLocation:                     []byte(location),
+						azure.ConnectionSecretKeySubscriptionID:               []byte("sub"),
+						azure.ConnectionSecretKeyResourceGroup:                []byte(resourceGroupName),
+						insightsclients.ConnectionSecretKeyWorkspaceID:        []byte(customerID),
+						insightsclients.ConnectionSecretKeyPrimarySharedKey:   []byte(primaryKey),
+						insightsclients.ConnectionSecretKeySecondarySharedKey: []byte(secondaryKey),
+					},
+				},
+				mg: workspace(withLateInitialized(), withConditions(xpv1.Available()), withObservation(succeeded)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotLogAnalyticsWorkspace": {
+			ec: &external{client: &fake.MockWorkspacesClient{}, keys: &fake.MockSharedKeysClient{}},
+			want: want{
+				err: errors.New(errNotLogAnalyticsWorkspace),
+			},
+		},
+		"CreateFailed": {
+			ec: &external{
+				client: &fake.MockWorkspacesClient{
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ operationalinsights.Workspace) (operationalinsights.WorkspacesCreateOrUpdateFuture, error) {
+						return operationalinsights.WorkspacesCreateOrUpdateFuture{}, errBoom
+					},
+				},
+				keys: &fake.MockSharedKeysClient{},
+			},
+			mg: workspace(),
+			want: want{
+				mg:  workspace(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateLogAnalyticsWorkspace),
+			},
+		},
+		"Successful": {
+			ec: &external{
+				client: &fake.MockWorkspacesClient{
+					MockCreateOrUpdate: func(_ context.Context, rg string, n string, p operationalinsights.Workspace) (operationalinsights.WorkspacesCreateOrUpdateFuture, error) {
+						if rg != resourceGroupName || n != name || azure.ToString(p.Location) != location {
+							return operationalinsights.WorkspacesCreateOrUpdateFuture{}, errBoom
+						}
+						if p.WorkspaceProperties == nil || p.Sku == nil || string(p.Sku.Name) != insightsclients.DefaultWorkspaceSKUName {
+							return operationalinsights.WorkspacesCreateOrUpdateFuture{}, errBoom
+						}
+						return operationalinsights.WorkspacesCreateOrUpdateFuture{}, nil
+					},
+				},
+				keys: &fake.MockSharedKeysClient{},
+			},
+			mg: workspace(),
+			want: want{
+				mg: workspace(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotLogAnalyticsWorkspace": {
+			ec:   &external{client: &fake.MockWorkspacesClient{}, keys: &fake.MockSharedKeysClient{}},
+			want: errors.New(errNotLogAnalyticsWorkspace),
+		},
+		"UpdateFailed": {
+			ec: &external{
+				client: &fake.MockWorkspacesClient{
+					MockUpdate: func(_ context.Context, _ string, _ string, _ operationalinsights.WorkspacePatch) (operationalinsights.Workspace, error) {
+						return operationalinsights.Workspace{}, errBoom
+					},
+				},
+				keys: &fake.MockSharedKeysClient{},
+			},
+			mg:   workspace(),
+			want: errors.Wrap(errBoom, errUpdateLogAnalyticsWorkspace),
+		},
+		"Successful": {
+			ec: &external{
+				client: &fake.MockWorkspacesClient{
+					MockUpdate: func(_ context.Context, _ string, _ string, p operationalinsights.WorkspacePatch) (operationalinsights.Workspace, error) {
+						if p.WorkspaceProperties == nil || p.RetentionInDays == nil || *p.RetentionInDays != 90 {
+							return operationalinsights.Workspace{}, errBoom
+						}
+						return operationalinsights.Workspace{}, nil
+					},
+				},
+				keys: &fake.MockSharedKeysClient{},
+			},
+			mg: workspace(withRetention(90)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotLogAnalyticsWorkspace": {
+			ec:   &external{client: &fake.MockWorkspacesClient{}, keys: &fake.MockSharedKeysClient{}},
+			want: errors.New(errNotLogAnalyticsWorkspace),
+		},
+		"AlreadyGone": {
+			ec: &external{
+				client: &fake.MockWorkspacesClient{
+					MockDelete: func(_ context.Context, _ string, _ string, _ *bool) (operationalinsights.WorkspacesDeleteFuture, error) {
+						return operationalinsights.WorkspacesDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				},
+				keys: &fake.MockSharedKeysClient{},
+			},
+			mg: workspace(),
+		},
+		"DeleteFailed": {
+			ec: &external{
+				client: &fake.MockWorkspacesClient{
+					MockDelete: func(_ context.Context, _ string, _ string, _ *bool) (operationalinsights.WorkspacesDeleteFuture, error) {
+						return operationalinsights.WorkspacesDeleteFuture{}, errBoom
+					},
+				},
+				keys: &fake.MockSharedKeysClient{},
+			},
+			mg:   workspace(),
+			want: errors.Wrap(errBoom, errDeleteLogAnalyticsWorkspace),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
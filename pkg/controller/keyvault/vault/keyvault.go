@@ -0,0 +1,183 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2019-09-01/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2019-09-01/keyvault/keyvaultapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	keyvaultv1alpha1 "github.com/crossplane-contrib/provider-azure/apis/keyvault/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	vaultclients "github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/vault"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotKeyVault    = "managed resource is not a KeyVault"
+	errConnectFailed  = "cannot connect to Azure API"
+	errGetKeyVault    = "cannot get KeyVault"
+	errCreateKeyVault = "cannot create KeyVault"
+	errUpdateKeyVault = "cannot update KeyVault"
+	errDeleteKeyVault = "cannot delete KeyVault"
+)
+
+// Setup adds a controller that reconciles KeyVaults.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(keyvaultv1alpha1.KeyVaultGroupKind)
+	o = azure.ControllerOptions(keyvaultv1alpha1.KeyVaultGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient()), azure.NewDefaultTagger(mgr.GetClient(), resourceTags)}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&keyvaultv1alpha1.KeyVault{}).
+		Complete(azure.NewInstrumentedReconciler(keyvaultv1alpha1.KeyVaultGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(keyvaultv1alpha1.KeyVaultGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(keyvaultv1alpha1.KeyVaultGroupKind, bo, r, &connecter{kube: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+func resourceTags(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*keyvaultv1alpha1.KeyVault)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Tags
+}
+
+type connecter struct {
+	kube client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnectFailed)
+	}
+	cl := keyvault.NewVaultsClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{client: cl}, nil
+}
+
+type external struct {
+	client keyvaultapi.VaultsClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*keyvaultv1alpha1.KeyVault)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotKeyVault)
+	}
+
+	az, err := e.client.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetKeyVault)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	vaultclients.LateInitialize(&cr.Spec.ForProvider, az)
+
+	cr.Status.AtProvider = vaultclients.GenerateObservation(az)
+	cr.SetConditions(xpv1.Available())
+
+	cd := azure.ResourceConnectionDetails(cr.Status.AtProvider.ID, cr.Spec.ForProvider.Location)
+	cd[vaultclients.ConnectionSecretKeyVaultURI] = []byte(cr.Status.AtProvider.VaultURI)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        vaultclients.IsUpToDate(cr.Spec.ForProvider, az),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails:       cd,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*keyvaultv1alpha1.KeyVault)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotKeyVault)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	p, err := vaultclients.NewVaultParameters(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateKeyVault)
+	}
+	_, err = e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), p)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateKeyVault)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*keyvaultv1alpha1.KeyVault)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotKeyVault)
+	}
+
+	p, err := vaultclients.NewVaultPatchParameters(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateKeyVault)
+	}
+	_, err = e.client.Update(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), p)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateKeyVault)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*keyvaultv1alpha1.KeyVault)
+	if !ok {
+		return errors.New(errNotKeyVault)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteKeyVault)
+}
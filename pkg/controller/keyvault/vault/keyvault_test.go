@@ -0,0 +1,372 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2019-09-01/keyvault"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/gofrs/uuid"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/keyvault/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	vaultclients "github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/vault"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/vault/fake"
+)
+
+const (
+	name              = "coolVault"
+	resourceGroupName = "coolRG"
+	location          = "westeurope"
+	tenantID          = "7ec52e29-9f8c-4c2c-a9bc-2d2c8b1a5e0f"
+	vaultURI          = "https://coolVault.vault.azure.net/"
+	resourceID        = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.KeyVault/vaults/coolVault"
+)
+
+type keyVaultModifier func(*v1alpha1.KeyVault)
+
+func withConditions(c ...xpv1.Condition) keyVaultModifier {
+	return func(kv *v1alpha1.KeyVault) { kv.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.KeyVaultObservation) keyVaultModifier {
+	return func(kv *v1alpha1.KeyVault) { kv.Status.AtProvider = o }
+}
+
+func withTenantID(id string) keyVaultModifier {
+	return func(kv *v1alpha1.KeyVault) { kv.Spec.ForProvider.TenantID = id }
+}
+
+func withSoftDelete(b bool) keyVaultModifier {
+	return func(kv *v1alpha1.KeyVault) { kv.Spec.ForProvider.EnableSoftDelete = &b }
+}
+
+func keyVault(m ...keyVaultModifier) *v1alpha1.KeyVault {
+	kv := &v1alpha1.KeyVault{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.KeyVaultSpec{
+			ForProvider: v1alpha1.KeyVaultParameters{
+				ResourceGroupName: resourceGroupName,
+				Location:          location,
+				TenantID:          tenantID,
+				SKUName:           string(keyvault.Standard),
+			},
+		},
+	}
+	meta.SetExternalName(kv, name)
+	for _, f := range m {
+		f(kv)
+	}
+	return kv
+}
+
+func azureVault(softDelete bool) keyvault.Vault {
+	tid := uuid.FromStringOrNil(tenantID)
+	return keyvault.Vault{
+		ID:       azure.ToStringPtr(resourceID),
+		Location: azure.ToStringPtr(location),
+		Properties: &keyvault.VaultProperties{
+			TenantID:         &tid,
+			Sku:              &keyvault.Sku{Family: azure.ToStringPtr("A"), Name: keyvault.Standard},
+			VaultURI:         azure.ToStringPtr(vaultURI),
+			EnableSoftDelete: &softDelete,
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotKeyVault": {
+			ec: &external{client: &fake.MockVaultsClient{}},
+			want: want{
+				err: errors.New(errNotKeyVault),
+			},
+		},
+		"NotFound": {
+			ec: &external{client: &fake.MockVaultsClient{
+				MockGet: func(_ context.Context, _ string, _ string) (keyvault.Vault, error) {
+					return keyvault.Vault{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: keyVault(),
+			want: want{
+				mg: keyVault(),
+			},
+		},
+		"GetFailed": {
+			ec: &external{client: &fake.MockVaultsClient{
+				MockGet: func(_ context.Context, _ string, _ string) (keyvault.Vault, error) {
+					return keyvault.Vault{}, errBoom
+				},
+			}},
+			mg: keyVault(),
+			want: want{
+				mg:  keyVault(),
+				err: errors.Wrap(errBoom, errGetKeyVault),
+			},
+		},
+		"LateInitialized": {
+			ec: &external{client: &fake.MockVaultsClient{
+				MockGet: func(_ context.Context, _ string, _ string) (keyvault.Vault, error) {
+					return azureVault(true), nil
+				},
+			}},
+			mg: keyVault(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyResourceID:      []byte(resourceID),
+						azure.ConnectionSecretKeyLocation:        []byte(location),
+						azure.ConnectionSecretKeySubscriptionID:  []byte("sub"),
+						azure.ConnectionSecretKeyResourceGroup:   []byte(resourceGroupName),
+						vaultclients.ConnectionSecretKeyVaultURI: []byte(vaultURI),
+					},
+				},
+				mg: keyVault(
+					withSoftDelete(true),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.KeyVaultObservation{ID: resourceID, VaultURI: vaultURI}),
+				),
+			},
+		},
+		"NeedsUpdate": {
+			ec: &external{client: &fake.MockVaultsClient{
+				MockGet: func(_ context.Context, _ string, _ string) (keyvault.Vault, error) {
+					return azureVault(false), nil
+				},
+			}},
+			mg: keyVault(withSoftDelete(true)),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyResourceID:      []byte(resourceID),
+						azure.ConnectionSecretKeyLocation:        []byte(location),
+						azure.ConnectionSecretKeySubscriptionID:  []byte("sub"),
+						azure.ConnectionSecretKeyResourceGroup:   []byte(resourceGroupName),
+						vaultclients.ConnectionSecretKeyVaultURI: []byte(vaultURI),
+					},
+				},
+				mg: keyVault(
+					withSoftDelete(true),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.KeyVaultObservation{ID: resourceID, VaultURI: vaultURI}),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	_, errUUID := uuid.FromString("not-a-uuid")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotKeyVault": {
+			ec: &external{client: &fake.MockVaultsClient{}},
+			want: want{
+				err: errors.New(errNotKeyVault),
+			},
+		},
+		"InvalidTenantID": {
+			ec: &external{client: &fake.MockVaultsClient{}},
+			mg: keyVault(withTenantID("not-a-uuid")),
+			want: want{
+				mg:  keyVault(withTenantID("not-a-uuid"), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errors.Wrapf(errUUID, "invalid tenant ID %q", "not-a-uuid"), errCreateKeyVault),
+			},
+		},
+		"CreateFailed": {
+			ec: &external{client: &fake.MockVaultsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ keyvault.VaultCreateOrUpdateParameters) (keyvault.VaultsCreateOrUpdateFuture, error) {
+					return keyvault.VaultsCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			mg: keyVault(),
+			want: want{
+				mg:  keyVault(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateKeyVault),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockVaultsClient{
+				MockCreateOrUpdate: func(_ context.Context, rg string, n string, p keyvault.VaultCreateOrUpdateParameters) (keyvault.VaultsCreateOrUpdateFuture, error) {
+					if rg != resourceGroupName || n != name || azure.ToString(p.Location) != location {
+						return keyvault.VaultsCreateOrUpdateFuture{}, errBoom
+					}
+					return keyvault.VaultsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			mg: keyVault(),
+			want: want{
+				mg: keyVault(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotKeyVault": {
+			ec:   &external{client: &fake.MockVaultsClient{}},
+			want: errors.New(errNotKeyVault),
+		},
+		"UpdateFailed": {
+			ec: &external{client: &fake.MockVaultsClient{
+				MockUpdate: func(_ context.Context, _ string, _ string, _ keyvault.VaultPatchParameters) (keyvault.Vault, error) {
+					return keyvault.Vault{}, errBoom
+				},
+			}},
+			mg:   keyVault(),
+			want: errors.Wrap(errBoom, errUpdateKeyVault),
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockVaultsClient{
+				MockUpdate: func(_ context.Context, _ string, _ string, p keyvault.VaultPatchParameters) (keyvault.Vault, error) {
+					if p.Properties == nil || p.Properties.EnableSoftDelete == nil || !*p.Properties.EnableSoftDelete {
+						return keyvault.Vault{}, errBoom
+					}
+					return keyvault.Vault{}, nil
+				},
+			}},
+			mg: keyVault(withSoftDelete(true)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotKeyVault": {
+			ec:   &external{client: &fake.MockVaultsClient{}},
+			want: errors.New(errNotKeyVault),
+		},
+		"AlreadyGone": {
+			ec: &external{client: &fake.MockVaultsClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (autorest.Response, error) {
+					return autorest.Response{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: keyVault(),
+		},
+		"DeleteFailed": {
+			ec: &external{client: &fake.MockVaultsClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (autorest.Response, error) {
+					return autorest.Response{}, errBoom
+				},
+			}},
+			mg:   keyVault(),
+			want: errors.Wrap(errBoom, errDeleteKeyVault),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
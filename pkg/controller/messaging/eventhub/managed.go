@@ -0,0 +1,242 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventhub
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub/eventhubapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/messaging/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	eventhubclients "github.com/crossplane-contrib/provider-azure/pkg/clients/eventhub"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotEventHub                     = "managed resource is not an EventHub"
+	errConnectFailed                   = "cannot connect to Azure API"
+	errGetEventHub                     = "cannot get EventHub"
+	errGetAuthorizationRule            = "cannot get EventHub authorization rule"
+	errListKeys                        = "cannot list EventHub authorization rule keys"
+	errListConsumerGroups              = "cannot list EventHub consumer groups"
+	errCreateEventHub                  = "cannot create EventHub"
+	errUpdateEventHub                  = "cannot update EventHub"
+	errCreateOrUpdateAuthorizationRule = "cannot create or update EventHub authorization rule"
+	errCreateConsumerGroup             = "cannot create EventHub consumer group"
+	errDeleteConsumerGroup             = "cannot delete EventHub consumer group"
+	errDeleteEventHub                  = "cannot delete EventHub"
+)
+
+// Setup adds a controller that reconciles EventHubs.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.EventHubGroupKind)
+	o = azure.ControllerOptions(v1alpha1.EventHubGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient())}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&v1alpha1.EventHub{}).
+		Complete(azure.NewInstrumentedReconciler(v1alpha1.EventHubGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.EventHubGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1alpha1.EventHubGroupKind, bo, r, &connecter{kube: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+type connecter struct {
+	kube client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnectFailed)
+	}
+	hubs := eventhub.NewEventHubsClient(creds[azure.CredentialsKeySubscriptionID])
+	hubs.Authorizer = auth
+	groups := eventhub.NewConsumerGroupsClient(creds[azure.CredentialsKeySubscriptionID])
+	groups.Authorizer = auth
+	return &external{client: hubs, groups: groups}, nil
+}
+
+type external struct {
+	client eventhubapi.EventHubsClientAPI
+	groups eventhubapi.ConsumerGroupsClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.EventHub)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEventHub)
+	}
+
+	p := cr.Spec.ForProvider
+	az, err := e.client.Get(ctx, p.ResourceGroupName, p.NamespaceName, meta.GetExternalName(cr))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetEventHub)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	eventhubclients.LateInitializeEventHub(&cr.Spec.ForProvider, az)
+
+	cr.Status.AtProvider = eventhubclients.GenerateEventHubObservation(az)
+	cr.SetConditions(xpv1.Available())
+
+	groups, err := e.consumerGroups(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListConsumerGroups)
+	}
+	create, remove := eventhubclients.ConsumerGroupChanges(p.ConsumerGroups, groups)
+
+	o := managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        eventhubclients.IsEventHubUpToDate(cr.Spec.ForProvider, az) && len(create) == 0 && len(remove) == 0,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}
+
+	// The authorization rule is created together with the Event Hub, so a
+	// missing rule is repaired by an update.
+	rule, err := e.client.GetAuthorizationRule(ctx, p.ResourceGroupName, p.NamespaceName, meta.GetExternalName(cr), eventhubclients.EventHubAuthorizationRuleName)
+	if azure.IsNotFound(err) {
+		o.ResourceUpToDate = false
+		return o, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetAuthorizationRule)
+	}
+	o.ResourceUpToDate = o.ResourceUpToDate && eventhubclients.IsAuthorizationRuleUpToDate(p.AuthorizationRights, rule)
+
+	keys, err := e.client.ListKeys(ctx, p.ResourceGroupName, p.NamespaceName, meta.GetExternalName(cr), eventhubclients.EventHubAuthorizationRuleName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListKeys)
+	}
+	o.ConnectionDetails = eventhubclients.ConnectionDetails(keys)
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.EventHub)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEventHub)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	p := cr.Spec.ForProvider
+	if _, err := e.client.CreateOrUpdate(ctx, p.ResourceGroupName, p.NamespaceName, meta.GetExternalName(cr), eventhubclients.NewEventHubParameters(p)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateEventHub)
+	}
+	return managed.ExternalCreation{}, e.reconcileChildren(ctx, cr, nil)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.EventHub)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEventHub)
+	}
+
+	p := cr.Spec.ForProvider
+	if _, err := e.client.CreateOrUpdate(ctx, p.ResourceGroupName, p.NamespaceName, meta.GetExternalName(cr), eventhubclients.NewEventHubParameters(p)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateEventHub)
+	}
+	groups, err := e.consumerGroups(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListConsumerGroups)
+	}
+	return managed.ExternalUpdate{}, e.reconcileChildren(ctx, cr, groups)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.EventHub)
+	if !ok {
+		return errors.New(errNotEventHub)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, cr.Spec.ForProvider.NamespaceName, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteEventHub)
+}
+
+// consumerGroups returns the names of the consumer groups of the supplied
+// EventHub.
+func (e *external) consumerGroups(ctx context.Context, cr *v1alpha1.EventHub) ([]string, error) {
+	var names []string
+	l, err := e.groups.ListByEventHubComplete(ctx, cr.Spec.ForProvider.ResourceGroupName, cr.Spec.ForProvider.NamespaceName, meta.GetExternalName(cr), nil, nil)
+	for ; err == nil && l.NotDone(); err = l.NextWithContext(ctx) {
+		names = append(names, azure.ToString(l.Value().Name))
+	}
+	return names, err
+}
+
+// reconcileChildren creates or updates the authorization rule of the supplied
+// EventHub and creates and deletes consumer groups so that the supplied
+// observed consumer groups match the desired ones.
+func (e *external) reconcileChildren(ctx context.Context, cr *v1alpha1.EventHub, observed []string) error {
+	p := cr.Spec.ForProvider
+	if _, err := e.client.CreateOrUpdateAuthorizationRule(ctx, p.ResourceGroupName, p.NamespaceName, meta.GetExternalName(cr), eventhubclients.EventHubAuthorizationRuleName, eventhubclients.NewAuthorizationRule(p.AuthorizationRights)); err != nil {
+		return errors.Wrap(err, errCreateOrUpdateAuthorizationRule)
+	}
+	create, remove := eventhubclients.ConsumerGroupChanges(p.ConsumerGroups, observed)
+	for _, n := range create {
+		if _, err := e.groups.CreateOrUpdate(ctx, p.ResourceGroupName, p.NamespaceName, meta.GetExternalName(cr), n, eventhub.ConsumerGroup{}); err != nil {
+			return errors.Wrap(err, errCreateConsumerGroup)
+		}
+	}
+	for _, n := range remove {
+		if _, err := e.groups.Delete(ctx, p.ResourceGroupName, p.NamespaceName, meta.GetExternalName(cr), n); resource.Ignore(azure.IsNotFound, err) != nil {
+			return errors.Wrap(err, errDeleteConsumerGroup)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,537 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventhub
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/messaging/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	eventhubclients "github.com/crossplane-contrib/provider-azure/pkg/clients/eventhub"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/eventhub/fake"
+)
+
+const (
+	name              = "coolHub"
+	resourceGroupName = "coolRG"
+	namespaceName     = "coolNamespace"
+	resourceID        = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.EventHub/namespaces/coolNamespace/eventhubs/coolHub"
+	connectionString  = "Endpoint=sb://coolNamespace.servicebus.windows.net/;SharedAccessKeyName=crossplane;SharedAccessKey=key;EntityPath=coolHub"
+	primaryKey        = "key"
+)
+
+type eventHubModifier func(*v1alpha1.EventHub)
+
+func withConditions(c ...xpv1.Condition) eventHubModifier {
+	return func(eh *v1alpha1.EventHub) { eh.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.EventHubObservation) eventHubModifier {
+	return func(eh *v1alpha1.EventHub) { eh.Status.AtProvider = o }
+}
+
+func withPartitionCount(c int64) eventHubModifier {
+	return func(eh *v1alpha1.EventHub) { eh.Spec.ForProvider.PartitionCount = &c }
+}
+
+func withMessageRetention(d int64) eventHubModifier {
+	return func(eh *v1alpha1.EventHub) { eh.Spec.ForProvider.MessageRetentionInDays = &d }
+}
+
+func withConsumerGroups(g ...string) eventHubModifier {
+	return func(eh *v1alpha1.EventHub) { eh.Spec.ForProvider.ConsumerGroups = g }
+}
+
+func eventHub(m ...eventHubModifier) *v1alpha1.EventHub {
+	eh := &v1alpha1.EventHub{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.EventHubSpec{
+			ForProvider: v1alpha1.EventHubParameters{
+				ResourceGroupName: resourceGroupName,
+				NamespaceName:     namespaceName,
+			},
+		},
+	}
+	meta.SetExternalName(eh, name)
+	for _, f := range m {
+		f(eh)
+	}
+	return eh
+}
+
+func azureEventHub(retention int64) eventhub.Model {
+	return eventhub.Model{
+		ID: azure.ToStringPtr(resourceID),
+		Properties: &eventhub.Properties{
+			PartitionIds:           &[]string{"0", "1"},
+			PartitionCount:         to.Int64Ptr(2),
+			MessageRetentionInDays: &retention,
+			Status:                 eventhub.Active,
+		},
+	}
+}
+
+func azureRule(rights ...eventhub.AccessRights) eventhub.AuthorizationRule {
+	return eventhub.AuthorizationRule{
+		AuthorizationRuleProperties: &eventhub.AuthorizationRuleProperties{Rights: &rights},
+	}
+}
+
+func consumerGroups(names ...string) eventhub.ConsumerGroupListResultIterator {
+	v := make([]eventhub.ConsumerGroup, len(names))
+	for i, n := range names {
+		v[i] = eventhub.ConsumerGroup{Name: azure.ToStringPtr(n)}
+	}
+	return eventhub.NewConsumerGroupListResultIterator(eventhub.NewConsumerGroupListResultPage(
+		eventhub.ConsumerGroupListResult{Value: &v},
+		func(context.Context, eventhub.ConsumerGroupListResult) (eventhub.ConsumerGroupListResult, error) {
+			return eventhub.ConsumerGroupListResult{}, nil
+		},
+	))
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	observation := v1alpha1.EventHubObservation{ID: resourceID, Status: string(eventhub.Active), PartitionIDs: []string{"0", "1"}}
+	listDefault := func(_ context.Context, _ string, _ string, _ string, _ *int32, _ *int32) (eventhub.ConsumerGroupListResultIterator, error) {
+		return consumerGroups(eventhubclients.DefaultConsumerGroupName), nil
+	}
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotEventHub": {
+			ec: &external{client: &fake.MockEventHubsClient{}, groups: &fake.MockConsumerGroupsClient{}},
+			want: want{
+				err: errors.New(errNotEventHub),
+			},
+		},
+		"NotFound": {
+			ec: &external{
+				client: &fake.MockEventHubsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (eventhub.Model, error) {
+						return eventhub.Model{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				},
+				groups: &fake.MockConsumerGroupsClient{},
+			},
+			mg: eventHub(),
+			want: want{
+				mg: eventHub(),
+			},
+		},
+		"GetFailed": {
+			ec: &external{
+				client: &fake.MockEventHubsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (eventhub.Model, error) {
+						return eventhub.Model{}, errBoom
+					},
+				},
+				groups: &fake.MockConsumerGroupsClient{},
+			},
+			mg: eventHub(),
+			want: want{
+				mg:  eventHub(),
+				err: errors.Wrap(errBoom, errGetEventHub),
+			},
+		},
+		"ListConsumerGroupsFailed": {
+			ec: &external{
+				client: &fake.MockEventHubsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (eventhub.Model, error) {
+						return azureEventHub(1), nil
+					},
+				},
+				groups: &fake.MockConsumerGroupsClient{
+					MockListByEventHubComplete: func(_ context.Context, _ string, _ string, _ string, _ *int32, _ *int32) (eventhub.ConsumerGroupListResultIterator, error) {
+						return eventhub.ConsumerGroupListResultIterator{}, errBoom
+					},
+				},
+			},
+			mg: eventHub(withPartitionCount(2), withMessageRetention(1)),
+			want: want{
+				mg:  eventHub(withPartitionCount(2), withMessageRetention(1), withConditions(xpv1.Available()), withObservation(observation)),
+				err: errors.Wrap(errBoom, errListConsumerGroups),
+			},
+		},
+		"ConsumerGroupMissing": {
+			ec: &external{
+				client: &fake.MockEventHubsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (eventhub.Model, error) {
+						return azureEventHub(1), nil
+					},
+					MockGetAuthorizationRule: func(_ context.Context, _ string, _ string, _ string, _ string) (eventhub.AuthorizationRule, error) {
+						return azureRule(eventhub.Listen, eventhub.SendEnumValue), nil
+					},
+					MockListKeys: func(_ context.Context, _ string, _ string, _ string, _ string) (eventhub.AccessKeys, error) {
+						return eventhub.AccessKeys{}, nil
+					},
+				},
+				groups: &fake.MockConsumerGroupsClient{MockListByEventHubComplete: listDefault},
+			},
+			mg: eventHub(withPartitionCount(2), withMessageRetention(1), withConsumerGroups("analytics")),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: eventhubclients.ConnectionDetails(eventhub.AccessKeys{}),
+				},
+				mg: eventHub(withPartitionCount(2), withMessageRetention(1), withConsumerGroups("analytics"), withConditions(xpv1.Available()), withObservation(observation)),
+			},
+		},
+		"AuthorizationRuleMissing": {
+			ec: &external{
+				client: &fake.MockEventHubsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (eventhub.Model, error) {
+						return azureEventHub(1), nil
+					},
+					MockGetAuthorizationRule: func(_ context.Context, _ string, _ string, _ string, _ string) (eventhub.AuthorizationRule, error) {
+						return eventhub.AuthorizationRule{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				},
+				groups: &fake.MockConsumerGroupsClient{MockListByEventHubComplete: listDefault},
+			},
+			mg: eventHub(withPartitionCount(2), withMessageRetention(1)),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				mg: eventHub(withPartitionCount(2), withMessageRetention(1), withConditions(xpv1.Available()), withObservation(observation)),
+			},
+		},
+		"LateInitialized": {
+			ec: &external{
+				client: &fake.MockEventHubsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (eventhub.Model, error) {
+						return azureEventHub(1), nil
+					},
+					MockGetAuthorizationRule: func(_ context.Context, _ string, _ string, _ string, _ string) (eventhub.AuthorizationRule, error) {
+						return azureRule(eventhub.SendEnumValue, eventhub.Listen), nil
+					},
+					MockListKeys: func(_ context.Context, _ string, _ string, _ string, rule string) (eventhub.AccessKeys, error) {
+						if rule != eventhubclients.EventHubAuthorizationRuleName {
+							return eventhub.AccessKeys{}, errBoom
+						}
+						return eventhub.AccessKeys{
+							PrimaryConnectionString: azure.ToStringPtr(connectionString),
+							PrimaryKey:              azure.ToStringPtr(primaryKey),
+							KeyName:                 azure.ToStringPtr(rule),
+						}, nil
+					},
+				},
+				groups: &fake.MockConsumerGroupsClient{MockListByEventHubComplete: listDefault},
+			},
+			mg: eventHub(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						eventhubclients.ConnectionSecretKeyPrimaryConnectionString:   []byte(connectionString),
+						eventhubclients.ConnectionSecretKeySecondaryConnectionString: []byte(""),
+						eventhubclients.ConnectionSecretKeyPrimaryKey:                []byte(primaryKey),
+						eventhubclients.ConnectionSecretKeySecondaryKey:              []byte(""),
+						eventhubclients.ConnectionSecretKeySharedAccessKeyName:       []byte(eventhubclients.EventHubAuthorizationRuleName),
+					},
+				},
+				mg: eventHub(withPartitionCount(2), withMessageRetention(1), withConditions(xpv1.Available()), withObservation(observation)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotEventHub": {
+			ec: &external{client: &fake.MockEventHubsClient{}, groups: &fake.MockConsumerGroupsClient{}},
+			want: want{
+				err: errors.New(errNotEventHub),
+			},
+		},
+		"CreateFailed": {
+			ec: &external{
+				client: &fake.MockEventHubsClient{
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ eventhub.Model) (eventhub.Model, error) {
+						return eventhub.Model{}, errBoom
+					},
+				},
+				groups: &fake.MockConsumerGroupsClient{},
+			},
+			mg: eventHub(),
+			want: want{
+				mg:  eventHub(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateEventHub),
+			},
+		},
+		"CreateConsumerGroupFailed": {
+			ec: &external{
+				client: &fake.MockEventHubsClient{
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ eventhub.Model) (eventhub.Model, error) {
+						return eventhub.Model{}, nil
+					},
+					MockCreateOrUpdateAuthorizationRule: func(_ context.Context, _ string, _ string, _ string, _ string, _ eventhub.AuthorizationRule) (eventhub.AuthorizationRule, error) {
+						return eventhub.AuthorizationRule{}, nil
+					},
+				},
+				groups: &fake.MockConsumerGroupsClient{
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ string, _ eventhub.ConsumerGroup) (eventhub.ConsumerGroup, error) {
+						return eventhub.ConsumerGroup{}, errBoom
+					},
+				},
+			},
+			mg: eventHub(withConsumerGroups("analytics")),
+			want: want{
+				mg:  eventHub(withConsumerGroups("analytics"), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateConsumerGroup),
+			},
+		},
+		"Successful": {
+			ec: &external{
+				client: &fake.MockEventHubsClient{
+					MockCreateOrUpdate: func(_ context.Context, rg string, ns string, n string, p eventhub.Model) (eventhub.Model, error) {
+						if rg != resourceGroupName || ns != namespaceName || n != name || to.Int64(p.PartitionCount) != 4 {
+							return eventhub.Model{}, errBoom
+						}
+						return eventhub.Model{}, nil
+					},
+					MockCreateOrUpdateAuthorizationRule: func(_ context.Context, _ string, _ string, _ string, rule string, _ eventhub.AuthorizationRule) (eventhub.AuthorizationRule, error) {
+						if rule != eventhubclients.EventHubAuthorizationRuleName {
+							return eventhub.AuthorizationRule{}, errBoom
+						}
+						return eventhub.AuthorizationRule{}, nil
+					},
+				},
+				groups: &fake.MockConsumerGroupsClient{
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, g string, _ eventhub.ConsumerGroup) (eventhub.ConsumerGroup, error) {
+						if g != "analytics" {
+							return eventhub.ConsumerGroup{}, errBoom
+						}
+						return eventhub.ConsumerGroup{}, nil
+					},
+				},
+			},
+			mg: eventHub(withPartitionCount(4), withConsumerGroups("analytics")),
+			want: want{
+				mg: eventHub(withPartitionCount(4), withConsumerGroups("analytics"), withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotEventHub": {
+			ec:   &external{client: &fake.MockEventHubsClient{}, groups: &fake.MockConsumerGroupsClient{}},
+			want: errors.New(errNotEventHub),
+		},
+		"UpdateFailed": {
+			ec: &external{
+				client: &fake.MockEventHubsClient{
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ eventhub.Model) (eventhub.Model, error) {
+						return eventhub.Model{}, errBoom
+					},
+				},
+				groups: &fake.MockConsumerGroupsClient{},
+			},
+			mg:   eventHub(),
+			want: errors.Wrap(errBoom, errUpdateEventHub),
+		},
+		"DeleteConsumerGroupFailed": {
+			ec: &external{
+				client: &fake.MockEventHubsClient{
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ eventhub.Model) (eventhub.Model, error) {
+						return eventhub.Model{}, nil
+					},
+					MockCreateOrUpdateAuthorizationRule: func(_ context.Context, _ string, _ string, _ string, _ string, _ eventhub.AuthorizationRule) (eventhub.AuthorizationRule, error) {
+						return eventhub.AuthorizationRule{}, nil
+					},
+				},
+				groups: &fake.MockConsumerGroupsClient{
+					MockListByEventHubComplete: func(_ context.Context, _ string, _ string, _ string, _ *int32, _ *int32) (eventhub.ConsumerGroupListResultIterator, error) {
+						return consumerGroups(eventhubclients.DefaultConsumerGroupName, "stale"), nil
+					},
+					MockDelete: func(_ context.Context, _ string, _ string, _ string, _ string) (autorest.Response, error) {
+						return autorest.Response{}, errBoom
+					},
+				},
+			},
+			mg:   eventHub(),
+			want: errors.Wrap(errBoom, errDeleteConsumerGroup),
+		},
+		"Successful": {
+			ec: &external{
+				client: &fake.MockEventHubsClient{
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, p eventhub.Model) (eventhub.Model, error) {
+						if p.Properties == nil || to.Int64(p.MessageRetentionInDays) != 7 {
+							return eventhub.Model{}, errBoom
+						}
+						return eventhub.Model{}, nil
+					},
+					MockCreateOrUpdateAuthorizationRule: func(_ context.Context, _ string, _ string, _ string, _ string, _ eventhub.AuthorizationRule) (eventhub.AuthorizationRule, error) {
+						return eventhub.AuthorizationRule{}, nil
+					},
+				},
+				groups: &fake.MockConsumerGroupsClient{
+					MockListByEventHubComplete: func(_ context.Context, _ string, _ string, _ string, _ *int32, _ *int32) (eventhub.ConsumerGroupListResultIterator, error) {
+						return consumerGroups(eventhubclients.DefaultConsumerGroupName, "stale"), nil
+					},
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, g string, _ eventhub.ConsumerGroup) (eventhub.ConsumerGroup, error) {
+						if g != "analytics" {
+							return eventhub.ConsumerGroup{}, errBoom
+						}
+						return eventhub.ConsumerGroup{}, nil
+					},
+					MockDelete: func(_ context.Context, _ string, _ string, _ string, g string) (autorest.Response, error) {
+						if g != "stale" {
+							return autorest.Response{}, errBoom
+						}
+						return autorest.Response{}, nil
+					},
+				},
+			},
+			mg: eventHub(withMessageRetention(7), withConsumerGroups("analytics")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotEventHub": {
+			ec:   &external{client: &fake.MockEventHubsClient{}, groups: &fake.MockConsumerGroupsClient{}},
+			want: errors.New(errNotEventHub),
+		},
+		"AlreadyGone": {
+			ec: &external{
+				client: &fake.MockEventHubsClient{
+					MockDelete: func(_ context.Context, _ string, _ string, _ string) (autorest.Response, error) {
+						return autorest.Response{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+					},
+				},
+				groups: &fake.MockConsumerGroupsClient{},
+			},
+			mg: eventHub(),
+		},
+		"DeleteFailed": {
+			ec: &external{
+				client: &fake.MockEventHubsClient{
+					MockDelete: func(_ context.Context, _ string, _ string, _ string) (autorest.Response, error) {
+						return autorest.Response{}, errBoom
+					},
+				},
+				groups: &fake.MockConsumerGroupsClient{},
+			},
+			mg:   eventHub(),
+			want: errors.Wrap(errBoom, errDeleteEventHub),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,395 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventhubnamespace
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/messaging/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	eventhubclients "github.com/crossplane-contrib/provider-azure/pkg/clients/eventhub"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/eventhub/fake"
+)
+
+const (
+	name              = "coolNamespace"
+	resourceGroupName = "coolRG"
+	location          = "westeurope"
+	endpoint          = "https://coolNamespace.eventhub.windows.net:443/"
+	resourceID        = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.EventHub/namespaces/coolNamespace"
+	connectionString  = "Endpoint=sb://coolNamespace.eventhub.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=key"
+	primaryKey        = "key"
+)
+
+type namespaceModifier func(*v1alpha1.EventHubNamespace)
+
+func withConditions(c ...xpv1.Condition) namespaceModifier {
+	return func(ns *v1alpha1.EventHubNamespace) { ns.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.EventHubNamespaceObservation) namespaceModifier {
+	return func(ns *v1alpha1.EventHubNamespace) { ns.Status.AtProvider = o }
+}
+
+func withAutoInflate(max int32) namespaceModifier {
+	return func(ns *v1alpha1.EventHubNamespace) {
+		ns.Spec.ForProvider.AutoInflateEnabled = azure.ToBoolPtr(true)
+		ns.Spec.ForProvider.MaximumThroughputUnits = &max
+	}
+}
+
+func withCapacity(c int32) namespaceModifier {
+	return func(ns *v1alpha1.EventHubNamespace) { ns.Spec.ForProvider.SKU.Capacity = &c }
+}
+
+func namespace(m ...namespaceModifier) *v1alpha1.EventHubNamespace {
+	ns := &v1alpha1.EventHubNamespace{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.EventHubNamespaceSpec{
+			ForProvider: v1alpha1.EventHubNamespaceParameters{
+				ResourceGroupName: resourceGroupName,
+				Location:          location,
+				SKU:               v1alpha1.EventHubSKU{Name: string(eventhub.Standard)},
+			},
+		},
+	}
+	meta.SetExternalName(ns, name)
+	for _, f := range m {
+		f(ns)
+	}
+	return ns
+}
+
+func azureNamespace(state string) eventhub.EHNamespace {
+	return eventhub.EHNamespace{
+		ID:       azure.ToStringPtr(resourceID),
+		Location: azure.ToStringPtr(location),
+		Sku:      &eventhub.Sku{Name: eventhub.Standard, Tier: eventhub.SkuTierStandard, Capacity: azure.ToInt32Ptr(1)},
+		EHNamespaceProperties: &eventhub.EHNamespaceProperties{
+			ProvisioningState:  azure.ToStringPtr(state),
+			ServiceBusEndpoint: azure.ToStringPtr(endpoint),
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotEventHubNamespace": {
+			ec: &external{client: &fake.MockNamespacesClient{}},
+			want: want{
+				err: errors.New(errNotEventHubNamespace),
+			},
+		},
+		"NotFound": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (eventhub.EHNamespace, error) {
+					return eventhub.EHNamespace{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: namespace(),
+			want: want{
+				mg: namespace(),
+			},
+		},
+		"GetFailed": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (eventhub.EHNamespace, error) {
+					return eventhub.EHNamespace{}, errBoom
+				},
+			}},
+			mg: namespace(),
+			want: want{
+				mg:  namespace(),
+				err: errors.Wrap(errBoom, errGetEventHubNamespace),
+			},
+		},
+		"Provisioning": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (eventhub.EHNamespace, error) {
+					return azureNamespace("Created"), nil
+				},
+			}},
+			mg: namespace(withCapacity(1)),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyResourceID:     []byte(resourceID),
+						azure.ConnectionSecretKeyLocation:       []byte(location),
+						azure.ConnectionSecretKeySubscriptionID: []byte("sub"),
+						azure.ConnectionSecretKeyResourceGroup:  []byte(resourceGroupName),
+					},
+				},
+				mg: namespace(
+					withCapacity(1),
+					withConditions(xpv1.Unavailable()),
+					withObservation(v1alpha1.EventHubNamespaceObservation{ID: resourceID, ProvisioningState: "Created", ServiceBusEndpoint: endpoint}),
+				),
+			},
+		},
+		"ListKeysFailed": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (eventhub.EHNamespace, error) {
+					return azureNamespace(eventhubclients.ProvisioningStateSucceeded), nil
+				},
+				MockListKeys: func(_ context.Context, _ string, _ string, _ string) (eventhub.AccessKeys, error) {
+					return eventhub.AccessKeys{}, errBoom
+				},
+			}},
+			mg: namespace(withCapacity(1)),
+			want: want{
+				mg: namespace(
+					withCapacity(1),
+					withObservation(v1alpha1.EventHubNamespaceObservation{ID: resourceID, ProvisioningState: eventhubclients.ProvisioningStateSucceeded, ServiceBusEndpoint: endpoint}),
+				),
+				err: errors.Wrap(errBoom, errListKeys),
+			},
+		},
+		"LateInitialized": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (eventhub.EHNamespace, error) {
+					return azureNamespace(eventhubclients.ProvisioningStateSucceeded), nil
+				},
+				MockListKeys: func(_ context.Context, _ string, _ string, rule string) (eventhub.AccessKeys, error) {
+					if rule != eventhubclients.NamespaceAuthorizationRuleName {
+						return eventhub.AccessKeys{}, errBoom
+					}
+					return eventhub.AccessKeys{
+						PrimaryConnectionString: azure.ToStringPtr(connectionString),
+						PrimaryKey:              azure.ToStringPtr(primaryKey),
+						KeyName:                 azure.ToStringPtr(rule),
+					}, nil
+				},
+			}},
+			mg: namespace(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyResourceID:                          []byte(resourceID),
+						azure.ConnectionSecretKeyLocation:                            []byte(location),
+						azure.ConnectionSecretKeySubscriptionID:                      []byte("sub"),
+						azure.ConnectionSecretKeyResourceGroup:                       []byte(resourceGroupName),
+						xpv1.ResourceCredentialsSecretEndpointKey:                    []byte(endpoint),
+						eventhubclients.ConnectionSecretKeyPrimaryConnectionString:   []byte(connectionString),
+						eventhubclients.ConnectionSecretKeySecondaryConnectionString: []byte(""),
+						eventhubclients.ConnectionSecretKeyPrimaryKey:                []byte(primaryKey),
+						eventhubclients.ConnectionSecretKeySecondaryKey:              []byte(""),
+						eventhubclients.ConnectionSecretKeySharedAccessKeyName:       []byte(eventhubclients.NamespaceAuthorizationRuleName),
+					},
+				},
+				mg: namespace(
+					withCapacity(1),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.EventHubNamespaceObservation{ID: resourceID, ProvisioningState: eventhubclients.ProvisioningStateSucceeded, ServiceBusEndpoint: endpoint}),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotEventHubNamespace": {
+			ec: &external{client: &fake.MockNamespacesClient{}},
+			want: want{
+				err: errors.New(errNotEventHubNamespace),
+			},
+		},
+		"CreateFailed": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ eventhub.EHNamespace) (eventhub.NamespacesCreateOrUpdateFuture, error) {
+					return eventhub.NamespacesCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			mg: namespace(),
+			want: want{
+				mg:  namespace(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateEventHubNamespace),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockCreateOrUpdate: func(_ context.Context, rg string, n string, p eventhub.EHNamespace) (eventhub.NamespacesCreateOrUpdateFuture, error) {
+					if rg != resourceGroupName || n != name || azure.ToString(p.Location) != location {
+						return eventhub.NamespacesCreateOrUpdateFuture{}, errBoom
+					}
+					return eventhub.NamespacesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			mg: namespace(),
+			want: want{
+				mg: namespace(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotEventHubNamespace": {
+			ec:   &external{client: &fake.MockNamespacesClient{}},
+			want: errors.New(errNotEventHubNamespace),
+		},
+		"UpdateFailed": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockUpdate: func(_ context.Context, _ string, _ string, _ eventhub.EHNamespace) (eventhub.EHNamespace, error) {
+					return eventhub.EHNamespace{}, errBoom
+				},
+			}},
+			mg:   namespace(),
+			want: errors.Wrap(errBoom, errUpdateEventHubNamespace),
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockUpdate: func(_ context.Context, _ string, _ string, p eventhub.EHNamespace) (eventhub.EHNamespace, error) {
+					if p.EHNamespaceProperties == nil || !azure.ToBool(p.IsAutoInflateEnabled) || p.MaximumThroughputUnits == nil || *p.MaximumThroughputUnits != 10 {
+						return eventhub.EHNamespace{}, errBoom
+					}
+					return eventhub.EHNamespace{}, nil
+				},
+			}},
+			mg: namespace(withAutoInflate(10)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotEventHubNamespace": {
+			ec:   &external{client: &fake.MockNamespacesClient{}},
+			want: errors.New(errNotEventHubNamespace),
+		},
+		"AlreadyGone": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (eventhub.NamespacesDeleteFuture, error) {
+					return eventhub.NamespacesDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: namespace(),
+		},
+		"DeleteFailed": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (eventhub.NamespacesDeleteFuture, error) {
+					return eventhub.NamespacesDeleteFuture{}, errBoom
+				},
+			}},
+			mg:   namespace(),
+			want: errors.Wrap(errBoom, errDeleteEventHubNamespace),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
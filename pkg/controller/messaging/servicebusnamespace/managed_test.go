@@ -0,0 +1,392 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicebusnamespace
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/messaging/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	servicebusclients "github.com/crossplane-contrib/provider-azure/pkg/clients/servicebus"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/servicebus/fake"
+)
+
+const (
+	name              = "coolNamespace"
+	resourceGroupName = "coolRG"
+	location          = "westeurope"
+	endpoint          = "https://coolNamespace.servicebus.windows.net:443/"
+	resourceID        = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.ServiceBus/namespaces/coolNamespace"
+	connectionString  = "Endpoint=sb://coolNamespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=key"
+	primaryKey        = "key"
+)
+
+type namespaceModifier func(*v1alpha1.ServiceBusNamespace)
+
+func withConditions(c ...xpv1.Condition) namespaceModifier {
+	return func(ns *v1alpha1.ServiceBusNamespace) { ns.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.ServiceBusNamespaceObservation) namespaceModifier {
+	return func(ns *v1alpha1.ServiceBusNamespace) { ns.Status.AtProvider = o }
+}
+
+func withSKU(s string) namespaceModifier {
+	return func(ns *v1alpha1.ServiceBusNamespace) { ns.Spec.ForProvider.SKU.Name = s }
+}
+
+func withCapacity(c int32) namespaceModifier {
+	return func(ns *v1alpha1.ServiceBusNamespace) { ns.Spec.ForProvider.SKU.Capacity = &c }
+}
+
+func namespace(m ...namespaceModifier) *v1alpha1.ServiceBusNamespace {
+	ns := &v1alpha1.ServiceBusNamespace{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.ServiceBusNamespaceSpec{
+			ForProvider: v1alpha1.ServiceBusNamespaceParameters{
+				ResourceGroupName: resourceGroupName,
+				Location:          location,
+				SKU:               v1alpha1.ServiceBusSKU{Name: string(servicebus.Standard)},
+			},
+		},
+	}
+	meta.SetExternalName(ns, name)
+	for _, f := range m {
+		f(ns)
+	}
+	return ns
+}
+
+func azureNamespace(state string) servicebus.SBNamespace {
+	return servicebus.SBNamespace{
+		ID:       azure.ToStringPtr(resourceID),
+		Location: azure.ToStringPtr(location),
+		Sku:      &servicebus.SBSku{Name: servicebus.Standard, Tier: servicebus.SkuTierStandard, Capacity: azure.ToInt32Ptr(1)},
+		SBNamespaceProperties: &servicebus.SBNamespaceProperties{
+			ProvisioningState:  azure.ToStringPtr(state),
+			ServiceBusEndpoint: azure.ToStringPtr(endpoint),
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotServiceBusNamespace": {
+			ec: &external{client: &fake.MockNamespacesClient{}},
+			want: want{
+				err: errors.New(errNotServiceBusNamespace),
+			},
+		},
+		"NotFound": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (servicebus.SBNamespace, error) {
+					return servicebus.SBNamespace{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: namespace(),
+			want: want{
+				mg: namespace(),
+			},
+		},
+		"GetFailed": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (servicebus.SBNamespace, error) {
+					return servicebus.SBNamespace{}, errBoom
+				},
+			}},
+			mg: namespace(),
+			want: want{
+				mg:  namespace(),
+				err: errors.Wrap(errBoom, errGetServiceBusNamespace),
+			},
+		},
+		"Provisioning": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (servicebus.SBNamespace, error) {
+					return azureNamespace("Created"), nil
+				},
+			}},
+			mg: namespace(withCapacity(1)),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyResourceID:     []byte(resourceID),
+						azure.ConnectionSecretKeyLocation:       []byte(location),
+						azure.ConnectionSecretKeySubscriptionID: []byte("sub"),
+						azure.ConnectionSecretKeyResourceGroup:  []byte(resourceGroupName),
+					},
+				},
+				mg: namespace(
+					withCapacity(1),
+					withConditions(xpv1.Unavailable()),
+					withObservation(v1alpha1.ServiceBusNamespaceObservation{ID: resourceID, ProvisioningState: "Created", ServiceBusEndpoint: endpoint}),
+				),
+			},
+		},
+		"ListKeysFailed": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (servicebus.SBNamespace, error) {
+					return azureNamespace(servicebusclients.ProvisioningStateSucceeded), nil
+				},
+				MockListKeys: func(_ context.Context, _ string, _ string, _ string) (servicebus.AccessKeys, error) {
+					return servicebus.AccessKeys{}, errBoom
+				},
+			}},
+			mg: namespace(withCapacity(1)),
+			want: want{
+				mg: namespace(
+					withCapacity(1),
+					withObservation(v1alpha1.ServiceBusNamespaceObservation{ID: resourceID, ProvisioningState: servicebusclients.ProvisioningStateSucceeded, ServiceBusEndpoint: endpoint}),
+				),
+				err: errors.Wrap(errBoom, errListKeys),
+			},
+		},
+		"LateInitialized": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockGet: func(_ context.Context, _ string, _ string) (servicebus.SBNamespace, error) {
+					return azureNamespace(servicebusclients.ProvisioningStateSucceeded), nil
+				},
+				MockListKeys: func(_ context.Context, _ string, _ string, rule string) (servicebus.AccessKeys, error) {
+					if rule != servicebusclients.NamespaceAuthorizationRuleName {
+						return servicebus.AccessKeys{}, errBoom
+					}
+					return servicebus.AccessKeys{
+						PrimaryConnectionString: azure.ToStringPtr(connectionString),
+						PrimaryKey:              azure.ToStringPtr(primaryKey),
+						KeyName:                 azure.ToStringPtr(rule),
+					}, nil
+				},
+			}},
+			mg: namespace(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azure.ConnectionSecretKeyResourceID:                            []byte(resourceID),
+						azure.ConnectionSecretKeyLocation:                              []byte(location),
+						azure.ConnectionSecretKeySubscriptionID:                        []byte("sub"),
+						azure.ConnectionSecretKeyResourceGroup:                         []byte(resourceGroupName),
+						xpv1.ResourceCredentialsSecretEndpointKey:                      []byte(endpoint),
+						servicebusclients.ConnectionSecretKeyPrimaryConnectionString:   []byte(connectionString),
+						servicebusclients.ConnectionSecretKeySecondaryConnectionString: []byte(""),
+						servicebusclients.ConnectionSecretKeyPrimaryKey:                []byte(primaryKey),
+						servicebusclients.ConnectionSecretKeySecondaryKey:              []byte(""),
+						servicebusclients.ConnectionSecretKeySharedAccessKeyName:       []byte(servicebusclients.NamespaceAuthorizationRuleName),
+					},
+				},
+				mg: namespace(
+					withCapacity(1),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.ServiceBusNamespaceObservation{ID: resourceID, ProvisioningState: servicebusclients.ProvisioningStateSucceeded, ServiceBusEndpoint: endpoint}),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotServiceBusNamespace": {
+			ec: &external{client: &fake.MockNamespacesClient{}},
+			want: want{
+				err: errors.New(errNotServiceBusNamespace),
+			},
+		},
+		"CreateFailed": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ servicebus.SBNamespace) (servicebus.NamespacesCreateOrUpdateFuture, error) {
+					return servicebus.NamespacesCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			mg: namespace(),
+			want: want{
+				mg:  namespace(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateServiceBusNamespace),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockCreateOrUpdate: func(_ context.Context, rg string, n string, p servicebus.SBNamespace) (servicebus.NamespacesCreateOrUpdateFuture, error) {
+					if rg != resourceGroupName || n != name || azure.ToString(p.Location) != location {
+						return servicebus.NamespacesCreateOrUpdateFuture{}, errBoom
+					}
+					return servicebus.NamespacesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			mg: namespace(),
+			want: want{
+				mg: namespace(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotServiceBusNamespace": {
+			ec:   &external{client: &fake.MockNamespacesClient{}},
+			want: errors.New(errNotServiceBusNamespace),
+		},
+		"UpdateFailed": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockUpdate: func(_ context.Context, _ string, _ string, _ servicebus.SBNamespaceUpdateParameters) (servicebus.SBNamespace, error) {
+					return servicebus.SBNamespace{}, errBoom
+				},
+			}},
+			mg:   namespace(),
+			want: errors.Wrap(errBoom, errUpdateServiceBusNamespace),
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockUpdate: func(_ context.Context, _ string, _ string, p servicebus.SBNamespaceUpdateParameters) (servicebus.SBNamespace, error) {
+					if p.Sku == nil || p.Sku.Name != servicebus.Premium {
+						return servicebus.SBNamespace{}, errBoom
+					}
+					return servicebus.SBNamespace{}, nil
+				},
+			}},
+			mg: namespace(withSKU(string(servicebus.Premium))),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotServiceBusNamespace": {
+			ec:   &external{client: &fake.MockNamespacesClient{}},
+			want: errors.New(errNotServiceBusNamespace),
+		},
+		"AlreadyGone": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (servicebus.NamespacesDeleteFuture, error) {
+					return servicebus.NamespacesDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: namespace(),
+		},
+		"DeleteFailed": {
+			ec: &external{client: &fake.MockNamespacesClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (servicebus.NamespacesDeleteFuture, error) {
+					return servicebus.NamespacesDeleteFuture{}, errBoom
+				},
+			}},
+			mg:   namespace(),
+			want: errors.Wrap(errBoom, errDeleteServiceBusNamespace),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
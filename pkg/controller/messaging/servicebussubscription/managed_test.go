@@ -0,0 +1,333 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicebussubscription
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/messaging/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/servicebus/fake"
+)
+
+const (
+	name              = "coolSubscription"
+	resourceGroupName = "coolRG"
+	namespaceName     = "coolNamespace"
+	topicName         = "coolTopic"
+	resourceID        = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.ServiceBus/namespaces/coolNamespace/topics/coolTopic/subscriptions/coolSubscription"
+)
+
+type subscriptionModifier func(*v1alpha1.ServiceBusSubscription)
+
+func withConditions(c ...xpv1.Condition) subscriptionModifier {
+	return func(s *v1alpha1.ServiceBusSubscription) { s.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.ServiceBusEntityObservation) subscriptionModifier {
+	return func(s *v1alpha1.ServiceBusSubscription) { s.Status.AtProvider = o }
+}
+
+func withMaxDeliveryCount(c int32) subscriptionModifier {
+	return func(s *v1alpha1.ServiceBusSubscription) { s.Spec.ForProvider.MaxDeliveryCount = &c }
+}
+
+func subscription(m ...subscriptionModifier) *v1alpha1.ServiceBusSubscription {
+	s := &v1alpha1.ServiceBusSubscription{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.ServiceBusSubscriptionSpec{
+			ForProvider: v1alpha1.ServiceBusSubscriptionParameters{
+				ResourceGroupName: resourceGroupName,
+				NamespaceName:     namespaceName,
+				TopicName:         topicName,
+			},
+		},
+	}
+	meta.SetExternalName(s, name)
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func azureSubscription(maxDeliveryCount int32) servicebus.SBSubscription {
+	return servicebus.SBSubscription{
+		ID: azure.ToStringPtr(resourceID),
+		SBSubscriptionProperties: &servicebus.SBSubscriptionProperties{
+			MaxDeliveryCount: &maxDeliveryCount,
+			Status:           servicebus.Active,
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	observation := v1alpha1.ServiceBusEntityObservation{ID: resourceID, Status: string(servicebus.Active)}
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotServiceBusSubscription": {
+			ec: &external{client: &fake.MockSubscriptionsClient{}},
+			want: want{
+				err: errors.New(errNotServiceBusSubscription),
+			},
+		},
+		"NotFound": {
+			ec: &external{client: &fake.MockSubscriptionsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string, _ string) (servicebus.SBSubscription, error) {
+					return servicebus.SBSubscription{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: subscription(),
+			want: want{
+				mg: subscription(),
+			},
+		},
+		"GetFailed": {
+			ec: &external{client: &fake.MockSubscriptionsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string, _ string) (servicebus.SBSubscription, error) {
+					return servicebus.SBSubscription{}, errBoom
+				},
+			}},
+			mg: subscription(),
+			want: want{
+				mg:  subscription(),
+				err: errors.Wrap(errBoom, errGetServiceBusSubscription),
+			},
+		},
+		"LateInitialized": {
+			ec: &external{client: &fake.MockSubscriptionsClient{
+				MockGet: func(_ context.Context, rg string, ns string, topic string, _ string) (servicebus.SBSubscription, error) {
+					if rg != resourceGroupName || ns != namespaceName || topic != topicName {
+						return servicebus.SBSubscription{}, errBoom
+					}
+					return azureSubscription(10), nil
+				},
+			}},
+			mg: subscription(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+				mg: subscription(withMaxDeliveryCount(10), withConditions(xpv1.Available()), withObservation(observation)),
+			},
+		},
+		"NeedsUpdate": {
+			ec: &external{client: &fake.MockSubscriptionsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string, _ string) (servicebus.SBSubscription, error) {
+					return azureSubscription(10), nil
+				},
+			}},
+			mg: subscription(withMaxDeliveryCount(5)),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				mg: subscription(withMaxDeliveryCount(5), withConditions(xpv1.Available()), withObservation(observation)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotServiceBusSubscription": {
+			ec: &external{client: &fake.MockSubscriptionsClient{}},
+			want: want{
+				err: errors.New(errNotServiceBusSubscription),
+			},
+		},
+		"CreateFailed": {
+			ec: &external{client: &fake.MockSubscriptionsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ string, _ servicebus.SBSubscription) (servicebus.SBSubscription, error) {
+					return servicebus.SBSubscription{}, errBoom
+				},
+			}},
+			mg: subscription(),
+			want: want{
+				mg:  subscription(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateServiceBusSubscription),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockSubscriptionsClient{
+				MockCreateOrUpdate: func(_ context.Context, rg string, ns string, topic string, n string, _ servicebus.SBSubscription) (servicebus.SBSubscription, error) {
+					if rg != resourceGroupName || ns != namespaceName || topic != topicName || n != name {
+						return servicebus.SBSubscription{}, errBoom
+					}
+					return servicebus.SBSubscription{}, nil
+				},
+			}},
+			mg: subscription(),
+			want: want{
+				mg: subscription(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotServiceBusSubscription": {
+			ec:   &external{client: &fake.MockSubscriptionsClient{}},
+			want: errors.New(errNotServiceBusSubscription),
+		},
+		"UpdateFailed": {
+			ec: &external{client: &fake.MockSubscriptionsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ string, _ servicebus.SBSubscription) (servicebus.SBSubscription, error) {
+					return servicebus.SBSubscription{}, errBoom
+				},
+			}},
+			mg:   subscription(),
+			want: errors.Wrap(errBoom, errUpdateServiceBusSubscription),
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockSubscriptionsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ string, p servicebus.SBSubscription) (servicebus.SBSubscription, error) {
+					if p.SBSubscriptionProperties == nil || p.MaxDeliveryCount == nil || *p.MaxDeliveryCount != 5 {
+						return servicebus.SBSubscription{}, errBoom
+					}
+					return servicebus.SBSubscription{}, nil
+				},
+			}},
+			mg: subscription(withMaxDeliveryCount(5)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotServiceBusSubscription": {
+			ec:   &external{client: &fake.MockSubscriptionsClient{}},
+			want: errors.New(errNotServiceBusSubscription),
+		},
+		"AlreadyGone": {
+			ec: &external{client: &fake.MockSubscriptionsClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string, _ string) (autorest.Response, error) {
+					return autorest.Response{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: subscription(),
+		},
+		"DeleteFailed": {
+			ec: &external{client: &fake.MockSubscriptionsClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string, _ string) (autorest.Response, error) {
+					return autorest.Response{}, errBoom
+				},
+			}},
+			mg:   subscription(),
+			want: errors.Wrap(errBoom, errDeleteServiceBusSubscription),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
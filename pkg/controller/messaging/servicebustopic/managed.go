@@ -0,0 +1,195 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicebustopic
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus/servicebusapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/messaging/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	servicebusclients "github.com/crossplane-contrib/provider-azure/pkg/clients/servicebus"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotServiceBusTopic              = "managed resource is not a ServiceBusTopic"
+	errConnectFailed                   = "cannot connect to Azure API"
+	errGetServiceBusTopic              = "cannot get ServiceBusTopic"
+	errGetAuthorizationRule            = "cannot get ServiceBusTopic authorization rule"
+	errListKeys                        = "cannot list ServiceBusTopic authorization rule keys"
+	errCreateServiceBusTopic           = "cannot create ServiceBusTopic"
+	errUpdateServiceBusTopic           = "cannot update ServiceBusTopic"
+	errCreateOrUpdateAuthorizationRule = "cannot create or update ServiceBusTopic authorization rule"
+	errDeleteServiceBusTopic           = "cannot delete ServiceBusTopic"
+)
+
+// Setup adds a controller that reconciles ServiceBusTopics.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ServiceBusTopicGroupKind)
+	o = azure.ControllerOptions(v1alpha1.ServiceBusTopicGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient())}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&v1alpha1.ServiceBusTopic{}).
+		Complete(azure.NewInstrumentedReconciler(v1alpha1.ServiceBusTopicGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ServiceBusTopicGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1alpha1.ServiceBusTopicGroupKind, bo, r, &connecter{kube: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+type connecter struct {
+	kube client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnectFailed)
+	}
+	cl := servicebus.NewTopicsClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{client: cl}, nil
+}
+
+type external struct {
+	client servicebusapi.TopicsClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceBusTopic)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotServiceBusTopic)
+	}
+
+	p := cr.Spec.ForProvider
+	az, err := e.client.Get(ctx, p.ResourceGroupName, p.NamespaceName, meta.GetExternalName(cr))
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetServiceBusTopic)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	servicebusclients.LateInitializeTopic(&cr.Spec.ForProvider, az)
+
+	cr.Status.AtProvider = servicebusclients.GenerateTopicObservation(az)
+	cr.SetConditions(xpv1.Available())
+
+	o := managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        servicebusclients.IsTopicUpToDate(cr.Spec.ForProvider, az),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}
+
+	// The authorization rule is created together with the topic, so a missing
+	// rule is repaired by an update.
+	rule, err := e.client.GetAuthorizationRule(ctx, p.ResourceGroupName, p.NamespaceName, meta.GetExternalName(cr), servicebusclients.EntityAuthorizationRuleName)
+	if azure.IsNotFound(err) {
+		o.ResourceUpToDate = false
+		return o, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetAuthorizationRule)
+	}
+	o.ResourceUpToDate = o.ResourceUpToDate && servicebusclients.IsAuthorizationRuleUpToDate(p.AuthorizationRights, rule)
+
+	keys, err := e.client.ListKeys(ctx, p.ResourceGroupName, p.NamespaceName, meta.GetExternalName(cr), servicebusclients.EntityAuthorizationRuleName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListKeys)
+	}
+	o.ConnectionDetails = servicebusclients.ConnectionDetails(keys)
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceBusTopic)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotServiceBusTopic)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	p := cr.Spec.ForProvider
+	if _, err := e.client.CreateOrUpdate(ctx, p.ResourceGroupName, p.NamespaceName, meta.GetExternalName(cr), servicebusclients.NewTopicParameters(p)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateServiceBusTopic)
+	}
+	_, err := e.client.CreateOrUpdateAuthorizationRule(ctx, p.ResourceGroupName, p.NamespaceName, meta.GetExternalName(cr), servicebusclients.EntityAuthorizationRuleName, servicebusclients.NewAuthorizationRule(p.AuthorizationRights))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateOrUpdateAuthorizationRule)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ServiceBusTopic)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotServiceBusTopic)
+	}
+
+	p := cr.Spec.ForProvider
+	if _, err := e.client.CreateOrUpdate(ctx, p.ResourceGroupName, p.NamespaceName, meta.GetExternalName(cr), servicebusclients.NewTopicParameters(p)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateServiceBusTopic)
+	}
+	_, err := e.client.CreateOrUpdateAuthorizationRule(ctx, p.ResourceGroupName, p.NamespaceName, meta.GetExternalName(cr), servicebusclients.EntityAuthorizationRuleName, servicebusclients.NewAuthorizationRule(p.AuthorizationRights))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errCreateOrUpdateAuthorizationRule)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ServiceBusTopic)
+	if !ok {
+		return errors.New(errNotServiceBusTopic)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, cr.Spec.ForProvider.NamespaceName, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteServiceBusTopic)
+}
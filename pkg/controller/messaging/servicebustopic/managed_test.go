@@ -0,0 +1,431 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicebustopic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/messaging/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	servicebusclients "github.com/crossplane-contrib/provider-azure/pkg/clients/servicebus"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/servicebus/fake"
+)
+
+const (
+	name              = "cooltopic"
+	resourceGroupName = "coolRG"
+	namespaceName     = "coolNamespace"
+	resourceID        = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.ServiceBus/namespaces/coolNamespace/topics/cooltopic"
+	connectionString  = "Endpoint=sb://coolNamespace.servicebus.windows.net/;SharedAccessKeyName=crossplane;SharedAccessKey=key;EntityPath=cooltopic"
+	primaryKey        = "key"
+)
+
+type topicModifier func(*v1alpha1.ServiceBusTopic)
+
+func withConditions(c ...xpv1.Condition) topicModifier {
+	return func(o *v1alpha1.ServiceBusTopic) { o.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(ob v1alpha1.ServiceBusEntityObservation) topicModifier {
+	return func(o *v1alpha1.ServiceBusTopic) { o.Status.AtProvider = ob }
+}
+
+func withMaxSize(s int32) topicModifier {
+	return func(o *v1alpha1.ServiceBusTopic) { o.Spec.ForProvider.MaxSizeInMegabytes = &s }
+}
+
+func withAuthorizationRights(r ...string) topicModifier {
+	return func(o *v1alpha1.ServiceBusTopic) { o.Spec.ForProvider.AuthorizationRights = r }
+}
+
+func topic(m ...topicModifier) *v1alpha1.ServiceBusTopic {
+	o := &v1alpha1.ServiceBusTopic{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.ServiceBusTopicSpec{
+			ForProvider: v1alpha1.ServiceBusTopicParameters{
+				ResourceGroupName: resourceGroupName,
+				NamespaceName:     namespaceName,
+			},
+		},
+	}
+	meta.SetExternalName(o, name)
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func azureTopic(maxSize int32) servicebus.SBTopic {
+	return servicebus.SBTopic{
+		ID: azure.ToStringPtr(resourceID),
+		SBTopicProperties: &servicebus.SBTopicProperties{
+			MaxSizeInMegabytes: &maxSize,
+			Status:             servicebus.Active,
+		},
+	}
+}
+
+func azureRule(rights ...servicebus.AccessRights) servicebus.SBAuthorizationRule {
+	return servicebus.SBAuthorizationRule{
+		SBAuthorizationRuleProperties: &servicebus.SBAuthorizationRuleProperties{Rights: &rights},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	observation := v1alpha1.ServiceBusEntityObservation{ID: resourceID, Status: string(servicebus.Active)}
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotServiceBusTopic": {
+			ec: &external{client: &fake.MockTopicsClient{}},
+			want: want{
+				err: errors.New(errNotServiceBusTopic),
+			},
+		},
+		"NotFound": {
+			ec: &external{client: &fake.MockTopicsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (servicebus.SBTopic, error) {
+					return servicebus.SBTopic{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: topic(),
+			want: want{
+				mg: topic(),
+			},
+		},
+		"GetFailed": {
+			ec: &external{client: &fake.MockTopicsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (servicebus.SBTopic, error) {
+					return servicebus.SBTopic{}, errBoom
+				},
+			}},
+			mg: topic(),
+			want: want{
+				mg:  topic(),
+				err: errors.Wrap(errBoom, errGetServiceBusTopic),
+			},
+		},
+		"AuthorizationRuleMissing": {
+			ec: &external{client: &fake.MockTopicsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (servicebus.SBTopic, error) {
+					return azureTopic(1024), nil
+				},
+				MockGetAuthorizationRule: func(_ context.Context, _ string, _ string, _ string, _ string) (servicebus.SBAuthorizationRule, error) {
+					return servicebus.SBAuthorizationRule{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: topic(withMaxSize(1024)),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				mg: topic(withMaxSize(1024), withConditions(xpv1.Available()), withObservation(observation)),
+			},
+		},
+		"AuthorizationRuleNeedsUpdate": {
+			ec: &external{client: &fake.MockTopicsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (servicebus.SBTopic, error) {
+					return azureTopic(1024), nil
+				},
+				MockGetAuthorizationRule: func(_ context.Context, _ string, _ string, _ string, _ string) (servicebus.SBAuthorizationRule, error) {
+					return azureRule(servicebus.Listen, servicebus.SendEnumValue), nil
+				},
+				MockListKeys: func(_ context.Context, _ string, _ string, _ string, _ string) (servicebus.AccessKeys, error) {
+					return servicebus.AccessKeys{}, nil
+				},
+			}},
+			mg: topic(withMaxSize(1024), withAuthorizationRights(string(servicebus.Listen))),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: servicebusclients.ConnectionDetails(servicebus.AccessKeys{}),
+				},
+				mg: topic(withMaxSize(1024), withAuthorizationRights(string(servicebus.Listen)), withConditions(xpv1.Available()), withObservation(observation)),
+			},
+		},
+		"ListKeysFailed": {
+			ec: &external{client: &fake.MockTopicsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (servicebus.SBTopic, error) {
+					return azureTopic(1024), nil
+				},
+				MockGetAuthorizationRule: func(_ context.Context, _ string, _ string, _ string, _ string) (servicebus.SBAuthorizationRule, error) {
+					return azureRule(servicebus.Listen, servicebus.SendEnumValue), nil
+				},
+				MockListKeys: func(_ context.Context, _ string, _ string, _ string, _ string) (servicebus.AccessKeys, error) {
+					return servicebus.AccessKeys{}, errBoom
+				},
+			}},
+			mg: topic(withMaxSize(1024)),
+			want: want{
+				mg:  topic(withMaxSize(1024), withConditions(xpv1.Available()), withObservation(observation)),
+				err: errors.Wrap(errBoom, errListKeys),
+			},
+		},
+		"LateInitialized": {
+			ec: &external{client: &fake.MockTopicsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (servicebus.SBTopic, error) {
+					return azureTopic(1024), nil
+				},
+				MockGetAuthorizationRule: func(_ context.Context, _ string, _ string, _ string, _ string) (servicebus.SBAuthorizationRule, error) {
+					return azureRule(servicebus.SendEnumValue, servicebus.Listen), nil
+				},
+				MockListKeys: func(_ context.Context, _ string, _ string, _ string, rule string) (servicebus.AccessKeys, error) {
+					if rule != servicebusclients.EntityAuthorizationRuleName {
+						return servicebus.AccessKeys{}, errBoom
+					}
+					return servicebus.AccessKeys{
+						PrimaryConnectionString: azure.ToStringPtr(connectionString),
+						PrimaryKey:              azure.ToStringPtr(primaryKey),
+						KeyName:                 azure.ToStringPtr(rule),
+					}, nil
+				},
+			}},
+			mg: topic(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						servicebusclients.ConnectionSecretKeyPrimaryConnectionString:   []byte(connectionString),
+						servicebusclients.ConnectionSecretKeySecondaryConnectionString: []byte(""),
+						servicebusclients.ConnectionSecretKeyPrimaryKey:                []byte(primaryKey),
+						servicebusclients.ConnectionSecretKeySecondaryKey:              []byte(""),
+						servicebusclients.ConnectionSecretKeySharedAccessKeyName:       []byte(servicebusclients.EntityAuthorizationRuleName),
+					},
+				},
+				mg: topic(withMaxSize(1024), withConditions(xpv1.Available()), withObservation(observation)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotServiceBusTopic": {
+			ec: &external{client: &fake.MockTopicsClient{}},
+			want: want{
+				err: errors.New(errNotServiceBusTopic),
+			},
+		},
+		"CreateFailed": {
+			ec: &external{client: &fake.MockTopicsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ servicebus.SBTopic) (servicebus.SBTopic, error) {
+					return servicebus.SBTopic{}, errBoom
+				},
+			}},
+			mg: topic(),
+			want: want{
+				mg:  topic(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateServiceBusTopic),
+			},
+		},
+		"CreateAuthorizationRuleFailed": {
+			ec: &external{client: &fake.MockTopicsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ servicebus.SBTopic) (servicebus.SBTopic, error) {
+					return servicebus.SBTopic{}, nil
+				},
+				MockCreateOrUpdateAuthorizationRule: func(_ context.Context, _ string, _ string, _ string, _ string, _ servicebus.SBAuthorizationRule) (servicebus.SBAuthorizationRule, error) {
+					return servicebus.SBAuthorizationRule{}, errBoom
+				},
+			}},
+			mg: topic(),
+			want: want{
+				mg:  topic(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateOrUpdateAuthorizationRule),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockTopicsClient{
+				MockCreateOrUpdate: func(_ context.Context, rg string, ns string, n string, _ servicebus.SBTopic) (servicebus.SBTopic, error) {
+					if rg != resourceGroupName || ns != namespaceName || n != name {
+						return servicebus.SBTopic{}, errBoom
+					}
+					return servicebus.SBTopic{}, nil
+				},
+				MockCreateOrUpdateAuthorizationRule: func(_ context.Context, _ string, _ string, _ string, rule string, p servicebus.SBAuthorizationRule) (servicebus.SBAuthorizationRule, error) {
+					if rule != servicebusclients.EntityAuthorizationRuleName || len(*p.Rights) != len(servicebusclients.DefaultAuthorizationRights) {
+						return servicebus.SBAuthorizationRule{}, errBoom
+					}
+					return servicebus.SBAuthorizationRule{}, nil
+				},
+			}},
+			mg: topic(),
+			want: want{
+				mg: topic(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotServiceBusTopic": {
+			ec:   &external{client: &fake.MockTopicsClient{}},
+			want: errors.New(errNotServiceBusTopic),
+		},
+		"UpdateFailed": {
+			ec: &external{client: &fake.MockTopicsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ servicebus.SBTopic) (servicebus.SBTopic, error) {
+					return servicebus.SBTopic{}, errBoom
+				},
+			}},
+			mg:   topic(),
+			want: errors.Wrap(errBoom, errUpdateServiceBusTopic),
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockTopicsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, p servicebus.SBTopic) (servicebus.SBTopic, error) {
+					if p.SBTopicProperties == nil || p.MaxSizeInMegabytes == nil || *p.MaxSizeInMegabytes != 2048 {
+						return servicebus.SBTopic{}, errBoom
+					}
+					return servicebus.SBTopic{}, nil
+				},
+				MockCreateOrUpdateAuthorizationRule: func(_ context.Context, _ string, _ string, _ string, _ string, p servicebus.SBAuthorizationRule) (servicebus.SBAuthorizationRule, error) {
+					if len(*p.Rights) != 1 || (*p.Rights)[0] != servicebus.Listen {
+						return servicebus.SBAuthorizationRule{}, errBoom
+					}
+					return servicebus.SBAuthorizationRule{}, nil
+				},
+			}},
+			mg: topic(withMaxSize(2048), withAuthorizationRights(string(servicebus.Listen))),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotServiceBusTopic": {
+			ec:   &external{client: &fake.MockTopicsClient{}},
+			want: errors.New(errNotServiceBusTopic),
+		},
+		"AlreadyGone": {
+			ec: &external{client: &fake.MockTopicsClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (autorest.Response, error) {
+					return autorest.Response{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: topic(),
+		},
+		"DeleteFailed": {
+			ec: &external{client: &fake.MockTopicsClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (autorest.Response, error) {
+					return autorest.Response{}, errBoom
+				},
+			}},
+			mg:   topic(),
+			want: errors.Wrap(errBoom, errDeleteServiceBusTopic),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
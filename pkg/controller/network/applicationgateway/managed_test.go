@@ -0,0 +1,543 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationgateway
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network/fake"
+)
+
+const (
+	name              = "coolApplicationGateway"
+	uid               = types.UID("definitely-a-uuid")
+	resourceGroupName = "coolRG"
+	location          = "coolplace"
+	subscriptionID    = "coolSubscription"
+	id                = "/subscriptions/coolSubscription/resourceGroups/coolRG/providers/Microsoft.Network/applicationGateways/coolApplicationGateway"
+	subnetID          = "/subscriptions/coolSubscription/resourceGroups/coolRG/providers/Microsoft.Network/virtualNetworks/vnet/subnets/gateway"
+	listenerID        = id + "/httpListeners/agic-listener"
+)
+
+var (
+	ctx       = context.Background()
+	errorBoom = errors.New("boom")
+)
+
+type testCase struct {
+	name    string
+	e       managed.ExternalClient
+	r       resource.Managed
+	want    resource.Managed
+	wantObs managed.ExternalObservation
+	wantErr error
+}
+
+type applicationGatewayModifier func(*v1alpha3.ApplicationGateway)
+
+func withConditions(c ...xpv1.Condition) applicationGatewayModifier {
+	return func(r *v1alpha3.ApplicationGateway) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAtProvider(o v1alpha3.ApplicationGatewayObservation) applicationGatewayModifier {
+	return func(r *v1alpha3.ApplicationGateway) { r.Status.AtProvider = o }
+}
+
+func withManagedByIngressController() applicationGatewayModifier {
+	return func(r *v1alpha3.ApplicationGateway) { r.Spec.ForProvider.ManagedByIngressController = true }
+}
+
+func withCapacity(c int32) applicationGatewayModifier {
+	return func(r *v1alpha3.ApplicationGateway) { r.Spec.ForProvider.SKU.Capacity = &c }
+}
+
+func withLateInitializedHTTPSettings() applicationGatewayModifier {
+	return func(r *v1alpha3.ApplicationGateway) {
+		s := &r.Spec.ForProvider.BackendHTTPSettings[0]
+		s.CookieBasedAffinity = azure.ToStringPtr(string(network.Disabled))
+		s.RequestTimeout = azure.ToInt32Ptr(30)
+		s.PickHostNameFromBackendAddress = azure.ToBoolPtr(false, azure.FieldRequired)
+	}
+}
+
+func applicationGateway(m ...applicationGatewayModifier) *v1alpha3.ApplicationGateway {
+	r := &v1alpha3.ApplicationGateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha3.ApplicationGatewaySpec{
+			ForProvider: v1alpha3.ApplicationGatewayParameters{
+				ResourceGroupName: resourceGroupName,
+				Location:          location,
+				SKU: v1alpha3.ApplicationGatewaySKU{
+					Name: string(network.StandardV2),
+					Tier: string(network.ApplicationGatewayTierStandardV2),
+				},
+				SubnetID: subnetID,
+				FrontendIPConfigurations: []v1alpha3.ApplicationGatewayFrontendIPConfiguration{
+					{Name: "private"},
+				},
+				FrontendPorts: []v1alpha3.ApplicationGatewayFrontendPort{
+					{Name: "http", Port: 80},
+				},
+				BackendAddressPools: []v1alpha3.ApplicationGatewayBackendAddressPool{
+					{Name: "pool", IPAddresses: []string{"10.0.1.4"}},
+				},
+				BackendHTTPSettings: []v1alpha3.ApplicationGatewayBackendHTTPSettings{
+					{Name: "settings", Port: 80, Protocol: string(network.HTTP)},
+				},
+				HTTPListeners: []v1alpha3.ApplicationGatewayHTTPListener{
+					{Name: "listener", FrontendIPConfigurationName: "private", FrontendPortName: "http", Protocol: string(network.HTTP)},
+				},
+				RequestRoutingRules: []v1alpha3.ApplicationGatewayRequestRoutingRule{
+					{Name: "rule", RuleType: string(network.Basic), HTTPListenerName: "listener", BackendAddressPoolName: "pool", BackendHTTPSettingsName: "settings"},
+				},
+				Tags: make(map[string]string),
+			},
+		},
+	}
+	meta.SetExternalName(r, name)
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+// azureApplicationGateway returns the Azure Application Gateway the supplied
+// ApplicationGateway results in, as Azure would report it.
+func azureApplicationGateway(cr *v1alpha3.ApplicationGateway, state string) network.ApplicationGateway {
+	az := network.ApplicationGateway{
+		ID: azure.ToStringPtr(id),
+		ApplicationGatewayPropertiesFormat: &network.ApplicationGatewayPropertiesFormat{
+			Sku: &network.ApplicationGatewaySku{
+				Name:     network.StandardV2,
+				Tier:     network.ApplicationGatewayTierStandardV2,
+				Capacity: azure.ToInt32Ptr(2),
+			},
+			GatewayIPConfigurations: &[]network.ApplicationGatewayIPConfiguration{{
+				ApplicationGatewayIPConfigurationPropertiesFormat: &network.ApplicationGatewayIPConfigurationPropertiesFormat{
+					Subnet: &network.SubResource{ID: azure.ToStringPtr(subnetID)},
+				},
+			}},
+			FrontendIPConfigurations: &[]network.ApplicationGatewayFrontendIPConfiguration{{
+				Name: azure.ToStringPtr("private"),
+				ApplicationGatewayFrontendIPConfigurationPropertiesFormat: &network.ApplicationGatewayFrontendIPConfigurationPropertiesFormat{
+					PrivateIPAddress: azure.ToStringPtr("10.0.0.4"),
+				},
+			}},
+			FrontendPorts: &[]network.ApplicationGatewayFrontendPort{{
+				Name: azure.ToStringPtr("http"),
+				ApplicationGatewayFrontendPortPropertiesFormat: &network.ApplicationGatewayFrontendPortPropertiesFormat{
+					Port: azure.ToInt32Ptr(80),
+				},
+			}},
+			BackendAddressPools: &[]network.ApplicationGatewayBackendAddressPool{{
+				Name: azure.ToStringPtr("pool"),
+				ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+					BackendAddresses: &[]network.ApplicationGatewayBackendAddress{{IPAddress: azure.ToStringPtr("10.0.1.4")}},
+				},
+			}},
+			BackendHTTPSettingsCollection: &[]network.ApplicationGatewayBackendHTTPSettings{{
+				Name: azure.ToStringPtr("settings"),
+				ApplicationGatewayBackendHTTPSettingsPropertiesFormat: &network.ApplicationGatewayBackendHTTPSettingsPropertiesFormat{
+					Port:                           azure.ToInt32Ptr(80),
+					Protocol:                       network.HTTP,
+					CookieBasedAffinity:            network.Disabled,
+					RequestTimeout:                 azure.ToInt32Ptr(30),
+					PickHostNameFromBackendAddress: azure.ToBoolPtr(false, azure.FieldRequired),
+				},
+			}},
+			HTTPListeners: &[]network.ApplicationGatewayHTTPListener{{
+				Name: azure.ToStringPtr("listener"),
+				ApplicationGatewayHTTPListenerPropertiesFormat: &network.ApplicationGatewayHTTPListenerPropertiesFormat{
+					FrontendIPConfiguration: &network.SubResource{ID: azure.ToStringPtr(id + "/frontendIPConfigurations/private")},
+					FrontendPort:            &network.SubResource{ID: azure.ToStringPtr(id + "/frontendPorts/http")},
+					Protocol:                network.HTTP,
+				},
+			}},
+			RequestRoutingRules: &[]network.ApplicationGatewayRequestRoutingRule{{
+				Name: azure.ToStringPtr("rule"),
+				ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+					RuleType:            network.Basic,
+					HTTPListener:        &network.SubResource{ID: azure.ToStringPtr(id + "/httpListeners/listener")},
+					BackendAddressPool:  &network.SubResource{ID: azure.ToStringPtr(id + "/backendAddressPools/pool")},
+					BackendHTTPSettings: &network.SubResource{ID: azure.ToStringPtr(id + "/backendHttpSettingsCollection/settings")},
+				},
+			}},
+			ProvisioningState: azure.ToStringPtr(state),
+			OperationalState:  network.Running,
+		},
+	}
+	if cr.Spec.ForProvider.SKU.Capacity != nil {
+		az.Sku.Capacity = cr.Spec.ForProvider.SKU.Capacity
+	}
+	return az
+}
+
+// withAGICListener returns the supplied Azure Application Gateway with the
+// listener of its routing rule replaced, as AGIC would.
+func withAGICListener(az network.ApplicationGateway) network.ApplicationGateway {
+	(*az.RequestRoutingRules)[0].HTTPListener = &network.SubResource{ID: azure.ToStringPtr(listenerID)}
+	return az
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	cases := []testCase{
+		{
+			name:    "NotApplicationGateway",
+			e:       &external{client: &fake.MockApplicationGatewaysClient{}},
+			r:       &v1alpha3.Subnet{},
+			want:    &v1alpha3.Subnet{},
+			wantErr: errors.New(errNotApplicationGateway),
+		},
+		{
+			name: "SuccessfulObserveNotExist",
+			e: &external{client: &fake.MockApplicationGatewaysClient{
+				MockGet: func(_ context.Context, _ string, _ string) (network.ApplicationGateway, error) {
+					return network.ApplicationGateway{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r:       applicationGateway(),
+			want:    applicationGateway(),
+			wantObs: managed.ExternalObservation{ResourceExists: false},
+		},
+		{
+			name: "FailedObserve",
+			e: &external{client: &fake.MockApplicationGatewaysClient{
+				MockGet: func(_ context.Context, _ string, _ string) (network.ApplicationGateway, error) {
+					return network.ApplicationGateway{}, errorBoom
+				},
+			}},
+			r:       applicationGateway(),
+			want:    applicationGateway(),
+			wantErr: errors.Wrap(errorBoom, errGetApplicationGateway),
+		},
+		{
+			name: "FailedUpdateCR",
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errorBoom)},
+				client: &fake.MockApplicationGatewaysClient{
+					MockGet: func(_ context.Context, _ string, _ string) (network.ApplicationGateway, error) {
+						return azureApplicationGateway(applicationGateway(withCapacity(2)), provisioningStateSucceeded), nil
+					},
+				},
+			},
+			r:       applicationGateway(),
+			want:    applicationGateway(withCapacity(2), withLateInitializedHTTPSettings()),
+			wantErr: errors.Wrap(errorBoom, errUpdateCR),
+		},
+		{
+			name: "Updating",
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockApplicationGatewaysClient{
+					MockGet: func(_ context.Context, _ string, _ string) (network.ApplicationGateway, error) {
+						return azureApplicationGateway(applicationGateway(withCapacity(3)), "Updating"), nil
+					},
+				},
+			},
+			r: applicationGateway(withCapacity(2)),
+			want: applicationGateway(
+				withCapacity(2),
+				withLateInitializedHTTPSettings(),
+				withConditions(xpv1.Unavailable()),
+				withAtProvider(v1alpha3.ApplicationGatewayObservation{ID: id, State: "Updating", OperationalState: string(network.Running)}),
+			),
+			wantObs: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: azure.ResourceConnectionDetails(id, location),
+			},
+		},
+		{
+			name: "UpToDate",
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockApplicationGatewaysClient{
+					MockGet: func(_ context.Context, _ string, _ string) (network.ApplicationGateway, error) {
+						return azureApplicationGateway(applicationGateway(withCapacity(2)), provisioningStateSucceeded), nil
+					},
+				},
+			},
+			r: applicationGateway(),
+			want: applicationGateway(
+				withCapacity(2),
+				withLateInitializedHTTPSettings(),
+				withConditions(xpv1.Available()),
+				withAtProvider(v1alpha3.ApplicationGatewayObservation{ID: id, State: provisioningStateSucceeded, OperationalState: string(network.Running)}),
+			),
+			wantObs: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: azure.ResourceConnectionDetails(id, location),
+			},
+		},
+		{
+			name: "CapacityChanged",
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockApplicationGatewaysClient{
+					MockGet: func(_ context.Context, _ string, _ string) (network.ApplicationGateway, error) {
+						return azureApplicationGateway(applicationGateway(withCapacity(2)), provisioningStateSucceeded), nil
+					},
+				},
+			},
+			r: applicationGateway(withCapacity(3), withManagedByIngressController()),
+			want: applicationGateway(
+				withCapacity(3),
+				withManagedByIngressController(),
+				withLateInitializedHTTPSettings(),
+				withConditions(xpv1.Available()),
+				withAtProvider(v1alpha3.ApplicationGatewayObservation{ID: id, State: provisioningStateSucceeded, OperationalState: string(network.Running)}),
+			),
+			wantObs: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: azure.ResourceConnectionDetails(id, location),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			obs, err := tc.e.Observe(ctx, tc.r)
+
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): want error != got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.wantObs, obs); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := []testCase{
+		{
+			name:    "NotApplicationGateway",
+			e:       &external{client: &fake.MockApplicationGatewaysClient{}},
+			r:       &v1alpha3.Subnet{},
+			want:    &v1alpha3.Subnet{},
+			wantErr: errors.New(errNotApplicationGateway),
+		},
+		{
+			name: "SuccessfulCreate",
+			e: &external{subscriptionID: subscriptionID, client: &fake.MockApplicationGatewaysClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, p network.ApplicationGateway) (network.ApplicationGatewaysCreateOrUpdateFuture, error) {
+					want := (*azureApplicationGateway(applicationGateway(), "").RequestRoutingRules)[0].HTTPListener
+					if diff := cmp.Diff(want, (*p.RequestRoutingRules)[0].HTTPListener); diff != "" {
+						return network.ApplicationGatewaysCreateOrUpdateFuture{}, errors.Errorf("listener: -want, +got:\n%s", diff)
+					}
+					return network.ApplicationGatewaysCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r:    applicationGateway(),
+			want: applicationGateway(withConditions(xpv1.Creating())),
+		},
+		{
+			name: "FailedCreate",
+			e: &external{client: &fake.MockApplicationGatewaysClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ network.ApplicationGateway) (network.ApplicationGatewaysCreateOrUpdateFuture, error) {
+					return network.ApplicationGatewaysCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:       applicationGateway(),
+			want:    applicationGateway(withConditions(xpv1.Creating())),
+			wantErr: errors.Wrap(errorBoom, errCreateApplicationGateway),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.e.Create(ctx, tc.r)
+
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): want error != got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := []testCase{
+		{
+			name:    "NotApplicationGateway",
+			e:       &external{client: &fake.MockApplicationGatewaysClient{}},
+			r:       &v1alpha3.Subnet{},
+			want:    &v1alpha3.Subnet{},
+			wantErr: errors.New(errNotApplicationGateway),
+		},
+		{
+			name: "SuccessfulUpdate",
+			e: &external{subscriptionID: subscriptionID, client: &fake.MockApplicationGatewaysClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, p network.ApplicationGateway) (network.ApplicationGatewaysCreateOrUpdateFuture, error) {
+					if azure.ToString((*p.RequestRoutingRules)[0].HTTPListener.ID) == listenerID {
+						return network.ApplicationGatewaysCreateOrUpdateFuture{}, errors.New("the routing rules must be replaced")
+					}
+					return network.ApplicationGatewaysCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r:    applicationGateway(),
+			want: applicationGateway(),
+		},
+		{
+			name: "SuccessfulUpdateManagedByIngressController",
+			e: &external{subscriptionID: subscriptionID, client: &fake.MockApplicationGatewaysClient{
+				MockGet: func(_ context.Context, _ string, _ string) (network.ApplicationGateway, error) {
+					return withAGICListener(azureApplicationGateway(applicationGateway(withCapacity(2)), provisioningStateSucceeded)), nil
+				},
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, p network.ApplicationGateway) (network.ApplicationGatewaysCreateOrUpdateFuture, error) {
+					if azure.ToString((*p.RequestRoutingRules)[0].HTTPListener.ID) != listenerID {
+						return network.ApplicationGatewaysCreateOrUpdateFuture{}, errors.New("the routing rules of AGIC must be preserved")
+					}
+					if *p.Sku.Capacity != 3 {
+						return network.ApplicationGatewaysCreateOrUpdateFuture{}, errors.New("the capacity must be updated")
+					}
+					return network.ApplicationGatewaysCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r:    applicationGateway(withCapacity(3), withManagedByIngressController()),
+			want: applicationGateway(withCapacity(3), withManagedByIngressController()),
+		},
+		{
+			name: "FailedGetManagedByIngressController",
+			e: &external{client: &fake.MockApplicationGatewaysClient{
+				MockGet: func(_ context.Context, _ string, _ string) (network.ApplicationGateway, error) {
+					return network.ApplicationGateway{}, errorBoom
+				},
+			}},
+			r:       applicationGateway(withManagedByIngressController()),
+			want:    applicationGateway(withManagedByIngressController()),
+			wantErr: errors.Wrap(errorBoom, errGetApplicationGateway),
+		},
+		{
+			name: "FailedUpdate",
+			e: &external{client: &fake.MockApplicationGatewaysClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ network.ApplicationGateway) (network.ApplicationGatewaysCreateOrUpdateFuture, error) {
+					return network.ApplicationGatewaysCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:       applicationGateway(),
+			want:    applicationGateway(),
+			wantErr: errors.Wrap(errorBoom, errUpdateApplicationGateway),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.e.Update(ctx, tc.r)
+
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): want error != got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := []testCase{
+		{
+			name:    "NotApplicationGateway",
+			e:       &external{client: &fake.MockApplicationGatewaysClient{}},
+			r:       &v1alpha3.Subnet{},
+			want:    &v1alpha3.Subnet{},
+			wantErr: errors.New(errNotApplicationGateway),
+		},
+		{
+			name: "Successful",
+			e: &external{client: &fake.MockApplicationGatewaysClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (network.ApplicationGatewaysDeleteFuture, error) {
+					return network.ApplicationGatewaysDeleteFuture{}, nil
+				},
+			}},
+			r:    applicationGateway(),
+			want: applicationGateway(withConditions(xpv1.Deleting())),
+		},
+		{
+			name: "SuccessfulNotFound",
+			e: &external{client: &fake.MockApplicationGatewaysClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (network.ApplicationGatewaysDeleteFuture, error) {
+					return network.ApplicationGatewaysDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r:    applicationGateway(),
+			want: applicationGateway(withConditions(xpv1.Deleting())),
+		},
+		{
+			name: "Failed",
+			e: &external{client: &fake.MockApplicationGatewaysClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (network.ApplicationGatewaysDeleteFuture, error) {
+					return network.ApplicationGatewaysDeleteFuture{}, errorBoom
+				},
+			}},
+			r:       applicationGateway(),
+			want:    applicationGateway(withConditions(xpv1.Deleting())),
+			wantErr: errors.Wrap(errorBoom, errDeleteApplicationGateway),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.e.Delete(ctx, tc.r)
+
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): want error != got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
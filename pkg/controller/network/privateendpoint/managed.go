@@ -0,0 +1,233 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privateendpoint
+
+import (
+	"context"
+
+	azurenetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network/networkapi"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azureclients "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errUpdateCR                  = "cannot update PrivateEndpoint custom resource"
+	errNotPrivateEndpoint        = "managed resource is not a PrivateEndpoint"
+	errCreatePrivateEndpoint     = "cannot create PrivateEndpoint"
+	errUpdatePrivateEndpoint     = "cannot update PrivateEndpoint"
+	errGetPrivateEndpoint        = "cannot get PrivateEndpoint"
+	errDeletePrivateEndpoint     = "cannot delete PrivateEndpoint"
+	errGetPrivateDNSZoneGroup    = "cannot get private DNS zone group of PrivateEndpoint"
+	errUpdatePrivateDNSZoneGroup = "cannot update private DNS zone group of PrivateEndpoint"
+	errDeletePrivateDNSZoneGroup = "cannot delete private DNS zone group of PrivateEndpoint"
+)
+
+const (
+	// provisioningStateSucceeded is the provisioning state of a Private
+	// Endpoint that is ready for use.
+	provisioningStateSucceeded = "Succeeded"
+
+	// connectionStatusApproved is the status of a connection that the owner
+	// of the connected resource approved.
+	connectionStatusApproved = "Approved"
+)
+
+// Setup adds a controller that reconciles Private Endpoints.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.PrivateEndpointGroupKind)
+	o = azureclients.ControllerOptions(v1alpha3.PrivateEndpointGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient()), azureclients.NewDefaultTagger(mgr.GetClient(), resourceTags)}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azureclients.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azureclients.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&v1alpha3.PrivateEndpoint{}).
+		Complete(azureclients.NewInstrumentedReconciler(v1alpha3.PrivateEndpointGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.PrivateEndpointGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azureclients.NewConnecter(v1alpha3.PrivateEndpointGroupKind, bo, r, &connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+func resourceTags(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*v1alpha3.PrivateEndpoint)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Tags
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azureclients.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := azurenetwork.NewPrivateEndpointsClient(creds[azureclients.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	zg := azurenetwork.NewPrivateDNSZoneGroupsClient(creds[azureclients.CredentialsKeySubscriptionID])
+	zg.Authorizer = auth
+	return &external{kube: c.client, client: cl, zoneGroups: zg}, nil
+}
+
+type external struct {
+	kube       client.Client
+	client     networkapi.PrivateEndpointsClientAPI
+	zoneGroups networkapi.PrivateDNSZoneGroupsClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.PrivateEndpoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPrivateEndpoint)
+	}
+
+	az, err := e.client.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), "")
+	if azureclients.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPrivateEndpoint)
+	}
+
+	network.LateInitializePrivateEndpoint(&cr.Spec.ForProvider, az)
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+	}
+
+	cr.Status.AtProvider = network.GeneratePrivateEndpointObservation(az)
+	o := managed.ExternalObservation{
+		ResourceExists:    true,
+		ConnectionDetails: azureclients.ResourceConnectionDetails(cr.Status.AtProvider.ID, cr.Spec.ForProvider.Location),
+	}
+
+	// The private DNS zone group cannot be created before the Private
+	// Endpoint is.
+	if cr.Status.AtProvider.State != provisioningStateSucceeded {
+		cr.SetConditions(xpv1.Unavailable())
+		o.ResourceUpToDate = true
+		return o, nil
+	}
+
+	// The connected resource is only reachable once its owner approved the
+	// connection, which happens immediately unless manual approval is
+	// requested.
+	if cr.Status.AtProvider.ConnectionStatus == connectionStatusApproved {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	zonesUpToDate, err := e.isZoneGroupUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	o.ResourceUpToDate = zonesUpToDate && network.IsPrivateEndpointUpToDate(cr.Spec.ForProvider, az)
+	return o, nil
+}
+
+func (e *external) isZoneGroupUpToDate(ctx context.Context, cr *v1alpha3.PrivateEndpoint) (bool, error) {
+	zg, err := e.zoneGroups.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), network.PrivateDNSZoneGroupName)
+	if azureclients.IsNotFound(err) {
+		return len(cr.Spec.ForProvider.PrivateDNSZoneIDs) == 0, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, errGetPrivateDNSZoneGroup)
+	}
+	return network.IsPrivateDNSZoneGroupUpToDate(cr.Spec.ForProvider.PrivateDNSZoneIDs, zg), nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.PrivateEndpoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPrivateEndpoint)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	// The private DNS zone group is created by the first update once the
+	// Private Endpoint exists.
+	_, err := e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), network.NewPrivateEndpointParameters(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreatePrivateEndpoint)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.PrivateEndpoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPrivateEndpoint)
+	}
+
+	rg, name := cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr)
+	if _, err := e.client.CreateOrUpdate(ctx, rg, name, network.NewPrivateEndpointParameters(name, cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePrivateEndpoint)
+	}
+
+	if len(cr.Spec.ForProvider.PrivateDNSZoneIDs) == 0 {
+		_, err := e.zoneGroups.Delete(ctx, rg, name, network.PrivateDNSZoneGroupName)
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errDeletePrivateDNSZoneGroup)
+	}
+	_, err := e.zoneGroups.CreateOrUpdate(ctx, rg, name, network.PrivateDNSZoneGroupName, network.NewPrivateDNSZoneGroup(cr.Spec.ForProvider.PrivateDNSZoneIDs))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePrivateDNSZoneGroup)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.PrivateEndpoint)
+	if !ok {
+		return errors.New(errNotPrivateEndpoint)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	// The private DNS zone group is deleted along with the Private Endpoint.
+	_, err := e.client.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errDeletePrivateEndpoint)
+}
@@ -0,0 +1,490 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privateendpoint
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network/fake"
+)
+
+const (
+	name              = "coolEndpoint"
+	uid               = types.UID("definitely-a-uuid")
+	resourceGroupName = "coolRG"
+	location          = "coolplace"
+	id                = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/privateEndpoints/coolEndpoint"
+	zoneID            = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/privateDnsZones/privatelink.blob.core.windows.net"
+)
+
+var (
+	ctx         = context.Background()
+	errorBoom   = errors.New("boom")
+	errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+)
+
+type testCase struct {
+	name    string
+	e       managed.ExternalClient
+	r       resource.Managed
+	want    resource.Managed
+	wantObs managed.ExternalObservation
+	wantErr error
+}
+
+type privateEndpointModifier func(*v1alpha3.PrivateEndpoint)
+
+func withConditions(c ...xpv1.Condition) privateEndpointModifier {
+	return func(r *v1alpha3.PrivateEndpoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAtProvider(o v1alpha3.PrivateEndpointObservation) privateEndpointModifier {
+	return func(r *v1alpha3.PrivateEndpoint) { r.Status.AtProvider = o }
+}
+
+func withZones(ids ...string) privateEndpointModifier {
+	return func(r *v1alpha3.PrivateEndpoint) { r.Spec.ForProvider.PrivateDNSZoneIDs = ids }
+}
+
+func privateEndpoint(pm ...privateEndpointModifier) *v1alpha3.PrivateEndpoint {
+	r := &v1alpha3.PrivateEndpoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha3.PrivateEndpointSpec{
+			ForProvider: v1alpha3.PrivateEndpointParameters{
+				ResourceGroupName: resourceGroupName,
+				Location:          location,
+				GroupIDs:          []string{"blob"},
+				Tags:              make(map[string]string),
+			},
+		},
+	}
+	meta.SetExternalName(r, name)
+	for _, m := range pm {
+		m(r)
+	}
+	return r
+}
+
+func azurePrivateEndpoint(state, status string) network.PrivateEndpoint {
+	return network.PrivateEndpoint{
+		ID: azure.ToStringPtr(id),
+		PrivateEndpointProperties: &network.PrivateEndpointProperties{
+			ProvisioningState: network.ProvisioningState(state),
+			PrivateLinkServiceConnections: &[]network.PrivateLinkServiceConnection{{
+				PrivateLinkServiceConnectionProperties: &network.PrivateLinkServiceConnectionProperties{
+					PrivateLinkServiceConnectionState: &network.PrivateLinkServiceConnectionState{Status: azure.ToStringPtr(status)},
+				},
+			}},
+		},
+	}
+}
+
+func zoneGroupClient(ids ...string) *fake.MockPrivateDNSZoneGroupsClient {
+	return &fake.MockPrivateDNSZoneGroupsClient{
+		MockGet: func(_ context.Context, _ string, _ string, _ string) (network.PrivateDNSZoneGroup, error) {
+			if len(ids) == 0 {
+				return network.PrivateDNSZoneGroup{}, errNotFound
+			}
+			return network.PrivateDNSZoneGroup{
+				PrivateDNSZoneGroupPropertiesFormat: &network.PrivateDNSZoneGroupPropertiesFormat{
+					PrivateDNSZoneConfigs: &[]network.PrivateDNSZoneConfig{{
+						PrivateDNSZonePropertiesFormat: &network.PrivateDNSZonePropertiesFormat{PrivateDNSZoneID: azure.ToStringPtr(ids[0])},
+					}},
+				},
+			}, nil
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	cases := []testCase{
+		{
+			name:    "NotPrivateEndpoint",
+			e:       &external{client: &fake.MockPrivateEndpointsClient{}},
+			r:       &v1alpha3.Subnet{},
+			want:    &v1alpha3.Subnet{},
+			wantErr: errors.New(errNotPrivateEndpoint),
+		},
+		{
+			name: "SuccessfulObserveNotExist",
+			e: &external{client: &fake.MockPrivateEndpointsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (network.PrivateEndpoint, error) {
+					return network.PrivateEndpoint{}, errNotFound
+				},
+			}},
+			r:       privateEndpoint(),
+			want:    privateEndpoint(),
+			wantObs: managed.ExternalObservation{ResourceExists: false},
+		},
+		{
+			name: "FailedObserve",
+			e: &external{client: &fake.MockPrivateEndpointsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (network.PrivateEndpoint, error) {
+					return network.PrivateEndpoint{}, errorBoom
+				},
+			}},
+			r:       privateEndpoint(),
+			want:    privateEndpoint(),
+			wantErr: errors.Wrap(errorBoom, errGetPrivateEndpoint),
+		},
+		{
+			name: "Updating",
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockPrivateEndpointsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.PrivateEndpoint, error) {
+						return azurePrivateEndpoint("Updating", connectionStatusApproved), nil
+					},
+				},
+			},
+			r: privateEndpoint(withZones(zoneID)),
+			want: privateEndpoint(
+				withZones(zoneID),
+				withConditions(xpv1.Unavailable()),
+				withAtProvider(v1alpha3.PrivateEndpointObservation{ID: id, State: "Updating", ConnectionStatus: connectionStatusApproved}),
+			),
+			wantObs: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: azure.ResourceConnectionDetails(id, location),
+			},
+		},
+		{
+			name: "PendingApproval",
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockPrivateEndpointsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.PrivateEndpoint, error) {
+						return azurePrivateEndpoint(provisioningStateSucceeded, "Pending"), nil
+					},
+				},
+				zoneGroups: zoneGroupClient(),
+			},
+			r: privateEndpoint(),
+			want: privateEndpoint(
+				withConditions(xpv1.Unavailable()),
+				withAtProvider(v1alpha3.PrivateEndpointObservation{ID: id, State: provisioningStateSucceeded, ConnectionStatus: "Pending"}),
+			),
+			wantObs: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: azure.ResourceConnectionDetails(id, location),
+			},
+		},
+		{
+			name: "UpToDate",
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockPrivateEndpointsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.PrivateEndpoint, error) {
+						return azurePrivateEndpoint(provisioningStateSucceeded, connectionStatusApproved), nil
+					},
+				},
+				zoneGroups: zoneGroupClient(zoneID),
+			},
+			r: privateEndpoint(withZones(zoneID)),
+			want: privateEndpoint(
+				withZones(zoneID),
+				withConditions(xpv1.Available()),
+				withAtProvider(v1alpha3.PrivateEndpointObservation{ID: id, State: provisioningStateSucceeded, ConnectionStatus: connectionStatusApproved}),
+			),
+			wantObs: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: azure.ResourceConnectionDetails(id, location),
+			},
+		},
+		{
+			name: "ZoneGroupMissing",
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockPrivateEndpointsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.PrivateEndpoint, error) {
+						return azurePrivateEndpoint(provisioningStateSucceeded, connectionStatusApproved), nil
+					},
+				},
+				zoneGroups: zoneGroupClient(),
+			},
+			r: privateEndpoint(withZones(zoneID)),
+			want: privateEndpoint(
+				withZones(zoneID),
+				withConditions(xpv1.Available()),
+				withAtProvider(v1alpha3.PrivateEndpointObservation{ID: id, State: provisioningStateSucceeded, ConnectionStatus: connectionStatusApproved}),
+			),
+			wantObs: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: azure.ResourceConnectionDetails(id, location),
+			},
+		},
+		{
+			name: "FailedGetZoneGroup",
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockPrivateEndpointsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.PrivateEndpoint, error) {
+						return azurePrivateEndpoint(provisioningStateSucceeded, connectionStatusApproved), nil
+					},
+				},
+				zoneGroups: &fake.MockPrivateDNSZoneGroupsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.PrivateDNSZoneGroup, error) {
+						return network.PrivateDNSZoneGroup{}, errorBoom
+					},
+				},
+			},
+			r: privateEndpoint(),
+			want: privateEndpoint(
+				withConditions(xpv1.Available()),
+				withAtProvider(v1alpha3.PrivateEndpointObservation{ID: id, State: provisioningStateSucceeded, ConnectionStatus: connectionStatusApproved}),
+			),
+			wantErr: errors.Wrap(errorBoom, errGetPrivateDNSZoneGroup),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			obs, err := tc.e.Observe(ctx, tc.r)
+
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): want error != got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.wantObs, obs); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := []testCase{
+		{
+			name:    "NotPrivateEndpoint",
+			e:       &external{client: &fake.MockPrivateEndpointsClient{}},
+			r:       &v1alpha3.Subnet{},
+			want:    &v1alpha3.Subnet{},
+			wantErr: errors.New(errNotPrivateEndpoint),
+		},
+		{
+			name: "SuccessfulCreate",
+			e: &external{client: &fake.MockPrivateEndpointsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ network.PrivateEndpoint) (network.PrivateEndpointsCreateOrUpdateFuture, error) {
+					return network.PrivateEndpointsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r:    privateEndpoint(),
+			want: privateEndpoint(withConditions(xpv1.Creating())),
+		},
+		{
+			name: "FailedCreate",
+			e: &external{client: &fake.MockPrivateEndpointsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ network.PrivateEndpoint) (network.PrivateEndpointsCreateOrUpdateFuture, error) {
+					return network.PrivateEndpointsCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:       privateEndpoint(),
+			want:    privateEndpoint(withConditions(xpv1.Creating())),
+			wantErr: errors.Wrap(errorBoom, errCreatePrivateEndpoint),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.e.Create(ctx, tc.r)
+
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): want error != got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	endpoints := &fake.MockPrivateEndpointsClient{
+		MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ network.PrivateEndpoint) (network.PrivateEndpointsCreateOrUpdateFuture, error) {
+			return network.PrivateEndpointsCreateOrUpdateFuture{}, nil
+		},
+	}
+
+	cases := []testCase{
+		{
+			name:    "NotPrivateEndpoint",
+			e:       &external{client: &fake.MockPrivateEndpointsClient{}},
+			r:       &v1alpha3.Subnet{},
+			want:    &v1alpha3.Subnet{},
+			wantErr: errors.New(errNotPrivateEndpoint),
+		},
+		{
+			name: "FailedUpdate",
+			e: &external{client: &fake.MockPrivateEndpointsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ network.PrivateEndpoint) (network.PrivateEndpointsCreateOrUpdateFuture, error) {
+					return network.PrivateEndpointsCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:       privateEndpoint(),
+			want:    privateEndpoint(),
+			wantErr: errors.Wrap(errorBoom, errUpdatePrivateEndpoint),
+		},
+		{
+			name: "SuccessfulUpdateZoneGroup",
+			e: &external{
+				client: endpoints,
+				zoneGroups: &fake.MockPrivateDNSZoneGroupsClient{
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ string, n string, p network.PrivateDNSZoneGroup) (network.PrivateDNSZoneGroupsCreateOrUpdateFuture, error) {
+						if n != "default" || len(*p.PrivateDNSZoneConfigs) != 1 || azure.ToString((*p.PrivateDNSZoneConfigs)[0].Name) != "privatelink-blob-core-windows-net" {
+							return network.PrivateDNSZoneGroupsCreateOrUpdateFuture{}, errors.New("unexpected private DNS zone group")
+						}
+						return network.PrivateDNSZoneGroupsCreateOrUpdateFuture{}, nil
+					},
+				},
+			},
+			r:    privateEndpoint(withZones(zoneID)),
+			want: privateEndpoint(withZones(zoneID)),
+		},
+		{
+			name: "FailedUpdateZoneGroup",
+			e: &external{
+				client: endpoints,
+				zoneGroups: &fake.MockPrivateDNSZoneGroupsClient{
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ network.PrivateDNSZoneGroup) (network.PrivateDNSZoneGroupsCreateOrUpdateFuture, error) {
+						return network.PrivateDNSZoneGroupsCreateOrUpdateFuture{}, errorBoom
+					},
+				},
+			},
+			r:       privateEndpoint(withZones(zoneID)),
+			want:    privateEndpoint(withZones(zoneID)),
+			wantErr: errors.Wrap(errorBoom, errUpdatePrivateDNSZoneGroup),
+		},
+		{
+			name: "SuccessfulDeleteZoneGroup",
+			e: &external{
+				client: endpoints,
+				zoneGroups: &fake.MockPrivateDNSZoneGroupsClient{
+					MockDelete: func(_ context.Context, _ string, _ string, _ string) (network.PrivateDNSZoneGroupsDeleteFuture, error) {
+						return network.PrivateDNSZoneGroupsDeleteFuture{}, errNotFound
+					},
+				},
+			},
+			r:    privateEndpoint(),
+			want: privateEndpoint(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.e.Update(ctx, tc.r)
+
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): want error != got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := []testCase{
+		{
+			name:    "NotPrivateEndpoint",
+			e:       &external{client: &fake.MockPrivateEndpointsClient{}},
+			r:       &v1alpha3.Subnet{},
+			want:    &v1alpha3.Subnet{},
+			wantErr: errors.New(errNotPrivateEndpoint),
+		},
+		{
+			name: "SuccessfulDelete",
+			e: &external{client: &fake.MockPrivateEndpointsClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (network.PrivateEndpointsDeleteFuture, error) {
+					return network.PrivateEndpointsDeleteFuture{}, nil
+				},
+			}},
+			r:    privateEndpoint(),
+			want: privateEndpoint(withConditions(xpv1.Deleting())),
+		},
+		{
+			name: "AlreadyDeleted",
+			e: &external{client: &fake.MockPrivateEndpointsClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (network.PrivateEndpointsDeleteFuture, error) {
+					return network.PrivateEndpointsDeleteFuture{}, errNotFound
+				},
+			}},
+			r:    privateEndpoint(),
+			want: privateEndpoint(withConditions(xpv1.Deleting())),
+		},
+		{
+			name: "FailedDelete",
+			e: &external{client: &fake.MockPrivateEndpointsClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (network.PrivateEndpointsDeleteFuture, error) {
+					return network.PrivateEndpointsDeleteFuture{}, errorBoom
+				},
+			}},
+			r:       privateEndpoint(),
+			want:    privateEndpoint(withConditions(xpv1.Deleting())),
+			wantErr: errors.Wrap(errorBoom, errDeletePrivateEndpoint),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.e.Delete(ctx, tc.r)
+
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): want error != got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
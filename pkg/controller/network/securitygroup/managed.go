@@ -0,0 +1,187 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+
+	azurenetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network/networkapi"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azureclients "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errUpdateCR            = "cannot update SecurityGroup custom resource"
+	errNotSecurityGroup    = "managed resource is not a SecurityGroup"
+	errCreateSecurityGroup = "cannot create SecurityGroup"
+	errUpdateSecurityGroup = "cannot update SecurityGroup"
+	errGetSecurityGroup    = "cannot get SecurityGroup"
+	errDeleteSecurityGroup = "cannot delete SecurityGroup"
+)
+
+// provisioningStateSucceeded is the provisioning state of a security group
+// that is ready for use.
+const provisioningStateSucceeded = "Succeeded"
+
+// Setup adds a controller that reconciles Network Security Groups.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.SecurityGroupGroupKind)
+	o = azureclients.ControllerOptions(v1alpha3.SecurityGroupGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient()), azureclients.NewDefaultTagger(mgr.GetClient(), resourceTags)}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azureclients.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azureclients.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&v1alpha3.SecurityGroup{}).
+		Complete(azureclients.NewInstrumentedReconciler(v1alpha3.SecurityGroupGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.SecurityGroupGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azureclients.NewConnecter(v1alpha3.SecurityGroupGroupKind, bo, r, &connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+func resourceTags(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*v1alpha3.SecurityGroup)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Tags
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azureclients.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := azurenetwork.NewSecurityGroupsClient(creds[azureclients.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{kube: c.client, client: cl}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client networkapi.SecurityGroupsClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.SecurityGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSecurityGroup)
+	}
+
+	az, err := e.client.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), "")
+	if azureclients.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSecurityGroup)
+	}
+
+	network.LateInitializeSecurityGroup(&cr.Spec.ForProvider, az)
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+	}
+
+	cr.Status.AtProvider = network.GenerateSecurityGroupObservation(az)
+	o := managed.ExternalObservation{
+		ResourceExists:    true,
+		ConnectionDetails: azureclients.ResourceConnectionDetails(cr.Status.AtProvider.ID, cr.Spec.ForProvider.Location),
+	}
+
+	// The security rules cannot be changed while an update is in progress.
+	if cr.Status.AtProvider.State != provisioningStateSucceeded {
+		cr.SetConditions(xpv1.Unavailable())
+		o.ResourceUpToDate = true
+		return o, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+	o.ResourceUpToDate = network.IsSecurityGroupUpToDate(cr.Spec.ForProvider, az)
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.SecurityGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSecurityGroup)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), network.NewSecurityGroupParameters(cr))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateSecurityGroup)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.SecurityGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSecurityGroup)
+	}
+
+	// The desired rules replace all rules of the security group, so drifted
+	// rules are updated and unknown rules are removed.
+	_, err := e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), network.NewSecurityGroupParameters(cr))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSecurityGroup)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.SecurityGroup)
+	if !ok {
+		return errors.New(errNotSecurityGroup)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(azureclients.IsNotFound, err), errDeleteSecurityGroup)
+}
@@ -0,0 +1,451 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/network/fake"
+)
+
+const (
+	name              = "coolSecurityGroup"
+	uid               = types.UID("definitely-a-uuid")
+	resourceGroupName = "coolRG"
+	location          = "coolplace"
+	id                = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Network/networkSecurityGroups/coolSecurityGroup"
+	ruleName          = "allow-https"
+)
+
+var (
+	ctx       = context.Background()
+	errorBoom = errors.New("boom")
+)
+
+type testCase struct {
+	name    string
+	e       managed.ExternalClient
+	r       resource.Managed
+	want    resource.Managed
+	wantObs managed.ExternalObservation
+	wantErr error
+}
+
+type securityGroupModifier func(*v1alpha3.SecurityGroup)
+
+func withConditions(c ...xpv1.Condition) securityGroupModifier {
+	return func(r *v1alpha3.SecurityGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAtProvider(o v1alpha3.SecurityGroupObservation) securityGroupModifier {
+	return func(r *v1alpha3.SecurityGroup) { r.Status.AtProvider = o }
+}
+
+func withRules(rules ...v1alpha3.SecurityRule) securityGroupModifier {
+	return func(r *v1alpha3.SecurityGroup) { r.Spec.ForProvider.SecurityRules = rules }
+}
+
+func securityGroup(sm ...securityGroupModifier) *v1alpha3.SecurityGroup {
+	r := &v1alpha3.SecurityGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha3.SecurityGroupSpec{
+			ForProvider: v1alpha3.SecurityGroupParameters{
+				ResourceGroupName: resourceGroupName,
+				Location:          location,
+				Tags:              make(map[string]string),
+			},
+		},
+	}
+	meta.SetExternalName(r, name)
+	for _, m := range sm {
+		m(r)
+	}
+	return r
+}
+
+func rule() v1alpha3.SecurityRule {
+	return v1alpha3.SecurityRule{
+		Name:                     ruleName,
+		Protocol:                 string(network.SecurityRuleProtocolTCP),
+		SourcePortRange:          azure.ToStringPtr("*"),
+		DestinationPortRange:     azure.ToStringPtr("443"),
+		SourceAddressPrefix:      azure.ToStringPtr("Internet"),
+		DestinationAddressPrefix: azure.ToStringPtr("*"),
+		Access:                   string(network.SecurityRuleAccessAllow),
+		Priority:                 100,
+		Direction:                string(network.SecurityRuleDirectionInbound),
+	}
+}
+
+func azureRule(port string) network.SecurityRule {
+	return network.SecurityRule{
+		Name: azure.ToStringPtr(ruleName),
+		SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+			Protocol:                 network.SecurityRuleProtocolTCP,
+			SourcePortRange:          azure.ToStringPtr("*"),
+			DestinationPortRange:     azure.ToStringPtr(port),
+			SourceAddressPrefix:      azure.ToStringPtr("Internet"),
+			DestinationAddressPrefix: azure.ToStringPtr("*"),
+			Access:                   network.SecurityRuleAccessAllow,
+			Priority:                 azure.ToInt32Ptr(100),
+			Direction:                network.SecurityRuleDirectionInbound,
+			ProvisioningState:        azure.ToStringPtr(provisioningStateSucceeded),
+		},
+	}
+}
+
+func azureSecurityGroup(state string, rules ...network.SecurityRule) network.SecurityGroup {
+	return network.SecurityGroup{
+		ID: azure.ToStringPtr(id),
+		SecurityGroupPropertiesFormat: &network.SecurityGroupPropertiesFormat{
+			SecurityRules:     &rules,
+			ProvisioningState: azure.ToStringPtr(state),
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	cases := []testCase{
+		{
+			name:    "NotSecurityGroup",
+			e:       &external{client: &fake.MockSecurityGroupsClient{}},
+			r:       &v1alpha3.Subnet{},
+			want:    &v1alpha3.Subnet{},
+			wantErr: errors.New(errNotSecurityGroup),
+		},
+		{
+			name: "SuccessfulObserveNotExist",
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (network.SecurityGroup, error) {
+					return network.SecurityGroup{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r:       securityGroup(),
+			want:    securityGroup(),
+			wantObs: managed.ExternalObservation{ResourceExists: false},
+		},
+		{
+			name: "FailedObserve",
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (network.SecurityGroup, error) {
+					return network.SecurityGroup{}, errorBoom
+				},
+			}},
+			r:       securityGroup(),
+			want:    securityGroup(),
+			wantErr: errors.Wrap(errorBoom, errGetSecurityGroup),
+		},
+		{
+			name: "FailedUpdateCR",
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errorBoom)},
+				client: &fake.MockSecurityGroupsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.SecurityGroup, error) {
+						return azureSecurityGroup(provisioningStateSucceeded), nil
+					},
+				},
+			},
+			r:       securityGroup(),
+			want:    securityGroup(),
+			wantErr: errors.Wrap(errorBoom, errUpdateCR),
+		},
+		{
+			name: "Updating",
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockSecurityGroupsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.SecurityGroup, error) {
+						return azureSecurityGroup("Updating"), nil
+					},
+				},
+			},
+			r: securityGroup(withRules(rule())),
+			want: securityGroup(
+				withRules(rule()),
+				withConditions(xpv1.Unavailable()),
+				withAtProvider(v1alpha3.SecurityGroupObservation{ID: id, State: "Updating"}),
+			),
+			wantObs: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: azure.ResourceConnectionDetails(id, location),
+			},
+		},
+		{
+			name: "UpToDate",
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockSecurityGroupsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.SecurityGroup, error) {
+						return azureSecurityGroup(provisioningStateSucceeded, azureRule("443")), nil
+					},
+				},
+			},
+			r: securityGroup(withRules(rule())),
+			want: securityGroup(
+				withRules(rule()),
+				withConditions(xpv1.Available()),
+				withAtProvider(v1alpha3.SecurityGroupObservation{ID: id, State: provisioningStateSucceeded}),
+			),
+			wantObs: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: azure.ResourceConnectionDetails(id, location),
+			},
+		},
+		{
+			name: "RuleDrifted",
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockSecurityGroupsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.SecurityGroup, error) {
+						return azureSecurityGroup(provisioningStateSucceeded, azureRule("8443")), nil
+					},
+				},
+			},
+			r: securityGroup(withRules(rule())),
+			want: securityGroup(
+				withRules(rule()),
+				withConditions(xpv1.Available()),
+				withAtProvider(v1alpha3.SecurityGroupObservation{ID: id, State: provisioningStateSucceeded}),
+			),
+			wantObs: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: azure.ResourceConnectionDetails(id, location),
+			},
+		},
+		{
+			name: "UnknownRule",
+			e: &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockSecurityGroupsClient{
+					MockGet: func(_ context.Context, _ string, _ string, _ string) (network.SecurityGroup, error) {
+						return azureSecurityGroup(provisioningStateSucceeded, azureRule("443")), nil
+					},
+				},
+			},
+			r: securityGroup(),
+			want: securityGroup(
+				withConditions(xpv1.Available()),
+				withAtProvider(v1alpha3.SecurityGroupObservation{ID: id, State: provisioningStateSucceeded}),
+			),
+			wantObs: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: azure.ResourceConnectionDetails(id, location),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			obs, err := tc.e.Observe(ctx, tc.r)
+
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): want error != got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.wantObs, obs); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := []testCase{
+		{
+			name:    "NotSecurityGroup",
+			e:       &external{client: &fake.MockSecurityGroupsClient{}},
+			r:       &v1alpha3.Subnet{},
+			want:    &v1alpha3.Subnet{},
+			wantErr: errors.New(errNotSecurityGroup),
+		},
+		{
+			name: "SuccessfulCreate",
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ network.SecurityGroup) (network.SecurityGroupsCreateOrUpdateFuture, error) {
+					return network.SecurityGroupsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r:    securityGroup(),
+			want: securityGroup(withConditions(xpv1.Creating())),
+		},
+		{
+			name: "FailedCreate",
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ network.SecurityGroup) (network.SecurityGroupsCreateOrUpdateFuture, error) {
+					return network.SecurityGroupsCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:       securityGroup(),
+			want:    securityGroup(withConditions(xpv1.Creating())),
+			wantErr: errors.Wrap(errorBoom, errCreateSecurityGroup),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.e.Create(ctx, tc.r)
+
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): want error != got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := []testCase{
+		{
+			name:    "NotSecurityGroup",
+			e:       &external{client: &fake.MockSecurityGroupsClient{}},
+			r:       &v1alpha3.Subnet{},
+			want:    &v1alpha3.Subnet{},
+			wantErr: errors.New(errNotSecurityGroup),
+		},
+		{
+			name: "SuccessfulUpdate",
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, p network.SecurityGroup) (network.SecurityGroupsCreateOrUpdateFuture, error) {
+					if p.SecurityRules == nil || len(*p.SecurityRules) != 0 {
+						return network.SecurityGroupsCreateOrUpdateFuture{}, errors.New("rules must be replaced by the desired, empty set")
+					}
+					return network.SecurityGroupsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			r:    securityGroup(),
+			want: securityGroup(),
+		},
+		{
+			name: "FailedUpdate",
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ network.SecurityGroup) (network.SecurityGroupsCreateOrUpdateFuture, error) {
+					return network.SecurityGroupsCreateOrUpdateFuture{}, errorBoom
+				},
+			}},
+			r:       securityGroup(),
+			want:    securityGroup(),
+			wantErr: errors.Wrap(errorBoom, errUpdateSecurityGroup),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.e.Update(ctx, tc.r)
+
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): want error != got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := []testCase{
+		{
+			name:    "NotSecurityGroup",
+			e:       &external{client: &fake.MockSecurityGroupsClient{}},
+			r:       &v1alpha3.Subnet{},
+			want:    &v1alpha3.Subnet{},
+			wantErr: errors.New(errNotSecurityGroup),
+		},
+		{
+			name: "Successful",
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (network.SecurityGroupsDeleteFuture, error) {
+					return network.SecurityGroupsDeleteFuture{}, nil
+				},
+			}},
+			r:    securityGroup(),
+			want: securityGroup(withConditions(xpv1.Deleting())),
+		},
+		{
+			name: "SuccessfulNotFound",
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (network.SecurityGroupsDeleteFuture, error) {
+					return network.SecurityGroupsDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			r:    securityGroup(),
+			want: securityGroup(withConditions(xpv1.Deleting())),
+		},
+		{
+			name: "Failed",
+			e: &external{client: &fake.MockSecurityGroupsClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (network.SecurityGroupsDeleteFuture, error) {
+					return network.SecurityGroupsDeleteFuture{}, errorBoom
+				},
+			}},
+			r:       securityGroup(),
+			want:    securityGroup(withConditions(xpv1.Deleting())),
+			wantErr: errors.Wrap(errorBoom, errDeleteSecurityGroup),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.e.Delete(ctx, tc.r)
+
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): want error != got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want, tc.r, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
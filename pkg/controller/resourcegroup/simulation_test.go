@@ -0,0 +1,84 @@
+//go:build simulation
+// +build simulation
+
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/test/simulation"
+)
+
+func TestSimulation(t *testing.T) {
+	srv := simulation.NewRecording(t, "resourcegroup.json").Serve()
+	kube := simulation.NewKube(t, srv.URL)
+	ctx := context.Background()
+
+	rg := &v1alpha3.ResourceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: simulation.ResourceGroup, UID: uid},
+		Spec:       v1alpha3.ResourceGroupSpec{Location: simulation.Location},
+	}
+	rg.SetProviderConfigReference(&xpv1.Reference{Name: simulation.ProviderConfigName})
+	meta.SetExternalName(rg, simulation.ResourceGroup)
+
+	c := &connecter{kube: kube, simulate: true}
+	e, err := c.Connect(ctx, rg)
+	if err != nil {
+		t.Fatalf("Connect(...): %v", err)
+	}
+
+	o, err := e.Observe(ctx, rg)
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if o.ResourceExists {
+		t.Errorf("Observe(...): want resource not to exist before it is created")
+	}
+
+	if _, err := e.Create(ctx, rg); err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+
+	o, err = e.Observe(ctx, rg)
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+	if !o.ResourceExists {
+		t.Errorf("Observe(...): want resource to exist after it is created")
+	}
+	if diff := cmp.Diff(v1alpha3.ProvisioningStateSucceeded, rg.Status.ProvisioningState); diff != "" {
+		t.Errorf("Observe(...): -want provisioning state, +got provisioning state:\n%s", diff)
+	}
+	if diff := cmp.Diff(xpv1.Available(), rg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+		t.Errorf("Observe(...): -want ready condition, +got ready condition:\n%s", diff)
+	}
+
+	if err := e.Delete(ctx, rg); err != nil {
+		t.Fatalf("Delete(...): %v", err)
+	}
+}
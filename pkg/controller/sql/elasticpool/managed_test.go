@@ -0,0 +1,389 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticpool
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v4.0/sql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/sql/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	sqlclients "github.com/crossplane-contrib/provider-azure/pkg/clients/sql"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/sql/fake"
+)
+
+const (
+	name              = "coolpool"
+	resourceGroupName = "coolRG"
+	serverName        = "coolserver"
+	location          = "westeurope"
+	resourceID        = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Sql/servers/coolserver/elasticPools/coolpool"
+	skuName           = "StandardPool"
+	skuTier           = "Standard"
+	capacity          = int32(50)
+	maxSizeBytes      = int64(5368709120)
+)
+
+type poolModifier func(*v1alpha1.ElasticPool)
+
+func withConditions(c ...xpv1.Condition) poolModifier {
+	return func(p *v1alpha1.ElasticPool) { p.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.ElasticPoolObservation) poolModifier {
+	return func(p *v1alpha1.ElasticPool) { p.Status.AtProvider = o }
+}
+
+func withCapacity(c int32) poolModifier {
+	return func(p *v1alpha1.ElasticPool) { p.Spec.ForProvider.SKU.Capacity = &c }
+}
+
+func withLateInitialized() poolModifier {
+	return func(p *v1alpha1.ElasticPool) {
+		min, max := resource.MustParse("0"), resource.MustParse("50")
+		p.Spec.ForProvider.SKU.Tier = azure.ToStringPtr(skuTier)
+		p.Spec.ForProvider.MaxSizeBytes = to.Int64Ptr(maxSizeBytes)
+		p.Spec.ForProvider.ZoneRedundant = azure.ToBoolPtr(false, azure.FieldRequired)
+		p.Spec.ForProvider.PerDatabaseSettings = &v1alpha1.ElasticPoolPerDatabaseSettings{MinCapacity: &min, MaxCapacity: &max}
+	}
+}
+
+func pool(m ...poolModifier) *v1alpha1.ElasticPool {
+	c := capacity
+	p := &v1alpha1.ElasticPool{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.ElasticPoolSpec{
+			ForProvider: v1alpha1.ElasticPoolParameters{
+				ResourceGroupName: resourceGroupName,
+				ServerName:        serverName,
+				Location:          location,
+				SKU:               v1alpha1.SKU{Name: skuName, Capacity: &c},
+			},
+		},
+	}
+	meta.SetExternalName(p, name)
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func azurePool(state sql.ElasticPoolState) sql.ElasticPool {
+	c, size, min, max := capacity, maxSizeBytes, float64(0), float64(50)
+	return sql.ElasticPool{
+		ID:       azure.ToStringPtr(resourceID),
+		Location: azure.ToStringPtr(location),
+		Sku:      &sql.Sku{Name: azure.ToStringPtr(skuName), Tier: azure.ToStringPtr(skuTier), Capacity: &c},
+		ElasticPoolProperties: &sql.ElasticPoolProperties{
+			State:               state,
+			MaxSizeBytes:        &size,
+			ZoneRedundant:       azure.ToBoolPtr(false, azure.FieldRequired),
+			PerDatabaseSettings: &sql.ElasticPoolPerDatabaseSettings{MinCapacity: &min, MaxCapacity: &max},
+		},
+	}
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		azure.ConnectionSecretKeyResourceID:     []byte(resourceID),
+		azure.ConnectionSecretKeyLocation:       []byte(location),
+		azure.ConnectionSecretKeySubscriptionID: []byte("sub"),
+		azure.ConnectionSecretKeyResourceGroup:  []byte(resourceGroupName),
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  xpresource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	ready := v1alpha1.ElasticPoolObservation{ID: resourceID, State: sqlclients.ElasticPoolStateReady}
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   xpresource.Managed
+		want want
+	}{
+		"NotElasticPool": {
+			ec: &external{client: &fake.MockElasticPoolsClient{}},
+			want: want{
+				err: errors.New(errNotElasticPool),
+			},
+		},
+		"NotFound": {
+			ec: &external{client: &fake.MockElasticPoolsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.ElasticPool, error) {
+					return sql.ElasticPool{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: pool(),
+			want: want{
+				mg: pool(),
+			},
+		},
+		"GetFailed": {
+			ec: &external{client: &fake.MockElasticPoolsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.ElasticPool, error) {
+					return sql.ElasticPool{}, errBoom
+				},
+			}},
+			mg: pool(),
+			want: want{
+				mg:  pool(),
+				err: errors.Wrap(errBoom, errGetElasticPool),
+			},
+		},
+		"Creating": {
+			ec: &external{client: &fake.MockElasticPoolsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.ElasticPool, error) {
+					return azurePool(sql.ElasticPoolStateCreating), nil
+				},
+			}},
+			mg: pool(withLateInitialized()),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+				mg: pool(
+					withLateInitialized(),
+					withConditions(xpv1.Unavailable()),
+					withObservation(v1alpha1.ElasticPoolObservation{ID: resourceID, State: string(sql.ElasticPoolStateCreating)}),
+				),
+			},
+		},
+		"LateInitialized": {
+			ec: &external{client: &fake.MockElasticPoolsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.ElasticPool, error) {
+					return azurePool(sql.ElasticPoolStateReady), nil
+				},
+			}},
+			mg: pool(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       connectionDetails(),
+				},
+				mg: pool(withLateInitialized(), withConditions(xpv1.Available()), withObservation(ready)),
+			},
+		},
+		"NotUpToDate": {
+			ec: &external{client: &fake.MockElasticPoolsClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.ElasticPool, error) {
+					return azurePool(sql.ElasticPoolStateReady), nil
+				},
+			}},
+			mg: pool(withLateInitialized(), withCapacity(100)),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(),
+				},
+				mg: pool(withLateInitialized(), withCapacity(100), withConditions(xpv1.Available()), withObservation(ready)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  xpresource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   xpresource.Managed
+		want want
+	}{
+		"NotElasticPool": {
+			ec: &external{client: &fake.MockElasticPoolsClient{}},
+			want: want{
+				err: errors.New(errNotElasticPool),
+			},
+		},
+		"CreateFailed": {
+			ec: &external{client: &fake.MockElasticPoolsClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ sql.ElasticPool) (sql.ElasticPoolsCreateOrUpdateFuture, error) {
+					return sql.ElasticPoolsCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			mg: pool(),
+			want: want{
+				mg:  pool(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateElasticPool),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockElasticPoolsClient{
+				MockCreateOrUpdate: func(_ context.Context, rg string, s string, n string, p sql.ElasticPool) (sql.ElasticPoolsCreateOrUpdateFuture, error) {
+					if rg != resourceGroupName || s != serverName || n != name {
+						return sql.ElasticPoolsCreateOrUpdateFuture{}, errBoom
+					}
+					if p.Sku == nil || azure.ToString(p.Sku.Name) != skuName {
+						return sql.ElasticPoolsCreateOrUpdateFuture{}, errBoom
+					}
+					return sql.ElasticPoolsCreateOrUpdateFuture{}, nil
+				},
+			}},
+			mg: pool(),
+			want: want{
+				mg: pool(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   xpresource.Managed
+		want error
+	}{
+		"NotElasticPool": {
+			ec:   &external{client: &fake.MockElasticPoolsClient{}},
+			want: errors.New(errNotElasticPool),
+		},
+		"UpdateFailed": {
+			ec: &external{client: &fake.MockElasticPoolsClient{
+				MockUpdate: func(_ context.Context, _ string, _ string, _ string, _ sql.ElasticPoolUpdate) (sql.ElasticPoolsUpdateFuture, error) {
+					return sql.ElasticPoolsUpdateFuture{}, errBoom
+				},
+			}},
+			mg:   pool(),
+			want: errors.Wrap(errBoom, errUpdateElasticPool),
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockElasticPoolsClient{
+				MockUpdate: func(_ context.Context, _ string, _ string, _ string, p sql.ElasticPoolUpdate) (sql.ElasticPoolsUpdateFuture, error) {
+					if p.Sku == nil || p.Sku.Capacity == nil || *p.Sku.Capacity != 100 {
+						return sql.ElasticPoolsUpdateFuture{}, errBoom
+					}
+					return sql.ElasticPoolsUpdateFuture{}, nil
+				},
+			}},
+			mg: pool(withCapacity(100)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   xpresource.Managed
+		want error
+	}{
+		"NotElasticPool": {
+			ec:   &external{client: &fake.MockElasticPoolsClient{}},
+			want: errors.New(errNotElasticPool),
+		},
+		"AlreadyGone": {
+			ec: &external{client: &fake.MockElasticPoolsClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (sql.ElasticPoolsDeleteFuture, error) {
+					return sql.ElasticPoolsDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: pool(),
+		},
+		"DeleteFailed": {
+			ec: &external{client: &fake.MockElasticPoolsClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (sql.ElasticPoolsDeleteFuture, error) {
+					return sql.ElasticPoolsDeleteFuture{}, errBoom
+				},
+			}},
+			mg:   pool(),
+			want: errors.Wrap(errBoom, errDeleteElasticPool),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,407 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqldatabase
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v4.0/sql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/gofrs/uuid"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/sql/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	sqlclients "github.com/crossplane-contrib/provider-azure/pkg/clients/sql"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/sql/fake"
+)
+
+const (
+	name              = "cooldb"
+	resourceGroupName = "coolRG"
+	serverName        = "coolserver"
+	location          = "westeurope"
+	resourceID        = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Sql/servers/coolserver/databases/cooldb"
+	elasticPoolID     = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Sql/servers/coolserver/elasticPools/coolpool"
+	databaseID        = "9f2bd7a6-4a0a-4d43-a1d5-a1b2c3d4e5f6"
+	skuName           = "ElasticPool"
+	skuTier           = "Standard"
+	collation         = "SQL_Latin1_General_CP1_CI_AS"
+	maxSizeBytes      = int64(268435456000)
+)
+
+type databaseModifier func(*v1alpha1.SQLDatabase)
+
+func withConditions(c ...xpv1.Condition) databaseModifier {
+	return func(d *v1alpha1.SQLDatabase) { d.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.SQLDatabaseObservation) databaseModifier {
+	return func(d *v1alpha1.SQLDatabase) { d.Status.AtProvider = o }
+}
+
+func withoutElasticPool() databaseModifier {
+	return func(d *v1alpha1.SQLDatabase) { d.Spec.ForProvider.ElasticPoolID = nil }
+}
+
+func withLateInitialized() databaseModifier {
+	return func(d *v1alpha1.SQLDatabase) {
+		d.Spec.ForProvider.SKU = &v1alpha1.SKU{Name: skuName, Tier: azure.ToStringPtr(skuTier)}
+		d.Spec.ForProvider.Collation = azure.ToStringPtr(collation)
+		d.Spec.ForProvider.MaxSizeBytes = to.Int64Ptr(maxSizeBytes)
+		d.Spec.ForProvider.ZoneRedundant = azure.ToBoolPtr(false, azure.FieldRequired)
+	}
+}
+
+func database(m ...databaseModifier) *v1alpha1.SQLDatabase {
+	d := &v1alpha1.SQLDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.SQLDatabaseSpec{
+			ForProvider: v1alpha1.SQLDatabaseParameters{
+				ResourceGroupName: resourceGroupName,
+				ServerName:        serverName,
+				Location:          location,
+				ElasticPoolID:     azure.ToStringPtr(elasticPoolID),
+			},
+		},
+	}
+	meta.SetExternalName(d, name)
+	for _, f := range m {
+		f(d)
+	}
+	return d
+}
+
+func uuidPtr(s string) *uuid.UUID {
+	u := uuid.Must(uuid.FromString(s))
+	return &u
+}
+
+func azureDatabase(status sql.DatabaseStatus) sql.Database {
+	size := maxSizeBytes
+	return sql.Database{
+		ID:       azure.ToStringPtr(resourceID),
+		Location: azure.ToStringPtr(location),
+		Sku:      &sql.Sku{Name: azure.ToStringPtr(skuName), Tier: azure.ToStringPtr(skuTier)},
+		DatabaseProperties: &sql.DatabaseProperties{
+			Status:                      status,
+			DatabaseID:                  uuidPtr(databaseID),
+			ElasticPoolID:               azure.ToStringPtr(strings.ToLower(elasticPoolID)),
+			Collation:                   azure.ToStringPtr(collation),
+			MaxSizeBytes:                &size,
+			ZoneRedundant:               azure.ToBoolPtr(false, azure.FieldRequired),
+			CurrentServiceObjectiveName: azure.ToStringPtr(skuName),
+		},
+	}
+}
+
+func observation(status string) v1alpha1.SQLDatabaseObservation {
+	return v1alpha1.SQLDatabaseObservation{
+		ID:                          resourceID,
+		Status:                      status,
+		DatabaseID:                  databaseID,
+		CurrentServiceObjectiveName: skuName,
+	}
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		azure.ConnectionSecretKeyResourceID:     []byte(resourceID),
+		azure.ConnectionSecretKeyLocation:       []byte(location),
+		azure.ConnectionSecretKeySubscriptionID: []byte("sub"),
+		azure.ConnectionSecretKeyResourceGroup:  []byte(resourceGroupName),
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  xpresource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	ready := observation(sqlclients.DatabaseStatusOnline)
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   xpresource.Managed
+		want want
+	}{
+		"NotSQLDatabase": {
+			ec: &external{client: &fake.MockDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotSQLDatabase),
+			},
+		},
+		"NotFound": {
+			ec: &external{client: &fake.MockDatabasesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.Database, error) {
+					return sql.Database{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: database(),
+			want: want{
+				mg: database(),
+			},
+		},
+		"GetFailed": {
+			ec: &external{client: &fake.MockDatabasesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.Database, error) {
+					return sql.Database{}, errBoom
+				},
+			}},
+			mg: database(),
+			want: want{
+				mg:  database(),
+				err: errors.Wrap(errBoom, errGetSQLDatabase),
+			},
+		},
+		"Creating": {
+			ec: &external{client: &fake.MockDatabasesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.Database, error) {
+					return azureDatabase(sql.DatabaseStatusCreating), nil
+				},
+			}},
+			mg: database(withLateInitialized()),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+				mg: database(
+					withLateInitialized(),
+					withConditions(xpv1.Unavailable()),
+					withObservation(observation(string(sql.DatabaseStatusCreating))),
+				),
+			},
+		},
+		"LateInitialized": {
+			ec: &external{client: &fake.MockDatabasesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.Database, error) {
+					return azureDatabase(sql.DatabaseStatusOnline), nil
+				},
+			}},
+			mg: database(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       connectionDetails(),
+				},
+				mg: database(withLateInitialized(), withConditions(xpv1.Available()), withObservation(ready)),
+			},
+		},
+		"NotUpToDate": {
+			ec: &external{client: &fake.MockDatabasesClient{
+				MockGet: func(_ context.Context, _ string, _ string, _ string) (sql.Database, error) {
+					return azureDatabase(sql.DatabaseStatusOnline), nil
+				},
+			}},
+			mg: database(withLateInitialized(), withoutElasticPool()),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(),
+				},
+				mg: database(withLateInitialized(), withoutElasticPool(), withConditions(xpv1.Available()), withObservation(ready)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  xpresource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   xpresource.Managed
+		want want
+	}{
+		"NotSQLDatabase": {
+			ec: &external{client: &fake.MockDatabasesClient{}},
+			want: want{
+				err: errors.New(errNotSQLDatabase),
+			},
+		},
+		"CreateFailed": {
+			ec: &external{client: &fake.MockDatabasesClient{
+				MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ string, _ sql.Database) (sql.DatabasesCreateOrUpdateFuture, error) {
+					return sql.DatabasesCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			mg: database(),
+			want: want{
+				mg:  database(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateSQLDatabase),
+			},
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockDatabasesClient{
+				MockCreateOrUpdate: func(_ context.Context, rg string, s string, n string, p sql.Database) (sql.DatabasesCreateOrUpdateFuture, error) {
+					if rg != resourceGroupName || s != serverName || n != name {
+						return sql.DatabasesCreateOrUpdateFuture{}, errBoom
+					}
+					if p.DatabaseProperties == nil || azure.ToString(p.ElasticPoolID) != elasticPoolID {
+						return sql.DatabasesCreateOrUpdateFuture{}, errBoom
+					}
+					return sql.DatabasesCreateOrUpdateFuture{}, nil
+				},
+			}},
+			mg: database(),
+			want: want{
+				mg: database(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   xpresource.Managed
+		want error
+	}{
+		"NotSQLDatabase": {
+			ec:   &external{client: &fake.MockDatabasesClient{}},
+			want: errors.New(errNotSQLDatabase),
+		},
+		"UpdateFailed": {
+			ec: &external{client: &fake.MockDatabasesClient{
+				MockUpdate: func(_ context.Context, _ string, _ string, _ string, _ sql.DatabaseUpdate) (sql.DatabasesUpdateFuture, error) {
+					return sql.DatabasesUpdateFuture{}, errBoom
+				},
+			}},
+			mg:   database(),
+			want: errors.Wrap(errBoom, errUpdateSQLDatabase),
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockDatabasesClient{
+				MockUpdate: func(_ context.Context, _ string, _ string, _ string, p sql.DatabaseUpdate) (sql.DatabasesUpdateFuture, error) {
+					if p.DatabaseProperties == nil || p.ElasticPoolID != nil {
+						return sql.DatabasesUpdateFuture{}, errBoom
+					}
+					return sql.DatabasesUpdateFuture{}, nil
+				},
+			}},
+			mg: database(withoutElasticPool()),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   xpresource.Managed
+		want error
+	}{
+		"NotSQLDatabase": {
+			ec:   &external{client: &fake.MockDatabasesClient{}},
+			want: errors.New(errNotSQLDatabase),
+		},
+		"AlreadyGone": {
+			ec: &external{client: &fake.MockDatabasesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (sql.DatabasesDeleteFuture, error) {
+					return sql.DatabasesDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: database(),
+		},
+		"DeleteFailed": {
+			ec: &external{client: &fake.MockDatabasesClient{
+				MockDelete: func(_ context.Context, _ string, _ string, _ string) (sql.DatabasesDeleteFuture, error) {
+					return sql.DatabasesDeleteFuture{}, errBoom
+				},
+			}},
+			mg:   database(),
+			want: errors.Wrap(errBoom, errDeleteSQLDatabase),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v4.0/sql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v4.0/sql/sqlapi"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/sql/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	sqlclients "github.com/crossplane-contrib/provider-azure/pkg/clients/sql"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotSQLServer       = "managed resource is not a SQLServer"
+	errConnectFailed      = "cannot connect to Azure API"
+	errGenPassword        = "cannot generate admin password"
+	errGetSQLServer       = "cannot get SQLServer"
+	errCreateSQLServer    = "cannot create SQLServer"
+	errUpdateSQLServer    = "cannot update SQLServer"
+	errDeleteSQLServer    = "cannot delete SQLServer"
+	errFetchLastOperation = "cannot fetch last operation"
+)
+
+// Setup adds a controller that reconciles SQLServers.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.SQLServerGroupKind)
+	o = azure.ControllerOptions(v1alpha1.SQLServerGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient()), azure.NewDefaultTagger(mgr.GetClient(), resourceTags)}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&v1alpha1.SQLServer{}).
+		Complete(azure.NewInstrumentedReconciler(v1alpha1.SQLServerGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SQLServerGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1alpha1.SQLServerGroupKind, bo, r, &connecter{kube: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+func resourceTags(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*v1alpha1.SQLServer)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Tags
+}
+
+type connecter struct {
+	kube client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnectFailed)
+	}
+	cl := sql.NewServersClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{kube: c.kube, client: cl, sender: cl.Client, newPasswordFn: password.Generate}, nil
+}
+
+type external struct {
+	kube          client.Client
+	client        sqlapi.ServersClientAPI
+	sender        autorest.Sender
+	newPasswordFn func() (password string, err error)
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SQLServer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSQLServer)
+	}
+
+	az, err := e.client.Get(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	if azure.IsNotFound(err) {
+		if err := azure.FetchAsyncOperation(ctx, e.sender, &cr.Status.AtProvider.LastOperation); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+		}
+		// Azure returns NotFound until the server has been created, so we
+		// report it as existing while a creation we started, possibly before
+		// the controller was restarted, is still in progress. Otherwise we
+		// would attempt to create it again.
+		creating := azure.AsyncOperationInProgress(cr.Status.AtProvider.LastOperation, http.MethodPut)
+		return managed.ExternalObservation{ResourceExists: creating}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSQLServer)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	sqlclients.LateInitializeServer(&cr.Spec.ForProvider, az)
+
+	op := cr.Status.AtProvider.LastOperation
+	cr.Status.AtProvider = sqlclients.GenerateServerObservation(az)
+	cr.Status.AtProvider.LastOperation = op
+	if err := azure.FetchAsyncOperation(ctx, e.sender, &cr.Status.AtProvider.LastOperation); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFetchLastOperation)
+	}
+
+	cd := sqlclients.ServerConnectionDetails(cr.Spec.ForProvider, cr.Status.AtProvider)
+	if azure.WantsConnectionStrings(cr.Spec.ForProvider.ConnectionSecretFormat) {
+		// Connection strings are composed from the password, which is only
+		// known when it is generated, so we read it back from the secret.
+		pw, err := azure.ConnectionSecretPassword(ctx, e.kube, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if pw != "" {
+			cd[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+		}
+	}
+	cd = azure.FormatConnectionDetails(cr.Spec.ForProvider.ConnectionSecretFormat, azure.DatabaseEngineSQLServer, cd)
+	if cr.Status.AtProvider.State != sqlclients.ServerStateReady {
+		cr.SetConditions(xpv1.Unavailable())
+		return managed.ExternalObservation{
+			ResourceExists:          true,
+			ResourceUpToDate:        true,
+			ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+			ConnectionDetails:       cd,
+		}, nil
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        sqlclients.IsServerUpToDate(cr.Spec.ForProvider, az),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails:       cd,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SQLServer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSQLServer)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	pw, err := e.newPasswordFn()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGenPassword)
+	}
+	op, err := e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), sqlclients.NewServerParameters(cr.Spec.ForProvider, pw))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateSQLServer)
+	}
+	cr.Status.AtProvider.LastOperation = azure.NewAsyncOperation(http.MethodPut, op.FutureAPI)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: azure.FormatConnectionDetails(cr.Spec.ForProvider.ConnectionSecretFormat, azure.DatabaseEngineSQLServer, managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		}),
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.SQLServer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSQLServer)
+	}
+	if cr.Status.AtProvider.LastOperation.Status == azure.AsyncOperationStatusInProgress {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	op, err := e.client.Update(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), sqlclients.NewServerUpdate(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSQLServer)
+	}
+	cr.Status.AtProvider.LastOperation = azure.NewAsyncOperation(http.MethodPatch, op.FutureAPI)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.SQLServer)
+	if !ok {
+		return errors.New(errNotSQLServer)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	op, err := e.client.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr))
+	if err != nil {
+		return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteSQLServer)
+	}
+	cr.Status.AtProvider.LastOperation = azure.NewAsyncOperation(http.MethodDelete, op.FutureAPI)
+	return nil
+}
@@ -0,0 +1,456 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlserver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v4.0/sql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/sql/v1alpha1"
+	"github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	sqlclients "github.com/crossplane-contrib/provider-azure/pkg/clients/sql"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/sql/fake"
+)
+
+const (
+	name              = "coolserver"
+	resourceGroupName = "coolRG"
+	location          = "westeurope"
+	resourceID        = "/subscriptions/sub/resourceGroups/coolRG/providers/Microsoft.Sql/servers/coolserver"
+	fqdn              = "coolserver.database.windows.net"
+	adminLogin        = "cooladmin"
+	adminPassword     = "verysecret"
+	version           = "12.0"
+	tlsVersion        = "1.2"
+)
+
+type serverModifier func(*v1alpha1.SQLServer)
+
+func withConditions(c ...xpv1.Condition) serverModifier {
+	return func(s *v1alpha1.SQLServer) { s.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o v1alpha1.SQLServerObservation) serverModifier {
+	return func(s *v1alpha1.SQLServer) { s.Status.AtProvider = o }
+}
+
+func withLastOperation(op v1alpha3.AsyncOperation) serverModifier {
+	return func(s *v1alpha1.SQLServer) { s.Status.AtProvider.LastOperation = op }
+}
+
+func withMinimalTLSVersion(v string) serverModifier {
+	return func(s *v1alpha1.SQLServer) { s.Spec.ForProvider.MinimalTLSVersion = &v }
+}
+
+func withLateInitialized() serverModifier {
+	return func(s *v1alpha1.SQLServer) {
+		s.Spec.ForProvider.Version = azure.ToStringPtr(version)
+		s.Spec.ForProvider.MinimalTLSVersion = azure.ToStringPtr(tlsVersion)
+		s.Spec.ForProvider.PublicNetworkAccess = azure.ToStringPtr(string(sql.ServerPublicNetworkAccessEnabled))
+	}
+}
+
+func server(m ...serverModifier) *v1alpha1.SQLServer {
+	s := &v1alpha1.SQLServer{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.SQLServerSpec{
+			ForProvider: v1alpha1.SQLServerParameters{
+				ResourceGroupName:  resourceGroupName,
+				Location:           location,
+				AdministratorLogin: adminLogin,
+			},
+		},
+	}
+	meta.SetExternalName(s, name)
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func azureServer(state string) sql.Server {
+	return sql.Server{
+		ID:       azure.ToStringPtr(resourceID),
+		Location: azure.ToStringPtr(location),
+		ServerProperties: &sql.ServerProperties{
+			AdministratorLogin:       azure.ToStringPtr(adminLogin),
+			Version:                  azure.ToStringPtr(version),
+			State:                    azure.ToStringPtr(state),
+			FullyQualifiedDomainName: azure.ToStringPtr(fqdn),
+			MinimalTLSVersion:        azure.ToStringPtr(tlsVersion),
+			PublicNetworkAccess:      sql.ServerPublicNetworkAccessEnabled,
+		},
+	}
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		azure.ConnectionSecretKeyResourceID:       []byte(resourceID),
+		azure.ConnectionSecretKeyLocation:         []byte(location),
+		azure.ConnectionSecretKeySubscriptionID:   []byte("sub"),
+		azure.ConnectionSecretKeyResourceGroup:    []byte(resourceGroupName),
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(fqdn),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(v1alpha1.SQLServerPort),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(adminLogin),
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	ready := v1alpha1.SQLServerObservation{ID: resourceID, State: sqlclients.ServerStateReady, FullyQualifiedDomainName: fqdn}
+	creating := v1alpha3.AsyncOperation{Method: http.MethodPut, Status: azure.AsyncOperationStatusInProgress}
+	created := v1alpha3.AsyncOperation{Method: http.MethodPut, Status: "Succeeded"}
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotSQLServer": {
+			ec: &external{client: &fake.MockServersClient{}},
+			want: want{
+				err: errors.New(errNotSQLServer),
+			},
+		},
+		"NotFound": {
+			ec: &external{client: &fake.MockServersClient{
+				MockGet: func(_ context.Context, _ string, _ string) (sql.Server, error) {
+					return sql.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: server(),
+			want: want{
+				mg: server(),
+			},
+		},
+		"CreationInProgress": {
+			ec: &external{client: &fake.MockServersClient{
+				MockGet: func(_ context.Context, _ string, _ string) (sql.Server, error) {
+					return sql.Server{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: server(withLastOperation(creating)),
+			want: want{
+				o:  managed.ExternalObservation{ResourceExists: true},
+				mg: server(withLastOperation(creating)),
+			},
+		},
+		"GetFailed": {
+			ec: &external{client: &fake.MockServersClient{
+				MockGet: func(_ context.Context, _ string, _ string) (sql.Server, error) {
+					return sql.Server{}, errBoom
+				},
+			}},
+			mg: server(),
+			want: want{
+				mg:  server(),
+				err: errors.Wrap(errBoom, errGetSQLServer),
+			},
+		},
+		"NotReady": {
+			ec: &external{client: &fake.MockServersClient{
+				MockGet: func(_ context.Context, _ string, _ string) (sql.Server, error) {
+					return azureServer("Disabled"), nil
+				},
+			}},
+			mg: server(withLateInitialized()),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+				mg: server(
+					withLateInitialized(),
+					withConditions(xpv1.Unavailable()),
+					withObservation(v1alpha1.SQLServerObservation{ID: resourceID, State: "Disabled", FullyQualifiedDomainName: fqdn}),
+				),
+			},
+		},
+		"LateInitialized": {
+			ec: &external{client: &fake.MockServersClient{
+				MockGet: func(_ context.Context, _ string, _ string) (sql.Server, error) {
+					return azureServer(sqlclients.ServerStateReady), nil
+				},
+			}},
+			mg: server(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       connectionDetails(),
+				},
+				mg: server(withLateInitialized(), withConditions(xpv1.Available()), withObservation(ready)),
+			},
+		},
+		"LastOperationPreserved": {
+			ec: &external{client: &fake.MockServersClient{
+				MockGet: func(_ context.Context, _ string, _ string) (sql.Server, error) {
+					return azureServer(sqlclients.ServerStateReady), nil
+				},
+			}},
+			mg: server(withLateInitialized(), withLastOperation(created)),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+				mg: server(withLateInitialized(), withConditions(xpv1.Available()), withObservation(ready), withLastOperation(created)),
+			},
+		},
+		"NotUpToDate": {
+			ec: &external{client: &fake.MockServersClient{
+				MockGet: func(_ context.Context, _ string, _ string) (sql.Server, error) {
+					return azureServer(sqlclients.ServerStateReady), nil
+				},
+			}},
+			mg: server(withLateInitialized(), withMinimalTLSVersion("1.1")),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(),
+				},
+				mg: server(withLateInitialized(), withMinimalTLSVersion("1.1"), withConditions(xpv1.Available()), withObservation(ready)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		c   managed.ExternalCreation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	newPassword := func() (string, error) { return adminPassword, nil }
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotSQLServer": {
+			ec: &external{client: &fake.MockServersClient{}, newPasswordFn: newPassword},
+			want: want{
+				err: errors.New(errNotSQLServer),
+			},
+		},
+		"GeneratePasswordFailed": {
+			ec: &external{
+				client:        &fake.MockServersClient{},
+				newPasswordFn: func() (string, error) { return "", errBoom },
+			},
+			mg: server(),
+			want: want{
+				mg:  server(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errGenPassword),
+			},
+		},
+		"CreateFailed": {
+			ec: &external{
+				client: &fake.MockServersClient{
+					MockCreateOrUpdate: func(_ context.Context, _ string, _ string, _ sql.Server) (sql.ServersCreateOrUpdateFuture, error) {
+						return sql.ServersCreateOrUpdateFuture{}, errBoom
+					},
+				},
+				newPasswordFn: newPassword,
+			},
+			mg: server(),
+			want: want{
+				mg:  server(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateSQLServer),
+			},
+		},
+		"Successful": {
+			ec: &external{
+				client: &fake.MockServersClient{
+					MockCreateOrUpdate: func(_ context.Context, rg string, n string, p sql.Server) (sql.ServersCreateOrUpdateFuture, error) {
+						if rg != resourceGroupName || n != name || azure.ToString(p.Location) != location {
+							return sql.ServersCreateOrUpdateFuture{}, errBoom
+						}
+						if p.ServerProperties == nil || azure.ToString(p.AdministratorLogin) != adminLogin || azure.ToString(p.AdministratorLoginPassword) != adminPassword {
+							return sql.ServersCreateOrUpdateFuture{}, errBoom
+						}
+						return sql.ServersCreateOrUpdateFuture{}, nil
+					},
+				},
+				newPasswordFn: newPassword,
+			},
+			mg: server(),
+			want: want{
+				c: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(adminPassword),
+					},
+				},
+				mg: server(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, err := tc.ec.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.c, c); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotSQLServer": {
+			ec:   &external{client: &fake.MockServersClient{}},
+			want: errors.New(errNotSQLServer),
+		},
+		"UpdateFailed": {
+			ec: &external{client: &fake.MockServersClient{
+				MockUpdate: func(_ context.Context, _ string, _ string, _ sql.ServerUpdate) (sql.ServersUpdateFuture, error) {
+					return sql.ServersUpdateFuture{}, errBoom
+				},
+			}},
+			mg:   server(),
+			want: errors.Wrap(errBoom, errUpdateSQLServer),
+		},
+		"OperationInProgress": {
+			ec: &external{client: &fake.MockServersClient{
+				MockUpdate: func(_ context.Context, _ string, _ string, _ sql.ServerUpdate) (sql.ServersUpdateFuture, error) {
+					return sql.ServersUpdateFuture{}, errBoom
+				},
+			}},
+			mg: server(withLastOperation(v1alpha3.AsyncOperation{Method: http.MethodPatch, Status: azure.AsyncOperationStatusInProgress})),
+		},
+		"Successful": {
+			ec: &external{client: &fake.MockServersClient{
+				MockUpdate: func(_ context.Context, _ string, _ string, p sql.ServerUpdate) (sql.ServersUpdateFuture, error) {
+					if p.ServerProperties == nil || azure.ToString(p.MinimalTLSVersion) != "1.1" {
+						return sql.ServersUpdateFuture{}, errBoom
+					}
+					return sql.ServersUpdateFuture{}, nil
+				},
+			}},
+			mg: server(withMinimalTLSVersion("1.1")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotSQLServer": {
+			ec:   &external{client: &fake.MockServersClient{}},
+			want: errors.New(errNotSQLServer),
+		},
+		"AlreadyGone": {
+			ec: &external{client: &fake.MockServersClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (sql.ServersDeleteFuture, error) {
+					return sql.ServersDeleteFuture{}, autorest.DetailedError{StatusCode: http.StatusNotFound}
+				},
+			}},
+			mg: server(),
+		},
+		"DeleteFailed": {
+			ec: &external{client: &fake.MockServersClient{
+				MockDelete: func(_ context.Context, _ string, _ string) (sql.ServersDeleteFuture, error) {
+					return sql.ServersDeleteFuture{}, errBoom
+				},
+			}},
+			mg:   server(),
+			want: errors.Wrap(errBoom, errDeleteSQLServer),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
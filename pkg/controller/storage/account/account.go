@@ -18,7 +18,10 @@ package account
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-06-01/storage"
@@ -26,6 +29,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,14 +37,22 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
+	apisv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 	azurestorage "github.com/crossplane-contrib/provider-azure/pkg/clients/storage"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings
+const (
+	errFetchLastOperation = "cannot fetch last operation"
 )
 
 const (
@@ -71,15 +83,20 @@ type Reconciler struct {
 // Setup adds a controller that reconciles Accounts.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha3.AccountGroupKind)
+	o = azure.ControllerOptions(v1alpha3.AccountGroupKind, o)
 
 	// NOTE(turkenh): We cannot add support for external secret stores to this
 	// resource since it does not use Crossplane Runtime Managed Reconciler.
 	r := &Reconciler{
-		Client:           mgr.GetClient(),
-		syncdeleterMaker: &accountSyncdeleterMaker{mgr.GetClient()},
-		Initializer:      managed.NewNameAsExternalName(mgr.GetClient()),
-		poll:             o.PollInterval,
-		log:              o.Logger.WithValues("controller", name),
+		Client: mgr.GetClient(),
+		syncdeleterMaker: &accountSyncdeleterMaker{
+			Client:   mgr.GetClient(),
+			simulate: o.Features.Enabled(features.EnableSimulation),
+			record:   event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+		},
+		Initializer: managed.NewNameAsExternalName(mgr.GetClient()),
+		poll:        o.PollInterval,
+		log:         o.Logger.WithValues("controller", name),
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -129,20 +146,29 @@ type syncdeleterMaker interface {
 
 type accountSyncdeleterMaker struct {
 	client.Client
+	simulate bool
+	record   event.Recorder
 }
 
 func (m *accountSyncdeleterMaker) newSyncdeleter(ctx context.Context, b *v1alpha3.Account, poll time.Duration) (syncdeleter, error) {
-	creds, auth, err := azure.GetAuthInfo(ctx, m.Client, b)
+	getAuthInfo := azure.GetAuthInfo
+	if m.simulate {
+		getAuthInfo = azure.GetSimulatedAuthInfo
+	}
+	creds, auth, err := getAuthInfo(ctx, m.Client, b)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get auth information")
 	}
 
 	cl := storage.NewAccountsClient(creds[azure.CredentialsKeySubscriptionID])
 	cl.Authorizer = auth
+	if m.simulate {
+		cl.BaseURI = azure.SimulatedEndpoint(creds)
+	}
 
 	return newAccountSyncDeleter(
 		azurestorage.NewAccountHandle(&cl, b.Spec.ResourceGroupName, meta.GetExternalName(b)),
-		m.Client, b, poll), nil
+		m.Client, b, poll, m.record), nil
 }
 
 type deleter interface {
@@ -181,9 +207,9 @@ type accountSyncDeleter struct {
 	acct *v1alpha3.Account
 }
 
-func newAccountSyncDeleter(ao azurestorage.AccountOperations, kube client.Client, b *v1alpha3.Account, poll time.Duration) *accountSyncDeleter {
+func newAccountSyncDeleter(ao azurestorage.AccountOperations, kube client.Client, b *v1alpha3.Account, poll time.Duration, record event.Recorder) *accountSyncDeleter {
 	return &accountSyncDeleter{
-		createupdater:     newAccountCreateUpdater(ao, kube, b, poll),
+		createupdater:     newAccountCreateUpdater(ao, kube, b, poll, record),
 		AccountOperations: ao,
 		kube:              kube,
 		acct:              b,
@@ -194,8 +220,16 @@ func (asd *accountSyncDeleter) delete(ctx context.Context) (reconcile.Result, er
 	asd.acct.Status.SetConditions(xpv1.Deleting())
 	switch asd.acct.Spec.DeletionPolicy {
 	case xpv1.DeletionDelete, "":
+		if azure.ObserveOnly(asd.acct) {
+			// Accounts that are only observed are orphaned.
+			break
+		}
+		if azure.DeletionProtected(asd.acct) {
+			asd.acct.Status.SetConditions(apisv1alpha3.DeletionBlocked(azure.ErrDeletionProtected.Error()), xpv1.ReconcileError(azure.ErrDeletionProtected))
+			return resultRequeue, asd.kube.Status().Update(ctx, asd.acct)
+		}
 		if err := asd.Delete(ctx); err != nil && !azure.IsNotFound(err) {
-			asd.acct.Status.SetConditions(xpv1.ReconcileError(err))
+			asd.acct.Status.SetConditions(xpv1.ReconcileError(azure.WithRequestIDs(err)))
 			return resultRequeue, asd.kube.Status().Update(ctx, asd.acct)
 		}
 	case xpv1.DeletionOrphan:
@@ -214,11 +248,27 @@ func (asd *accountSyncDeleter) delete(ctx context.Context) (reconcile.Result, er
 func (asd *accountSyncDeleter) sync(ctx context.Context) (reconcile.Result, error) {
 	account, err := asd.Get(ctx)
 	if err != nil && !azure.IsNotFound(err) {
-		asd.acct.Status.SetConditions(xpv1.ReconcileError(err))
+		asd.acct.Status.SetConditions(xpv1.ReconcileError(azure.WithRequestIDs(err)))
 		return resultRequeue, asd.kube.Status().Update(ctx, asd.acct)
 	}
 
 	if account == nil {
+		if azure.ObserveOnly(asd.acct) {
+			asd.acct.Status.SetConditions(xpv1.ReconcileError(azure.ErrObserveOnlyNotFound))
+			return resultRequeue, asd.kube.Status().Update(ctx, asd.acct)
+		}
+		// Azure returns NotFound until the creation of an account completes, so
+		// we check whether a creation we started earlier, possibly before the
+		// controller was restarted, is in fact still in motion. Creating the
+		// account again in that case would fail.
+		if err := azure.FetchAsyncOperation(ctx, asd.GetRESTClient(), &asd.acct.Status.LastOperation); err != nil {
+			asd.acct.Status.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errFetchLastOperation)))
+			return resultRequeue, asd.kube.Status().Update(ctx, asd.acct)
+		}
+		if azure.AsyncOperationInProgress(asd.acct.Status.LastOperation, http.MethodPut) {
+			asd.acct.Status.SetConditions(xpv1.Creating(), xpv1.ReconcileSuccess())
+			return requeueOnWait, asd.kube.Status().Update(ctx, asd.acct)
+		}
 		return asd.create(ctx)
 	}
 
@@ -242,9 +292,9 @@ type accountCreateUpdater struct {
 }
 
 // newAccountCreateUpdater new instance of accountCreateUpdater
-func newAccountCreateUpdater(ao azurestorage.AccountOperations, kube client.Client, acct *v1alpha3.Account, poll time.Duration) *accountCreateUpdater {
+func newAccountCreateUpdater(ao azurestorage.AccountOperations, kube client.Client, acct *v1alpha3.Account, poll time.Duration, record event.Recorder) *accountCreateUpdater {
 	return &accountCreateUpdater{
-		syncbacker:        newAccountSyncBacker(ao, kube, acct, poll),
+		syncbacker:        newAccountSyncBacker(ao, kube, acct, poll, record),
 		AccountOperations: ao,
 		kube:              kube,
 		acct:              acct,
@@ -254,18 +304,29 @@ func newAccountCreateUpdater(ao azurestorage.AccountOperations, kube client.Clie
 
 // create new storage account resource and save changes back to account specs
 func (acu *accountCreateUpdater) create(ctx context.Context) (reconcile.Result, error) {
+	// We persist the finalizer before we start the creation operation, and
+	// the operation as soon as it has started, so that the creation can be
+	// resumed rather than started again if the controller is stopped before
+	// it completes.
+	if !meta.FinalizerExists(acu.acct, finalizer) {
+		meta.AddFinalizer(acu.acct, finalizer)
+		if err := acu.kube.Update(ctx, acu.acct); err != nil {
+			return resultRequeue, err
+		}
+	}
 	acu.acct.Status.SetConditions(xpv1.Creating())
-	meta.AddFinalizer(acu.acct, finalizer)
 
 	accountSpec := v1alpha3.ToStorageAccountCreate(acu.acct.Spec.StorageAccountSpec)
 
-	a, err := acu.Create(ctx, accountSpec)
+	op, err := acu.Create(ctx, accountSpec)
 	if err != nil {
-		acu.acct.Status.SetConditions(xpv1.ReconcileError(err))
+		acu.acct.Status.SetConditions(xpv1.ReconcileError(azure.WithRequestIDs(err)))
 		return resultRequeue, acu.kube.Status().Update(ctx, acu.acct)
 	}
 
-	return acu.syncback(ctx, a)
+	acu.acct.Status.LastOperation = op
+	acu.acct.Status.SetConditions(xpv1.ReconcileSuccess())
+	return requeueOnWait, acu.kube.Status().Update(ctx, acu.acct)
 }
 
 // update storage account resource if needed
@@ -273,6 +334,25 @@ func (acu *accountCreateUpdater) update(ctx context.Context, account *storage.Ac
 	if account.ProvisioningState == storage.Succeeded {
 		acu.acct.Status.SetConditions(xpv1.Available())
 
+		if azure.ObserveOnly(acu.acct) {
+			drifted, err := acu.drifted(ctx, account)
+			if err != nil {
+				acu.acct.Status.SetConditions(xpv1.ReconcileError(azure.WithRequestIDs(err)))
+				return resultRequeue, acu.kube.Status().Update(ctx, acu.acct)
+			}
+			if drifted {
+				acu.acct.Status.SetConditions(apisv1alpha3.Drifted())
+			} else {
+				acu.acct.Status.SetConditions(apisv1alpha3.NotDrifted())
+			}
+			return acu.syncback(ctx, account)
+		}
+
+		if err := acu.syncPublicNetworkAccess(ctx); err != nil {
+			acu.acct.Status.SetConditions(xpv1.ReconcileError(azure.WithRequestIDs(err)))
+			return resultRequeue, acu.kube.Status().Update(ctx, acu.acct)
+		}
+
 		current := v1alpha3.NewStorageAccountSpec(account)
 		if reflect.DeepEqual(current, acu.acct.Spec.StorageAccountSpec) {
 			acu.acct.Status.SetConditions(xpv1.ReconcileSuccess())
@@ -281,7 +361,7 @@ func (acu *accountCreateUpdater) update(ctx context.Context, account *storage.Ac
 
 		a, err := acu.Update(ctx, v1alpha3.ToStorageAccountUpdate(acu.acct.Spec.StorageAccountSpec))
 		if err != nil {
-			acu.acct.Status.SetConditions(xpv1.ReconcileError(err))
+			acu.acct.Status.SetConditions(xpv1.ReconcileError(azure.WithRequestIDs(err)))
 			return resultRequeue, acu.kube.Status().Update(ctx, acu.acct)
 		}
 		account = a
@@ -290,6 +370,41 @@ func (acu *accountCreateUpdater) update(ctx context.Context, account *storage.Ac
 	return acu.syncback(ctx, account)
 }
 
+// syncPublicNetworkAccess sets whether the storage account can be reached over
+// the public internet, if it is not already set as desired. The setting is not
+// part of the storage account spec, which reflects an API version that
+// predates it.
+func (acu *accountCreateUpdater) syncPublicNetworkAccess(ctx context.Context) error {
+	want := acu.acct.Spec.PublicNetworkAccess
+	if want == nil {
+		return nil
+	}
+	got, err := acu.GetPublicNetworkAccess(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get public network access")
+	}
+	if strings.EqualFold(got, *want) {
+		return nil
+	}
+	return errors.Wrap(acu.SetPublicNetworkAccess(ctx, *want), "failed to set public network access")
+}
+
+// drifted returns true if the storage account differs from its desired state.
+func (acu *accountCreateUpdater) drifted(ctx context.Context, account *storage.Account) (bool, error) {
+	if !reflect.DeepEqual(v1alpha3.NewStorageAccountSpec(account), acu.acct.Spec.StorageAccountSpec) {
+		return true, nil
+	}
+	want := acu.acct.Spec.PublicNetworkAccess
+	if want == nil {
+		return false, nil
+	}
+	got, err := acu.GetPublicNetworkAccess(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get public network access")
+	}
+	return !strings.EqualFold(got, *want), nil
+}
+
 type accountSyncbacker struct {
 	secretupdater
 	acct *v1alpha3.Account
@@ -297,9 +412,9 @@ type accountSyncbacker struct {
 	poll time.Duration
 }
 
-func newAccountSyncBacker(ao azurestorage.AccountOperations, kube client.Client, acct *v1alpha3.Account, poll time.Duration) *accountSyncbacker {
+func newAccountSyncBacker(ao azurestorage.AccountOperations, kube client.Client, acct *v1alpha3.Account, poll time.Duration, record event.Recorder) *accountSyncbacker {
 	return &accountSyncbacker{
-		secretupdater: newAccountSecretUpdater(ao, kube, acct),
+		secretupdater: newAccountSecretUpdater(ao, kube, acct, record),
 		kube:          kube,
 		acct:          acct,
 		poll:          poll,
@@ -307,9 +422,13 @@ func newAccountSyncBacker(ao azurestorage.AccountOperations, kube client.Client,
 }
 
 func (asb *accountSyncbacker) syncback(ctx context.Context, acct *storage.Account) (reconcile.Result, error) {
-	asb.acct.Spec.StorageAccountSpec = v1alpha3.NewStorageAccountSpec(acct)
-	if err := asb.kube.Update(ctx, asb.acct); err != nil {
-		return resultRequeue, err
+	// The spec of an account that is only observed is left as is, so that
+	// drift from it can be reported.
+	if !azure.ObserveOnly(asb.acct) {
+		asb.acct.Spec.StorageAccountSpec = v1alpha3.NewStorageAccountSpec(acct)
+		if err := asb.kube.Update(ctx, asb.acct); err != nil {
+			return resultRequeue, err
+		}
 	}
 
 	asb.acct.Status.StorageAccountStatus = v1alpha3.NewStorageAccountStatus(acct)
@@ -320,7 +439,7 @@ func (asb *accountSyncbacker) syncback(ctx context.Context, acct *storage.Accoun
 	}
 
 	if err := asb.updatesecret(ctx, acct); err != nil {
-		asb.acct.Status.SetConditions(xpv1.ReconcileError(err))
+		asb.acct.Status.SetConditions(xpv1.ReconcileError(azure.WithRequestIDs(err)))
 		return resultRequeue, asb.kube.Status().Update(ctx, asb.acct)
 	}
 
@@ -330,15 +449,17 @@ func (asb *accountSyncbacker) syncback(ctx context.Context, acct *storage.Accoun
 
 type accountSecretUpdater struct {
 	azurestorage.AccountOperations
-	acct *v1alpha3.Account
-	kube client.Client
+	acct   *v1alpha3.Account
+	kube   client.Client
+	record event.Recorder
 }
 
-func newAccountSecretUpdater(ao azurestorage.AccountOperations, kube client.Client, acct *v1alpha3.Account) *accountSecretUpdater {
+func newAccountSecretUpdater(ao azurestorage.AccountOperations, kube client.Client, acct *v1alpha3.Account, record event.Recorder) *accountSecretUpdater {
 	return &accountSecretUpdater{
 		AccountOperations: ao,
 		acct:              acct,
 		kube:              kube,
+		record:            record,
 	}
 }
 
@@ -350,6 +471,12 @@ func (asu *accountSecretUpdater) updatesecret(ctx context.Context, acct *storage
 		secret.Data[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(to.String(acct.PrimaryEndpoints.Blob))
 	}
 
+	if !azure.ObserveOnly(asu.acct) && azure.RotationDue(asu.acct.Spec.CredentialRotation, asu.acct.Status.CredentialRotation, asu.acct.GetCreationTimestamp()) {
+		if err := asu.rotateKeys(ctx); err != nil {
+			return err
+		}
+	}
+
 	keys, err := asu.ListKeys(ctx)
 	if err != nil {
 		return errors.Wrapf(err, "failed to list account keys")
@@ -358,15 +485,86 @@ func (asu *accountSecretUpdater) updatesecret(ctx context.Context, acct *storage
 		return errors.New("account keys are empty")
 	}
 
+	for k, v := range azure.ResourceConnectionDetails(to.String(acct.ID), to.String(acct.Location)) {
+		secret.Data[k] = v
+	}
 	secret.Data[xpv1.ResourceCredentialsSecretUserKey] = []byte(meta.GetExternalName(asu.acct))
-	secret.Data[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(to.String(keys[0].Value))
+	active := keys[0]
+	if asu.acct.Status.CredentialRotation.ActiveKey == apisv1alpha3.CredentialKeySecondary && len(keys) > 1 {
+		active = keys[1]
+	}
+	secret.Data[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(to.String(active.Value))
+	// Clients of an account whose keys are rotated may only use the active
+	// key, since the other one is regenerated by the next rotation.
+	if asu.acct.Spec.CredentialRotation == nil {
+		secret.Data[azurestorage.ConnectionSecretKeyPrimaryAccessKey] = []byte(to.String(keys[0].Value))
+		if len(keys) > 1 {
+			secret.Data[azurestorage.ConnectionSecretKeySecondaryAccessKey] = []byte(to.String(keys[1].Value))
+		}
+	}
+
+	var sasExpiry *metav1.Time
+	if p := asu.acct.Spec.SharedAccessSignature; p != nil {
+		token, exp, err := asu.sasToken(ctx, key, *p)
+		if err != nil {
+			return err
+		}
+		secret.Data[azurestorage.ConnectionSecretKeySASToken] = token
+		sasExpiry = exp
+	}
 
 	if err := asu.kube.Create(ctx, secret); err != nil {
-		if kerrors.IsAlreadyExists(err) {
-			return errors.Wrapf(asu.kube.Update(ctx, secret), "failed to update secret: %s", key)
+		if !kerrors.IsAlreadyExists(err) {
+			return errors.Wrapf(err, "failed to create secret: %s", key)
+		}
+		if err := asu.kube.Update(ctx, secret); err != nil {
+			return errors.Wrapf(err, "failed to update secret: %s", key)
 		}
-		return errors.Wrapf(err, "failed to create secret: %s", key)
 	}
 
+	// We only record a new token once it is in the connection secret, so that
+	// we never mistake the token it replaces for it.
+	if sasExpiry != nil {
+		asu.acct.Status.SharedAccessSignatureExpiry = sasExpiry
+		asu.acct.Status.SharedAccessSignatureParametersHash = azurestorage.SASParametersHash(*asu.acct.Spec.SharedAccessSignature)
+	}
+	return nil
+}
+
+// rotateKeys regenerates the access key that is not published as the password
+// of the account, and records that it is to be published instead. Clients
+// that use the previously published key keep working until the next rotation.
+func (asu *accountSecretUpdater) rotateKeys(ctx context.Context) error {
+	standby := azure.StandbyKey(asu.acct.Status.CredentialRotation)
+	if err := asu.RegenerateKey(ctx, standby); err != nil {
+		return errors.Wrap(err, "failed to regenerate account key")
+	}
+	azure.SetRotated(&asu.acct.Status.CredentialRotation, standby)
+	asu.record.Event(asu.acct, event.Normal(azure.ReasonRotatedCredentials, fmt.Sprintf("Regenerated and published the %s access key", strings.ToLower(standby))))
 	return nil
 }
+
+// sasToken returns the shared access signature token currently in the
+// connection secret, unless it was generated with different parameters or
+// less than a quarter of its validity remains, in which case it returns a new
+// one along with its expiry.
+func (asu *accountSecretUpdater) sasToken(ctx context.Context, key types.NamespacedName, p v1alpha3.SharedAccessSignatureParameters) ([]byte, *metav1.Time, error) {
+	validity := azurestorage.SASValidity(p)
+	exp := asu.acct.Status.SharedAccessSignatureExpiry
+	if exp != nil && time.Until(exp.Time) > validity/4 && asu.acct.Status.SharedAccessSignatureParametersHash == azurestorage.SASParametersHash(p) {
+		s := &corev1.Secret{}
+		if err := asu.kube.Get(ctx, key, s); resource.IgnoreNotFound(err) != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get secret: %s", key)
+		}
+		if t := s.Data[azurestorage.ConnectionSecretKeySASToken]; len(t) > 0 {
+			return t, nil, nil
+		}
+	}
+
+	expiry := time.Now().Add(validity)
+	token, err := asu.ListSAS(ctx, p, expiry)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate shared access signature")
+	}
+	return []byte(token), &metav1.Time{Time: expiry}, nil
+}
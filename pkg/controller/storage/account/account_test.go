@@ -29,6 +29,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -39,6 +40,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -46,6 +48,7 @@ import (
 	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
 	v1alpha3test "github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3/test"
 	azurev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 	azurestorage "github.com/crossplane-contrib/provider-azure/pkg/clients/storage"
 	azurestoragefake "github.com/crossplane-contrib/provider-azure/pkg/clients/storage/fake"
 )
@@ -294,6 +297,8 @@ func Test_syncdeleter_delete(t *testing.T) {
 	ctx := context.TODO()
 	bucketName := "test-account"
 	errBoom := errors.New("boom")
+	protected := map[string]string{azure.AnnotationKeyDeletionProtection: azure.AnnotationValueDeletionProtectionEnabled}
+	observeOnly := map[string]string{azure.AnnotationKeyManagementPolicy: azure.AnnotationValueManagementPolicyObserveOnly}
 
 	type fields struct {
 		ao   azurestorage.AccountOperations
@@ -379,6 +384,59 @@ func Test_syncdeleter_delete(t *testing.T) {
 					Account,
 			},
 		},
+		{
+			name: "DeletionProtected",
+			fields: fields{
+				acct: v1alpha3test.NewMockAccount(bucketName).WithSpecDeletionPolicy(xpv1.DeletionDelete).
+					WithAnnotations(protected).
+					WithFinalizer(finalizer).Account,
+				cc: &test.MockClient{
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						return nil
+					},
+				},
+				ao: &azurestoragefake.MockAccountOperations{
+					MockDelete: func(ctx context.Context) error {
+						return errors.New("unexpected call to Delete")
+					},
+				},
+			},
+			want: want{
+				err: nil,
+				res: resultRequeue,
+				acct: v1alpha3test.NewMockAccount(bucketName).WithSpecDeletionPolicy(xpv1.DeletionDelete).
+					WithAnnotations(protected).
+					WithFinalizer(finalizer).
+					WithStatusConditions(xpv1.Deleting(), azurev1alpha3.DeletionBlocked(azure.ErrDeletionProtected.Error()), xpv1.ReconcileError(azure.ErrDeletionProtected)).
+					Account,
+			},
+		},
+		{
+			name: "ObserveOnly",
+			fields: fields{
+				acct: v1alpha3test.NewMockAccount(bucketName).WithSpecDeletionPolicy(xpv1.DeletionDelete).
+					WithAnnotations(observeOnly).
+					WithFinalizer(finalizer).Account,
+				cc: &test.MockClient{
+					MockUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error { return nil },
+				},
+				ao: &azurestoragefake.MockAccountOperations{
+					MockDelete: func(ctx context.Context) error {
+						return errors.New("unexpected call to Delete")
+					},
+				},
+			},
+			want: want{
+				err: nil,
+				res: reconcile.Result{},
+				acct: v1alpha3test.NewMockAccount(bucketName).
+					WithAnnotations(observeOnly).
+					WithFinalizers([]string{}).
+					WithSpecDeletionPolicy(xpv1.DeletionDelete).
+					WithStatusConditions(xpv1.Deleting()).
+					Account,
+			},
+		},
 		{
 			name: "DeleteNonExistent",
 			fields: fields{
@@ -408,7 +466,7 @@ func Test_syncdeleter_delete(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			bh := newAccountSyncDeleter(tt.fields.ao, tt.fields.cc, tt.fields.acct, tt.fields.poll)
+			bh := newAccountSyncDeleter(tt.fields.ao, tt.fields.cc, tt.fields.acct, tt.fields.poll, event.NewNopRecorder())
 			got, err := bh.delete(ctx)
 			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("accountSyncDeleter.delete(): -want error, +got error: \n%s", diff)
@@ -427,6 +485,7 @@ func Test_syncdeleter_sync(t *testing.T) {
 	ctx := context.TODO()
 	name := testAccountName
 	errBoom := errors.New("boom")
+	observeOnly := map[string]string{azure.AnnotationKeyManagementPolicy: azure.AnnotationValueManagementPolicyObserveOnly}
 
 	type fields struct {
 		ao   azurestorage.AccountOperations
@@ -480,6 +539,7 @@ func Test_syncdeleter_sync(t *testing.T) {
 							StatusCode: http.StatusNotFound,
 						}
 					},
+					MockGetRESTClient: func() autorest.Sender { return nil },
 				},
 				acct: v1alpha3test.NewMockAccount(name).WithUID("test-uid").Account,
 				poll: time.Minute,
@@ -489,6 +549,64 @@ func Test_syncdeleter_sync(t *testing.T) {
 				acct: v1alpha3test.NewMockAccount(name).WithUID("test-uid").Account,
 			},
 		},
+		{
+			name: "AttrsNotFoundObserveOnly",
+			fields: fields{
+				kube: &test.MockClient{
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						return nil
+					},
+				},
+				ao: &azurestoragefake.MockAccountOperations{
+					MockGet: func(i context.Context) (attrs *storage.Account, e error) {
+						return nil, autorest.DetailedError{
+							StatusCode: http.StatusNotFound,
+						}
+					},
+				},
+				acct: v1alpha3test.NewMockAccount(name).WithUID("test-uid").WithAnnotations(observeOnly).Account,
+				poll: time.Minute,
+			},
+			want: want{
+				res: resultRequeue,
+				acct: v1alpha3test.NewMockAccount(name).
+					WithUID("test-uid").
+					WithAnnotations(observeOnly).
+					WithStatusConditions(xpv1.ReconcileError(azure.ErrObserveOnlyNotFound)).
+					Account,
+			},
+		},
+		{
+			name: "AttrsNotFoundCreating",
+			fields: fields{
+				kube: &test.MockClient{
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						return nil
+					},
+				},
+				ao: &azurestoragefake.MockAccountOperations{
+					MockGet: func(i context.Context) (attrs *storage.Account, e error) {
+						return nil, autorest.DetailedError{
+							StatusCode: http.StatusNotFound,
+						}
+					},
+					MockGetRESTClient: func() autorest.Sender { return nil },
+				},
+				acct: v1alpha3test.NewMockAccount(name).
+					WithUID("test-uid").
+					WithStatusLastOperation(azurev1alpha3.AsyncOperation{Method: http.MethodPut, Status: azure.AsyncOperationStatusInProgress}).
+					Account,
+				poll: time.Minute,
+			},
+			want: want{
+				res: requeueOnWait,
+				acct: v1alpha3test.NewMockAccount(name).
+					WithUID("test-uid").
+					WithStatusLastOperation(azurev1alpha3.AsyncOperation{Method: http.MethodPut, Status: azure.AsyncOperationStatusInProgress}).
+					WithStatusConditions(xpv1.Creating(), xpv1.ReconcileSuccess()).
+					Account,
+			},
+		},
 		{
 			name: "Update",
 			fields: fields{
@@ -536,6 +654,11 @@ func Test_createupdater_create(t *testing.T) {
 	ctx := context.TODO()
 	name := testAccountName
 	errBoom := errors.New("boom")
+	creating := azurev1alpha3.AsyncOperation{
+		Method:     http.MethodPut,
+		PollingURL: "https://crossplane.io/operation",
+		Status:     azure.AsyncOperationStatusInProgress,
+	}
 
 	type fields struct {
 		sb        syncbacker
@@ -559,11 +682,12 @@ func Test_createupdater_create(t *testing.T) {
 			name: "CreateFailed",
 			fields: fields{
 				ao: &azurestoragefake.MockAccountOperations{
-					MockCreate: func(ctx context.Context, params storage.AccountCreateParameters) (*storage.Account, error) {
-						return nil, errBoom
+					MockCreate: func(ctx context.Context, params storage.AccountCreateParameters) (azurev1alpha3.AsyncOperation, error) {
+						return azurev1alpha3.AsyncOperation{}, errBoom
 					},
 				},
 				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
 					MockStatusUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
 						return nil
 					},
@@ -580,15 +704,16 @@ func Test_createupdater_create(t *testing.T) {
 			},
 		},
 		{
-			name: "CreateSuccessful",
+			name: "UpdateFailed",
 			fields: fields{
-				sb: &MockAccountSyncbacker{
-					MockSyncback: func(ctx context.Context, a *storage.Account) (result reconcile.Result, e error) {
-						return resultRequeue, errBoom
+				ao: &azurestoragefake.MockAccountOperations{
+					MockCreate: func(ctx context.Context, params storage.AccountCreateParameters) (azurev1alpha3.AsyncOperation, error) {
+						return azurev1alpha3.AsyncOperation{}, errors.New("started creating the account before persisting the finalizer")
 					},
 				},
-				ao:   azurestoragefake.NewMockAccountOperations(),
-				kube: test.NewMockClient(),
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
 				acct: v1alpha3test.NewMockAccount(name).
 					WithUID("test-uid").
 					Account,
@@ -599,7 +724,56 @@ func Test_createupdater_create(t *testing.T) {
 				obj: v1alpha3test.NewMockAccount(name).
 					WithUID("test-uid").
 					WithFinalizer(finalizer).
-					WithStatusConditions(xpv1.Creating()).
+					Account,
+			},
+		},
+		{
+			name: "FinalizerExists",
+			fields: fields{
+				ao: &azurestoragefake.MockAccountOperations{
+					MockCreate: func(ctx context.Context, params storage.AccountCreateParameters) (azurev1alpha3.AsyncOperation, error) {
+						return creating, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockUpdateFn(errors.New("persisted the finalizer again")),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+				},
+				acct: v1alpha3test.NewMockAccount(name).
+					WithUID("test-uid").
+					WithFinalizer(finalizer).
+					Account,
+			},
+			want: want{
+				res: requeueOnWait,
+				obj: v1alpha3test.NewMockAccount(name).
+					WithUID("test-uid").
+					WithFinalizer(finalizer).
+					WithStatusConditions(xpv1.Creating(), xpv1.ReconcileSuccess()).
+					WithStatusLastOperation(creating).
+					Account,
+			},
+		},
+		{
+			name: "CreateSuccessful",
+			fields: fields{
+				ao: &azurestoragefake.MockAccountOperations{
+					MockCreate: func(ctx context.Context, params storage.AccountCreateParameters) (azurev1alpha3.AsyncOperation, error) {
+						return creating, nil
+					},
+				},
+				kube: test.NewMockClient(),
+				acct: v1alpha3test.NewMockAccount(name).
+					WithUID("test-uid").
+					Account,
+			},
+			want: want{
+				res: requeueOnWait,
+				obj: v1alpha3test.NewMockAccount(name).
+					WithUID("test-uid").
+					WithFinalizer(finalizer).
+					WithStatusConditions(xpv1.Creating(), xpv1.ReconcileSuccess()).
+					WithStatusLastOperation(creating).
 					Account,
 			},
 		},
@@ -627,10 +801,16 @@ func Test_createupdater_create(t *testing.T) {
 	}
 }
 
+func withPublicNetworkAccess(a *v1alpha3.Account, access string) *v1alpha3.Account {
+	a.Spec.PublicNetworkAccess = to.StringPtr(access)
+	return a
+}
+
 func Test_bucketCreateUpdater_update(t *testing.T) {
 	ctx := context.TODO()
 	name := testAccountName
 	errBoom := errors.New("boom")
+	observeOnly := map[string]string{azure.AnnotationKeyManagementPolicy: azure.AnnotationValueManagementPolicyObserveOnly}
 
 	type fields struct {
 		sb   syncbacker
@@ -687,6 +867,58 @@ func Test_bucketCreateUpdater_update(t *testing.T) {
 					Account,
 			},
 		},
+		{
+			name: "PublicNetworkAccessSet",
+			attrs: &storage.Account{
+				AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded},
+			},
+			fields: fields{
+				acct: withPublicNetworkAccess(v1alpha3test.NewMockAccount(name).
+					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
+					Account, "Disabled"),
+				ao: &azurestoragefake.MockAccountOperations{
+					MockGetPublicNetworkAccess: func(_ context.Context) (string, error) { return "Enabled", nil },
+					MockSetPublicNetworkAccess: func(_ context.Context, access string) error {
+						if access != "Disabled" {
+							return errors.Errorf("unexpected public network access %q", access)
+						}
+						return nil
+					},
+				},
+				kube: test.NewMockClient(),
+				poll: time.Minute,
+			},
+			want: want{
+				res: reconcile.Result{RequeueAfter: time.Minute},
+				acct: withPublicNetworkAccess(v1alpha3test.NewMockAccount(name).
+					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
+					WithStatusConditions(xpv1.Available(), xpv1.ReconcileSuccess()).
+					Account, "Disabled"),
+			},
+		},
+		{
+			name: "PublicNetworkAccessFailed",
+			attrs: &storage.Account{
+				AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded},
+			},
+			fields: fields{
+				acct: withPublicNetworkAccess(v1alpha3test.NewMockAccount(name).
+					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
+					Account, "Disabled"),
+				ao: &azurestoragefake.MockAccountOperations{
+					MockGetPublicNetworkAccess: func(_ context.Context) (string, error) { return "", nil },
+					MockSetPublicNetworkAccess: func(_ context.Context, _ string) error { return errBoom },
+				},
+				kube: test.NewMockClient(),
+			},
+			want: want{
+				res: resultRequeue,
+				acct: withPublicNetworkAccess(v1alpha3test.NewMockAccount(name).
+					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
+					WithStatusConditions(xpv1.Available(), xpv1.ReconcileError(errors.Wrap(errBoom, "failed to set public network access"))).
+					Account, "Disabled"),
+			},
+		},
 		{
 			name: "UpdateFailed",
 			attrs: &storage.Account{
@@ -712,6 +944,101 @@ func Test_bucketCreateUpdater_update(t *testing.T) {
 					Account,
 			},
 		},
+		{
+			name: "ObserveOnlyNotDrifted",
+			attrs: &storage.Account{
+				AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded},
+			},
+			fields: fields{
+				sb: &MockAccountSyncbacker{
+					MockSyncback: func(ctx context.Context, a *storage.Account) (result reconcile.Result, e error) {
+						return reconcile.Result{RequeueAfter: time.Minute}, nil
+					},
+				},
+				acct: v1alpha3test.NewMockAccount(name).
+					WithAnnotations(observeOnly).
+					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
+					Account,
+				ao: &azurestoragefake.MockAccountOperations{
+					MockUpdate: func(ctx context.Context, update storage.AccountUpdateParameters) (attrs *storage.Account, e error) {
+						return nil, errors.New("unexpected call to Update")
+					},
+				},
+				poll: time.Minute,
+			},
+			want: want{
+				res: reconcile.Result{RequeueAfter: time.Minute},
+				acct: v1alpha3test.NewMockAccount(name).
+					WithAnnotations(observeOnly).
+					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
+					WithStatusConditions(xpv1.Available(), azurev1alpha3.NotDrifted()).
+					Account,
+			},
+		},
+		{
+			name: "ObserveOnlyDrifted",
+			attrs: &storage.Account{
+				AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded},
+				Location:          to.StringPtr("test-location"),
+			},
+			fields: fields{
+				sb: &MockAccountSyncbacker{
+					MockSyncback: func(ctx context.Context, a *storage.Account) (result reconcile.Result, e error) {
+						return reconcile.Result{RequeueAfter: time.Minute}, nil
+					},
+				},
+				acct: v1alpha3test.NewMockAccount(name).
+					WithAnnotations(observeOnly).
+					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
+					Account,
+				ao: &azurestoragefake.MockAccountOperations{
+					MockUpdate: func(ctx context.Context, update storage.AccountUpdateParameters) (attrs *storage.Account, e error) {
+						return nil, errors.New("unexpected call to Update")
+					},
+				},
+				poll: time.Minute,
+			},
+			want: want{
+				res: reconcile.Result{RequeueAfter: time.Minute},
+				acct: v1alpha3test.NewMockAccount(name).
+					WithAnnotations(observeOnly).
+					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
+					WithStatusConditions(xpv1.Available(), azurev1alpha3.Drifted()).
+					Account,
+			},
+		},
+		{
+			name: "ObserveOnlyPublicNetworkAccessDrifted",
+			attrs: &storage.Account{
+				AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded},
+			},
+			fields: fields{
+				sb: &MockAccountSyncbacker{
+					MockSyncback: func(ctx context.Context, a *storage.Account) (result reconcile.Result, e error) {
+						return reconcile.Result{RequeueAfter: time.Minute}, nil
+					},
+				},
+				acct: withPublicNetworkAccess(v1alpha3test.NewMockAccount(name).
+					WithAnnotations(observeOnly).
+					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
+					Account, "Disabled"),
+				ao: &azurestoragefake.MockAccountOperations{
+					MockGetPublicNetworkAccess: func(_ context.Context) (string, error) { return "Enabled", nil },
+					MockSetPublicNetworkAccess: func(_ context.Context, _ string) error {
+						return errors.New("unexpected call to SetPublicNetworkAccess")
+					},
+				},
+				poll: time.Minute,
+			},
+			want: want{
+				res: reconcile.Result{RequeueAfter: time.Minute},
+				acct: withPublicNetworkAccess(v1alpha3test.NewMockAccount(name).
+					WithAnnotations(observeOnly).
+					WithSpecStorageAccountSpec(newStoragAccountSpecWithProperties()).
+					WithStatusConditions(xpv1.Available(), azurev1alpha3.Drifted()).
+					Account, "Disabled"),
+			},
+		},
 		{
 			name: "UpdateSuccess",
 			attrs: &storage.Account{
@@ -844,6 +1171,39 @@ func Test_accountSyncBacker_syncback(t *testing.T) {
 					WithStatusConditions(xpv1.ReconcileError(errBoom)).Account,
 			},
 		},
+		{
+			name: "ObserveOnly",
+			fields: fields{
+				secretupdater: &MockAccountSecretupdater{
+					MockUpdateSecret: func(ctx context.Context, a *storage.Account) error { return nil },
+				},
+				acct: v1alpha3test.NewMockAccount(name).
+					WithAnnotations(map[string]string{azure.AnnotationKeyManagementPolicy: azure.AnnotationValueManagementPolicyObserveOnly}).
+					Account,
+				kube: &test.MockClient{
+					MockUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						return errors.New("unexpected call to Update")
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error { return nil },
+				},
+				poll: time.Minute,
+			},
+			acct: &storage.Account{
+				AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded},
+				Location:          to.StringPtr("test-location"),
+			},
+			want: want{
+				res: reconcile.Result{RequeueAfter: time.Minute},
+				acct: v1alpha3test.NewMockAccount(name).
+					WithAnnotations(map[string]string{azure.AnnotationKeyManagementPolicy: azure.AnnotationValueManagementPolicyObserveOnly}).
+					WithStorageAccountStatus(v1alpha3.NewStorageAccountStatus(&storage.Account{
+						AccountProperties: &storage.AccountProperties{ProvisioningState: storage.Succeeded},
+						Location:          to.StringPtr("test-location"),
+					})).
+					WithStatusConditions(xpv1.ReconcileSuccess()).
+					Account,
+			},
+		},
 		{
 			name: "Success",
 			fields: fields{
@@ -891,6 +1251,27 @@ func Test_accountSyncBacker_syncback(t *testing.T) {
 	}
 }
 
+func withSAS(a *v1alpha3.Account, expiry *metav1.Time) *v1alpha3.Account {
+	a.Spec.SharedAccessSignature = &v1alpha3.SharedAccessSignatureParameters{}
+	a.Status.SharedAccessSignatureExpiry = expiry
+	if expiry != nil {
+		a.Status.SharedAccessSignatureParametersHash = azurestorage.SASParametersHash(*a.Spec.SharedAccessSignature)
+	}
+	return a
+}
+
+func withSASPermissions(a *v1alpha3.Account, permissions string) *v1alpha3.Account {
+	a.Spec.SharedAccessSignature.Permissions = permissions
+	return a
+}
+
+func withCredentialRotation(a *v1alpha3.Account, active string) *v1alpha3.Account {
+	a.Spec.CredentialRotation = &azurev1alpha3.CredentialRotationPolicy{Period: metav1.Duration{Duration: time.Hour}}
+	a.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-2 * time.Hour)))
+	a.Status.CredentialRotation.ActiveKey = active
+	return a
+}
+
 func Test_accountSecretUpdater_updatesecret(t *testing.T) {
 	ctx := context.TODO()
 	ns := testNamespace
@@ -908,6 +1289,7 @@ func Test_accountSecretUpdater_updatesecret(t *testing.T) {
 		fields  fields
 		acct    *storage.Account
 		wantErr error
+		wantSAS bool
 	}{
 		{
 			name: "FailedListKeys",
@@ -1054,6 +1436,199 @@ func Test_accountSecretUpdater_updatesecret(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "GenerateSASFailed",
+			fields: fields{
+				ops: &azurestoragefake.MockAccountOperations{
+					MockListKeys: func(ctx context.Context) (keys []storage.AccountKey, e error) {
+						return []storage.AccountKey{{Value: to.StringPtr("test-value")}}, nil
+					},
+					MockListSAS: func(context.Context, v1alpha3.SharedAccessSignatureParameters, time.Time) (string, error) {
+						return "", errors.New("test-list-sas-error")
+					},
+				},
+				acct: withSAS(v1alpha3test.NewMockAccount(name).WithSpecWriteConnectionSecretToReference(ns, csName).Account, nil),
+			},
+			acct:    &storage.Account{AccountProperties: &storage.AccountProperties{}},
+			wantErr: errors.Wrap(errors.New("test-list-sas-error"), "failed to generate shared access signature"),
+		},
+		{
+			name: "GenerateSAS",
+			fields: fields{
+				ops: &azurestoragefake.MockAccountOperations{
+					MockListKeys: func(ctx context.Context) (keys []storage.AccountKey, e error) {
+						return []storage.AccountKey{{Value: to.StringPtr("test-value")}, {Value: to.StringPtr("test-value-2")}}, nil
+					},
+					MockListSAS: func(context.Context, v1alpha3.SharedAccessSignatureParameters, time.Time) (string, error) {
+						return "test-new-token", nil
+					},
+				},
+				kube: &test.MockClient{
+					MockCreate: func(ctx context.Context, obj client.Object, _ ...client.CreateOption) error {
+						want := map[string]string{
+							azurestorage.ConnectionSecretKeyPrimaryAccessKey:   "test-value",
+							azurestorage.ConnectionSecretKeySecondaryAccessKey: "test-value-2",
+							azurestorage.ConnectionSecretKeySASToken:           "test-new-token",
+						}
+						for k, v := range want {
+							if diff := cmp.Diff(v, string(obj.(*corev1.Secret).Data[k])); diff != "" {
+								t.Errorf("Create(...): -want %s, +got %s:\n%s", k, k, diff)
+							}
+						}
+						return nil
+					},
+				},
+				// The token in the connection secret is about to expire.
+				acct: withSAS(v1alpha3test.NewMockAccount(name).WithSpecWriteConnectionSecretToReference(ns, csName).Account, &metav1.Time{Time: time.Now().Add(time.Hour)}),
+			},
+			acct:    &storage.Account{AccountProperties: &storage.AccountProperties{}},
+			wantSAS: true,
+		},
+		{
+			name: "WriteSASFailed",
+			fields: fields{
+				ops: &azurestoragefake.MockAccountOperations{
+					MockListKeys: func(ctx context.Context) (keys []storage.AccountKey, e error) {
+						return []storage.AccountKey{{Value: to.StringPtr("test-value")}}, nil
+					},
+					MockListSAS: func(context.Context, v1alpha3.SharedAccessSignatureParameters, time.Time) (string, error) {
+						return "test-new-token", nil
+					},
+				},
+				kube: &test.MockClient{
+					MockCreate: func(ctx context.Context, obj client.Object, _ ...client.CreateOption) error {
+						return errors.New("test-create-secret-error")
+					},
+				},
+				acct: withSAS(v1alpha3test.NewMockAccount(name).WithSpecWriteConnectionSecretToReference(ns, csName).Account, &metav1.Time{Time: time.Now().Add(time.Hour)}),
+			},
+			acct:    &storage.Account{AccountProperties: &storage.AccountProperties{}},
+			wantErr: errors.Wrapf(errors.New("test-create-secret-error"), "failed to create secret: %s/%s", ns, csName),
+		},
+		{
+			name: "SASParametersChanged",
+			fields: fields{
+				ops: &azurestoragefake.MockAccountOperations{
+					MockListKeys: func(ctx context.Context) (keys []storage.AccountKey, e error) {
+						return []storage.AccountKey{{Value: to.StringPtr("test-value")}}, nil
+					},
+					MockListSAS: func(_ context.Context, p v1alpha3.SharedAccessSignatureParameters, _ time.Time) (string, error) {
+						return "test-new-token-" + p.Permissions, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{azurestorage.ConnectionSecretKeySASToken: []byte("test-token")}
+						return nil
+					},
+					MockCreate: func(ctx context.Context, obj client.Object, _ ...client.CreateOption) error {
+						if diff := cmp.Diff("test-new-token-rw", string(obj.(*corev1.Secret).Data[azurestorage.ConnectionSecretKeySASToken])); diff != "" {
+							t.Errorf("Create(...): -want token, +got token:\n%s", diff)
+						}
+						return nil
+					},
+				},
+				// The token in the connection secret is still fresh, but was
+				// generated with different permissions.
+				acct: withSASPermissions(withSAS(v1alpha3test.NewMockAccount(name).WithSpecWriteConnectionSecretToReference(ns, csName).Account, &metav1.Time{Time: time.Now().Add(23 * time.Hour)}), "rw"),
+			},
+			acct:    &storage.Account{AccountProperties: &storage.AccountProperties{}},
+			wantSAS: true,
+		},
+		{
+			name: "ReuseSAS",
+			fields: fields{
+				ops: &azurestoragefake.MockAccountOperations{
+					MockListKeys: func(ctx context.Context) (keys []storage.AccountKey, e error) {
+						return []storage.AccountKey{{Value: to.StringPtr("test-value")}}, nil
+					},
+					MockListSAS: func(context.Context, v1alpha3.SharedAccessSignatureParameters, time.Time) (string, error) {
+						return "", errors.New("unexpected call to ListSAS")
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{azurestorage.ConnectionSecretKeySASToken: []byte("test-token")}
+						return nil
+					},
+					MockCreate: func(ctx context.Context, obj client.Object, _ ...client.CreateOption) error {
+						if diff := cmp.Diff("test-token", string(obj.(*corev1.Secret).Data[azurestorage.ConnectionSecretKeySASToken])); diff != "" {
+							t.Errorf("Create(...): -want token, +got token:\n%s", diff)
+						}
+						return nil
+					},
+				},
+				acct: withSAS(v1alpha3test.NewMockAccount(name).WithSpecWriteConnectionSecretToReference(ns, csName).Account, &metav1.Time{Time: time.Now().Add(23 * time.Hour)}),
+			},
+			acct: &storage.Account{AccountProperties: &storage.AccountProperties{}},
+		},
+		{
+			name: "RotateKeysFailed",
+			fields: fields{
+				ops: &azurestoragefake.MockAccountOperations{
+					MockRegenerateKey: func(context.Context, string) error {
+						return errors.New("test-regenerate-key-error")
+					},
+				},
+				acct: withCredentialRotation(v1alpha3test.NewMockAccount(name).WithSpecWriteConnectionSecretToReference(ns, csName).Account, ""),
+			},
+			acct:    &storage.Account{AccountProperties: &storage.AccountProperties{}},
+			wantErr: errors.Wrap(errors.New("test-regenerate-key-error"), "failed to regenerate account key"),
+		},
+		{
+			name: "ObserveOnlyNoRotation",
+			fields: fields{
+				ops: &azurestoragefake.MockAccountOperations{
+					MockRegenerateKey: func(context.Context, string) error {
+						return errors.New("unexpected call to RegenerateKey")
+					},
+					MockListKeys: func(ctx context.Context) (keys []storage.AccountKey, e error) {
+						return []storage.AccountKey{{Value: to.StringPtr("test-value")}}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockCreate: func(ctx context.Context, obj client.Object, _ ...client.CreateOption) error { return nil },
+				},
+				acct: withCredentialRotation(v1alpha3test.NewMockAccount(name).
+					WithAnnotations(map[string]string{azure.AnnotationKeyManagementPolicy: azure.AnnotationValueManagementPolicyObserveOnly}).
+					WithSpecWriteConnectionSecretToReference(ns, csName).Account, ""),
+			},
+			acct: &storage.Account{AccountProperties: &storage.AccountProperties{}},
+		},
+		{
+			name: "RotateKeys",
+			fields: fields{
+				ops: &azurestoragefake.MockAccountOperations{
+					MockRegenerateKey: func(_ context.Context, key string) error {
+						if key != azurev1alpha3.CredentialKeyPrimary {
+							return errors.Errorf("regenerated the %s key", key)
+						}
+						return nil
+					},
+					MockListKeys: func(ctx context.Context) (keys []storage.AccountKey, e error) {
+						return []storage.AccountKey{{Value: to.StringPtr("test-value")}, {Value: to.StringPtr("test-value-2")}}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockCreate: func(ctx context.Context, obj client.Object, _ ...client.CreateOption) error {
+						data := obj.(*corev1.Secret).Data
+						if diff := cmp.Diff("test-value", string(data[xpv1.ResourceCredentialsSecretPasswordKey])); diff != "" {
+							t.Errorf("Create(...): -want password, +got password:\n%s", diff)
+						}
+						for _, k := range []string{azurestorage.ConnectionSecretKeyPrimaryAccessKey, azurestorage.ConnectionSecretKeySecondaryAccessKey} {
+							if _, ok := data[k]; ok {
+								t.Errorf("Create(...): %s is published although keys are rotated", k)
+							}
+						}
+						return nil
+					},
+				},
+				// The secondary key is published, so the primary one is
+				// regenerated and published instead.
+				acct: withCredentialRotation(v1alpha3test.NewMockAccount(name).WithSpecWriteConnectionSecretToReference(ns, csName).Account, azurev1alpha3.CredentialKeySecondary),
+			},
+			acct: &storage.Account{AccountProperties: &storage.AccountProperties{}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1061,11 +1636,16 @@ func Test_accountSecretUpdater_updatesecret(t *testing.T) {
 				AccountOperations: tt.fields.ops,
 				acct:              tt.fields.acct,
 				kube:              tt.fields.kube,
+				record:            event.NewNopRecorder(),
 			}
+			sas := tt.fields.acct.Status.SharedAccessSignatureExpiry
 			err := asu.updatesecret(ctx, tt.acct)
 			if diff := cmp.Diff(tt.wantErr, err, test.EquateErrors()); diff != "" {
 				t.Errorf("accountSyncBackSecretUpdater.syncback() -want error, +got error:\n%s", diff)
 			}
+			if got := tt.fields.acct.Status.SharedAccessSignatureExpiry != sas; got != tt.wantSAS {
+				t.Errorf("accountSyncBackSecretUpdater.syncback(): want new token recorded %t, got %t", tt.wantSAS, got)
+			}
 		})
 	}
 }
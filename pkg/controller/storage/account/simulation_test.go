@@ -0,0 +1,113 @@
+//go:build simulation
+// +build simulation
+
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-06-01/storage"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/test/simulation"
+)
+
+func TestSimulation(t *testing.T) {
+	srv := simulation.NewRecording(t, "storageaccount.json").Serve()
+	ctx := context.Background()
+
+	acct := &v1alpha3.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: simulation.AzuriteAccount, UID: "simulated-account"},
+		Spec: v1alpha3.AccountSpec{
+			AccountParameters: v1alpha3.AccountParameters{
+				ResourceGroupName: simulation.ResourceGroup,
+				StorageAccountSpec: &v1alpha3.StorageAccountSpec{
+					Kind:     storage.Storage,
+					Location: simulation.Location,
+					Sku:      &v1alpha3.Sku{Name: storage.StandardLRS},
+				},
+			},
+		},
+	}
+	acct.SetProviderConfigReference(&xpv1.Reference{Name: simulation.ProviderConfigName})
+	acct.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: testNamespace, Name: simulation.AzuriteAccount})
+	meta.SetExternalName(acct, simulation.AzuriteAccount)
+
+	kube := simulation.NewKube(t, srv.URL, acct)
+	r := &Reconciler{
+		Client:           kube,
+		syncdeleterMaker: &accountSyncdeleterMaker{Client: kube, simulate: true, record: event.NewNopRecorder()},
+		Initializer:      managed.NewNameAsExternalName(kube),
+		poll:             time.Minute,
+		log:              logging.NewNopLogger(),
+	}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: simulation.AzuriteAccount}}
+
+	// The account does not exist yet, so it is created.
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile(...): %v", err)
+	}
+	simulation.Get(t, kube, acct)
+	if diff := cmp.Diff(xpv1.Creating(), acct.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+		t.Errorf("Reconcile(...): -want ready condition, +got ready condition:\n%s", diff)
+	}
+
+	// The account exists, so its state and connection details are published.
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile(...): %v", err)
+	}
+	simulation.Get(t, kube, acct)
+	if diff := cmp.Diff(xpv1.Available(), acct.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+		t.Errorf("Reconcile(...): -want ready condition, +got ready condition:\n%s", diff)
+	}
+	if diff := cmp.Diff(xpv1.ReconcileSuccess(), acct.GetCondition(xpv1.TypeSynced), test.EquateConditions()); diff != "" {
+		t.Errorf("Reconcile(...): -want synced condition, +got synced condition:\n%s", diff)
+	}
+	s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: simulation.AzuriteAccount}}
+	simulation.Get(t, kube, s)
+	if diff := cmp.Diff(simulation.AzuriteKey, string(s.Data[xpv1.ResourceCredentialsSecretPasswordKey])); diff != "" {
+		t.Errorf("Reconcile(...): -want password, +got password:\n%s", diff)
+	}
+
+	// The account is deleted, so its finalizer is removed once it is.
+	if err := kube.Delete(ctx, acct); err != nil {
+		t.Fatalf("Delete(...): %v", err)
+	}
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile(...): %v", err)
+	}
+	if err := kube.Get(ctx, req.NamespacedName, acct); !kerrors.IsNotFound(err) {
+		t.Errorf("Reconcile(...): want account to be deleted, got error %v", err)
+	}
+}
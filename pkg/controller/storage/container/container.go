@@ -39,8 +39,10 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
+	apisv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
 	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 	"github.com/crossplane-contrib/provider-azure/pkg/clients/storage"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
 )
 
 const (
@@ -74,10 +76,11 @@ type Reconciler struct {
 // Setup adds a controller that reconciles Containers.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha3.ContainerGroupKind)
+	o = azure.ControllerOptions(v1alpha3.ContainerGroupKind, o)
 
 	r := &Reconciler{
 		Client:           mgr.GetClient(),
-		syncdeleterMaker: &containerSyncdeleterMaker{mgr.GetClient()},
+		syncdeleterMaker: &containerSyncdeleterMaker{Client: mgr.GetClient(), simulate: o.Features.Enabled(features.EnableSimulation)},
 		Initializer:      managed.NewNameAsExternalName(mgr.GetClient()),
 		poll:             o.PollInterval,
 		log:              o.Logger.WithValues("controller", name),
@@ -126,6 +129,7 @@ type syncdeleterMaker interface {
 
 type containerSyncdeleterMaker struct {
 	client.Client
+	simulate bool
 }
 
 func (m *containerSyncdeleterMaker) newSyncdeleter(ctx context.Context, c *v1alpha3.Container, poll time.Duration) (syncdeleter, error) { // nolint:gocyclo
@@ -172,6 +176,12 @@ func (m *containerSyncdeleterMaker) newSyncdeleter(ctx context.Context, c *v1alp
 	containerName := meta.GetExternalName(c)
 
 	ch, err := storage.NewContainerHandle(accountName, accountPassword, containerName)
+	if m.simulate {
+		// Simulators such as Azurite serve the blob service from the endpoint
+		// they reported for the storage account.
+		endpoint := string(s.Data[xpv1.ResourceCredentialsSecretEndpointKey])
+		ch, err = storage.NewContainerHandleWithEndpoint(endpoint, accountName, accountPassword, containerName)
+	}
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create client handle: %s, storage account: %s", containerName, accountName)
 	}
@@ -225,7 +235,12 @@ type containerSyncdeleter struct {
 
 func (csd *containerSyncdeleter) delete(ctx context.Context) (reconcile.Result, error) {
 	csd.container.Status.SetConditions(xpv1.Deleting())
-	if csd.container.Spec.DeletionPolicy == xpv1.DeletionDelete {
+	// Containers that are only observed are orphaned.
+	if csd.container.Spec.DeletionPolicy == xpv1.DeletionDelete && !azure.ObserveOnly(csd.container) {
+		if azure.DeletionProtected(csd.container) {
+			csd.container.Status.SetConditions(apisv1alpha3.DeletionBlocked(azure.ErrDeletionProtected.Error()), xpv1.ReconcileError(azure.ErrDeletionProtected))
+			return resultRequeue, csd.kube.Status().Update(ctx, csd.container)
+		}
 		if err := csd.Delete(ctx); err != nil && !azure.IsNotFound(err) {
 			csd.container.Status.SetConditions(xpv1.ReconcileError(err))
 			return resultRequeue, csd.kube.Status().Update(ctx, csd.container)
@@ -247,6 +262,10 @@ func (csd *containerSyncdeleter) sync(ctx context.Context) (reconcile.Result, er
 	}
 
 	if access == nil {
+		if azure.ObserveOnly(csd.container) {
+			csd.container.Status.SetConditions(xpv1.ReconcileError(azure.ErrObserveOnlyNotFound))
+			return resultRequeue, csd.kube.Status().Update(ctx, csd.container)
+		}
 		return csd.create(ctx)
 	}
 
@@ -291,7 +310,13 @@ func (ccu *containerCreateUpdater) update(ctx context.Context, accessType *azblo
 	container := ccu.container
 	spec := container.Spec
 
-	if !reflect.DeepEqual(*accessType, spec.PublicAccessType) || !reflect.DeepEqual(meta, spec.Metadata) {
+	upToDate := reflect.DeepEqual(*accessType, spec.PublicAccessType) && reflect.DeepEqual(meta, spec.Metadata)
+	switch {
+	case azure.ObserveOnly(container) && upToDate:
+		container.Status.SetConditions(apisv1alpha3.NotDrifted())
+	case azure.ObserveOnly(container):
+		container.Status.SetConditions(apisv1alpha3.Drifted())
+	case !upToDate:
 		if err := ccu.Update(ctx, spec.PublicAccessType, spec.Metadata); err != nil {
 			container.Status.SetConditions(xpv1.ReconcileError(err))
 			return resultRequeue, ccu.kube.Status().Update(ctx, container)
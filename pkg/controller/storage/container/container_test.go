@@ -47,6 +47,8 @@ import (
 
 	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
 	v1alpha3test "github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3/test"
+	apisv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
 	"github.com/crossplane-contrib/provider-azure/pkg/clients/storage"
 	azurestoragefake "github.com/crossplane-contrib/provider-azure/pkg/clients/storage/fake"
 )
@@ -141,6 +143,11 @@ const (
 	testAccountName   = "testAccount"
 )
 
+var (
+	observeOnly = map[string]string{azure.AnnotationKeyManagementPolicy: azure.AnnotationValueManagementPolicyObserveOnly}
+	protected   = map[string]string{azure.AnnotationKeyDeletionProtection: azure.AnnotationValueDeletionProtectionEnabled}
+)
+
 func TestReconciler_Reconcile(t *testing.T) {
 	key := types.NamespacedName{Name: testContainerName}
 	req := reconcile.Request{NamespacedName: key}
@@ -560,6 +567,58 @@ func Test_containerSyncdeleter_delete(t *testing.T) {
 					Container,
 			},
 		},
+		{
+			name: "DeletionProtected",
+			fields: fields{
+				kube: test.NewMockClient(),
+				ContainerOperations: &azurestoragefake.MockContainerOperations{
+					MockDelete: func(ctx context.Context) error {
+						return errors.New("unexpected call to Delete")
+					},
+				},
+				container: v1alpha3test.NewMockContainer(testContainerName).
+					WithAnnotations(protected).
+					WithSpecDeletionPolicy(xpv1.DeletionDelete).
+					WithFinalizer(finalizer).
+					Container,
+			},
+			args: args{ctx: ctx},
+			want: want{
+				res: resultRequeue,
+				cont: v1alpha3test.NewMockContainer(testContainerName).
+					WithAnnotations(protected).
+					WithSpecDeletionPolicy(xpv1.DeletionDelete).
+					WithFinalizer(finalizer).
+					WithStatusConditions(xpv1.Deleting(), apisv1alpha3.DeletionBlocked(azure.ErrDeletionProtected.Error()), xpv1.ReconcileError(azure.ErrDeletionProtected)).
+					Container,
+			},
+		},
+		{
+			name: "ObserveOnly",
+			fields: fields{
+				kube: test.NewMockClient(),
+				ContainerOperations: &azurestoragefake.MockContainerOperations{
+					MockDelete: func(ctx context.Context) error {
+						return errors.New("unexpected call to Delete")
+					},
+				},
+				container: v1alpha3test.NewMockContainer(testContainerName).
+					WithAnnotations(observeOnly).
+					WithSpecDeletionPolicy(xpv1.DeletionDelete).
+					WithFinalizer(finalizer).
+					Container,
+			},
+			args: args{ctx: ctx},
+			want: want{
+				res: reconcile.Result{},
+				cont: v1alpha3test.NewMockContainer(testContainerName).
+					WithAnnotations(observeOnly).
+					WithSpecDeletionPolicy(xpv1.DeletionDelete).
+					WithFinalizers([]string{}).
+					WithStatusConditions(xpv1.Deleting()).
+					Container,
+			},
+		},
 		{
 			name: "DeleteErrorOther",
 			fields: fields{
@@ -640,9 +699,12 @@ func Test_containerSyncdeleter_sync(t *testing.T) {
 						return nil, nil, newStorageNotFoundError()
 					},
 				},
+				container: v1alpha3test.NewMockContainer(testContainerName).Container,
 			},
 			args: args{ctx: ctx},
-			want: want{},
+			want: want{
+				cont: v1alpha3test.NewMockContainer(testContainerName).Container,
+			},
 		},
 		{
 			name: "GetErrorOther",
@@ -682,6 +744,31 @@ func Test_containerSyncdeleter_sync(t *testing.T) {
 				cont: v1alpha3test.NewMockContainer(testContainerName).Container,
 			},
 		},
+		{
+			name: "ObserveOnlyNotFound",
+			fields: fields{
+				createupdater: &mockCreateUpdater{
+					mockCreate: func(ctx context.Context) (reconcile.Result, error) {
+						return reconcile.Result{}, errors.New("unexpected call to create")
+					},
+				},
+				ContainerOperations: &azurestoragefake.MockContainerOperations{
+					MockGet: func(ctx context.Context) (*azblob.PublicAccessType, azblob.Metadata, error) {
+						return nil, nil, newStorageNotFoundError()
+					},
+				},
+				container: v1alpha3test.NewMockContainer(testContainerName).WithAnnotations(observeOnly).Container,
+				kube:      test.NewMockClient(),
+			},
+			args: args{ctx: ctx},
+			want: want{
+				res: resultRequeue,
+				cont: v1alpha3test.NewMockContainer(testContainerName).
+					WithAnnotations(observeOnly).
+					WithStatusConditions(xpv1.ReconcileError(azure.ErrObserveOnlyNotFound)).
+					Container,
+			},
+		},
 		{
 			name: "Update",
 			fields: fields{
@@ -870,6 +957,63 @@ func Test_containerCreateUpdater_update(t *testing.T) {
 					Container,
 			},
 		},
+		{
+			name: "ObserveOnlyNotDrifted",
+			fields: fields{
+				container: v1alpha3test.NewMockContainer(testContainerName).
+					WithAnnotations(observeOnly).
+					WithSpecPAC(azblob.PublicAccessContainer).Container,
+				ContainerOperations: &azurestoragefake.MockContainerOperations{
+					MockUpdate: func(ctx context.Context, publicAccessType azblob.PublicAccessType, meta azblob.Metadata) error {
+						return errors.New("unexpected call to Update")
+					},
+				},
+				kube: test.NewMockClient(),
+				poll: time.Minute,
+			},
+			args: args{
+				ctx:        ctx,
+				accessType: azurestoragefake.PublicAccessTypePtr(azblob.PublicAccessContainer),
+			},
+			want: want{
+				res: reconcile.Result{RequeueAfter: time.Minute},
+				cont: v1alpha3test.NewMockContainer(testContainerName).
+					WithAnnotations(observeOnly).
+					WithSpecPAC(azblob.PublicAccessContainer).
+					WithStatusConditions(apisv1alpha3.NotDrifted(), xpv1.Available(), xpv1.ReconcileSuccess()).
+					Container,
+			},
+		},
+		{
+			name: "ObserveOnlyDrifted",
+			fields: fields{
+				container: v1alpha3test.NewMockContainer(testContainerName).
+					WithAnnotations(observeOnly).
+					WithSpecPAC(azblob.PublicAccessContainer).Container,
+				ContainerOperations: &azurestoragefake.MockContainerOperations{
+					MockUpdate: func(ctx context.Context, publicAccessType azblob.PublicAccessType, meta azblob.Metadata) error {
+						return errors.New("unexpected call to Update")
+					},
+				},
+				kube: test.NewMockClient(),
+				poll: time.Minute,
+			},
+			args: args{
+				ctx:        ctx,
+				accessType: azurestoragefake.PublicAccessTypePtr(azblob.PublicAccessContainer),
+				meta: azblob.Metadata{
+					"foo": "bar",
+				},
+			},
+			want: want{
+				res: reconcile.Result{RequeueAfter: time.Minute},
+				cont: v1alpha3test.NewMockContainer(testContainerName).
+					WithAnnotations(observeOnly).
+					WithSpecPAC(azblob.PublicAccessContainer).
+					WithStatusConditions(apisv1alpha3.Drifted(), xpv1.Available(), xpv1.ReconcileSuccess()).
+					Container,
+			},
+		},
 		{
 			name: "ContainerUpdateFailed",
 			fields: fields{
@@ -0,0 +1,133 @@
+//go:build simulation
+// +build simulation
+
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/storage"
+	"github.com/crossplane-contrib/provider-azure/test/simulation"
+)
+
+func TestSimulation(t *testing.T) {
+	endpoint := os.Getenv(simulation.EnvAzuriteBlobEndpoint)
+	if endpoint == "" {
+		t.Skipf("%s is not set", simulation.EnvAzuriteBlobEndpoint)
+	}
+	ctx := context.Background()
+
+	// Containers are connected to Azurite using the connection details of
+	// the storage account they belong to.
+	acct := &v1alpha3.Account{ObjectMeta: metav1.ObjectMeta{Name: simulation.AzuriteAccount}}
+	acct.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: testNamespace, Name: simulation.AzuriteAccount})
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: simulation.AzuriteAccount},
+		Data: map[string][]byte{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+			xpv1.ResourceCredentialsSecretUserKey:     []byte(simulation.AzuriteAccount),
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(simulation.AzuriteKey),
+		},
+	}
+	c := &v1alpha3.Container{
+		ObjectMeta: metav1.ObjectMeta{Name: testContainerName},
+		Spec: v1alpha3.ContainerSpec{
+			ResourceSpec:        xpv1.ResourceSpec{DeletionPolicy: xpv1.DeletionDelete},
+			ContainerParameters: v1alpha3.ContainerParameters{PublicAccessType: azblob.PublicAccessNone},
+		},
+	}
+	c.SetProviderConfigReference(&xpv1.Reference{Name: simulation.AzuriteAccount})
+
+	kube := simulation.NewKube(t, endpoint, acct, s, c)
+	r := &Reconciler{
+		Client:           kube,
+		syncdeleterMaker: &containerSyncdeleterMaker{Client: kube, simulate: true},
+		Initializer:      managed.NewNameAsExternalName(kube),
+		poll:             time.Minute,
+		log:              logging.NewNopLogger(),
+	}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testContainerName}}
+
+	// The container does not exist yet, so it is created.
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile(...): %v", err)
+	}
+	simulation.Get(t, kube, c)
+	if diff := cmp.Diff(xpv1.Available(), c.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+		t.Errorf("Reconcile(...): -want ready condition, +got ready condition:\n%s", diff)
+	}
+
+	// The container exists, so it is updated to match its spec.
+	md := azblob.Metadata{"cool": "true"}
+	c.Spec.PublicAccessType = azblob.PublicAccessContainer
+	c.Spec.Metadata = md
+	if err := kube.Update(ctx, c); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile(...): %v", err)
+	}
+	simulation.Get(t, kube, c)
+	if diff := cmp.Diff(xpv1.ReconcileSuccess(), c.GetCondition(xpv1.TypeSynced), test.EquateConditions()); diff != "" {
+		t.Errorf("Reconcile(...): -want synced condition, +got synced condition:\n%s", diff)
+	}
+
+	h, err := storage.NewContainerHandleWithEndpoint(endpoint, simulation.AzuriteAccount, simulation.AzuriteKey, testContainerName)
+	if err != nil {
+		t.Fatalf("NewContainerHandleWithEndpoint(...): %v", err)
+	}
+	access, got, err := h.Get(ctx)
+	if err != nil {
+		t.Fatalf("Get(...): %v", err)
+	}
+	if diff := cmp.Diff(azblob.PublicAccessContainer, *access); diff != "" {
+		t.Errorf("Get(...): -want access, +got access:\n%s", diff)
+	}
+	if diff := cmp.Diff(md, got); diff != "" {
+		t.Errorf("Get(...): -want metadata, +got metadata:\n%s", diff)
+	}
+
+	// The container is deleted, so its finalizer is removed once it is.
+	if err := kube.Delete(ctx, c); err != nil {
+		t.Fatalf("Delete(...): %v", err)
+	}
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile(...): %v", err)
+	}
+	if err := kube.Get(ctx, req.NamespacedName, c); !kerrors.IsNotFound(err) {
+		t.Errorf("Reconcile(...): want container to be deleted, got error %v", err)
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fileshare
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage/storageapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	azurestorage "github.com/crossplane-contrib/provider-azure/pkg/clients/storage"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotFileShare    = "managed resource is not a FileShare"
+	errCreateFileShare = "cannot create FileShare"
+	errGetFileShare    = "cannot get FileShare"
+	errUpdateFileShare = "cannot update FileShare"
+	errDeleteFileShare = "cannot delete FileShare"
+	errListKeys        = "cannot list access keys of storage account"
+)
+
+// Setup adds a controller that reconciles FileShares.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.FileShareGroupKind)
+	o = azure.ControllerOptions(v1alpha3.FileShareGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient())}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&v1alpha3.FileShare{}).
+		Complete(azure.NewInstrumentedReconciler(v1alpha3.FileShareGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.FileShareGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1alpha3.FileShareGroupKind, bo, r, &connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	shares := storage.NewFileSharesClient(creds[azure.CredentialsKeySubscriptionID])
+	shares.Authorizer = auth
+	accounts := storage.NewAccountsClient(creds[azure.CredentialsKeySubscriptionID])
+	accounts.Authorizer = auth
+	return &external{shares: shares, accounts: accounts}, nil
+}
+
+type external struct {
+	shares   storageapi.FileSharesClientAPI
+	accounts storageapi.AccountsClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	s, ok := mg.(*v1alpha3.FileShare)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFileShare)
+	}
+
+	p := s.Spec.ForProvider
+	az, err := e.shares.Get(ctx, p.ResourceGroupName, p.AccountName, meta.GetExternalName(s), storage.Stats)
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFileShare)
+	}
+
+	// The Azure Files CSI driver mounts shares using the access keys of
+	// their storage account.
+	keys, err := e.accounts.ListKeys(ctx, p.ResourceGroupName, p.AccountName, "")
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListKeys)
+	}
+
+	current := s.Spec.ForProvider.DeepCopy()
+	azurestorage.LateInitializeFileShare(&s.Spec.ForProvider, az)
+
+	s.Status.AtProvider = azurestorage.GenerateFileShareObservation(az)
+	s.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        azurestorage.IsFileShareUpToDate(s.Spec.ForProvider, az),
+		ResourceLateInitialized: !cmp.Equal(current, &s.Spec.ForProvider),
+		ConnectionDetails:       azurestorage.FileShareConnectionDetails(p.AccountName, keys),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	s, ok := mg.(*v1alpha3.FileShare)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFileShare)
+	}
+
+	s.SetConditions(xpv1.Creating())
+	p := s.Spec.ForProvider
+	_, err := e.shares.Create(ctx, p.ResourceGroupName, p.AccountName, meta.GetExternalName(s), azurestorage.NewFileShare(p))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFileShare)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	s, ok := mg.(*v1alpha3.FileShare)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFileShare)
+	}
+
+	p := s.Spec.ForProvider
+	_, err := e.shares.Update(ctx, p.ResourceGroupName, p.AccountName, meta.GetExternalName(s), azurestorage.NewFileShareUpdate(p))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFileShare)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	s, ok := mg.(*v1alpha3.FileShare)
+	if !ok {
+		return errors.New(errNotFileShare)
+	}
+
+	s.SetConditions(xpv1.Deleting())
+	p := s.Spec.ForProvider
+	_, err := e.shares.Delete(ctx, p.ResourceGroupName, p.AccountName, meta.GetExternalName(s))
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteFileShare)
+}
@@ -0,0 +1,404 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fileshare
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/go-autorest/autorest"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis/storage/v1alpha3"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	azurestorage "github.com/crossplane-contrib/provider-azure/pkg/clients/storage"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/storage/fake"
+)
+
+const (
+	name              = "coolshare"
+	accountName       = "coolaccount"
+	resourceGroupName = "coolRG"
+	resourceID        = "a-very-cool-id"
+	accountKey        = "a-very-cool-key"
+)
+
+var errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+
+type shareModifier func(*v1alpha3.FileShare)
+
+func withConditions(c ...xpv1.Condition) shareModifier {
+	return func(s *v1alpha3.FileShare) { s.Status.ConditionedStatus.Conditions = c }
+}
+
+func withID(id string) shareModifier {
+	return func(s *v1alpha3.FileShare) { s.Status.AtProvider.ID = id }
+}
+
+func withQuota(q int) shareModifier {
+	return func(s *v1alpha3.FileShare) { s.Spec.ForProvider.ShareQuota = azure.ToInt32Ptr(q) }
+}
+
+func withProtocols(p string) shareModifier {
+	return func(s *v1alpha3.FileShare) { s.Spec.ForProvider.EnabledProtocols = azure.ToStringPtr(p) }
+}
+
+func share(m ...shareModifier) *v1alpha3.FileShare {
+	s := &v1alpha3.FileShare{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha3.FileShareSpec{
+			ForProvider: v1alpha3.FileShareParameters{
+				ResourceGroupName: resourceGroupName,
+				AccountName:       accountName,
+			},
+		},
+	}
+
+	meta.SetExternalName(s, name)
+
+	for _, f := range m {
+		f(s)
+	}
+
+	return s
+}
+
+func keys() storage.AccountListKeysResult {
+	return storage.AccountListKeysResult{Keys: &[]storage.AccountKey{{Value: azure.ToStringPtr(accountKey)}}}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotFileShare": {
+			ec: &external{},
+			want: want{
+				err: errors.New(errNotFileShare),
+			},
+		},
+		"SuccessfulObserveNotExist": {
+			ec: &external{shares: &fake.MockFileSharesClient{
+				MockGet: func(_ context.Context, _, _, _ string, _ storage.GetShareExpand) (storage.FileShare, error) {
+					return storage.FileShare{}, errNotFound
+				},
+			}},
+			mg: share(),
+			want: want{
+				mg: share(),
+			},
+		},
+		"SuccessfulObserveLateInitialized": {
+			ec: &external{
+				shares: &fake.MockFileSharesClient{
+					MockGet: func(_ context.Context, _, _, _ string, _ storage.GetShareExpand) (storage.FileShare, error) {
+						return storage.FileShare{
+							ID: azure.ToStringPtr(resourceID),
+							FileShareProperties: &storage.FileShareProperties{
+								ShareQuota:       azure.ToInt32Ptr(5120),
+								EnabledProtocols: storage.SMB,
+							},
+						}, nil
+					},
+				},
+				accounts: &fake.MockAccountsClient{
+					MockListKeys: func(_ context.Context, _, _ string, _ storage.ListKeyExpand) (storage.AccountListKeysResult, error) {
+						return keys(), nil
+					},
+				},
+			},
+			mg: share(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azurestorage.ConnectionSecretKeyAzureStorageAccountName: []byte(accountName),
+						azurestorage.ConnectionSecretKeyAzureStorageAccountKey:  []byte(accountKey),
+					},
+				},
+				mg: share(withQuota(5120), withProtocols("SMB"), withID(resourceID), withConditions(xpv1.Available())),
+			},
+		},
+		"SuccessfulObserveNeedsUpdate": {
+			ec: &external{
+				shares: &fake.MockFileSharesClient{
+					MockGet: func(_ context.Context, _, _, _ string, _ storage.GetShareExpand) (storage.FileShare, error) {
+						return storage.FileShare{
+							ID: azure.ToStringPtr(resourceID),
+							FileShareProperties: &storage.FileShareProperties{
+								ShareQuota:       azure.ToInt32Ptr(5120),
+								EnabledProtocols: storage.SMB,
+							},
+						}, nil
+					},
+				},
+				accounts: &fake.MockAccountsClient{
+					MockListKeys: func(_ context.Context, _, _ string, _ storage.ListKeyExpand) (storage.AccountListKeysResult, error) {
+						return keys(), nil
+					},
+				},
+			},
+			mg: share(withQuota(100), withProtocols("SMB")),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						azurestorage.ConnectionSecretKeyAzureStorageAccountName: []byte(accountName),
+						azurestorage.ConnectionSecretKeyAzureStorageAccountKey:  []byte(accountKey),
+					},
+				},
+				mg: share(withQuota(100), withProtocols("SMB"), withID(resourceID), withConditions(xpv1.Available())),
+			},
+		},
+		"FailedObserve": {
+			ec: &external{shares: &fake.MockFileSharesClient{
+				MockGet: func(_ context.Context, _, _, _ string, _ storage.GetShareExpand) (storage.FileShare, error) {
+					return storage.FileShare{}, errBoom
+				},
+			}},
+			mg: share(),
+			want: want{
+				mg:  share(),
+				err: errors.Wrap(errBoom, errGetFileShare),
+			},
+		},
+		"FailedListKeys": {
+			ec: &external{
+				shares: &fake.MockFileSharesClient{
+					MockGet: func(_ context.Context, _, _, _ string, _ storage.GetShareExpand) (storage.FileShare, error) {
+						return storage.FileShare{}, nil
+					},
+				},
+				accounts: &fake.MockAccountsClient{
+					MockListKeys: func(_ context.Context, _, _ string, _ storage.ListKeyExpand) (storage.AccountListKeysResult, error) {
+						return storage.AccountListKeysResult{}, errBoom
+					},
+				},
+			},
+			mg: share(),
+			want: want{
+				mg:  share(),
+				err: errors.Wrap(errBoom, errListKeys),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.ec.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotFileShare": {
+			ec: &external{},
+			want: want{
+				err: errors.New(errNotFileShare),
+			},
+		},
+		"ErrorCreate": {
+			ec: &external{shares: &fake.MockFileSharesClient{
+				MockCreate: func(_ context.Context, _, _, _ string, _ storage.FileShare) (storage.FileShare, error) {
+					return storage.FileShare{}, errBoom
+				},
+			}},
+			mg: share(),
+			want: want{
+				mg:  share(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFileShare),
+			},
+		},
+		"Successful": {
+			ec: &external{shares: &fake.MockFileSharesClient{
+				MockCreate: func(_ context.Context, _, _, _ string, s storage.FileShare) (storage.FileShare, error) {
+					if azure.ToInt(s.ShareQuota) != 100 || s.EnabledProtocols != storage.NFS {
+						return storage.FileShare{}, errors.New("unexpected quota or protocols")
+					}
+					return storage.FileShare{}, nil
+				},
+			}},
+			mg: share(withQuota(100), withProtocols("NFS")),
+			want: want{
+				mg: share(withQuota(100), withProtocols("NFS"), withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotFileShare": {
+			ec:   &external{},
+			want: errors.New(errNotFileShare),
+		},
+		"ErrorUpdate": {
+			ec: &external{shares: &fake.MockFileSharesClient{
+				MockUpdate: func(_ context.Context, _, _, _ string, _ storage.FileShare) (storage.FileShare, error) {
+					return storage.FileShare{}, errBoom
+				},
+			}},
+			mg:   share(withQuota(200)),
+			want: errors.Wrap(errBoom, errUpdateFileShare),
+		},
+		"Successful": {
+			ec: &external{shares: &fake.MockFileSharesClient{
+				MockUpdate: func(_ context.Context, _, _, _ string, s storage.FileShare) (storage.FileShare, error) {
+					if azure.ToInt(s.ShareQuota) != 200 || s.EnabledProtocols != "" {
+						return storage.FileShare{}, errors.New("unexpected quota or protocols")
+					}
+					return storage.FileShare{}, nil
+				},
+			}},
+			mg: share(withQuota(200), withProtocols("SMB")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.ec.Update(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		ec   managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotFileShare": {
+			ec: &external{},
+			want: want{
+				err: errors.New(errNotFileShare),
+			},
+		},
+		"SuccessfulNotFound": {
+			ec: &external{shares: &fake.MockFileSharesClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (autorest.Response, error) {
+					return autorest.Response{}, errNotFound
+				},
+			}},
+			mg: share(),
+			want: want{
+				mg: share(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			ec: &external{shares: &fake.MockFileSharesClient{
+				MockDelete: func(_ context.Context, _, _, _ string) (autorest.Response, error) {
+					return autorest.Response{}, errBoom
+				},
+			}},
+			mg: share(),
+			want: want{
+				mg:  share(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFileShare),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.ec.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appserviceplan
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	websdk "github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/web/v1alpha1"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/web/fake"
+)
+
+const (
+	name              = "cool-plan"
+	resourceGroupName = "cool-rg"
+	id                = "cool-id"
+	sku               = "P1v2"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+)
+
+type modifier func(*v1alpha1.AppServicePlan)
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(p *v1alpha1.AppServicePlan) { p.Status.SetConditions(c...) }
+}
+
+func withCapacity(c int32) modifier {
+	return func(p *v1alpha1.AppServicePlan) { p.Spec.ForProvider.SKU.Capacity = to.Int32Ptr(c) }
+}
+
+func withOSType(t string) modifier {
+	return func(p *v1alpha1.AppServicePlan) { p.Spec.ForProvider.OSType = to.StringPtr(t) }
+}
+
+func withObservation(o v1alpha1.AppServicePlanObservation) modifier {
+	return func(p *v1alpha1.AppServicePlan) { p.Status.AtProvider = o }
+}
+
+func plan(m ...modifier) *v1alpha1.AppServicePlan {
+	p := &v1alpha1.AppServicePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.AppServicePlanSpec{
+			ForProvider: v1alpha1.AppServicePlanParameters{
+				ResourceGroupName: resourceGroupName,
+				SKU:               v1alpha1.AppServicePlanSKU{Name: sku},
+			},
+		},
+	}
+	meta.SetExternalName(p, name)
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func azurePlan(state websdk.ProvisioningState, capacity int32) websdk.AppServicePlan {
+	return websdk.AppServicePlan{
+		ID:  to.StringPtr(id),
+		Sku: &websdk.SkuDescription{Name: to.StringPtr(sku), Capacity: to.Int32Ptr(capacity)},
+		AppServicePlanProperties: &websdk.AppServicePlanProperties{
+			Reserved:          to.BoolPtr(true),
+			ProvisioningState: state,
+		},
+	}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotAppServicePlan": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotAppServicePlan),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockAppServicePlansClient{
+				MockGet: func(_ context.Context, _, _ string) (websdk.AppServicePlan, error) {
+					return websdk.AppServicePlan{}, errNotFound
+				},
+			}},
+			mg: plan(),
+			want: want{
+				mg: plan(),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			e: &external{client: &fake.MockAppServicePlansClient{
+				MockGet: func(_ context.Context, _, _ string) (websdk.AppServicePlan, error) {
+					return websdk.AppServicePlan{}, errBoom
+				},
+			}},
+			mg: plan(),
+			want: want{
+				mg:  plan(),
+				err: errors.Wrap(errBoom, errGetAppServicePlan),
+			},
+		},
+		"Creating": {
+			e: &external{client: &fake.MockAppServicePlansClient{
+				MockGet: func(_ context.Context, _, _ string) (websdk.AppServicePlan, error) {
+					return azurePlan(websdk.ProvisioningStateInProgress, 1), nil
+				},
+			}},
+			mg: plan(withCapacity(2), withOSType(v1alpha1.OSTypeLinux)),
+			want: want{
+				mg: plan(withCapacity(2), withOSType(v1alpha1.OSTypeLinux),
+					withObservation(v1alpha1.AppServicePlanObservation{ID: id, ProvisioningState: "InProgress"}),
+					withConditions(xpv1.Unavailable())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			e: &external{client: &fake.MockAppServicePlansClient{
+				MockGet: func(_ context.Context, _, _ string) (websdk.AppServicePlan, error) {
+					return azurePlan(websdk.ProvisioningStateSucceeded, 1), nil
+				},
+			}},
+			mg: plan(withCapacity(2), withOSType(v1alpha1.OSTypeLinux)),
+			want: want{
+				mg: plan(withCapacity(2), withOSType(v1alpha1.OSTypeLinux),
+					withObservation(v1alpha1.AppServicePlanObservation{ID: id, ProvisioningState: "Succeeded"}),
+					withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LateInitialized": {
+			e: &external{client: &fake.MockAppServicePlansClient{
+				MockGet: func(_ context.Context, _, _ string) (websdk.AppServicePlan, error) {
+					return azurePlan(websdk.ProvisioningStateSucceeded, 1), nil
+				},
+			}},
+			mg: plan(),
+			want: want{
+				mg: plan(withCapacity(1), withOSType(v1alpha1.OSTypeLinux),
+					withObservation(v1alpha1.AppServicePlanObservation{ID: id, ProvisioningState: "Succeeded"}),
+					withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotAppServicePlan": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotAppServicePlan),
+			},
+		},
+		"CreateError": {
+			e: &external{client: &fake.MockAppServicePlansClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ websdk.AppServicePlan) (websdk.AppServicePlansCreateOrUpdateFuture, error) {
+					return websdk.AppServicePlansCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			mg: plan(),
+			want: want{
+				mg:  plan(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateAppServicePlan),
+			},
+		},
+		"Success": {
+			e: &external{client: &fake.MockAppServicePlansClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ websdk.AppServicePlan) (websdk.AppServicePlansCreateOrUpdateFuture, error) {
+					return websdk.AppServicePlansCreateOrUpdateFuture{}, nil
+				},
+			}},
+			mg: plan(),
+			want: want{
+				mg: plan(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotAppServicePlan": {
+			e:    &external{},
+			want: errors.New(errNotAppServicePlan),
+		},
+		"UpdateError": {
+			e: &external{client: &fake.MockAppServicePlansClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ websdk.AppServicePlan) (websdk.AppServicePlansCreateOrUpdateFuture, error) {
+					return websdk.AppServicePlansCreateOrUpdateFuture{}, errBoom
+				},
+			}},
+			mg:   plan(),
+			want: errors.Wrap(errBoom, errUpdateAppServicePlan),
+		},
+		"Success": {
+			e: &external{client: &fake.MockAppServicePlansClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _ string, _ websdk.AppServicePlan) (websdk.AppServicePlansCreateOrUpdateFuture, error) {
+					return websdk.AppServicePlansCreateOrUpdateFuture{}, nil
+				},
+			}},
+			mg: plan(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotAppServicePlan": {
+			e:    &external{},
+			want: errors.New(errNotAppServicePlan),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockAppServicePlansClient{
+				MockDelete: func(_ context.Context, _, _ string) (autorest.Response, error) {
+					return autorest.Response{}, errNotFound
+				},
+			}},
+			mg: plan(),
+		},
+		"DeleteError": {
+			e: &external{client: &fake.MockAppServicePlansClient{
+				MockDelete: func(_ context.Context, _, _ string) (autorest.Response, error) {
+					return autorest.Response{}, errBoom
+				},
+			}},
+			mg:   plan(),
+			want: errors.Wrap(errBoom, errDeleteAppServicePlan),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package functionapp
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2020-02-02/insights"
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2020-02-02/insights/insightsapi"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage/storageapi"
+	websdk "github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web/webapi"
+	azureresource "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/web/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/web"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotFunctionApp        = "managed resource is not a FunctionApp"
+	errCreateFunctionApp     = "cannot create FunctionApp"
+	errGetFunctionApp        = "cannot get FunctionApp"
+	errGetConfiguration      = "cannot get configuration of FunctionApp"
+	errListAppSettings       = "cannot list application settings of FunctionApp"
+	errListConnectionStrings = "cannot list connection strings of FunctionApp"
+	errListHostKeys          = "cannot list host keys of FunctionApp"
+	errParsePlanID           = "cannot parse App Service plan ID"
+	errGetPlan               = "cannot get App Service plan"
+	errListKeys              = "cannot list access keys of storage account"
+	errGetInsights           = "cannot get Application Insights component"
+	errResolveAppSettings    = "cannot resolve application settings"
+	errResolveConnStrings    = "cannot resolve connection strings"
+	errUpdateFunctionApp     = "cannot update FunctionApp"
+	errDeleteFunctionApp     = "cannot delete FunctionApp"
+)
+
+// Setup adds a controller that reconciles FunctionApps.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.FunctionAppGroupKind)
+	o = azure.ControllerOptions(v1alpha1.FunctionAppGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient()), azure.NewDefaultTagger(mgr.GetClient(), resourceTags)}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&v1alpha1.FunctionApp{}).
+		Complete(azure.NewInstrumentedReconciler(v1alpha1.FunctionAppGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FunctionAppGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1alpha1.FunctionAppGroupKind, bo, r, &connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+func resourceTags(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*v1alpha1.FunctionApp)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Tags
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	sub := creds[azure.CredentialsKeySubscriptionID]
+	apps := websdk.NewAppsClient(sub)
+	apps.Authorizer = auth
+	plans := websdk.NewAppServicePlansClient(sub)
+	plans.Authorizer = auth
+	accounts := storage.NewAccountsClient(sub)
+	accounts.Authorizer = auth
+	components := insights.NewComponentsClient(sub)
+	components.Authorizer = auth
+	return &external{kube: c.client, client: web.NewAppsClient(apps), plans: plans, accounts: accounts, components: components}, nil
+}
+
+type external struct {
+	kube       client.Client
+	client     web.AppsAPI
+	plans      webapi.AppServicePlansClientAPI
+	accounts   storageapi.AccountsClientAPI
+	components insightsapi.ComponentsClientAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.FunctionApp)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFunctionApp)
+	}
+
+	rg, name := cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr)
+	az, err := e.client.Get(ctx, rg, name, "")
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFunctionApp)
+	}
+	cfg, err := e.client.GetConfiguration(ctx, rg, name, "")
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetConfiguration)
+	}
+	settings, err := e.client.ListApplicationSettings(ctx, rg, name, "")
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListAppSettings)
+	}
+	conns, err := e.client.ListConnectionStrings(ctx, rg, name, "")
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListConnectionStrings)
+	}
+	keys, err := e.client.ListHostKeys(ctx, rg, name, "")
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListHostKeys)
+	}
+	_, wantSettings, wantConns, err := e.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	web.LateInitializeWebApp(&cr.Spec.ForProvider.WebAppParameters, az, cfg)
+	li := !cmp.Equal(current, &cr.Spec.ForProvider)
+
+	cr.Status.AtProvider = web.GenerateWebAppObservation(az)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: web.IsWebAppUpToDate(cr.Spec.ForProvider.WebAppParameters, az, cfg) &&
+			web.AreAppSettingsUpToDate(wantSettings, settings) &&
+			web.AreConnectionStringsUpToDate(wantConns, conns),
+		ResourceLateInitialized: li,
+		ConnectionDetails:       web.FunctionAppConnectionDetails(az, keys),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.FunctionApp)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFunctionApp)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	site, _, _, err := e.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	err = e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), "", site)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFunctionApp)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.FunctionApp)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFunctionApp)
+	}
+
+	site, _, _, err := e.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	err = e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), "", site)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFunctionApp)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.FunctionApp)
+	if !ok {
+		return errors.New(errNotFunctionApp)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	err := e.client.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), "")
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteFunctionApp)
+}
+
+// desired returns the desired function app, along with its application
+// settings and connection strings. The settings that configure the
+// Functions host depend on the App Service plan of the app, and link it to
+// its storage account and Application Insights component.
+func (e *external) desired(ctx context.Context, cr *v1alpha1.FunctionApp) (websdk.Site, map[string]string, map[string]websdk.ConnStringValueTypePair, error) {
+	p := cr.Spec.ForProvider
+	id, err := azureresource.ParseResourceID(p.ServerFarmID)
+	if err != nil {
+		return websdk.Site{}, nil, nil, errors.Wrap(err, errParsePlanID)
+	}
+	plan, err := e.plans.Get(ctx, id.ResourceGroup, id.ResourceName)
+	if err != nil {
+		return websdk.Site{}, nil, nil, errors.Wrap(err, errGetPlan)
+	}
+	keys, err := e.accounts.ListKeys(ctx, p.ResourceGroupName, p.StorageAccountName, "")
+	if err != nil {
+		return websdk.Site{}, nil, nil, errors.Wrap(err, errListKeys)
+	}
+	insightsConn := ""
+	if p.ApplicationInsightsName != nil {
+		c, err := e.components.Get(ctx, p.ResourceGroupName, *p.ApplicationInsightsName)
+		if err != nil {
+			return websdk.Site{}, nil, nil, errors.Wrap(err, errGetInsights)
+		}
+		if c.ApplicationInsightsComponentProperties != nil {
+			insightsConn = azure.ToString(c.ConnectionString)
+		}
+	}
+	resolved, err := web.ResolveAppSettings(ctx, e.kube, p.WebAppParameters)
+	if err != nil {
+		return websdk.Site{}, nil, nil, errors.Wrap(err, errResolveAppSettings)
+	}
+	conns, err := web.ResolveConnectionStrings(ctx, e.kube, p.WebAppParameters)
+	if err != nil {
+		return websdk.Site{}, nil, nil, errors.Wrap(err, errResolveConnStrings)
+	}
+
+	settings := web.FunctionAppSettings(meta.GetExternalName(cr), p, plan, web.StorageConnectionString(p.StorageAccountName, keys), insightsConn, resolved)
+	return web.NewFunctionSite(p, plan, settings, conns), settings, conns, nil
+}
@@ -0,0 +1,462 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package functionapp
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2020-02-02/insights"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	websdk "github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/web/v1alpha1"
+	insightsfake "github.com/crossplane-contrib/provider-azure/pkg/clients/insights/fake"
+	storagefake "github.com/crossplane-contrib/provider-azure/pkg/clients/storage/fake"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/web"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/web/fake"
+)
+
+const (
+	name              = "cool-functions"
+	resourceGroupName = "cool-rg"
+	id                = "cool-id"
+	hostName          = "cool-functions.azurewebsites.net"
+	planID            = "/subscriptions/sub/resourceGroups/cool-rg/providers/Microsoft.Web/serverfarms/cool-plan"
+	accountName       = "coolstorage"
+	insightsName      = "cool-insights"
+	insightsConn      = "InstrumentationKey=cool"
+	hostKey           = "cool-key"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+
+	storageConn = "DefaultEndpointsProtocol=https;AccountName=coolstorage;AccountKey=key;EndpointSuffix=core.windows.net"
+)
+
+type modifier func(*v1alpha1.FunctionApp)
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(f *v1alpha1.FunctionApp) { f.Status.SetConditions(c...) }
+}
+
+func withServerFarmID(id string) modifier {
+	return func(f *v1alpha1.FunctionApp) { f.Spec.ForProvider.ServerFarmID = id }
+}
+
+func withHTTPSOnly(b bool) modifier {
+	return func(f *v1alpha1.FunctionApp) { f.Spec.ForProvider.HTTPSOnly = to.BoolPtr(b) }
+}
+
+func withObservation(o v1alpha1.WebAppObservation) modifier {
+	return func(f *v1alpha1.FunctionApp) { f.Status.AtProvider = o }
+}
+
+func functionApp(m ...modifier) *v1alpha1.FunctionApp {
+	f := &v1alpha1.FunctionApp{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.FunctionAppSpec{
+			ForProvider: v1alpha1.FunctionAppParameters{
+				StorageAccountName:      accountName,
+				ApplicationInsightsName: to.StringPtr(insightsName),
+				WorkerRuntime:           "python",
+				WebAppParameters: v1alpha1.WebAppParameters{
+					ResourceGroupName: resourceGroupName,
+					ServerFarmID:      planID,
+					HTTPSOnly:         to.BoolPtr(true),
+				},
+			},
+		},
+	}
+	meta.SetExternalName(f, name)
+	for _, fn := range m {
+		fn(f)
+	}
+	return f
+}
+
+// settings returns the application settings of a function app on a Linux
+// consumption plan.
+func settings() map[string]*string {
+	return map[string]*string{
+		web.AppSettingWorkerRuntime:            to.StringPtr("python"),
+		web.AppSettingExtensionVersion:         to.StringPtr(web.DefaultFunctionsExtensionVersion),
+		web.AppSettingWebJobsStorage:           to.StringPtr(storageConn),
+		web.AppSettingInsightsConnectionString: to.StringPtr(insightsConn),
+	}
+}
+
+type clients struct {
+	apps       *fake.MockAppsClient
+	plans      *fake.MockAppServicePlansClient
+	accounts   *storagefake.MockAccountsClient
+	components *insightsfake.MockComponentsClient
+}
+
+// newClients returns clients that observe an existing function app with the
+// supplied application settings.
+func newClients(s map[string]*string) clients {
+	return clients{
+		apps: &fake.MockAppsClient{
+			MockGet: func(_ context.Context, _, _, _ string) (websdk.Site, error) {
+				return websdk.Site{ID: to.StringPtr(id), SiteProperties: &websdk.SiteProperties{
+					State:           to.StringPtr("Running"),
+					DefaultHostName: to.StringPtr(hostName),
+					ServerFarmID:    to.StringPtr(planID),
+					HTTPSOnly:       to.BoolPtr(true),
+				}}, nil
+			},
+			MockGetConfiguration: func(_ context.Context, _, _, _ string) (websdk.SiteConfigResource, error) {
+				return websdk.SiteConfigResource{SiteConfig: &websdk.SiteConfig{}}, nil
+			},
+			MockListApplicationSettings: func(_ context.Context, _, _, _ string) (websdk.StringDictionary, error) {
+				return websdk.StringDictionary{Properties: s}, nil
+			},
+			MockListConnectionStrings: func(_ context.Context, _, _, _ string) (websdk.ConnectionStringDictionary, error) {
+				return websdk.ConnectionStringDictionary{}, nil
+			},
+			MockListHostKeys: func(_ context.Context, _, _, _ string) (websdk.HostKeys, error) {
+				return websdk.HostKeys{FunctionKeys: map[string]*string{"default": to.StringPtr(hostKey)}}, nil
+			},
+		},
+		plans: &fake.MockAppServicePlansClient{
+			MockGet: func(_ context.Context, rg, n string) (websdk.AppServicePlan, error) {
+				if rg != resourceGroupName || n != "cool-plan" {
+					return websdk.AppServicePlan{}, errNotFound
+				}
+				return websdk.AppServicePlan{
+					Sku:                      &websdk.SkuDescription{Name: to.StringPtr("Y1"), Tier: to.StringPtr("Dynamic")},
+					AppServicePlanProperties: &websdk.AppServicePlanProperties{Reserved: to.BoolPtr(true)},
+				}, nil
+			},
+		},
+		accounts: &storagefake.MockAccountsClient{
+			MockListKeys: func(_ context.Context, _, _ string, _ storage.ListKeyExpand) (storage.AccountListKeysResult, error) {
+				return storage.AccountListKeysResult{Keys: &[]storage.AccountKey{{Value: to.StringPtr("key")}}}, nil
+			},
+		},
+		components: &insightsfake.MockComponentsClient{
+			MockGet: func(_ context.Context, _, _ string) (insights.ApplicationInsightsComponent, error) {
+				return insights.ApplicationInsightsComponent{
+					ApplicationInsightsComponentProperties: &insights.ApplicationInsightsComponentProperties{ConnectionString: to.StringPtr(insightsConn)},
+				}, nil
+			},
+		},
+	}
+}
+
+func (c clients) external() *external {
+	return &external{client: c.apps, plans: c.plans, accounts: c.accounts, components: c.components}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	details := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(hostName),
+		web.ConnectionSecretKeyFunctionHostKey:    []byte(hostKey),
+	}
+	observation := v1alpha1.WebAppObservation{ID: id, State: "Running", DefaultHostName: hostName}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotFunctionApp": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotFunctionApp),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockAppsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (websdk.Site, error) {
+					return websdk.Site{}, errNotFound
+				},
+			}},
+			mg: functionApp(),
+			want: want{
+				mg: functionApp(),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ListHostKeysError": {
+			e: func() managed.ExternalClient {
+				c := newClients(settings())
+				c.apps.MockListHostKeys = func(_ context.Context, _, _, _ string) (websdk.HostKeys, error) {
+					return websdk.HostKeys{}, errBoom
+				}
+				return c.external()
+			}(),
+			mg: functionApp(),
+			want: want{
+				mg:  functionApp(),
+				err: errors.Wrap(errBoom, errListHostKeys),
+			},
+		},
+		"ParsePlanIDError": {
+			e:  newClients(settings()).external(),
+			mg: functionApp(withServerFarmID("cool-plan")),
+			want: want{
+				mg:  functionApp(withServerFarmID("cool-plan")),
+				err: errors.Wrap(errors.New("parsing failed for cool-plan. Invalid resource Id format"), errParsePlanID),
+			},
+		},
+		"GetInsightsError": {
+			e: func() managed.ExternalClient {
+				c := newClients(settings())
+				c.components.MockGet = func(_ context.Context, _, _ string) (insights.ApplicationInsightsComponent, error) {
+					return insights.ApplicationInsightsComponent{}, errBoom
+				}
+				return c.external()
+			}(),
+			mg: functionApp(),
+			want: want{
+				mg:  functionApp(),
+				err: errors.Wrap(errBoom, errGetInsights),
+			},
+		},
+		"SettingsNeedUpdate": {
+			e: func() managed.ExternalClient {
+				s := settings()
+				delete(s, web.AppSettingInsightsConnectionString)
+				return newClients(s).external()
+			}(),
+			mg: functionApp(),
+			want: want{
+				mg: functionApp(withObservation(observation), withConditions(xpv1.Available())),
+				o:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: details},
+			},
+		},
+		"UpToDate": {
+			e:  newClients(settings()).external(),
+			mg: functionApp(),
+			want: want{
+				mg: functionApp(withObservation(observation), withConditions(xpv1.Available())),
+				o:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: details},
+			},
+		},
+		"LateInitialized": {
+			e:  newClients(settings()).external(),
+			mg: functionApp(func(f *v1alpha1.FunctionApp) { f.Spec.ForProvider.HTTPSOnly = nil }),
+			want: want{
+				mg: functionApp(withHTTPSOnly(true), withObservation(observation), withConditions(xpv1.Available())),
+				o:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true, ConnectionDetails: details},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotFunctionApp": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotFunctionApp),
+			},
+		},
+		"ListKeysError": {
+			e: func() managed.ExternalClient {
+				c := newClients(nil)
+				c.accounts.MockListKeys = func(_ context.Context, _, _ string, _ storage.ListKeyExpand) (storage.AccountListKeysResult, error) {
+					return storage.AccountListKeysResult{}, errBoom
+				}
+				return c.external()
+			}(),
+			mg: functionApp(),
+			want: want{
+				mg:  functionApp(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errListKeys),
+			},
+		},
+		"CreateError": {
+			e: func() managed.ExternalClient {
+				c := newClients(nil)
+				c.apps.MockCreateOrUpdate = func(_ context.Context, _, _, _ string, _ websdk.Site) error {
+					return errBoom
+				}
+				return c.external()
+			}(),
+			mg: functionApp(),
+			want: want{
+				mg:  functionApp(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFunctionApp),
+			},
+		},
+		"Success": {
+			e: func() managed.ExternalClient {
+				c := newClients(nil)
+				c.apps.MockCreateOrUpdate = func(_ context.Context, _, _, _ string, s websdk.Site) error {
+					if diff := cmp.Diff(to.StringPtr("functionapp,linux"), s.Kind); diff != "" {
+						t.Errorf("CreateOrUpdate(...): -want kind, +got kind:\n%s", diff)
+					}
+					if diff := cmp.Diff(len(settings()), len(*s.SiteConfig.AppSettings)); diff != "" {
+						t.Errorf("CreateOrUpdate(...): -want settings, +got settings:\n%s", diff)
+					}
+					return nil
+				}
+				return c.external()
+			}(),
+			mg: functionApp(),
+			want: want{
+				mg: functionApp(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotFunctionApp": {
+			e:    &external{},
+			want: errors.New(errNotFunctionApp),
+		},
+		"GetPlanError": {
+			e: func() managed.ExternalClient {
+				c := newClients(nil)
+				c.plans.MockGet = func(_ context.Context, _, _ string) (websdk.AppServicePlan, error) {
+					return websdk.AppServicePlan{}, errBoom
+				}
+				return c.external()
+			}(),
+			mg:   functionApp(),
+			want: errors.Wrap(errBoom, errGetPlan),
+		},
+		"UpdateError": {
+			e: func() managed.ExternalClient {
+				c := newClients(nil)
+				c.apps.MockCreateOrUpdate = func(_ context.Context, _, _, _ string, _ websdk.Site) error {
+					return errBoom
+				}
+				return c.external()
+			}(),
+			mg:   functionApp(),
+			want: errors.Wrap(errBoom, errUpdateFunctionApp),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotFunctionApp": {
+			e:    &external{},
+			want: errors.New(errNotFunctionApp),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockAppsClient{
+				MockDelete: func(_ context.Context, _, _, _ string) error {
+					return errNotFound
+				},
+			}},
+			mg: functionApp(),
+		},
+		"DeleteError": {
+			e: &external{client: &fake.MockAppsClient{
+				MockDelete: func(_ context.Context, _, _, _ string) error {
+					return errBoom
+				},
+			}},
+			mg:   functionApp(),
+			want: errors.Wrap(errBoom, errDeleteFunctionApp),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webapp
+
+import (
+	"context"
+
+	websdk "github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/web/v1alpha1"
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/keyvault/secretstore"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/web"
+	"github.com/crossplane-contrib/provider-azure/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotWebApp             = "managed resource is not a WebApp"
+	errCreateWebApp          = "cannot create WebApp"
+	errGetWebApp             = "cannot get WebApp"
+	errGetConfiguration      = "cannot get configuration of WebApp"
+	errListAppSettings       = "cannot list application settings of WebApp"
+	errListConnectionStrings = "cannot list connection strings of WebApp"
+	errResolveAppSettings    = "cannot resolve application settings"
+	errResolveConnStrings    = "cannot resolve connection strings"
+	errUpdateWebApp          = "cannot update WebApp"
+	errDeleteWebApp          = "cannot delete WebApp"
+)
+
+// Setup adds a controller that reconciles WebApps.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.WebAppGroupKind)
+	o = azure.ControllerOptions(v1alpha1.WebAppGroupKind, o)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, secretstore.NewDetailsManager(mgr.GetClient()))
+	}
+
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	is := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient()), azure.NewDefaultTagger(mgr.GetClient(), resourceTags)}
+	if o.Features.Enabled(features.EnableAlphaNamespaceProviderConfig) {
+		is = append(is, azure.NewNamespaceProviderConfig(mgr.GetClient()))
+	}
+
+	bo := azure.NewBackoff()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(bo.ForControllerRuntime(o)).
+		For(&v1alpha1.WebApp{}).
+		Complete(azure.NewInstrumentedReconciler(v1alpha1.WebAppGroupKind, managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.WebAppGroupVersionKind),
+			managed.WithInitializers(is...),
+			managed.WithExternalConnecter(azure.NewConnecter(v1alpha1.WebAppGroupKind, bo, r, &connecter{client: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(r),
+			managed.WithConnectionPublishers(cps...))))
+}
+
+func resourceTags(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*v1alpha1.WebApp)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Tags
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	creds, auth, err := azure.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	cl := websdk.NewAppsClient(creds[azure.CredentialsKeySubscriptionID])
+	cl.Authorizer = auth
+	return &external{kube: c.client, client: web.NewAppsClient(cl)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client web.AppsAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.WebApp)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotWebApp)
+	}
+
+	rg, name := cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr)
+	az, err := e.client.Get(ctx, rg, name, "")
+	if azure.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetWebApp)
+	}
+	cfg, err := e.client.GetConfiguration(ctx, rg, name, "")
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetConfiguration)
+	}
+	settings, err := e.client.ListApplicationSettings(ctx, rg, name, "")
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListAppSettings)
+	}
+	conns, err := e.client.ListConnectionStrings(ctx, rg, name, "")
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListConnectionStrings)
+	}
+	wantSettings, err := web.ResolveAppSettings(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errResolveAppSettings)
+	}
+	wantConns, err := web.ResolveConnectionStrings(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errResolveConnStrings)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	web.LateInitializeWebApp(&cr.Spec.ForProvider, az, cfg)
+	li := !cmp.Equal(current, &cr.Spec.ForProvider)
+
+	cr.Status.AtProvider = web.GenerateWebAppObservation(az)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: web.IsWebAppUpToDate(cr.Spec.ForProvider, az, cfg) &&
+			web.AreAppSettingsUpToDate(wantSettings, settings) &&
+			web.AreConnectionStringsUpToDate(wantConns, conns),
+		ResourceLateInitialized: li,
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.DefaultHostName),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.WebApp)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotWebApp)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	site, err := e.site(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	err = e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), "", site)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateWebApp)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.WebApp)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotWebApp)
+	}
+
+	site, err := e.site(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	err = e.client.CreateOrUpdate(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), "", site)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateWebApp)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.WebApp)
+	if !ok {
+		return errors.New(errNotWebApp)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	err := e.client.Delete(ctx, cr.Spec.ForProvider.ResourceGroupName, meta.GetExternalName(cr), "")
+	return errors.Wrap(resource.Ignore(azure.IsNotFound, err), errDeleteWebApp)
+}
+
+// site returns the desired web app with its application settings and
+// connection strings read from the secrets they reference.
+func (e *external) site(ctx context.Context, p v1alpha1.WebAppParameters) (websdk.Site, error) {
+	settings, err := web.ResolveAppSettings(ctx, e.kube, p)
+	if err != nil {
+		return websdk.Site{}, errors.Wrap(err, errResolveAppSettings)
+	}
+	conns, err := web.ResolveConnectionStrings(ctx, e.kube, p)
+	if err != nil {
+		return websdk.Site{}, errors.Wrap(err, errResolveConnStrings)
+	}
+	return web.NewSite(p, settings, conns), nil
+}
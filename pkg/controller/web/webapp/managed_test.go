@@ -0,0 +1,426 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webapp
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	websdk "github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/web/v1alpha1"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/web/fake"
+)
+
+const (
+	name              = "cool-app"
+	resourceGroupName = "cool-rg"
+	id                = "cool-id"
+	hostName          = "cool-app.azurewebsites.net"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+
+	ref = xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "cool-secret", Namespace: "cool-ns"}, Key: "db"}
+)
+
+type modifier func(*v1alpha1.WebApp)
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(a *v1alpha1.WebApp) { a.Status.SetConditions(c...) }
+}
+
+func withHTTPSOnly(b bool) modifier {
+	return func(a *v1alpha1.WebApp) { a.Spec.ForProvider.HTTPSOnly = to.BoolPtr(b) }
+}
+
+func withSiteConfig(c v1alpha1.WebAppSiteConfig) modifier {
+	return func(a *v1alpha1.WebApp) { a.Spec.ForProvider.SiteConfig = &c }
+}
+
+func withAppSettings(s ...v1alpha1.WebAppSetting) modifier {
+	return func(a *v1alpha1.WebApp) { a.Spec.ForProvider.AppSettings = s }
+}
+
+func withConnectionStrings(c ...v1alpha1.WebAppConnectionString) modifier {
+	return func(a *v1alpha1.WebApp) { a.Spec.ForProvider.ConnectionStrings = c }
+}
+
+func withObservation(o v1alpha1.WebAppObservation) modifier {
+	return func(a *v1alpha1.WebApp) { a.Status.AtProvider = o }
+}
+
+func webApp(m ...modifier) *v1alpha1.WebApp {
+	a := &v1alpha1.WebApp{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.WebAppSpec{
+			ForProvider: v1alpha1.WebAppParameters{ResourceGroupName: resourceGroupName},
+		},
+	}
+	meta.SetExternalName(a, name)
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func site() websdk.Site {
+	return websdk.Site{
+		ID: to.StringPtr(id),
+		SiteProperties: &websdk.SiteProperties{
+			State:           to.StringPtr("Running"),
+			DefaultHostName: to.StringPtr(hostName),
+			HTTPSOnly:       to.BoolPtr(true),
+		},
+	}
+}
+
+func config() websdk.SiteConfigResource {
+	return websdk.SiteConfigResource{SiteConfig: &websdk.SiteConfig{AlwaysOn: to.BoolPtr(false), MinTLSVersion: websdk.SupportedTLSVersionsOneFullStopTwo}}
+}
+
+func appsClient(settings map[string]*string, conns map[string]*websdk.ConnStringValueTypePair) *fake.MockAppsClient {
+	return &fake.MockAppsClient{
+		MockGet: func(_ context.Context, _, _, _ string) (websdk.Site, error) {
+			return site(), nil
+		},
+		MockGetConfiguration: func(_ context.Context, _, _, _ string) (websdk.SiteConfigResource, error) {
+			return config(), nil
+		},
+		MockListApplicationSettings: func(_ context.Context, _, _, _ string) (websdk.StringDictionary, error) {
+			return websdk.StringDictionary{Properties: settings}, nil
+		},
+		MockListConnectionStrings: func(_ context.Context, _, _, _ string) (websdk.ConnectionStringDictionary, error) {
+			return websdk.ConnectionStringDictionary{Properties: conns}, nil
+		},
+	}
+}
+
+func secretClient(value string) client.Client {
+	return &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.Secret).Data = map[string][]byte{"db": []byte(value)}
+		return nil
+	}}
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	observation := v1alpha1.WebAppObservation{ID: id, State: "Running", DefaultHostName: hostName}
+	lateInit := []modifier{
+		withHTTPSOnly(true),
+		withSiteConfig(v1alpha1.WebAppSiteConfig{AlwaysOn: to.BoolPtr(false), MinTLSVersion: to.StringPtr("1.2")}),
+	}
+	conn := v1alpha1.WebAppConnectionString{Name: "db", Type: "SQLAzure", ValueSecretRef: ref}
+	details := managed.ConnectionDetails{xpv1.ResourceCredentialsSecretEndpointKey: []byte(hostName)}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotWebApp": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotWebApp),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockAppsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (websdk.Site, error) {
+					return websdk.Site{}, errNotFound
+				},
+			}},
+			mg: webApp(),
+			want: want{
+				mg: webApp(),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			e: &external{client: &fake.MockAppsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (websdk.Site, error) {
+					return websdk.Site{}, errBoom
+				},
+			}},
+			mg: webApp(),
+			want: want{
+				mg:  webApp(),
+				err: errors.Wrap(errBoom, errGetWebApp),
+			},
+		},
+		"GetConfigurationError": {
+			e: &external{client: &fake.MockAppsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (websdk.Site, error) {
+					return site(), nil
+				},
+				MockGetConfiguration: func(_ context.Context, _, _, _ string) (websdk.SiteConfigResource, error) {
+					return websdk.SiteConfigResource{}, errBoom
+				},
+			}},
+			mg: webApp(),
+			want: want{
+				mg:  webApp(),
+				err: errors.Wrap(errBoom, errGetConfiguration),
+			},
+		},
+		"ResolveConnectionStringsError": {
+			e: &external{
+				kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				client: appsClient(nil, nil),
+			},
+			mg: webApp(withConnectionStrings(conn)),
+			want: want{
+				mg:  webApp(withConnectionStrings(conn)),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get secret"), errResolveConnStrings),
+			},
+		},
+		"AppSettingsNeedUpdate": {
+			e:  &external{client: appsClient(map[string]*string{"MODE": to.StringPtr("debug")}, nil)},
+			mg: webApp(append(lateInit, withAppSettings(v1alpha1.WebAppSetting{Name: "MODE", Value: to.StringPtr("release")}))...),
+			want: want{
+				mg: webApp(append(lateInit,
+					withAppSettings(v1alpha1.WebAppSetting{Name: "MODE", Value: to.StringPtr("release")}),
+					withObservation(observation),
+					withConditions(xpv1.Available()))...),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: details},
+			},
+		},
+		"ConnectionStringsNeedUpdate": {
+			e: &external{
+				kube: secretClient("new"),
+				client: appsClient(nil, map[string]*websdk.ConnStringValueTypePair{
+					"db": {Value: to.StringPtr("old"), Type: websdk.ConnectionStringTypeSQLAzure},
+				}),
+			},
+			mg: webApp(append(lateInit, withConnectionStrings(conn))...),
+			want: want{
+				mg: webApp(append(lateInit,
+					withConnectionStrings(conn),
+					withObservation(observation),
+					withConditions(xpv1.Available()))...),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: details},
+			},
+		},
+		"LateInitialized": {
+			e: &external{
+				kube: secretClient("cool"),
+				client: appsClient(map[string]*string{"MODE": to.StringPtr("release")}, map[string]*websdk.ConnStringValueTypePair{
+					"db": {Value: to.StringPtr("cool"), Type: websdk.ConnectionStringTypeSQLAzure},
+				}),
+			},
+			mg: webApp(withAppSettings(v1alpha1.WebAppSetting{Name: "MODE", Value: to.StringPtr("release")}), withConnectionStrings(conn)),
+			want: want{
+				mg: webApp(append(lateInit,
+					withAppSettings(v1alpha1.WebAppSetting{Name: "MODE", Value: to.StringPtr("release")}),
+					withConnectionStrings(conn),
+					withObservation(observation),
+					withConditions(xpv1.Available()))...),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true, ConnectionDetails: details},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	setting := v1alpha1.WebAppSetting{Name: "KEY", ValueSecretRef: &ref}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotWebApp": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotWebApp),
+			},
+		},
+		"ResolveAppSettingsError": {
+			e:  &external{kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)}},
+			mg: webApp(withAppSettings(setting)),
+			want: want{
+				mg:  webApp(withAppSettings(setting), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get secret"), errResolveAppSettings),
+			},
+		},
+		"CreateError": {
+			e: &external{client: &fake.MockAppsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ websdk.Site) error {
+					return errBoom
+				},
+			}},
+			mg: webApp(),
+			want: want{
+				mg:  webApp(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateWebApp),
+			},
+		},
+		"Success": {
+			e: &external{
+				kube: secretClient("secret"),
+				client: &fake.MockAppsClient{
+					MockCreateOrUpdate: func(_ context.Context, _, _, _ string, s websdk.Site) error {
+						want := []websdk.NameValuePair{{Name: to.StringPtr("KEY"), Value: to.StringPtr("secret")}}
+						if diff := cmp.Diff(want, *s.SiteConfig.AppSettings); diff != "" {
+							t.Errorf("CreateOrUpdate(...): -want, +got:\n%s", diff)
+						}
+						return nil
+					},
+				},
+			},
+			mg: webApp(withAppSettings(setting)),
+			want: want{
+				mg: webApp(withAppSettings(setting), withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotWebApp": {
+			e:    &external{},
+			want: errors.New(errNotWebApp),
+		},
+		"UpdateError": {
+			e: &external{client: &fake.MockAppsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ websdk.Site) error {
+					return errBoom
+				},
+			}},
+			mg:   webApp(),
+			want: errors.Wrap(errBoom, errUpdateWebApp),
+		},
+		"Success": {
+			e: &external{client: &fake.MockAppsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ websdk.Site) error {
+					return nil
+				},
+			}},
+			mg: webApp(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotWebApp": {
+			e:    &external{},
+			want: errors.New(errNotWebApp),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockAppsClient{
+				MockDelete: func(_ context.Context, _, _, _ string) error {
+					return errNotFound
+				},
+			}},
+			mg: webApp(),
+		},
+		"DeleteError": {
+			e: &external{client: &fake.MockAppsClient{
+				MockDelete: func(_ context.Context, _, _, _ string) error {
+					return errBoom
+				},
+			}},
+			mg:   webApp(),
+			want: errors.Wrap(errBoom, errDeleteWebApp),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webappslot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	websdk "github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis/web/v1alpha1"
+	"github.com/crossplane-contrib/provider-azure/pkg/clients/web/fake"
+)
+
+const (
+	name              = "staging"
+	appName           = "cool-app"
+	resourceGroupName = "cool-rg"
+	id                = "cool-id"
+	hostName          = "cool-app-staging.azurewebsites.net"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = autorest.DetailedError{StatusCode: http.StatusNotFound}
+)
+
+type modifier func(*v1alpha1.WebAppSlot)
+
+func withConditions(c ...xpv1.Condition) modifier {
+	return func(s *v1alpha1.WebAppSlot) { s.Status.SetConditions(c...) }
+}
+
+func withHTTPSOnly(b bool) modifier {
+	return func(s *v1alpha1.WebAppSlot) { s.Spec.ForProvider.HTTPSOnly = to.BoolPtr(b) }
+}
+
+func withObservation(o v1alpha1.WebAppObservation) modifier {
+	return func(s *v1alpha1.WebAppSlot) { s.Status.AtProvider = o }
+}
+
+func webAppSlot(m ...modifier) *v1alpha1.WebAppSlot {
+	s := &v1alpha1.WebAppSlot{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.WebAppSlotSpec{
+			ForProvider: v1alpha1.WebAppSlotParameters{
+				WebAppName:       appName,
+				WebAppParameters: v1alpha1.WebAppParameters{ResourceGroupName: resourceGroupName},
+			},
+		},
+	}
+	meta.SetExternalName(s, name)
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+// checkNames returns an error unless the supplied names identify the slot.
+func checkNames(rg, app, slot string) error {
+	if rg != resourceGroupName || app != appName || slot != name {
+		return errors.Errorf("unexpected slot %s/%s/%s", rg, app, slot)
+	}
+	return nil
+}
+
+// Test that our Reconciler implementation satisfies the Reconciler interface.
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotWebAppSlot": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotWebAppSlot),
+			},
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockAppsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (websdk.Site, error) {
+					return websdk.Site{}, errNotFound
+				},
+			}},
+			mg: webAppSlot(),
+			want: want{
+				mg: webAppSlot(),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ListAppSettingsError": {
+			e: &external{client: &fake.MockAppsClient{
+				MockGet: func(_ context.Context, _, _, _ string) (websdk.Site, error) {
+					return websdk.Site{}, nil
+				},
+				MockGetConfiguration: func(_ context.Context, _, _, _ string) (websdk.SiteConfigResource, error) {
+					return websdk.SiteConfigResource{}, nil
+				},
+				MockListApplicationSettings: func(_ context.Context, _, _, _ string) (websdk.StringDictionary, error) {
+					return websdk.StringDictionary{}, errBoom
+				},
+			}},
+			mg: webAppSlot(),
+			want: want{
+				mg:  webAppSlot(),
+				err: errors.Wrap(errBoom, errListAppSettings),
+			},
+		},
+		"Available": {
+			e: &external{client: &fake.MockAppsClient{
+				MockGet: func(_ context.Context, rg, app, slot string) (websdk.Site, error) {
+					return websdk.Site{
+						ID: to.StringPtr(id),
+						SiteProperties: &websdk.SiteProperties{
+							State:           to.StringPtr("Running"),
+							DefaultHostName: to.StringPtr(hostName),
+							HTTPSOnly:       to.BoolPtr(true),
+						},
+					}, checkNames(rg, app, slot)
+				},
+				MockGetConfiguration: func(_ context.Context, rg, app, slot string) (websdk.SiteConfigResource, error) {
+					return websdk.SiteConfigResource{SiteConfig: &websdk.SiteConfig{}}, checkNames(rg, app, slot)
+				},
+				MockListApplicationSettings: func(_ context.Context, rg, app, slot string) (websdk.StringDictionary, error) {
+					return websdk.StringDictionary{}, checkNames(rg, app, slot)
+				},
+				MockListConnectionStrings: func(_ context.Context, rg, app, slot string) (websdk.ConnectionStringDictionary, error) {
+					return websdk.ConnectionStringDictionary{}, checkNames(rg, app, slot)
+				},
+			}},
+			mg: webAppSlot(withHTTPSOnly(true)),
+			want: want{
+				mg: webAppSlot(withHTTPSOnly(true),
+					withObservation(v1alpha1.WebAppObservation{ID: id, State: "Running", DefaultHostName: hostName}),
+					withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretEndpointKey: []byte(hostName)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotWebAppSlot": {
+			e: &external{},
+			want: want{
+				err: errors.New(errNotWebAppSlot),
+			},
+		},
+		"CreateError": {
+			e: &external{client: &fake.MockAppsClient{
+				MockCreateOrUpdate: func(_ context.Context, _, _, _ string, _ websdk.Site) error {
+					return errBoom
+				},
+			}},
+			mg: webAppSlot(),
+			want: want{
+				mg:  webAppSlot(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateWebAppSlot),
+			},
+		},
+		"Success": {
+			e: &external{client: &fake.MockAppsClient{
+				MockCreateOrUpdate: func(_ context.Context, rg, app, slot string, _ websdk.Site) error {
+					return checkNames(rg, app, slot)
+				},
+			}},
+			mg: webAppSlot(),
+			want: want{
+				mg: webAppSlot(withConditions(xpv1.Creating())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("tc.e.Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		e    managed.ExternalClient
+		mg   resource.Managed
+		want error
+	}{
+		"NotWebAppSlot": {
+			e:    &external{},
+			want: errors.New(errNotWebAppSlot),
+		},
+		"NotFound": {
+			e: &external{client: &fake.MockAppsClient{
+				MockDelete: func(_ context.Context, _, _, _ string) error {
+					return errNotFound
+				},
+			}},
+			mg: webAppSlot(),
+		},
+		"Success": {
+			e: &external{client: &fake.MockAppsClient{
+				MockDelete: func(_ context.Context, rg, app, slot string) error {
+					return checkNames(rg, app, slot)
+				},
+			}},
+			mg: webAppSlot(),
+		},
+		"DeleteError": {
+			e: &external{client: &fake.MockAppsClient{
+				MockDelete: func(_ context.Context, _, _, _ string) error {
+					return errBoom
+				},
+			}},
+			mg:   webAppSlot(),
+			want: errors.Wrap(errBoom, errDeleteWebAppSlot),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -24,4 +24,25 @@ const (
 	// External Secret Stores. See the below design for more details.
 	// https://github.com/crossplane/crossplane/blob/390ddd/design/design-doc-external-secret-stores.md
 	EnableAlphaExternalSecretStores feature.Flag = "EnableAlphaExternalSecretStores"
+
+	// EnableAlphaNamespaceProviderConfig enables alpha support for defaulting
+	// the ProviderConfig of managed resources composed for claims from the
+	// azure.crossplane.io/default-provider-config annotation of the claim's
+	// namespace. The provider must be allowed to get namespaces.
+	EnableAlphaNamespaceProviderConfig feature.Flag = "EnableAlphaNamespaceProviderConfig"
+
+	// EnableAlphaAdvisorRecommendations enables alpha support for surfacing
+	// the Azure Advisor recommendations of managed resources as conditions and
+	// events.
+	EnableAlphaAdvisorRecommendations feature.Flag = "EnableAlphaAdvisorRecommendations"
+
+	// EnableAlphaCostReporting enables alpha support for reporting the
+	// month-to-date cost of managed resources, as reported by Azure Cost
+	// Management, as annotations and as a Prometheus metric.
+	EnableAlphaCostReporting feature.Flag = "EnableAlphaCostReporting"
+
+	// EnableSimulation points the clients of supported controllers at a local
+	// API simulator, e.g. Azurite or a replay of recorded Azure API responses,
+	// rather than at Azure. Requests are not authorized in this mode.
+	EnableSimulation feature.Flag = "EnableSimulation"
 )
@@ -0,0 +1,74 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"net"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-compute-azure-crossplane-io-v1alpha3-akscluster,mutating=false,failurePolicy=fail,groups=compute.azure.crossplane.io,resources=aksclusters,versions=v1alpha3,name=aksclusters.compute.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+func setupCompute(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha3.AKSCluster{}).
+		WithValidator(&validator{
+			gk:     schema.GroupKind{Group: v1alpha3.Group, Kind: v1alpha3.AKSClusterKind},
+			create: validateAKSCluster,
+			update: validateAKSClusterUpdate,
+		}).
+		Complete()
+}
+
+// validateAKSCluster returns an error if the network ranges of the supplied
+// cluster are not in CIDR notation, or if its DNS service IP is not within
+// its service range.
+func validateAKSCluster(mg resource.Managed) field.ErrorList {
+	p := mg.(*v1alpha3.AKSCluster).Spec.AKSClusterParameters
+	fp := field.NewPath("spec")
+	errs := validCIDR(fp.Child("podCidr"), p.PodCIDR)
+	errs = append(errs, validCIDR(fp.Child("serviceCidr"), p.ServiceCIDR)...)
+	errs = append(errs, validCIDR(fp.Child("dockerBridgeCidr"), p.DockerBridgeCIDR)...)
+	if p.DNSServiceIP == "" {
+		return errs
+	}
+	ip := net.ParseIP(p.DNSServiceIP)
+	if ip == nil {
+		return append(errs, field.Invalid(fp.Child("dnsServiceIP"), p.DNSServiceIP, "must be an IP address"))
+	}
+	if _, svc, err := net.ParseCIDR(p.ServiceCIDR); err == nil && !svc.Contains(ip) {
+		errs = append(errs, field.Invalid(fp.Child("dnsServiceIP"), p.DNSServiceIP, "must be within spec.serviceCidr"))
+	}
+	return errs
+}
+
+// validateAKSClusterUpdate returns an error if a field that identifies the
+// external cluster was changed.
+func validateAKSClusterUpdate(oldMg, mg resource.Managed) field.ErrorList {
+	old, p := oldMg.(*v1alpha3.AKSCluster).Spec.AKSClusterParameters, mg.(*v1alpha3.AKSCluster).Spec.AKSClusterParameters
+	fp := field.NewPath("spec")
+	errs := immutableExternalName(oldMg, mg)
+	errs = append(errs, immutableLocation(fp.Child("location"), old.Location, p.Location)...)
+	return append(errs, immutableName(fp.Child("resourceGroupName"), old.ResourceGroupName, p.ResourceGroupName)...)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
+)
+
+// skuCapacities are the vCores that each tier of Azure Database for MySQL and
+// PostgreSQL servers supports.
+var skuCapacities = map[string][]int{
+	"Basic":           {1, 2},
+	"GeneralPurpose":  {2, 4, 8, 16, 32, 64},
+	"MemoryOptimized": {2, 4, 8, 16, 32},
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-database-azure-crossplane-io-v1beta1-mysqlserver,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=mysqlservers,versions=v1beta1,name=mysqlservers.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=create;update,path=/validate-database-azure-crossplane-io-v1beta1-postgresqlserver,mutating=false,failurePolicy=fail,groups=database.azure.crossplane.io,resources=postgresqlservers,versions=v1beta1,name=postgresqlservers.database.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+func setupDatabase(mgr ctrl.Manager) error {
+	if err := ctrl.NewWebhookManagedBy(mgr).
+		For(&v1beta1.MySQLServer{}).
+		WithValidator(&validator{
+			gk:     schema.GroupKind{Group: v1beta1.Group, Kind: v1beta1.MySQLServerKind},
+			create: validateSQLServer,
+			update: validateSQLServerUpdate,
+		}).
+		Complete(); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&v1beta1.PostgreSQLServer{}).
+		WithValidator(&validator{
+			gk:     schema.GroupKind{Group: v1beta1.Group, Kind: v1beta1.PostgreSQLServerKind},
+			create: validateSQLServer,
+			update: validateSQLServerUpdate,
+		}).
+		Complete()
+}
+
+// sqlServerParameters returns the parameters of the supplied MySQL or
+// PostgreSQL server.
+func sqlServerParameters(mg resource.Managed) v1beta1.SQLServerParameters {
+	switch cr := mg.(type) {
+	case *v1beta1.MySQLServer:
+		return cr.Spec.ForProvider
+	case *v1beta1.PostgreSQLServer:
+		return cr.Spec.ForProvider
+	}
+	return v1beta1.SQLServerParameters{}
+}
+
+// validateSQLServer returns an error if the SKU of the supplied MySQL or
+// PostgreSQL server is not supported by Azure.
+func validateSQLServer(mg resource.Managed) field.ErrorList {
+	sku := sqlServerParameters(mg).SKU
+	caps, ok := skuCapacities[sku.Tier]
+	if !ok {
+		// The CRD schema already restricts the tier.
+		return nil
+	}
+	for _, c := range caps {
+		if c == sku.Capacity {
+			return nil
+		}
+	}
+	supported := make([]string, len(caps))
+	for i, c := range caps {
+		supported[i] = strconv.Itoa(c)
+	}
+	return field.ErrorList{field.NotSupported(field.NewPath("spec", "forProvider", "sku", "capacity"), sku.Capacity, supported)}
+}
+
+// validateSQLServerUpdate returns an error if a field that identifies the
+// external MySQL or PostgreSQL server was changed.
+func validateSQLServerUpdate(oldMg, mg resource.Managed) field.ErrorList {
+	old, p := sqlServerParameters(oldMg), sqlServerParameters(mg)
+	fp := field.NewPath("spec", "forProvider")
+	errs := immutableExternalName(oldMg, mg)
+	errs = append(errs, immutableLocation(fp.Child("location"), old.Location, p.Location)...)
+	return append(errs, immutableName(fp.Child("resourceGroupName"), old.ResourceGroupName, p.ResourceGroupName)...)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-network-azure-crossplane-io-v1alpha3-virtualnetwork,mutating=false,failurePolicy=fail,groups=network.azure.crossplane.io,resources=virtualnetworks,versions=v1alpha3,name=virtualnetworks.network.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=create;update,path=/validate-network-azure-crossplane-io-v1alpha3-subnet,mutating=false,failurePolicy=fail,groups=network.azure.crossplane.io,resources=subnets,versions=v1alpha3,name=subnets.network.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+func setupNetwork(mgr ctrl.Manager) error {
+	if err := ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha3.VirtualNetwork{}).
+		WithValidator(&validator{
+			gk:     schema.GroupKind{Group: v1alpha3.Group, Kind: v1alpha3.VirtualNetworkKind},
+			create: validateVirtualNetwork,
+			update: validateVirtualNetworkUpdate,
+		}).
+		Complete(); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha3.Subnet{}).
+		WithValidator(&validator{
+			gk:     schema.GroupKind{Group: v1alpha3.Group, Kind: v1alpha3.SubnetKind},
+			create: validateSubnet,
+			update: validateSubnetUpdate,
+		}).
+		Complete()
+}
+
+// validateVirtualNetwork returns an error if an address prefix of the
+// supplied virtual network is not in CIDR notation.
+func validateVirtualNetwork(mg resource.Managed) field.ErrorList {
+	vn := mg.(*v1alpha3.VirtualNetwork)
+	fp := field.NewPath("spec", "properties", "addressSpace", "addressPrefixes")
+	var errs field.ErrorList
+	for i, prefix := range vn.Spec.AddressSpace.AddressPrefixes {
+		errs = append(errs, validCIDR(fp.Index(i), prefix)...)
+	}
+	return errs
+}
+
+// validateVirtualNetworkUpdate returns an error if a field that identifies the
+// external virtual network was changed.
+func validateVirtualNetworkUpdate(oldMg, mg resource.Managed) field.ErrorList {
+	old, vn := oldMg.(*v1alpha3.VirtualNetwork), mg.(*v1alpha3.VirtualNetwork)
+	fp := field.NewPath("spec")
+	errs := immutableExternalName(oldMg, mg)
+	errs = append(errs, immutableLocation(fp.Child("location"), old.Spec.Location, vn.Spec.Location)...)
+	return append(errs, immutableName(fp.Child("resourceGroupName"), old.Spec.ResourceGroupName, vn.Spec.ResourceGroupName)...)
+}
+
+// validateSubnet returns an error if the address prefix of the supplied
+// subnet is not in CIDR notation.
+func validateSubnet(mg resource.Managed) field.ErrorList {
+	s := mg.(*v1alpha3.Subnet)
+	return validCIDR(field.NewPath("spec", "properties", "addressPrefix"), s.Spec.AddressPrefix)
+}
+
+// validateSubnetUpdate returns an error if a field that identifies the
+// external subnet was changed.
+func validateSubnetUpdate(oldMg, mg resource.Managed) field.ErrorList {
+	old, s := oldMg.(*v1alpha3.Subnet), mg.(*v1alpha3.Subnet)
+	fp := field.NewPath("spec")
+	errs := immutableExternalName(oldMg, mg)
+	errs = append(errs, immutableName(fp.Child("virtualNetworkName"), old.Spec.VirtualNetworkName, s.Spec.VirtualNetworkName)...)
+	return append(errs, immutableName(fp.Child("resourceGroupName"), old.Spec.ResourceGroupName, s.Spec.ResourceGroupName)...)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-azure/apis/sql/v1alpha1"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-sql-azure-crossplane-io-v1alpha1-sqlserver,mutating=false,failurePolicy=fail,groups=sql.azure.crossplane.io,resources=sqlservers,versions=v1alpha1,name=sqlservers.sql.azure.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+func setupSQL(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha1.SQLServer{}).
+		WithValidator(&validator{
+			gk:     schema.GroupKind{Group: v1alpha1.Group, Kind: v1alpha1.SQLServerKind},
+			update: validateAzureSQLServerUpdate,
+		}).
+		Complete()
+}
+
+// validateAzureSQLServerUpdate returns an error if a field that identifies
+// the external Azure SQL server was changed.
+func validateAzureSQLServerUpdate(oldMg, mg resource.Managed) field.ErrorList {
+	old, p := oldMg.(*v1alpha1.SQLServer).Spec.ForProvider, mg.(*v1alpha1.SQLServer).Spec.ForProvider
+	fp := field.NewPath("spec", "forProvider")
+	errs := immutableExternalName(oldMg, mg)
+	errs = append(errs, immutableLocation(fp.Child("location"), old.Location, p.Location)...)
+	return append(errs, immutableName(fp.Child("resourceGroupName"), old.ResourceGroupName, p.ResourceGroupName)...)
+}
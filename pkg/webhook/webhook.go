@@ -0,0 +1,162 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements admission webhooks that reject invalid Azure
+// managed resources before they are persisted, rather than letting their
+// controllers fail to reconcile them. It also serves the conversion webhook
+// of kinds that have more than one API version.
+package webhook
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	azure "github.com/crossplane-contrib/provider-azure/pkg/clients"
+)
+
+const errImmutable = "cannot be changed after the external resource was created"
+
+// Setup registers the admission webhooks of Azure managed resources with the
+// supplied manager. The conversion webhook is registered along with the
+// admission webhooks of the hub version of a convertible kind.
+func Setup(mgr ctrl.Manager) error {
+	for _, setup := range []func(ctrl.Manager) error{
+		setupDatabase,
+		setupSQL,
+		setupNetwork,
+		setupCompute,
+	} {
+		if err := setup(mgr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A validator validates managed resources of a single kind. Fields that are
+// valid on creation must remain valid on update, so create validations are
+// run on both.
+type validator struct {
+	gk     schema.GroupKind
+	create func(mg resource.Managed) field.ErrorList
+	update func(old, mg resource.Managed) field.ErrorList
+}
+
+// ValidateCreate validates a managed resource that is being created.
+func (v *validator) ValidateCreate(_ context.Context, obj runtime.Object) error {
+	mg, ok := obj.(resource.Managed)
+	if !ok {
+		return nil
+	}
+	return v.invalid(mg, v.validateCreate(mg))
+}
+
+// ValidateUpdate validates a managed resource that is being updated.
+func (v *validator) ValidateUpdate(_ context.Context, oldObj, obj runtime.Object) error {
+	old, ok := oldObj.(resource.Managed)
+	if !ok {
+		return nil
+	}
+	mg, ok := obj.(resource.Managed)
+	if !ok {
+		return nil
+	}
+	errs := v.validateCreate(mg)
+	if v.update != nil && created(old) {
+		errs = append(errs, v.update(old, mg)...)
+	}
+	return v.invalid(mg, errs)
+}
+
+// ValidateDelete allows all managed resources to be deleted.
+func (v *validator) ValidateDelete(_ context.Context, _ runtime.Object) error {
+	return nil
+}
+
+func (v *validator) validateCreate(mg resource.Managed) field.ErrorList {
+	if v.create == nil {
+		return nil
+	}
+	return v.create(mg)
+}
+
+func (v *validator) invalid(mg resource.Managed, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return kerrors.NewInvalid(v.gk, mg.GetName(), errs)
+}
+
+// created returns true if the external resource of the supplied managed
+// resource was created, or observed to exist. Fields that identify the
+// external resource may change freely until then.
+func created(mg resource.Managed) bool {
+	return !meta.GetExternalCreateSucceeded(mg).IsZero() || mg.GetCondition(xpv1.TypeReady).Reason != ""
+}
+
+// immutableExternalName returns an error if the external name of the supplied
+// managed resource was changed.
+func immutableExternalName(old, mg resource.Managed) field.ErrorList {
+	o, n := meta.GetExternalName(old), meta.GetExternalName(mg)
+	if o == "" || o == n {
+		return nil
+	}
+	p := field.NewPath("metadata", "annotations").Key(meta.AnnotationKeyExternalName)
+	return field.ErrorList{field.Invalid(p, n, errImmutable)}
+}
+
+// immutableLocation returns an error if the supplied location was changed.
+// Locations that differ only in their format, e.g. West US 2 and westus2,
+// are considered unchanged.
+func immutableLocation(p *field.Path, old, location string) field.ErrorList {
+	if !azure.LocationChanged(location, old) {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(p, location, errImmutable)}
+}
+
+// immutableName returns an error if the supplied name of an Azure resource,
+// e.g. a resource group, was changed. Azure resource names are case
+// insensitive. A name may be set once, e.g. when a reference is resolved.
+func immutableName(p *field.Path, old, name string) field.ErrorList {
+	if old == "" || strings.EqualFold(old, name) {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(p, name, errImmutable)}
+}
+
+// validCIDR returns an error if the supplied value is not empty and not an IP
+// address range in CIDR notation, e.g. 10.0.0.0/16.
+func validCIDR(p *field.Path, cidr string) field.ErrorList {
+	if cidr == "" {
+		return nil
+	}
+	if _, _, err := net.ParseCIDR(cidr); err != nil {
+		return field.ErrorList{field.Invalid(p, cidr, "must be an IP address range in CIDR notation, e.g. 10.0.0.0/16")}
+	}
+	return nil
+}
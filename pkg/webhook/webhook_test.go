@@ -0,0 +1,211 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-azure/apis"
+	computev1alpha3 "github.com/crossplane-contrib/provider-azure/apis/compute/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/database/v1beta1"
+	networkv1alpha3 "github.com/crossplane-contrib/provider-azure/apis/network/v1alpha3"
+	"github.com/crossplane-contrib/provider-azure/apis/sql/v1alpha1"
+)
+
+func TestValidateUpdate(t *testing.T) {
+	gk := schema.GroupKind{Group: v1alpha1.Group, Kind: v1alpha1.SQLServerKind}
+	v := &validator{gk: gk, update: validateAzureSQLServerUpdate}
+
+	server := func(name, location, rg string, created bool) *v1alpha1.SQLServer {
+		s := &v1alpha1.SQLServer{}
+		s.SetName("cool")
+		meta.SetExternalName(s, name)
+		s.Spec.ForProvider.Location = location
+		s.Spec.ForProvider.ResourceGroupName = rg
+		if created {
+			meta.SetExternalCreateSucceeded(s, time.Now())
+		}
+		return s
+	}
+
+	cases := map[string]struct {
+		old  *v1alpha1.SQLServer
+		new  *v1alpha1.SQLServer
+		want error
+	}{
+		"NotCreated": {
+			old: server("a", "westus", "rg", false),
+			new: server("b", "eastus", "other", false),
+		},
+		"Unchanged": {
+			old: server("a", "West US", "rg", true),
+			new: server("a", "westus", "RG", true),
+		},
+		"ResourceGroupResolved": {
+			old: server("a", "westus", "", true),
+			new: server("a", "westus", "rg", true),
+		},
+		"Changed": {
+			old: server("a", "westus", "rg", true),
+			new: server("b", "eastus", "other", true),
+			want: kerrors.NewInvalid(gk, "cool", field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "annotations").Key(meta.AnnotationKeyExternalName), "b", errImmutable),
+				field.Invalid(field.NewPath("spec", "forProvider", "location"), "eastus", errImmutable),
+				field.Invalid(field.NewPath("spec", "forProvider", "resourceGroupName"), "other", errImmutable),
+			}),
+		},
+		"ObservedWithoutCreation": {
+			old: func() *v1alpha1.SQLServer {
+				s := server("a", "westus", "rg", false)
+				s.SetConditions(xpv1.Available())
+				return s
+			}(),
+			new: server("a", "westus", "other", false),
+			want: kerrors.NewInvalid(gk, "cool", field.ErrorList{
+				field.Invalid(field.NewPath("spec", "forProvider", "resourceGroupName"), "other", errImmutable),
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := v.ValidateUpdate(context.Background(), tc.old, tc.new)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateUpdate(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+	mysql := func(tier string, capacity int) *v1beta1.MySQLServer {
+		s := &v1beta1.MySQLServer{}
+		s.Spec.ForProvider.SKU = v1beta1.SKU{Tier: tier, Capacity: capacity, Family: "Gen5"}
+		return s
+	}
+	aks := func(serviceCIDR, dnsServiceIP string) *computev1alpha3.AKSCluster {
+		c := &computev1alpha3.AKSCluster{}
+		c.Spec.ServiceCIDR = serviceCIDR
+		c.Spec.DNSServiceIP = dnsServiceIP
+		return c
+	}
+	vnet := func(prefixes ...string) *networkv1alpha3.VirtualNetwork {
+		vn := &networkv1alpha3.VirtualNetwork{}
+		vn.Spec.AddressSpace.AddressPrefixes = prefixes
+		return vn
+	}
+
+	cases := map[string]struct {
+		v    *validator
+		mg   resource.Managed
+		want field.ErrorList
+	}{
+		"ValidSKU": {
+			v:  &validator{create: validateSQLServer},
+			mg: mysql("GeneralPurpose", 4),
+		},
+		"UnsupportedSKUCapacity": {
+			v:  &validator{create: validateSQLServer},
+			mg: mysql("Basic", 4),
+			want: field.ErrorList{
+				field.NotSupported(field.NewPath("spec", "forProvider", "sku", "capacity"), 4, []string{"1", "2"}),
+			},
+		},
+		"ValidAddressPrefixes": {
+			v:  &validator{create: validateVirtualNetwork},
+			mg: vnet("10.0.0.0/16", "10.1.0.0/16"),
+		},
+		"InvalidAddressPrefix": {
+			v:  &validator{create: validateVirtualNetwork},
+			mg: vnet("10.0.0.0/16", "10.1.0.0"),
+			want: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "properties", "addressSpace", "addressPrefixes").Index(1), "10.1.0.0", "must be an IP address range in CIDR notation, e.g. 10.0.0.0/16"),
+			},
+		},
+		"DNSServiceIPWithinServiceCIDR": {
+			v:  &validator{create: validateAKSCluster},
+			mg: aks("10.0.0.0/16", "10.0.0.10"),
+		},
+		"DNSServiceIPOutsideServiceCIDR": {
+			v:  &validator{create: validateAKSCluster},
+			mg: aks("10.0.0.0/16", "10.1.0.10"),
+			want: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "dnsServiceIP"), "10.1.0.10", "must be within spec.serviceCidr"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.v.validateCreate(tc.mg)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("validateCreate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConvertible(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatalf("apis.AddToScheme(...): %s", err)
+	}
+
+	cases := map[string]struct {
+		obj  runtime.Object
+		want bool
+	}{
+		"AKSCluster": {
+			obj:  &computev1alpha3.AKSCluster{},
+			want: true,
+		},
+		"SQLServer": {
+			obj:  &v1alpha1.SQLServer{},
+			want: true,
+		},
+		"Subnet": {
+			obj:  &networkv1alpha3.Subnet{},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := conversion.IsConvertible(s, tc.obj)
+			if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+				t.Errorf("IsConvertible(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsConvertible(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
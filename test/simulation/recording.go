@@ -0,0 +1,199 @@
+//go:build simulation
+// +build simulation
+
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package simulation supports tests that run the provider's controllers in
+// simulation mode, against a local API simulator rather than an Azure
+// subscription. Azure Resource Manager calls are served from recorded API
+// responses, while storage data plane calls are served by Azurite. The tests
+// live alongside the controllers they exercise, and are built with the
+// simulation build tag.
+package simulation
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-azure/apis"
+	"github.com/crossplane-contrib/provider-azure/apis/v1beta1"
+)
+
+// The subscription, resource group and location of the recorded resources.
+const (
+	SubscriptionID = "00000000-0000-0000-0000-000000000000"
+	ResourceGroup  = "coolgroup"
+	Location       = "westus2"
+)
+
+// ProviderConfigName is the name of the ProviderConfig served by NewKube.
+const ProviderConfigName = "simulation"
+
+const (
+	// AzuriteAccount and AzuriteKey are the well known storage account and
+	// key served by Azurite.
+	// https://docs.microsoft.com/en-us/azure/storage/common/storage-use-azurite#well-known-storage-account-and-key
+	AzuriteAccount = "devstoreaccount1"
+	AzuriteKey     = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+
+	// EnvAzuriteBlobEndpoint is the environment variable that must be set to
+	// the blob service endpoint of an Azurite instance to run the container
+	// tests, e.g. http://127.0.0.1:10000/devstoreaccount1.
+	EnvAzuriteBlobEndpoint = "AZURITE_BLOB_ENDPOINT"
+)
+
+// An Interaction is a recorded Azure API request and its response.
+type Interaction struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// A Recording replays recorded Azure API responses.
+type Recording struct {
+	t *testing.T
+
+	mu           sync.Mutex
+	interactions map[string][]Interaction
+}
+
+// NewRecording loads the named recording from the testdata directory of this
+// package.
+func NewRecording(t *testing.T, name string) *Recording {
+	t.Helper()
+	_, file, _, _ := runtime.Caller(0)
+	b, err := os.ReadFile(filepath.Join(filepath.Dir(file), "testdata", name))
+	if err != nil {
+		t.Fatalf("cannot read recording %s: %v", name, err)
+	}
+	in := []Interaction{}
+	if err := json.Unmarshal(b, &in); err != nil {
+		t.Fatalf("cannot unmarshal recording %s: %v", name, err)
+	}
+	r := &Recording{t: t, interactions: map[string][]Interaction{}}
+	for _, i := range in {
+		k := key(i.Method, i.Path)
+		r.interactions[k] = append(r.interactions[k], i)
+	}
+	return r
+}
+
+// Serve starts an HTTP server that replays the recording. Interactions that
+// share a method and path are replayed in the order they were recorded, and
+// the last of them is repeated once they are exhausted, e.g. while a client
+// polls a long running operation. The server is closed when the test ends.
+func (r *Recording) Serve() *httptest.Server {
+	srv := httptest.NewServer(r)
+	r.t.Cleanup(srv.Close)
+	return srv
+}
+
+// ServeHTTP replays the recorded response to the supplied request.
+func (r *Recording) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	_, _ = io.Copy(io.Discard, req.Body)
+
+	r.mu.Lock()
+	k := key(req.Method, req.URL.Path)
+	in, ok := r.interactions[k]
+	if !ok || len(in) == 0 {
+		r.mu.Unlock()
+		r.t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+		w.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+	i := in[0]
+	if len(in) > 1 {
+		r.interactions[k] = in[1:]
+	}
+	r.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(i.Status)
+	_, _ = w.Write(i.Body)
+}
+
+// Azure Resource Manager paths are case insensitive.
+func key(method, path string) string {
+	return method + " " + strings.ToLower(path)
+}
+
+// NewKube returns a fake Kubernetes API client that serves the supplied
+// objects, and a ProviderConfig named ProviderConfigName whose credentials
+// point the Azure clients at the supplied simulator endpoint.
+func NewKube(t *testing.T, endpoint string, objs ...client.Object) client.Client {
+	t.Helper()
+	s := kruntime.NewScheme()
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatalf("cannot add core types to scheme: %v", err)
+	}
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatalf("cannot add provider types to scheme: %v", err)
+	}
+
+	creds, err := json.Marshal(map[string]string{
+		"subscriptionId":             SubscriptionID,
+		"resourceManagerEndpointUrl": endpoint,
+	})
+	if err != nil {
+		t.Fatalf("cannot marshal credentials: %v", err)
+	}
+	ref := xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: ProviderConfigName},
+		Key:             "credentials",
+	}
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ref.Namespace, Name: ref.Name},
+			Data:       map[string][]byte{ref.Key: creds},
+		},
+		&v1beta1.ProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: ProviderConfigName},
+			Spec: v1beta1.ProviderConfigSpec{Credentials: v1beta1.ProviderCredentials{
+				Source:                    xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &ref},
+			}},
+		},
+	)
+	return fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+}
+
+// Get returns the current state of the supplied object, failing the test if
+// it cannot be read.
+func Get(t *testing.T, kube client.Client, obj client.Object) {
+	t.Helper()
+	if err := kube.Get(context.Background(), client.ObjectKeyFromObject(obj), obj); err != nil {
+		t.Fatalf("cannot get %s: %v", obj.GetName(), err)
+	}
+}